@@ -65,11 +65,25 @@ func initCommands(ui cli.Ui) map[string]cli.CommandFactory {
 		"generate resources":    commandFactory(&cmd.GenerateResourcesCommand{UI: ui}),
 		"generate data-sources": commandFactory(&cmd.GenerateDataSourcesCommand{UI: ui}),
 		"generate provider":     commandFactory(&cmd.GenerateProviderCommand{UI: ui}),
+		"generate docs":         commandFactory(&cmd.GenerateDocsCommand{UI: ui}),
+		"generate examples":     commandFactory(&cmd.GenerateExamplesCommand{UI: ui}),
+		"generate tests":        commandFactory(&cmd.GenerateTestsCommand{UI: ui}),
 		// Code scaffolding commands
-		"scaffold":             commandFactory(&cmd.ScaffoldCommand{UI: ui}),
-		"scaffold resource":    commandFactory(&cmd.ScaffoldResourceCommand{UI: ui}),
-		"scaffold data-source": commandFactory(&cmd.ScaffoldDataSourceCommand{UI: ui}),
-		"scaffold provider":    commandFactory(&cmd.ScaffoldProviderCommand{UI: ui}),
+		"scaffold":                    commandFactory(&cmd.ScaffoldCommand{UI: ui}),
+		"scaffold resource":           commandFactory(&cmd.ScaffoldResourceCommand{UI: ui}),
+		"scaffold data-source":        commandFactory(&cmd.ScaffoldDataSourceCommand{UI: ui}),
+		"scaffold ephemeral-resource": commandFactory(&cmd.ScaffoldEphemeralResourceCommand{UI: ui}),
+		"scaffold function":           commandFactory(&cmd.ScaffoldFunctionCommand{UI: ui}),
+		"scaffold provider":           commandFactory(&cmd.ScaffoldProviderCommand{UI: ui}),
+		"scaffold project":            commandFactory(&cmd.ScaffoldProjectCommand{UI: ui}),
+		// Specification commands
+		"diff":          commandFactory(&cmd.DiffCommand{UI: ui}),
+		"fmt":           commandFactory(&cmd.FmtCommand{UI: ui}),
+		"import-schema": commandFactory(&cmd.ImportSchemaCommand{UI: ui}),
+		"import-sdkv2":  commandFactory(&cmd.ImportSDKv2Command{UI: ui}),
+		"import-struct": commandFactory(&cmd.ImportStructCommand{UI: ui}),
+		"lint":          commandFactory(&cmd.LintCommand{UI: ui}),
+		"validate":      commandFactory(&cmd.ValidateCommand{UI: ui}),
 	}
 }
 
@@ -0,0 +1,192 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+// Package acctest generates acceptance test skeletons for the resources and data
+// sources in a specification, using terraform-plugin-testing.
+package acctest
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"text/template"
+
+	"github.com/hashicorp/terraform-plugin-codegen-spec/spec"
+
+	"github.com/hashicorp/terraform-plugin-codegen-framework/internal/config"
+	"github.com/hashicorp/terraform-plugin-codegen-framework/internal/examples"
+	"github.com/hashicorp/terraform-plugin-codegen-framework/internal/schema"
+)
+
+// stateCheck asserts the value of a top-level attribute in state.
+type stateCheck struct {
+	// Name is the name of the attribute.
+	Name string
+
+	// KnownValue is the Go expression of the knownvalue.Check for the
+	// attribute (e.g., knownvalue.StringExact("example")).
+	KnownValue string
+}
+
+// kind defines the test generated for a data source or resource.
+type kind struct {
+	// suffix is appended to the name of generated test files (e.g.,
+	// _resource_test.go).
+	suffix string
+
+	// blockType is the type of configuration block (e.g., resource).
+	blockType string
+
+	// addressPrefix is prepended to the type name to form the address of the
+	// data source or resource in state (e.g., data.).
+	addressPrefix string
+
+	// template is the test template.
+	template *string
+}
+
+var (
+	dataSourceKind = kind{
+		suffix:        "_data_source_test.go",
+		blockType:     "data",
+		addressPrefix: "data.",
+		template:      &dataSourceTestGoTemplate,
+	}
+	resourceKind = kind{
+		suffix:    "_resource_test.go",
+		blockType: "resource",
+		template:  &resourceTestGoTemplate,
+	}
+)
+
+// Generate returns the acceptance tests generated for the resources and data
+// sources in the specification, keyed on file name (e.g.,
+// instance_resource_test.go and instance_data_source_test.go).
+//
+// Each test applies a configuration which sets the required attributes and
+// blocks to the placeholder values used in generated examples, and checks the
+// values of the required and computed attributes in state. Resource tests also
+// include an import step. The tests refer to testAccPreCheck and
+// testAccProtoV6ProviderFactories, which are expected to be declared by the
+// provider. The test function names are cased with the naming settings of the
+// generator configuration.
+func Generate(s spec.Specification, cfg config.Config, packageName string) (map[string][]byte, error) {
+	providerName := ""
+
+	if s.Provider != nil {
+		providerName = s.Provider.Name
+	}
+
+	opts := cfg.Naming.Options()
+
+	files := make(map[string][]byte)
+
+	resourceSchemas, err := cfg.ResourceSchemas(s, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, r := range s.Resources {
+		b, err := generate(resourceKind, r.Name, providerName, packageName, resourceSchemas[r.Name], opts)
+		if err != nil {
+			return nil, fmt.Errorf("error generating resource %q test: %w", r.Name, err)
+		}
+
+		files[r.Name+resourceKind.suffix] = b
+	}
+
+	dataSourceSchemas, err := cfg.DataSourceSchemas(s, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, d := range s.DataSources {
+		b, err := generate(dataSourceKind, d.Name, providerName, packageName, dataSourceSchemas[d.Name], opts)
+		if err != nil {
+			return nil, fmt.Errorf("error generating data source %q test: %w", d.Name, err)
+		}
+
+		files[d.Name+dataSourceKind.suffix] = b
+	}
+
+	return files, nil
+}
+
+func generate(k kind, name, providerName, packageName string, s schema.GeneratorSchema, opts *schema.Options) ([]byte, error) {
+	typeName := providerName + "_" + name
+
+	configuration := examples.Configuration(fmt.Sprintf("%s %q %q", k.blockType, typeName, "test"), s, true)
+
+	t, err := template.New(k.blockType + "_test").Parse(*k.template)
+	if err != nil {
+		return nil, err
+	}
+
+	templateData := struct {
+		PackageName string
+		NamePascal  string
+		Address     string
+		Config      string
+		StateChecks []stateCheck
+	}{
+		PackageName: packageName,
+		NamePascal:  opts.ToPascalCase(schema.FrameworkIdentifier(name)),
+		Address:     k.addressPrefix + typeName + ".test",
+		Config:      string(configuration),
+		StateChecks: stateChecks(s.Attributes),
+	}
+
+	var buf bytes.Buffer
+
+	err = t.Execute(&buf, templateData)
+	if err != nil {
+		return nil, err
+	}
+
+	return format.Source(buf.Bytes())
+}
+
+// stateChecks returns a check for each required and computed attribute.
+// Attributes with a primitive type are checked against the placeholder value
+// set in the configuration for required attributes, or the static default for
+// computed attributes, and all other attributes are checked to be set.
+func stateChecks(attributes schema.GeneratorAttributes) []stateCheck {
+	var checks []stateCheck
+
+	for _, f := range schema.Fields(attributes, nil) {
+		if !f.Required && !f.Computed {
+			continue
+		}
+
+		check := stateCheck{
+			Name:       f.Name,
+			KnownValue: "knownvalue.NotNull()",
+		}
+
+		value := ""
+
+		switch {
+		case f.Required:
+			value = examples.PrimitiveValue(f.Type)
+		case f.StaticDefault != "":
+			value = f.StaticDefault
+		}
+
+		if value != "" {
+			switch f.Type {
+			case schema.GeneratorBoolAttribute:
+				check.KnownValue = "knownvalue.Bool(" + value + ")"
+			case schema.GeneratorFloat64Attribute:
+				check.KnownValue = "knownvalue.Float64Exact(" + value + ")"
+			case schema.GeneratorInt64Attribute:
+				check.KnownValue = "knownvalue.Int64Exact(" + value + ")"
+			case schema.GeneratorStringAttribute:
+				check.KnownValue = "knownvalue.StringExact(" + value + ")"
+			}
+		}
+
+		checks = append(checks, check)
+	}
+
+	return checks
+}
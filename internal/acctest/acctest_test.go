@@ -0,0 +1,249 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package acctest_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-codegen-spec/spec"
+
+	"github.com/hashicorp/terraform-plugin-codegen-framework/internal/acctest"
+	"github.com/hashicorp/terraform-plugin-codegen-framework/internal/config"
+)
+
+func TestGenerate(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		spec     string
+		config   config.Config
+		expected map[string]string
+	}{
+		"no_state_checks": {
+			spec: `{
+  "version": "0.1",
+  "provider": {"name": "example"},
+  "datasources": [
+    {
+      "name": "one",
+      "schema": {
+        "attributes": [
+          {"name": "filter", "string": {"computed_optional_required": "optional"}}
+        ]
+      }
+    }
+  ]
+}`,
+			expected: map[string]string{
+				"one_data_source_test.go": `package example
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccOneDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		// Verify that the environment required by the acceptance tests, such
+		// as API credentials, is configured.
+		PreCheck: func() { testAccPreCheck(t) },
+
+		// Map of provider names to provider servers, such as
+		// providerserver.NewProtocol6WithError(New("test")()).
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: testAccOneDataSourceConfig(),
+			},
+		},
+	})
+}
+
+func testAccOneDataSourceConfig() string {
+	return ` + "`" + `
+data "example_one" "test" {}
+` + "`" + `
+}
+`,
+			},
+		},
+		"naming": {
+			spec: `{
+  "version": "0.1",
+  "provider": {"name": "example"},
+  "datasources": [
+    {
+      "name": "vpc_id",
+      "schema": {
+        "attributes": [
+          {"name": "filter", "string": {"computed_optional_required": "optional"}}
+        ]
+      }
+    }
+  ]
+}`,
+			config: config.Config{
+				Naming: &config.Naming{
+					Initialisms:      true,
+					ExtraInitialisms: []string{"VPC"},
+				},
+			},
+			expected: map[string]string{
+				"vpc_id_data_source_test.go": `package example
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccVPCIDDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		// Verify that the environment required by the acceptance tests, such
+		// as API credentials, is configured.
+		PreCheck: func() { testAccPreCheck(t) },
+
+		// Map of provider names to provider servers, such as
+		// providerserver.NewProtocol6WithError(New("test")()).
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: testAccVPCIDDataSourceConfig(),
+			},
+		},
+	})
+}
+
+func testAccVPCIDDataSourceConfig() string {
+	return ` + "`" + `
+data "example_vpc_id" "test" {}
+` + "`" + `
+}
+`,
+			},
+		},
+		"state_checks": {
+			spec: `{
+  "version": "0.1",
+  "provider": {"name": "example"},
+  "resources": [
+    {
+      "name": "one_two",
+      "schema": {
+        "attributes": [
+          {"name": "id", "string": {"computed_optional_required": "computed"}},
+          {"name": "enabled", "bool": {"computed_optional_required": "required"}},
+          {"name": "count", "int64": {"computed_optional_required": "computed_optional", "default": {"static": 3}}},
+          {"name": "ratio", "number": {"computed_optional_required": "required"}},
+          {"name": "description", "string": {"computed_optional_required": "optional"}}
+        ]
+      }
+    }
+  ]
+}`,
+			expected: map[string]string{
+				"one_two_resource_test.go": `package example
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+)
+
+func TestAccOneTwoResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		// Verify that the environment required by the acceptance tests, such
+		// as API credentials, is configured.
+		PreCheck: func() { testAccPreCheck(t) },
+
+		// Map of provider names to provider servers, such as
+		// providerserver.NewProtocol6WithError(New("test")()).
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccOneTwoResourceConfig(),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"example_one_two.test",
+						tfjsonpath.New("count"),
+						knownvalue.Int64Exact(3),
+					),
+					statecheck.ExpectKnownValue(
+						"example_one_two.test",
+						tfjsonpath.New("enabled"),
+						knownvalue.Bool(true),
+					),
+					statecheck.ExpectKnownValue(
+						"example_one_two.test",
+						tfjsonpath.New("id"),
+						knownvalue.NotNull(),
+					),
+					statecheck.ExpectKnownValue(
+						"example_one_two.test",
+						tfjsonpath.New("ratio"),
+						knownvalue.NotNull(),
+					),
+				},
+			},
+			// ImportState testing
+			{
+				ResourceName:      "example_one_two.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccOneTwoResourceConfig() string {
+	return ` + "`" + `
+resource "example_one_two" "test" {
+  enabled = true
+  ratio   = 1.5
+}
+` + "`" + `
+}
+`,
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			s, err := spec.Parse(context.Background(), []byte(testCase.spec))
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			got, err := acctest.Generate(s, testCase.config, "example")
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			gotStrings := make(map[string]string, len(got))
+
+			for k, v := range got {
+				gotStrings[k] = string(v)
+			}
+
+			if diff := cmp.Diff(testCase.expected, gotStrings); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,14 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package acctest
+
+import (
+	_ "embed"
+)
+
+//go:embed templates/resource_test.gotmpl
+var resourceTestGoTemplate string
+
+//go:embed templates/data_source_test.gotmpl
+var dataSourceTestGoTemplate string
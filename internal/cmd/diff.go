@@ -0,0 +1,212 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/cli"
+	"github.com/hashicorp/terraform-plugin-codegen-spec/spec"
+
+	"github.com/hashicorp/terraform-plugin-codegen-framework/internal/diff"
+	"github.com/hashicorp/terraform-plugin-codegen-framework/internal/input"
+	"github.com/hashicorp/terraform-plugin-codegen-framework/internal/validate"
+)
+
+// diffBreakingExitCode is the exit status of the diff command if there are
+// breaking changes, which is distinct from the exit status of 1 on error.
+const diffBreakingExitCode = 2
+
+type DiffCommand struct {
+	UI              cli.Ui
+	flagOldPath     string
+	flagNewPath     string
+	flagInputFormat string
+	flagFormat      string
+}
+
+func (cmd *DiffCommand) Flags() *flag.FlagSet {
+	fs := flag.NewFlagSet("diff", flag.ExitOnError)
+	fs.StringVar(&cmd.flagOldPath, "old", "", "path to previous intermediate representation (JSON or YAML), required")
+	fs.StringVar(&cmd.flagNewPath, "new", "", "path to new intermediate representation (JSON or YAML), required")
+	fs.StringVar(&cmd.flagInputFormat, "input-format", "", "format of intermediate representations, json or yaml, defaults to yaml for .yaml and .yml files and json otherwise")
+	fs.StringVar(&cmd.flagFormat, "format", "text", "output format, text or json")
+
+	return fs
+}
+
+func (cmd *DiffCommand) Help() string {
+	strBuilder := &strings.Builder{}
+
+	longestName := 0
+	longestUsage := 0
+	cmd.Flags().VisitAll(func(f *flag.Flag) {
+		if len(f.Name) > longestName {
+			longestName = len(f.Name)
+		}
+		if len(f.Usage) > longestUsage {
+			longestUsage = len(f.Usage)
+		}
+	})
+
+	strBuilder.WriteString("\nUsage: tfplugingen-framework diff [<args>]\n\n")
+	cmd.Flags().VisitAll(func(f *flag.Flag) {
+		if f.DefValue != "" {
+			strBuilder.WriteString(fmt.Sprintf("    --%s <ARG> %s%s%s  (default: %q)\n",
+				f.Name,
+				strings.Repeat(" ", longestName-len(f.Name)+2),
+				f.Usage,
+				strings.Repeat(" ", longestUsage-len(f.Usage)+2),
+				f.DefValue,
+			))
+		} else {
+			strBuilder.WriteString(fmt.Sprintf("    --%s <ARG> %s%s%s\n",
+				f.Name,
+				strings.Repeat(" ", longestName-len(f.Name)+2),
+				f.Usage,
+				strings.Repeat(" ", longestUsage-len(f.Usage)+2),
+			))
+		}
+	})
+	strBuilder.WriteString("\n")
+	strBuilder.WriteString(fmt.Sprintf("Exits with status %d if there are breaking changes, and status 1 on error.\n\n", diffBreakingExitCode))
+
+	return strBuilder.String()
+}
+
+func (cmd *DiffCommand) Synopsis() string {
+	return "Report breaking and non-breaking schema changes between two Intermediate Representation (IR) JSON files."
+}
+
+func (cmd *DiffCommand) Run(args []string) int {
+	ctx := context.Background()
+
+	fs := cmd.Flags()
+	err := fs.Parse(args)
+	if err != nil {
+		cmd.UI.Error(fmt.Sprintf("error parsing command flags: %s", err))
+		return 1
+	}
+
+	changes, err := cmd.runInternal(ctx)
+	if err != nil {
+		cmd.UI.Error(fmt.Sprintf("Error executing command: %s\n", err))
+		return 1
+	}
+
+	if diff.HasBreaking(changes) {
+		return diffBreakingExitCode
+	}
+
+	return 0
+}
+
+func (cmd *DiffCommand) runInternal(ctx context.Context) ([]diff.Change, error) {
+	if cmd.flagOldPath == "" {
+		return nil, errors.New("--old flag is required")
+	}
+
+	if cmd.flagNewPath == "" {
+		return nil, errors.New("--new flag is required")
+	}
+
+	if cmd.flagFormat != "text" && cmd.flagFormat != "json" {
+		return nil, fmt.Errorf("unsupported --format %q, expected text or json", cmd.flagFormat)
+	}
+
+	oldSpec, err := readSpecification(ctx, cmd.flagOldPath, cmd.flagInputFormat)
+	if err != nil {
+		return nil, fmt.Errorf("error reading old IR JSON: %w", err)
+	}
+
+	newSpec, err := readSpecification(ctx, cmd.flagNewPath, cmd.flagInputFormat)
+	if err != nil {
+		return nil, fmt.Errorf("error reading new IR JSON: %w", err)
+	}
+
+	changes, err := diff.Compare(oldSpec, newSpec)
+	if err != nil {
+		return nil, fmt.Errorf("error comparing IR JSON: %w", err)
+	}
+
+	if cmd.flagFormat == "json" {
+		output := struct {
+			Breaking bool          `json:"breaking"`
+			Changes  []diff.Change `json:"changes"`
+		}{
+			Breaking: diff.HasBreaking(changes),
+			Changes:  append([]diff.Change{}, changes...),
+		}
+
+		b, err := json.MarshalIndent(output, "", "  ")
+		if err != nil {
+			return nil, fmt.Errorf("error marshalling changes: %w", err)
+		}
+
+		cmd.UI.Output(string(b))
+
+		return changes, nil
+	}
+
+	cmd.UI.Output(diffText(changes))
+
+	return changes, nil
+}
+
+// diffText returns the changes listed under headings for breaking and
+// non-breaking changes.
+func diffText(changes []diff.Change) string {
+	if len(changes) == 0 {
+		return "No changes."
+	}
+
+	var breaking, nonBreaking []string
+
+	for _, c := range changes {
+		if c.Breaking {
+			breaking = append(breaking, "  "+c.String())
+			continue
+		}
+
+		nonBreaking = append(nonBreaking, "  "+c.String())
+	}
+
+	var sections []string
+
+	if len(breaking) > 0 {
+		sections = append(sections, "Breaking changes:\n"+strings.Join(breaking, "\n"))
+	}
+
+	if len(nonBreaking) > 0 {
+		sections = append(sections, "Non-breaking changes:\n"+strings.Join(nonBreaking, "\n"))
+	}
+
+	return strings.Join(sections, "\n\n")
+}
+
+// readSpecification reads, validates and parses the intermediate representation
+// file at the path, in the format determined by input.SpecificationFormat.
+func readSpecification(ctx context.Context, path, format string) (spec.Specification, error) {
+	ir, err := input.ReadSpecification(path, format)
+	if err != nil {
+		return spec.Specification{}, err
+	}
+
+	err = validate.JSON(ir.JSON)
+	if err != nil {
+		return spec.Specification{}, err
+	}
+
+	s, err := spec.Parse(ctx, ir.JSON)
+	if err != nil {
+		return spec.Specification{}, ir.Error(err)
+	}
+
+	return s, nil
+}
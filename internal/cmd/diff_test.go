@@ -0,0 +1,108 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package cmd_test
+
+import (
+	"os"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/cli"
+
+	"github.com/hashicorp/terraform-plugin-codegen-framework/internal/cmd"
+)
+
+func TestDiffCommand(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		oldPath          string
+		newPath          string
+		format           string
+		goldenFile       string
+		expectedExitCode int
+		expectError      bool
+	}{
+		"no_changes": {
+			oldPath:    "testdata/diff/old.json",
+			newPath:    "testdata/diff/old.json",
+			goldenFile: "testdata/diff/no_changes.txt",
+		},
+		"non_breaking": {
+			oldPath:    "testdata/diff/old.json",
+			newPath:    "testdata/diff/new_non_breaking.json",
+			goldenFile: "testdata/diff/non_breaking.txt",
+		},
+		"breaking": {
+			oldPath:          "testdata/diff/old.json",
+			newPath:          "testdata/diff/new_breaking.json",
+			goldenFile:       "testdata/diff/breaking.txt",
+			expectedExitCode: 2,
+		},
+		"breaking_json": {
+			oldPath:          "testdata/diff/old.json",
+			newPath:          "testdata/diff/new_breaking.json",
+			format:           "json",
+			goldenFile:       "testdata/diff/breaking.json",
+			expectedExitCode: 2,
+		},
+		"unsupported_format": {
+			oldPath:     "testdata/diff/old.json",
+			newPath:     "testdata/diff/new_breaking.json",
+			format:      "yaml",
+			expectError: true,
+		},
+		"missing_new": {
+			oldPath:     "testdata/diff/old.json",
+			expectError: true,
+		},
+	}
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			mockUi := cli.NewMockUi()
+			c := cmd.DiffCommand{
+				UI: mockUi,
+			}
+
+			args := []string{
+				"--old", testCase.oldPath,
+				"--new", testCase.newPath,
+			}
+
+			if testCase.format != "" {
+				args = append(args, "--format", testCase.format)
+			}
+
+			exitCode := c.Run(args)
+
+			if testCase.expectError {
+				if mockUi.ErrorWriter.String() == "" {
+					t.Fatal("expected error running `diff` cmd")
+				}
+
+				if exitCode != 1 {
+					t.Fatalf("expected exit code 1 on error, got %d", exitCode)
+				}
+
+				return
+			}
+
+			if exitCode != testCase.expectedExitCode {
+				t.Fatalf("expected exit code %d, got %d: %s", testCase.expectedExitCode, exitCode, mockUi.ErrorWriter.String())
+			}
+
+			expected, err := os.ReadFile(testCase.goldenFile)
+			if err != nil {
+				t.Fatalf("unexpected error reading golden file: %s", err)
+			}
+
+			if diff := cmp.Diff(mockUi.OutputWriter.String(), string(expected)); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
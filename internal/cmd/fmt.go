@@ -0,0 +1,202 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/cli"
+	"github.com/hashicorp/terraform-plugin-codegen-spec/spec"
+
+	"github.com/hashicorp/terraform-plugin-codegen-framework/internal/input"
+	"github.com/hashicorp/terraform-plugin-codegen-framework/internal/output"
+	"github.com/hashicorp/terraform-plugin-codegen-framework/internal/specfmt"
+	"github.com/hashicorp/terraform-plugin-codegen-framework/internal/validate"
+)
+
+type FmtCommand struct {
+	UI            cli.Ui
+	flagCheck     bool
+	flagWrite     bool
+	flagKeepOrder bool
+}
+
+func (cmd *FmtCommand) Flags() *flag.FlagSet {
+	fs := flag.NewFlagSet("fmt", flag.ExitOnError)
+	fs.BoolVar(&cmd.flagCheck, "check", false, "list files which are not formatted, and exit with status 1 if there are any, without writing")
+	fs.BoolVar(&cmd.flagWrite, "w", false, "write the formatted specification to each file, rather than to stdout")
+	fs.BoolVar(&cmd.flagKeepOrder, "keep-order", false, "keep resources, data sources, attributes and blocks in their original order, rather than sorting by name")
+
+	return fs
+}
+
+func (cmd *FmtCommand) Help() string {
+	strBuilder := &strings.Builder{}
+
+	longestName := 0
+	longestUsage := 0
+	cmd.Flags().VisitAll(func(f *flag.Flag) {
+		if len(f.Name) > longestName {
+			longestName = len(f.Name)
+		}
+		if len(f.Usage) > longestUsage {
+			longestUsage = len(f.Usage)
+		}
+	})
+
+	strBuilder.WriteString("\nUsage: tfplugingen-framework fmt [<args>] [<path> ...]\n\n")
+	cmd.Flags().VisitAll(func(f *flag.Flag) {
+		if f.DefValue != "" {
+			strBuilder.WriteString(fmt.Sprintf("    --%s <ARG> %s%s%s  (default: %q)\n",
+				f.Name,
+				strings.Repeat(" ", longestName-len(f.Name)+2),
+				f.Usage,
+				strings.Repeat(" ", longestUsage-len(f.Usage)+2),
+				f.DefValue,
+			))
+		} else {
+			strBuilder.WriteString(fmt.Sprintf("    --%s <ARG> %s%s%s\n",
+				f.Name,
+				strings.Repeat(" ", longestName-len(f.Name)+2),
+				f.Usage,
+				strings.Repeat(" ", longestUsage-len(f.Usage)+2),
+			))
+		}
+	})
+	strBuilder.WriteString("\n")
+	strBuilder.WriteString("Formats each Intermediate Representation (IR) JSON file, or stdin if there are none. Object keys are sorted,\n")
+	strBuilder.WriteString("other than name, which is first, and null values, empty arrays and empty descriptions are dropped.\n")
+	strBuilder.WriteString("YAML specifications (.yaml and .yml files) are not formatted.\n\n")
+
+	return strBuilder.String()
+}
+
+func (cmd *FmtCommand) Synopsis() string {
+	return "Format Intermediate Representation (IR) JSON files canonically."
+}
+
+func (cmd *FmtCommand) Run(args []string) int {
+	ctx := context.Background()
+
+	fs := cmd.Flags()
+	err := fs.Parse(args)
+	if err != nil {
+		cmd.UI.Error(fmt.Sprintf("error parsing command flags: %s", err))
+		return 1
+	}
+
+	unformatted, err := cmd.runInternal(ctx, fs.Args())
+	if err != nil {
+		cmd.UI.Error(fmt.Sprintf("Error executing command: %s\n", err))
+		return 1
+	}
+
+	if cmd.flagCheck && len(unformatted) > 0 {
+		return 1
+	}
+
+	return 0
+}
+
+// runInternal formats each path, or stdin if there are none, and returns the
+// paths which were not formatted.
+func (cmd *FmtCommand) runInternal(ctx context.Context, paths []string) ([]string, error) {
+	if cmd.flagCheck && cmd.flagWrite {
+		return nil, errors.New("--check and -w flags cannot be used together")
+	}
+
+	if cmd.flagWrite && len(paths) == 0 {
+		return nil, errors.New("-w flag requires file paths, as stdin cannot be rewritten")
+	}
+
+	if len(paths) == 0 {
+		paths = []string{""}
+	}
+
+	var unformatted []string
+
+	for _, path := range paths {
+		name := path
+		if name == "" {
+			name = "<stdin>"
+		}
+
+		format, err := input.SpecificationFormat(path, "")
+		if err != nil {
+			return nil, err
+		}
+
+		if format == input.FormatYAML {
+			return nil, fmt.Errorf("error formatting %s: only JSON specifications are formatted, as YAML comments and layout would be lost", name)
+		}
+
+		src, err := input.Read(path)
+		if err != nil {
+			return nil, fmt.Errorf("error reading %s: %w", name, err)
+		}
+
+		b, err := cmd.format(ctx, src)
+		if err != nil {
+			return nil, fmt.Errorf("error formatting %s: %w", name, err)
+		}
+
+		changed := !bytes.Equal(src, b)
+
+		if changed {
+			unformatted = append(unformatted, name)
+		}
+
+		switch {
+		case cmd.flagCheck:
+			if changed {
+				cmd.UI.Output(name)
+			}
+		case cmd.flagWrite:
+			if !changed {
+				continue
+			}
+
+			err = output.WriteBytes(path, b, true)
+			if err != nil {
+				return nil, fmt.Errorf("error writing %s: %w", name, err)
+			}
+		default:
+			cmd.UI.Output(strings.TrimSuffix(string(b), "\n"))
+		}
+	}
+
+	return unformatted, nil
+}
+
+// format validates the specification, and returns its canonical formatting,
+// which is also validated, so that formatting never produces an invalid
+// specification.
+func (cmd *FmtCommand) format(ctx context.Context, src []byte) ([]byte, error) {
+	err := validate.JSON(src)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = spec.Parse(ctx, src)
+	if err != nil {
+		return nil, err
+	}
+
+	b, err := specfmt.Format(src, specfmt.Options{KeepOrder: cmd.flagKeepOrder})
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = spec.Parse(ctx, b)
+	if err != nil {
+		return nil, fmt.Errorf("formatted specification is invalid: %w", err)
+	}
+
+	return b, nil
+}
@@ -0,0 +1,147 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package cmd_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/cli"
+
+	"github.com/hashicorp/terraform-plugin-codegen-framework/internal/cmd"
+)
+
+func TestFmtCommand(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		args             []string
+		goldenFile       string
+		expectedOutput   string
+		expectedExitCode int
+		expectError      bool
+	}{
+		"stdout": {
+			args:       []string{"testdata/fmt/unformatted.json"},
+			goldenFile: "testdata/fmt/formatted.json",
+		},
+		"keep_order": {
+			args:       []string{"-keep-order", "testdata/fmt/unformatted.json"},
+			goldenFile: "testdata/fmt/formatted_keep_order.json",
+		},
+		"check_formatted": {
+			args: []string{"-check", "testdata/fmt/formatted.json"},
+		},
+		"check_keep_order": {
+			args: []string{"-check", "-keep-order", "testdata/fmt/formatted_keep_order.json"},
+		},
+		"check_unformatted": {
+			args:             []string{"-check", "testdata/fmt/formatted.json", "testdata/fmt/unformatted.json"},
+			expectedOutput:   "testdata/fmt/unformatted.json\n",
+			expectedExitCode: 1,
+		},
+		"check_and_write": {
+			args:        []string{"-check", "-w", "testdata/fmt/unformatted.json"},
+			expectError: true,
+		},
+		"write_stdin": {
+			args:        []string{"-w"},
+			expectError: true,
+		},
+		"invalid_specification": {
+			args:        []string{"testdata/validate/schema_error.json"},
+			expectError: true,
+		},
+		"yaml": {
+			args:        []string{"testdata/lint/spec.yaml"},
+			expectError: true,
+		},
+	}
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			mockUi := cli.NewMockUi()
+			c := cmd.FmtCommand{
+				UI: mockUi,
+			}
+
+			exitCode := c.Run(testCase.args)
+
+			if testCase.expectError {
+				if mockUi.ErrorWriter.String() == "" {
+					t.Fatal("expected error running `fmt` cmd")
+				}
+
+				return
+			}
+
+			if exitCode != testCase.expectedExitCode {
+				t.Fatalf("expected exit code %d, got %d: %s", testCase.expectedExitCode, exitCode, mockUi.ErrorWriter.String())
+			}
+
+			expected := testCase.expectedOutput
+
+			if testCase.goldenFile != "" {
+				b, err := os.ReadFile(testCase.goldenFile)
+				if err != nil {
+					t.Fatalf("unexpected error reading golden file: %s", err)
+				}
+
+				expected = string(b)
+			}
+
+			if diff := cmp.Diff(mockUi.OutputWriter.String(), expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestFmtCommand_Write(t *testing.T) {
+	t.Parallel()
+
+	src, err := os.ReadFile("testdata/fmt/unformatted.json")
+	if err != nil {
+		t.Fatalf("unexpected error reading input: %s", err)
+	}
+
+	path := filepath.Join(t.TempDir(), "spec.json")
+
+	err = os.WriteFile(path, src, 0644)
+	if err != nil {
+		t.Fatalf("unexpected error writing input: %s", err)
+	}
+
+	mockUi := cli.NewMockUi()
+	c := cmd.FmtCommand{
+		UI: mockUi,
+	}
+
+	exitCode := c.Run([]string{"-w", path})
+	if exitCode != 0 {
+		t.Fatalf("expected exit code 0, got %d: %s", exitCode, mockUi.ErrorWriter.String())
+	}
+
+	if mockUi.OutputWriter.String() != "" {
+		t.Errorf("unexpected output: %s", mockUi.OutputWriter.String())
+	}
+
+	got, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("unexpected error reading output: %s", err)
+	}
+
+	expected, err := os.ReadFile("testdata/fmt/formatted.json")
+	if err != nil {
+		t.Fatalf("unexpected error reading golden file: %s", err)
+	}
+
+	if diff := cmp.Diff(string(got), string(expected)); diff != "" {
+		t.Errorf("unexpected difference: %s", diff)
+	}
+}
@@ -4,9 +4,15 @@
 package cmd
 
 import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
 	"strings"
 
 	"github.com/hashicorp/cli"
+
+	"github.com/hashicorp/terraform-plugin-codegen-framework/internal/config"
 )
 
 type GenerateCommand struct {
@@ -30,3 +36,49 @@ func (a *GenerateCommand) Synopsis() string {
 func (cmd *GenerateCommand) Run(args []string) int {
 	return cli.RunResultHelp
 }
+
+// readConfig returns the contents of the generator configuration file at the
+// supplied path. An empty path returns no configuration.
+func readConfig(path string) ([]byte, error) {
+	if path == "" {
+		return nil, nil
+	}
+
+	return os.ReadFile(path)
+}
+
+// parseConfig reads and parses the generator configuration file at the supplied
+// path, and reads the prior resource schema versions it declares, for commands
+// which convert the specification to framework schemas without generating code.
+// An empty path returns an empty configuration.
+func parseConfig(ctx context.Context, path string) (config.Config, error) {
+	document, err := readConfig(path)
+	if err != nil {
+		return config.Config{}, fmt.Errorf("error reading generator configuration: %w", err)
+	}
+
+	cfg, err := config.Parse(document)
+	if err != nil {
+		return config.Config{}, fmt.Errorf("error parsing generator configuration: %w", err)
+	}
+
+	err = cfg.ReadPriorVersions(ctx, filepath.Dir(path))
+	if err != nil {
+		return config.Config{}, fmt.Errorf("error reading prior resource schema versions: %w", err)
+	}
+
+	return cfg, nil
+}
+
+// stringsFlag is a flag which can be set more than once, collecting each value.
+type stringsFlag []string
+
+func (f *stringsFlag) String() string {
+	return strings.Join(*f, ",")
+}
+
+func (f *stringsFlag) Set(value string) error {
+	*f = append(*f, value)
+
+	return nil
+}
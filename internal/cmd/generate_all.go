@@ -9,27 +9,38 @@ import (
 	"fmt"
 	"log/slog"
 	"os"
+	"path/filepath"
 	"strings"
 
 	"github.com/hashicorp/cli"
 	"github.com/hashicorp/terraform-plugin-codegen-spec/spec"
 
 	"github.com/hashicorp/terraform-plugin-codegen-framework/internal/input"
+	"github.com/hashicorp/terraform-plugin-codegen-framework/internal/output"
 	"github.com/hashicorp/terraform-plugin-codegen-framework/internal/validate"
+	"github.com/hashicorp/terraform-plugin-codegen-framework/pkg/generator"
 )
 
 type GenerateAllCommand struct {
 	UI              cli.Ui
 	flagIRInputPath string
+	flagInputFormat string
+	flagConfigPath  string
 	flagOutputPath  string
 	flagPackageName string
+	flagPlugins     stringsFlag
+	flagToFromTests bool
 }
 
 func (cmd *GenerateAllCommand) Flags() *flag.FlagSet {
 	fs := flag.NewFlagSet("generate all", flag.ExitOnError)
-	fs.StringVar(&cmd.flagIRInputPath, "input", "", "path to intermediate representation (JSON)")
+	fs.StringVar(&cmd.flagIRInputPath, "input", "", "path to intermediate representation (JSON or YAML)")
+	fs.StringVar(&cmd.flagInputFormat, "input-format", "", "format of intermediate representation, json or yaml, defaults to yaml for .yaml and .yml files and json otherwise")
+	fs.StringVar(&cmd.flagConfigPath, "config", "", "path to generator configuration (JSON)")
 	fs.StringVar(&cmd.flagOutputPath, "output", "./output", "directory path to output generated code files")
 	fs.StringVar(&cmd.flagPackageName, "package", "", "name of Go package for generated code files")
+	fs.Var(&cmd.flagPlugins, "plugin", "name or path of external generator plugin executable (repeatable)")
+	fs.BoolVar(&cmd.flagToFromTests, "to-from-tests", false, "generate unit tests for associated external type to/from functions")
 
 	return fs
 }
@@ -101,36 +112,41 @@ func (cmd *GenerateAllCommand) Run(args []string) int {
 
 func (cmd *GenerateAllCommand) runInternal(ctx context.Context, logger *slog.Logger) error {
 	// read input file
-	src, err := input.Read(cmd.flagIRInputPath)
+	ir, err := input.ReadSpecification(cmd.flagIRInputPath, cmd.flagInputFormat)
 	if err != nil {
 		return fmt.Errorf("error reading IR JSON: %w", err)
 	}
 
 	// validate JSON
-	err = validate.JSON(src)
+	err = validate.JSON(ir.JSON)
 	if err != nil {
 		return fmt.Errorf("error validating IR JSON: %w", err)
 	}
 
 	// parse and validate IR against specification
-	spec, err := spec.Parse(ctx, src)
+	spec, err := spec.Parse(ctx, ir.JSON)
 	if err != nil {
-		return fmt.Errorf("error parsing IR JSON: %w", err)
+		return fmt.Errorf("error parsing IR JSON: %w", ir.Error(err))
 	}
 
-	err = generateDataSourceCode(ctx, spec, cmd.flagOutputPath, cmd.flagPackageName, "DataSource", logger)
+	// read generator configuration
+	cfg, err := readConfig(cmd.flagConfigPath)
 	if err != nil {
-		return fmt.Errorf("error generating data source code: %w", err)
+		return fmt.Errorf("error reading generator configuration: %w", err)
 	}
 
-	err = generateResourceCode(ctx, spec, cmd.flagOutputPath, cmd.flagPackageName, "Resource", logger)
-	if err != nil {
-		return fmt.Errorf("error generating resource code: %w", err)
-	}
-
-	err = generateProviderCode(ctx, spec, cmd.flagOutputPath, cmd.flagPackageName, "Provider", logger)
+	// generate and write code
+	_, err = generator.Generate(ctx, spec, generator.Options{
+		PackageName: cmd.flagPackageName,
+		Config:      cfg,
+		ConfigDir:   filepath.Dir(cmd.flagConfigPath),
+		Logger:      logger,
+		Plugins:     cmd.flagPlugins,
+		ToFromTests: cmd.flagToFromTests,
+		WriteFile:   output.FileWriter(cmd.flagOutputPath),
+	})
 	if err != nil {
-		return fmt.Errorf("error generating provider code: %w", err)
+		return err
 	}
 
 	return nil
@@ -18,11 +18,19 @@ func TestGenerateAllCommand(t *testing.T) {
 		irInputPath   string
 		pkgName       string
 		goldenFileDir string
+		expectError   bool
 	}{
 		"specified_pkg_name": {
-			irInputPath:   "testdata/custom_and_external/ir.json",
+			irInputPath:   "testdata/specified_pkg_name/ir.json",
 			pkgName:       "specified",
-			goldenFileDir: "testdata/custom_and_external/all_output/specified_pkg_name",
+			goldenFileDir: "testdata/specified_pkg_name/all_output",
+		},
+		// Data sources, resources and the provider all declare ExampleModel,
+		// amongst other colliding types, in the same package.
+		"specified_pkg_name_collisions": {
+			irInputPath: "testdata/custom_and_external/ir.json",
+			pkgName:     "specified",
+			expectError: true,
 		},
 		"default_pkg_name": {
 			irInputPath:   "testdata/custom_and_external/ir.json",
@@ -47,6 +55,14 @@ func TestGenerateAllCommand(t *testing.T) {
 			}
 
 			exitCode := c.Run(args)
+			if testCase.expectError {
+				if exitCode == 0 {
+					t.Fatal("expected error running `generate all` cmd")
+				}
+
+				return
+			}
+
 			if exitCode != 0 {
 				t.Fatalf("unexpected error running `generate all` cmd: %s", mockUi.ErrorWriter.String())
 			}
@@ -55,3 +71,31 @@ func TestGenerateAllCommand(t *testing.T) {
 		})
 	}
 }
+
+// TestGenerateCommands_SpecifiedPkgName generates the data sources, resources and
+// provider into the same specified package one command at a time, which does not
+// check for collisions, so the output matches that of `generate all` before
+// colliding types were rejected.
+func TestGenerateCommands_SpecifiedPkgName(t *testing.T) {
+	t.Parallel()
+
+	testOutputDir := t.TempDir()
+	mockUi := cli.NewMockUi()
+
+	for name, c := range map[string]cli.Command{
+		"data-sources": &cmd.GenerateDataSourcesCommand{UI: mockUi},
+		"provider":     &cmd.GenerateProviderCommand{UI: mockUi},
+		"resources":    &cmd.GenerateResourcesCommand{UI: mockUi},
+	} {
+		exitCode := c.Run([]string{
+			"--input", "testdata/custom_and_external/ir.json",
+			"--package", "specified",
+			"--output", testOutputDir,
+		})
+		if exitCode != 0 {
+			t.Fatalf("unexpected error running `generate %s` cmd: %s", name, mockUi.ErrorWriter.String())
+		}
+	}
+
+	compareDirectories(t, "testdata/custom_and_external/all_output/specified_pkg_name", testOutputDir)
+}
@@ -7,7 +7,6 @@ import (
 	"context"
 	"flag"
 	"fmt"
-	"log"
 	"log/slog"
 	"os"
 	"strings"
@@ -15,27 +14,32 @@ import (
 	"github.com/hashicorp/cli"
 	"github.com/hashicorp/terraform-plugin-codegen-spec/spec"
 
-	"github.com/hashicorp/terraform-plugin-codegen-framework/internal/datasource"
-	"github.com/hashicorp/terraform-plugin-codegen-framework/internal/format"
 	"github.com/hashicorp/terraform-plugin-codegen-framework/internal/input"
-	"github.com/hashicorp/terraform-plugin-codegen-framework/internal/logging"
 	"github.com/hashicorp/terraform-plugin-codegen-framework/internal/output"
-	"github.com/hashicorp/terraform-plugin-codegen-framework/internal/schema"
 	"github.com/hashicorp/terraform-plugin-codegen-framework/internal/validate"
+	"github.com/hashicorp/terraform-plugin-codegen-framework/pkg/generator"
 )
 
 type GenerateDataSourcesCommand struct {
 	UI              cli.Ui
 	flagIRInputPath string
+	flagInputFormat string
+	flagConfigPath  string
 	flagOutputPath  string
 	flagPackageName string
+	flagPlugins     stringsFlag
+	flagToFromTests bool
 }
 
 func (cmd *GenerateDataSourcesCommand) Flags() *flag.FlagSet {
 	fs := flag.NewFlagSet("generate data-sources", flag.ExitOnError)
-	fs.StringVar(&cmd.flagIRInputPath, "input", "./ir.json", "path to intermediate representation (JSON)")
+	fs.StringVar(&cmd.flagIRInputPath, "input", "./ir.json", "path to intermediate representation (JSON or YAML)")
+	fs.StringVar(&cmd.flagInputFormat, "input-format", "", "format of intermediate representation, json or yaml, defaults to yaml for .yaml and .yml files and json otherwise")
+	fs.StringVar(&cmd.flagConfigPath, "config", "", "path to generator configuration (JSON)")
 	fs.StringVar(&cmd.flagOutputPath, "output", "./output", "directory path to output generated code files")
 	fs.StringVar(&cmd.flagPackageName, "package", "", "name of Go package for generated code files")
+	fs.Var(&cmd.flagPlugins, "plugin", "name or path of external generator plugin executable (repeatable)")
+	fs.BoolVar(&cmd.flagToFromTests, "to-from-tests", false, "generate unit tests for associated external type to/from functions")
 
 	return fs
 }
@@ -107,93 +111,40 @@ func (cmd *GenerateDataSourcesCommand) Run(args []string) int {
 
 func (cmd *GenerateDataSourcesCommand) runInternal(ctx context.Context, logger *slog.Logger) error {
 	// read input file
-	src, err := input.Read(cmd.flagIRInputPath)
+	ir, err := input.ReadSpecification(cmd.flagIRInputPath, cmd.flagInputFormat)
 	if err != nil {
 		return fmt.Errorf("error reading IR JSON: %w", err)
 	}
 
 	// validate JSON
-	err = validate.JSON(src)
+	err = validate.JSON(ir.JSON)
 	if err != nil {
 		return fmt.Errorf("error validating IR JSON: %w", err)
 	}
 
 	// parse and validate IR against specification
-	spec, err := spec.Parse(ctx, src)
+	spec, err := spec.Parse(ctx, ir.JSON)
 	if err != nil {
-		return fmt.Errorf("error parsing IR JSON: %w", err)
+		return fmt.Errorf("error parsing IR JSON: %w", ir.Error(err))
 	}
 
-	err = generateDataSourceCode(ctx, spec, cmd.flagOutputPath, cmd.flagPackageName, "DataSource", logger)
+	// read generator configuration
+	cfg, err := readConfig(cmd.flagConfigPath)
 	if err != nil {
-		return fmt.Errorf("error generating data source code: %w", err)
+		return fmt.Errorf("error reading generator configuration: %w", err)
 	}
 
-	return nil
-}
-
-func generateDataSourceCode(ctx context.Context, spec spec.Specification, outputPath, packageName, generatorType string, logger *slog.Logger) error {
-	ctxWithPath := logging.SetPathInContext(ctx, "data_source")
-
-	// convert IR to framework schema
-	s, err := datasource.NewSchemas(spec)
-	if err != nil {
-		return fmt.Errorf("error converting IR to Plugin Framework schema: %w", err)
-	}
-
-	// convert framework schema to []byte
-	g := schema.NewGeneratorSchemas(s)
-	schemas, err := g.Schemas(packageName, generatorType)
-	if err != nil {
-		return fmt.Errorf("error converting Plugin Framework schema to Go code: %w", err)
-	}
-
-	// generate model code
-	models, err := g.Models()
-	if err != nil {
-		log.Fatal(err)
-	}
-
-	// generate custom type and value types code
-	customTypeValue, err := g.CustomTypeValue()
-	if err != nil {
-		log.Fatal(err)
-	}
-
-	// generate "expand" and "flatten" code
-	toFromFunctions, err := g.ToFromFunctions(ctxWithPath, logger)
-	if err != nil {
-		log.Fatal(err)
-	}
-
-	// format schema code
-	formattedSchemas, err := format.Format(schemas)
-	if err != nil {
-		return fmt.Errorf("error formatting Go code: %w", err)
-	}
-
-	// format model code
-	formattedModels, err := format.Format(models)
-	if err != nil {
-		log.Fatal(err)
-	}
-
-	// format custom type and value types code
-	formattedCustomTypeValue, err := format.Format(customTypeValue)
-	if err != nil {
-		log.Fatal(err)
-	}
-
-	// format "expand" and "flatten" code
-	formattedToFromFunctions, err := format.Format(toFromFunctions)
-	if err != nil {
-		log.Fatal(err)
-	}
-
-	// write code
-	err = output.WriteDataSources(formattedSchemas, formattedModels, formattedCustomTypeValue, formattedToFromFunctions, outputPath, packageName)
+	// generate and write code
+	_, err = generator.GenerateDataSources(ctx, spec, generator.Options{
+		PackageName: cmd.flagPackageName,
+		Config:      cfg,
+		Logger:      logger,
+		Plugins:     cmd.flagPlugins,
+		ToFromTests: cmd.flagToFromTests,
+		WriteFile:   output.FileWriter(cmd.flagOutputPath),
+	})
 	if err != nil {
-		return fmt.Errorf("error writing Go code to output: %w", err)
+		return err
 	}
 
 	return nil
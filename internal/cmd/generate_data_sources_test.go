@@ -15,12 +15,18 @@ func TestGenerateDataSourcesCommand(t *testing.T) {
 
 	testCases := map[string]struct {
 		irInputPath   string
+		configPath    string
 		goldenFileDir string
 	}{
 		"custom_and_external": {
 			irInputPath:   "testdata/custom_and_external/ir.json",
 			goldenFileDir: "testdata/custom_and_external/data_sources_output",
 		},
+		"model_assoc_ext_type": {
+			irInputPath:   "testdata/model_assoc_ext_type/ir.json",
+			configPath:    "testdata/model_assoc_ext_type/config.json",
+			goldenFileDir: "testdata/model_assoc_ext_type/data_sources_output",
+		},
 	}
 	for name, testCase := range testCases {
 
@@ -35,6 +41,7 @@ func TestGenerateDataSourcesCommand(t *testing.T) {
 
 			args := []string{
 				"--input", testCase.irInputPath,
+				"--config", testCase.configPath,
 				"--package", "generated",
 				"--output", testOutputDir,
 			}
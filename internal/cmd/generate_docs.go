@@ -0,0 +1,204 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package cmd
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"io/fs"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/cli"
+	"github.com/hashicorp/terraform-plugin-codegen-spec/spec"
+
+	"github.com/hashicorp/terraform-plugin-codegen-framework/internal/docs"
+	"github.com/hashicorp/terraform-plugin-codegen-framework/internal/input"
+	"github.com/hashicorp/terraform-plugin-codegen-framework/internal/output"
+	"github.com/hashicorp/terraform-plugin-codegen-framework/internal/validate"
+)
+
+type GenerateDocsCommand struct {
+	UI                cli.Ui
+	flagIRInputPath   string
+	flagInputFormat   string
+	flagConfigPath    string
+	flagOutputPath    string
+	flagTemplatesPath string
+}
+
+func (cmd *GenerateDocsCommand) Flags() *flag.FlagSet {
+	fs := flag.NewFlagSet("generate docs", flag.ExitOnError)
+	fs.StringVar(&cmd.flagIRInputPath, "input", "", "path to intermediate representation (JSON or YAML)")
+	fs.StringVar(&cmd.flagInputFormat, "input-format", "", "format of intermediate representation, json or yaml, defaults to yaml for .yaml and .yml files and json otherwise")
+	fs.StringVar(&cmd.flagConfigPath, "config", "", "path to generator configuration (JSON)")
+	fs.StringVar(&cmd.flagOutputPath, "output", "./docs", "directory path to output generated documentation files")
+	fs.StringVar(&cmd.flagTemplatesPath, "templates", "", "directory path to page templates (*.md.tmpl)")
+
+	return fs
+}
+
+func (cmd *GenerateDocsCommand) Help() string {
+	strBuilder := &strings.Builder{}
+
+	longestName := 0
+	longestUsage := 0
+	cmd.Flags().VisitAll(func(f *flag.Flag) {
+		if len(f.Name) > longestName {
+			longestName = len(f.Name)
+		}
+		if len(f.Usage) > longestUsage {
+			longestUsage = len(f.Usage)
+		}
+	})
+
+	strBuilder.WriteString("\nUsage: tfplugingen-framework generate docs [<args>]\n\n")
+	cmd.Flags().VisitAll(func(f *flag.Flag) {
+		if f.DefValue != "" {
+			strBuilder.WriteString(fmt.Sprintf("    --%s <ARG> %s%s%s  (default: %q)\n",
+				f.Name,
+				strings.Repeat(" ", longestName-len(f.Name)+2),
+				f.Usage,
+				strings.Repeat(" ", longestUsage-len(f.Usage)+2),
+				f.DefValue,
+			))
+		} else {
+			strBuilder.WriteString(fmt.Sprintf("    --%s <ARG> %s%s%s\n",
+				f.Name,
+				strings.Repeat(" ", longestName-len(f.Name)+2),
+				f.Usage,
+				strings.Repeat(" ", longestUsage-len(f.Usage)+2),
+			))
+		}
+	})
+	strBuilder.WriteString("\n")
+
+	return strBuilder.String()
+}
+
+func (cmd *GenerateDocsCommand) Synopsis() string {
+	return "Generate markdown documentation for provider, resources, and data sources from an Intermediate Representation (IR) JSON file."
+}
+
+func (cmd *GenerateDocsCommand) Run(args []string) int {
+	ctx := context.Background()
+
+	logger := slog.New(slog.NewTextHandler(os.Stdout, &slog.HandlerOptions{
+		Level: slog.LevelWarn,
+	}))
+
+	fs := cmd.Flags()
+	err := fs.Parse(args)
+	if err != nil {
+		logger.Error("error parsing command flags", "err", err)
+		return 1
+	}
+
+	err = cmd.runInternal(ctx)
+	if err != nil {
+		logger.Error("error executing command", "err", err)
+		return 1
+	}
+
+	return 0
+}
+
+func (cmd *GenerateDocsCommand) runInternal(ctx context.Context) error {
+	// read input file
+	ir, err := input.ReadSpecification(cmd.flagIRInputPath, cmd.flagInputFormat)
+	if err != nil {
+		return fmt.Errorf("error reading IR JSON: %w", err)
+	}
+
+	// validate JSON
+	err = validate.JSON(ir.JSON)
+	if err != nil {
+		return fmt.Errorf("error validating IR JSON: %w", err)
+	}
+
+	// parse and validate IR against specification
+	spec, err := spec.Parse(ctx, ir.JSON)
+	if err != nil {
+		return fmt.Errorf("error parsing IR JSON: %w", ir.Error(err))
+	}
+
+	// read generator configuration
+	cfg, err := parseConfig(ctx, cmd.flagConfigPath)
+	if err != nil {
+		return err
+	}
+
+	// read page templates
+	templates, err := readDocsTemplates(cmd.flagTemplatesPath)
+	if err != nil {
+		return fmt.Errorf("error reading page templates: %w", err)
+	}
+
+	// generate documentation
+	files, err := docs.Generate(spec, cfg, templates)
+	if err != nil {
+		return fmt.Errorf("error generating documentation: %w", err)
+	}
+
+	// write documentation
+	writeFile := output.FileWriter(cmd.flagOutputPath)
+
+	for _, k := range sortedKeys(files) {
+		err = writeFile(k, files[k])
+		if err != nil {
+			return fmt.Errorf("error writing documentation to output: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// readDocsTemplates returns the contents of the page templates in the directory,
+// keyed on slash-separated path relative to the directory. An empty path returns
+// no templates.
+func readDocsTemplates(dir string) (map[string]string, error) {
+	templates := make(map[string]string)
+
+	if dir == "" {
+		return templates, nil
+	}
+
+	err := filepath.WalkDir(dir, func(p string, d fs.DirEntry, err error) error {
+		if err != nil || d.IsDir() || !strings.HasSuffix(p, docs.TemplateExtension) {
+			return err
+		}
+
+		rel, err := filepath.Rel(dir, p)
+		if err != nil {
+			return err
+		}
+
+		b, err := os.ReadFile(p)
+		if err != nil {
+			return err
+		}
+
+		templates[filepath.ToSlash(rel)] = string(b)
+
+		return nil
+	})
+
+	return templates, err
+}
+
+func sortedKeys[T any](m map[string]T) []string {
+	keys := make([]string, 0, len(m))
+
+	for k := range m {
+		keys = append(keys, k)
+	}
+
+	sort.Strings(keys)
+
+	return keys
+}
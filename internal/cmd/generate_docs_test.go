@@ -0,0 +1,72 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package cmd_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/cli"
+
+	"github.com/hashicorp/terraform-plugin-codegen-framework/internal/cmd"
+)
+
+func TestGenerateDocsCommand(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		irInputPath   string
+		templatesPath string
+		goldenFileDir string
+		expectError   bool
+	}{
+		"default_templates": {
+			irInputPath:   "testdata/docs/ir.json",
+			goldenFileDir: "testdata/docs/docs_output/default_templates",
+		},
+		"custom_templates": {
+			irInputPath:   "testdata/docs/ir.json",
+			templatesPath: "testdata/docs/templates",
+			goldenFileDir: "testdata/docs/docs_output/custom_templates",
+		},
+		// Templates which do not match any page are most likely misnamed.
+		"unmatched_templates": {
+			irInputPath:   "testdata/custom_and_external/ir.json",
+			templatesPath: "testdata/docs/templates",
+			expectError:   true,
+		},
+	}
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			testOutputDir := t.TempDir()
+			mockUi := cli.NewMockUi()
+			c := cmd.GenerateDocsCommand{
+				UI: mockUi,
+			}
+
+			args := []string{
+				"--input", testCase.irInputPath,
+				"--templates", testCase.templatesPath,
+				"--output", testOutputDir,
+			}
+
+			exitCode := c.Run(args)
+			if testCase.expectError {
+				if exitCode == 0 {
+					t.Fatal("expected error running `generate docs` cmd")
+				}
+
+				return
+			}
+
+			if exitCode != 0 {
+				t.Fatalf("unexpected error running `generate docs` cmd: %s", mockUi.ErrorWriter.String())
+			}
+
+			compareDirectories(t, testCase.goldenFileDir, testOutputDir)
+		})
+	}
+}
@@ -0,0 +1,63 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package cmd_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/cli"
+
+	"github.com/hashicorp/terraform-plugin-codegen-framework/internal/cmd"
+)
+
+func TestGenerateExamplesCommand(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		irInputPath   string
+		goldenFileDir string
+		expectError   bool
+	}{
+		"examples": {
+			irInputPath:   "testdata/examples/ir.json",
+			goldenFileDir: "testdata/examples/examples_output",
+		},
+		"invalid_ir": {
+			irInputPath: "testdata/examples/missing.json",
+			expectError: true,
+		},
+	}
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			testOutputDir := t.TempDir()
+			mockUi := cli.NewMockUi()
+			c := cmd.GenerateExamplesCommand{
+				UI: mockUi,
+			}
+
+			args := []string{
+				"--input", testCase.irInputPath,
+				"--output", testOutputDir,
+			}
+
+			exitCode := c.Run(args)
+			if testCase.expectError {
+				if exitCode == 0 {
+					t.Fatal("expected error running `generate examples` cmd")
+				}
+
+				return
+			}
+
+			if exitCode != 0 {
+				t.Fatalf("unexpected error running `generate examples` cmd: %s", mockUi.ErrorWriter.String())
+			}
+
+			compareDirectories(t, testCase.goldenFileDir, testOutputDir)
+		})
+	}
+}
@@ -4,6 +4,7 @@
 package cmd_test
 
 import (
+	"path/filepath"
 	"testing"
 
 	"github.com/hashicorp/cli"
@@ -15,12 +16,45 @@ func TestGenerateResourcesCommand(t *testing.T) {
 
 	testCases := map[string]struct {
 		irInputPath   string
+		configPath    string
+		toFromTests   bool
 		goldenFileDir string
 	}{
 		"custom_and_external": {
 			irInputPath:   "testdata/custom_and_external/ir.json",
 			goldenFileDir: "testdata/custom_and_external/resources_output",
 		},
+		"model_assoc_ext_type": {
+			irInputPath:   "testdata/model_assoc_ext_type/ir.json",
+			configPath:    "testdata/model_assoc_ext_type/config.json",
+			goldenFileDir: "testdata/model_assoc_ext_type/resources_output",
+		},
+		"model_assoc_ext_type_to_from_tests": {
+			irInputPath:   "testdata/model_assoc_ext_type/ir.json",
+			configPath:    "testdata/model_assoc_ext_type/config.json",
+			toFromTests:   true,
+			goldenFileDir: "testdata/model_assoc_ext_type/resources_to_from_tests_output",
+		},
+		"model_assoc_ext_type_crud": {
+			irInputPath:   "testdata/model_assoc_ext_type/ir.json",
+			configPath:    "testdata/model_assoc_ext_type/crud_config.json",
+			goldenFileDir: "testdata/model_assoc_ext_type/resources_crud_output",
+		},
+		"model_assoc_ext_type_crud_input_output": {
+			irInputPath:   "testdata/model_assoc_ext_type/ir.json",
+			configPath:    "testdata/model_assoc_ext_type/crud_input_output_config.json",
+			goldenFileDir: "testdata/model_assoc_ext_type/resources_crud_input_output",
+		},
+		"initialisms": {
+			irInputPath:   "testdata/initialisms/ir.json",
+			configPath:    "testdata/initialisms/config.json",
+			goldenFileDir: "testdata/initialisms/resources_output",
+		},
+		"state_upgrade": {
+			irInputPath:   "testdata/state_upgrade/ir.json",
+			configPath:    "testdata/state_upgrade/config.json",
+			goldenFileDir: "testdata/state_upgrade/resources_output",
+		},
 	}
 	for name, testCase := range testCases {
 
@@ -35,10 +69,143 @@ func TestGenerateResourcesCommand(t *testing.T) {
 
 			args := []string{
 				"--input", testCase.irInputPath,
+				"--config", testCase.configPath,
+				"--package", "generated",
+				"--output", testOutputDir,
+			}
+
+			if testCase.toFromTests {
+				args = append(args, "--to-from-tests")
+			}
+
+			exitCode := c.Run(args)
+			if exitCode != 0 {
+				t.Fatalf("unexpected error running `generate resources` cmd: %s", mockUi.ErrorWriter.String())
+			}
+
+			compareDirectories(t, testCase.goldenFileDir, testOutputDir)
+		})
+	}
+}
+
+// TestGenerateResourcesCommand_CRUD compiles the CRUD methods generated for a
+// resource with an optional and computed object attribute, and runs Create with
+// the object null and unknown in the plan.
+func TestGenerateResourcesCommand_CRUD(t *testing.T) {
+	t.Parallel()
+
+	moduleDir := t.TempDir()
+	outputDir := filepath.Join(moduleDir, "generated")
+	mockUi := cli.NewMockUi()
+	c := cmd.GenerateResourcesCommand{
+		UI: mockUi,
+	}
+
+	args := []string{
+		"--input", "testdata/crud/ir.json",
+		"--config", "testdata/crud/config.json",
+		"--package", "generated",
+		"--output", outputDir,
+	}
+
+	exitCode := c.Run(args)
+	if exitCode != 0 {
+		t.Fatalf("unexpected error running `generate resources` cmd: %s", mockUi.ErrorWriter.String())
+	}
+
+	compareDirectories(t, "testdata/crud/resources_output", outputDir)
+
+	copyFile(t, "testdata/crud/example_crud_test.go", outputDir)
+	copyFile(t, "testdata/crud/apisdk/apisdk.go", filepath.Join(moduleDir, "apisdk"))
+
+	runGoTest(t, moduleDir)
+}
+
+func TestGenerateResourcesCommand_NestedTypeNames(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		configPath    string
+		goldenFileDir string
+		expectError   bool
+	}{
+		// network.config, storage.config, and logging.config all declare
+		// ConfigType and ConfigValue.
+		"attribute": {
+			expectError: true,
+		},
+		"hierarchical": {
+			configPath:    "testdata/hierarchical_type_names/config.json",
+			goldenFileDir: "testdata/hierarchical_type_names/resources_output",
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			testOutputDir := t.TempDir()
+			mockUi := cli.NewMockUi()
+			c := cmd.GenerateResourcesCommand{
+				UI: mockUi,
+			}
+
+			args := []string{
+				"--input", "testdata/hierarchical_type_names/ir.json",
+				"--config", testCase.configPath,
 				"--package", "generated",
 				"--output", testOutputDir,
 			}
 
+			exitCode := c.Run(args)
+			if testCase.expectError {
+				if exitCode == 0 {
+					t.Fatal("expected error running `generate resources` cmd")
+				}
+
+				return
+			}
+
+			if exitCode != 0 {
+				t.Fatalf("unexpected error running `generate resources` cmd: %s", mockUi.ErrorWriter.String())
+			}
+
+			compareDirectories(t, testCase.goldenFileDir, testOutputDir)
+		})
+	}
+}
+
+func TestGenerateResourcesCommand_SharedTypes(t *testing.T) {
+	testCases := map[string]struct {
+		pkgName       string
+		goldenFileDir string
+	}{
+		"default_pkg_name": {
+			goldenFileDir: "testdata/shared_types/resources_output/default_pkg_name",
+		},
+		"specified_pkg_name": {
+			pkgName:       "generated",
+			goldenFileDir: "testdata/shared_types/resources_output/specified_pkg_name",
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			testOutputDir := t.TempDir()
+			mockUi := cli.NewMockUi()
+			c := cmd.GenerateResourcesCommand{
+				UI: mockUi,
+			}
+
+			args := []string{
+				"--input", "testdata/shared_types/ir.json",
+				"--config", "testdata/shared_types/config.json",
+				"--package", testCase.pkgName,
+				"--output", testOutputDir,
+			}
+
 			exitCode := c.Run(args)
 			if exitCode != 0 {
 				t.Fatalf("unexpected error running `generate resources` cmd: %s", mockUi.ErrorWriter.String())
@@ -4,8 +4,11 @@
 package cmd_test
 
 import (
+	"fmt"
 	"os"
+	"os/exec"
 	"path"
+	"path/filepath"
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
@@ -88,3 +91,67 @@ func compareFiles(t *testing.T, got, want string) {
 		t.Errorf("unexpected difference in %s: %s", got, diff)
 	}
 }
+
+// frameworkVersion is the version of terraform-plugin-framework which generated
+// code is compiled against by runGoTest.
+const frameworkVersion = "v1.19.0"
+
+// runGoTest runs the tests of the packages in dir, as the example.com module
+// requiring terraform-plugin-framework, so that generated code is compiled and
+// run. The test is skipped in short mode, or if the go command or the module
+// dependencies are unavailable, such as when offline.
+func runGoTest(t *testing.T, dir string) {
+	t.Helper()
+
+	if testing.Short() {
+		t.Skip("skipping compilation of generated code in short mode")
+	}
+
+	goCmd, err := exec.LookPath("go")
+	if err != nil {
+		t.Skipf("go command not found: %s", err)
+	}
+
+	goMod := fmt.Sprintf("module example.com\n\ngo 1.22.7\n\nrequire github.com/hashicorp/terraform-plugin-framework %s\n", frameworkVersion)
+
+	err = os.WriteFile(filepath.Join(dir, "go.mod"), []byte(goMod), 0644)
+	if err != nil {
+		t.Fatalf("unexpected error writing go.mod: %s", err)
+	}
+
+	run := func(args ...string) ([]byte, error) {
+		cmd := exec.Command(goCmd, args...)
+		cmd.Dir = dir
+		cmd.Env = append(os.Environ(), "GOFLAGS=-mod=mod")
+
+		return cmd.CombinedOutput()
+	}
+
+	if out, err := run("mod", "tidy"); err != nil {
+		t.Skipf("module dependencies unavailable: %s", out)
+	}
+
+	if out, err := run("test", "./..."); err != nil {
+		t.Fatalf("unexpected error running tests of generated code: %s\n%s", err, out)
+	}
+}
+
+// copyFile copies the file to the directory, which is created if necessary.
+func copyFile(t *testing.T, src, dir string) {
+	t.Helper()
+
+	b, err := os.ReadFile(src)
+	if err != nil {
+		t.Fatalf("unexpected error reading %s: %s", src, err)
+	}
+
+	err = os.MkdirAll(dir, 0755)
+	if err != nil {
+		t.Fatalf("unexpected error creating %s: %s", dir, err)
+	}
+
+	err = os.WriteFile(filepath.Join(dir, filepath.Base(src)), b, 0644)
+	if err != nil {
+		t.Fatalf("unexpected error writing %s: %s", src, err)
+	}
+}
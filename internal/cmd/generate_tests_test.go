@@ -0,0 +1,97 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package cmd_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/hashicorp/cli"
+
+	"github.com/hashicorp/terraform-plugin-codegen-framework/internal/cmd"
+)
+
+func TestGenerateTestsCommand(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		irInputPath   string
+		configPath    string
+		existingFile  string
+		force         bool
+		goldenFileDir string
+		expectError   bool
+	}{
+		"tests": {
+			irInputPath:   "testdata/examples/ir.json",
+			goldenFileDir: "testdata/acctest/acctest_output",
+		},
+		"naming": {
+			irInputPath:   "testdata/acctest/naming/spec.json",
+			configPath:    "testdata/acctest/naming/config.json",
+			goldenFileDir: "testdata/acctest/naming/acctest_output",
+		},
+		// Generated tests are expected to be edited, so are only overwritten
+		// with --force.
+		"existing_file": {
+			irInputPath:  "testdata/examples/ir.json",
+			existingFile: "instance_resource_test.go",
+			expectError:  true,
+		},
+		"existing_file_force": {
+			irInputPath:   "testdata/examples/ir.json",
+			existingFile:  "instance_resource_test.go",
+			force:         true,
+			goldenFileDir: "testdata/acctest/acctest_output",
+		},
+	}
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			testOutputDir := t.TempDir()
+			mockUi := cli.NewMockUi()
+			c := cmd.GenerateTestsCommand{
+				UI: mockUi,
+			}
+
+			if testCase.existingFile != "" {
+				err := os.WriteFile(filepath.Join(testOutputDir, testCase.existingFile), nil, 0666)
+				if err != nil {
+					t.Fatalf("unexpected error: %s", err)
+				}
+			}
+
+			args := []string{
+				"--input", testCase.irInputPath,
+				"--output", testOutputDir,
+			}
+
+			if testCase.configPath != "" {
+				args = append(args, "--config", testCase.configPath)
+			}
+
+			if testCase.force {
+				args = append(args, "--force")
+			}
+
+			exitCode := c.Run(args)
+			if testCase.expectError {
+				if exitCode == 0 {
+					t.Fatal("expected error running `generate tests` cmd")
+				}
+
+				return
+			}
+
+			if exitCode != 0 {
+				t.Fatalf("unexpected error running `generate tests` cmd: %s", mockUi.ErrorWriter.String())
+			}
+
+			compareDirectories(t, testCase.goldenFileDir, testOutputDir)
+		})
+	}
+}
@@ -0,0 +1,169 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package cmd
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/cli"
+	"github.com/hashicorp/terraform-plugin-codegen-spec/spec"
+
+	"github.com/hashicorp/terraform-plugin-codegen-framework/internal/importschema"
+	"github.com/hashicorp/terraform-plugin-codegen-framework/internal/input"
+	"github.com/hashicorp/terraform-plugin-codegen-framework/internal/output"
+	"github.com/hashicorp/terraform-plugin-codegen-framework/internal/specjson"
+	"github.com/hashicorp/terraform-plugin-codegen-framework/internal/validate"
+)
+
+type ImportSchemaCommand struct {
+	UI                 cli.Ui
+	flagInputPath      string
+	flagProvider       string
+	flagOutputPath     string
+	flagForceOverwrite bool
+}
+
+func (cmd *ImportSchemaCommand) Flags() *flag.FlagSet {
+	fs := flag.NewFlagSet("import-schema", flag.ExitOnError)
+	fs.StringVar(&cmd.flagInputPath, "input", "", "path to output of `terraform providers schema -json`, defaults to stdin")
+	fs.StringVar(&cmd.flagProvider, "provider", "", "source address or name of provider to import, required if there are multiple providers")
+	fs.StringVar(&cmd.flagOutputPath, "output", "", "path to write intermediate representation (JSON) to, defaults to stdout")
+	fs.BoolVar(&cmd.flagForceOverwrite, "force", false, "force creation, overwriting existing files")
+
+	return fs
+}
+
+func (cmd *ImportSchemaCommand) Help() string {
+	strBuilder := &strings.Builder{}
+
+	longestName := 0
+	longestUsage := 0
+	cmd.Flags().VisitAll(func(f *flag.Flag) {
+		if len(f.Name) > longestName {
+			longestName = len(f.Name)
+		}
+		if len(f.Usage) > longestUsage {
+			longestUsage = len(f.Usage)
+		}
+	})
+
+	strBuilder.WriteString("\nUsage: tfplugingen-framework import-schema [<args>]\n\n")
+	cmd.Flags().VisitAll(func(f *flag.Flag) {
+		if f.DefValue != "" {
+			strBuilder.WriteString(fmt.Sprintf("    --%s <ARG> %s%s%s  (default: %q)\n",
+				f.Name,
+				strings.Repeat(" ", longestName-len(f.Name)+2),
+				f.Usage,
+				strings.Repeat(" ", longestUsage-len(f.Usage)+2),
+				f.DefValue,
+			))
+		} else {
+			strBuilder.WriteString(fmt.Sprintf("    --%s <ARG> %s%s%s\n",
+				f.Name,
+				strings.Repeat(" ", longestName-len(f.Name)+2),
+				f.Usage,
+				strings.Repeat(" ", longestUsage-len(f.Usage)+2),
+			))
+		}
+	})
+	strBuilder.WriteString("\n")
+
+	return strBuilder.String()
+}
+
+func (cmd *ImportSchemaCommand) Synopsis() string {
+	return "Create an Intermediate Representation (IR) JSON file from the output of `terraform providers schema -json`."
+}
+
+func (cmd *ImportSchemaCommand) Run(args []string) int {
+	ctx := context.Background()
+
+	fs := cmd.Flags()
+	err := fs.Parse(args)
+	if err != nil {
+		cmd.UI.Error(fmt.Sprintf("error parsing command flags: %s", err))
+		return 1
+	}
+
+	err = cmd.runInternal(ctx)
+	if err != nil {
+		cmd.UI.Error(fmt.Sprintf("Error executing command: %s\n", err))
+		return 1
+	}
+
+	return 0
+}
+
+func (cmd *ImportSchemaCommand) runInternal(ctx context.Context) error {
+	src, err := input.Read(cmd.flagInputPath)
+	if err != nil {
+		return fmt.Errorf("error reading provider schemas: %w", err)
+	}
+
+	providerSchemas, err := importschema.Parse(src)
+	if err != nil {
+		return fmt.Errorf("error parsing provider schemas: %w", err)
+	}
+
+	s, warnings, err := providerSchemas.Specification(cmd.flagProvider)
+	if err != nil {
+		return fmt.Errorf("error converting provider schemas to IR: %w", err)
+	}
+
+	err = writeSpecification(ctx, cmd.UI, s, cmd.flagOutputPath, cmd.flagForceOverwrite)
+	if err != nil {
+		return err
+	}
+
+	if len(warnings) == 0 {
+		return nil
+	}
+
+	lines := make([]string, 0, len(warnings)+1)
+	lines = append(lines, "Warnings:")
+
+	for _, w := range warnings {
+		lines = append(lines, "  "+w.String())
+	}
+
+	cmd.UI.Warn(strings.Join(lines, "\n"))
+
+	return nil
+}
+
+// writeSpecification writes the specification to the output path, or to the UI
+// if the path is empty, once it has been validated and parsed as it is by the
+// generate commands.
+func writeSpecification(ctx context.Context, ui cli.Ui, s specjson.Specification, outputPath string, forceOverwrite bool) error {
+	b, err := s.Marshal()
+	if err != nil {
+		return fmt.Errorf("error marshalling IR JSON: %w", err)
+	}
+
+	err = validate.JSON(b)
+	if err != nil {
+		return fmt.Errorf("error validating IR JSON: %w", err)
+	}
+
+	_, err = spec.Parse(ctx, b)
+	if err != nil {
+		return fmt.Errorf("error parsing IR JSON: %w", err)
+	}
+
+	if outputPath == "" {
+		ui.Output(strings.TrimSuffix(string(b), "\n"))
+
+		return nil
+	}
+
+	err = output.WriteBytes(outputPath, b, forceOverwrite)
+	if err != nil {
+		return fmt.Errorf("error writing IR JSON: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,126 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package cmd_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/cli"
+	"github.com/hashicorp/terraform-plugin-codegen-spec/spec"
+
+	"github.com/hashicorp/terraform-plugin-codegen-framework/internal/cmd"
+	"github.com/hashicorp/terraform-plugin-codegen-framework/pkg/generator"
+)
+
+func TestImportSchemaCommand(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		inputPath    string
+		provider     string
+		goldenFile   string
+		warningsFile string
+		expectError  bool
+	}{
+		"single_provider": {
+			inputPath:    "testdata/import_schema/schema.json",
+			goldenFile:   "testdata/import_schema/spec.json",
+			warningsFile: "testdata/import_schema/warnings.txt",
+		},
+		"provider_name": {
+			inputPath:    "testdata/import_schema/schema.json",
+			provider:     "examplecloud",
+			goldenFile:   "testdata/import_schema/spec.json",
+			warningsFile: "testdata/import_schema/warnings.txt",
+		},
+		"provider_address": {
+			inputPath:    "testdata/import_schema/schema.json",
+			provider:     "registry.terraform.io/examplecorp/examplecloud",
+			goldenFile:   "testdata/import_schema/spec.json",
+			warningsFile: "testdata/import_schema/warnings.txt",
+		},
+		"provider_not_found": {
+			inputPath:   "testdata/import_schema/schema.json",
+			provider:    "other",
+			expectError: true,
+		},
+		"not_provider_schemas": {
+			inputPath:   "testdata/import_schema/spec.json",
+			expectError: true,
+		},
+	}
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			outputPath := filepath.Join(t.TempDir(), "spec.json")
+
+			mockUi := cli.NewMockUi()
+			c := cmd.ImportSchemaCommand{
+				UI: mockUi,
+			}
+
+			args := []string{
+				"--input", testCase.inputPath,
+				"--output", outputPath,
+			}
+
+			if testCase.provider != "" {
+				args = append(args, "--provider", testCase.provider)
+			}
+
+			exitCode := c.Run(args)
+
+			if testCase.expectError {
+				if mockUi.ErrorWriter.String() == "" {
+					t.Fatal("expected error running `import-schema` cmd")
+				}
+
+				return
+			}
+
+			if exitCode != 0 {
+				t.Fatalf("unexpected error running `import-schema` cmd: %s", mockUi.ErrorWriter.String())
+			}
+
+			got, err := os.ReadFile(outputPath)
+			if err != nil {
+				t.Fatalf("unexpected error reading output file: %s", err)
+			}
+
+			expected, err := os.ReadFile(testCase.goldenFile)
+			if err != nil {
+				t.Fatalf("unexpected error reading golden file: %s", err)
+			}
+
+			if diff := cmp.Diff(string(got), string(expected)); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+
+			expectedWarnings, err := os.ReadFile(testCase.warningsFile)
+			if err != nil {
+				t.Fatalf("unexpected error reading golden file: %s", err)
+			}
+
+			if diff := cmp.Diff(mockUi.ErrorWriter.String(), string(expectedWarnings)); diff != "" {
+				t.Errorf("unexpected warnings difference: %s", diff)
+			}
+
+			s, err := spec.Parse(context.Background(), got)
+			if err != nil {
+				t.Fatalf("unexpected error parsing output: %s", err)
+			}
+
+			_, err = generator.Generate(context.Background(), s, generator.Options{})
+			if err != nil {
+				t.Errorf("unexpected error generating code from output: %s", err)
+			}
+		})
+	}
+}
@@ -0,0 +1,122 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package cmd
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/cli"
+
+	"github.com/hashicorp/terraform-plugin-codegen-framework/internal/importsdkv2"
+)
+
+type ImportSDKv2Command struct {
+	UI                 cli.Ui
+	flagDir            string
+	flagProviderName   string
+	flagOutputPath     string
+	flagForceOverwrite bool
+}
+
+func (cmd *ImportSDKv2Command) Flags() *flag.FlagSet {
+	fs := flag.NewFlagSet("import-sdkv2", flag.ExitOnError)
+	fs.StringVar(&cmd.flagDir, "dir", ".", "directory path of Go package containing the SDKv2 provider, resources and data sources")
+	fs.StringVar(&cmd.flagProviderName, "provider-name", "", "name of provider, defaults to the prefix of resource and data source type names")
+	fs.StringVar(&cmd.flagOutputPath, "output", "", "path to write intermediate representation (JSON) to, defaults to stdout")
+	fs.BoolVar(&cmd.flagForceOverwrite, "force", false, "force creation, overwriting existing files")
+
+	return fs
+}
+
+func (cmd *ImportSDKv2Command) Help() string {
+	strBuilder := &strings.Builder{}
+
+	longestName := 0
+	longestUsage := 0
+	cmd.Flags().VisitAll(func(f *flag.Flag) {
+		if len(f.Name) > longestName {
+			longestName = len(f.Name)
+		}
+		if len(f.Usage) > longestUsage {
+			longestUsage = len(f.Usage)
+		}
+	})
+
+	strBuilder.WriteString("\nUsage: tfplugingen-framework import-sdkv2 [<args>]\n\n")
+	cmd.Flags().VisitAll(func(f *flag.Flag) {
+		if f.DefValue != "" {
+			strBuilder.WriteString(fmt.Sprintf("    --%s <ARG> %s%s%s  (default: %q)\n",
+				f.Name,
+				strings.Repeat(" ", longestName-len(f.Name)+2),
+				f.Usage,
+				strings.Repeat(" ", longestUsage-len(f.Usage)+2),
+				f.DefValue,
+			))
+		} else {
+			strBuilder.WriteString(fmt.Sprintf("    --%s <ARG> %s%s%s\n",
+				f.Name,
+				strings.Repeat(" ", longestName-len(f.Name)+2),
+				f.Usage,
+				strings.Repeat(" ", longestUsage-len(f.Usage)+2),
+			))
+		}
+	})
+	strBuilder.WriteString("\n")
+	strBuilder.WriteString("Anything which cannot be translated is listed as a warning.\n\n")
+
+	return strBuilder.String()
+}
+
+func (cmd *ImportSDKv2Command) Synopsis() string {
+	return "Create an Intermediate Representation (IR) JSON file from the schemas of a terraform-plugin-sdk/v2 provider."
+}
+
+func (cmd *ImportSDKv2Command) Run(args []string) int {
+	ctx := context.Background()
+
+	fs := cmd.Flags()
+	err := fs.Parse(args)
+	if err != nil {
+		cmd.UI.Error(fmt.Sprintf("error parsing command flags: %s", err))
+		return 1
+	}
+
+	err = cmd.runInternal(ctx)
+	if err != nil {
+		cmd.UI.Error(fmt.Sprintf("Error executing command: %s\n", err))
+		return 1
+	}
+
+	return 0
+}
+
+func (cmd *ImportSDKv2Command) runInternal(ctx context.Context) error {
+	s, untranslated, err := importsdkv2.Import(cmd.flagDir, cmd.flagProviderName)
+	if err != nil {
+		return fmt.Errorf("error converting SDKv2 schemas to IR: %w", err)
+	}
+
+	err = writeSpecification(ctx, cmd.UI, s, cmd.flagOutputPath, cmd.flagForceOverwrite)
+	if err != nil {
+		return err
+	}
+
+	if len(untranslated) == 0 {
+		return nil
+	}
+
+	lines := make([]string, 0, len(untranslated)+1)
+	lines = append(lines, "Not translated:")
+
+	for _, u := range untranslated {
+		lines = append(lines, "  "+u.String())
+	}
+
+	cmd.UI.Warn(strings.Join(lines, "\n"))
+
+	return nil
+}
@@ -0,0 +1,115 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package cmd_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/cli"
+	"github.com/hashicorp/terraform-plugin-codegen-spec/spec"
+
+	"github.com/hashicorp/terraform-plugin-codegen-framework/internal/cmd"
+	"github.com/hashicorp/terraform-plugin-codegen-framework/pkg/generator"
+)
+
+func TestImportSDKv2Command(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		dir              string
+		providerName     string
+		goldenFile       string
+		untranslatedFile string
+		expectError      bool
+	}{
+		"provider": {
+			dir:              "testdata/import_sdkv2/provider",
+			goldenFile:       "testdata/import_sdkv2/spec.json",
+			untranslatedFile: "testdata/import_sdkv2/untranslated.txt",
+		},
+		"provider_name": {
+			dir:              "testdata/import_sdkv2/provider",
+			providerName:     "examplecloud",
+			goldenFile:       "testdata/import_sdkv2/spec.json",
+			untranslatedFile: "testdata/import_sdkv2/untranslated.txt",
+		},
+		"not_sdkv2": {
+			dir:         "testdata/import_schema",
+			expectError: true,
+		},
+	}
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			outputPath := filepath.Join(t.TempDir(), "spec.json")
+
+			mockUi := cli.NewMockUi()
+			c := cmd.ImportSDKv2Command{
+				UI: mockUi,
+			}
+
+			args := []string{
+				"--dir", testCase.dir,
+				"--output", outputPath,
+			}
+
+			if testCase.providerName != "" {
+				args = append(args, "--provider-name", testCase.providerName)
+			}
+
+			exitCode := c.Run(args)
+
+			if testCase.expectError {
+				if exitCode == 0 {
+					t.Fatal("expected error running `import-sdkv2` cmd")
+				}
+
+				return
+			}
+
+			if exitCode != 0 {
+				t.Fatalf("unexpected error running `import-sdkv2` cmd: %s", mockUi.ErrorWriter.String())
+			}
+
+			got, err := os.ReadFile(outputPath)
+			if err != nil {
+				t.Fatalf("unexpected error reading output file: %s", err)
+			}
+
+			expected, err := os.ReadFile(testCase.goldenFile)
+			if err != nil {
+				t.Fatalf("unexpected error reading golden file: %s", err)
+			}
+
+			if diff := cmp.Diff(string(got), string(expected)); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+
+			expectedUntranslated, err := os.ReadFile(testCase.untranslatedFile)
+			if err != nil {
+				t.Fatalf("unexpected error reading golden file: %s", err)
+			}
+
+			if diff := cmp.Diff(mockUi.ErrorWriter.String(), string(expectedUntranslated)); diff != "" {
+				t.Errorf("unexpected untranslated difference: %s", diff)
+			}
+
+			s, err := spec.Parse(context.Background(), got)
+			if err != nil {
+				t.Fatalf("unexpected error parsing output: %s", err)
+			}
+
+			_, err = generator.Generate(context.Background(), s, generator.Options{})
+			if err != nil {
+				t.Errorf("unexpected error generating code from output: %s", err)
+			}
+		})
+	}
+}
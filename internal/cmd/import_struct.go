@@ -0,0 +1,221 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/cli"
+	"github.com/hashicorp/terraform-plugin-codegen-spec/code"
+	specschema "github.com/hashicorp/terraform-plugin-codegen-spec/schema"
+
+	"github.com/hashicorp/terraform-plugin-codegen-framework/internal/config"
+	"github.com/hashicorp/terraform-plugin-codegen-framework/internal/importstruct"
+	"github.com/hashicorp/terraform-plugin-codegen-framework/internal/output"
+	"github.com/hashicorp/terraform-plugin-codegen-framework/internal/specjson"
+)
+
+type ImportStructCommand struct {
+	UI                 cli.Ui
+	flagDir            string
+	flagStruct         string
+	flagImportPath     string
+	flagName           string
+	flagProviderName   string
+	flagDataSource     bool
+	flagOutputPath     string
+	flagConfigPath     string
+	flagForceOverwrite bool
+}
+
+func (cmd *ImportStructCommand) Flags() *flag.FlagSet {
+	fs := flag.NewFlagSet("import-struct", flag.ExitOnError)
+	fs.StringVar(&cmd.flagDir, "dir", ".", "directory path of Go package containing the struct type")
+	fs.StringVar(&cmd.flagStruct, "struct", "", "name of struct type, required")
+	fs.StringVar(&cmd.flagImportPath, "import-path", "", "import path of Go package, defaults to the path determined from go.mod")
+	fs.StringVar(&cmd.flagName, "name", "", "name of resource or data source, defaults to the snake case of the struct type name")
+	fs.StringVar(&cmd.flagProviderName, "provider-name", "", "name of provider, required")
+	fs.BoolVar(&cmd.flagDataSource, "data-source", false, "create a data source, rather than a resource")
+	fs.StringVar(&cmd.flagOutputPath, "output", "", "path to write intermediate representation (JSON) to, defaults to stdout")
+	fs.StringVar(&cmd.flagConfigPath, "config-output", "", "path to write generator configuration (JSON) setting the associated external type of the resource or data source")
+	fs.BoolVar(&cmd.flagForceOverwrite, "force", false, "force creation, overwriting existing files")
+
+	return fs
+}
+
+func (cmd *ImportStructCommand) Help() string {
+	strBuilder := &strings.Builder{}
+
+	longestName := 0
+	longestUsage := 0
+	cmd.Flags().VisitAll(func(f *flag.Flag) {
+		if len(f.Name) > longestName {
+			longestName = len(f.Name)
+		}
+		if len(f.Usage) > longestUsage {
+			longestUsage = len(f.Usage)
+		}
+	})
+
+	strBuilder.WriteString("\nUsage: tfplugingen-framework import-struct [<args>]\n\n")
+	cmd.Flags().VisitAll(func(f *flag.Flag) {
+		if f.DefValue != "" {
+			strBuilder.WriteString(fmt.Sprintf("    --%s <ARG> %s%s%s  (default: %q)\n",
+				f.Name,
+				strings.Repeat(" ", longestName-len(f.Name)+2),
+				f.Usage,
+				strings.Repeat(" ", longestUsage-len(f.Usage)+2),
+				f.DefValue,
+			))
+		} else {
+			strBuilder.WriteString(fmt.Sprintf("    --%s <ARG> %s%s%s\n",
+				f.Name,
+				strings.Repeat(" ", longestName-len(f.Name)+2),
+				f.Usage,
+				strings.Repeat(" ", longestUsage-len(f.Usage)+2),
+			))
+		}
+	})
+	strBuilder.WriteString("\n")
+	strBuilder.WriteString("Fields which cannot be translated, or for which generated conversion functions will not compile, are listed as warnings.\n\n")
+
+	return strBuilder.String()
+}
+
+func (cmd *ImportStructCommand) Synopsis() string {
+	return "Create an Intermediate Representation (IR) JSON file with a resource or data source mirroring a Go struct type."
+}
+
+func (cmd *ImportStructCommand) Run(args []string) int {
+	ctx := context.Background()
+
+	fs := cmd.Flags()
+	err := fs.Parse(args)
+	if err != nil {
+		cmd.UI.Error(fmt.Sprintf("error parsing command flags: %s", err))
+		return 1
+	}
+
+	err = cmd.runInternal(ctx)
+	if err != nil {
+		cmd.UI.Error(fmt.Sprintf("Error executing command: %s\n", err))
+		return 1
+	}
+
+	return 0
+}
+
+func (cmd *ImportStructCommand) runInternal(ctx context.Context) error {
+	if cmd.flagStruct == "" {
+		return errors.New("--struct flag is required")
+	}
+
+	if cmd.flagProviderName == "" {
+		return errors.New("--provider-name flag is required")
+	}
+
+	result, err := importstruct.Import(cmd.flagDir, cmd.flagStruct, cmd.flagImportPath)
+	if err != nil {
+		return fmt.Errorf("error converting struct type to IR: %w", err)
+	}
+
+	if len(result.Attributes) == 0 {
+		return errors.New("error converting struct type to IR: struct type has no fields which can be translated")
+	}
+
+	name := cmd.flagName
+	if name == "" {
+		name = result.Name
+	}
+
+	s := specjson.Specification{
+		Provider: &specjson.Provider{
+			Name: cmd.flagProviderName,
+		},
+	}
+
+	schema := specjson.Schema{
+		Name: name,
+		Schema: specjson.SchemaBody{
+			Attributes: result.Attributes,
+		},
+	}
+
+	if cmd.flagDataSource {
+		s.DataSources = []specjson.Schema{schema}
+	} else {
+		s.Resources = []specjson.Schema{schema}
+	}
+
+	err = writeSpecification(ctx, cmd.UI, s, cmd.flagOutputPath, cmd.flagForceOverwrite)
+	if err != nil {
+		return err
+	}
+
+	if cmd.flagConfigPath != "" {
+		err = cmd.writeConfig(name, result.AssociatedExternalType)
+		if err != nil {
+			return err
+		}
+	}
+
+	if len(result.Warnings) == 0 {
+		return nil
+	}
+
+	lines := make([]string, 0, len(result.Warnings)+1)
+	lines = append(lines, "Warnings:")
+
+	for _, w := range result.Warnings {
+		lines = append(lines, "  "+w.String())
+	}
+
+	cmd.UI.Warn(strings.Join(lines, "\n"))
+
+	return nil
+}
+
+// writeConfig writes a generator configuration which sets the associated
+// external type of the resource or data source to the struct type.
+func (cmd *ImportStructCommand) writeConfig(name string, aet specjson.AssociatedExternalType) error {
+	assocExtType := &specschema.AssociatedExternalType{
+		Import: &code.Import{
+			Path: aet.Import.Path,
+		},
+		Type: aet.Type,
+	}
+
+	if aet.Import.Alias != "" {
+		assocExtType.Import.Alias = &aet.Import.Alias
+	}
+
+	var c config.Config
+
+	if cmd.flagDataSource {
+		c.DataSources = map[string]config.DataSource{
+			name: {AssociatedExternalType: assocExtType},
+		}
+	} else {
+		c.Resources = map[string]config.Resource{
+			name: {AssociatedExternalType: assocExtType},
+		}
+	}
+
+	b, err := json.MarshalIndent(c, "", "  ")
+	if err != nil {
+		return fmt.Errorf("error marshalling generator configuration: %w", err)
+	}
+
+	err = output.WriteBytes(cmd.flagConfigPath, append(b, '\n'), cmd.flagForceOverwrite)
+	if err != nil {
+		return fmt.Errorf("error writing generator configuration: %w", err)
+	}
+
+	return nil
+}
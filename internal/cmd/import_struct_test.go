@@ -0,0 +1,148 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package cmd_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/cli"
+	"github.com/hashicorp/terraform-plugin-codegen-spec/spec"
+
+	"github.com/hashicorp/terraform-plugin-codegen-framework/internal/cmd"
+	"github.com/hashicorp/terraform-plugin-codegen-framework/pkg/generator"
+)
+
+func TestImportStructCommand(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		args             []string
+		goldenFile       string
+		configGoldenFile string
+		warningsFile     string
+		expectError      bool
+	}{
+		"resource": {
+			args: []string{
+				"--dir", "testdata/import_struct/apisdk",
+				"--struct", "Server",
+				"--provider-name", "examplecloud",
+			},
+			goldenFile:       "testdata/import_struct/spec.json",
+			configGoldenFile: "testdata/import_struct/config.json",
+			warningsFile:     "testdata/import_struct/warnings.txt",
+		},
+		"data_source": {
+			args: []string{
+				"--dir", "testdata/import_struct/apisdk",
+				"--struct", "Server",
+				"--provider-name", "examplecloud",
+				"--data-source",
+			},
+			goldenFile:       "testdata/import_struct/data_source_spec.json",
+			configGoldenFile: "testdata/import_struct/data_source_config.json",
+			warningsFile:     "testdata/import_struct/warnings.txt",
+		},
+		"struct_not_found": {
+			args: []string{
+				"--dir", "testdata/import_struct/apisdk",
+				"--struct", "Missing",
+				"--provider-name", "examplecloud",
+			},
+			expectError: true,
+		},
+		"missing_provider_name": {
+			args: []string{
+				"--dir", "testdata/import_struct/apisdk",
+				"--struct", "Server",
+			},
+			expectError: true,
+		},
+	}
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			outputPath := filepath.Join(t.TempDir(), "spec.json")
+			configPath := filepath.Join(t.TempDir(), "config.json")
+
+			mockUi := cli.NewMockUi()
+			c := cmd.ImportStructCommand{
+				UI: mockUi,
+			}
+
+			args := append(testCase.args,
+				"--output", outputPath,
+				"--config-output", configPath,
+			)
+
+			exitCode := c.Run(args)
+
+			if testCase.expectError {
+				if exitCode == 0 {
+					t.Fatal("expected error running `import-struct` cmd")
+				}
+
+				return
+			}
+
+			if exitCode != 0 {
+				t.Fatalf("unexpected error running `import-struct` cmd: %s", mockUi.ErrorWriter.String())
+			}
+
+			for outputFile, goldenFile := range map[string]string{
+				outputPath: testCase.goldenFile,
+				configPath: testCase.configGoldenFile,
+			} {
+				got, err := os.ReadFile(outputFile)
+				if err != nil {
+					t.Fatalf("unexpected error reading output file: %s", err)
+				}
+
+				expected, err := os.ReadFile(goldenFile)
+				if err != nil {
+					t.Fatalf("unexpected error reading golden file: %s", err)
+				}
+
+				if diff := cmp.Diff(string(got), string(expected)); diff != "" {
+					t.Errorf("unexpected difference in %s: %s", goldenFile, diff)
+				}
+			}
+
+			expectedWarnings, err := os.ReadFile(testCase.warningsFile)
+			if err != nil {
+				t.Fatalf("unexpected error reading golden file: %s", err)
+			}
+
+			if diff := cmp.Diff(mockUi.ErrorWriter.String(), string(expectedWarnings)); diff != "" {
+				t.Errorf("unexpected warnings difference: %s", diff)
+			}
+
+			specBytes, err := os.ReadFile(outputPath)
+			if err != nil {
+				t.Fatalf("unexpected error reading output file: %s", err)
+			}
+
+			s, err := spec.Parse(context.Background(), specBytes)
+			if err != nil {
+				t.Fatalf("unexpected error parsing output: %s", err)
+			}
+
+			configBytes, err := os.ReadFile(configPath)
+			if err != nil {
+				t.Fatalf("unexpected error reading config file: %s", err)
+			}
+
+			_, err = generator.Generate(context.Background(), s, generator.Options{Config: configBytes})
+			if err != nil {
+				t.Errorf("unexpected error generating code from output: %s", err)
+			}
+		})
+	}
+}
@@ -0,0 +1,230 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/hashicorp/cli"
+	"github.com/hashicorp/terraform-plugin-codegen-spec/spec"
+
+	"github.com/hashicorp/terraform-plugin-codegen-framework/internal/input"
+	"github.com/hashicorp/terraform-plugin-codegen-framework/internal/lint"
+	"github.com/hashicorp/terraform-plugin-codegen-framework/internal/validate"
+)
+
+type LintCommand struct {
+	UI              cli.Ui
+	flagInputPath   string
+	flagInputFormat string
+	flagOverlayPath string
+	flagFormat      string
+}
+
+func (cmd *LintCommand) Flags() *flag.FlagSet {
+	fs := flag.NewFlagSet("lint", flag.ExitOnError)
+	fs.StringVar(&cmd.flagInputPath, "input", "", "path to intermediate representation (JSON or YAML), defaults to stdin")
+	fs.StringVar(&cmd.flagInputFormat, "input-format", "", "format of intermediate representation, json or yaml, defaults to yaml for .yaml and .yml files and json otherwise")
+	fs.StringVar(&cmd.flagOverlayPath, "overlay", "", "path to overlay (JSON) changing rule severities and suppressing findings")
+	fs.StringVar(&cmd.flagFormat, "format", "text", "output format, text, json or sarif")
+
+	return fs
+}
+
+func (cmd *LintCommand) Help() string {
+	strBuilder := &strings.Builder{}
+
+	longestName := 0
+	longestUsage := 0
+	cmd.Flags().VisitAll(func(f *flag.Flag) {
+		if len(f.Name) > longestName {
+			longestName = len(f.Name)
+		}
+		if len(f.Usage) > longestUsage {
+			longestUsage = len(f.Usage)
+		}
+	})
+
+	strBuilder.WriteString("\nUsage: tfplugingen-framework lint [<args>]\n\n")
+	cmd.Flags().VisitAll(func(f *flag.Flag) {
+		if f.DefValue != "" {
+			strBuilder.WriteString(fmt.Sprintf("    --%s <ARG> %s%s%s  (default: %q)\n",
+				f.Name,
+				strings.Repeat(" ", longestName-len(f.Name)+2),
+				f.Usage,
+				strings.Repeat(" ", longestUsage-len(f.Usage)+2),
+				f.DefValue,
+			))
+		} else {
+			strBuilder.WriteString(fmt.Sprintf("    --%s <ARG> %s%s%s\n",
+				f.Name,
+				strings.Repeat(" ", longestName-len(f.Name)+2),
+				f.Usage,
+				strings.Repeat(" ", longestUsage-len(f.Usage)+2),
+			))
+		}
+	})
+	strBuilder.WriteString("\n")
+	strBuilder.WriteString("Rules:\n\n")
+
+	longestID := 0
+	for _, r := range lint.Rules() {
+		longestID = max(longestID, len(r.ID))
+	}
+
+	for _, r := range lint.Rules() {
+		strBuilder.WriteString(fmt.Sprintf("    %s%s%-7s  %s\n",
+			r.ID,
+			strings.Repeat(" ", longestID-len(r.ID)+2),
+			r.Severity,
+			r.Description,
+		))
+	}
+
+	strBuilder.WriteString("\n")
+	strBuilder.WriteString("Exits with status 1 if there are any findings with error severity which are not suppressed.\n\n")
+
+	return strBuilder.String()
+}
+
+func (cmd *LintCommand) Synopsis() string {
+	return "Check an Intermediate Representation (IR) JSON file against provider conventions."
+}
+
+func (cmd *LintCommand) Run(args []string) int {
+	ctx := context.Background()
+
+	fs := cmd.Flags()
+	err := fs.Parse(args)
+	if err != nil {
+		cmd.UI.Error(fmt.Sprintf("error parsing command flags: %s", err))
+		return 1
+	}
+
+	findings, err := cmd.runInternal(ctx)
+	if err != nil {
+		cmd.UI.Error(fmt.Sprintf("Error executing command: %s\n", err))
+		return 1
+	}
+
+	if lint.HasErrors(findings) {
+		return 1
+	}
+
+	return 0
+}
+
+func (cmd *LintCommand) runInternal(ctx context.Context) ([]lint.Finding, error) {
+	switch cmd.flagFormat {
+	case "text", "json", "sarif":
+	default:
+		return nil, fmt.Errorf("unsupported --format %q, expected text, json or sarif", cmd.flagFormat)
+	}
+
+	ir, err := input.ReadSpecification(cmd.flagInputPath, cmd.flagInputFormat)
+	if err != nil {
+		return nil, fmt.Errorf("error reading IR JSON: %w", err)
+	}
+
+	err = validate.JSON(ir.JSON)
+	if err != nil {
+		return nil, fmt.Errorf("error reading IR JSON: %w", err)
+	}
+
+	s, err := spec.Parse(ctx, ir.JSON)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing IR JSON: %w", ir.Error(err))
+	}
+
+	var overlay lint.Overlay
+
+	if cmd.flagOverlayPath != "" {
+		b, err := os.ReadFile(cmd.flagOverlayPath)
+		if err != nil {
+			return nil, fmt.Errorf("error reading overlay: %w", err)
+		}
+
+		overlay, err = lint.ParseOverlay(b)
+		if err != nil {
+			return nil, fmt.Errorf("error parsing overlay: %w", err)
+		}
+	}
+
+	findings, err := lint.Lint(s, overlay)
+	if err != nil {
+		return nil, fmt.Errorf("error linting IR JSON: %w", err)
+	}
+
+	switch cmd.flagFormat {
+	case "json":
+		output := struct {
+			Errors   bool           `json:"errors"`
+			Findings []lint.Finding `json:"findings"`
+		}{
+			Errors:   lint.HasErrors(findings),
+			Findings: append([]lint.Finding{}, findings...),
+		}
+
+		b, err := json.MarshalIndent(output, "", "  ")
+		if err != nil {
+			return nil, fmt.Errorf("error marshalling findings: %w", err)
+		}
+
+		cmd.UI.Output(string(b))
+	case "sarif":
+		b, err := lint.SARIF(findings, ir)
+		if err != nil {
+			return nil, fmt.Errorf("error marshalling findings: %w", err)
+		}
+
+		cmd.UI.Output(string(b))
+	default:
+		cmd.UI.Output(lintText(findings, ir))
+	}
+
+	return findings, nil
+}
+
+// lintText returns the findings which are not suppressed, prefixed with their
+// line and column in YAML specifications, followed by the number of suppressed
+// findings, if any.
+func lintText(findings []lint.Finding, ir input.Specification) string {
+	var lines []string
+
+	suppressed := 0
+
+	for _, f := range findings {
+		if f.Suppression != nil {
+			suppressed++
+			continue
+		}
+
+		line := f.String()
+
+		if location := ir.Location(f.Pointer); location != "" {
+			line = location + ": " + line
+		}
+
+		lines = append(lines, line)
+	}
+
+	if len(lines) == 0 {
+		lines = append(lines, "No problems found.")
+	}
+
+	switch suppressed {
+	case 0:
+	case 1:
+		lines = append(lines, "", "1 finding suppressed by overlay.")
+	default:
+		lines = append(lines, "", fmt.Sprintf("%d findings suppressed by overlay.", suppressed))
+	}
+
+	return strings.Join(lines, "\n")
+}
@@ -0,0 +1,110 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package cmd_test
+
+import (
+	"os"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/cli"
+
+	"github.com/hashicorp/terraform-plugin-codegen-framework/internal/cmd"
+)
+
+func TestLintCommand(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		inputPath        string
+		overlayPath      string
+		format           string
+		goldenFile       string
+		expectedExitCode int
+		expectError      bool
+	}{
+		"text": {
+			goldenFile:       "testdata/lint/spec.txt",
+			expectedExitCode: 1,
+		},
+		"yaml": {
+			inputPath:        "testdata/lint/spec.yaml",
+			goldenFile:       "testdata/lint/spec_yaml.txt",
+			expectedExitCode: 1,
+		},
+		"overlay": {
+			overlayPath: "testdata/lint/overlay.json",
+			goldenFile:  "testdata/lint/overlay.txt",
+		},
+		"overlay_json": {
+			overlayPath: "testdata/lint/overlay.json",
+			format:      "json",
+			goldenFile:  "testdata/lint/overlay_json.txt",
+		},
+		"overlay_sarif": {
+			overlayPath: "testdata/lint/overlay.json",
+			format:      "sarif",
+			goldenFile:  "testdata/lint/overlay.sarif",
+		},
+		"unsupported_format": {
+			format:      "yaml",
+			expectError: true,
+		},
+		"missing_overlay": {
+			overlayPath: "testdata/lint/missing.json",
+			expectError: true,
+		},
+	}
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			mockUi := cli.NewMockUi()
+			c := cmd.LintCommand{
+				UI: mockUi,
+			}
+
+			inputPath := testCase.inputPath
+			if inputPath == "" {
+				inputPath = "testdata/lint/spec.json"
+			}
+
+			args := []string{
+				"--input", inputPath,
+			}
+
+			if testCase.overlayPath != "" {
+				args = append(args, "--overlay", testCase.overlayPath)
+			}
+
+			if testCase.format != "" {
+				args = append(args, "--format", testCase.format)
+			}
+
+			exitCode := c.Run(args)
+
+			if testCase.expectError {
+				if mockUi.ErrorWriter.String() == "" {
+					t.Fatal("expected error running `lint` cmd")
+				}
+
+				return
+			}
+
+			if exitCode != testCase.expectedExitCode {
+				t.Fatalf("expected exit code %d, got %d: %s", testCase.expectedExitCode, exitCode, mockUi.ErrorWriter.String())
+			}
+
+			expected, err := os.ReadFile(testCase.goldenFile)
+			if err != nil {
+				t.Fatalf("unexpected error reading golden file: %s", err)
+			}
+
+			if diff := cmp.Diff(mockUi.OutputWriter.String(), string(expected)); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
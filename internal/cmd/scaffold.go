@@ -4,9 +4,15 @@
 package cmd
 
 import (
+	"fmt"
+	"os"
 	"strings"
 
 	"github.com/hashicorp/cli"
+
+	"github.com/hashicorp/terraform-plugin-codegen-framework/internal/config"
+	"github.com/hashicorp/terraform-plugin-codegen-framework/internal/scaffold"
+	"github.com/hashicorp/terraform-plugin-codegen-framework/internal/schema"
 )
 
 type ScaffoldCommand struct {
@@ -30,3 +36,53 @@ func (a *ScaffoldCommand) Synopsis() string {
 func (cmd *ScaffoldCommand) Run(args []string) int {
 	return cli.RunResultHelp
 }
+
+// scaffoldTemplate returns the data for scaffolding code with the identifier
+// into the package and output directory, and the contents of the template file,
+// which is empty if no path is supplied. Names are cased with the naming
+// settings of the generator configuration, if a path is supplied.
+func scaffoldTemplate(identifier schema.FrameworkIdentifier, packageName, outputDir, templatePath, configPath string) (scaffold.TemplateData, string, error) {
+	document, err := readConfig(configPath)
+	if err != nil {
+		return scaffold.TemplateData{}, "", fmt.Errorf("error reading generator configuration: %w", err)
+	}
+
+	cfg, err := config.Parse(document)
+	if err != nil {
+		return scaffold.TemplateData{}, "", fmt.Errorf("error parsing generator configuration: %w", err)
+	}
+
+	data := scaffold.NewTemplateData(identifier, packageName, cfg.Naming.Options())
+
+	modulePath, err := scaffold.ModulePath(outputDir)
+	if err != nil {
+		return data, "", fmt.Errorf("error reading Go module path: %w", err)
+	}
+
+	data.ModulePath = modulePath
+
+	if templatePath == "" {
+		return data, "", nil
+	}
+
+	b, err := os.ReadFile(templatePath)
+	if err != nil {
+		return data, "", fmt.Errorf("error reading template: %w", err)
+	}
+
+	return data, string(b), nil
+}
+
+// registerConstructor adds the scaffolded constructor to the provider in the
+// output directory with the register function. Failures are reported as
+// warnings, as the scaffolded code has already been written.
+func registerConstructor(ui cli.Ui, register func(dir, constructor string) (string, bool, error), dir, constructor string) {
+	filename, changed, err := register(dir, constructor)
+
+	switch {
+	case err != nil:
+		ui.Warn(fmt.Sprintf("%s was not registered with the provider: %s", constructor, err))
+	case changed:
+		ui.Info(fmt.Sprintf("Registered %s in %s", constructor, filename))
+	}
+}
@@ -26,6 +26,10 @@ type ScaffoldDataSourceCommand struct {
 	flagOutputFile          string
 	flagPackageName         string
 	flagForceOverwrite      bool
+	flagProviderName        string
+	flagTemplatePath        string
+	flagConfigPath          string
+	flagRegister            bool
 }
 
 func (cmd *ScaffoldDataSourceCommand) Flags() *flag.FlagSet {
@@ -36,6 +40,10 @@ func (cmd *ScaffoldDataSourceCommand) Flags() *flag.FlagSet {
 	fs.StringVar(&cmd.flagOutputDir, "output-dir", ".", "directory path to output scaffolded code file")
 	fs.StringVar(&cmd.flagOutputFile, "output-file", "", "file name and extension to write scaffolded code to, default will use the --name flag with '_data_source.go' suffix")
 	fs.StringVar(&cmd.flagPackageName, "package", "provider", "name of Go package for scaffolded code file")
+	fs.StringVar(&cmd.flagProviderName, "provider-name", "", "name of provider, available to templates")
+	fs.StringVar(&cmd.flagTemplatePath, "template", "", "path to Go template file to use in place of the built-in template")
+	fs.StringVar(&cmd.flagConfigPath, "config", "", "path to generator configuration (JSON), to case names with its naming settings")
+	fs.BoolVar(&cmd.flagRegister, "register", false, "add constructor to the DataSources method of the provider in the output directory")
 	return fs
 }
 
@@ -110,7 +118,14 @@ func (cmd *ScaffoldDataSourceCommand) runInternal(_ context.Context) error {
 		return fmt.Errorf("'%s' is not a valid Terraform data source identifier", cmd.flagDataSourceNameSnake)
 	}
 
-	goBytes, err := scaffold.DataSourceBytes(dataSourceIdentifier, cmd.flagPackageName)
+	data, text, err := scaffoldTemplate(dataSourceIdentifier, cmd.flagPackageName, cmd.flagOutputDir, cmd.flagTemplatePath, cmd.flagConfigPath)
+	if err != nil {
+		return err
+	}
+
+	data.ProviderName = cmd.flagProviderName
+
+	goBytes, err := scaffold.DataSourceBytes(data, text)
 	if err != nil {
 		return fmt.Errorf("error creating scaffolding data source Go code: %w", err)
 	}
@@ -125,6 +140,10 @@ func (cmd *ScaffoldDataSourceCommand) runInternal(_ context.Context) error {
 		return fmt.Errorf("error writing scaffolding data source Go code: %w", err)
 	}
 
+	if cmd.flagRegister {
+		registerConstructor(cmd.UI, scaffold.RegisterDataSource, cmd.flagOutputDir, "New"+data.NamePascal+"DataSource")
+	}
+
 	return nil
 }
 
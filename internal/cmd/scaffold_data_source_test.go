@@ -4,6 +4,9 @@
 package cmd_test
 
 import (
+	"os"
+	"path/filepath"
+	"strings"
 	"testing"
 
 	"github.com/hashicorp/cli"
@@ -14,11 +17,19 @@ func TestScaffoldDataSourceCommand(t *testing.T) {
 	t.Parallel()
 
 	testCases := map[string]struct {
+		name          string
+		templatePath  string
 		goldenFileDir string
 	}{
 		"data source scaffold": {
+			name:          "thing",
 			goldenFileDir: "testdata/scaffold/data_source",
 		},
+		"custom template": {
+			name:          "my_thing",
+			templatePath:  "testdata/scaffold/templates/data_source.gotmpl",
+			goldenFileDir: "testdata/scaffold/custom_template/data_source",
+		},
 	}
 	for name, testCase := range testCases {
 
@@ -32,11 +43,15 @@ func TestScaffoldDataSourceCommand(t *testing.T) {
 			}
 
 			args := []string{
-				"--name", "thing",
+				"--name", testCase.name,
 				"--package", "scaffold",
 				"--output-dir", testOutputDir,
 			}
 
+			if testCase.templatePath != "" {
+				args = append(args, "--template", testCase.templatePath)
+			}
+
 			exitCode := c.Run(args)
 			if exitCode != 0 {
 				t.Fatalf("unexpected error running `scaffold data-source` cmd: %s", mockUi.ErrorWriter.String())
@@ -46,3 +61,40 @@ func TestScaffoldDataSourceCommand(t *testing.T) {
 		})
 	}
 }
+
+func TestScaffoldDataSourceCommand_Register(t *testing.T) {
+	t.Parallel()
+
+	testOutputDir := t.TempDir()
+
+	provider, err := os.ReadFile("testdata/scaffold/provider/provider.go")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	err = os.WriteFile(filepath.Join(testOutputDir, "provider.go"), provider, 0666)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	mockUi := cli.NewMockUi()
+	c := cmd.ScaffoldDataSourceCommand{
+		UI: mockUi,
+	}
+
+	exitCode := c.Run([]string{
+		"--name", "thing",
+		"--package", "scaffold",
+		"--output-dir", testOutputDir,
+		"--register",
+	})
+	if exitCode != 0 {
+		t.Fatalf("unexpected error running `scaffold data-source` cmd: %s", mockUi.ErrorWriter.String())
+	}
+
+	if !strings.Contains(mockUi.OutputWriter.String(), "Registered NewThingDataSource") {
+		t.Errorf("unexpected output: %s", mockUi.OutputWriter.String())
+	}
+
+	compareDirectories(t, "testdata/scaffold/register/data_source", testOutputDir)
+}
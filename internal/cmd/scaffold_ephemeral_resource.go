@@ -0,0 +1,157 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package cmd
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"go/format"
+	"path/filepath"
+	"strings"
+
+	"github.com/hashicorp/cli"
+
+	"github.com/hashicorp/terraform-plugin-codegen-framework/internal/output"
+	"github.com/hashicorp/terraform-plugin-codegen-framework/internal/scaffold"
+	"github.com/hashicorp/terraform-plugin-codegen-framework/internal/schema"
+)
+
+type ScaffoldEphemeralResourceCommand struct {
+	UI                             cli.Ui
+	flagEphemeralResourceNameSnake string
+	flagOutputDir                  string
+	flagOutputFile                 string
+	flagPackageName                string
+	flagForceOverwrite             bool
+	flagProviderName               string
+	flagTemplatePath               string
+	flagConfigPath                 string
+	flagRegister                   bool
+}
+
+func (cmd *ScaffoldEphemeralResourceCommand) Flags() *flag.FlagSet {
+	fs := flag.NewFlagSet("scaffold ephemeral resource", flag.ExitOnError)
+
+	fs.StringVar(&cmd.flagEphemeralResourceNameSnake, "name", "", "name of ephemeral resource in snake case without the provider type prefix, required")
+	fs.BoolVar(&cmd.flagForceOverwrite, "force", false, "force creation, overwriting existing files")
+	fs.StringVar(&cmd.flagOutputDir, "output-dir", ".", "directory path to output scaffolded code file")
+	fs.StringVar(&cmd.flagOutputFile, "output-file", "", "file name and extension to write scaffolded code to, default will use the --name flag with '_ephemeral_resource.go' suffix")
+	fs.StringVar(&cmd.flagPackageName, "package", "provider", "name of Go package for scaffolded code file")
+	fs.StringVar(&cmd.flagProviderName, "provider-name", "", "name of provider, available to templates")
+	fs.StringVar(&cmd.flagTemplatePath, "template", "", "path to Go template file to use in place of the built-in template")
+	fs.StringVar(&cmd.flagConfigPath, "config", "", "path to generator configuration (JSON), to case names with its naming settings")
+	fs.BoolVar(&cmd.flagRegister, "register", false, "add constructor to the EphemeralResources method of the provider in the output directory")
+	return fs
+}
+
+func (cmd *ScaffoldEphemeralResourceCommand) Help() string {
+	strBuilder := &strings.Builder{}
+
+	longestName := 0
+	longestUsage := 0
+	cmd.Flags().VisitAll(func(f *flag.Flag) {
+		if len(f.Name) > longestName {
+			longestName = len(f.Name)
+		}
+		if len(f.Usage) > longestUsage {
+			longestUsage = len(f.Usage)
+		}
+	})
+
+	strBuilder.WriteString("\nUsage: tfplugingen-framework scaffold ephemeral-resource [<args>]\n\n")
+	cmd.Flags().VisitAll(func(f *flag.Flag) {
+		if f.DefValue != "" {
+			strBuilder.WriteString(fmt.Sprintf("    --%s <ARG> %s%s%s  (default: %q)\n",
+				f.Name,
+				strings.Repeat(" ", longestName-len(f.Name)+2),
+				f.Usage,
+				strings.Repeat(" ", longestUsage-len(f.Usage)+2),
+				f.DefValue,
+			))
+		} else {
+			strBuilder.WriteString(fmt.Sprintf("    --%s <ARG> %s%s%s\n",
+				f.Name,
+				strings.Repeat(" ", longestName-len(f.Name)+2),
+				f.Usage,
+				strings.Repeat(" ", longestUsage-len(f.Usage)+2),
+			))
+		}
+	})
+	strBuilder.WriteString("\n")
+
+	return strBuilder.String()
+}
+
+func (a *ScaffoldEphemeralResourceCommand) Synopsis() string {
+	return "Create scaffolding code for a Terraform Plugin Framework ephemeral resource."
+}
+
+func (cmd *ScaffoldEphemeralResourceCommand) Run(args []string) int {
+	ctx := context.Background()
+
+	fs := cmd.Flags()
+	err := fs.Parse(args)
+	if err != nil {
+		cmd.UI.Error(fmt.Sprintf("error parsing command flags: %s", err))
+		return 1
+	}
+
+	err = cmd.runInternal(ctx)
+	if err != nil {
+		cmd.UI.Error(fmt.Sprintf("Error executing command: %s\n", err))
+		return 1
+	}
+
+	return 0
+}
+
+func (cmd *ScaffoldEphemeralResourceCommand) runInternal(_ context.Context) error {
+	if cmd.flagEphemeralResourceNameSnake == "" {
+		return errors.New("--name flag is required")
+	}
+
+	ephemeralResourceIdentifier := schema.FrameworkIdentifier(cmd.flagEphemeralResourceNameSnake)
+	if !ephemeralResourceIdentifier.Valid() {
+		return fmt.Errorf("'%s' is not a valid Terraform ephemeral resource identifier", cmd.flagEphemeralResourceNameSnake)
+	}
+
+	data, text, err := scaffoldTemplate(ephemeralResourceIdentifier, cmd.flagPackageName, cmd.flagOutputDir, cmd.flagTemplatePath, cmd.flagConfigPath)
+	if err != nil {
+		return err
+	}
+
+	data.ProviderName = cmd.flagProviderName
+
+	goBytes, err := scaffold.EphemeralResourceBytes(data, text)
+	if err != nil {
+		return fmt.Errorf("error creating scaffolding ephemeral resource Go code: %w", err)
+	}
+
+	formattedGoBytes, err := format.Source(goBytes)
+	if err != nil {
+		return fmt.Errorf("error formatting scaffolding ephemeral resource Go code: %w", err)
+	}
+
+	err = output.WriteBytes(cmd.getOutputFilePath(), formattedGoBytes, cmd.flagForceOverwrite)
+	if err != nil {
+		return fmt.Errorf("error writing scaffolding ephemeral resource Go code: %w", err)
+	}
+
+	if cmd.flagRegister {
+		registerConstructor(cmd.UI, scaffold.RegisterEphemeralResource, cmd.flagOutputDir, "New"+data.NamePascal+"EphemeralResource")
+	}
+
+	return nil
+}
+
+func (cmd *ScaffoldEphemeralResourceCommand) getOutputFilePath() string {
+	filename := fmt.Sprintf("%s_ephemeral_resource.go", cmd.flagEphemeralResourceNameSnake)
+	if cmd.flagOutputFile != "" {
+		filename = cmd.flagOutputFile
+	}
+
+	return filepath.Join(cmd.flagOutputDir, filename)
+}
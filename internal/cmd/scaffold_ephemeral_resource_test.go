@@ -0,0 +1,48 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package cmd_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/cli"
+	"github.com/hashicorp/terraform-plugin-codegen-framework/internal/cmd"
+)
+
+func TestScaffoldEphemeralResourceCommand(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		name          string
+		goldenFileDir string
+	}{
+		"ephemeral resource scaffold": {
+			name:          "thing",
+			goldenFileDir: "testdata/scaffold/ephemeral_resource",
+		},
+	}
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			testOutputDir := t.TempDir()
+			mockUi := cli.NewMockUi()
+			c := cmd.ScaffoldEphemeralResourceCommand{
+				UI: mockUi,
+			}
+
+			exitCode := c.Run([]string{
+				"--name", testCase.name,
+				"--package", "scaffold",
+				"--output-dir", testOutputDir,
+			})
+			if exitCode != 0 {
+				t.Fatalf("unexpected error running `scaffold ephemeral-resource` cmd: %s", mockUi.ErrorWriter.String())
+			}
+
+			compareDirectories(t, testCase.goldenFileDir, testOutputDir)
+		})
+	}
+}
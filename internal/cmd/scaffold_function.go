@@ -0,0 +1,176 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package cmd
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"go/format"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/cli"
+
+	"github.com/hashicorp/terraform-plugin-codegen-framework/internal/output"
+	"github.com/hashicorp/terraform-plugin-codegen-framework/internal/scaffold"
+	"github.com/hashicorp/terraform-plugin-codegen-framework/internal/schema"
+)
+
+type ScaffoldFunctionCommand struct {
+	UI                    cli.Ui
+	flagFunctionNameSnake string
+	flagOutputDir         string
+	flagOutputFile        string
+	flagPackageName       string
+	flagForceOverwrite    bool
+	flagParameters        stringsFlag
+	flagReturn            string
+	flagTemplatePath      string
+	flagConfigPath        string
+	flagRegister          bool
+}
+
+func (cmd *ScaffoldFunctionCommand) Flags() *flag.FlagSet {
+	fs := flag.NewFlagSet("scaffold function", flag.ExitOnError)
+
+	fs.StringVar(&cmd.flagFunctionNameSnake, "name", "", "name of function in snake case, required")
+	fs.BoolVar(&cmd.flagForceOverwrite, "force", false, "force creation, overwriting existing files")
+	fs.StringVar(&cmd.flagOutputDir, "output-dir", ".", "directory path to output scaffolded code file")
+	fs.StringVar(&cmd.flagOutputFile, "output-file", "", "file name and extension to write scaffolded code to, default will use the --name flag with '_function.go' suffix")
+	fs.StringVar(&cmd.flagPackageName, "package", "provider", "name of Go package for scaffolded code file")
+	fs.Var(&cmd.flagParameters, "parameter", "function parameter name and type separated by a colon, such as input:string (repeatable)")
+	fs.StringVar(&cmd.flagReturn, "return", "string", "type of function return value")
+	fs.StringVar(&cmd.flagTemplatePath, "template", "", "path to Go template file to use in place of the built-in template")
+	fs.StringVar(&cmd.flagConfigPath, "config", "", "path to generator configuration (JSON), to case names with its naming settings")
+	fs.BoolVar(&cmd.flagRegister, "register", false, "add constructor to the Functions method of the provider in the output directory")
+	return fs
+}
+
+func (cmd *ScaffoldFunctionCommand) Help() string {
+	strBuilder := &strings.Builder{}
+
+	longestName := 0
+	longestUsage := 0
+	cmd.Flags().VisitAll(func(f *flag.Flag) {
+		if len(f.Name) > longestName {
+			longestName = len(f.Name)
+		}
+		if len(f.Usage) > longestUsage {
+			longestUsage = len(f.Usage)
+		}
+	})
+
+	strBuilder.WriteString("\nUsage: tfplugingen-framework scaffold function [<args>]\n\n")
+	cmd.Flags().VisitAll(func(f *flag.Flag) {
+		if f.DefValue != "" {
+			strBuilder.WriteString(fmt.Sprintf("    --%s <ARG> %s%s%s  (default: %q)\n",
+				f.Name,
+				strings.Repeat(" ", longestName-len(f.Name)+2),
+				f.Usage,
+				strings.Repeat(" ", longestUsage-len(f.Usage)+2),
+				f.DefValue,
+			))
+		} else {
+			strBuilder.WriteString(fmt.Sprintf("    --%s <ARG> %s%s%s\n",
+				f.Name,
+				strings.Repeat(" ", longestName-len(f.Name)+2),
+				f.Usage,
+				strings.Repeat(" ", longestUsage-len(f.Usage)+2),
+			))
+		}
+	})
+	strBuilder.WriteString("\n")
+
+	return strBuilder.String()
+}
+
+func (a *ScaffoldFunctionCommand) Synopsis() string {
+	return "Create scaffolding code for a Terraform Plugin Framework function."
+}
+
+func (cmd *ScaffoldFunctionCommand) Run(args []string) int {
+	ctx := context.Background()
+
+	fs := cmd.Flags()
+	err := fs.Parse(args)
+	if err != nil {
+		cmd.UI.Error(fmt.Sprintf("error parsing command flags: %s", err))
+		return 1
+	}
+
+	err = cmd.runInternal(ctx)
+	if err != nil {
+		cmd.UI.Error(fmt.Sprintf("Error executing command: %s\n", err))
+		return 1
+	}
+
+	return 0
+}
+
+func (cmd *ScaffoldFunctionCommand) runInternal(_ context.Context) error {
+	if cmd.flagFunctionNameSnake == "" {
+		return errors.New("--name flag is required")
+	}
+
+	functionIdentifier := schema.FrameworkIdentifier(cmd.flagFunctionNameSnake)
+	if !functionIdentifier.Valid() {
+		return fmt.Errorf("'%s' is not a valid Terraform function identifier", cmd.flagFunctionNameSnake)
+	}
+
+	data, text, err := scaffoldTemplate(functionIdentifier, cmd.flagPackageName, cmd.flagOutputDir, cmd.flagTemplatePath, cmd.flagConfigPath)
+	if err != nil {
+		return err
+	}
+
+	for _, p := range cmd.flagParameters {
+		parameter, err := scaffold.ParseFunctionParameter(p)
+		if err != nil {
+			return err
+		}
+
+		if slices.ContainsFunc(data.Parameters, func(existing scaffold.FunctionParameter) bool { return existing.NameCamel == parameter.NameCamel }) {
+			return fmt.Errorf("function parameter %q is duplicated", parameter.Name)
+		}
+
+		data.Parameters = append(data.Parameters, parameter)
+	}
+
+	data.Return, err = scaffold.ParseFunctionType(cmd.flagReturn)
+	if err != nil {
+		return err
+	}
+
+	goBytes, err := scaffold.FunctionBytes(data, text)
+	if err != nil {
+		return fmt.Errorf("error creating scaffolding function Go code: %w", err)
+	}
+
+	formattedGoBytes, err := format.Source(goBytes)
+	if err != nil {
+		return fmt.Errorf("error formatting scaffolding function Go code: %w", err)
+	}
+
+	err = output.WriteBytes(cmd.getOutputFilePath(), formattedGoBytes, cmd.flagForceOverwrite)
+	if err != nil {
+		return fmt.Errorf("error writing scaffolding function Go code: %w", err)
+	}
+
+	if cmd.flagRegister {
+		registerConstructor(cmd.UI, scaffold.RegisterFunction, cmd.flagOutputDir, "New"+data.NamePascal+"Function")
+	}
+
+	return nil
+}
+
+func (cmd *ScaffoldFunctionCommand) getOutputFilePath() string {
+	filename := fmt.Sprintf("%s_function.go", cmd.flagFunctionNameSnake)
+	if cmd.flagOutputFile != "" {
+		filename = cmd.flagOutputFile
+	}
+
+	return filepath.Join(cmd.flagOutputDir, filename)
+}
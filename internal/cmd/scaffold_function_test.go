@@ -0,0 +1,86 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package cmd_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/cli"
+	"github.com/hashicorp/terraform-plugin-codegen-framework/internal/cmd"
+)
+
+func TestScaffoldFunctionCommand(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		name          string
+		args          []string
+		goldenFileDir string
+		expectError   bool
+	}{
+		"function scaffold": {
+			name:          "thing",
+			goldenFileDir: "testdata/scaffold/function/default",
+		},
+		"typed parameters": {
+			name: "parse_thing",
+			args: []string{
+				"--parameter", "input:string",
+				"--parameter", "precision:number",
+				"--parameter", "extra_options:dynamic",
+				"--return", "int64",
+			},
+			goldenFileDir: "testdata/scaffold/function/parameters",
+		},
+		"unsupported parameter type": {
+			name:        "thing",
+			args:        []string{"--parameter", "input:tuple"},
+			expectError: true,
+		},
+		"reserved parameter name": {
+			name:        "thing",
+			args:        []string{"--parameter", "result:string"},
+			expectError: true,
+		},
+		"duplicate parameter name": {
+			name:        "thing",
+			args:        []string{"--parameter", "input:string", "--parameter", "input:bool"},
+			expectError: true,
+		},
+	}
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			testOutputDir := t.TempDir()
+			mockUi := cli.NewMockUi()
+			c := cmd.ScaffoldFunctionCommand{
+				UI: mockUi,
+			}
+
+			args := []string{
+				"--name", testCase.name,
+				"--package", "scaffold",
+				"--output-dir", testOutputDir,
+			}
+
+			exitCode := c.Run(append(args, testCase.args...))
+
+			if testCase.expectError {
+				if exitCode == 0 {
+					t.Fatal("expected error running `scaffold function` cmd")
+				}
+
+				return
+			}
+
+			if exitCode != 0 {
+				t.Fatalf("unexpected error running `scaffold function` cmd: %s", mockUi.ErrorWriter.String())
+			}
+
+			compareDirectories(t, testCase.goldenFileDir, testOutputDir)
+		})
+	}
+}
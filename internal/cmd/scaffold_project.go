@@ -0,0 +1,145 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package cmd
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/hashicorp/cli"
+
+	"github.com/hashicorp/terraform-plugin-codegen-framework/internal/output"
+	"github.com/hashicorp/terraform-plugin-codegen-framework/internal/scaffold"
+	"github.com/hashicorp/terraform-plugin-codegen-framework/internal/schema"
+)
+
+type ScaffoldProjectCommand struct {
+	UI                    cli.Ui
+	flagProviderNameSnake string
+	flagModulePath        string
+	flagAddress           string
+	flagOutputDir         string
+	flagForceOverwrite    bool
+}
+
+func (cmd *ScaffoldProjectCommand) Flags() *flag.FlagSet {
+	fs := flag.NewFlagSet("scaffold project", flag.ExitOnError)
+
+	fs.StringVar(&cmd.flagProviderNameSnake, "name", "", "name of provider in snake case, required")
+	fs.StringVar(&cmd.flagModulePath, "module", "", "Go module path of provider project, required")
+	fs.StringVar(&cmd.flagAddress, "address", "", "registry address of provider, default is derived from the module path")
+	fs.BoolVar(&cmd.flagForceOverwrite, "force", false, "force creation, overwriting existing files")
+	fs.StringVar(&cmd.flagOutputDir, "output-dir", ".", "directory path to output scaffolded project")
+	return fs
+}
+
+func (cmd *ScaffoldProjectCommand) Help() string {
+	strBuilder := &strings.Builder{}
+
+	longestName := 0
+	longestUsage := 0
+	cmd.Flags().VisitAll(func(f *flag.Flag) {
+		if len(f.Name) > longestName {
+			longestName = len(f.Name)
+		}
+		if len(f.Usage) > longestUsage {
+			longestUsage = len(f.Usage)
+		}
+	})
+
+	strBuilder.WriteString("\nUsage: tfplugingen-framework scaffold project [<args>]\n\n")
+	cmd.Flags().VisitAll(func(f *flag.Flag) {
+		if f.DefValue != "" {
+			strBuilder.WriteString(fmt.Sprintf("    --%s <ARG> %s%s%s  (default: %q)\n",
+				f.Name,
+				strings.Repeat(" ", longestName-len(f.Name)+2),
+				f.Usage,
+				strings.Repeat(" ", longestUsage-len(f.Usage)+2),
+				f.DefValue,
+			))
+		} else {
+			strBuilder.WriteString(fmt.Sprintf("    --%s <ARG> %s%s%s\n",
+				f.Name,
+				strings.Repeat(" ", longestName-len(f.Name)+2),
+				f.Usage,
+				strings.Repeat(" ", longestUsage-len(f.Usage)+2),
+			))
+		}
+	})
+	strBuilder.WriteString("\n")
+
+	return strBuilder.String()
+}
+
+func (a *ScaffoldProjectCommand) Synopsis() string {
+	return "Create scaffolding for a new Terraform Plugin Framework provider project."
+}
+
+func (cmd *ScaffoldProjectCommand) Run(args []string) int {
+	ctx := context.Background()
+
+	fs := cmd.Flags()
+	err := fs.Parse(args)
+	if err != nil {
+		cmd.UI.Error(fmt.Sprintf("error parsing command flags: %s", err))
+		return 1
+	}
+
+	err = cmd.runInternal(ctx)
+	if err != nil {
+		cmd.UI.Error(fmt.Sprintf("Error executing command: %s\n", err))
+		return 1
+	}
+
+	return 0
+}
+
+func (cmd *ScaffoldProjectCommand) runInternal(ctx context.Context) error {
+	if cmd.flagProviderNameSnake == "" {
+		return errors.New("--name flag is required")
+	}
+
+	if cmd.flagModulePath == "" {
+		return errors.New("--module flag is required")
+	}
+
+	providerIdentifier := schema.FrameworkIdentifier(cmd.flagProviderNameSnake)
+	if !providerIdentifier.Valid() {
+		return fmt.Errorf("'%s' is not a valid Terraform provider identifier", cmd.flagProviderNameSnake)
+	}
+
+	files, err := scaffold.Project(ctx, providerIdentifier, cmd.flagModulePath, cmd.flagAddress)
+	if err != nil {
+		return fmt.Errorf("error creating scaffolding project: %w", err)
+	}
+
+	// check for existing files before writing, so that a project is not
+	// partially overwritten
+	if !cmd.flagForceOverwrite {
+		for _, k := range sortedKeys(files) {
+			outputFilePath := filepath.Join(cmd.flagOutputDir, filepath.FromSlash(k))
+
+			if _, err := os.Stat(outputFilePath); !errors.Is(err, fs.ErrNotExist) {
+				return fmt.Errorf("file (%s) already exists and --force is false", outputFilePath)
+			}
+		}
+	}
+
+	writeFile := output.FileWriter(cmd.flagOutputDir)
+
+	for _, k := range sortedKeys(files) {
+		err = writeFile(k, files[k])
+		if err != nil {
+			return fmt.Errorf("error writing scaffolding project: %w", err)
+		}
+	}
+
+	return nil
+}
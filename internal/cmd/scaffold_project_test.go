@@ -0,0 +1,88 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package cmd_test
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/cli"
+
+	"github.com/hashicorp/terraform-plugin-codegen-framework/internal/cmd"
+)
+
+func TestScaffoldProjectCommand(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		goldenFileDir string
+	}{
+		"project scaffold": {
+			goldenFileDir: "testdata/scaffold/project",
+		},
+	}
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			testOutputDir := t.TempDir()
+			mockUi := cli.NewMockUi()
+			c := cmd.ScaffoldProjectCommand{
+				UI: mockUi,
+			}
+
+			exitCode := c.Run([]string{
+				"--name", "ourco",
+				"--module", "github.com/ourco/terraform-provider-ourco",
+				"--output-dir", testOutputDir,
+			})
+			if exitCode != 0 {
+				t.Fatalf("unexpected error running `scaffold project` cmd: %s", mockUi.ErrorWriter.String())
+			}
+
+			compareDirectories(t, testCase.goldenFileDir, testOutputDir)
+		})
+	}
+}
+
+func TestScaffoldProjectCommand_Existing(t *testing.T) {
+	t.Parallel()
+
+	testOutputDir := t.TempDir()
+
+	err := os.WriteFile(filepath.Join(testOutputDir, "go.mod"), []byte("module example.com/existing\n"), 0666)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	mockUi := cli.NewMockUi()
+	c := cmd.ScaffoldProjectCommand{
+		UI: mockUi,
+	}
+
+	exitCode := c.Run([]string{
+		"--name", "ourco",
+		"--module", "github.com/ourco/terraform-provider-ourco",
+		"--output-dir", testOutputDir,
+	})
+	if exitCode != 1 {
+		t.Fatalf("expected exit code 1, got: %d", exitCode)
+	}
+
+	if !strings.Contains(mockUi.ErrorWriter.String(), "already exists and --force is false") {
+		t.Errorf("unexpected error: %s", mockUi.ErrorWriter.String())
+	}
+
+	entries, err := os.ReadDir(testOutputDir)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if len(entries) != 1 {
+		t.Errorf("expected only existing go.mod, got %d entries", len(entries))
+	}
+}
@@ -26,6 +26,8 @@ type ScaffoldProviderCommand struct {
 	flagOutputFile        string
 	flagPackageName       string
 	flagForceOverwrite    bool
+	flagTemplatePath      string
+	flagConfigPath        string
 }
 
 func (cmd *ScaffoldProviderCommand) Flags() *flag.FlagSet {
@@ -36,6 +38,8 @@ func (cmd *ScaffoldProviderCommand) Flags() *flag.FlagSet {
 	fs.StringVar(&cmd.flagOutputDir, "output-dir", ".", "directory path to output scaffolded code file")
 	fs.StringVar(&cmd.flagOutputFile, "output-file", "", "file name and extension to write scaffolded code to, default is 'provider.go'")
 	fs.StringVar(&cmd.flagPackageName, "package", "provider", "name of Go package for scaffolded code file")
+	fs.StringVar(&cmd.flagTemplatePath, "template", "", "path to Go template file to use in place of the built-in template")
+	fs.StringVar(&cmd.flagConfigPath, "config", "", "path to generator configuration (JSON), to case names with its naming settings")
 	return fs
 }
 
@@ -110,7 +114,14 @@ func (cmd *ScaffoldProviderCommand) runInternal(_ context.Context) error {
 		return fmt.Errorf("'%s' is not a valid Terraform provider identifier", cmd.flagProviderNameSnake)
 	}
 
-	goBytes, err := scaffold.ProviderBytes(providerIdentifier, cmd.flagPackageName)
+	data, text, err := scaffoldTemplate(providerIdentifier, cmd.flagPackageName, cmd.flagOutputDir, cmd.flagTemplatePath, cmd.flagConfigPath)
+	if err != nil {
+		return err
+	}
+
+	data.ProviderName = cmd.flagProviderNameSnake
+
+	goBytes, err := scaffold.ProviderBytes(data, text)
 	if err != nil {
 		return fmt.Errorf("error creating scaffolding provider Go code: %w", err)
 	}
@@ -14,11 +14,19 @@ func TestScaffoldProviderCommand(t *testing.T) {
 	t.Parallel()
 
 	testCases := map[string]struct {
+		name          string
+		templatePath  string
 		goldenFileDir string
 	}{
 		"provider scaffold": {
+			name:          "examplecloud",
 			goldenFileDir: "testdata/scaffold/provider",
 		},
+		"custom template": {
+			name:          "examplecloud",
+			templatePath:  "testdata/scaffold/templates/provider.gotmpl",
+			goldenFileDir: "testdata/scaffold/custom_template/provider",
+		},
 	}
 	for name, testCase := range testCases {
 
@@ -32,11 +40,15 @@ func TestScaffoldProviderCommand(t *testing.T) {
 			}
 
 			args := []string{
-				"--name", "examplecloud",
+				"--name", testCase.name,
 				"--package", "scaffold",
 				"--output-dir", testOutputDir,
 			}
 
+			if testCase.templatePath != "" {
+				args = append(args, "--template", testCase.templatePath)
+			}
+
 			exitCode := c.Run(args)
 			if exitCode != 0 {
 				t.Fatalf("unexpected error running `scaffold provider` cmd: %s", mockUi.ErrorWriter.String())
@@ -10,13 +10,18 @@ import (
 	"fmt"
 	"go/format"
 	"path/filepath"
+	"slices"
 	"strings"
 
 	"github.com/hashicorp/cli"
+	"github.com/hashicorp/terraform-plugin-codegen-spec/resource"
+	"github.com/hashicorp/terraform-plugin-codegen-spec/spec"
 
+	"github.com/hashicorp/terraform-plugin-codegen-framework/internal/input"
 	"github.com/hashicorp/terraform-plugin-codegen-framework/internal/output"
 	"github.com/hashicorp/terraform-plugin-codegen-framework/internal/scaffold"
 	"github.com/hashicorp/terraform-plugin-codegen-framework/internal/schema"
+	"github.com/hashicorp/terraform-plugin-codegen-framework/internal/validate"
 )
 
 type ScaffoldResourceCommand struct {
@@ -26,6 +31,12 @@ type ScaffoldResourceCommand struct {
 	flagOutputFile        string
 	flagPackageName       string
 	flagForceOverwrite    bool
+	flagSpecPath          string
+	flagGeneratedDir      string
+	flagProviderName      string
+	flagTemplatePath      string
+	flagConfigPath        string
+	flagRegister          bool
 }
 
 func (cmd *ScaffoldResourceCommand) Flags() *flag.FlagSet {
@@ -36,6 +47,12 @@ func (cmd *ScaffoldResourceCommand) Flags() *flag.FlagSet {
 	fs.StringVar(&cmd.flagOutputDir, "output-dir", ".", "directory path to output scaffolded code file")
 	fs.StringVar(&cmd.flagOutputFile, "output-file", "", "file name and extension to write scaffolded code to, default will use the --name flag with '_resource.go' suffix")
 	fs.StringVar(&cmd.flagPackageName, "package", "provider", "name of Go package for scaffolded code file")
+	fs.StringVar(&cmd.flagSpecPath, "spec", "", "path to specification (JSON, or YAML with a .yaml or .yml extension) containing the resource, to use its generated schema and data model")
+	fs.StringVar(&cmd.flagProviderName, "provider-name", "", "name of provider, available to templates, defaults to the provider in the --spec")
+	fs.StringVar(&cmd.flagTemplatePath, "template", "", "path to Go template file to use in place of the built-in template")
+	fs.StringVar(&cmd.flagConfigPath, "config", "", "path to generator configuration (JSON), to case names with its naming settings")
+	fs.BoolVar(&cmd.flagRegister, "register", false, "add constructor to the Resources method of the provider in the output directory")
+	fs.StringVar(&cmd.flagGeneratedDir, "generated-dir", "./output", "directory path which code for the --spec was generated into")
 	return fs
 }
 
@@ -100,7 +117,7 @@ func (cmd *ScaffoldResourceCommand) Run(args []string) int {
 	return 0
 }
 
-func (cmd *ScaffoldResourceCommand) runInternal(_ context.Context) error {
+func (cmd *ScaffoldResourceCommand) runInternal(ctx context.Context) error {
 	if cmd.flagResourceNameSnake == "" {
 		return errors.New("--name flag is required")
 	}
@@ -110,7 +127,21 @@ func (cmd *ScaffoldResourceCommand) runInternal(_ context.Context) error {
 		return fmt.Errorf("'%s' is not a valid Terraform resource identifier", cmd.flagResourceNameSnake)
 	}
 
-	goBytes, err := scaffold.ResourceBytes(resourceIdentifier, cmd.flagPackageName)
+	data, text, err := scaffoldTemplate(resourceIdentifier, cmd.flagPackageName, cmd.flagOutputDir, cmd.flagTemplatePath, cmd.flagConfigPath)
+	if err != nil {
+		return err
+	}
+
+	data.ProviderName = cmd.flagProviderName
+
+	if cmd.flagSpecPath != "" {
+		err = cmd.setGenerated(ctx, &data)
+		if err != nil {
+			return err
+		}
+	}
+
+	goBytes, err := scaffold.ResourceBytes(data, text)
 	if err != nil {
 		return fmt.Errorf("error creating scaffolding resource Go code: %w", err)
 	}
@@ -125,6 +156,58 @@ func (cmd *ScaffoldResourceCommand) runInternal(_ context.Context) error {
 		return fmt.Errorf("error writing scaffolding resource Go code: %w", err)
 	}
 
+	if cmd.flagRegister {
+		registerConstructor(cmd.UI, scaffold.RegisterResource, cmd.flagOutputDir, "New"+data.NamePascal+"Resource")
+	}
+
+	return nil
+}
+
+// setGenerated sets the generated package of the resource in the --spec in the
+// template data, and the provider name if not set by the --provider-name flag.
+func (cmd *ScaffoldResourceCommand) setGenerated(ctx context.Context, data *scaffold.TemplateData) error {
+	ir, err := input.ReadSpecification(cmd.flagSpecPath, "")
+	if err != nil {
+		return fmt.Errorf("error reading IR JSON: %w", err)
+	}
+
+	err = validate.JSON(ir.JSON)
+	if err != nil {
+		return fmt.Errorf("error validating IR JSON: %w", err)
+	}
+
+	s, err := spec.Parse(ctx, ir.JSON)
+	if err != nil {
+		return fmt.Errorf("error parsing IR JSON: %w", ir.Error(err))
+	}
+
+	if !slices.ContainsFunc(s.Resources, func(r resource.Resource) bool { return r.Name == cmd.flagResourceNameSnake }) {
+		return fmt.Errorf("resource %q not found in specification", cmd.flagResourceNameSnake)
+	}
+
+	generated, err := scaffold.FindResourcePackage(cmd.flagGeneratedDir, cmd.flagResourceNameSnake)
+	if err != nil {
+		return fmt.Errorf("error finding generated resource package: %w", err)
+	}
+
+	outputDir, err := filepath.Abs(cmd.flagOutputDir)
+	if err != nil {
+		return err
+	}
+
+	// scaffolding into the generated package requires no import, and the
+	// package name must match
+	if outputDir == generated.Dir {
+		data.PackageName = generated.Name
+		generated.ImportPath = ""
+	}
+
+	data.SetGenerated(generated)
+
+	if data.ProviderName == "" && s.Provider != nil {
+		data.ProviderName = s.Provider.Name
+	}
+
 	return nil
 }
 
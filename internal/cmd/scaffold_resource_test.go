@@ -4,9 +4,14 @@
 package cmd_test
 
 import (
+	"os"
+	"path/filepath"
+	"strings"
 	"testing"
 
+	"github.com/google/go-cmp/cmp"
 	"github.com/hashicorp/cli"
+
 	"github.com/hashicorp/terraform-plugin-codegen-framework/internal/cmd"
 )
 
@@ -14,11 +19,19 @@ func TestScaffoldResourceCommand(t *testing.T) {
 	t.Parallel()
 
 	testCases := map[string]struct {
+		name          string
+		templatePath  string
 		goldenFileDir string
 	}{
 		"resource scaffold": {
+			name:          "thing",
 			goldenFileDir: "testdata/scaffold/resource",
 		},
+		"custom template": {
+			name:          "my_thing",
+			templatePath:  "testdata/scaffold/templates/resource.gotmpl",
+			goldenFileDir: "testdata/scaffold/custom_template/resource",
+		},
 	}
 	for name, testCase := range testCases {
 
@@ -32,11 +45,15 @@ func TestScaffoldResourceCommand(t *testing.T) {
 			}
 
 			args := []string{
-				"--name", "thing",
+				"--name", testCase.name,
 				"--package", "scaffold",
 				"--output-dir", testOutputDir,
 			}
 
+			if testCase.templatePath != "" {
+				args = append(args, "--template", testCase.templatePath)
+			}
+
 			exitCode := c.Run(args)
 			if exitCode != 0 {
 				t.Fatalf("unexpected error running `scaffold resource` cmd: %s", mockUi.ErrorWriter.String())
@@ -46,3 +63,199 @@ func TestScaffoldResourceCommand(t *testing.T) {
 		})
 	}
 }
+
+func TestScaffoldResourceCommand_Spec(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		name          string
+		generatedDir  string
+		goldenFileDir string
+		expectError   bool
+	}{
+		// No code has been generated, so the package generated for each
+		// resource without --package is assumed.
+		"default_layout": {
+			name:          "thing",
+			generatedDir:  "testdata/scaffold/resource_spec/project/internal/generated",
+			goldenFileDir: "testdata/scaffold/resource_spec/default_layout",
+		},
+		// Code generated with --package, where the package name differs from
+		// the directory name.
+		"package_layout": {
+			name:          "thing",
+			generatedDir:  "testdata/scaffold/resource_spec/project/internal/provider",
+			goldenFileDir: "testdata/scaffold/resource_spec/package_layout",
+		},
+		"resource_not_found": {
+			name:         "other",
+			generatedDir: "testdata/scaffold/resource_spec/project/internal/provider",
+			expectError:  true,
+		},
+	}
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			testOutputDir := t.TempDir()
+			mockUi := cli.NewMockUi()
+			c := cmd.ScaffoldResourceCommand{
+				UI: mockUi,
+			}
+
+			args := []string{
+				"--name", testCase.name,
+				"--spec", "testdata/scaffold/resource_spec/spec.json",
+				"--generated-dir", testCase.generatedDir,
+				"--output-dir", testOutputDir,
+			}
+
+			exitCode := c.Run(args)
+			if testCase.expectError {
+				if exitCode == 0 {
+					t.Fatal("expected error running `scaffold resource` cmd")
+				}
+
+				return
+			}
+
+			if exitCode != 0 {
+				t.Fatalf("unexpected error running `scaffold resource` cmd: %s", mockUi.ErrorWriter.String())
+			}
+
+			compareDirectories(t, testCase.goldenFileDir, testOutputDir)
+		})
+	}
+}
+
+func TestScaffoldResourceCommand_Register(t *testing.T) {
+	t.Parallel()
+
+	testOutputDir := t.TempDir()
+
+	provider, err := os.ReadFile("testdata/scaffold/provider/provider.go")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	err = os.WriteFile(filepath.Join(testOutputDir, "provider.go"), provider, 0666)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	mockUi := cli.NewMockUi()
+	c := cmd.ScaffoldResourceCommand{
+		UI: mockUi,
+	}
+
+	exitCode := c.Run([]string{
+		"--name", "thing",
+		"--package", "scaffold",
+		"--output-dir", testOutputDir,
+		"--register",
+	})
+	if exitCode != 0 {
+		t.Fatalf("unexpected error running `scaffold resource` cmd: %s", mockUi.ErrorWriter.String())
+	}
+
+	if !strings.Contains(mockUi.OutputWriter.String(), "Registered NewThingResource") {
+		t.Errorf("unexpected output: %s", mockUi.OutputWriter.String())
+	}
+
+	compareDirectories(t, "testdata/scaffold/register/resource", testOutputDir)
+}
+
+func TestScaffoldResourceCommand_RegisterDisabled(t *testing.T) {
+	t.Parallel()
+
+	testOutputDir := t.TempDir()
+
+	provider, err := os.ReadFile("testdata/scaffold/provider/provider.go")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	err = os.WriteFile(filepath.Join(testOutputDir, "provider.go"), provider, 0666)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	mockUi := cli.NewMockUi()
+	c := cmd.ScaffoldResourceCommand{
+		UI: mockUi,
+	}
+
+	exitCode := c.Run([]string{
+		"--name", "thing",
+		"--package", "scaffold",
+		"--output-dir", testOutputDir,
+	})
+	if exitCode != 0 {
+		t.Fatalf("unexpected error running `scaffold resource` cmd: %s", mockUi.ErrorWriter.String())
+	}
+
+	got, err := os.ReadFile(filepath.Join(testOutputDir, "provider.go"))
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if diff := cmp.Diff(string(got), string(provider)); diff != "" {
+		t.Errorf("unexpected difference: %s", diff)
+	}
+}
+
+// TestScaffoldResourceCommand_Initialisms compiles a resource scaffolded with
+// the --config used to generate its schema and data model with initialisms
+// enabled (e.g., VPCIDResourceSchema and VPCIDModel).
+func TestScaffoldResourceCommand_Initialisms(t *testing.T) {
+	t.Parallel()
+
+	moduleDir := t.TempDir()
+	generatedDir := filepath.Join(moduleDir, "internal", "generated")
+	outputDir := filepath.Join(moduleDir, "internal", "provider")
+
+	err := os.WriteFile(filepath.Join(moduleDir, "go.mod"), []byte("module example.com\n"), 0644)
+	if err != nil {
+		t.Fatalf("unexpected error writing go.mod: %s", err)
+	}
+
+	err = os.MkdirAll(outputDir, 0755)
+	if err != nil {
+		t.Fatalf("unexpected error creating output directory: %s", err)
+	}
+
+	mockUi := cli.NewMockUi()
+	generate := cmd.GenerateResourcesCommand{
+		UI: mockUi,
+	}
+
+	exitCode := generate.Run([]string{
+		"--input", "testdata/scaffold/resource_initialisms/spec.json",
+		"--config", "testdata/scaffold/resource_initialisms/config.json",
+		"--package", "generated",
+		"--output", generatedDir,
+	})
+	if exitCode != 0 {
+		t.Fatalf("unexpected error running `generate resources` cmd: %s", mockUi.ErrorWriter.String())
+	}
+
+	c := cmd.ScaffoldResourceCommand{
+		UI: mockUi,
+	}
+
+	exitCode = c.Run([]string{
+		"--name", "vpc_id",
+		"--spec", "testdata/scaffold/resource_initialisms/spec.json",
+		"--config", "testdata/scaffold/resource_initialisms/config.json",
+		"--generated-dir", generatedDir,
+		"--output-dir", outputDir,
+	})
+	if exitCode != 0 {
+		t.Fatalf("unexpected error running `scaffold resource` cmd: %s", mockUi.ErrorWriter.String())
+	}
+
+	compareDirectories(t, "testdata/scaffold/resource_initialisms/output", outputDir)
+
+	runGoTest(t, moduleDir)
+}
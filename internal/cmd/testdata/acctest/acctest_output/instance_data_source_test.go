@@ -0,0 +1,49 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+)
+
+func TestAccInstanceDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		// Verify that the environment required by the acceptance tests, such
+		// as API credentials, is configured.
+		PreCheck: func() { testAccPreCheck(t) },
+
+		// Map of provider names to provider servers, such as
+		// providerserver.NewProtocol6WithError(New("test")()).
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: testAccInstanceDataSourceConfig(),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"data.example_instance.test",
+						tfjsonpath.New("name"),
+						knownvalue.StringExact("example"),
+					),
+					statecheck.ExpectKnownValue(
+						"data.example_instance.test",
+						tfjsonpath.New("size"),
+						knownvalue.NotNull(),
+					),
+				},
+			},
+		},
+	})
+}
+
+func testAccInstanceDataSourceConfig() string {
+	return `
+data "example_instance" "test" {
+  name = "example"
+}
+`
+}
@@ -0,0 +1,94 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+)
+
+func TestAccInstanceResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		// Verify that the environment required by the acceptance tests, such
+		// as API credentials, is configured.
+		PreCheck: func() { testAccPreCheck(t) },
+
+		// Map of provider names to provider servers, such as
+		// providerserver.NewProtocol6WithError(New("test")()).
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccInstanceResourceConfig(),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"example_instance.test",
+						tfjsonpath.New("cpu_count"),
+						knownvalue.Int64Exact(1),
+					),
+					statecheck.ExpectKnownValue(
+						"example_instance.test",
+						tfjsonpath.New("id"),
+						knownvalue.NotNull(),
+					),
+					statecheck.ExpectKnownValue(
+						"example_instance.test",
+						tfjsonpath.New("monitoring"),
+						knownvalue.Bool(false),
+					),
+					statecheck.ExpectKnownValue(
+						"example_instance.test",
+						tfjsonpath.New("name"),
+						knownvalue.StringExact("example"),
+					),
+					statecheck.ExpectKnownValue(
+						"example_instance.test",
+						tfjsonpath.New("network_interface"),
+						knownvalue.NotNull(),
+					),
+					statecheck.ExpectKnownValue(
+						"example_instance.test",
+						tfjsonpath.New("size"),
+						knownvalue.StringExact("small"),
+					),
+					statecheck.ExpectKnownValue(
+						"example_instance.test",
+						tfjsonpath.New("weight"),
+						knownvalue.Float64Exact(0.5),
+					),
+					statecheck.ExpectKnownValue(
+						"example_instance.test",
+						tfjsonpath.New("zones"),
+						knownvalue.NotNull(),
+					),
+				},
+			},
+			// ImportState testing
+			{
+				ResourceName:      "example_instance.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccInstanceResourceConfig() string {
+	return `
+resource "example_instance" "test" {
+  cpu_count = 1
+  name      = "example"
+
+  network_interface = [
+    {
+      subnet_id = "example"
+    },
+  ]
+
+  zones = ["example"]
+}
+`
+}
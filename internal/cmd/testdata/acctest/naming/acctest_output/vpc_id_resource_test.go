@@ -0,0 +1,55 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+)
+
+func TestAccVPCIDResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		// Verify that the environment required by the acceptance tests, such
+		// as API credentials, is configured.
+		PreCheck: func() { testAccPreCheck(t) },
+
+		// Map of provider names to provider servers, such as
+		// providerserver.NewProtocol6WithError(New("test")()).
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccVPCIDResourceConfig(),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"example_vpc_id.test",
+						tfjsonpath.New("cidr_block"),
+						knownvalue.StringExact("example"),
+					),
+					statecheck.ExpectKnownValue(
+						"example_vpc_id.test",
+						tfjsonpath.New("id"),
+						knownvalue.NotNull(),
+					),
+				},
+			},
+			// ImportState testing
+			{
+				ResourceName:      "example_vpc_id.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccVPCIDResourceConfig() string {
+	return `
+resource "example_vpc_id" "test" {
+  cidr_block = "example"
+}
+`
+}
@@ -0,0 +1,10 @@
+// Package apisdk is an API SDK used by the generated CRUD methods.
+package apisdk
+
+type Example struct {
+	Id       *string
+	Name     *string
+	Settings struct {
+		Mode *string
+	}
+}
@@ -0,0 +1,116 @@
+package generated
+
+import (
+	"context"
+	"testing"
+
+	"example.com/apisdk"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// client records the input of each call, and returns it with the id set.
+type client struct {
+	in *apisdk.Example
+}
+
+func (c *client) call(in *apisdk.Example) (*apisdk.Example, error) {
+	c.in = in
+
+	out := *in
+	id := "example-id"
+	out.Id = &id
+
+	return &out, nil
+}
+
+func (c *client) CreateExample(ctx context.Context, in *apisdk.Example) (*apisdk.Example, error) {
+	return c.call(in)
+}
+
+func (c *client) ReadExample(ctx context.Context, in *apisdk.Example) (*apisdk.Example, error) {
+	return c.call(in)
+}
+
+func (c *client) UpdateExample(ctx context.Context, in *apisdk.Example) (*apisdk.Example, error) {
+	return c.call(in)
+}
+
+func (c *client) DeleteExample(ctx context.Context, in *apisdk.Example) error {
+	c.in = in
+
+	return nil
+}
+
+func TestExampleCRUD_Create(t *testing.T) {
+	ctx := context.Background()
+	s := ExampleResourceSchema(ctx)
+	settingsTypes := map[string]attr.Type{"mode": types.StringType}
+
+	testCases := map[string]types.Object{
+		"null":    types.ObjectNull(settingsTypes),
+		"unknown": types.ObjectUnknown(settingsTypes),
+	}
+
+	for name, settings := range testCases {
+		t.Run(name, func(t *testing.T) {
+			plan := tfsdk.Plan{
+				Schema: s,
+				Raw:    tftypes.NewValue(s.Type().TerraformType(ctx), nil),
+			}
+
+			diags := plan.Set(ctx, ExampleModel{
+				Id:       types.StringUnknown(),
+				Name:     types.StringValue("example"),
+				Settings: settings,
+			})
+
+			if diags.HasError() {
+				t.Fatalf("unexpected error setting plan: %v", diags)
+			}
+
+			c := &client{}
+			r := &ExampleCRUD{Client: c}
+
+			resp := &resource.CreateResponse{
+				State: tfsdk.State{
+					Schema: s,
+					Raw:    tftypes.NewValue(s.Type().TerraformType(ctx), nil),
+				},
+			}
+
+			r.Create(ctx, resource.CreateRequest{Plan: plan}, resp)
+
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("unexpected error creating: %v", resp.Diagnostics)
+			}
+
+			if c.in.Id != nil {
+				t.Errorf("expected unknown id to be unset, got %q", *c.in.Id)
+			}
+
+			if c.in.Settings.Mode != nil {
+				t.Errorf("expected %s settings to be unset, got %q", name, *c.in.Settings.Mode)
+			}
+
+			var got ExampleModel
+
+			diags = resp.State.Get(ctx, &got)
+
+			if diags.HasError() {
+				t.Fatalf("unexpected error getting state: %v", diags)
+			}
+
+			if got.Id.ValueString() != "example-id" {
+				t.Errorf("expected id example-id, got %s", got.Id)
+			}
+
+			if !got.Settings.IsNull() {
+				t.Errorf("expected null settings, got %s", got.Settings)
+			}
+		})
+	}
+}
@@ -0,0 +1,113 @@
+// Code generated by terraform-plugin-framework-generator DO NOT EDIT.
+
+package generated
+
+import (
+	"context"
+	"example.com/apisdk"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+)
+
+func ExampleResourceSchema(ctx context.Context) schema.Schema {
+	return schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+			},
+			"name": schema.StringAttribute{
+				Required: true,
+			},
+			"settings": schema.ObjectAttribute{
+				AttributeTypes: map[string]attr.Type{
+					"mode": types.StringType,
+				},
+				Optional: true,
+				Computed: true,
+			},
+		},
+	}
+}
+
+type ExampleModel struct {
+	Id       types.String `tfsdk:"id"`
+	Name     types.String `tfsdk:"name"`
+	Settings types.Object `tfsdk:"settings"`
+}
+
+func (m ExampleModel) ToApisdkExample(ctx context.Context) (*apisdk.Example, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	var settingsField struct {
+		Mode *string
+	}
+
+	if !m.Settings.IsNull() && !m.Settings.IsUnknown() {
+		settingsAttributes := m.Settings.Attributes()
+
+		settingsFieldMode, ok := settingsAttributes["mode"].(types.String)
+
+		if !ok {
+			diags.Append(diag.NewErrorDiagnostic(
+				"Settings Field mode Is Wrong Type",
+				fmt.Sprintf(`Settings field mode expected to be types.String, was: %T`, settingsAttributes["mode"]),
+			))
+
+			return nil, diags
+		}
+
+		settingsField.Mode = settingsFieldMode.ValueStringPointer()
+	}
+
+	return &apisdk.Example{
+		Id:       m.Id.ValueStringPointer(),
+		Name:     m.Name.ValueStringPointer(),
+		Settings: settingsField,
+	}, diags
+}
+
+func ExampleModelFromApisdkExample(ctx context.Context, apiObject *apisdk.Example) (ExampleModel, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if apiObject == nil {
+		diags.Append(diag.NewErrorDiagnostic(
+			"ExampleModel From ApisdkExample Is Nil",
+			`"*apisdk.Example" is nil.`,
+		))
+
+		return ExampleModel{}, diags
+	}
+
+	settingsAttributeTypes := map[string]attr.Type{
+		"mode": types.StringType,
+	}
+
+	settingsVal := types.ObjectNull(settingsAttributeTypes)
+
+	if apiObject.Settings != (struct {
+		Mode *string
+	}{}) {
+		o, d := basetypes.NewObjectValue(settingsAttributeTypes, map[string]attr.Value{
+			"mode": types.StringPointerValue(apiObject.Settings.Mode),
+		})
+
+		diags.Append(d...)
+
+		if diags.HasError() {
+			return ExampleModel{}, diags
+		}
+
+		settingsVal = o
+	}
+
+	return ExampleModel{
+		Id:       types.StringPointerValue(apiObject.Id),
+		Name:     types.StringPointerValue(apiObject.Name),
+		Settings: settingsVal,
+	}, diags
+}
@@ -0,0 +1,3 @@
+data "example_instance" "example" {
+  name = "example"
+}
@@ -0,0 +1,7 @@
+provider "example" {
+  token = "example"
+
+  # endpoint = "example"
+
+  # retry {}
+}
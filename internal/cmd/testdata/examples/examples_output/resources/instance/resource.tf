@@ -0,0 +1,37 @@
+resource "example_instance" "example" {
+  cpu_count = 1
+  name      = "example"
+
+  network_interface = [
+    {
+      subnet_id = "example"
+
+      # primary = true
+    },
+  ]
+
+  zones = ["example"]
+
+  # labels = {
+  #   key = {
+  #     value = "example"
+  #   }
+  # }
+
+  # location   = { latitude = 1.5, longitude = 1.5 }
+  # monitoring = false
+
+  # security = {
+  #   group_ids = ["example"]
+  # }
+
+  # size   = "small"
+  # tags   = { key = "example" }
+  # weight = 0.5
+
+  # disk {
+  #   size_gb = 1
+  # }
+
+  # timeouts {}
+}
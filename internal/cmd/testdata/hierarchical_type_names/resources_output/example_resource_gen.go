@@ -0,0 +1,2640 @@
+// Code generated by terraform-plugin-framework-generator DO NOT EDIT.
+
+package generated
+
+import (
+	"context"
+	"example.com/apisdk"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+)
+
+func ExampleResourceSchema(ctx context.Context) schema.Schema {
+	return schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"compute": schema.SingleNestedAttribute{
+				Attributes: map[string]schema.Attribute{
+					"region": schema.StringAttribute{
+						CustomType: ComputeRegionType{},
+						Optional:   true,
+					},
+				},
+				CustomType: ComputeType{
+					ObjectType: types.ObjectType{
+						AttrTypes: ComputeValue{}.AttributeTypes(ctx),
+					},
+				},
+				Optional: true,
+			},
+			"network": schema.SingleNestedAttribute{
+				Attributes: map[string]schema.Attribute{
+					"config": schema.ListNestedAttribute{
+						NestedObject: schema.NestedAttributeObject{
+							Attributes: map[string]schema.Attribute{
+								"name": schema.StringAttribute{
+									Optional: true,
+								},
+							},
+							CustomType: NetworkConfigType{
+								ObjectType: types.ObjectType{
+									AttrTypes: NetworkConfigValue{}.AttributeTypes(ctx),
+								},
+							},
+						},
+						Optional: true,
+					},
+				},
+				CustomType: NetworkType{
+					ObjectType: types.ObjectType{
+						AttrTypes: NetworkValue{}.AttributeTypes(ctx),
+					},
+				},
+				Optional: true,
+			},
+			"storage": schema.SingleNestedAttribute{
+				Attributes: map[string]schema.Attribute{
+					"config": schema.SingleNestedAttribute{
+						Attributes: map[string]schema.Attribute{
+							"size": schema.Int64Attribute{
+								Optional: true,
+							},
+						},
+						CustomType: StorageConfigType{
+							ObjectType: types.ObjectType{
+								AttrTypes: StorageConfigValue{}.AttributeTypes(ctx),
+							},
+						},
+						Optional: true,
+					},
+				},
+				CustomType: StorageType{
+					ObjectType: types.ObjectType{
+						AttrTypes: StorageValue{}.AttributeTypes(ctx),
+					},
+				},
+				Optional: true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"logging": schema.SingleNestedBlock{
+				Blocks: map[string]schema.Block{
+					"config": schema.ListNestedBlock{
+						NestedObject: schema.NestedBlockObject{
+							Attributes: map[string]schema.Attribute{
+								"level": schema.StringAttribute{
+									Optional: true,
+								},
+							},
+							CustomType: LoggingConfigType{
+								ObjectType: types.ObjectType{
+									AttrTypes: LoggingConfigValue{}.AttributeTypes(ctx),
+								},
+							},
+						},
+					},
+				},
+				CustomType: LoggingType{
+					ObjectType: types.ObjectType{
+						AttrTypes: LoggingValue{}.AttributeTypes(ctx),
+					},
+				},
+			},
+		},
+	}
+}
+
+type ExampleModel struct {
+	Compute ComputeValue `tfsdk:"compute"`
+	Network NetworkValue `tfsdk:"network"`
+	Storage StorageValue `tfsdk:"storage"`
+	Logging LoggingValue `tfsdk:"logging"`
+}
+
+var _ basetypes.ObjectTypable = ComputeType{}
+
+type ComputeType struct {
+	basetypes.ObjectType
+}
+
+func (t ComputeType) Equal(o attr.Type) bool {
+	other, ok := o.(ComputeType)
+
+	if !ok {
+		return false
+	}
+
+	return t.ObjectType.Equal(other.ObjectType)
+}
+
+func (t ComputeType) String() string {
+	return "ComputeType"
+}
+
+func (t ComputeType) ValueFromObject(ctx context.Context, in basetypes.ObjectValue) (basetypes.ObjectValuable, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	attributes := in.Attributes()
+
+	regionAttribute, ok := attributes["region"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`region is missing from object`)
+
+		return nil, diags
+	}
+
+	regionVal, ok := regionAttribute.(ComputeRegionValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`region expected to be ComputeRegionValue, was: %T`, regionAttribute))
+	}
+
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	return ComputeValue{
+		Region: regionVal,
+		state:  attr.ValueStateKnown,
+	}, diags
+}
+
+func NewComputeValueNull() ComputeValue {
+	return ComputeValue{
+		state: attr.ValueStateNull,
+	}
+}
+
+func NewComputeValueUnknown() ComputeValue {
+	return ComputeValue{
+		state: attr.ValueStateUnknown,
+	}
+}
+
+func NewComputeValue(attributeTypes map[string]attr.Type, attributes map[string]attr.Value) (ComputeValue, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	// Reference: https://github.com/hashicorp/terraform-plugin-framework/issues/521
+	ctx := context.Background()
+
+	for name, attributeType := range attributeTypes {
+		attribute, ok := attributes[name]
+
+		if !ok {
+			diags.AddError(
+				"Missing ComputeValue Attribute Value",
+				"While creating a ComputeValue value, a missing attribute value was detected. "+
+					"A ComputeValue must contain values for all attributes, even if null or unknown. "+
+					"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+					fmt.Sprintf("ComputeValue Attribute Name (%s) Expected Type: %s", name, attributeType.String()),
+			)
+
+			continue
+		}
+
+		if !attributeType.Equal(attribute.Type(ctx)) {
+			diags.AddError(
+				"Invalid ComputeValue Attribute Type",
+				"While creating a ComputeValue value, an invalid attribute value was detected. "+
+					"A ComputeValue must use a matching attribute type for the value. "+
+					"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+					fmt.Sprintf("ComputeValue Attribute Name (%s) Expected Type: %s\n", name, attributeType.String())+
+					fmt.Sprintf("ComputeValue Attribute Name (%s) Given Type: %s", name, attribute.Type(ctx)),
+			)
+		}
+	}
+
+	for name := range attributes {
+		_, ok := attributeTypes[name]
+
+		if !ok {
+			diags.AddError(
+				"Extra ComputeValue Attribute Value",
+				"While creating a ComputeValue value, an extra attribute value was detected. "+
+					"A ComputeValue must not contain values beyond the expected attribute types. "+
+					"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+					fmt.Sprintf("Extra ComputeValue Attribute Name: %s", name),
+			)
+		}
+	}
+
+	if diags.HasError() {
+		return NewComputeValueUnknown(), diags
+	}
+
+	regionAttribute, ok := attributes["region"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`region is missing from object`)
+
+		return NewComputeValueUnknown(), diags
+	}
+
+	regionVal, ok := regionAttribute.(ComputeRegionValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`region expected to be ComputeRegionValue, was: %T`, regionAttribute))
+	}
+
+	if diags.HasError() {
+		return NewComputeValueUnknown(), diags
+	}
+
+	return ComputeValue{
+		Region: regionVal,
+		state:  attr.ValueStateKnown,
+	}, diags
+}
+
+func NewComputeValueMust(attributeTypes map[string]attr.Type, attributes map[string]attr.Value) ComputeValue {
+	object, diags := NewComputeValue(attributeTypes, attributes)
+
+	if diags.HasError() {
+		// This could potentially be added to the diag package.
+		diagsStrings := make([]string, 0, len(diags))
+
+		for _, diagnostic := range diags {
+			diagsStrings = append(diagsStrings, fmt.Sprintf(
+				"%s | %s | %s",
+				diagnostic.Severity(),
+				diagnostic.Summary(),
+				diagnostic.Detail()))
+		}
+
+		panic("NewComputeValueMust received error(s): " + strings.Join(diagsStrings, "\n"))
+	}
+
+	return object
+}
+
+func (t ComputeType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	if in.Type() == nil {
+		return NewComputeValueNull(), nil
+	}
+
+	if !in.Type().Equal(t.TerraformType(ctx)) {
+		return nil, fmt.Errorf("expected %s, got %s", t.TerraformType(ctx), in.Type())
+	}
+
+	if !in.IsKnown() {
+		return NewComputeValueUnknown(), nil
+	}
+
+	if in.IsNull() {
+		return NewComputeValueNull(), nil
+	}
+
+	attributes := map[string]attr.Value{}
+
+	val := map[string]tftypes.Value{}
+
+	err := in.As(&val)
+
+	if err != nil {
+		return nil, err
+	}
+
+	for k, v := range val {
+		a, err := t.AttrTypes[k].ValueFromTerraform(ctx, v)
+
+		if err != nil {
+			return nil, err
+		}
+
+		attributes[k] = a
+	}
+
+	return NewComputeValueMust(ComputeValue{}.AttributeTypes(ctx), attributes), nil
+}
+
+func (t ComputeType) ValueType(ctx context.Context) attr.Value {
+	return ComputeValue{}
+}
+
+var _ basetypes.ObjectValuable = ComputeValue{}
+
+type ComputeValue struct {
+	Region ComputeRegionValue `tfsdk:"region"`
+	state  attr.ValueState
+}
+
+func (v ComputeValue) ToTerraformValue(ctx context.Context) (tftypes.Value, error) {
+	attrTypes := make(map[string]tftypes.Type, 1)
+
+	var val tftypes.Value
+	var err error
+
+	attrTypes["region"] = ComputeRegionType{}.TerraformType(ctx)
+
+	objectType := tftypes.Object{AttributeTypes: attrTypes}
+
+	switch v.state {
+	case attr.ValueStateKnown:
+		vals := make(map[string]tftypes.Value, 1)
+
+		val, err = v.Region.ToTerraformValue(ctx)
+
+		if err != nil {
+			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
+		}
+
+		vals["region"] = val
+
+		if err := tftypes.ValidateValue(objectType, vals); err != nil {
+			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
+		}
+
+		return tftypes.NewValue(objectType, vals), nil
+	case attr.ValueStateNull:
+		return tftypes.NewValue(objectType, nil), nil
+	case attr.ValueStateUnknown:
+		return tftypes.NewValue(objectType, tftypes.UnknownValue), nil
+	default:
+		panic(fmt.Sprintf("unhandled Object state in ToTerraformValue: %s", v.state))
+	}
+}
+
+func (v ComputeValue) IsNull() bool {
+	return v.state == attr.ValueStateNull
+}
+
+func (v ComputeValue) IsUnknown() bool {
+	return v.state == attr.ValueStateUnknown
+}
+
+func (v ComputeValue) String() string {
+	return "ComputeValue"
+}
+
+func (v ComputeValue) ToObjectValue(ctx context.Context) (basetypes.ObjectValue, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	attributeTypes := map[string]attr.Type{
+		"region": ComputeRegionType{},
+	}
+
+	if v.IsNull() {
+		return types.ObjectNull(attributeTypes), diags
+	}
+
+	if v.IsUnknown() {
+		return types.ObjectUnknown(attributeTypes), diags
+	}
+
+	objVal, diags := types.ObjectValue(
+		attributeTypes,
+		map[string]attr.Value{
+			"region": v.Region,
+		})
+
+	return objVal, diags
+}
+
+func (v ComputeValue) Equal(o attr.Value) bool {
+	other, ok := o.(ComputeValue)
+
+	if !ok {
+		return false
+	}
+
+	if v.state != other.state {
+		return false
+	}
+
+	if v.state != attr.ValueStateKnown {
+		return true
+	}
+
+	if !v.Region.Equal(other.Region) {
+		return false
+	}
+
+	return true
+}
+
+func (v ComputeValue) Type(ctx context.Context) attr.Type {
+	return ComputeType{
+		basetypes.ObjectType{
+			AttrTypes: v.AttributeTypes(ctx),
+		},
+	}
+}
+
+func (v ComputeValue) AttributeTypes(ctx context.Context) map[string]attr.Type {
+	return map[string]attr.Type{
+		"region": ComputeRegionType{},
+	}
+}
+
+var _ basetypes.StringTypable = ComputeRegionType{}
+
+type ComputeRegionType struct {
+	basetypes.StringType
+}
+
+func (t ComputeRegionType) Equal(o attr.Type) bool {
+	other, ok := o.(ComputeRegionType)
+
+	if !ok {
+		return false
+	}
+
+	return t.StringType.Equal(other.StringType)
+}
+
+func (t ComputeRegionType) String() string {
+	return "ComputeRegionType"
+}
+
+func (t ComputeRegionType) ValueFromString(ctx context.Context, in basetypes.StringValue) (basetypes.StringValuable, diag.Diagnostics) {
+	return ComputeRegionValue{
+		StringValue: in,
+	}, nil
+}
+
+func (t ComputeRegionType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	attrValue, err := t.StringType.ValueFromTerraform(ctx, in)
+
+	if err != nil {
+		return nil, err
+	}
+
+	boolValue, ok := attrValue.(basetypes.StringValue)
+
+	if !ok {
+		return nil, fmt.Errorf("unexpected value type of %T", attrValue)
+	}
+
+	boolValuable, diags := t.ValueFromString(ctx, boolValue)
+
+	if diags.HasError() {
+		return nil, fmt.Errorf("unexpected error converting StringValue to StringValuable: %v", diags)
+	}
+
+	return boolValuable, nil
+}
+
+func (t ComputeRegionType) ValueType(ctx context.Context) attr.Value {
+	return ComputeRegionValue{}
+}
+
+var _ basetypes.StringValuable = ComputeRegionValue{}
+
+type ComputeRegionValue struct {
+	basetypes.StringValue
+}
+
+func (v ComputeRegionValue) Equal(o attr.Value) bool {
+	other, ok := o.(ComputeRegionValue)
+
+	if !ok {
+		return false
+	}
+
+	return v.StringValue.Equal(other.StringValue)
+}
+
+func (v ComputeRegionValue) Type(ctx context.Context) attr.Type {
+	return ComputeRegionType{}
+}
+
+var _ basetypes.ObjectTypable = NetworkType{}
+
+type NetworkType struct {
+	basetypes.ObjectType
+}
+
+func (t NetworkType) Equal(o attr.Type) bool {
+	other, ok := o.(NetworkType)
+
+	if !ok {
+		return false
+	}
+
+	return t.ObjectType.Equal(other.ObjectType)
+}
+
+func (t NetworkType) String() string {
+	return "NetworkType"
+}
+
+func (t NetworkType) ValueFromObject(ctx context.Context, in basetypes.ObjectValue) (basetypes.ObjectValuable, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	attributes := in.Attributes()
+
+	configAttribute, ok := attributes["config"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`config is missing from object`)
+
+		return nil, diags
+	}
+
+	configVal, ok := configAttribute.(basetypes.ListValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`config expected to be basetypes.ListValue, was: %T`, configAttribute))
+	}
+
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	return NetworkValue{
+		Config: configVal,
+		state:  attr.ValueStateKnown,
+	}, diags
+}
+
+func NewNetworkValueNull() NetworkValue {
+	return NetworkValue{
+		state: attr.ValueStateNull,
+	}
+}
+
+func NewNetworkValueUnknown() NetworkValue {
+	return NetworkValue{
+		state: attr.ValueStateUnknown,
+	}
+}
+
+func NewNetworkValue(attributeTypes map[string]attr.Type, attributes map[string]attr.Value) (NetworkValue, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	// Reference: https://github.com/hashicorp/terraform-plugin-framework/issues/521
+	ctx := context.Background()
+
+	for name, attributeType := range attributeTypes {
+		attribute, ok := attributes[name]
+
+		if !ok {
+			diags.AddError(
+				"Missing NetworkValue Attribute Value",
+				"While creating a NetworkValue value, a missing attribute value was detected. "+
+					"A NetworkValue must contain values for all attributes, even if null or unknown. "+
+					"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+					fmt.Sprintf("NetworkValue Attribute Name (%s) Expected Type: %s", name, attributeType.String()),
+			)
+
+			continue
+		}
+
+		if !attributeType.Equal(attribute.Type(ctx)) {
+			diags.AddError(
+				"Invalid NetworkValue Attribute Type",
+				"While creating a NetworkValue value, an invalid attribute value was detected. "+
+					"A NetworkValue must use a matching attribute type for the value. "+
+					"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+					fmt.Sprintf("NetworkValue Attribute Name (%s) Expected Type: %s\n", name, attributeType.String())+
+					fmt.Sprintf("NetworkValue Attribute Name (%s) Given Type: %s", name, attribute.Type(ctx)),
+			)
+		}
+	}
+
+	for name := range attributes {
+		_, ok := attributeTypes[name]
+
+		if !ok {
+			diags.AddError(
+				"Extra NetworkValue Attribute Value",
+				"While creating a NetworkValue value, an extra attribute value was detected. "+
+					"A NetworkValue must not contain values beyond the expected attribute types. "+
+					"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+					fmt.Sprintf("Extra NetworkValue Attribute Name: %s", name),
+			)
+		}
+	}
+
+	if diags.HasError() {
+		return NewNetworkValueUnknown(), diags
+	}
+
+	configAttribute, ok := attributes["config"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`config is missing from object`)
+
+		return NewNetworkValueUnknown(), diags
+	}
+
+	configVal, ok := configAttribute.(basetypes.ListValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`config expected to be basetypes.ListValue, was: %T`, configAttribute))
+	}
+
+	if diags.HasError() {
+		return NewNetworkValueUnknown(), diags
+	}
+
+	return NetworkValue{
+		Config: configVal,
+		state:  attr.ValueStateKnown,
+	}, diags
+}
+
+func NewNetworkValueMust(attributeTypes map[string]attr.Type, attributes map[string]attr.Value) NetworkValue {
+	object, diags := NewNetworkValue(attributeTypes, attributes)
+
+	if diags.HasError() {
+		// This could potentially be added to the diag package.
+		diagsStrings := make([]string, 0, len(diags))
+
+		for _, diagnostic := range diags {
+			diagsStrings = append(diagsStrings, fmt.Sprintf(
+				"%s | %s | %s",
+				diagnostic.Severity(),
+				diagnostic.Summary(),
+				diagnostic.Detail()))
+		}
+
+		panic("NewNetworkValueMust received error(s): " + strings.Join(diagsStrings, "\n"))
+	}
+
+	return object
+}
+
+func (t NetworkType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	if in.Type() == nil {
+		return NewNetworkValueNull(), nil
+	}
+
+	if !in.Type().Equal(t.TerraformType(ctx)) {
+		return nil, fmt.Errorf("expected %s, got %s", t.TerraformType(ctx), in.Type())
+	}
+
+	if !in.IsKnown() {
+		return NewNetworkValueUnknown(), nil
+	}
+
+	if in.IsNull() {
+		return NewNetworkValueNull(), nil
+	}
+
+	attributes := map[string]attr.Value{}
+
+	val := map[string]tftypes.Value{}
+
+	err := in.As(&val)
+
+	if err != nil {
+		return nil, err
+	}
+
+	for k, v := range val {
+		a, err := t.AttrTypes[k].ValueFromTerraform(ctx, v)
+
+		if err != nil {
+			return nil, err
+		}
+
+		attributes[k] = a
+	}
+
+	return NewNetworkValueMust(NetworkValue{}.AttributeTypes(ctx), attributes), nil
+}
+
+func (t NetworkType) ValueType(ctx context.Context) attr.Value {
+	return NetworkValue{}
+}
+
+var _ basetypes.ObjectValuable = NetworkValue{}
+
+type NetworkValue struct {
+	Config basetypes.ListValue `tfsdk:"config"`
+	state  attr.ValueState
+}
+
+func (v NetworkValue) ToTerraformValue(ctx context.Context) (tftypes.Value, error) {
+	attrTypes := make(map[string]tftypes.Type, 1)
+
+	var val tftypes.Value
+	var err error
+
+	attrTypes["config"] = basetypes.ListType{
+		ElemType: NetworkConfigValue{}.Type(ctx),
+	}.TerraformType(ctx)
+
+	objectType := tftypes.Object{AttributeTypes: attrTypes}
+
+	switch v.state {
+	case attr.ValueStateKnown:
+		vals := make(map[string]tftypes.Value, 1)
+
+		val, err = v.Config.ToTerraformValue(ctx)
+
+		if err != nil {
+			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
+		}
+
+		vals["config"] = val
+
+		if err := tftypes.ValidateValue(objectType, vals); err != nil {
+			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
+		}
+
+		return tftypes.NewValue(objectType, vals), nil
+	case attr.ValueStateNull:
+		return tftypes.NewValue(objectType, nil), nil
+	case attr.ValueStateUnknown:
+		return tftypes.NewValue(objectType, tftypes.UnknownValue), nil
+	default:
+		panic(fmt.Sprintf("unhandled Object state in ToTerraformValue: %s", v.state))
+	}
+}
+
+func (v NetworkValue) IsNull() bool {
+	return v.state == attr.ValueStateNull
+}
+
+func (v NetworkValue) IsUnknown() bool {
+	return v.state == attr.ValueStateUnknown
+}
+
+func (v NetworkValue) String() string {
+	return "NetworkValue"
+}
+
+func (v NetworkValue) ToObjectValue(ctx context.Context) (basetypes.ObjectValue, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	config := types.ListValueMust(
+		NetworkConfigType{
+			basetypes.ObjectType{
+				AttrTypes: NetworkConfigValue{}.AttributeTypes(ctx),
+			},
+		},
+		v.Config.Elements(),
+	)
+
+	if v.Config.IsNull() {
+		config = types.ListNull(
+			NetworkConfigType{
+				basetypes.ObjectType{
+					AttrTypes: NetworkConfigValue{}.AttributeTypes(ctx),
+				},
+			},
+		)
+	}
+
+	if v.Config.IsUnknown() {
+		config = types.ListUnknown(
+			NetworkConfigType{
+				basetypes.ObjectType{
+					AttrTypes: NetworkConfigValue{}.AttributeTypes(ctx),
+				},
+			},
+		)
+	}
+
+	attributeTypes := map[string]attr.Type{
+		"config": basetypes.ListType{
+			ElemType: NetworkConfigValue{}.Type(ctx),
+		},
+	}
+
+	if v.IsNull() {
+		return types.ObjectNull(attributeTypes), diags
+	}
+
+	if v.IsUnknown() {
+		return types.ObjectUnknown(attributeTypes), diags
+	}
+
+	objVal, diags := types.ObjectValue(
+		attributeTypes,
+		map[string]attr.Value{
+			"config": config,
+		})
+
+	return objVal, diags
+}
+
+func (v NetworkValue) Equal(o attr.Value) bool {
+	other, ok := o.(NetworkValue)
+
+	if !ok {
+		return false
+	}
+
+	if v.state != other.state {
+		return false
+	}
+
+	if v.state != attr.ValueStateKnown {
+		return true
+	}
+
+	if !v.Config.Equal(other.Config) {
+		return false
+	}
+
+	return true
+}
+
+func (v NetworkValue) Type(ctx context.Context) attr.Type {
+	return NetworkType{
+		basetypes.ObjectType{
+			AttrTypes: v.AttributeTypes(ctx),
+		},
+	}
+}
+
+func (v NetworkValue) AttributeTypes(ctx context.Context) map[string]attr.Type {
+	return map[string]attr.Type{
+		"config": basetypes.ListType{
+			ElemType: NetworkConfigValue{}.Type(ctx),
+		},
+	}
+}
+
+var _ basetypes.ObjectTypable = NetworkConfigType{}
+
+type NetworkConfigType struct {
+	basetypes.ObjectType
+}
+
+func (t NetworkConfigType) Equal(o attr.Type) bool {
+	other, ok := o.(NetworkConfigType)
+
+	if !ok {
+		return false
+	}
+
+	return t.ObjectType.Equal(other.ObjectType)
+}
+
+func (t NetworkConfigType) String() string {
+	return "NetworkConfigType"
+}
+
+func (t NetworkConfigType) ValueFromObject(ctx context.Context, in basetypes.ObjectValue) (basetypes.ObjectValuable, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	attributes := in.Attributes()
+
+	nameAttribute, ok := attributes["name"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`name is missing from object`)
+
+		return nil, diags
+	}
+
+	nameVal, ok := nameAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`name expected to be basetypes.StringValue, was: %T`, nameAttribute))
+	}
+
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	return NetworkConfigValue{
+		Name:  nameVal,
+		state: attr.ValueStateKnown,
+	}, diags
+}
+
+func NewNetworkConfigValueNull() NetworkConfigValue {
+	return NetworkConfigValue{
+		state: attr.ValueStateNull,
+	}
+}
+
+func NewNetworkConfigValueUnknown() NetworkConfigValue {
+	return NetworkConfigValue{
+		state: attr.ValueStateUnknown,
+	}
+}
+
+func NewNetworkConfigValue(attributeTypes map[string]attr.Type, attributes map[string]attr.Value) (NetworkConfigValue, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	// Reference: https://github.com/hashicorp/terraform-plugin-framework/issues/521
+	ctx := context.Background()
+
+	for name, attributeType := range attributeTypes {
+		attribute, ok := attributes[name]
+
+		if !ok {
+			diags.AddError(
+				"Missing NetworkConfigValue Attribute Value",
+				"While creating a NetworkConfigValue value, a missing attribute value was detected. "+
+					"A NetworkConfigValue must contain values for all attributes, even if null or unknown. "+
+					"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+					fmt.Sprintf("NetworkConfigValue Attribute Name (%s) Expected Type: %s", name, attributeType.String()),
+			)
+
+			continue
+		}
+
+		if !attributeType.Equal(attribute.Type(ctx)) {
+			diags.AddError(
+				"Invalid NetworkConfigValue Attribute Type",
+				"While creating a NetworkConfigValue value, an invalid attribute value was detected. "+
+					"A NetworkConfigValue must use a matching attribute type for the value. "+
+					"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+					fmt.Sprintf("NetworkConfigValue Attribute Name (%s) Expected Type: %s\n", name, attributeType.String())+
+					fmt.Sprintf("NetworkConfigValue Attribute Name (%s) Given Type: %s", name, attribute.Type(ctx)),
+			)
+		}
+	}
+
+	for name := range attributes {
+		_, ok := attributeTypes[name]
+
+		if !ok {
+			diags.AddError(
+				"Extra NetworkConfigValue Attribute Value",
+				"While creating a NetworkConfigValue value, an extra attribute value was detected. "+
+					"A NetworkConfigValue must not contain values beyond the expected attribute types. "+
+					"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+					fmt.Sprintf("Extra NetworkConfigValue Attribute Name: %s", name),
+			)
+		}
+	}
+
+	if diags.HasError() {
+		return NewNetworkConfigValueUnknown(), diags
+	}
+
+	nameAttribute, ok := attributes["name"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`name is missing from object`)
+
+		return NewNetworkConfigValueUnknown(), diags
+	}
+
+	nameVal, ok := nameAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`name expected to be basetypes.StringValue, was: %T`, nameAttribute))
+	}
+
+	if diags.HasError() {
+		return NewNetworkConfigValueUnknown(), diags
+	}
+
+	return NetworkConfigValue{
+		Name:  nameVal,
+		state: attr.ValueStateKnown,
+	}, diags
+}
+
+func NewNetworkConfigValueMust(attributeTypes map[string]attr.Type, attributes map[string]attr.Value) NetworkConfigValue {
+	object, diags := NewNetworkConfigValue(attributeTypes, attributes)
+
+	if diags.HasError() {
+		// This could potentially be added to the diag package.
+		diagsStrings := make([]string, 0, len(diags))
+
+		for _, diagnostic := range diags {
+			diagsStrings = append(diagsStrings, fmt.Sprintf(
+				"%s | %s | %s",
+				diagnostic.Severity(),
+				diagnostic.Summary(),
+				diagnostic.Detail()))
+		}
+
+		panic("NewNetworkConfigValueMust received error(s): " + strings.Join(diagsStrings, "\n"))
+	}
+
+	return object
+}
+
+func (t NetworkConfigType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	if in.Type() == nil {
+		return NewNetworkConfigValueNull(), nil
+	}
+
+	if !in.Type().Equal(t.TerraformType(ctx)) {
+		return nil, fmt.Errorf("expected %s, got %s", t.TerraformType(ctx), in.Type())
+	}
+
+	if !in.IsKnown() {
+		return NewNetworkConfigValueUnknown(), nil
+	}
+
+	if in.IsNull() {
+		return NewNetworkConfigValueNull(), nil
+	}
+
+	attributes := map[string]attr.Value{}
+
+	val := map[string]tftypes.Value{}
+
+	err := in.As(&val)
+
+	if err != nil {
+		return nil, err
+	}
+
+	for k, v := range val {
+		a, err := t.AttrTypes[k].ValueFromTerraform(ctx, v)
+
+		if err != nil {
+			return nil, err
+		}
+
+		attributes[k] = a
+	}
+
+	return NewNetworkConfigValueMust(NetworkConfigValue{}.AttributeTypes(ctx), attributes), nil
+}
+
+func (t NetworkConfigType) ValueType(ctx context.Context) attr.Value {
+	return NetworkConfigValue{}
+}
+
+var _ basetypes.ObjectValuable = NetworkConfigValue{}
+
+type NetworkConfigValue struct {
+	Name  basetypes.StringValue `tfsdk:"name"`
+	state attr.ValueState
+}
+
+func (v NetworkConfigValue) ToTerraformValue(ctx context.Context) (tftypes.Value, error) {
+	attrTypes := make(map[string]tftypes.Type, 1)
+
+	var val tftypes.Value
+	var err error
+
+	attrTypes["name"] = basetypes.StringType{}.TerraformType(ctx)
+
+	objectType := tftypes.Object{AttributeTypes: attrTypes}
+
+	switch v.state {
+	case attr.ValueStateKnown:
+		vals := make(map[string]tftypes.Value, 1)
+
+		val, err = v.Name.ToTerraformValue(ctx)
+
+		if err != nil {
+			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
+		}
+
+		vals["name"] = val
+
+		if err := tftypes.ValidateValue(objectType, vals); err != nil {
+			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
+		}
+
+		return tftypes.NewValue(objectType, vals), nil
+	case attr.ValueStateNull:
+		return tftypes.NewValue(objectType, nil), nil
+	case attr.ValueStateUnknown:
+		return tftypes.NewValue(objectType, tftypes.UnknownValue), nil
+	default:
+		panic(fmt.Sprintf("unhandled Object state in ToTerraformValue: %s", v.state))
+	}
+}
+
+func (v NetworkConfigValue) IsNull() bool {
+	return v.state == attr.ValueStateNull
+}
+
+func (v NetworkConfigValue) IsUnknown() bool {
+	return v.state == attr.ValueStateUnknown
+}
+
+func (v NetworkConfigValue) String() string {
+	return "NetworkConfigValue"
+}
+
+func (v NetworkConfigValue) ToObjectValue(ctx context.Context) (basetypes.ObjectValue, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	attributeTypes := map[string]attr.Type{
+		"name": basetypes.StringType{},
+	}
+
+	if v.IsNull() {
+		return types.ObjectNull(attributeTypes), diags
+	}
+
+	if v.IsUnknown() {
+		return types.ObjectUnknown(attributeTypes), diags
+	}
+
+	objVal, diags := types.ObjectValue(
+		attributeTypes,
+		map[string]attr.Value{
+			"name": v.Name,
+		})
+
+	return objVal, diags
+}
+
+func (v NetworkConfigValue) Equal(o attr.Value) bool {
+	other, ok := o.(NetworkConfigValue)
+
+	if !ok {
+		return false
+	}
+
+	if v.state != other.state {
+		return false
+	}
+
+	if v.state != attr.ValueStateKnown {
+		return true
+	}
+
+	if !v.Name.Equal(other.Name) {
+		return false
+	}
+
+	return true
+}
+
+func (v NetworkConfigValue) Type(ctx context.Context) attr.Type {
+	return NetworkConfigType{
+		basetypes.ObjectType{
+			AttrTypes: v.AttributeTypes(ctx),
+		},
+	}
+}
+
+func (v NetworkConfigValue) AttributeTypes(ctx context.Context) map[string]attr.Type {
+	return map[string]attr.Type{
+		"name": basetypes.StringType{},
+	}
+}
+
+var _ basetypes.ObjectTypable = StorageType{}
+
+type StorageType struct {
+	basetypes.ObjectType
+}
+
+func (t StorageType) Equal(o attr.Type) bool {
+	other, ok := o.(StorageType)
+
+	if !ok {
+		return false
+	}
+
+	return t.ObjectType.Equal(other.ObjectType)
+}
+
+func (t StorageType) String() string {
+	return "StorageType"
+}
+
+func (t StorageType) ValueFromObject(ctx context.Context, in basetypes.ObjectValue) (basetypes.ObjectValuable, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	attributes := in.Attributes()
+
+	configAttribute, ok := attributes["config"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`config is missing from object`)
+
+		return nil, diags
+	}
+
+	configVal, ok := configAttribute.(basetypes.ObjectValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`config expected to be basetypes.ObjectValue, was: %T`, configAttribute))
+	}
+
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	return StorageValue{
+		Config: configVal,
+		state:  attr.ValueStateKnown,
+	}, diags
+}
+
+func NewStorageValueNull() StorageValue {
+	return StorageValue{
+		state: attr.ValueStateNull,
+	}
+}
+
+func NewStorageValueUnknown() StorageValue {
+	return StorageValue{
+		state: attr.ValueStateUnknown,
+	}
+}
+
+func NewStorageValue(attributeTypes map[string]attr.Type, attributes map[string]attr.Value) (StorageValue, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	// Reference: https://github.com/hashicorp/terraform-plugin-framework/issues/521
+	ctx := context.Background()
+
+	for name, attributeType := range attributeTypes {
+		attribute, ok := attributes[name]
+
+		if !ok {
+			diags.AddError(
+				"Missing StorageValue Attribute Value",
+				"While creating a StorageValue value, a missing attribute value was detected. "+
+					"A StorageValue must contain values for all attributes, even if null or unknown. "+
+					"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+					fmt.Sprintf("StorageValue Attribute Name (%s) Expected Type: %s", name, attributeType.String()),
+			)
+
+			continue
+		}
+
+		if !attributeType.Equal(attribute.Type(ctx)) {
+			diags.AddError(
+				"Invalid StorageValue Attribute Type",
+				"While creating a StorageValue value, an invalid attribute value was detected. "+
+					"A StorageValue must use a matching attribute type for the value. "+
+					"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+					fmt.Sprintf("StorageValue Attribute Name (%s) Expected Type: %s\n", name, attributeType.String())+
+					fmt.Sprintf("StorageValue Attribute Name (%s) Given Type: %s", name, attribute.Type(ctx)),
+			)
+		}
+	}
+
+	for name := range attributes {
+		_, ok := attributeTypes[name]
+
+		if !ok {
+			diags.AddError(
+				"Extra StorageValue Attribute Value",
+				"While creating a StorageValue value, an extra attribute value was detected. "+
+					"A StorageValue must not contain values beyond the expected attribute types. "+
+					"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+					fmt.Sprintf("Extra StorageValue Attribute Name: %s", name),
+			)
+		}
+	}
+
+	if diags.HasError() {
+		return NewStorageValueUnknown(), diags
+	}
+
+	configAttribute, ok := attributes["config"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`config is missing from object`)
+
+		return NewStorageValueUnknown(), diags
+	}
+
+	configVal, ok := configAttribute.(basetypes.ObjectValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`config expected to be basetypes.ObjectValue, was: %T`, configAttribute))
+	}
+
+	if diags.HasError() {
+		return NewStorageValueUnknown(), diags
+	}
+
+	return StorageValue{
+		Config: configVal,
+		state:  attr.ValueStateKnown,
+	}, diags
+}
+
+func NewStorageValueMust(attributeTypes map[string]attr.Type, attributes map[string]attr.Value) StorageValue {
+	object, diags := NewStorageValue(attributeTypes, attributes)
+
+	if diags.HasError() {
+		// This could potentially be added to the diag package.
+		diagsStrings := make([]string, 0, len(diags))
+
+		for _, diagnostic := range diags {
+			diagsStrings = append(diagsStrings, fmt.Sprintf(
+				"%s | %s | %s",
+				diagnostic.Severity(),
+				diagnostic.Summary(),
+				diagnostic.Detail()))
+		}
+
+		panic("NewStorageValueMust received error(s): " + strings.Join(diagsStrings, "\n"))
+	}
+
+	return object
+}
+
+func (t StorageType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	if in.Type() == nil {
+		return NewStorageValueNull(), nil
+	}
+
+	if !in.Type().Equal(t.TerraformType(ctx)) {
+		return nil, fmt.Errorf("expected %s, got %s", t.TerraformType(ctx), in.Type())
+	}
+
+	if !in.IsKnown() {
+		return NewStorageValueUnknown(), nil
+	}
+
+	if in.IsNull() {
+		return NewStorageValueNull(), nil
+	}
+
+	attributes := map[string]attr.Value{}
+
+	val := map[string]tftypes.Value{}
+
+	err := in.As(&val)
+
+	if err != nil {
+		return nil, err
+	}
+
+	for k, v := range val {
+		a, err := t.AttrTypes[k].ValueFromTerraform(ctx, v)
+
+		if err != nil {
+			return nil, err
+		}
+
+		attributes[k] = a
+	}
+
+	return NewStorageValueMust(StorageValue{}.AttributeTypes(ctx), attributes), nil
+}
+
+func (t StorageType) ValueType(ctx context.Context) attr.Value {
+	return StorageValue{}
+}
+
+var _ basetypes.ObjectValuable = StorageValue{}
+
+type StorageValue struct {
+	Config basetypes.ObjectValue `tfsdk:"config"`
+	state  attr.ValueState
+}
+
+func (v StorageValue) ToTerraformValue(ctx context.Context) (tftypes.Value, error) {
+	attrTypes := make(map[string]tftypes.Type, 1)
+
+	var val tftypes.Value
+	var err error
+
+	attrTypes["config"] = basetypes.ObjectType{
+		AttrTypes: StorageConfigValue{}.AttributeTypes(ctx),
+	}.TerraformType(ctx)
+
+	objectType := tftypes.Object{AttributeTypes: attrTypes}
+
+	switch v.state {
+	case attr.ValueStateKnown:
+		vals := make(map[string]tftypes.Value, 1)
+
+		val, err = v.Config.ToTerraformValue(ctx)
+
+		if err != nil {
+			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
+		}
+
+		vals["config"] = val
+
+		if err := tftypes.ValidateValue(objectType, vals); err != nil {
+			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
+		}
+
+		return tftypes.NewValue(objectType, vals), nil
+	case attr.ValueStateNull:
+		return tftypes.NewValue(objectType, nil), nil
+	case attr.ValueStateUnknown:
+		return tftypes.NewValue(objectType, tftypes.UnknownValue), nil
+	default:
+		panic(fmt.Sprintf("unhandled Object state in ToTerraformValue: %s", v.state))
+	}
+}
+
+func (v StorageValue) IsNull() bool {
+	return v.state == attr.ValueStateNull
+}
+
+func (v StorageValue) IsUnknown() bool {
+	return v.state == attr.ValueStateUnknown
+}
+
+func (v StorageValue) String() string {
+	return "StorageValue"
+}
+
+func (v StorageValue) ToObjectValue(ctx context.Context) (basetypes.ObjectValue, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	var config basetypes.ObjectValue
+
+	if v.Config.IsNull() {
+		config = types.ObjectNull(
+			StorageConfigValue{}.AttributeTypes(ctx),
+		)
+	}
+
+	if v.Config.IsUnknown() {
+		config = types.ObjectUnknown(
+			StorageConfigValue{}.AttributeTypes(ctx),
+		)
+	}
+
+	if !v.Config.IsNull() && !v.Config.IsUnknown() {
+		config = types.ObjectValueMust(
+			StorageConfigValue{}.AttributeTypes(ctx),
+			v.Config.Attributes(),
+		)
+	}
+
+	attributeTypes := map[string]attr.Type{
+		"config": basetypes.ObjectType{
+			AttrTypes: StorageConfigValue{}.AttributeTypes(ctx),
+		},
+	}
+
+	if v.IsNull() {
+		return types.ObjectNull(attributeTypes), diags
+	}
+
+	if v.IsUnknown() {
+		return types.ObjectUnknown(attributeTypes), diags
+	}
+
+	objVal, diags := types.ObjectValue(
+		attributeTypes,
+		map[string]attr.Value{
+			"config": config,
+		})
+
+	return objVal, diags
+}
+
+func (v StorageValue) Equal(o attr.Value) bool {
+	other, ok := o.(StorageValue)
+
+	if !ok {
+		return false
+	}
+
+	if v.state != other.state {
+		return false
+	}
+
+	if v.state != attr.ValueStateKnown {
+		return true
+	}
+
+	if !v.Config.Equal(other.Config) {
+		return false
+	}
+
+	return true
+}
+
+func (v StorageValue) Type(ctx context.Context) attr.Type {
+	return StorageType{
+		basetypes.ObjectType{
+			AttrTypes: v.AttributeTypes(ctx),
+		},
+	}
+}
+
+func (v StorageValue) AttributeTypes(ctx context.Context) map[string]attr.Type {
+	return map[string]attr.Type{
+		"config": basetypes.ObjectType{
+			AttrTypes: StorageConfigValue{}.AttributeTypes(ctx),
+		},
+	}
+}
+
+var _ basetypes.ObjectTypable = StorageConfigType{}
+
+type StorageConfigType struct {
+	basetypes.ObjectType
+}
+
+func (t StorageConfigType) Equal(o attr.Type) bool {
+	other, ok := o.(StorageConfigType)
+
+	if !ok {
+		return false
+	}
+
+	return t.ObjectType.Equal(other.ObjectType)
+}
+
+func (t StorageConfigType) String() string {
+	return "StorageConfigType"
+}
+
+func (t StorageConfigType) ValueFromObject(ctx context.Context, in basetypes.ObjectValue) (basetypes.ObjectValuable, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	attributes := in.Attributes()
+
+	sizeAttribute, ok := attributes["size"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`size is missing from object`)
+
+		return nil, diags
+	}
+
+	sizeVal, ok := sizeAttribute.(basetypes.Int64Value)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`size expected to be basetypes.Int64Value, was: %T`, sizeAttribute))
+	}
+
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	return StorageConfigValue{
+		Size:  sizeVal,
+		state: attr.ValueStateKnown,
+	}, diags
+}
+
+func NewStorageConfigValueNull() StorageConfigValue {
+	return StorageConfigValue{
+		state: attr.ValueStateNull,
+	}
+}
+
+func NewStorageConfigValueUnknown() StorageConfigValue {
+	return StorageConfigValue{
+		state: attr.ValueStateUnknown,
+	}
+}
+
+func NewStorageConfigValue(attributeTypes map[string]attr.Type, attributes map[string]attr.Value) (StorageConfigValue, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	// Reference: https://github.com/hashicorp/terraform-plugin-framework/issues/521
+	ctx := context.Background()
+
+	for name, attributeType := range attributeTypes {
+		attribute, ok := attributes[name]
+
+		if !ok {
+			diags.AddError(
+				"Missing StorageConfigValue Attribute Value",
+				"While creating a StorageConfigValue value, a missing attribute value was detected. "+
+					"A StorageConfigValue must contain values for all attributes, even if null or unknown. "+
+					"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+					fmt.Sprintf("StorageConfigValue Attribute Name (%s) Expected Type: %s", name, attributeType.String()),
+			)
+
+			continue
+		}
+
+		if !attributeType.Equal(attribute.Type(ctx)) {
+			diags.AddError(
+				"Invalid StorageConfigValue Attribute Type",
+				"While creating a StorageConfigValue value, an invalid attribute value was detected. "+
+					"A StorageConfigValue must use a matching attribute type for the value. "+
+					"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+					fmt.Sprintf("StorageConfigValue Attribute Name (%s) Expected Type: %s\n", name, attributeType.String())+
+					fmt.Sprintf("StorageConfigValue Attribute Name (%s) Given Type: %s", name, attribute.Type(ctx)),
+			)
+		}
+	}
+
+	for name := range attributes {
+		_, ok := attributeTypes[name]
+
+		if !ok {
+			diags.AddError(
+				"Extra StorageConfigValue Attribute Value",
+				"While creating a StorageConfigValue value, an extra attribute value was detected. "+
+					"A StorageConfigValue must not contain values beyond the expected attribute types. "+
+					"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+					fmt.Sprintf("Extra StorageConfigValue Attribute Name: %s", name),
+			)
+		}
+	}
+
+	if diags.HasError() {
+		return NewStorageConfigValueUnknown(), diags
+	}
+
+	sizeAttribute, ok := attributes["size"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`size is missing from object`)
+
+		return NewStorageConfigValueUnknown(), diags
+	}
+
+	sizeVal, ok := sizeAttribute.(basetypes.Int64Value)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`size expected to be basetypes.Int64Value, was: %T`, sizeAttribute))
+	}
+
+	if diags.HasError() {
+		return NewStorageConfigValueUnknown(), diags
+	}
+
+	return StorageConfigValue{
+		Size:  sizeVal,
+		state: attr.ValueStateKnown,
+	}, diags
+}
+
+func NewStorageConfigValueMust(attributeTypes map[string]attr.Type, attributes map[string]attr.Value) StorageConfigValue {
+	object, diags := NewStorageConfigValue(attributeTypes, attributes)
+
+	if diags.HasError() {
+		// This could potentially be added to the diag package.
+		diagsStrings := make([]string, 0, len(diags))
+
+		for _, diagnostic := range diags {
+			diagsStrings = append(diagsStrings, fmt.Sprintf(
+				"%s | %s | %s",
+				diagnostic.Severity(),
+				diagnostic.Summary(),
+				diagnostic.Detail()))
+		}
+
+		panic("NewStorageConfigValueMust received error(s): " + strings.Join(diagsStrings, "\n"))
+	}
+
+	return object
+}
+
+func (t StorageConfigType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	if in.Type() == nil {
+		return NewStorageConfigValueNull(), nil
+	}
+
+	if !in.Type().Equal(t.TerraformType(ctx)) {
+		return nil, fmt.Errorf("expected %s, got %s", t.TerraformType(ctx), in.Type())
+	}
+
+	if !in.IsKnown() {
+		return NewStorageConfigValueUnknown(), nil
+	}
+
+	if in.IsNull() {
+		return NewStorageConfigValueNull(), nil
+	}
+
+	attributes := map[string]attr.Value{}
+
+	val := map[string]tftypes.Value{}
+
+	err := in.As(&val)
+
+	if err != nil {
+		return nil, err
+	}
+
+	for k, v := range val {
+		a, err := t.AttrTypes[k].ValueFromTerraform(ctx, v)
+
+		if err != nil {
+			return nil, err
+		}
+
+		attributes[k] = a
+	}
+
+	return NewStorageConfigValueMust(StorageConfigValue{}.AttributeTypes(ctx), attributes), nil
+}
+
+func (t StorageConfigType) ValueType(ctx context.Context) attr.Value {
+	return StorageConfigValue{}
+}
+
+var _ basetypes.ObjectValuable = StorageConfigValue{}
+
+type StorageConfigValue struct {
+	Size  basetypes.Int64Value `tfsdk:"size"`
+	state attr.ValueState
+}
+
+func (v StorageConfigValue) ToTerraformValue(ctx context.Context) (tftypes.Value, error) {
+	attrTypes := make(map[string]tftypes.Type, 1)
+
+	var val tftypes.Value
+	var err error
+
+	attrTypes["size"] = basetypes.Int64Type{}.TerraformType(ctx)
+
+	objectType := tftypes.Object{AttributeTypes: attrTypes}
+
+	switch v.state {
+	case attr.ValueStateKnown:
+		vals := make(map[string]tftypes.Value, 1)
+
+		val, err = v.Size.ToTerraformValue(ctx)
+
+		if err != nil {
+			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
+		}
+
+		vals["size"] = val
+
+		if err := tftypes.ValidateValue(objectType, vals); err != nil {
+			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
+		}
+
+		return tftypes.NewValue(objectType, vals), nil
+	case attr.ValueStateNull:
+		return tftypes.NewValue(objectType, nil), nil
+	case attr.ValueStateUnknown:
+		return tftypes.NewValue(objectType, tftypes.UnknownValue), nil
+	default:
+		panic(fmt.Sprintf("unhandled Object state in ToTerraformValue: %s", v.state))
+	}
+}
+
+func (v StorageConfigValue) IsNull() bool {
+	return v.state == attr.ValueStateNull
+}
+
+func (v StorageConfigValue) IsUnknown() bool {
+	return v.state == attr.ValueStateUnknown
+}
+
+func (v StorageConfigValue) String() string {
+	return "StorageConfigValue"
+}
+
+func (v StorageConfigValue) ToObjectValue(ctx context.Context) (basetypes.ObjectValue, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	attributeTypes := map[string]attr.Type{
+		"size": basetypes.Int64Type{},
+	}
+
+	if v.IsNull() {
+		return types.ObjectNull(attributeTypes), diags
+	}
+
+	if v.IsUnknown() {
+		return types.ObjectUnknown(attributeTypes), diags
+	}
+
+	objVal, diags := types.ObjectValue(
+		attributeTypes,
+		map[string]attr.Value{
+			"size": v.Size,
+		})
+
+	return objVal, diags
+}
+
+func (v StorageConfigValue) Equal(o attr.Value) bool {
+	other, ok := o.(StorageConfigValue)
+
+	if !ok {
+		return false
+	}
+
+	if v.state != other.state {
+		return false
+	}
+
+	if v.state != attr.ValueStateKnown {
+		return true
+	}
+
+	if !v.Size.Equal(other.Size) {
+		return false
+	}
+
+	return true
+}
+
+func (v StorageConfigValue) Type(ctx context.Context) attr.Type {
+	return StorageConfigType{
+		basetypes.ObjectType{
+			AttrTypes: v.AttributeTypes(ctx),
+		},
+	}
+}
+
+func (v StorageConfigValue) AttributeTypes(ctx context.Context) map[string]attr.Type {
+	return map[string]attr.Type{
+		"size": basetypes.Int64Type{},
+	}
+}
+
+var _ basetypes.ObjectTypable = LoggingType{}
+
+type LoggingType struct {
+	basetypes.ObjectType
+}
+
+func (t LoggingType) Equal(o attr.Type) bool {
+	other, ok := o.(LoggingType)
+
+	if !ok {
+		return false
+	}
+
+	return t.ObjectType.Equal(other.ObjectType)
+}
+
+func (t LoggingType) String() string {
+	return "LoggingType"
+}
+
+func (t LoggingType) ValueFromObject(ctx context.Context, in basetypes.ObjectValue) (basetypes.ObjectValuable, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	attributes := in.Attributes()
+
+	configAttribute, ok := attributes["config"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`config is missing from object`)
+
+		return nil, diags
+	}
+
+	configVal, ok := configAttribute.(basetypes.ListValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`config expected to be basetypes.ListValue, was: %T`, configAttribute))
+	}
+
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	return LoggingValue{
+		Config: configVal,
+		state:  attr.ValueStateKnown,
+	}, diags
+}
+
+func NewLoggingValueNull() LoggingValue {
+	return LoggingValue{
+		state: attr.ValueStateNull,
+	}
+}
+
+func NewLoggingValueUnknown() LoggingValue {
+	return LoggingValue{
+		state: attr.ValueStateUnknown,
+	}
+}
+
+func NewLoggingValue(attributeTypes map[string]attr.Type, attributes map[string]attr.Value) (LoggingValue, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	// Reference: https://github.com/hashicorp/terraform-plugin-framework/issues/521
+	ctx := context.Background()
+
+	for name, attributeType := range attributeTypes {
+		attribute, ok := attributes[name]
+
+		if !ok {
+			diags.AddError(
+				"Missing LoggingValue Attribute Value",
+				"While creating a LoggingValue value, a missing attribute value was detected. "+
+					"A LoggingValue must contain values for all attributes, even if null or unknown. "+
+					"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+					fmt.Sprintf("LoggingValue Attribute Name (%s) Expected Type: %s", name, attributeType.String()),
+			)
+
+			continue
+		}
+
+		if !attributeType.Equal(attribute.Type(ctx)) {
+			diags.AddError(
+				"Invalid LoggingValue Attribute Type",
+				"While creating a LoggingValue value, an invalid attribute value was detected. "+
+					"A LoggingValue must use a matching attribute type for the value. "+
+					"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+					fmt.Sprintf("LoggingValue Attribute Name (%s) Expected Type: %s\n", name, attributeType.String())+
+					fmt.Sprintf("LoggingValue Attribute Name (%s) Given Type: %s", name, attribute.Type(ctx)),
+			)
+		}
+	}
+
+	for name := range attributes {
+		_, ok := attributeTypes[name]
+
+		if !ok {
+			diags.AddError(
+				"Extra LoggingValue Attribute Value",
+				"While creating a LoggingValue value, an extra attribute value was detected. "+
+					"A LoggingValue must not contain values beyond the expected attribute types. "+
+					"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+					fmt.Sprintf("Extra LoggingValue Attribute Name: %s", name),
+			)
+		}
+	}
+
+	if diags.HasError() {
+		return NewLoggingValueUnknown(), diags
+	}
+
+	configAttribute, ok := attributes["config"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`config is missing from object`)
+
+		return NewLoggingValueUnknown(), diags
+	}
+
+	configVal, ok := configAttribute.(basetypes.ListValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`config expected to be basetypes.ListValue, was: %T`, configAttribute))
+	}
+
+	if diags.HasError() {
+		return NewLoggingValueUnknown(), diags
+	}
+
+	return LoggingValue{
+		Config: configVal,
+		state:  attr.ValueStateKnown,
+	}, diags
+}
+
+func NewLoggingValueMust(attributeTypes map[string]attr.Type, attributes map[string]attr.Value) LoggingValue {
+	object, diags := NewLoggingValue(attributeTypes, attributes)
+
+	if diags.HasError() {
+		// This could potentially be added to the diag package.
+		diagsStrings := make([]string, 0, len(diags))
+
+		for _, diagnostic := range diags {
+			diagsStrings = append(diagsStrings, fmt.Sprintf(
+				"%s | %s | %s",
+				diagnostic.Severity(),
+				diagnostic.Summary(),
+				diagnostic.Detail()))
+		}
+
+		panic("NewLoggingValueMust received error(s): " + strings.Join(diagsStrings, "\n"))
+	}
+
+	return object
+}
+
+func (t LoggingType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	if in.Type() == nil {
+		return NewLoggingValueNull(), nil
+	}
+
+	if !in.Type().Equal(t.TerraformType(ctx)) {
+		return nil, fmt.Errorf("expected %s, got %s", t.TerraformType(ctx), in.Type())
+	}
+
+	if !in.IsKnown() {
+		return NewLoggingValueUnknown(), nil
+	}
+
+	if in.IsNull() {
+		return NewLoggingValueNull(), nil
+	}
+
+	attributes := map[string]attr.Value{}
+
+	val := map[string]tftypes.Value{}
+
+	err := in.As(&val)
+
+	if err != nil {
+		return nil, err
+	}
+
+	for k, v := range val {
+		a, err := t.AttrTypes[k].ValueFromTerraform(ctx, v)
+
+		if err != nil {
+			return nil, err
+		}
+
+		attributes[k] = a
+	}
+
+	return NewLoggingValueMust(LoggingValue{}.AttributeTypes(ctx), attributes), nil
+}
+
+func (t LoggingType) ValueType(ctx context.Context) attr.Value {
+	return LoggingValue{}
+}
+
+var _ basetypes.ObjectValuable = LoggingValue{}
+
+type LoggingValue struct {
+	Config basetypes.ListValue `tfsdk:"config"`
+	state  attr.ValueState
+}
+
+func (v LoggingValue) ToTerraformValue(ctx context.Context) (tftypes.Value, error) {
+	attrTypes := make(map[string]tftypes.Type, 1)
+
+	var val tftypes.Value
+	var err error
+
+	attrTypes["config"] = basetypes.ListType{
+		ElemType: LoggingConfigValue{}.Type(ctx),
+	}.TerraformType(ctx)
+
+	objectType := tftypes.Object{AttributeTypes: attrTypes}
+
+	switch v.state {
+	case attr.ValueStateKnown:
+		vals := make(map[string]tftypes.Value, 1)
+
+		val, err = v.Config.ToTerraformValue(ctx)
+
+		if err != nil {
+			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
+		}
+
+		vals["config"] = val
+
+		if err := tftypes.ValidateValue(objectType, vals); err != nil {
+			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
+		}
+
+		return tftypes.NewValue(objectType, vals), nil
+	case attr.ValueStateNull:
+		return tftypes.NewValue(objectType, nil), nil
+	case attr.ValueStateUnknown:
+		return tftypes.NewValue(objectType, tftypes.UnknownValue), nil
+	default:
+		panic(fmt.Sprintf("unhandled Object state in ToTerraformValue: %s", v.state))
+	}
+}
+
+func (v LoggingValue) IsNull() bool {
+	return v.state == attr.ValueStateNull
+}
+
+func (v LoggingValue) IsUnknown() bool {
+	return v.state == attr.ValueStateUnknown
+}
+
+func (v LoggingValue) String() string {
+	return "LoggingValue"
+}
+
+func (v LoggingValue) ToObjectValue(ctx context.Context) (basetypes.ObjectValue, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	config := types.ListValueMust(
+		LoggingConfigType{
+			basetypes.ObjectType{
+				AttrTypes: LoggingConfigValue{}.AttributeTypes(ctx),
+			},
+		},
+		v.Config.Elements(),
+	)
+
+	if v.Config.IsNull() {
+		config = types.ListNull(
+			LoggingConfigType{
+				basetypes.ObjectType{
+					AttrTypes: LoggingConfigValue{}.AttributeTypes(ctx),
+				},
+			},
+		)
+	}
+
+	if v.Config.IsUnknown() {
+		config = types.ListUnknown(
+			LoggingConfigType{
+				basetypes.ObjectType{
+					AttrTypes: LoggingConfigValue{}.AttributeTypes(ctx),
+				},
+			},
+		)
+	}
+
+	attributeTypes := map[string]attr.Type{
+		"config": basetypes.ListType{
+			ElemType: LoggingConfigValue{}.Type(ctx),
+		},
+	}
+
+	if v.IsNull() {
+		return types.ObjectNull(attributeTypes), diags
+	}
+
+	if v.IsUnknown() {
+		return types.ObjectUnknown(attributeTypes), diags
+	}
+
+	objVal, diags := types.ObjectValue(
+		attributeTypes,
+		map[string]attr.Value{
+			"config": config,
+		})
+
+	return objVal, diags
+}
+
+func (v LoggingValue) Equal(o attr.Value) bool {
+	other, ok := o.(LoggingValue)
+
+	if !ok {
+		return false
+	}
+
+	if v.state != other.state {
+		return false
+	}
+
+	if v.state != attr.ValueStateKnown {
+		return true
+	}
+
+	if !v.Config.Equal(other.Config) {
+		return false
+	}
+
+	return true
+}
+
+func (v LoggingValue) Type(ctx context.Context) attr.Type {
+	return LoggingType{
+		basetypes.ObjectType{
+			AttrTypes: v.AttributeTypes(ctx),
+		},
+	}
+}
+
+func (v LoggingValue) AttributeTypes(ctx context.Context) map[string]attr.Type {
+	return map[string]attr.Type{
+		"config": basetypes.ListType{
+			ElemType: LoggingConfigValue{}.Type(ctx),
+		},
+	}
+}
+
+var _ basetypes.ObjectTypable = LoggingConfigType{}
+
+type LoggingConfigType struct {
+	basetypes.ObjectType
+}
+
+func (t LoggingConfigType) Equal(o attr.Type) bool {
+	other, ok := o.(LoggingConfigType)
+
+	if !ok {
+		return false
+	}
+
+	return t.ObjectType.Equal(other.ObjectType)
+}
+
+func (t LoggingConfigType) String() string {
+	return "LoggingConfigType"
+}
+
+func (t LoggingConfigType) ValueFromObject(ctx context.Context, in basetypes.ObjectValue) (basetypes.ObjectValuable, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	attributes := in.Attributes()
+
+	levelAttribute, ok := attributes["level"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`level is missing from object`)
+
+		return nil, diags
+	}
+
+	levelVal, ok := levelAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`level expected to be basetypes.StringValue, was: %T`, levelAttribute))
+	}
+
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	return LoggingConfigValue{
+		Level: levelVal,
+		state: attr.ValueStateKnown,
+	}, diags
+}
+
+func NewLoggingConfigValueNull() LoggingConfigValue {
+	return LoggingConfigValue{
+		state: attr.ValueStateNull,
+	}
+}
+
+func NewLoggingConfigValueUnknown() LoggingConfigValue {
+	return LoggingConfigValue{
+		state: attr.ValueStateUnknown,
+	}
+}
+
+func NewLoggingConfigValue(attributeTypes map[string]attr.Type, attributes map[string]attr.Value) (LoggingConfigValue, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	// Reference: https://github.com/hashicorp/terraform-plugin-framework/issues/521
+	ctx := context.Background()
+
+	for name, attributeType := range attributeTypes {
+		attribute, ok := attributes[name]
+
+		if !ok {
+			diags.AddError(
+				"Missing LoggingConfigValue Attribute Value",
+				"While creating a LoggingConfigValue value, a missing attribute value was detected. "+
+					"A LoggingConfigValue must contain values for all attributes, even if null or unknown. "+
+					"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+					fmt.Sprintf("LoggingConfigValue Attribute Name (%s) Expected Type: %s", name, attributeType.String()),
+			)
+
+			continue
+		}
+
+		if !attributeType.Equal(attribute.Type(ctx)) {
+			diags.AddError(
+				"Invalid LoggingConfigValue Attribute Type",
+				"While creating a LoggingConfigValue value, an invalid attribute value was detected. "+
+					"A LoggingConfigValue must use a matching attribute type for the value. "+
+					"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+					fmt.Sprintf("LoggingConfigValue Attribute Name (%s) Expected Type: %s\n", name, attributeType.String())+
+					fmt.Sprintf("LoggingConfigValue Attribute Name (%s) Given Type: %s", name, attribute.Type(ctx)),
+			)
+		}
+	}
+
+	for name := range attributes {
+		_, ok := attributeTypes[name]
+
+		if !ok {
+			diags.AddError(
+				"Extra LoggingConfigValue Attribute Value",
+				"While creating a LoggingConfigValue value, an extra attribute value was detected. "+
+					"A LoggingConfigValue must not contain values beyond the expected attribute types. "+
+					"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+					fmt.Sprintf("Extra LoggingConfigValue Attribute Name: %s", name),
+			)
+		}
+	}
+
+	if diags.HasError() {
+		return NewLoggingConfigValueUnknown(), diags
+	}
+
+	levelAttribute, ok := attributes["level"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`level is missing from object`)
+
+		return NewLoggingConfigValueUnknown(), diags
+	}
+
+	levelVal, ok := levelAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`level expected to be basetypes.StringValue, was: %T`, levelAttribute))
+	}
+
+	if diags.HasError() {
+		return NewLoggingConfigValueUnknown(), diags
+	}
+
+	return LoggingConfigValue{
+		Level: levelVal,
+		state: attr.ValueStateKnown,
+	}, diags
+}
+
+func NewLoggingConfigValueMust(attributeTypes map[string]attr.Type, attributes map[string]attr.Value) LoggingConfigValue {
+	object, diags := NewLoggingConfigValue(attributeTypes, attributes)
+
+	if diags.HasError() {
+		// This could potentially be added to the diag package.
+		diagsStrings := make([]string, 0, len(diags))
+
+		for _, diagnostic := range diags {
+			diagsStrings = append(diagsStrings, fmt.Sprintf(
+				"%s | %s | %s",
+				diagnostic.Severity(),
+				diagnostic.Summary(),
+				diagnostic.Detail()))
+		}
+
+		panic("NewLoggingConfigValueMust received error(s): " + strings.Join(diagsStrings, "\n"))
+	}
+
+	return object
+}
+
+func (t LoggingConfigType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	if in.Type() == nil {
+		return NewLoggingConfigValueNull(), nil
+	}
+
+	if !in.Type().Equal(t.TerraformType(ctx)) {
+		return nil, fmt.Errorf("expected %s, got %s", t.TerraformType(ctx), in.Type())
+	}
+
+	if !in.IsKnown() {
+		return NewLoggingConfigValueUnknown(), nil
+	}
+
+	if in.IsNull() {
+		return NewLoggingConfigValueNull(), nil
+	}
+
+	attributes := map[string]attr.Value{}
+
+	val := map[string]tftypes.Value{}
+
+	err := in.As(&val)
+
+	if err != nil {
+		return nil, err
+	}
+
+	for k, v := range val {
+		a, err := t.AttrTypes[k].ValueFromTerraform(ctx, v)
+
+		if err != nil {
+			return nil, err
+		}
+
+		attributes[k] = a
+	}
+
+	return NewLoggingConfigValueMust(LoggingConfigValue{}.AttributeTypes(ctx), attributes), nil
+}
+
+func (t LoggingConfigType) ValueType(ctx context.Context) attr.Value {
+	return LoggingConfigValue{}
+}
+
+var _ basetypes.ObjectValuable = LoggingConfigValue{}
+
+type LoggingConfigValue struct {
+	Level basetypes.StringValue `tfsdk:"level"`
+	state attr.ValueState
+}
+
+func (v LoggingConfigValue) ToTerraformValue(ctx context.Context) (tftypes.Value, error) {
+	attrTypes := make(map[string]tftypes.Type, 1)
+
+	var val tftypes.Value
+	var err error
+
+	attrTypes["level"] = basetypes.StringType{}.TerraformType(ctx)
+
+	objectType := tftypes.Object{AttributeTypes: attrTypes}
+
+	switch v.state {
+	case attr.ValueStateKnown:
+		vals := make(map[string]tftypes.Value, 1)
+
+		val, err = v.Level.ToTerraformValue(ctx)
+
+		if err != nil {
+			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
+		}
+
+		vals["level"] = val
+
+		if err := tftypes.ValidateValue(objectType, vals); err != nil {
+			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
+		}
+
+		return tftypes.NewValue(objectType, vals), nil
+	case attr.ValueStateNull:
+		return tftypes.NewValue(objectType, nil), nil
+	case attr.ValueStateUnknown:
+		return tftypes.NewValue(objectType, tftypes.UnknownValue), nil
+	default:
+		panic(fmt.Sprintf("unhandled Object state in ToTerraformValue: %s", v.state))
+	}
+}
+
+func (v LoggingConfigValue) IsNull() bool {
+	return v.state == attr.ValueStateNull
+}
+
+func (v LoggingConfigValue) IsUnknown() bool {
+	return v.state == attr.ValueStateUnknown
+}
+
+func (v LoggingConfigValue) String() string {
+	return "LoggingConfigValue"
+}
+
+func (v LoggingConfigValue) ToObjectValue(ctx context.Context) (basetypes.ObjectValue, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	attributeTypes := map[string]attr.Type{
+		"level": basetypes.StringType{},
+	}
+
+	if v.IsNull() {
+		return types.ObjectNull(attributeTypes), diags
+	}
+
+	if v.IsUnknown() {
+		return types.ObjectUnknown(attributeTypes), diags
+	}
+
+	objVal, diags := types.ObjectValue(
+		attributeTypes,
+		map[string]attr.Value{
+			"level": v.Level,
+		})
+
+	return objVal, diags
+}
+
+func (v LoggingConfigValue) Equal(o attr.Value) bool {
+	other, ok := o.(LoggingConfigValue)
+
+	if !ok {
+		return false
+	}
+
+	if v.state != other.state {
+		return false
+	}
+
+	if v.state != attr.ValueStateKnown {
+		return true
+	}
+
+	if !v.Level.Equal(other.Level) {
+		return false
+	}
+
+	return true
+}
+
+func (v LoggingConfigValue) Type(ctx context.Context) attr.Type {
+	return LoggingConfigType{
+		basetypes.ObjectType{
+			AttrTypes: v.AttributeTypes(ctx),
+		},
+	}
+}
+
+func (v LoggingConfigValue) AttributeTypes(ctx context.Context) map[string]attr.Type {
+	return map[string]attr.Type{
+		"level": basetypes.StringType{},
+	}
+}
+
+func (v ComputeValue) ToApisdkCompute(ctx context.Context) (*apisdk.Compute, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if v.IsNull() {
+		return nil, diags
+	}
+
+	if v.IsUnknown() {
+		diags.Append(diag.NewErrorDiagnostic(
+			"ComputeValue Value Is Unknown",
+			`"ComputeValue" is unknown.`,
+		))
+
+		return nil, diags
+	}
+
+	apisdkRegion, d := v.Region.ToApisdkRegion(ctx)
+
+	diags.Append(d...)
+
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	return &apisdk.Compute{
+		Region: apisdkRegion,
+	}, diags
+}
+
+func (v ComputeValue) FromApisdkCompute(ctx context.Context, apiObject *apisdk.Compute) (ComputeValue, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if apiObject == nil {
+		return NewComputeValueNull(), diags
+	}
+
+	regionVal, d := ComputeRegionValue{}.FromApisdkRegion(ctx, apiObject.Region)
+
+	diags.Append(d...)
+
+	if diags.HasError() {
+		return NewComputeValueUnknown(), diags
+	}
+
+	return ComputeValue{
+		Region: regionVal,
+		state:  attr.ValueStateKnown,
+	}, diags
+}
+
+func (v ComputeRegionValue) ToApisdkRegion(ctx context.Context) (*apisdk.Region, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if v.IsNull() {
+		return nil, diags
+	}
+
+	if v.IsUnknown() {
+		diags.Append(diag.NewErrorDiagnostic(
+			"ComputeRegionValue Value Is Unknown",
+			`"ComputeRegionValue" is unknown.`,
+		))
+
+		return nil, diags
+	}
+
+	a := apisdk.Region(v.ValueStringPointer())
+
+	return &a, diags
+}
+
+func (v ComputeRegionValue) FromApisdkRegion(ctx context.Context, apiObject *apisdk.Region) (ComputeRegionValue, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if apiObject == nil {
+		return ComputeRegionValue{
+			types.StringNull(),
+		}, diags
+	}
+
+	return ComputeRegionValue{
+		types.StringPointerValue(*apiObject),
+	}, diags
+}
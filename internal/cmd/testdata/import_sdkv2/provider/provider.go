@@ -0,0 +1,29 @@
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func Provider() *schema.Provider {
+	return &schema.Provider{
+		Schema: map[string]*schema.Schema{
+			"endpoint": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "API endpoint.",
+				DefaultFunc: schema.EnvDefaultFunc("EXAMPLE_ENDPOINT", nil),
+			},
+			"token": {
+				Type:      schema.TypeString,
+				Required:  true,
+				Sensitive: true,
+			},
+		},
+		ResourcesMap: map[string]*schema.Resource{
+			"examplecloud_server": resourceServer(),
+		},
+		DataSourcesMap: map[string]*schema.Resource{
+			"examplecloud_server": dataSourceServer(),
+		},
+	}
+}
@@ -0,0 +1,47 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package apisdk
+
+import (
+	"time"
+)
+
+type Metadata struct {
+	Region *string `json:"region,omitempty"`
+}
+
+type Server struct {
+	Metadata
+
+	ID        *string           `json:"id,omitempty"`
+	Name      *string           `json:"name"`
+	Size      *int64            `json:"size"`
+	Enabled   *bool             `json:"enabled,omitempty"`
+	Weight    *float64          `json:"weight,omitempty"`
+	CreatedAt *time.Time        `json:"createdAt,omitempty"`
+	Count     int               `json:"count"`
+	Tags      []string          `json:"tags,omitempty"`
+	Labels    map[string]string `json:"labels,omitempty"`
+	Network   *Network          `json:"network,omitempty"`
+	Volumes   []Volume          `json:"volumes,omitempty"`
+	Parent    *Server           `json:"parent,omitempty"`
+	Events    chan string       `json:"events"`
+	Client    func()            `json:"-"`
+
+	internal string
+}
+
+type Network struct {
+	SubnetId *string  `json:"subnet_id"`
+	Firewall Firewall `json:"firewall"`
+}
+
+type Firewall struct {
+	Enabled *bool `json:"enabled"`
+}
+
+type Volume struct {
+	Size    *int64             `json:"size"`
+	Options map[string]*string `json:"options,omitempty"`
+}
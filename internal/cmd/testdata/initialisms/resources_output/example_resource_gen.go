@@ -0,0 +1,807 @@
+// Code generated by terraform-plugin-framework-generator DO NOT EDIT.
+
+package generated
+
+import (
+	"context"
+	"example.com/apisdk"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+)
+
+func ExampleResourceSchema(ctx context.Context) schema.Schema {
+	return schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"api_url": schema.StringAttribute{
+				Optional: true,
+			},
+			"id": schema.StringAttribute{
+				Computed: true,
+			},
+			"tls_settings": schema.ListNestedAttribute{
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"min_tls_version": schema.StringAttribute{
+							Optional: true,
+						},
+					},
+					CustomType: TLSSettingsType{
+						ObjectType: types.ObjectType{
+							AttrTypes: TLSSettingsValue{}.AttributeTypes(ctx),
+						},
+					},
+				},
+				Optional: true,
+			},
+			"vpc_config": schema.SingleNestedAttribute{
+				Attributes: map[string]schema.Attribute{
+					"cidr_block": schema.StringAttribute{
+						Optional: true,
+					},
+					"subnet_id": schema.StringAttribute{
+						Optional: true,
+					},
+				},
+				CustomType: VPCConfigType{
+					ObjectType: types.ObjectType{
+						AttrTypes: VPCConfigValue{}.AttributeTypes(ctx),
+					},
+				},
+				Optional: true,
+			},
+		},
+	}
+}
+
+type ExampleModel struct {
+	APIURL      types.String   `tfsdk:"api_url"`
+	ID          types.String   `tfsdk:"id"`
+	TLSSettings types.List     `tfsdk:"tls_settings"`
+	VPCConfig   VPCConfigValue `tfsdk:"vpc_config"`
+}
+
+var _ basetypes.ObjectTypable = TLSSettingsType{}
+
+type TLSSettingsType struct {
+	basetypes.ObjectType
+}
+
+func (t TLSSettingsType) Equal(o attr.Type) bool {
+	other, ok := o.(TLSSettingsType)
+
+	if !ok {
+		return false
+	}
+
+	return t.ObjectType.Equal(other.ObjectType)
+}
+
+func (t TLSSettingsType) String() string {
+	return "TLSSettingsType"
+}
+
+func (t TLSSettingsType) ValueFromObject(ctx context.Context, in basetypes.ObjectValue) (basetypes.ObjectValuable, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	attributes := in.Attributes()
+
+	minTLSVersionAttribute, ok := attributes["min_tls_version"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`min_tls_version is missing from object`)
+
+		return nil, diags
+	}
+
+	minTLSVersionVal, ok := minTLSVersionAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`min_tls_version expected to be basetypes.StringValue, was: %T`, minTLSVersionAttribute))
+	}
+
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	return TLSSettingsValue{
+		MinTLSVersion: minTLSVersionVal,
+		state:         attr.ValueStateKnown,
+	}, diags
+}
+
+func NewTLSSettingsValueNull() TLSSettingsValue {
+	return TLSSettingsValue{
+		state: attr.ValueStateNull,
+	}
+}
+
+func NewTLSSettingsValueUnknown() TLSSettingsValue {
+	return TLSSettingsValue{
+		state: attr.ValueStateUnknown,
+	}
+}
+
+func NewTLSSettingsValue(attributeTypes map[string]attr.Type, attributes map[string]attr.Value) (TLSSettingsValue, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	// Reference: https://github.com/hashicorp/terraform-plugin-framework/issues/521
+	ctx := context.Background()
+
+	for name, attributeType := range attributeTypes {
+		attribute, ok := attributes[name]
+
+		if !ok {
+			diags.AddError(
+				"Missing TLSSettingsValue Attribute Value",
+				"While creating a TLSSettingsValue value, a missing attribute value was detected. "+
+					"A TLSSettingsValue must contain values for all attributes, even if null or unknown. "+
+					"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+					fmt.Sprintf("TLSSettingsValue Attribute Name (%s) Expected Type: %s", name, attributeType.String()),
+			)
+
+			continue
+		}
+
+		if !attributeType.Equal(attribute.Type(ctx)) {
+			diags.AddError(
+				"Invalid TLSSettingsValue Attribute Type",
+				"While creating a TLSSettingsValue value, an invalid attribute value was detected. "+
+					"A TLSSettingsValue must use a matching attribute type for the value. "+
+					"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+					fmt.Sprintf("TLSSettingsValue Attribute Name (%s) Expected Type: %s\n", name, attributeType.String())+
+					fmt.Sprintf("TLSSettingsValue Attribute Name (%s) Given Type: %s", name, attribute.Type(ctx)),
+			)
+		}
+	}
+
+	for name := range attributes {
+		_, ok := attributeTypes[name]
+
+		if !ok {
+			diags.AddError(
+				"Extra TLSSettingsValue Attribute Value",
+				"While creating a TLSSettingsValue value, an extra attribute value was detected. "+
+					"A TLSSettingsValue must not contain values beyond the expected attribute types. "+
+					"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+					fmt.Sprintf("Extra TLSSettingsValue Attribute Name: %s", name),
+			)
+		}
+	}
+
+	if diags.HasError() {
+		return NewTLSSettingsValueUnknown(), diags
+	}
+
+	minTLSVersionAttribute, ok := attributes["min_tls_version"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`min_tls_version is missing from object`)
+
+		return NewTLSSettingsValueUnknown(), diags
+	}
+
+	minTLSVersionVal, ok := minTLSVersionAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`min_tls_version expected to be basetypes.StringValue, was: %T`, minTLSVersionAttribute))
+	}
+
+	if diags.HasError() {
+		return NewTLSSettingsValueUnknown(), diags
+	}
+
+	return TLSSettingsValue{
+		MinTLSVersion: minTLSVersionVal,
+		state:         attr.ValueStateKnown,
+	}, diags
+}
+
+func NewTLSSettingsValueMust(attributeTypes map[string]attr.Type, attributes map[string]attr.Value) TLSSettingsValue {
+	object, diags := NewTLSSettingsValue(attributeTypes, attributes)
+
+	if diags.HasError() {
+		// This could potentially be added to the diag package.
+		diagsStrings := make([]string, 0, len(diags))
+
+		for _, diagnostic := range diags {
+			diagsStrings = append(diagsStrings, fmt.Sprintf(
+				"%s | %s | %s",
+				diagnostic.Severity(),
+				diagnostic.Summary(),
+				diagnostic.Detail()))
+		}
+
+		panic("NewTLSSettingsValueMust received error(s): " + strings.Join(diagsStrings, "\n"))
+	}
+
+	return object
+}
+
+func (t TLSSettingsType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	if in.Type() == nil {
+		return NewTLSSettingsValueNull(), nil
+	}
+
+	if !in.Type().Equal(t.TerraformType(ctx)) {
+		return nil, fmt.Errorf("expected %s, got %s", t.TerraformType(ctx), in.Type())
+	}
+
+	if !in.IsKnown() {
+		return NewTLSSettingsValueUnknown(), nil
+	}
+
+	if in.IsNull() {
+		return NewTLSSettingsValueNull(), nil
+	}
+
+	attributes := map[string]attr.Value{}
+
+	val := map[string]tftypes.Value{}
+
+	err := in.As(&val)
+
+	if err != nil {
+		return nil, err
+	}
+
+	for k, v := range val {
+		a, err := t.AttrTypes[k].ValueFromTerraform(ctx, v)
+
+		if err != nil {
+			return nil, err
+		}
+
+		attributes[k] = a
+	}
+
+	return NewTLSSettingsValueMust(TLSSettingsValue{}.AttributeTypes(ctx), attributes), nil
+}
+
+func (t TLSSettingsType) ValueType(ctx context.Context) attr.Value {
+	return TLSSettingsValue{}
+}
+
+var _ basetypes.ObjectValuable = TLSSettingsValue{}
+
+type TLSSettingsValue struct {
+	MinTLSVersion basetypes.StringValue `tfsdk:"min_tls_version"`
+	state         attr.ValueState
+}
+
+func (v TLSSettingsValue) ToTerraformValue(ctx context.Context) (tftypes.Value, error) {
+	attrTypes := make(map[string]tftypes.Type, 1)
+
+	var val tftypes.Value
+	var err error
+
+	attrTypes["min_tls_version"] = basetypes.StringType{}.TerraformType(ctx)
+
+	objectType := tftypes.Object{AttributeTypes: attrTypes}
+
+	switch v.state {
+	case attr.ValueStateKnown:
+		vals := make(map[string]tftypes.Value, 1)
+
+		val, err = v.MinTLSVersion.ToTerraformValue(ctx)
+
+		if err != nil {
+			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
+		}
+
+		vals["min_tls_version"] = val
+
+		if err := tftypes.ValidateValue(objectType, vals); err != nil {
+			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
+		}
+
+		return tftypes.NewValue(objectType, vals), nil
+	case attr.ValueStateNull:
+		return tftypes.NewValue(objectType, nil), nil
+	case attr.ValueStateUnknown:
+		return tftypes.NewValue(objectType, tftypes.UnknownValue), nil
+	default:
+		panic(fmt.Sprintf("unhandled Object state in ToTerraformValue: %s", v.state))
+	}
+}
+
+func (v TLSSettingsValue) IsNull() bool {
+	return v.state == attr.ValueStateNull
+}
+
+func (v TLSSettingsValue) IsUnknown() bool {
+	return v.state == attr.ValueStateUnknown
+}
+
+func (v TLSSettingsValue) String() string {
+	return "TLSSettingsValue"
+}
+
+func (v TLSSettingsValue) ToObjectValue(ctx context.Context) (basetypes.ObjectValue, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	attributeTypes := map[string]attr.Type{
+		"min_tls_version": basetypes.StringType{},
+	}
+
+	if v.IsNull() {
+		return types.ObjectNull(attributeTypes), diags
+	}
+
+	if v.IsUnknown() {
+		return types.ObjectUnknown(attributeTypes), diags
+	}
+
+	objVal, diags := types.ObjectValue(
+		attributeTypes,
+		map[string]attr.Value{
+			"min_tls_version": v.MinTLSVersion,
+		})
+
+	return objVal, diags
+}
+
+func (v TLSSettingsValue) Equal(o attr.Value) bool {
+	other, ok := o.(TLSSettingsValue)
+
+	if !ok {
+		return false
+	}
+
+	if v.state != other.state {
+		return false
+	}
+
+	if v.state != attr.ValueStateKnown {
+		return true
+	}
+
+	if !v.MinTLSVersion.Equal(other.MinTLSVersion) {
+		return false
+	}
+
+	return true
+}
+
+func (v TLSSettingsValue) Type(ctx context.Context) attr.Type {
+	return TLSSettingsType{
+		basetypes.ObjectType{
+			AttrTypes: v.AttributeTypes(ctx),
+		},
+	}
+}
+
+func (v TLSSettingsValue) AttributeTypes(ctx context.Context) map[string]attr.Type {
+	return map[string]attr.Type{
+		"min_tls_version": basetypes.StringType{},
+	}
+}
+
+var _ basetypes.ObjectTypable = VPCConfigType{}
+
+type VPCConfigType struct {
+	basetypes.ObjectType
+}
+
+func (t VPCConfigType) Equal(o attr.Type) bool {
+	other, ok := o.(VPCConfigType)
+
+	if !ok {
+		return false
+	}
+
+	return t.ObjectType.Equal(other.ObjectType)
+}
+
+func (t VPCConfigType) String() string {
+	return "VPCConfigType"
+}
+
+func (t VPCConfigType) ValueFromObject(ctx context.Context, in basetypes.ObjectValue) (basetypes.ObjectValuable, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	attributes := in.Attributes()
+
+	cidrBlockAttribute, ok := attributes["cidr_block"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`cidr_block is missing from object`)
+
+		return nil, diags
+	}
+
+	cidrBlockVal, ok := cidrBlockAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`cidr_block expected to be basetypes.StringValue, was: %T`, cidrBlockAttribute))
+	}
+
+	subnetIDAttribute, ok := attributes["subnet_id"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`subnet_id is missing from object`)
+
+		return nil, diags
+	}
+
+	subnetIDVal, ok := subnetIDAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`subnet_id expected to be basetypes.StringValue, was: %T`, subnetIDAttribute))
+	}
+
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	return VPCConfigValue{
+		CIDRBlock: cidrBlockVal,
+		SubnetID:  subnetIDVal,
+		state:     attr.ValueStateKnown,
+	}, diags
+}
+
+func NewVPCConfigValueNull() VPCConfigValue {
+	return VPCConfigValue{
+		state: attr.ValueStateNull,
+	}
+}
+
+func NewVPCConfigValueUnknown() VPCConfigValue {
+	return VPCConfigValue{
+		state: attr.ValueStateUnknown,
+	}
+}
+
+func NewVPCConfigValue(attributeTypes map[string]attr.Type, attributes map[string]attr.Value) (VPCConfigValue, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	// Reference: https://github.com/hashicorp/terraform-plugin-framework/issues/521
+	ctx := context.Background()
+
+	for name, attributeType := range attributeTypes {
+		attribute, ok := attributes[name]
+
+		if !ok {
+			diags.AddError(
+				"Missing VPCConfigValue Attribute Value",
+				"While creating a VPCConfigValue value, a missing attribute value was detected. "+
+					"A VPCConfigValue must contain values for all attributes, even if null or unknown. "+
+					"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+					fmt.Sprintf("VPCConfigValue Attribute Name (%s) Expected Type: %s", name, attributeType.String()),
+			)
+
+			continue
+		}
+
+		if !attributeType.Equal(attribute.Type(ctx)) {
+			diags.AddError(
+				"Invalid VPCConfigValue Attribute Type",
+				"While creating a VPCConfigValue value, an invalid attribute value was detected. "+
+					"A VPCConfigValue must use a matching attribute type for the value. "+
+					"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+					fmt.Sprintf("VPCConfigValue Attribute Name (%s) Expected Type: %s\n", name, attributeType.String())+
+					fmt.Sprintf("VPCConfigValue Attribute Name (%s) Given Type: %s", name, attribute.Type(ctx)),
+			)
+		}
+	}
+
+	for name := range attributes {
+		_, ok := attributeTypes[name]
+
+		if !ok {
+			diags.AddError(
+				"Extra VPCConfigValue Attribute Value",
+				"While creating a VPCConfigValue value, an extra attribute value was detected. "+
+					"A VPCConfigValue must not contain values beyond the expected attribute types. "+
+					"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+					fmt.Sprintf("Extra VPCConfigValue Attribute Name: %s", name),
+			)
+		}
+	}
+
+	if diags.HasError() {
+		return NewVPCConfigValueUnknown(), diags
+	}
+
+	cidrBlockAttribute, ok := attributes["cidr_block"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`cidr_block is missing from object`)
+
+		return NewVPCConfigValueUnknown(), diags
+	}
+
+	cidrBlockVal, ok := cidrBlockAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`cidr_block expected to be basetypes.StringValue, was: %T`, cidrBlockAttribute))
+	}
+
+	subnetIDAttribute, ok := attributes["subnet_id"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`subnet_id is missing from object`)
+
+		return NewVPCConfigValueUnknown(), diags
+	}
+
+	subnetIDVal, ok := subnetIDAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`subnet_id expected to be basetypes.StringValue, was: %T`, subnetIDAttribute))
+	}
+
+	if diags.HasError() {
+		return NewVPCConfigValueUnknown(), diags
+	}
+
+	return VPCConfigValue{
+		CIDRBlock: cidrBlockVal,
+		SubnetID:  subnetIDVal,
+		state:     attr.ValueStateKnown,
+	}, diags
+}
+
+func NewVPCConfigValueMust(attributeTypes map[string]attr.Type, attributes map[string]attr.Value) VPCConfigValue {
+	object, diags := NewVPCConfigValue(attributeTypes, attributes)
+
+	if diags.HasError() {
+		// This could potentially be added to the diag package.
+		diagsStrings := make([]string, 0, len(diags))
+
+		for _, diagnostic := range diags {
+			diagsStrings = append(diagsStrings, fmt.Sprintf(
+				"%s | %s | %s",
+				diagnostic.Severity(),
+				diagnostic.Summary(),
+				diagnostic.Detail()))
+		}
+
+		panic("NewVPCConfigValueMust received error(s): " + strings.Join(diagsStrings, "\n"))
+	}
+
+	return object
+}
+
+func (t VPCConfigType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	if in.Type() == nil {
+		return NewVPCConfigValueNull(), nil
+	}
+
+	if !in.Type().Equal(t.TerraformType(ctx)) {
+		return nil, fmt.Errorf("expected %s, got %s", t.TerraformType(ctx), in.Type())
+	}
+
+	if !in.IsKnown() {
+		return NewVPCConfigValueUnknown(), nil
+	}
+
+	if in.IsNull() {
+		return NewVPCConfigValueNull(), nil
+	}
+
+	attributes := map[string]attr.Value{}
+
+	val := map[string]tftypes.Value{}
+
+	err := in.As(&val)
+
+	if err != nil {
+		return nil, err
+	}
+
+	for k, v := range val {
+		a, err := t.AttrTypes[k].ValueFromTerraform(ctx, v)
+
+		if err != nil {
+			return nil, err
+		}
+
+		attributes[k] = a
+	}
+
+	return NewVPCConfigValueMust(VPCConfigValue{}.AttributeTypes(ctx), attributes), nil
+}
+
+func (t VPCConfigType) ValueType(ctx context.Context) attr.Value {
+	return VPCConfigValue{}
+}
+
+var _ basetypes.ObjectValuable = VPCConfigValue{}
+
+type VPCConfigValue struct {
+	CIDRBlock basetypes.StringValue `tfsdk:"cidr_block"`
+	SubnetID  basetypes.StringValue `tfsdk:"subnet_id"`
+	state     attr.ValueState
+}
+
+func (v VPCConfigValue) ToTerraformValue(ctx context.Context) (tftypes.Value, error) {
+	attrTypes := make(map[string]tftypes.Type, 2)
+
+	var val tftypes.Value
+	var err error
+
+	attrTypes["cidr_block"] = basetypes.StringType{}.TerraformType(ctx)
+	attrTypes["subnet_id"] = basetypes.StringType{}.TerraformType(ctx)
+
+	objectType := tftypes.Object{AttributeTypes: attrTypes}
+
+	switch v.state {
+	case attr.ValueStateKnown:
+		vals := make(map[string]tftypes.Value, 2)
+
+		val, err = v.CIDRBlock.ToTerraformValue(ctx)
+
+		if err != nil {
+			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
+		}
+
+		vals["cidr_block"] = val
+
+		val, err = v.SubnetID.ToTerraformValue(ctx)
+
+		if err != nil {
+			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
+		}
+
+		vals["subnet_id"] = val
+
+		if err := tftypes.ValidateValue(objectType, vals); err != nil {
+			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
+		}
+
+		return tftypes.NewValue(objectType, vals), nil
+	case attr.ValueStateNull:
+		return tftypes.NewValue(objectType, nil), nil
+	case attr.ValueStateUnknown:
+		return tftypes.NewValue(objectType, tftypes.UnknownValue), nil
+	default:
+		panic(fmt.Sprintf("unhandled Object state in ToTerraformValue: %s", v.state))
+	}
+}
+
+func (v VPCConfigValue) IsNull() bool {
+	return v.state == attr.ValueStateNull
+}
+
+func (v VPCConfigValue) IsUnknown() bool {
+	return v.state == attr.ValueStateUnknown
+}
+
+func (v VPCConfigValue) String() string {
+	return "VPCConfigValue"
+}
+
+func (v VPCConfigValue) ToObjectValue(ctx context.Context) (basetypes.ObjectValue, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	attributeTypes := map[string]attr.Type{
+		"cidr_block": basetypes.StringType{},
+		"subnet_id":  basetypes.StringType{},
+	}
+
+	if v.IsNull() {
+		return types.ObjectNull(attributeTypes), diags
+	}
+
+	if v.IsUnknown() {
+		return types.ObjectUnknown(attributeTypes), diags
+	}
+
+	objVal, diags := types.ObjectValue(
+		attributeTypes,
+		map[string]attr.Value{
+			"cidr_block": v.CIDRBlock,
+			"subnet_id":  v.SubnetID,
+		})
+
+	return objVal, diags
+}
+
+func (v VPCConfigValue) Equal(o attr.Value) bool {
+	other, ok := o.(VPCConfigValue)
+
+	if !ok {
+		return false
+	}
+
+	if v.state != other.state {
+		return false
+	}
+
+	if v.state != attr.ValueStateKnown {
+		return true
+	}
+
+	if !v.CIDRBlock.Equal(other.CIDRBlock) {
+		return false
+	}
+
+	if !v.SubnetID.Equal(other.SubnetID) {
+		return false
+	}
+
+	return true
+}
+
+func (v VPCConfigValue) Type(ctx context.Context) attr.Type {
+	return VPCConfigType{
+		basetypes.ObjectType{
+			AttrTypes: v.AttributeTypes(ctx),
+		},
+	}
+}
+
+func (v VPCConfigValue) AttributeTypes(ctx context.Context) map[string]attr.Type {
+	return map[string]attr.Type{
+		"cidr_block": basetypes.StringType{},
+		"subnet_id":  basetypes.StringType{},
+	}
+}
+
+func (v VPCConfigValue) ToApisdkVPCConfig(ctx context.Context) (*apisdk.VPCConfig, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if v.IsNull() {
+		return nil, diags
+	}
+
+	if v.IsUnknown() {
+		diags.Append(diag.NewErrorDiagnostic(
+			"VPCConfigValue Value Is Unknown",
+			`"VPCConfigValue" is unknown.`,
+		))
+
+		return nil, diags
+	}
+
+	return &apisdk.VPCConfig{
+		CIDRBlock: v.CIDRBlock.ValueStringPointer(),
+		SubnetID:  v.SubnetID.ValueStringPointer(),
+	}, diags
+}
+
+func (v VPCConfigValue) FromApisdkVPCConfig(ctx context.Context, apiObject *apisdk.VPCConfig) (VPCConfigValue, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if apiObject == nil {
+		return NewVPCConfigValueNull(), diags
+	}
+
+	return VPCConfigValue{
+		CIDRBlock: types.StringPointerValue(apiObject.CIDRBlock),
+		SubnetID:  types.StringPointerValue(apiObject.SubnetID),
+		state:     attr.ValueStateKnown,
+	}, diags
+}
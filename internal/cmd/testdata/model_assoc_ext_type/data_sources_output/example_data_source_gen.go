@@ -0,0 +1,57 @@
+// Code generated by terraform-plugin-framework-generator DO NOT EDIT.
+
+package generated
+
+import (
+	"context"
+	"example.com/apisdk"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+)
+
+func ExampleDataSourceSchema(ctx context.Context) schema.Schema {
+	return schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Required: true,
+			},
+			"name": schema.StringAttribute{
+				Computed: true,
+			},
+		},
+	}
+}
+
+type ExampleModel struct {
+	Id   types.String `tfsdk:"id"`
+	Name types.String `tfsdk:"name"`
+}
+
+func (m ExampleModel) ToApisdkExampleOutput(ctx context.Context) (*apisdk.ExampleOutput, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	return &apisdk.ExampleOutput{
+		Id:   m.Id.ValueStringPointer(),
+		Name: m.Name.ValueStringPointer(),
+	}, diags
+}
+
+func ExampleModelFromApisdkExampleOutput(ctx context.Context, apiObject *apisdk.ExampleOutput) (ExampleModel, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if apiObject == nil {
+		diags.Append(diag.NewErrorDiagnostic(
+			"ExampleModel From ApisdkExampleOutput Is Nil",
+			`"*apisdk.ExampleOutput" is nil.`,
+		))
+
+		return ExampleModel{}, diags
+	}
+
+	return ExampleModel{
+		Id:   types.StringPointerValue(apiObject.Id),
+		Name: types.StringPointerValue(apiObject.Name),
+	}, diags
+}
@@ -0,0 +1,1060 @@
+// Code generated by terraform-plugin-framework-generator DO NOT EDIT.
+
+package generated
+
+import (
+	"context"
+	"example.com/apisdk"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+)
+
+func ExampleResourceSchema(ctx context.Context) schema.Schema {
+	return schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"bool_attribute": schema.BoolAttribute{
+				Optional: true,
+			},
+			"float64_attribute": schema.Float64Attribute{
+				Optional: true,
+			},
+			"int64_attribute": schema.Int64Attribute{
+				Optional: true,
+			},
+			"list_attribute": schema.ListAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"number_attribute": schema.NumberAttribute{
+				Optional: true,
+			},
+			"object_attribute": schema.ObjectAttribute{
+				AttributeTypes: map[string]attr.Type{
+					"string_attribute": types.StringType,
+				},
+				Optional: true,
+			},
+			"single_nested_attribute": schema.SingleNestedAttribute{
+				Attributes: map[string]schema.Attribute{
+					"string_attribute": schema.StringAttribute{
+						Optional: true,
+					},
+				},
+				CustomType: SingleNestedAttributeType{
+					ObjectType: types.ObjectType{
+						AttrTypes: SingleNestedAttributeValue{}.AttributeTypes(ctx),
+					},
+				},
+				Optional: true,
+			},
+			"string_attribute": schema.StringAttribute{
+				CustomType: StringAttributeType{},
+				Optional:   true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"single_nested_block": schema.SingleNestedBlock{
+				Attributes: map[string]schema.Attribute{
+					"string_attribute": schema.StringAttribute{
+						Optional: true,
+					},
+				},
+				CustomType: SingleNestedBlockType{
+					ObjectType: types.ObjectType{
+						AttrTypes: SingleNestedBlockValue{}.AttributeTypes(ctx),
+					},
+				},
+			},
+		},
+	}
+}
+
+type ExampleModel struct {
+	BoolAttribute         types.Bool                 `tfsdk:"bool_attribute"`
+	Float64Attribute      types.Float64              `tfsdk:"float64_attribute"`
+	Int64Attribute        types.Int64                `tfsdk:"int64_attribute"`
+	ListAttribute         types.List                 `tfsdk:"list_attribute"`
+	NumberAttribute       types.Number               `tfsdk:"number_attribute"`
+	ObjectAttribute       types.Object               `tfsdk:"object_attribute"`
+	SingleNestedAttribute SingleNestedAttributeValue `tfsdk:"single_nested_attribute"`
+	StringAttribute       StringAttributeValue       `tfsdk:"string_attribute"`
+	SingleNestedBlock     SingleNestedBlockValue     `tfsdk:"single_nested_block"`
+}
+
+var _ basetypes.ObjectTypable = SingleNestedAttributeType{}
+
+type SingleNestedAttributeType struct {
+	basetypes.ObjectType
+}
+
+func (t SingleNestedAttributeType) Equal(o attr.Type) bool {
+	other, ok := o.(SingleNestedAttributeType)
+
+	if !ok {
+		return false
+	}
+
+	return t.ObjectType.Equal(other.ObjectType)
+}
+
+func (t SingleNestedAttributeType) String() string {
+	return "SingleNestedAttributeType"
+}
+
+func (t SingleNestedAttributeType) ValueFromObject(ctx context.Context, in basetypes.ObjectValue) (basetypes.ObjectValuable, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	attributes := in.Attributes()
+
+	stringAttributeAttribute, ok := attributes["string_attribute"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`string_attribute is missing from object`)
+
+		return nil, diags
+	}
+
+	stringAttributeVal, ok := stringAttributeAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`string_attribute expected to be basetypes.StringValue, was: %T`, stringAttributeAttribute))
+	}
+
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	return SingleNestedAttributeValue{
+		StringAttribute: stringAttributeVal,
+		state:           attr.ValueStateKnown,
+	}, diags
+}
+
+func NewSingleNestedAttributeValueNull() SingleNestedAttributeValue {
+	return SingleNestedAttributeValue{
+		state: attr.ValueStateNull,
+	}
+}
+
+func NewSingleNestedAttributeValueUnknown() SingleNestedAttributeValue {
+	return SingleNestedAttributeValue{
+		state: attr.ValueStateUnknown,
+	}
+}
+
+func NewSingleNestedAttributeValue(attributeTypes map[string]attr.Type, attributes map[string]attr.Value) (SingleNestedAttributeValue, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	// Reference: https://github.com/hashicorp/terraform-plugin-framework/issues/521
+	ctx := context.Background()
+
+	for name, attributeType := range attributeTypes {
+		attribute, ok := attributes[name]
+
+		if !ok {
+			diags.AddError(
+				"Missing SingleNestedAttributeValue Attribute Value",
+				"While creating a SingleNestedAttributeValue value, a missing attribute value was detected. "+
+					"A SingleNestedAttributeValue must contain values for all attributes, even if null or unknown. "+
+					"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+					fmt.Sprintf("SingleNestedAttributeValue Attribute Name (%s) Expected Type: %s", name, attributeType.String()),
+			)
+
+			continue
+		}
+
+		if !attributeType.Equal(attribute.Type(ctx)) {
+			diags.AddError(
+				"Invalid SingleNestedAttributeValue Attribute Type",
+				"While creating a SingleNestedAttributeValue value, an invalid attribute value was detected. "+
+					"A SingleNestedAttributeValue must use a matching attribute type for the value. "+
+					"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+					fmt.Sprintf("SingleNestedAttributeValue Attribute Name (%s) Expected Type: %s\n", name, attributeType.String())+
+					fmt.Sprintf("SingleNestedAttributeValue Attribute Name (%s) Given Type: %s", name, attribute.Type(ctx)),
+			)
+		}
+	}
+
+	for name := range attributes {
+		_, ok := attributeTypes[name]
+
+		if !ok {
+			diags.AddError(
+				"Extra SingleNestedAttributeValue Attribute Value",
+				"While creating a SingleNestedAttributeValue value, an extra attribute value was detected. "+
+					"A SingleNestedAttributeValue must not contain values beyond the expected attribute types. "+
+					"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+					fmt.Sprintf("Extra SingleNestedAttributeValue Attribute Name: %s", name),
+			)
+		}
+	}
+
+	if diags.HasError() {
+		return NewSingleNestedAttributeValueUnknown(), diags
+	}
+
+	stringAttributeAttribute, ok := attributes["string_attribute"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`string_attribute is missing from object`)
+
+		return NewSingleNestedAttributeValueUnknown(), diags
+	}
+
+	stringAttributeVal, ok := stringAttributeAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`string_attribute expected to be basetypes.StringValue, was: %T`, stringAttributeAttribute))
+	}
+
+	if diags.HasError() {
+		return NewSingleNestedAttributeValueUnknown(), diags
+	}
+
+	return SingleNestedAttributeValue{
+		StringAttribute: stringAttributeVal,
+		state:           attr.ValueStateKnown,
+	}, diags
+}
+
+func NewSingleNestedAttributeValueMust(attributeTypes map[string]attr.Type, attributes map[string]attr.Value) SingleNestedAttributeValue {
+	object, diags := NewSingleNestedAttributeValue(attributeTypes, attributes)
+
+	if diags.HasError() {
+		// This could potentially be added to the diag package.
+		diagsStrings := make([]string, 0, len(diags))
+
+		for _, diagnostic := range diags {
+			diagsStrings = append(diagsStrings, fmt.Sprintf(
+				"%s | %s | %s",
+				diagnostic.Severity(),
+				diagnostic.Summary(),
+				diagnostic.Detail()))
+		}
+
+		panic("NewSingleNestedAttributeValueMust received error(s): " + strings.Join(diagsStrings, "\n"))
+	}
+
+	return object
+}
+
+func (t SingleNestedAttributeType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	if in.Type() == nil {
+		return NewSingleNestedAttributeValueNull(), nil
+	}
+
+	if !in.Type().Equal(t.TerraformType(ctx)) {
+		return nil, fmt.Errorf("expected %s, got %s", t.TerraformType(ctx), in.Type())
+	}
+
+	if !in.IsKnown() {
+		return NewSingleNestedAttributeValueUnknown(), nil
+	}
+
+	if in.IsNull() {
+		return NewSingleNestedAttributeValueNull(), nil
+	}
+
+	attributes := map[string]attr.Value{}
+
+	val := map[string]tftypes.Value{}
+
+	err := in.As(&val)
+
+	if err != nil {
+		return nil, err
+	}
+
+	for k, v := range val {
+		a, err := t.AttrTypes[k].ValueFromTerraform(ctx, v)
+
+		if err != nil {
+			return nil, err
+		}
+
+		attributes[k] = a
+	}
+
+	return NewSingleNestedAttributeValueMust(SingleNestedAttributeValue{}.AttributeTypes(ctx), attributes), nil
+}
+
+func (t SingleNestedAttributeType) ValueType(ctx context.Context) attr.Value {
+	return SingleNestedAttributeValue{}
+}
+
+var _ basetypes.ObjectValuable = SingleNestedAttributeValue{}
+
+type SingleNestedAttributeValue struct {
+	StringAttribute basetypes.StringValue `tfsdk:"string_attribute"`
+	state           attr.ValueState
+}
+
+func (v SingleNestedAttributeValue) ToTerraformValue(ctx context.Context) (tftypes.Value, error) {
+	attrTypes := make(map[string]tftypes.Type, 1)
+
+	var val tftypes.Value
+	var err error
+
+	attrTypes["string_attribute"] = basetypes.StringType{}.TerraformType(ctx)
+
+	objectType := tftypes.Object{AttributeTypes: attrTypes}
+
+	switch v.state {
+	case attr.ValueStateKnown:
+		vals := make(map[string]tftypes.Value, 1)
+
+		val, err = v.StringAttribute.ToTerraformValue(ctx)
+
+		if err != nil {
+			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
+		}
+
+		vals["string_attribute"] = val
+
+		if err := tftypes.ValidateValue(objectType, vals); err != nil {
+			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
+		}
+
+		return tftypes.NewValue(objectType, vals), nil
+	case attr.ValueStateNull:
+		return tftypes.NewValue(objectType, nil), nil
+	case attr.ValueStateUnknown:
+		return tftypes.NewValue(objectType, tftypes.UnknownValue), nil
+	default:
+		panic(fmt.Sprintf("unhandled Object state in ToTerraformValue: %s", v.state))
+	}
+}
+
+func (v SingleNestedAttributeValue) IsNull() bool {
+	return v.state == attr.ValueStateNull
+}
+
+func (v SingleNestedAttributeValue) IsUnknown() bool {
+	return v.state == attr.ValueStateUnknown
+}
+
+func (v SingleNestedAttributeValue) String() string {
+	return "SingleNestedAttributeValue"
+}
+
+func (v SingleNestedAttributeValue) ToObjectValue(ctx context.Context) (basetypes.ObjectValue, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	attributeTypes := map[string]attr.Type{
+		"string_attribute": basetypes.StringType{},
+	}
+
+	if v.IsNull() {
+		return types.ObjectNull(attributeTypes), diags
+	}
+
+	if v.IsUnknown() {
+		return types.ObjectUnknown(attributeTypes), diags
+	}
+
+	objVal, diags := types.ObjectValue(
+		attributeTypes,
+		map[string]attr.Value{
+			"string_attribute": v.StringAttribute,
+		})
+
+	return objVal, diags
+}
+
+func (v SingleNestedAttributeValue) Equal(o attr.Value) bool {
+	other, ok := o.(SingleNestedAttributeValue)
+
+	if !ok {
+		return false
+	}
+
+	if v.state != other.state {
+		return false
+	}
+
+	if v.state != attr.ValueStateKnown {
+		return true
+	}
+
+	if !v.StringAttribute.Equal(other.StringAttribute) {
+		return false
+	}
+
+	return true
+}
+
+func (v SingleNestedAttributeValue) Type(ctx context.Context) attr.Type {
+	return SingleNestedAttributeType{
+		basetypes.ObjectType{
+			AttrTypes: v.AttributeTypes(ctx),
+		},
+	}
+}
+
+func (v SingleNestedAttributeValue) AttributeTypes(ctx context.Context) map[string]attr.Type {
+	return map[string]attr.Type{
+		"string_attribute": basetypes.StringType{},
+	}
+}
+
+var _ basetypes.StringTypable = StringAttributeType{}
+
+type StringAttributeType struct {
+	basetypes.StringType
+}
+
+func (t StringAttributeType) Equal(o attr.Type) bool {
+	other, ok := o.(StringAttributeType)
+
+	if !ok {
+		return false
+	}
+
+	return t.StringType.Equal(other.StringType)
+}
+
+func (t StringAttributeType) String() string {
+	return "StringAttributeType"
+}
+
+func (t StringAttributeType) ValueFromString(ctx context.Context, in basetypes.StringValue) (basetypes.StringValuable, diag.Diagnostics) {
+	return StringAttributeValue{
+		StringValue: in,
+	}, nil
+}
+
+func (t StringAttributeType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	attrValue, err := t.StringType.ValueFromTerraform(ctx, in)
+
+	if err != nil {
+		return nil, err
+	}
+
+	boolValue, ok := attrValue.(basetypes.StringValue)
+
+	if !ok {
+		return nil, fmt.Errorf("unexpected value type of %T", attrValue)
+	}
+
+	boolValuable, diags := t.ValueFromString(ctx, boolValue)
+
+	if diags.HasError() {
+		return nil, fmt.Errorf("unexpected error converting StringValue to StringValuable: %v", diags)
+	}
+
+	return boolValuable, nil
+}
+
+func (t StringAttributeType) ValueType(ctx context.Context) attr.Value {
+	return StringAttributeValue{}
+}
+
+var _ basetypes.StringValuable = StringAttributeValue{}
+
+type StringAttributeValue struct {
+	basetypes.StringValue
+}
+
+func (v StringAttributeValue) Equal(o attr.Value) bool {
+	other, ok := o.(StringAttributeValue)
+
+	if !ok {
+		return false
+	}
+
+	return v.StringValue.Equal(other.StringValue)
+}
+
+func (v StringAttributeValue) Type(ctx context.Context) attr.Type {
+	return StringAttributeType{}
+}
+
+var _ basetypes.ObjectTypable = SingleNestedBlockType{}
+
+type SingleNestedBlockType struct {
+	basetypes.ObjectType
+}
+
+func (t SingleNestedBlockType) Equal(o attr.Type) bool {
+	other, ok := o.(SingleNestedBlockType)
+
+	if !ok {
+		return false
+	}
+
+	return t.ObjectType.Equal(other.ObjectType)
+}
+
+func (t SingleNestedBlockType) String() string {
+	return "SingleNestedBlockType"
+}
+
+func (t SingleNestedBlockType) ValueFromObject(ctx context.Context, in basetypes.ObjectValue) (basetypes.ObjectValuable, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	attributes := in.Attributes()
+
+	stringAttributeAttribute, ok := attributes["string_attribute"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`string_attribute is missing from object`)
+
+		return nil, diags
+	}
+
+	stringAttributeVal, ok := stringAttributeAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`string_attribute expected to be basetypes.StringValue, was: %T`, stringAttributeAttribute))
+	}
+
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	return SingleNestedBlockValue{
+		StringAttribute: stringAttributeVal,
+		state:           attr.ValueStateKnown,
+	}, diags
+}
+
+func NewSingleNestedBlockValueNull() SingleNestedBlockValue {
+	return SingleNestedBlockValue{
+		state: attr.ValueStateNull,
+	}
+}
+
+func NewSingleNestedBlockValueUnknown() SingleNestedBlockValue {
+	return SingleNestedBlockValue{
+		state: attr.ValueStateUnknown,
+	}
+}
+
+func NewSingleNestedBlockValue(attributeTypes map[string]attr.Type, attributes map[string]attr.Value) (SingleNestedBlockValue, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	// Reference: https://github.com/hashicorp/terraform-plugin-framework/issues/521
+	ctx := context.Background()
+
+	for name, attributeType := range attributeTypes {
+		attribute, ok := attributes[name]
+
+		if !ok {
+			diags.AddError(
+				"Missing SingleNestedBlockValue Attribute Value",
+				"While creating a SingleNestedBlockValue value, a missing attribute value was detected. "+
+					"A SingleNestedBlockValue must contain values for all attributes, even if null or unknown. "+
+					"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+					fmt.Sprintf("SingleNestedBlockValue Attribute Name (%s) Expected Type: %s", name, attributeType.String()),
+			)
+
+			continue
+		}
+
+		if !attributeType.Equal(attribute.Type(ctx)) {
+			diags.AddError(
+				"Invalid SingleNestedBlockValue Attribute Type",
+				"While creating a SingleNestedBlockValue value, an invalid attribute value was detected. "+
+					"A SingleNestedBlockValue must use a matching attribute type for the value. "+
+					"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+					fmt.Sprintf("SingleNestedBlockValue Attribute Name (%s) Expected Type: %s\n", name, attributeType.String())+
+					fmt.Sprintf("SingleNestedBlockValue Attribute Name (%s) Given Type: %s", name, attribute.Type(ctx)),
+			)
+		}
+	}
+
+	for name := range attributes {
+		_, ok := attributeTypes[name]
+
+		if !ok {
+			diags.AddError(
+				"Extra SingleNestedBlockValue Attribute Value",
+				"While creating a SingleNestedBlockValue value, an extra attribute value was detected. "+
+					"A SingleNestedBlockValue must not contain values beyond the expected attribute types. "+
+					"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+					fmt.Sprintf("Extra SingleNestedBlockValue Attribute Name: %s", name),
+			)
+		}
+	}
+
+	if diags.HasError() {
+		return NewSingleNestedBlockValueUnknown(), diags
+	}
+
+	stringAttributeAttribute, ok := attributes["string_attribute"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`string_attribute is missing from object`)
+
+		return NewSingleNestedBlockValueUnknown(), diags
+	}
+
+	stringAttributeVal, ok := stringAttributeAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`string_attribute expected to be basetypes.StringValue, was: %T`, stringAttributeAttribute))
+	}
+
+	if diags.HasError() {
+		return NewSingleNestedBlockValueUnknown(), diags
+	}
+
+	return SingleNestedBlockValue{
+		StringAttribute: stringAttributeVal,
+		state:           attr.ValueStateKnown,
+	}, diags
+}
+
+func NewSingleNestedBlockValueMust(attributeTypes map[string]attr.Type, attributes map[string]attr.Value) SingleNestedBlockValue {
+	object, diags := NewSingleNestedBlockValue(attributeTypes, attributes)
+
+	if diags.HasError() {
+		// This could potentially be added to the diag package.
+		diagsStrings := make([]string, 0, len(diags))
+
+		for _, diagnostic := range diags {
+			diagsStrings = append(diagsStrings, fmt.Sprintf(
+				"%s | %s | %s",
+				diagnostic.Severity(),
+				diagnostic.Summary(),
+				diagnostic.Detail()))
+		}
+
+		panic("NewSingleNestedBlockValueMust received error(s): " + strings.Join(diagsStrings, "\n"))
+	}
+
+	return object
+}
+
+func (t SingleNestedBlockType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	if in.Type() == nil {
+		return NewSingleNestedBlockValueNull(), nil
+	}
+
+	if !in.Type().Equal(t.TerraformType(ctx)) {
+		return nil, fmt.Errorf("expected %s, got %s", t.TerraformType(ctx), in.Type())
+	}
+
+	if !in.IsKnown() {
+		return NewSingleNestedBlockValueUnknown(), nil
+	}
+
+	if in.IsNull() {
+		return NewSingleNestedBlockValueNull(), nil
+	}
+
+	attributes := map[string]attr.Value{}
+
+	val := map[string]tftypes.Value{}
+
+	err := in.As(&val)
+
+	if err != nil {
+		return nil, err
+	}
+
+	for k, v := range val {
+		a, err := t.AttrTypes[k].ValueFromTerraform(ctx, v)
+
+		if err != nil {
+			return nil, err
+		}
+
+		attributes[k] = a
+	}
+
+	return NewSingleNestedBlockValueMust(SingleNestedBlockValue{}.AttributeTypes(ctx), attributes), nil
+}
+
+func (t SingleNestedBlockType) ValueType(ctx context.Context) attr.Value {
+	return SingleNestedBlockValue{}
+}
+
+var _ basetypes.ObjectValuable = SingleNestedBlockValue{}
+
+type SingleNestedBlockValue struct {
+	StringAttribute basetypes.StringValue `tfsdk:"string_attribute"`
+	state           attr.ValueState
+}
+
+func (v SingleNestedBlockValue) ToTerraformValue(ctx context.Context) (tftypes.Value, error) {
+	attrTypes := make(map[string]tftypes.Type, 1)
+
+	var val tftypes.Value
+	var err error
+
+	attrTypes["string_attribute"] = basetypes.StringType{}.TerraformType(ctx)
+
+	objectType := tftypes.Object{AttributeTypes: attrTypes}
+
+	switch v.state {
+	case attr.ValueStateKnown:
+		vals := make(map[string]tftypes.Value, 1)
+
+		val, err = v.StringAttribute.ToTerraformValue(ctx)
+
+		if err != nil {
+			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
+		}
+
+		vals["string_attribute"] = val
+
+		if err := tftypes.ValidateValue(objectType, vals); err != nil {
+			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
+		}
+
+		return tftypes.NewValue(objectType, vals), nil
+	case attr.ValueStateNull:
+		return tftypes.NewValue(objectType, nil), nil
+	case attr.ValueStateUnknown:
+		return tftypes.NewValue(objectType, tftypes.UnknownValue), nil
+	default:
+		panic(fmt.Sprintf("unhandled Object state in ToTerraformValue: %s", v.state))
+	}
+}
+
+func (v SingleNestedBlockValue) IsNull() bool {
+	return v.state == attr.ValueStateNull
+}
+
+func (v SingleNestedBlockValue) IsUnknown() bool {
+	return v.state == attr.ValueStateUnknown
+}
+
+func (v SingleNestedBlockValue) String() string {
+	return "SingleNestedBlockValue"
+}
+
+func (v SingleNestedBlockValue) ToObjectValue(ctx context.Context) (basetypes.ObjectValue, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	attributeTypes := map[string]attr.Type{
+		"string_attribute": basetypes.StringType{},
+	}
+
+	if v.IsNull() {
+		return types.ObjectNull(attributeTypes), diags
+	}
+
+	if v.IsUnknown() {
+		return types.ObjectUnknown(attributeTypes), diags
+	}
+
+	objVal, diags := types.ObjectValue(
+		attributeTypes,
+		map[string]attr.Value{
+			"string_attribute": v.StringAttribute,
+		})
+
+	return objVal, diags
+}
+
+func (v SingleNestedBlockValue) Equal(o attr.Value) bool {
+	other, ok := o.(SingleNestedBlockValue)
+
+	if !ok {
+		return false
+	}
+
+	if v.state != other.state {
+		return false
+	}
+
+	if v.state != attr.ValueStateKnown {
+		return true
+	}
+
+	if !v.StringAttribute.Equal(other.StringAttribute) {
+		return false
+	}
+
+	return true
+}
+
+func (v SingleNestedBlockValue) Type(ctx context.Context) attr.Type {
+	return SingleNestedBlockType{
+		basetypes.ObjectType{
+			AttrTypes: v.AttributeTypes(ctx),
+		},
+	}
+}
+
+func (v SingleNestedBlockValue) AttributeTypes(ctx context.Context) map[string]attr.Type {
+	return map[string]attr.Type{
+		"string_attribute": basetypes.StringType{},
+	}
+}
+
+func (m ExampleModel) ToApisdkExample(ctx context.Context) (*apisdk.Example, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	var listAttributeField []*string
+
+	diags.Append(m.ListAttribute.ElementsAs(ctx, &listAttributeField, false)...)
+
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	var objectAttributeField struct {
+		StringAttribute *string
+	}
+
+	if !m.ObjectAttribute.IsNull() && !m.ObjectAttribute.IsUnknown() {
+		objectAttributeAttributes := m.ObjectAttribute.Attributes()
+
+		objectAttributeFieldStringAttribute, ok := objectAttributeAttributes["string_attribute"].(types.String)
+
+		if !ok {
+			diags.Append(diag.NewErrorDiagnostic(
+				"ObjectAttribute Field string_attribute Is Wrong Type",
+				fmt.Sprintf(`ObjectAttribute field string_attribute expected to be types.String, was: %T`, objectAttributeAttributes["string_attribute"]),
+			))
+
+			return nil, diags
+		}
+
+		objectAttributeField.StringAttribute = objectAttributeFieldStringAttribute.ValueStringPointer()
+	}
+
+	singleNestedAttributeField, d := m.SingleNestedAttribute.ToApisdkNested(ctx)
+
+	diags.Append(d...)
+
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	singleNestedBlockField, d := m.SingleNestedBlock.ToApisdkNested(ctx)
+
+	diags.Append(d...)
+
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	stringAttributeField, d := m.StringAttribute.ToApisdkStringType(ctx)
+
+	diags.Append(d...)
+
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	return &apisdk.Example{
+		BoolAttribute:         m.BoolAttribute.ValueBoolPointer(),
+		Float64Attribute:      m.Float64Attribute.ValueFloat64Pointer(),
+		Int64Attribute:        m.Int64Attribute.ValueInt64Pointer(),
+		ListAttribute:         listAttributeField,
+		NumberAttribute:       m.NumberAttribute.ValueBigFloat(),
+		ObjectAttribute:       objectAttributeField,
+		SingleNestedAttribute: singleNestedAttributeField,
+		SingleNestedBlock:     singleNestedBlockField,
+		StringAttribute:       stringAttributeField,
+	}, diags
+}
+
+func ExampleModelFromApisdkExample(ctx context.Context, apiObject *apisdk.Example) (ExampleModel, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if apiObject == nil {
+		diags.Append(diag.NewErrorDiagnostic(
+			"ExampleModel From ApisdkExample Is Nil",
+			`"*apisdk.Example" is nil.`,
+		))
+
+		return ExampleModel{}, diags
+	}
+
+	listAttributeVal, d := types.ListValueFrom(ctx, types.StringType, apiObject.ListAttribute)
+
+	diags.Append(d...)
+
+	if diags.HasError() {
+		return ExampleModel{}, diags
+	}
+
+	objectAttributeAttributeTypes := map[string]attr.Type{
+		"string_attribute": types.StringType,
+	}
+
+	objectAttributeVal := types.ObjectNull(objectAttributeAttributeTypes)
+
+	if apiObject.ObjectAttribute != (struct {
+		StringAttribute *string
+	}{}) {
+		o, d := basetypes.NewObjectValue(objectAttributeAttributeTypes, map[string]attr.Value{
+			"string_attribute": types.StringPointerValue(apiObject.ObjectAttribute.StringAttribute),
+		})
+
+		diags.Append(d...)
+
+		if diags.HasError() {
+			return ExampleModel{}, diags
+		}
+
+		objectAttributeVal = o
+	}
+
+	singleNestedAttributeVal, d := SingleNestedAttributeValue{}.FromApisdkNested(ctx, apiObject.SingleNestedAttribute)
+
+	diags.Append(d...)
+
+	if diags.HasError() {
+		return ExampleModel{}, diags
+	}
+
+	singleNestedBlockVal, d := SingleNestedBlockValue{}.FromApisdkNested(ctx, apiObject.SingleNestedBlock)
+
+	diags.Append(d...)
+
+	if diags.HasError() {
+		return ExampleModel{}, diags
+	}
+
+	stringAttributeVal, d := StringAttributeValue{}.FromApisdkStringType(ctx, apiObject.StringAttribute)
+
+	diags.Append(d...)
+
+	if diags.HasError() {
+		return ExampleModel{}, diags
+	}
+
+	return ExampleModel{
+		BoolAttribute:         types.BoolPointerValue(apiObject.BoolAttribute),
+		Float64Attribute:      types.Float64PointerValue(apiObject.Float64Attribute),
+		Int64Attribute:        types.Int64PointerValue(apiObject.Int64Attribute),
+		ListAttribute:         listAttributeVal,
+		NumberAttribute:       types.NumberValue(apiObject.NumberAttribute),
+		ObjectAttribute:       objectAttributeVal,
+		SingleNestedAttribute: singleNestedAttributeVal,
+		SingleNestedBlock:     singleNestedBlockVal,
+		StringAttribute:       stringAttributeVal,
+	}, diags
+}
+
+func (v SingleNestedAttributeValue) ToApisdkNested(ctx context.Context) (*apisdk.Nested, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if v.IsNull() {
+		return nil, diags
+	}
+
+	if v.IsUnknown() {
+		diags.Append(diag.NewErrorDiagnostic(
+			"SingleNestedAttributeValue Value Is Unknown",
+			`"SingleNestedAttributeValue" is unknown.`,
+		))
+
+		return nil, diags
+	}
+
+	return &apisdk.Nested{
+		StringAttribute: v.StringAttribute.ValueStringPointer(),
+	}, diags
+}
+
+func (v SingleNestedAttributeValue) FromApisdkNested(ctx context.Context, apiObject *apisdk.Nested) (SingleNestedAttributeValue, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if apiObject == nil {
+		return NewSingleNestedAttributeValueNull(), diags
+	}
+
+	return SingleNestedAttributeValue{
+		StringAttribute: types.StringPointerValue(apiObject.StringAttribute),
+		state:           attr.ValueStateKnown,
+	}, diags
+}
+
+func (v StringAttributeValue) ToApisdkStringType(ctx context.Context) (*apisdk.StringType, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if v.IsNull() {
+		return nil, diags
+	}
+
+	if v.IsUnknown() {
+		diags.Append(diag.NewErrorDiagnostic(
+			"StringAttributeValue Value Is Unknown",
+			`"StringAttributeValue" is unknown.`,
+		))
+
+		return nil, diags
+	}
+
+	a := apisdk.StringType(v.ValueStringPointer())
+
+	return &a, diags
+}
+
+func (v StringAttributeValue) FromApisdkStringType(ctx context.Context, apiObject *apisdk.StringType) (StringAttributeValue, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if apiObject == nil {
+		return StringAttributeValue{
+			types.StringNull(),
+		}, diags
+	}
+
+	return StringAttributeValue{
+		types.StringPointerValue(*apiObject),
+	}, diags
+}
+
+func (v SingleNestedBlockValue) ToApisdkNested(ctx context.Context) (*apisdk.Nested, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if v.IsNull() {
+		return nil, diags
+	}
+
+	if v.IsUnknown() {
+		diags.Append(diag.NewErrorDiagnostic(
+			"SingleNestedBlockValue Value Is Unknown",
+			`"SingleNestedBlockValue" is unknown.`,
+		))
+
+		return nil, diags
+	}
+
+	return &apisdk.Nested{
+		StringAttribute: v.StringAttribute.ValueStringPointer(),
+	}, diags
+}
+
+func (v SingleNestedBlockValue) FromApisdkNested(ctx context.Context, apiObject *apisdk.Nested) (SingleNestedBlockValue, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if apiObject == nil {
+		return NewSingleNestedBlockValueNull(), diags
+	}
+
+	return SingleNestedBlockValue{
+		StringAttribute: types.StringPointerValue(apiObject.StringAttribute),
+		state:           attr.ValueStateKnown,
+	}, diags
+}
@@ -0,0 +1,8 @@
+package scaffold
+
+// Examplecloud is scaffolded from a custom provider template.
+const examplecloudTypeName = "examplecloud"
+
+const examplecloudModulePath = ""
+
+var examplecloudUpper = "EXAMPLECLOUD"
@@ -0,0 +1,8 @@
+package scaffold
+
+// My Thing is scaffolded from a custom resource template.
+const myThingTypeName = "unknown_my_thing"
+
+const myThingModulePath = ""
+
+var myThingUpper = "MYTHING"
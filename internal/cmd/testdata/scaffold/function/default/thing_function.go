@@ -0,0 +1,36 @@
+package scaffold
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+var _ function.Function = (*thingFunction)(nil)
+
+func NewThingFunction() function.Function {
+	return &thingFunction{}
+}
+
+type thingFunction struct{}
+
+func (f *thingFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "thing"
+}
+
+func (f *thingFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:    "thing function",
+		Parameters: []function.Parameter{},
+		Return:     function.StringReturn{},
+	}
+}
+
+func (f *thingFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var result string
+
+	// Function logic
+
+	// Save function result
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, result))
+}
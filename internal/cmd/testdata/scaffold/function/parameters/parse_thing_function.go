@@ -0,0 +1,59 @@
+package scaffold
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ function.Function = (*parseThingFunction)(nil)
+
+func NewParseThingFunction() function.Function {
+	return &parseThingFunction{}
+}
+
+type parseThingFunction struct{}
+
+func (f *parseThingFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "parse_thing"
+}
+
+func (f *parseThingFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "parse_thing function",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name: "input",
+			},
+			function.NumberParameter{
+				Name: "precision",
+			},
+			function.DynamicParameter{
+				Name: "extra_options",
+			},
+		},
+		Return: function.Int64Return{},
+	}
+}
+
+func (f *parseThingFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var input string
+	var precision *big.Float
+	var extraOptions types.Dynamic
+
+	// Read function arguments
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &input, &precision, &extraOptions))
+
+	if resp.Error != nil {
+		return
+	}
+
+	var result int64
+
+	// Function logic
+
+	// Save function result
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, result))
+}
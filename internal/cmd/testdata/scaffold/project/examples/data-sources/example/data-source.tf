@@ -0,0 +1,3 @@
+data "ourco_example" "example" {
+  id = "example"
+}
@@ -0,0 +1,3 @@
+provider "ourco" {
+  # endpoint = "example"
+}
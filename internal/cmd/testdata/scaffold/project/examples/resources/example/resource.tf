@@ -0,0 +1,3 @@
+resource "ourco_example" "example" {
+  name = "example"
+}
@@ -0,0 +1,5 @@
+module github.com/ourco/terraform-provider-ourco
+
+go 1.25.0
+
+require github.com/hashicorp/terraform-plugin-framework v1.19.0
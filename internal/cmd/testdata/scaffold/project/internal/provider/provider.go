@@ -0,0 +1,54 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/provider"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+
+	"github.com/ourco/terraform-provider-ourco/internal/provider/provider_ourco"
+)
+
+var _ provider.Provider = (*ourcoProvider)(nil)
+
+func New() func() provider.Provider {
+	return func() provider.Provider {
+		return &ourcoProvider{}
+	}
+}
+
+type ourcoProvider struct{}
+
+func (p *ourcoProvider) Schema(ctx context.Context, req provider.SchemaRequest, resp *provider.SchemaResponse) {
+	resp.Schema = provider_ourco.OurcoProviderSchema(ctx)
+}
+
+func (p *ourcoProvider) Configure(ctx context.Context, req provider.ConfigureRequest, resp *provider.ConfigureResponse) {
+	var data provider_ourco.OurcoModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// API client configuration logic
+}
+
+func (p *ourcoProvider) Metadata(ctx context.Context, req provider.MetadataRequest, resp *provider.MetadataResponse) {
+	resp.TypeName = "ourco"
+}
+
+func (p *ourcoProvider) DataSources(ctx context.Context) []func() datasource.DataSource {
+	return []func() datasource.DataSource{
+		NewExampleDataSource,
+	}
+}
+
+func (p *ourcoProvider) Resources(ctx context.Context) []func() resource.Resource {
+	return []func() resource.Resource{
+		NewExampleResource,
+	}
+}
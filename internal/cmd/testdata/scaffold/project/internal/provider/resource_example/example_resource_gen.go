@@ -0,0 +1,32 @@
+// Code generated by terraform-plugin-framework-generator DO NOT EDIT.
+
+package resource_example
+
+import (
+	"context"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+)
+
+func ExampleResourceSchema(ctx context.Context) schema.Schema {
+	return schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				Description:         "Identifier of the example.",
+				MarkdownDescription: "Identifier of the example.",
+			},
+			"name": schema.StringAttribute{
+				Required:            true,
+				Description:         "Name of the example.",
+				MarkdownDescription: "Name of the example.",
+			},
+		},
+	}
+}
+
+type ExampleModel struct {
+	Id   types.String `tfsdk:"id"`
+	Name types.String `tfsdk:"name"`
+}
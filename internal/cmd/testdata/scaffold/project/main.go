@@ -0,0 +1,28 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+
+	"github.com/ourco/terraform-provider-ourco/internal/provider"
+)
+
+func main() {
+	var debug bool
+
+	flag.BoolVar(&debug, "debug", false, "set to true to run the provider with support for debuggers like delve")
+	flag.Parse()
+
+	opts := providerserver.ServeOpts{
+		Address: "registry.terraform.io/ourco/ourco",
+		Debug:   debug,
+	}
+
+	err := providerserver.Serve(context.Background(), provider.New(), opts)
+	if err != nil {
+		log.Fatal(err.Error())
+	}
+}
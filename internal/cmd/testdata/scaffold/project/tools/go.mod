@@ -0,0 +1,3 @@
+module github.com/ourco/terraform-provider-ourco/tools
+
+go 1.25.0
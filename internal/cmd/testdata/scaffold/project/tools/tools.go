@@ -0,0 +1,13 @@
+//go:build generate
+
+package tools
+
+import (
+	_ "github.com/hashicorp/terraform-plugin-codegen-framework/cmd/tfplugingen-framework"
+)
+
+// Generate provider, resource and data source code from the specification.
+//go:generate go run github.com/hashicorp/terraform-plugin-codegen-framework/cmd/tfplugingen-framework generate all --input ../.tfplugingen/spec.json --output ../internal/provider
+
+// Generate documentation from the specification.
+//go:generate go run github.com/hashicorp/terraform-plugin-codegen-framework/cmd/tfplugingen-framework generate docs --input ../.tfplugingen/spec.json --output ../docs
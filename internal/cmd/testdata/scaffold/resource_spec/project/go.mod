@@ -0,0 +1,3 @@
+module github.com/example/terraform-provider-example
+
+go 1.22
@@ -0,0 +1,434 @@
+// Code generated by terraform-plugin-framework-generator DO NOT EDIT.
+
+package resource_instance
+
+import (
+	"context"
+	"fmt"
+	"github.com/example/terraform-provider-example/internal/generated/shared_resource"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+)
+
+func InstanceResourceSchema(ctx context.Context) schema.Schema {
+	return schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				Required: true,
+			},
+			"network_interface": schema.SingleNestedAttribute{
+				Attributes: map[string]schema.Attribute{
+					"subnet_id": schema.StringAttribute{
+						Required: true,
+					},
+				},
+				CustomType: NetworkInterfaceType{
+					ObjectType: types.ObjectType{
+						AttrTypes: NetworkInterfaceValue{}.AttributeTypes(ctx),
+					},
+				},
+				Optional: true,
+			},
+			"settings": schema.ListNestedAttribute{
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"limits": schema.SingleNestedAttribute{
+							Attributes: map[string]schema.Attribute{
+								"max": schema.Int64Attribute{
+									Optional: true,
+								},
+							},
+							CustomType: shared_resource.LimitsType{
+								ObjectType: types.ObjectType{
+									AttrTypes: shared_resource.LimitsValue{}.AttributeTypes(ctx),
+								},
+							},
+							Optional: true,
+						},
+						"name": schema.StringAttribute{
+							Required: true,
+						},
+					},
+					CustomType: shared_resource.SettingsType{
+						ObjectType: types.ObjectType{
+							AttrTypes: shared_resource.SettingsValue{}.AttributeTypes(ctx),
+						},
+					},
+				},
+				Optional: true,
+			},
+			"tags": schema.ListNestedAttribute{
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"key": schema.StringAttribute{
+							Required: true,
+						},
+						"value": schema.StringAttribute{
+							Optional: true,
+						},
+					},
+					CustomType: shared_resource.TagsType{
+						ObjectType: types.ObjectType{
+							AttrTypes: shared_resource.TagsValue{}.AttributeTypes(ctx),
+						},
+					},
+				},
+				Optional: true,
+			},
+			"timeouts": schema.SingleNestedAttribute{
+				Attributes: map[string]schema.Attribute{
+					"create": schema.StringAttribute{
+						Optional: true,
+					},
+					"delete": schema.StringAttribute{
+						Optional: true,
+					},
+				},
+				CustomType: shared_resource.TimeoutsType{
+					ObjectType: types.ObjectType{
+						AttrTypes: shared_resource.TimeoutsValue{}.AttributeTypes(ctx),
+					},
+				},
+				Optional: true,
+			},
+		},
+	}
+}
+
+type InstanceModel struct {
+	Name             types.String                  `tfsdk:"name"`
+	NetworkInterface NetworkInterfaceValue         `tfsdk:"network_interface"`
+	Settings         types.List                    `tfsdk:"settings"`
+	Tags             types.List                    `tfsdk:"tags"`
+	Timeouts         shared_resource.TimeoutsValue `tfsdk:"timeouts"`
+}
+
+var _ basetypes.ObjectTypable = NetworkInterfaceType{}
+
+type NetworkInterfaceType struct {
+	basetypes.ObjectType
+}
+
+func (t NetworkInterfaceType) Equal(o attr.Type) bool {
+	other, ok := o.(NetworkInterfaceType)
+
+	if !ok {
+		return false
+	}
+
+	return t.ObjectType.Equal(other.ObjectType)
+}
+
+func (t NetworkInterfaceType) String() string {
+	return "NetworkInterfaceType"
+}
+
+func (t NetworkInterfaceType) ValueFromObject(ctx context.Context, in basetypes.ObjectValue) (basetypes.ObjectValuable, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	attributes := in.Attributes()
+
+	subnetIdAttribute, ok := attributes["subnet_id"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`subnet_id is missing from object`)
+
+		return nil, diags
+	}
+
+	subnetIdVal, ok := subnetIdAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`subnet_id expected to be basetypes.StringValue, was: %T`, subnetIdAttribute))
+	}
+
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	return NetworkInterfaceValue{
+		SubnetId: subnetIdVal,
+		state:    attr.ValueStateKnown,
+	}, diags
+}
+
+func NewNetworkInterfaceValueNull() NetworkInterfaceValue {
+	return NetworkInterfaceValue{
+		state: attr.ValueStateNull,
+	}
+}
+
+func NewNetworkInterfaceValueUnknown() NetworkInterfaceValue {
+	return NetworkInterfaceValue{
+		state: attr.ValueStateUnknown,
+	}
+}
+
+func NewNetworkInterfaceValue(attributeTypes map[string]attr.Type, attributes map[string]attr.Value) (NetworkInterfaceValue, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	// Reference: https://github.com/hashicorp/terraform-plugin-framework/issues/521
+	ctx := context.Background()
+
+	for name, attributeType := range attributeTypes {
+		attribute, ok := attributes[name]
+
+		if !ok {
+			diags.AddError(
+				"Missing NetworkInterfaceValue Attribute Value",
+				"While creating a NetworkInterfaceValue value, a missing attribute value was detected. "+
+					"A NetworkInterfaceValue must contain values for all attributes, even if null or unknown. "+
+					"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+					fmt.Sprintf("NetworkInterfaceValue Attribute Name (%s) Expected Type: %s", name, attributeType.String()),
+			)
+
+			continue
+		}
+
+		if !attributeType.Equal(attribute.Type(ctx)) {
+			diags.AddError(
+				"Invalid NetworkInterfaceValue Attribute Type",
+				"While creating a NetworkInterfaceValue value, an invalid attribute value was detected. "+
+					"A NetworkInterfaceValue must use a matching attribute type for the value. "+
+					"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+					fmt.Sprintf("NetworkInterfaceValue Attribute Name (%s) Expected Type: %s\n", name, attributeType.String())+
+					fmt.Sprintf("NetworkInterfaceValue Attribute Name (%s) Given Type: %s", name, attribute.Type(ctx)),
+			)
+		}
+	}
+
+	for name := range attributes {
+		_, ok := attributeTypes[name]
+
+		if !ok {
+			diags.AddError(
+				"Extra NetworkInterfaceValue Attribute Value",
+				"While creating a NetworkInterfaceValue value, an extra attribute value was detected. "+
+					"A NetworkInterfaceValue must not contain values beyond the expected attribute types. "+
+					"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+					fmt.Sprintf("Extra NetworkInterfaceValue Attribute Name: %s", name),
+			)
+		}
+	}
+
+	if diags.HasError() {
+		return NewNetworkInterfaceValueUnknown(), diags
+	}
+
+	subnetIdAttribute, ok := attributes["subnet_id"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`subnet_id is missing from object`)
+
+		return NewNetworkInterfaceValueUnknown(), diags
+	}
+
+	subnetIdVal, ok := subnetIdAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`subnet_id expected to be basetypes.StringValue, was: %T`, subnetIdAttribute))
+	}
+
+	if diags.HasError() {
+		return NewNetworkInterfaceValueUnknown(), diags
+	}
+
+	return NetworkInterfaceValue{
+		SubnetId: subnetIdVal,
+		state:    attr.ValueStateKnown,
+	}, diags
+}
+
+func NewNetworkInterfaceValueMust(attributeTypes map[string]attr.Type, attributes map[string]attr.Value) NetworkInterfaceValue {
+	object, diags := NewNetworkInterfaceValue(attributeTypes, attributes)
+
+	if diags.HasError() {
+		// This could potentially be added to the diag package.
+		diagsStrings := make([]string, 0, len(diags))
+
+		for _, diagnostic := range diags {
+			diagsStrings = append(diagsStrings, fmt.Sprintf(
+				"%s | %s | %s",
+				diagnostic.Severity(),
+				diagnostic.Summary(),
+				diagnostic.Detail()))
+		}
+
+		panic("NewNetworkInterfaceValueMust received error(s): " + strings.Join(diagsStrings, "\n"))
+	}
+
+	return object
+}
+
+func (t NetworkInterfaceType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	if in.Type() == nil {
+		return NewNetworkInterfaceValueNull(), nil
+	}
+
+	if !in.Type().Equal(t.TerraformType(ctx)) {
+		return nil, fmt.Errorf("expected %s, got %s", t.TerraformType(ctx), in.Type())
+	}
+
+	if !in.IsKnown() {
+		return NewNetworkInterfaceValueUnknown(), nil
+	}
+
+	if in.IsNull() {
+		return NewNetworkInterfaceValueNull(), nil
+	}
+
+	attributes := map[string]attr.Value{}
+
+	val := map[string]tftypes.Value{}
+
+	err := in.As(&val)
+
+	if err != nil {
+		return nil, err
+	}
+
+	for k, v := range val {
+		a, err := t.AttrTypes[k].ValueFromTerraform(ctx, v)
+
+		if err != nil {
+			return nil, err
+		}
+
+		attributes[k] = a
+	}
+
+	return NewNetworkInterfaceValueMust(NetworkInterfaceValue{}.AttributeTypes(ctx), attributes), nil
+}
+
+func (t NetworkInterfaceType) ValueType(ctx context.Context) attr.Value {
+	return NetworkInterfaceValue{}
+}
+
+var _ basetypes.ObjectValuable = NetworkInterfaceValue{}
+
+type NetworkInterfaceValue struct {
+	SubnetId basetypes.StringValue `tfsdk:"subnet_id"`
+	state    attr.ValueState
+}
+
+func (v NetworkInterfaceValue) ToTerraformValue(ctx context.Context) (tftypes.Value, error) {
+	attrTypes := make(map[string]tftypes.Type, 1)
+
+	var val tftypes.Value
+	var err error
+
+	attrTypes["subnet_id"] = basetypes.StringType{}.TerraformType(ctx)
+
+	objectType := tftypes.Object{AttributeTypes: attrTypes}
+
+	switch v.state {
+	case attr.ValueStateKnown:
+		vals := make(map[string]tftypes.Value, 1)
+
+		val, err = v.SubnetId.ToTerraformValue(ctx)
+
+		if err != nil {
+			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
+		}
+
+		vals["subnet_id"] = val
+
+		if err := tftypes.ValidateValue(objectType, vals); err != nil {
+			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
+		}
+
+		return tftypes.NewValue(objectType, vals), nil
+	case attr.ValueStateNull:
+		return tftypes.NewValue(objectType, nil), nil
+	case attr.ValueStateUnknown:
+		return tftypes.NewValue(objectType, tftypes.UnknownValue), nil
+	default:
+		panic(fmt.Sprintf("unhandled Object state in ToTerraformValue: %s", v.state))
+	}
+}
+
+func (v NetworkInterfaceValue) IsNull() bool {
+	return v.state == attr.ValueStateNull
+}
+
+func (v NetworkInterfaceValue) IsUnknown() bool {
+	return v.state == attr.ValueStateUnknown
+}
+
+func (v NetworkInterfaceValue) String() string {
+	return "NetworkInterfaceValue"
+}
+
+func (v NetworkInterfaceValue) ToObjectValue(ctx context.Context) (basetypes.ObjectValue, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	attributeTypes := map[string]attr.Type{
+		"subnet_id": basetypes.StringType{},
+	}
+
+	if v.IsNull() {
+		return types.ObjectNull(attributeTypes), diags
+	}
+
+	if v.IsUnknown() {
+		return types.ObjectUnknown(attributeTypes), diags
+	}
+
+	objVal, diags := types.ObjectValue(
+		attributeTypes,
+		map[string]attr.Value{
+			"subnet_id": v.SubnetId,
+		})
+
+	return objVal, diags
+}
+
+func (v NetworkInterfaceValue) Equal(o attr.Value) bool {
+	other, ok := o.(NetworkInterfaceValue)
+
+	if !ok {
+		return false
+	}
+
+	if v.state != other.state {
+		return false
+	}
+
+	if v.state != attr.ValueStateKnown {
+		return true
+	}
+
+	if !v.SubnetId.Equal(other.SubnetId) {
+		return false
+	}
+
+	return true
+}
+
+func (v NetworkInterfaceValue) Type(ctx context.Context) attr.Type {
+	return NetworkInterfaceType{
+		basetypes.ObjectType{
+			AttrTypes: v.AttributeTypes(ctx),
+		},
+	}
+}
+
+func (v NetworkInterfaceValue) AttributeTypes(ctx context.Context) map[string]attr.Type {
+	return map[string]attr.Type{
+		"subnet_id": basetypes.StringType{},
+	}
+}
@@ -0,0 +1,369 @@
+// Code generated by terraform-plugin-framework-generator DO NOT EDIT.
+
+package specified
+
+import (
+	"context"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
+)
+
+func ExampleProviderSchema(ctx context.Context) schema.Schema {
+	return schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"endpoint": schema.StringAttribute{
+				Optional: true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"retry": schema.SingleNestedBlock{
+				Attributes: map[string]schema.Attribute{
+					"attempts": schema.Int64Attribute{
+						Optional: true,
+					},
+				},
+				CustomType: RetryType{
+					ObjectType: types.ObjectType{
+						AttrTypes: RetryValue{}.AttributeTypes(ctx),
+					},
+				},
+			},
+		},
+	}
+}
+
+type ExampleModel struct {
+	Endpoint types.String `tfsdk:"endpoint"`
+	Retry    RetryValue   `tfsdk:"retry"`
+}
+
+var _ basetypes.ObjectTypable = RetryType{}
+
+type RetryType struct {
+	basetypes.ObjectType
+}
+
+func (t RetryType) Equal(o attr.Type) bool {
+	other, ok := o.(RetryType)
+
+	if !ok {
+		return false
+	}
+
+	return t.ObjectType.Equal(other.ObjectType)
+}
+
+func (t RetryType) String() string {
+	return "RetryType"
+}
+
+func (t RetryType) ValueFromObject(ctx context.Context, in basetypes.ObjectValue) (basetypes.ObjectValuable, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	attributes := in.Attributes()
+
+	attemptsAttribute, ok := attributes["attempts"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`attempts is missing from object`)
+
+		return nil, diags
+	}
+
+	attemptsVal, ok := attemptsAttribute.(basetypes.Int64Value)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`attempts expected to be basetypes.Int64Value, was: %T`, attemptsAttribute))
+	}
+
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	return RetryValue{
+		Attempts: attemptsVal,
+		state:    attr.ValueStateKnown,
+	}, diags
+}
+
+func NewRetryValueNull() RetryValue {
+	return RetryValue{
+		state: attr.ValueStateNull,
+	}
+}
+
+func NewRetryValueUnknown() RetryValue {
+	return RetryValue{
+		state: attr.ValueStateUnknown,
+	}
+}
+
+func NewRetryValue(attributeTypes map[string]attr.Type, attributes map[string]attr.Value) (RetryValue, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	// Reference: https://github.com/hashicorp/terraform-plugin-framework/issues/521
+	ctx := context.Background()
+
+	for name, attributeType := range attributeTypes {
+		attribute, ok := attributes[name]
+
+		if !ok {
+			diags.AddError(
+				"Missing RetryValue Attribute Value",
+				"While creating a RetryValue value, a missing attribute value was detected. "+
+					"A RetryValue must contain values for all attributes, even if null or unknown. "+
+					"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+					fmt.Sprintf("RetryValue Attribute Name (%s) Expected Type: %s", name, attributeType.String()),
+			)
+
+			continue
+		}
+
+		if !attributeType.Equal(attribute.Type(ctx)) {
+			diags.AddError(
+				"Invalid RetryValue Attribute Type",
+				"While creating a RetryValue value, an invalid attribute value was detected. "+
+					"A RetryValue must use a matching attribute type for the value. "+
+					"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+					fmt.Sprintf("RetryValue Attribute Name (%s) Expected Type: %s\n", name, attributeType.String())+
+					fmt.Sprintf("RetryValue Attribute Name (%s) Given Type: %s", name, attribute.Type(ctx)),
+			)
+		}
+	}
+
+	for name := range attributes {
+		_, ok := attributeTypes[name]
+
+		if !ok {
+			diags.AddError(
+				"Extra RetryValue Attribute Value",
+				"While creating a RetryValue value, an extra attribute value was detected. "+
+					"A RetryValue must not contain values beyond the expected attribute types. "+
+					"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+					fmt.Sprintf("Extra RetryValue Attribute Name: %s", name),
+			)
+		}
+	}
+
+	if diags.HasError() {
+		return NewRetryValueUnknown(), diags
+	}
+
+	attemptsAttribute, ok := attributes["attempts"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`attempts is missing from object`)
+
+		return NewRetryValueUnknown(), diags
+	}
+
+	attemptsVal, ok := attemptsAttribute.(basetypes.Int64Value)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`attempts expected to be basetypes.Int64Value, was: %T`, attemptsAttribute))
+	}
+
+	if diags.HasError() {
+		return NewRetryValueUnknown(), diags
+	}
+
+	return RetryValue{
+		Attempts: attemptsVal,
+		state:    attr.ValueStateKnown,
+	}, diags
+}
+
+func NewRetryValueMust(attributeTypes map[string]attr.Type, attributes map[string]attr.Value) RetryValue {
+	object, diags := NewRetryValue(attributeTypes, attributes)
+
+	if diags.HasError() {
+		// This could potentially be added to the diag package.
+		diagsStrings := make([]string, 0, len(diags))
+
+		for _, diagnostic := range diags {
+			diagsStrings = append(diagsStrings, fmt.Sprintf(
+				"%s | %s | %s",
+				diagnostic.Severity(),
+				diagnostic.Summary(),
+				diagnostic.Detail()))
+		}
+
+		panic("NewRetryValueMust received error(s): " + strings.Join(diagsStrings, "\n"))
+	}
+
+	return object
+}
+
+func (t RetryType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	if in.Type() == nil {
+		return NewRetryValueNull(), nil
+	}
+
+	if !in.Type().Equal(t.TerraformType(ctx)) {
+		return nil, fmt.Errorf("expected %s, got %s", t.TerraformType(ctx), in.Type())
+	}
+
+	if !in.IsKnown() {
+		return NewRetryValueUnknown(), nil
+	}
+
+	if in.IsNull() {
+		return NewRetryValueNull(), nil
+	}
+
+	attributes := map[string]attr.Value{}
+
+	val := map[string]tftypes.Value{}
+
+	err := in.As(&val)
+
+	if err != nil {
+		return nil, err
+	}
+
+	for k, v := range val {
+		a, err := t.AttrTypes[k].ValueFromTerraform(ctx, v)
+
+		if err != nil {
+			return nil, err
+		}
+
+		attributes[k] = a
+	}
+
+	return NewRetryValueMust(RetryValue{}.AttributeTypes(ctx), attributes), nil
+}
+
+func (t RetryType) ValueType(ctx context.Context) attr.Value {
+	return RetryValue{}
+}
+
+var _ basetypes.ObjectValuable = RetryValue{}
+
+type RetryValue struct {
+	Attempts basetypes.Int64Value `tfsdk:"attempts"`
+	state    attr.ValueState
+}
+
+func (v RetryValue) ToTerraformValue(ctx context.Context) (tftypes.Value, error) {
+	attrTypes := make(map[string]tftypes.Type, 1)
+
+	var val tftypes.Value
+	var err error
+
+	attrTypes["attempts"] = basetypes.Int64Type{}.TerraformType(ctx)
+
+	objectType := tftypes.Object{AttributeTypes: attrTypes}
+
+	switch v.state {
+	case attr.ValueStateKnown:
+		vals := make(map[string]tftypes.Value, 1)
+
+		val, err = v.Attempts.ToTerraformValue(ctx)
+
+		if err != nil {
+			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
+		}
+
+		vals["attempts"] = val
+
+		if err := tftypes.ValidateValue(objectType, vals); err != nil {
+			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
+		}
+
+		return tftypes.NewValue(objectType, vals), nil
+	case attr.ValueStateNull:
+		return tftypes.NewValue(objectType, nil), nil
+	case attr.ValueStateUnknown:
+		return tftypes.NewValue(objectType, tftypes.UnknownValue), nil
+	default:
+		panic(fmt.Sprintf("unhandled Object state in ToTerraformValue: %s", v.state))
+	}
+}
+
+func (v RetryValue) IsNull() bool {
+	return v.state == attr.ValueStateNull
+}
+
+func (v RetryValue) IsUnknown() bool {
+	return v.state == attr.ValueStateUnknown
+}
+
+func (v RetryValue) String() string {
+	return "RetryValue"
+}
+
+func (v RetryValue) ToObjectValue(ctx context.Context) (basetypes.ObjectValue, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	attributeTypes := map[string]attr.Type{
+		"attempts": basetypes.Int64Type{},
+	}
+
+	if v.IsNull() {
+		return types.ObjectNull(attributeTypes), diags
+	}
+
+	if v.IsUnknown() {
+		return types.ObjectUnknown(attributeTypes), diags
+	}
+
+	objVal, diags := types.ObjectValue(
+		attributeTypes,
+		map[string]attr.Value{
+			"attempts": v.Attempts,
+		})
+
+	return objVal, diags
+}
+
+func (v RetryValue) Equal(o attr.Value) bool {
+	other, ok := o.(RetryValue)
+
+	if !ok {
+		return false
+	}
+
+	if v.state != other.state {
+		return false
+	}
+
+	if v.state != attr.ValueStateKnown {
+		return true
+	}
+
+	if !v.Attempts.Equal(other.Attempts) {
+		return false
+	}
+
+	return true
+}
+
+func (v RetryValue) Type(ctx context.Context) attr.Type {
+	return RetryType{
+		basetypes.ObjectType{
+			AttrTypes: v.AttributeTypes(ctx),
+		},
+	}
+}
+
+func (v RetryValue) AttributeTypes(ctx context.Context) map[string]attr.Type {
+	return map[string]attr.Type{
+		"attempts": basetypes.Int64Type{},
+	}
+}
@@ -0,0 +1,176 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/cli"
+
+	"github.com/hashicorp/terraform-plugin-codegen-framework/internal/input"
+	"github.com/hashicorp/terraform-plugin-codegen-framework/internal/validate"
+)
+
+type ValidateCommand struct {
+	UI              cli.Ui
+	flagInputPath   string
+	flagInputFormat string
+	flagConfigPath  string
+	flagFormat      string
+}
+
+func (cmd *ValidateCommand) Flags() *flag.FlagSet {
+	fs := flag.NewFlagSet("validate", flag.ExitOnError)
+	fs.StringVar(&cmd.flagInputPath, "input", "", "path to intermediate representation (JSON or YAML), defaults to stdin")
+	fs.StringVar(&cmd.flagInputFormat, "input-format", "", "format of intermediate representation, json or yaml, defaults to yaml for .yaml and .yml files and json otherwise")
+	fs.StringVar(&cmd.flagConfigPath, "config", "", "path to generator configuration (JSON)")
+	fs.StringVar(&cmd.flagFormat, "format", "text", "output format, text or json")
+
+	return fs
+}
+
+func (cmd *ValidateCommand) Help() string {
+	strBuilder := &strings.Builder{}
+
+	longestName := 0
+	longestUsage := 0
+	cmd.Flags().VisitAll(func(f *flag.Flag) {
+		if len(f.Name) > longestName {
+			longestName = len(f.Name)
+		}
+		if len(f.Usage) > longestUsage {
+			longestUsage = len(f.Usage)
+		}
+	})
+
+	strBuilder.WriteString("\nUsage: tfplugingen-framework validate [<args>]\n\n")
+	cmd.Flags().VisitAll(func(f *flag.Flag) {
+		if f.DefValue != "" {
+			strBuilder.WriteString(fmt.Sprintf("    --%s <ARG> %s%s%s  (default: %q)\n",
+				f.Name,
+				strings.Repeat(" ", longestName-len(f.Name)+2),
+				f.Usage,
+				strings.Repeat(" ", longestUsage-len(f.Usage)+2),
+				f.DefValue,
+			))
+		} else {
+			strBuilder.WriteString(fmt.Sprintf("    --%s <ARG> %s%s%s\n",
+				f.Name,
+				strings.Repeat(" ", longestName-len(f.Name)+2),
+				f.Usage,
+				strings.Repeat(" ", longestUsage-len(f.Usage)+2),
+			))
+		}
+	})
+	strBuilder.WriteString("\n")
+	strBuilder.WriteString("Problems are located by JSON pointer. Exits with status 1 if there are any.\n\n")
+
+	return strBuilder.String()
+}
+
+func (cmd *ValidateCommand) Synopsis() string {
+	return "Check an Intermediate Representation (IR) JSON file for problems which prevent code generation."
+}
+
+func (cmd *ValidateCommand) Run(args []string) int {
+	ctx := context.Background()
+
+	fs := cmd.Flags()
+	err := fs.Parse(args)
+	if err != nil {
+		cmd.UI.Error(fmt.Sprintf("error parsing command flags: %s", err))
+		return 1
+	}
+
+	errs, err := cmd.runInternal(ctx)
+	if err != nil {
+		cmd.UI.Error(fmt.Sprintf("Error executing command: %s\n", err))
+		return 1
+	}
+
+	if len(errs) > 0 {
+		return 1
+	}
+
+	return 0
+}
+
+func (cmd *ValidateCommand) runInternal(ctx context.Context) ([]validate.Error, error) {
+	if cmd.flagFormat != "text" && cmd.flagFormat != "json" {
+		return nil, fmt.Errorf("unsupported --format %q, expected text or json", cmd.flagFormat)
+	}
+
+	ir, err := input.ReadSpecification(cmd.flagInputPath, cmd.flagInputFormat)
+	if err != nil {
+		return nil, fmt.Errorf("error reading IR JSON: %w", err)
+	}
+
+	cfg, err := parseConfig(ctx, cmd.flagConfigPath)
+	if err != nil {
+		return nil, err
+	}
+
+	errs := validate.Specification(ctx, ir.JSON, cfg)
+
+	if ir.Format == input.FormatYAML {
+		for i, e := range errs {
+			if e.Pointer == "" {
+				errs[i].Message = ir.Error(errors.New(e.Message)).Error()
+
+				continue
+			}
+
+			if r, ok := ir.Region(e.Pointer); ok {
+				errs[i].Line = r.Start.Line
+				errs[i].Column = r.Start.Column
+			}
+		}
+	}
+
+	if cmd.flagFormat == "json" {
+		output := struct {
+			Valid  bool             `json:"valid"`
+			Errors []validate.Error `json:"errors"`
+		}{
+			Valid:  len(errs) == 0,
+			Errors: append([]validate.Error{}, errs...),
+		}
+
+		b, err := json.MarshalIndent(output, "", "  ")
+		if err != nil {
+			return nil, fmt.Errorf("error marshalling problems: %w", err)
+		}
+
+		cmd.UI.Output(string(b))
+
+		return errs, nil
+	}
+
+	if len(errs) == 0 {
+		cmd.UI.Output("No problems found.")
+
+		return nil, nil
+	}
+
+	lines := make([]string, 0, len(errs))
+
+	for _, e := range errs {
+		line := e.Error()
+
+		if location := ir.Location(e.Pointer); location != "" && e.Pointer != "" {
+			line = location + ": " + line
+		}
+
+		lines = append(lines, line)
+	}
+
+	cmd.UI.Output(strings.Join(lines, "\n"))
+
+	return errs, nil
+}
@@ -0,0 +1,174 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package cmd_test
+
+import (
+	"os"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/cli"
+
+	"github.com/hashicorp/terraform-plugin-codegen-framework/internal/cmd"
+)
+
+func TestValidateCommand(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		inputPath        string
+		inputFormat      string
+		configPath       string
+		format           string
+		goldenFile       string
+		expectedExitCode int
+		expectError      bool
+	}{
+		"valid": {
+			inputPath:  "testdata/custom_and_external/ir.json",
+			goldenFile: "testdata/validate/valid.txt",
+		},
+		"invalid": {
+			inputPath:        "testdata/validate/invalid.json",
+			goldenFile:       "testdata/validate/invalid.txt",
+			expectedExitCode: 1,
+		},
+		"schema_error": {
+			inputPath:        "testdata/validate/schema_error.json",
+			goldenFile:       "testdata/validate/schema_error.txt",
+			expectedExitCode: 1,
+		},
+		"schema_error_json": {
+			inputPath:        "testdata/validate/schema_error.json",
+			format:           "json",
+			goldenFile:       "testdata/validate/schema_error_json.txt",
+			expectedExitCode: 1,
+		},
+		"invalid_yaml": {
+			inputPath:        "testdata/validate/invalid.yaml",
+			goldenFile:       "testdata/validate/invalid_yaml.txt",
+			expectedExitCode: 1,
+		},
+		"schema_error_yaml": {
+			inputPath:        "testdata/validate/schema_error.yaml",
+			goldenFile:       "testdata/validate/schema_error_yaml.txt",
+			expectedExitCode: 1,
+		},
+		"schema_error_yaml_json": {
+			inputPath:        "testdata/validate/schema_error.yaml",
+			format:           "json",
+			goldenFile:       "testdata/validate/schema_error_yaml_json.txt",
+			expectedExitCode: 1,
+		},
+		"input_format_yaml": {
+			inputPath:        "testdata/validate/schema_error.json",
+			inputFormat:      "yaml",
+			goldenFile:       "testdata/validate/schema_error_input_format_yaml.txt",
+			expectedExitCode: 1,
+		},
+		"duplicate": {
+			inputPath:        "testdata/validate/duplicate.json",
+			goldenFile:       "testdata/validate/duplicate.txt",
+			expectedExitCode: 1,
+		},
+		"duplicate_input_format_yaml": {
+			inputPath:        "testdata/validate/duplicate.json",
+			inputFormat:      "yaml",
+			goldenFile:       "testdata/validate/duplicate_input_format_yaml.txt",
+			expectedExitCode: 1,
+		},
+		"collisions": {
+			inputPath:        "testdata/validate/collisions.json",
+			goldenFile:       "testdata/validate/collisions.txt",
+			expectedExitCode: 1,
+		},
+		// Hierarchical nested type names prevent the collision, whereas
+		// initialisms give id and i_d the same Go identifier.
+		"collisions_config": {
+			inputPath:        "testdata/validate/collisions.json",
+			configPath:       "testdata/validate/collisions_config.json",
+			goldenFile:       "testdata/validate/collisions_config.txt",
+			expectedExitCode: 1,
+		},
+		"undefined_config": {
+			inputPath:        "testdata/custom_and_external/ir.json",
+			configPath:       "testdata/validate/undefined_config.json",
+			goldenFile:       "testdata/validate/undefined_config.txt",
+			expectedExitCode: 1,
+		},
+		"missing_config": {
+			inputPath:   "testdata/custom_and_external/ir.json",
+			configPath:  "testdata/validate/missing_config.json",
+			expectError: true,
+		},
+		"duplicate_yaml_key": {
+			inputPath:   "testdata/validate/duplicate_key.yaml",
+			expectError: true,
+		},
+		"unsupported_input_format": {
+			inputPath:   "testdata/validate/invalid.json",
+			inputFormat: "toml",
+			expectError: true,
+		},
+		"unsupported_format": {
+			inputPath:   "testdata/validate/invalid.json",
+			format:      "yaml",
+			expectError: true,
+		},
+		"missing_input": {
+			inputPath:   "testdata/validate/missing.json",
+			expectError: true,
+		},
+	}
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			mockUi := cli.NewMockUi()
+			c := cmd.ValidateCommand{
+				UI: mockUi,
+			}
+
+			args := []string{
+				"--input", testCase.inputPath,
+			}
+
+			if testCase.inputFormat != "" {
+				args = append(args, "--input-format", testCase.inputFormat)
+			}
+
+			if testCase.configPath != "" {
+				args = append(args, "--config", testCase.configPath)
+			}
+
+			if testCase.format != "" {
+				args = append(args, "--format", testCase.format)
+			}
+
+			exitCode := c.Run(args)
+
+			if testCase.expectError {
+				if mockUi.ErrorWriter.String() == "" {
+					t.Fatal("expected error running `validate` cmd")
+				}
+
+				return
+			}
+
+			if exitCode != testCase.expectedExitCode {
+				t.Fatalf("expected exit code %d, got %d: %s", testCase.expectedExitCode, exitCode, mockUi.ErrorWriter.String())
+			}
+
+			expected, err := os.ReadFile(testCase.goldenFile)
+			if err != nil {
+				t.Fatalf("unexpected error reading golden file: %s", err)
+			}
+
+			if diff := cmp.Diff(mockUi.OutputWriter.String(), string(expected)); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
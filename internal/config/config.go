@@ -0,0 +1,494 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package config
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"go/token"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-codegen-spec/resource"
+	specschema "github.com/hashicorp/terraform-plugin-codegen-spec/schema"
+	"github.com/hashicorp/terraform-plugin-codegen-spec/spec"
+
+	"github.com/hashicorp/terraform-plugin-codegen-framework/internal/format"
+	generatorresource "github.com/hashicorp/terraform-plugin-codegen-framework/internal/resource"
+	"github.com/hashicorp/terraform-plugin-codegen-framework/internal/schema"
+)
+
+// initialismRegex is used to validate extra initialisms.
+var initialismRegex = regexp.MustCompile("^[A-Za-z][A-Za-z0-9]*$")
+
+// Config defines generator settings which are not part of the Provider Code
+// Specification. Data sources and resources are keyed on their name within the
+// specification.
+type Config struct {
+	DataSources map[string]DataSource `json:"datasources,omitempty"`
+	Naming      *Naming               `json:"naming,omitempty"`
+	Resources   map[string]Resource   `json:"resources,omitempty"`
+	SharedTypes *SharedTypes          `json:"shared_types,omitempty"`
+}
+
+// SharedTypes enables declaring the custom type and value types generated for
+// structurally equal nested attributes and blocks once, in a shared package,
+// rather than once for each data source or resource.
+type SharedTypes struct {
+	// ImportPath is the Go import path of the output directory, which is used
+	// to import the shared package into the package generated for each data
+	// source and resource. It is not required when all generated code is
+	// placed into the same package.
+	ImportPath string `json:"import_path,omitempty"`
+}
+
+// Naming defines settings for the casing of generated Go identifiers, such as
+// model fields, custom type and value types, and to/from method names.
+type Naming struct {
+	// Initialisms enables initialism-aware casing, for example, generating ID
+	// rather than Id, and APIURL rather than ApiUrl. Disabled by default to
+	// preserve the names generated by previous versions.
+	Initialisms bool `json:"initialisms,omitempty"`
+
+	// ExtraInitialisms defines initialisms in addition to the common initialisms
+	// used by golint, for example, VPC, ARN and CIDR.
+	ExtraInitialisms []string `json:"extra_initialisms,omitempty"`
+
+	// NestedTypeNames defines how the custom type and value types generated for
+	// nested attributes and blocks are named. Either NestedTypeNamesAttribute,
+	// which is the default, or NestedTypeNamesHierarchical.
+	NestedTypeNames string `json:"nested_type_names,omitempty"`
+}
+
+const (
+	// NestedTypeNamesAttribute names the custom type and value types generated for
+	// nested attributes and blocks after the attribute or block alone (e.g.,
+	// ConfigValue).
+	NestedTypeNamesAttribute = "attribute"
+
+	// NestedTypeNamesHierarchical prefixes the names of the custom type and value
+	// types generated for nested attributes and blocks with the names of their
+	// parent attributes and blocks (e.g., NetworkConfigValue).
+	NestedTypeNamesHierarchical = "hierarchical"
+)
+
+// Options returns generator options with the casing and naming strategy used for
+// generated Go identifiers. A nil Naming returns the default options.
+func (n *Naming) Options() *schema.Options {
+	if n == nil {
+		return &schema.Options{}
+	}
+
+	opts := &schema.Options{
+		HierarchicalTypeNames: n.NestedTypeNames == NestedTypeNamesHierarchical,
+	}
+
+	if n.Initialisms {
+		opts.Casing = format.NewCasing(n.ExtraInitialisms)
+	}
+
+	return opts
+}
+
+// DataSource defines generator settings for an individual data source.
+type DataSource struct {
+	// AssociatedExternalType is used to generate conversion functions between
+	// the data source model and an external type, such as an API SDK type.
+	AssociatedExternalType *specschema.AssociatedExternalType `json:"associated_external_type,omitempty"`
+}
+
+// Resource defines generator settings for an individual resource.
+type Resource struct {
+	// AssociatedExternalType is used to generate conversion functions between
+	// the resource model and an external type, such as an API SDK type.
+	AssociatedExternalType *specschema.AssociatedExternalType `json:"associated_external_type,omitempty"`
+
+	// CRUD enables generating Create, Read, Update and Delete methods for the
+	// resource, which call a generated client interface with the associated
+	// external type.
+	CRUD *CRUD `json:"crud,omitempty"`
+
+	// SchemaVersion sets the version of the resource schema, and enables
+	// generating state upgraders from prior versions of the schema.
+	SchemaVersion *SchemaVersion `json:"schema_version,omitempty"`
+}
+
+// SchemaVersion defines the current version of a resource schema, and the
+// specification snapshots declaring the resource at prior versions.
+type SchemaVersion struct {
+	// Version is the current version of the resource schema.
+	Version int64 `json:"version"`
+
+	// PriorVersions are the prior versions of the resource schema which state
+	// can be upgraded from.
+	PriorVersions []PriorVersion `json:"prior_versions,omitempty"`
+}
+
+// PriorVersion defines a prior version of a resource schema.
+type PriorVersion struct {
+	// Version is the prior version of the resource schema, which must be
+	// less than the current version.
+	Version int64 `json:"version"`
+
+	// Input is the path of a specification declaring the resource at the
+	// prior version, relative to the directory passed to ReadPriorVersions.
+	Input string `json:"input"`
+
+	// resource is the resource declared by the specification, which is set
+	// by ReadPriorVersions.
+	resource *resource.Resource
+}
+
+// CRUD defines settings for generating the Create, Read, Update and Delete
+// methods of a resource.
+type CRUD struct {
+	// Client is the name of the generated client interface. Defaults to the
+	// name of the resource with a Client suffix (e.g., ExampleClient).
+	Client string `json:"client,omitempty"`
+
+	// Input is the type passed to the client methods, when this differs from
+	// the associated external type of the resource (e.g., *apisdk.ExampleInput).
+	Input *specschema.AssociatedExternalType `json:"input,omitempty"`
+
+	// Output is the type returned by the client methods, when this differs from
+	// the associated external type of the resource.
+	Output *specschema.AssociatedExternalType `json:"output,omitempty"`
+}
+
+// Parse returns a Config from the JSON document contents. An empty document
+// returns an empty Config.
+func Parse(document []byte) (Config, error) {
+	var c Config
+
+	if len(document) == 0 {
+		return c, nil
+	}
+
+	if !json.Valid(document) {
+		return c, errors.New("invalid JSON")
+	}
+
+	if err := json.Unmarshal(document, &c); err != nil {
+		return c, err
+	}
+
+	if err := c.Validate(); err != nil {
+		return c, err
+	}
+
+	return c, nil
+}
+
+// Validate checks that any associated external types have a type defined, that
+// resources generating CRUD methods have an associated external type, that
+// prior resource schema versions are unique and less than the current version,
+// that any extra initialisms only contain letters and digits, and that the
+// naming strategy for nested types is known.
+func (c Config) Validate() error {
+	var errs []error
+
+	if c.Naming != nil {
+		errs = append(errs, c.Naming.validate()...)
+	}
+
+	for _, name := range sortedKeys(c.DataSources) {
+		v := c.DataSources[name]
+
+		if v.AssociatedExternalType != nil && v.AssociatedExternalType.Type == "" {
+			errs = append(errs, fmt.Errorf("data source %q associated_external_type: type is required", name))
+		}
+	}
+
+	for _, name := range sortedKeys(c.Resources) {
+		v := c.Resources[name]
+
+		if v.AssociatedExternalType != nil && v.AssociatedExternalType.Type == "" {
+			errs = append(errs, fmt.Errorf("resource %q associated_external_type: type is required", name))
+		}
+
+		if v.CRUD != nil && v.AssociatedExternalType == nil {
+			errs = append(errs, fmt.Errorf("resource %q crud: associated_external_type is required", name))
+		}
+
+		if v.CRUD != nil && v.CRUD.Client != "" && !token.IsIdentifier(v.CRUD.Client) {
+			errs = append(errs, fmt.Errorf("resource %q crud client: %q must be a Go identifier", name, v.CRUD.Client))
+		}
+
+		if v.CRUD != nil && v.CRUD.Input != nil && v.CRUD.Input.Type == "" {
+			errs = append(errs, fmt.Errorf("resource %q crud input: type is required", name))
+		}
+
+		if v.CRUD != nil && v.CRUD.Output != nil && v.CRUD.Output.Type == "" {
+			errs = append(errs, fmt.Errorf("resource %q crud output: type is required", name))
+		}
+
+		if v.SchemaVersion != nil {
+			errs = append(errs, v.SchemaVersion.validate(name)...)
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+func (n Naming) validate() []error {
+	var errs []error
+
+	for _, v := range n.ExtraInitialisms {
+		if !initialismRegex.MatchString(v) {
+			errs = append(errs, fmt.Errorf("naming extra_initialisms: %q must only contain letters and digits", v))
+		}
+	}
+
+	if len(n.ExtraInitialisms) > 0 && !n.Initialisms {
+		errs = append(errs, errors.New("naming extra_initialisms: initialisms must be enabled"))
+	}
+
+	switch n.NestedTypeNames {
+	case "", NestedTypeNamesAttribute, NestedTypeNamesHierarchical:
+	default:
+		errs = append(errs, fmt.Errorf("naming nested_type_names: must be one of %q or %q, got %q", NestedTypeNamesAttribute, NestedTypeNamesHierarchical, n.NestedTypeNames))
+	}
+
+	return errs
+}
+
+func (v SchemaVersion) validate(name string) []error {
+	var errs []error
+
+	if v.Version < 0 {
+		errs = append(errs, fmt.Errorf("resource %q schema_version: version must not be negative", name))
+	}
+
+	versions := make(map[int64]struct{}, len(v.PriorVersions))
+
+	for _, p := range v.PriorVersions {
+		if p.Version < 0 || p.Version >= v.Version {
+			errs = append(errs, fmt.Errorf("resource %q schema_version prior_versions: version %d must be between 0 and %d", name, p.Version, v.Version-1))
+		}
+
+		if _, ok := versions[p.Version]; ok {
+			errs = append(errs, fmt.Errorf("resource %q schema_version prior_versions: version %d is declared more than once", name, p.Version))
+		}
+
+		versions[p.Version] = struct{}{}
+
+		if p.Input == "" {
+			errs = append(errs, fmt.Errorf("resource %q schema_version prior_versions: input is required for version %d", name, p.Version))
+		}
+	}
+
+	return errs
+}
+
+// ReadPriorVersions reads the specification of each prior resource schema
+// version, resolving relative paths against dir. An error is returned if a
+// specification is invalid, or does not declare the resource.
+func (c Config) ReadPriorVersions(ctx context.Context, dir string) error {
+	for _, name := range sortedKeys(c.Resources) {
+		v := c.Resources[name].SchemaVersion
+
+		if v == nil {
+			continue
+		}
+
+		for i, p := range v.PriorVersions {
+			path := p.Input
+
+			if !filepath.IsAbs(path) {
+				path = filepath.Join(dir, path)
+			}
+
+			src, err := os.ReadFile(path)
+			if err != nil {
+				return fmt.Errorf("resource %q schema version %d: %w", name, p.Version, err)
+			}
+
+			s, err := spec.Parse(ctx, src)
+			if err != nil {
+				return fmt.Errorf("resource %q schema version %d: error parsing %s: %w", name, p.Version, p.Input, err)
+			}
+
+			for j := range s.Resources {
+				if s.Resources[j].Name == name {
+					v.PriorVersions[i].resource = &s.Resources[j]
+				}
+			}
+
+			if v.PriorVersions[i].resource == nil {
+				return fmt.Errorf("resource %q schema version %d: resource is not defined in %s", name, p.Version, p.Input)
+			}
+		}
+	}
+
+	return nil
+}
+
+func sortedKeys[T any](m map[string]T) []string {
+	keys := make([]string, 0, len(m))
+
+	for k := range m {
+		keys = append(keys, k)
+	}
+
+	sort.Strings(keys)
+
+	return keys
+}
+
+// ApplyDataSources sets generator settings on the supplied data source schemas. An
+// error is returned if a data source in the configuration is not present in the schemas.
+func (c Config) ApplyDataSources(schemas map[string]schema.GeneratorSchema) error {
+	for _, name := range sortedKeys(c.DataSources) {
+		s, ok := schemas[name]
+
+		if !ok {
+			return fmt.Errorf("data source %q is not defined in the specification", name)
+		}
+
+		s.AssociatedExternalType = schema.NewAssocExtType(c.DataSources[name].AssociatedExternalType, s.Options)
+
+		schemas[name] = s
+	}
+
+	return nil
+}
+
+// ApplyResources sets generator settings on the supplied resource schemas. An
+// error is returned if a resource in the configuration is not present in the schemas.
+func (c Config) ApplyResources(schemas map[string]schema.GeneratorSchema) error {
+	for _, name := range sortedKeys(c.Resources) {
+		s, ok := schemas[name]
+
+		if !ok {
+			return fmt.Errorf("resource %q is not defined in the specification", name)
+		}
+
+		s.AssociatedExternalType = schema.NewAssocExtType(c.Resources[name].AssociatedExternalType, s.Options)
+
+		if crud := c.Resources[name].CRUD; crud != nil {
+			s.CRUD = &schema.CRUD{
+				Client: crud.Client,
+				Input:  schema.NewAssocExtType(crud.Input, s.Options),
+				Output: schema.NewAssocExtType(crud.Output, s.Options),
+			}
+		}
+
+		if v := c.Resources[name].SchemaVersion; v != nil {
+			stateUpgrade, err := newStateUpgrade(*v)
+			if err != nil {
+				return fmt.Errorf("resource %q: %w", name, err)
+			}
+
+			s.StateUpgrade = stateUpgrade
+		}
+
+		schemas[name] = s
+	}
+
+	return nil
+}
+
+// newStateUpgrade converts the schema version, which must have been read by
+// ReadPriorVersions, with prior versions in ascending order.
+func newStateUpgrade(v SchemaVersion) (*schema.StateUpgrade, error) {
+	stateUpgrade := &schema.StateUpgrade{
+		Version: v.Version,
+	}
+
+	for _, p := range v.PriorVersions {
+		if p.resource == nil {
+			return nil, fmt.Errorf("schema version %d has not been read", p.Version)
+		}
+
+		r, err := priorResource(*p.resource)
+		if err != nil {
+			return nil, fmt.Errorf("schema version %d: %w", p.Version, err)
+		}
+
+		s, err := generatorresource.NewSchema(r, &schema.Options{
+			FrameworkTypes: true,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("schema version %d: %w", p.Version, err)
+		}
+
+		stateUpgrade.PriorVersions = append(stateUpgrade.PriorVersions, schema.PriorVersion{
+			Version: p.Version,
+			Schema:  s,
+		})
+	}
+
+	sort.Slice(stateUpgrade.PriorVersions, func(i, j int) bool {
+		return stateUpgrade.PriorVersions[i].Version < stateUpgrade.PriorVersions[j].Version
+	})
+
+	return stateUpgrade, nil
+}
+
+// priorProperties are the properties of attributes and blocks which are removed
+// from prior versions of resource schemas, as they are not needed to read prior
+// state.
+var priorProperties = []string{
+	"associated_external_type",
+	"custom_type",
+	"default",
+	"deprecation_message",
+	"description",
+	"markdown_description",
+	"plan_modifiers",
+	"validators",
+}
+
+// priorResource returns a copy of the prior version of a resource with the
+// priorProperties removed from the schema, and from every attribute, block and
+// element type within it.
+func priorResource(r resource.Resource) (resource.Resource, error) {
+	var result resource.Resource
+
+	b, err := json.Marshal(r)
+	if err != nil {
+		return result, err
+	}
+
+	var v any
+
+	err = json.Unmarshal(b, &v)
+	if err != nil {
+		return result, err
+	}
+
+	removePriorProperties(v)
+
+	b, err = json.Marshal(v)
+	if err != nil {
+		return result, err
+	}
+
+	err = json.Unmarshal(b, &result)
+
+	return result, err
+}
+
+// removePriorProperties removes the priorProperties from every object within the
+// decoded JSON value.
+func removePriorProperties(v any) {
+	switch v := v.(type) {
+	case map[string]any:
+		for _, k := range priorProperties {
+			delete(v, k)
+		}
+
+		for _, e := range v {
+			removePriorProperties(e)
+		}
+	case []any:
+		for _, e := range v {
+			removePriorProperties(e)
+		}
+	}
+}
@@ -0,0 +1,60 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package config
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-codegen-spec/spec"
+
+	"github.com/hashicorp/terraform-plugin-codegen-framework/internal/datasource"
+	"github.com/hashicorp/terraform-plugin-codegen-framework/internal/provider"
+	"github.com/hashicorp/terraform-plugin-codegen-framework/internal/resource"
+	"github.com/hashicorp/terraform-plugin-codegen-framework/internal/schema"
+)
+
+// DataSourceSchemas converts the data source schemas in the specification to
+// framework schemas with the options, and applies the data source settings.
+func (c Config) DataSourceSchemas(s spec.Specification, opts *schema.Options) (map[string]schema.GeneratorSchema, error) {
+	schemas, err := datasource.NewSchemas(s, opts)
+	if err != nil {
+		return nil, fmt.Errorf("error converting IR to Plugin Framework schema: %w", err)
+	}
+
+	err = c.ApplyDataSources(schemas)
+	if err != nil {
+		return nil, fmt.Errorf("error applying generator configuration: %w", err)
+	}
+
+	return schemas, nil
+}
+
+// ResourceSchemas converts the resource schemas in the specification to
+// framework schemas with the options, and applies the resource settings. Prior
+// schema versions must have been read by ReadPriorVersions.
+func (c Config) ResourceSchemas(s spec.Specification, opts *schema.Options) (map[string]schema.GeneratorSchema, error) {
+	schemas, err := resource.NewSchemas(s, opts)
+	if err != nil {
+		return nil, fmt.Errorf("error converting IR to Plugin Framework schema: %w", err)
+	}
+
+	err = c.ApplyResources(schemas)
+	if err != nil {
+		return nil, fmt.Errorf("error applying generator configuration: %w", err)
+	}
+
+	return schemas, nil
+}
+
+// ProviderSchemas converts the provider schema in the specification to a
+// framework schema with the options. The configuration has no provider
+// settings.
+func (c Config) ProviderSchemas(s spec.Specification, opts *schema.Options) (map[string]schema.GeneratorSchema, error) {
+	schemas, err := provider.NewSchemas(s, opts)
+	if err != nil {
+		return nil, fmt.Errorf("error converting IR to Plugin Framework schema: %w", err)
+	}
+
+	return schemas, nil
+}
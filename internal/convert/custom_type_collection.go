@@ -9,7 +9,6 @@ import (
 	"github.com/hashicorp/terraform-plugin-codegen-spec/code"
 	specschema "github.com/hashicorp/terraform-plugin-codegen-spec/schema"
 
-	"github.com/hashicorp/terraform-plugin-codegen-framework/internal/format"
 	"github.com/hashicorp/terraform-plugin-codegen-framework/internal/schema"
 )
 
@@ -31,6 +30,7 @@ type CustomTypeCollection struct {
 	customType             *specschema.CustomType
 	elementType            string
 	name                   string
+	options                *schema.Options
 }
 
 // NewCustomTypeCollection constructs an CustomTypeCollection which is used to determine whether a CustomType
@@ -42,13 +42,14 @@ type CustomTypeCollection struct {
 // If the spec CustomType is nil, and the spec AssociatedExternalType is not nil, the generator
 // will create custom Type and Value types using the attribute name, and the generated custom
 // Type type will be used as the CustomType in the schema.
-func NewCustomTypeCollection(c *specschema.CustomType, a *specschema.AssociatedExternalType, cct CustomCollectionTypes, elemType, name string) CustomTypeCollection {
+func NewCustomTypeCollection(c *specschema.CustomType, a *specschema.AssociatedExternalType, cct CustomCollectionTypes, elemType, name string, opts *schema.Options) CustomTypeCollection {
 	return CustomTypeCollection{
 		associatedExternalType: a,
 		customCollectionType:   cct,
 		customType:             c,
 		elementType:            elemType,
 		name:                   name,
+		options:                opts,
 	}
 }
 
@@ -95,7 +96,7 @@ func (c CustomTypeCollection) Schema() []byte {
 	case c.customType != nil:
 		customType = c.customType.Type
 	case c.associatedExternalType != nil:
-		customType = fmt.Sprintf("%sType{\ntypes.%sType{\nElemType: %s,\n},\n}", format.ToPascalCase(c.name), c.customCollectionType, c.elementType)
+		customType = fmt.Sprintf("%sType{\ntypes.%sType{\nElemType: %s,\n},\n}", c.options.ToPascalCase(schema.FrameworkIdentifier(c.name)), c.customCollectionType, c.elementType)
 	}
 
 	if customType != "" {
@@ -110,7 +111,7 @@ func (c CustomTypeCollection) ValueType() string {
 	case c.customType != nil:
 		return c.customType.ValueType
 	case c.associatedExternalType != nil:
-		return fmt.Sprintf("%sValue", format.ToPascalCase(c.name))
+		return fmt.Sprintf("%sValue", c.options.ToPascalCase(schema.FrameworkIdentifier(c.name)))
 	}
 
 	return ""
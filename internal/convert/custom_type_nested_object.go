@@ -9,13 +9,13 @@ import (
 	"github.com/hashicorp/terraform-plugin-codegen-spec/code"
 	specschema "github.com/hashicorp/terraform-plugin-codegen-spec/schema"
 
-	"github.com/hashicorp/terraform-plugin-codegen-framework/internal/format"
 	"github.com/hashicorp/terraform-plugin-codegen-framework/internal/schema"
 )
 
 type CustomTypeNestedObject struct {
 	customType *specschema.CustomType
 	name       string
+	options    *schema.Options
 }
 
 // NewCustomTypeNestedObject constructs an CustomTypeNestedObject which is used to determine whether a CustomType
@@ -26,10 +26,11 @@ type CustomTypeNestedObject struct {
 //
 // If the spec CustomType is nil, the generator will create custom Type and Value types using the attribute
 // name, and the generated custom Type type will be used as the CustomType in the schema.
-func NewCustomTypeNestedObject(c *specschema.CustomType, name string) CustomTypeNestedObject {
+func NewCustomTypeNestedObject(c *specschema.CustomType, name string, opts *schema.Options) CustomTypeNestedObject {
 	return CustomTypeNestedObject{
 		customType: c,
 		name:       name,
+		options:    opts,
 	}
 }
 
@@ -63,8 +64,10 @@ func (c CustomTypeNestedObject) Schema() []byte {
 	switch {
 	case c.customType != nil:
 		customTypeType = c.customType.Type
+	case c.options != nil && c.options.FrameworkTypes:
+		return nil
 	default:
-		customTypeType = fmt.Sprintf("%sType{\nObjectType: types.ObjectType{\nAttrTypes: %sValue{}.AttributeTypes(ctx),\n},\n}", format.ToPascalCase(c.name), format.ToPascalCase(c.name))
+		customTypeType = fmt.Sprintf("%sType{\nObjectType: types.ObjectType{\nAttrTypes: %sValue{}.AttributeTypes(ctx),\n},\n}", c.options.TypeReference(c.name), c.options.TypeReference(c.name))
 	}
 
 	if customTypeType != "" {
@@ -9,7 +9,6 @@ import (
 	"github.com/hashicorp/terraform-plugin-codegen-spec/code"
 	specschema "github.com/hashicorp/terraform-plugin-codegen-spec/schema"
 
-	"github.com/hashicorp/terraform-plugin-codegen-framework/internal/format"
 	"github.com/hashicorp/terraform-plugin-codegen-framework/internal/schema"
 )
 
@@ -17,6 +16,7 @@ type CustomTypeObject struct {
 	associatedExternalType *specschema.AssociatedExternalType
 	customType             *specschema.CustomType
 	name                   string
+	options                *schema.Options
 }
 
 // NewCustomTypeObject constructs an CustomTypeObject which is used to determine whether a CustomType
@@ -28,11 +28,12 @@ type CustomTypeObject struct {
 // If the spec CustomType is nil, and the spec AssociatedExternalType is not nil, the generator
 // will create custom Type and Value types using the attribute name, and the generated custom
 // Type type will be used as the CustomType in the schema.
-func NewCustomTypeObject(c *specschema.CustomType, a *specschema.AssociatedExternalType, name string) CustomTypeObject {
+func NewCustomTypeObject(c *specschema.CustomType, a *specschema.AssociatedExternalType, name string, opts *schema.Options) CustomTypeObject {
 	return CustomTypeObject{
 		associatedExternalType: a,
 		customType:             c,
 		name:                   name,
+		options:                opts,
 	}
 }
 
@@ -71,7 +72,8 @@ func (c CustomTypeObject) Schema() []byte {
 	case c.customType != nil:
 		customType = c.customType.Type
 	case c.associatedExternalType != nil:
-		customType = fmt.Sprintf("%sType{\ntypes.ObjectType{\nAttrTypes: %sValue{}.AttributeTypes(ctx),\n},\n}", format.ToPascalCase(c.name), format.ToPascalCase(c.name))
+		name := c.options.ToPascalCase(schema.FrameworkIdentifier(c.name))
+		customType = fmt.Sprintf("%sType{\ntypes.ObjectType{\nAttrTypes: %sValue{}.AttributeTypes(ctx),\n},\n}", name, name)
 	}
 
 	if customType != "" {
@@ -86,7 +88,7 @@ func (c CustomTypeObject) ValueType() string {
 	case c.customType != nil:
 		return c.customType.ValueType
 	case c.associatedExternalType != nil:
-		return fmt.Sprintf("%sValue", format.ToPascalCase(c.name))
+		return fmt.Sprintf("%sValue", c.options.ToPascalCase(schema.FrameworkIdentifier(c.name)))
 	}
 
 	return ""
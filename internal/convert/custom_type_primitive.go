@@ -9,7 +9,6 @@ import (
 	"github.com/hashicorp/terraform-plugin-codegen-spec/code"
 	specschema "github.com/hashicorp/terraform-plugin-codegen-spec/schema"
 
-	"github.com/hashicorp/terraform-plugin-codegen-framework/internal/format"
 	"github.com/hashicorp/terraform-plugin-codegen-framework/internal/schema"
 )
 
@@ -17,6 +16,7 @@ type CustomTypePrimitive struct {
 	associatedExternalType *specschema.AssociatedExternalType
 	customType             *specschema.CustomType
 	name                   string
+	options                *schema.Options
 }
 
 // NewCustomTypePrimitive constructs an CustomTypePrimitive which is used to determine whether a CustomType
@@ -28,11 +28,12 @@ type CustomTypePrimitive struct {
 // If the spec CustomType is nil, and the spec AssociatedExternalType is not nil, the generator
 // will create custom Type and Value types using the attribute name, and the generated custom
 // Type type will be used as the CustomType in the schema.
-func NewCustomTypePrimitive(c *specschema.CustomType, a *specschema.AssociatedExternalType, name string) CustomTypePrimitive {
+func NewCustomTypePrimitive(c *specschema.CustomType, a *specschema.AssociatedExternalType, name string, opts *schema.Options) CustomTypePrimitive {
 	return CustomTypePrimitive{
 		associatedExternalType: a,
 		customType:             c,
 		name:                   name,
+		options:                opts,
 	}
 }
 
@@ -71,7 +72,7 @@ func (c CustomTypePrimitive) Schema() []byte {
 	case c.customType != nil:
 		customType = c.customType.Type
 	case c.associatedExternalType != nil:
-		customType = fmt.Sprintf("%sType{}", format.ToPascalCase(c.name))
+		customType = fmt.Sprintf("%sType{}", c.options.ToPascalCase(schema.FrameworkIdentifier(c.name)))
 	}
 
 	if customType != "" {
@@ -86,7 +87,7 @@ func (c CustomTypePrimitive) ValueType() string {
 	case c.customType != nil:
 		return c.customType.ValueType
 	case c.associatedExternalType != nil:
-		return fmt.Sprintf("%sValue", format.ToPascalCase(c.name))
+		return fmt.Sprintf("%sValue", c.options.ToPascalCase(schema.FrameworkIdentifier(c.name)))
 	}
 
 	return ""
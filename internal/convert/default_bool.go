@@ -4,6 +4,7 @@
 package convert
 
 import (
+	"encoding/json"
 	"fmt"
 
 	"github.com/hashicorp/terraform-plugin-codegen-spec/code"
@@ -67,3 +68,18 @@ func (d DefaultBool) Schema() []byte {
 
 	return nil
 }
+
+// Static returns the static default value as a JSON literal, which is also a
+// valid Terraform expression, or an empty string if there is no static default.
+func (d DefaultBool) Static() string {
+	if d.boolDefault == nil || d.boolDefault.Static == nil {
+		return ""
+	}
+
+	b, err := json.Marshal(*d.boolDefault.Static)
+	if err != nil {
+		return ""
+	}
+
+	return string(b)
+}
@@ -4,6 +4,7 @@
 package convert
 
 import (
+	"encoding/json"
 	"fmt"
 
 	"github.com/hashicorp/terraform-plugin-codegen-spec/code"
@@ -67,3 +68,18 @@ func (d DefaultFloat64) Schema() []byte {
 
 	return nil
 }
+
+// Static returns the static default value as a JSON literal, which is also a
+// valid Terraform expression, or an empty string if there is no static default.
+func (d DefaultFloat64) Static() string {
+	if d.float64Default == nil || d.float64Default.Static == nil {
+		return ""
+	}
+
+	b, err := json.Marshal(*d.float64Default.Static)
+	if err != nil {
+		return ""
+	}
+
+	return string(b)
+}
@@ -4,6 +4,7 @@
 package convert
 
 import (
+	"encoding/json"
 	"fmt"
 
 	"github.com/hashicorp/terraform-plugin-codegen-spec/code"
@@ -67,3 +68,18 @@ func (d DefaultInt64) Schema() []byte {
 
 	return nil
 }
+
+// Static returns the static default value as a JSON literal, which is also a
+// valid Terraform expression, or an empty string if there is no static default.
+func (d DefaultInt64) Static() string {
+	if d.int64Default == nil || d.int64Default.Static == nil {
+		return ""
+	}
+
+	b, err := json.Marshal(*d.int64Default.Static)
+	if err != nil {
+		return ""
+	}
+
+	return string(b)
+}
@@ -19,10 +19,10 @@ type NestedAttributeObject struct {
 
 // NewNestedAttributeObject constructs a NestedAttributeObject which is used to generate a
 // nested attribute object in the schema.
-func NewNestedAttributeObject(a schema.GeneratorAttributes, c *specschema.CustomType, v Validators, name string) NestedAttributeObject {
+func NewNestedAttributeObject(a schema.GeneratorAttributes, c *specschema.CustomType, v Validators, name string, opts *schema.Options) NestedAttributeObject {
 	return NestedAttributeObject{
 		attributes: a,
-		customType: NewCustomTypeNestedObject(c, name),
+		customType: NewCustomTypeNestedObject(c, name, opts),
 		validators: v,
 	}
 }
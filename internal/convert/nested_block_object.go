@@ -20,11 +20,11 @@ type NestedBlockObject struct {
 
 // NewNestedBlockObject constructs a NestedBlockObject which is used to generate a
 // nested attribute block in the schema.
-func NewNestedBlockObject(a schema.GeneratorAttributes, b schema.GeneratorBlocks, c *specschema.CustomType, v Validators, name string) NestedBlockObject {
+func NewNestedBlockObject(a schema.GeneratorAttributes, b schema.GeneratorBlocks, c *specschema.CustomType, v Validators, name string, opts *schema.Options) NestedBlockObject {
 	return NestedBlockObject{
 		attributes: a,
 		blocks:     b,
-		customType: NewCustomTypeNestedObject(c, name),
+		customType: NewCustomTypeNestedObject(c, name, opts),
 		validators: v,
 	}
 }
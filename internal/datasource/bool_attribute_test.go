@@ -34,7 +34,7 @@ func TestGeneratorBoolAttribute_New(t *testing.T) {
 			},
 			expected: GeneratorBoolAttribute{
 				ComputedOptionalRequired: convert.NewComputedOptionalRequired(specschema.Computed),
-				CustomType:               convert.NewCustomTypePrimitive(nil, nil, "name"),
+				CustomType:               convert.NewCustomTypePrimitive(nil, nil, "name", nil),
 				Validators:               convert.NewValidators(convert.ValidatorTypeBool, specschema.CustomValidators{}),
 			},
 		},
@@ -44,7 +44,7 @@ func TestGeneratorBoolAttribute_New(t *testing.T) {
 			},
 			expected: GeneratorBoolAttribute{
 				ComputedOptionalRequired: convert.NewComputedOptionalRequired(specschema.ComputedOptional),
-				CustomType:               convert.NewCustomTypePrimitive(nil, nil, "name"),
+				CustomType:               convert.NewCustomTypePrimitive(nil, nil, "name", nil),
 				Validators:               convert.NewValidators(convert.ValidatorTypeBool, specschema.CustomValidators{}),
 			},
 		},
@@ -54,7 +54,7 @@ func TestGeneratorBoolAttribute_New(t *testing.T) {
 			},
 			expected: GeneratorBoolAttribute{
 				ComputedOptionalRequired: convert.NewComputedOptionalRequired(specschema.Optional),
-				CustomType:               convert.NewCustomTypePrimitive(nil, nil, "name"),
+				CustomType:               convert.NewCustomTypePrimitive(nil, nil, "name", nil),
 				Validators:               convert.NewValidators(convert.ValidatorTypeBool, specschema.CustomValidators{}),
 			},
 		},
@@ -64,7 +64,7 @@ func TestGeneratorBoolAttribute_New(t *testing.T) {
 			},
 			expected: GeneratorBoolAttribute{
 				ComputedOptionalRequired: convert.NewComputedOptionalRequired(specschema.Required),
-				CustomType:               convert.NewCustomTypePrimitive(nil, nil, "name"),
+				CustomType:               convert.NewCustomTypePrimitive(nil, nil, "name", nil),
 				Validators:               convert.NewValidators(convert.ValidatorTypeBool, specschema.CustomValidators{}),
 			},
 		},
@@ -85,7 +85,7 @@ func TestGeneratorBoolAttribute_New(t *testing.T) {
 					},
 					Type:      "my_type",
 					ValueType: "myvalue_type",
-				}, nil, "name"),
+				}, nil, "name", nil),
 				Validators: convert.NewValidators(convert.ValidatorTypeBool, nil),
 			},
 		},
@@ -94,7 +94,7 @@ func TestGeneratorBoolAttribute_New(t *testing.T) {
 				DeprecationMessage: pointer("deprecation message"),
 			},
 			expected: GeneratorBoolAttribute{
-				CustomType:         convert.NewCustomTypePrimitive(nil, nil, "name"),
+				CustomType:         convert.NewCustomTypePrimitive(nil, nil, "name", nil),
 				DeprecationMessage: convert.NewDeprecationMessage(pointer("deprecation message")),
 				Validators:         convert.NewValidators(convert.ValidatorTypeBool, specschema.CustomValidators{}),
 			},
@@ -104,7 +104,7 @@ func TestGeneratorBoolAttribute_New(t *testing.T) {
 				Description: pointer("description"),
 			},
 			expected: GeneratorBoolAttribute{
-				CustomType:  convert.NewCustomTypePrimitive(nil, nil, "name"),
+				CustomType:  convert.NewCustomTypePrimitive(nil, nil, "name", nil),
 				Description: convert.NewDescription(pointer("description")),
 				Validators:  convert.NewValidators(convert.ValidatorTypeBool, specschema.CustomValidators{}),
 			},
@@ -114,7 +114,7 @@ func TestGeneratorBoolAttribute_New(t *testing.T) {
 				Sensitive: pointer(true),
 			},
 			expected: GeneratorBoolAttribute{
-				CustomType: convert.NewCustomTypePrimitive(nil, nil, "name"),
+				CustomType: convert.NewCustomTypePrimitive(nil, nil, "name", nil),
 				Sensitive:  convert.NewSensitive(pointer(true)),
 				Validators: convert.NewValidators(convert.ValidatorTypeBool, specschema.CustomValidators{}),
 			},
@@ -135,7 +135,7 @@ func TestGeneratorBoolAttribute_New(t *testing.T) {
 				},
 			},
 			expected: GeneratorBoolAttribute{
-				CustomType: convert.NewCustomTypePrimitive(nil, nil, "name"),
+				CustomType: convert.NewCustomTypePrimitive(nil, nil, "name", nil),
 				Validators: convert.NewValidators(convert.ValidatorTypeBool, specschema.CustomValidators{
 					&specschema.CustomValidator{
 						Imports: []code.Import{
@@ -155,7 +155,7 @@ func TestGeneratorBoolAttribute_New(t *testing.T) {
 		t.Run(name, func(t *testing.T) {
 			t.Parallel()
 
-			got, err := NewGeneratorBoolAttribute("name", testCase.input)
+			got, err := NewGeneratorBoolAttribute("name", testCase.input, nil)
 
 			if diff := cmp.Diff(err, testCase.expectedError, equateErrorMessage); diff != "" {
 				t.Errorf("unexpected error: %s", diff)
@@ -184,7 +184,7 @@ func TestGeneratorBoolAttribute_Imports(t *testing.T) {
 		},
 		"custom-type-without-import": {
 			input: GeneratorBoolAttribute{
-				CustomType: convert.NewCustomTypePrimitive(&specschema.CustomType{}, nil, ""),
+				CustomType: convert.NewCustomTypePrimitive(&specschema.CustomType{}, nil, "", nil),
 			},
 			expected: []code.Import{},
 		},
@@ -198,6 +198,7 @@ func TestGeneratorBoolAttribute_Imports(t *testing.T) {
 					},
 					nil,
 					"",
+					nil,
 				),
 			},
 			expected: []code.Import{},
@@ -212,6 +213,7 @@ func TestGeneratorBoolAttribute_Imports(t *testing.T) {
 					},
 					nil,
 					"",
+					nil,
 				),
 			},
 			expected: []code.Import{
@@ -374,6 +376,7 @@ func TestGeneratorBoolAttribute_Imports(t *testing.T) {
 					},
 					nil,
 					"",
+					nil,
 				),
 			},
 			expected: []code.Import{
@@ -432,6 +435,7 @@ func TestGeneratorBoolAttribute_Schema(t *testing.T) {
 					},
 					nil,
 					"bool_attribute",
+					nil,
 				),
 			},
 			expected: `"bool_attribute": schema.BoolAttribute{
@@ -447,6 +451,7 @@ CustomType: my_custom_type,
 						Type: "*api.ExtBool",
 					},
 					"bool_attribute",
+					nil,
 				),
 			},
 			expected: `"bool_attribute": schema.BoolAttribute{
@@ -464,6 +469,7 @@ CustomType: BoolAttributeType{},
 						Type: "*api.ExtBool",
 					},
 					"bool_attribute",
+					nil,
 				),
 			},
 			expected: `"bool_attribute": schema.BoolAttribute{
@@ -595,6 +601,7 @@ func TestGeneratorBoolAttribute_ModelField(t *testing.T) {
 					},
 					nil,
 					"",
+					nil,
 				),
 			},
 			expected: model.Field{
@@ -611,6 +618,7 @@ func TestGeneratorBoolAttribute_ModelField(t *testing.T) {
 						Type: "*api.BoolAttribute",
 					},
 					"bool_attribute",
+					nil,
 				),
 			},
 			expected: model.Field{
@@ -629,6 +637,7 @@ func TestGeneratorBoolAttribute_ModelField(t *testing.T) {
 						Type: "*api.BoolAttribute",
 					},
 					"",
+					nil,
 				),
 			},
 			expected: model.Field{
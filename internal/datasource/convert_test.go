@@ -183,7 +183,7 @@ func Test_NewSchemas(t *testing.T) {
 					Attributes: generatorschema.GeneratorAttributes{
 						"bool_attribute": GeneratorBoolAttribute{
 							ComputedOptionalRequired: convert.NewComputedOptionalRequired(specschema.Optional),
-							CustomType:               convert.NewCustomTypePrimitive(nil, nil, "bool_attribute"),
+							CustomType:               convert.NewCustomTypePrimitive(nil, nil, "bool_attribute", nil),
 							Sensitive:                convert.NewSensitive(pointer(true)),
 							Validators:               convert.NewValidators(convert.ValidatorTypeBool, specschema.CustomValidators{}),
 						},
@@ -195,6 +195,7 @@ func Test_NewSchemas(t *testing.T) {
 								convert.CustomCollectionTypeList,
 								"types.ListType{\nElemType: types.StringType,\n}",
 								"list_attribute",
+								nil,
 							),
 							ElementType: specschema.ElementType{
 								List: &specschema.ListType{
@@ -220,6 +221,7 @@ func Test_NewSchemas(t *testing.T) {
 								convert.CustomCollectionTypeMap,
 								"types.MapType{\nElemType: types.StringType,\n}",
 								"map_attribute",
+								nil,
 							),
 							ElementType: specschema.ElementType{
 								Map: &specschema.MapType{
@@ -245,6 +247,7 @@ func Test_NewSchemas(t *testing.T) {
 								convert.CustomCollectionTypeSet,
 								"types.SetType{\nElemType: types.StringType,\n}",
 								"set_attribute",
+								nil,
 							),
 							ElementType: specschema.ElementType{
 								Set: &specschema.SetType{
@@ -268,7 +271,7 @@ func Test_NewSchemas(t *testing.T) {
 								Attributes: generatorschema.GeneratorAttributes{
 									"nested_bool_attribute": GeneratorBoolAttribute{
 										ComputedOptionalRequired: convert.NewComputedOptionalRequired(specschema.Optional),
-										CustomType:               convert.NewCustomTypePrimitive(nil, nil, "nested_bool_attribute"),
+										CustomType:               convert.NewCustomTypePrimitive(nil, nil, "nested_bool_attribute", nil),
 										Validators:               convert.NewValidators(convert.ValidatorTypeBool, specschema.CustomValidators{}),
 									},
 									"nested_list_attribute": GeneratorListAttribute{
@@ -279,6 +282,7 @@ func Test_NewSchemas(t *testing.T) {
 											convert.CustomCollectionTypeList,
 											"types.StringType",
 											"nested_list_attribute",
+											nil,
 										),
 										ElementType: specschema.ElementType{
 											String: &specschema.StringType{},
@@ -294,7 +298,7 @@ func Test_NewSchemas(t *testing.T) {
 								generatorschema.GeneratorAttributes{
 									"nested_bool_attribute": GeneratorBoolAttribute{
 										ComputedOptionalRequired: convert.NewComputedOptionalRequired(specschema.Optional),
-										CustomType:               convert.NewCustomTypePrimitive(nil, nil, "nested_bool_attribute"),
+										CustomType:               convert.NewCustomTypePrimitive(nil, nil, "nested_bool_attribute", nil),
 										Validators:               convert.NewValidators(convert.ValidatorTypeBool, specschema.CustomValidators{}),
 									},
 									"nested_list_attribute": GeneratorListAttribute{
@@ -305,6 +309,7 @@ func Test_NewSchemas(t *testing.T) {
 											convert.CustomCollectionTypeList,
 											"types.StringType",
 											"nested_list_attribute",
+											nil,
 										),
 										ElementType: specschema.ElementType{
 											String: &specschema.StringType{},
@@ -318,6 +323,7 @@ func Test_NewSchemas(t *testing.T) {
 								nil,
 								convert.NewValidators(convert.ValidatorTypeObject, specschema.CustomValidators{}),
 								"list_nested_attribute",
+								nil,
 							),
 							Validators: convert.NewValidators(convert.ValidatorTypeList, specschema.CustomValidators{}),
 						},
@@ -351,14 +357,14 @@ func Test_NewSchemas(t *testing.T) {
 								},
 							}),
 							ComputedOptionalRequired: convert.NewComputedOptionalRequired(specschema.Optional),
-							CustomType:               convert.NewCustomTypeObject(nil, nil, "object_attribute"),
+							CustomType:               convert.NewCustomTypeObject(nil, nil, "object_attribute", nil),
 							Validators:               convert.NewValidators(convert.ValidatorTypeObject, specschema.CustomValidators{}),
 						},
 						"single_nested_attribute": GeneratorSingleNestedAttribute{
 							Attributes: generatorschema.GeneratorAttributes{
 								"nested_bool_attribute": GeneratorBoolAttribute{
 									ComputedOptionalRequired: convert.NewComputedOptionalRequired(specschema.Optional),
-									CustomType:               convert.NewCustomTypePrimitive(nil, nil, "nested_bool_attribute"),
+									CustomType:               convert.NewCustomTypePrimitive(nil, nil, "nested_bool_attribute", nil),
 									Validators:               convert.NewValidators(convert.ValidatorTypeBool, specschema.CustomValidators{}),
 								},
 								"nested_list_attribute": GeneratorListAttribute{
@@ -369,6 +375,7 @@ func Test_NewSchemas(t *testing.T) {
 										convert.CustomCollectionTypeList,
 										"types.StringType",
 										"nested_list_attribute",
+										nil,
 									),
 									ElementType: specschema.ElementType{
 										String: &specschema.StringType{},
@@ -380,7 +387,7 @@ func Test_NewSchemas(t *testing.T) {
 								},
 							},
 							ComputedOptionalRequired: convert.NewComputedOptionalRequired(specschema.Optional),
-							CustomType:               convert.NewCustomTypeNestedObject(nil, "single_nested_attribute"),
+							CustomType:               convert.NewCustomTypeNestedObject(nil, "single_nested_attribute", nil),
 							Validators:               convert.NewValidators(convert.ValidatorTypeObject, specschema.CustomValidators{}),
 						},
 					},
@@ -390,7 +397,7 @@ func Test_NewSchemas(t *testing.T) {
 								Attributes: generatorschema.GeneratorAttributes{
 									"nested_bool_attribute": GeneratorBoolAttribute{
 										ComputedOptionalRequired: convert.NewComputedOptionalRequired(specschema.Optional),
-										CustomType:               convert.NewCustomTypePrimitive(nil, nil, "nested_bool_attribute"),
+										CustomType:               convert.NewCustomTypePrimitive(nil, nil, "nested_bool_attribute", nil),
 										Validators:               convert.NewValidators(convert.ValidatorTypeBool, specschema.CustomValidators{}),
 									},
 								},
@@ -399,7 +406,7 @@ func Test_NewSchemas(t *testing.T) {
 								generatorschema.GeneratorAttributes{
 									"nested_bool_attribute": GeneratorBoolAttribute{
 										ComputedOptionalRequired: convert.NewComputedOptionalRequired(specschema.Optional),
-										CustomType:               convert.NewCustomTypePrimitive(nil, nil, "nested_bool_attribute"),
+										CustomType:               convert.NewCustomTypePrimitive(nil, nil, "nested_bool_attribute", nil),
 										Validators:               convert.NewValidators(convert.ValidatorTypeBool, specschema.CustomValidators{}),
 									},
 								},
@@ -407,6 +414,7 @@ func Test_NewSchemas(t *testing.T) {
 								nil,
 								convert.NewValidators(convert.ValidatorTypeObject, specschema.CustomValidators{}),
 								"list_nested_block",
+								nil,
 							),
 							Validators: convert.NewValidators(convert.ValidatorTypeList, specschema.CustomValidators{}),
 						},
@@ -414,11 +422,11 @@ func Test_NewSchemas(t *testing.T) {
 							Attributes: generatorschema.GeneratorAttributes{
 								"nested_bool_attribute": GeneratorBoolAttribute{
 									ComputedOptionalRequired: convert.NewComputedOptionalRequired(specschema.Optional),
-									CustomType:               convert.NewCustomTypePrimitive(nil, nil, "nested_bool_attribute"),
+									CustomType:               convert.NewCustomTypePrimitive(nil, nil, "nested_bool_attribute", nil),
 									Validators:               convert.NewValidators(convert.ValidatorTypeBool, specschema.CustomValidators{}),
 								},
 							},
-							CustomType: convert.NewCustomTypeNestedObject(nil, "single_nested_block"),
+							CustomType: convert.NewCustomTypeNestedObject(nil, "single_nested_block", nil),
 							Validators: convert.NewValidators(convert.ValidatorTypeObject, specschema.CustomValidators{}),
 						},
 					},
@@ -432,7 +440,7 @@ func Test_NewSchemas(t *testing.T) {
 		t.Run(name, func(t *testing.T) {
 			t.Parallel()
 
-			got, err := NewSchemas(testCase.spec)
+			got, err := NewSchemas(testCase.spec, nil)
 
 			if err != nil {
 				t.Error(err)
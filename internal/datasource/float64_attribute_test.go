@@ -33,7 +33,7 @@ func TestGeneratorFloat64Attribute_New(t *testing.T) {
 			},
 			expected: GeneratorFloat64Attribute{
 				ComputedOptionalRequired: convert.NewComputedOptionalRequired(specschema.Computed),
-				CustomType:               convert.NewCustomTypePrimitive(nil, nil, "name"),
+				CustomType:               convert.NewCustomTypePrimitive(nil, nil, "name", nil),
 				Validators:               convert.NewValidators(convert.ValidatorTypeFloat64, specschema.CustomValidators{}),
 			},
 		},
@@ -43,7 +43,7 @@ func TestGeneratorFloat64Attribute_New(t *testing.T) {
 			},
 			expected: GeneratorFloat64Attribute{
 				ComputedOptionalRequired: convert.NewComputedOptionalRequired(specschema.ComputedOptional),
-				CustomType:               convert.NewCustomTypePrimitive(nil, nil, "name"),
+				CustomType:               convert.NewCustomTypePrimitive(nil, nil, "name", nil),
 				Validators:               convert.NewValidators(convert.ValidatorTypeFloat64, specschema.CustomValidators{}),
 			},
 		},
@@ -53,7 +53,7 @@ func TestGeneratorFloat64Attribute_New(t *testing.T) {
 			},
 			expected: GeneratorFloat64Attribute{
 				ComputedOptionalRequired: convert.NewComputedOptionalRequired(specschema.Optional),
-				CustomType:               convert.NewCustomTypePrimitive(nil, nil, "name"),
+				CustomType:               convert.NewCustomTypePrimitive(nil, nil, "name", nil),
 				Validators:               convert.NewValidators(convert.ValidatorTypeFloat64, specschema.CustomValidators{}),
 			},
 		},
@@ -63,7 +63,7 @@ func TestGeneratorFloat64Attribute_New(t *testing.T) {
 			},
 			expected: GeneratorFloat64Attribute{
 				ComputedOptionalRequired: convert.NewComputedOptionalRequired(specschema.Required),
-				CustomType:               convert.NewCustomTypePrimitive(nil, nil, "name"),
+				CustomType:               convert.NewCustomTypePrimitive(nil, nil, "name", nil),
 				Validators:               convert.NewValidators(convert.ValidatorTypeFloat64, specschema.CustomValidators{}),
 			},
 		},
@@ -84,7 +84,7 @@ func TestGeneratorFloat64Attribute_New(t *testing.T) {
 					},
 					Type:      "my_type",
 					ValueType: "myvalue_type",
-				}, nil, "name"),
+				}, nil, "name", nil),
 				Validators: convert.NewValidators(convert.ValidatorTypeFloat64, nil),
 			},
 		},
@@ -93,7 +93,7 @@ func TestGeneratorFloat64Attribute_New(t *testing.T) {
 				DeprecationMessage: pointer("deprecation message"),
 			},
 			expected: GeneratorFloat64Attribute{
-				CustomType:         convert.NewCustomTypePrimitive(nil, nil, "name"),
+				CustomType:         convert.NewCustomTypePrimitive(nil, nil, "name", nil),
 				DeprecationMessage: convert.NewDeprecationMessage(pointer("deprecation message")),
 				Validators:         convert.NewValidators(convert.ValidatorTypeFloat64, specschema.CustomValidators{}),
 			},
@@ -103,7 +103,7 @@ func TestGeneratorFloat64Attribute_New(t *testing.T) {
 				Description: pointer("description"),
 			},
 			expected: GeneratorFloat64Attribute{
-				CustomType:  convert.NewCustomTypePrimitive(nil, nil, "name"),
+				CustomType:  convert.NewCustomTypePrimitive(nil, nil, "name", nil),
 				Description: convert.NewDescription(pointer("description")),
 				Validators:  convert.NewValidators(convert.ValidatorTypeFloat64, specschema.CustomValidators{}),
 			},
@@ -113,7 +113,7 @@ func TestGeneratorFloat64Attribute_New(t *testing.T) {
 				Sensitive: pointer(true),
 			},
 			expected: GeneratorFloat64Attribute{
-				CustomType: convert.NewCustomTypePrimitive(nil, nil, "name"),
+				CustomType: convert.NewCustomTypePrimitive(nil, nil, "name", nil),
 				Sensitive:  convert.NewSensitive(pointer(true)),
 				Validators: convert.NewValidators(convert.ValidatorTypeFloat64, specschema.CustomValidators{}),
 			},
@@ -134,7 +134,7 @@ func TestGeneratorFloat64Attribute_New(t *testing.T) {
 				},
 			},
 			expected: GeneratorFloat64Attribute{
-				CustomType: convert.NewCustomTypePrimitive(nil, nil, "name"),
+				CustomType: convert.NewCustomTypePrimitive(nil, nil, "name", nil),
 				Validators: convert.NewValidators(convert.ValidatorTypeFloat64, specschema.CustomValidators{
 					&specschema.CustomValidator{
 						Imports: []code.Import{
@@ -154,7 +154,7 @@ func TestGeneratorFloat64Attribute_New(t *testing.T) {
 		t.Run(name, func(t *testing.T) {
 			t.Parallel()
 
-			got, err := NewGeneratorFloat64Attribute("name", testCase.input)
+			got, err := NewGeneratorFloat64Attribute("name", testCase.input, nil)
 
 			if diff := cmp.Diff(err, testCase.expectedError, equateErrorMessage); diff != "" {
 				t.Errorf("unexpected error: %s", diff)
@@ -183,6 +183,7 @@ func TestGeneratorFloat64Attribute_Schema(t *testing.T) {
 					},
 					nil,
 					"float64_attribute",
+					nil,
 				),
 			},
 			expected: `"float64_attribute": schema.Float64Attribute{
@@ -198,6 +199,7 @@ CustomType: my_custom_type,
 						Type: "*api.ExtFloat64",
 					},
 					"float64_attribute",
+					nil,
 				),
 			},
 			expected: `"float64_attribute": schema.Float64Attribute{
@@ -215,6 +217,7 @@ CustomType: Float64AttributeType{},
 						Type: "*api.ExtFloat64",
 					},
 					"float64_attribute",
+					nil,
 				),
 			},
 			expected: `"float64_attribute": schema.Float64Attribute{
@@ -345,6 +348,7 @@ func TestGeneratorFloat64Attribute_ModelField(t *testing.T) {
 					},
 					nil,
 					"",
+					nil,
 				),
 			},
 			expected: model.Field{
@@ -361,6 +365,7 @@ func TestGeneratorFloat64Attribute_ModelField(t *testing.T) {
 						Type: "*api.Float64Attribute",
 					},
 					"float64_attribute",
+					nil,
 				),
 			},
 			expected: model.Field{
@@ -379,6 +384,7 @@ func TestGeneratorFloat64Attribute_ModelField(t *testing.T) {
 						Type: "*api.Float64Attribute",
 					},
 					"",
+					nil,
 				),
 			},
 			expected: model.Field{
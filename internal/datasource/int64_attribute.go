@@ -22,16 +22,18 @@ type GeneratorInt64Attribute struct {
 	Description              convert.Description
 	Sensitive                convert.Sensitive
 	Validators               convert.Validators
+
+	options *schema.Options
 }
 
-func NewGeneratorInt64Attribute(name string, a *datasource.Int64Attribute) (GeneratorInt64Attribute, error) {
+func NewGeneratorInt64Attribute(name string, a *datasource.Int64Attribute, opts *schema.Options) (GeneratorInt64Attribute, error) {
 	if a == nil {
 		return GeneratorInt64Attribute{}, fmt.Errorf("*datasource.Int64Attribute is nil")
 	}
 
 	c := convert.NewComputedOptionalRequired(a.ComputedOptionalRequired)
 
-	ctp := convert.NewCustomTypePrimitive(a.CustomType, a.AssociatedExternalType, name)
+	ctp := convert.NewCustomTypePrimitive(a.CustomType, a.AssociatedExternalType, name, opts)
 
 	d := convert.NewDescription(a.Description)
 
@@ -42,13 +44,14 @@ func NewGeneratorInt64Attribute(name string, a *datasource.Int64Attribute) (Gene
 	v := convert.NewValidators(convert.ValidatorTypeInt64, a.Validators.CustomValidators())
 
 	return GeneratorInt64Attribute{
-		AssociatedExternalType:   schema.NewAssocExtType(a.AssociatedExternalType),
+		AssociatedExternalType:   schema.NewAssocExtType(a.AssociatedExternalType, opts),
 		ComputedOptionalRequired: c,
 		CustomType:               ctp,
 		DeprecationMessage:       dm,
 		Description:              d,
 		Sensitive:                s,
 		Validators:               v,
+		options:                  opts,
 	}, nil
 }
 
@@ -123,7 +126,7 @@ func (g GeneratorInt64Attribute) Schema(name schema.FrameworkIdentifier) (string
 
 func (g GeneratorInt64Attribute) ModelField(name schema.FrameworkIdentifier) (model.Field, error) {
 	field := model.Field{
-		Name:      name.ToPascalCase(),
+		Name:      g.options.ToPascalCase(name),
 		TfsdkName: name.ToString(),
 		ValueType: model.Int64ValueType,
 	}
@@ -144,7 +147,7 @@ func (g GeneratorInt64Attribute) CustomTypeAndValue(name string) ([]byte, error)
 
 	var buf bytes.Buffer
 
-	int64Type := schema.NewCustomInt64Type(name)
+	int64Type := schema.NewCustomInt64Type(name, g.options)
 
 	b, err := int64Type.Render()
 
@@ -154,7 +157,7 @@ func (g GeneratorInt64Attribute) CustomTypeAndValue(name string) ([]byte, error)
 
 	buf.Write(b)
 
-	int64Value := schema.NewCustomInt64Value(name)
+	int64Value := schema.NewCustomInt64Value(name, g.options)
 
 	b, err = int64Value.Render()
 
@@ -167,12 +170,16 @@ func (g GeneratorInt64Attribute) CustomTypeAndValue(name string) ([]byte, error)
 	return buf.Bytes(), nil
 }
 
+func (g GeneratorInt64Attribute) GetAssociatedExternalType() *schema.AssocExtType {
+	return g.AssociatedExternalType
+}
+
 func (g GeneratorInt64Attribute) ToFromFunctions(name string) ([]byte, error) {
 	if g.AssociatedExternalType == nil {
 		return nil, nil
 	}
 
-	toFrom := schema.NewToFromInt64(name, g.AssociatedExternalType)
+	toFrom := schema.NewToFromInt64(name, g.AssociatedExternalType, g.options)
 
 	b, err := toFrom.Render()
 
@@ -186,7 +193,7 @@ func (g GeneratorInt64Attribute) ToFromFunctions(name string) ([]byte, error) {
 // AttrType returns a string representation of a basetypes.Int64Typable type.
 func (g GeneratorInt64Attribute) AttrType(name schema.FrameworkIdentifier) (string, error) {
 	if g.AssociatedExternalType != nil {
-		return fmt.Sprintf("%sType{}", name.ToPascalCase()), nil
+		return fmt.Sprintf("%sType{}", g.options.ToPascalCase(name)), nil
 	}
 
 	return "basetypes.Int64Type{}", nil
@@ -195,7 +202,7 @@ func (g GeneratorInt64Attribute) AttrType(name schema.FrameworkIdentifier) (stri
 // AttrValue returns a string representation of a basetypes.Int64Valuable type.
 func (g GeneratorInt64Attribute) AttrValue(name schema.FrameworkIdentifier) string {
 	if g.AssociatedExternalType != nil {
-		return fmt.Sprintf("%sValue", name.ToPascalCase())
+		return fmt.Sprintf("%sValue", g.options.ToPascalCase(name))
 	}
 
 	return "basetypes.Int64Value"
@@ -224,3 +231,14 @@ func (g GeneratorInt64Attribute) From() (schema.ToFromConversion, error) {
 		Default: "Int64PointerValue",
 	}, nil
 }
+
+func (g GeneratorInt64Attribute) Properties() schema.FieldProperties {
+	return schema.FieldProperties{
+		Computed:           g.ComputedOptionalRequired.IsComputed(),
+		Optional:           g.ComputedOptionalRequired.IsOptional(),
+		Required:           g.ComputedOptionalRequired.IsRequired(),
+		Sensitive:          g.Sensitive.IsSensitive(),
+		Description:        g.Description.Description(),
+		DeprecationMessage: g.DeprecationMessage.DeprecationMessage(),
+	}
+}
@@ -33,7 +33,7 @@ func TestGeneratorInt64Attribute_New(t *testing.T) {
 			},
 			expected: GeneratorInt64Attribute{
 				ComputedOptionalRequired: convert.NewComputedOptionalRequired(specschema.Computed),
-				CustomType:               convert.NewCustomTypePrimitive(nil, nil, "name"),
+				CustomType:               convert.NewCustomTypePrimitive(nil, nil, "name", nil),
 				Validators:               convert.NewValidators(convert.ValidatorTypeInt64, specschema.CustomValidators{}),
 			},
 		},
@@ -43,7 +43,7 @@ func TestGeneratorInt64Attribute_New(t *testing.T) {
 			},
 			expected: GeneratorInt64Attribute{
 				ComputedOptionalRequired: convert.NewComputedOptionalRequired(specschema.ComputedOptional),
-				CustomType:               convert.NewCustomTypePrimitive(nil, nil, "name"),
+				CustomType:               convert.NewCustomTypePrimitive(nil, nil, "name", nil),
 				Validators:               convert.NewValidators(convert.ValidatorTypeInt64, specschema.CustomValidators{}),
 			},
 		},
@@ -53,7 +53,7 @@ func TestGeneratorInt64Attribute_New(t *testing.T) {
 			},
 			expected: GeneratorInt64Attribute{
 				ComputedOptionalRequired: convert.NewComputedOptionalRequired(specschema.Optional),
-				CustomType:               convert.NewCustomTypePrimitive(nil, nil, "name"),
+				CustomType:               convert.NewCustomTypePrimitive(nil, nil, "name", nil),
 				Validators:               convert.NewValidators(convert.ValidatorTypeInt64, specschema.CustomValidators{}),
 			},
 		},
@@ -63,7 +63,7 @@ func TestGeneratorInt64Attribute_New(t *testing.T) {
 			},
 			expected: GeneratorInt64Attribute{
 				ComputedOptionalRequired: convert.NewComputedOptionalRequired(specschema.Required),
-				CustomType:               convert.NewCustomTypePrimitive(nil, nil, "name"),
+				CustomType:               convert.NewCustomTypePrimitive(nil, nil, "name", nil),
 				Validators:               convert.NewValidators(convert.ValidatorTypeInt64, specschema.CustomValidators{}),
 			},
 		},
@@ -84,7 +84,7 @@ func TestGeneratorInt64Attribute_New(t *testing.T) {
 					},
 					Type:      "my_type",
 					ValueType: "myvalue_type",
-				}, nil, "name"),
+				}, nil, "name", nil),
 				Validators: convert.NewValidators(convert.ValidatorTypeInt64, nil),
 			},
 		},
@@ -93,7 +93,7 @@ func TestGeneratorInt64Attribute_New(t *testing.T) {
 				DeprecationMessage: pointer("deprecation message"),
 			},
 			expected: GeneratorInt64Attribute{
-				CustomType:         convert.NewCustomTypePrimitive(nil, nil, "name"),
+				CustomType:         convert.NewCustomTypePrimitive(nil, nil, "name", nil),
 				DeprecationMessage: convert.NewDeprecationMessage(pointer("deprecation message")),
 				Validators:         convert.NewValidators(convert.ValidatorTypeInt64, specschema.CustomValidators{}),
 			},
@@ -103,7 +103,7 @@ func TestGeneratorInt64Attribute_New(t *testing.T) {
 				Description: pointer("description"),
 			},
 			expected: GeneratorInt64Attribute{
-				CustomType:  convert.NewCustomTypePrimitive(nil, nil, "name"),
+				CustomType:  convert.NewCustomTypePrimitive(nil, nil, "name", nil),
 				Description: convert.NewDescription(pointer("description")),
 				Validators:  convert.NewValidators(convert.ValidatorTypeInt64, specschema.CustomValidators{}),
 			},
@@ -113,7 +113,7 @@ func TestGeneratorInt64Attribute_New(t *testing.T) {
 				Sensitive: pointer(true),
 			},
 			expected: GeneratorInt64Attribute{
-				CustomType: convert.NewCustomTypePrimitive(nil, nil, "name"),
+				CustomType: convert.NewCustomTypePrimitive(nil, nil, "name", nil),
 				Sensitive:  convert.NewSensitive(pointer(true)),
 				Validators: convert.NewValidators(convert.ValidatorTypeInt64, specschema.CustomValidators{}),
 			},
@@ -134,7 +134,7 @@ func TestGeneratorInt64Attribute_New(t *testing.T) {
 				},
 			},
 			expected: GeneratorInt64Attribute{
-				CustomType: convert.NewCustomTypePrimitive(nil, nil, "name"),
+				CustomType: convert.NewCustomTypePrimitive(nil, nil, "name", nil),
 				Validators: convert.NewValidators(convert.ValidatorTypeInt64, specschema.CustomValidators{
 					&specschema.CustomValidator{
 						Imports: []code.Import{
@@ -154,7 +154,7 @@ func TestGeneratorInt64Attribute_New(t *testing.T) {
 		t.Run(name, func(t *testing.T) {
 			t.Parallel()
 
-			got, err := NewGeneratorInt64Attribute("name", testCase.input)
+			got, err := NewGeneratorInt64Attribute("name", testCase.input, nil)
 
 			if diff := cmp.Diff(err, testCase.expectedError, equateErrorMessage); diff != "" {
 				t.Errorf("unexpected error: %s", diff)
@@ -183,6 +183,7 @@ func TestGeneratorInt64Attribute_Schema(t *testing.T) {
 					},
 					nil,
 					"int64_attribute",
+					nil,
 				),
 			},
 			expected: `"int64_attribute": schema.Int64Attribute{
@@ -198,6 +199,7 @@ CustomType: my_custom_type,
 						Type: "*api.ExtInt64",
 					},
 					"int64_attribute",
+					nil,
 				),
 			},
 			expected: `"int64_attribute": schema.Int64Attribute{
@@ -215,6 +217,7 @@ CustomType: Int64AttributeType{},
 						Type: "*api.ExtInt64",
 					},
 					"int64_attribute",
+					nil,
 				),
 			},
 			expected: `"int64_attribute": schema.Int64Attribute{
@@ -346,6 +349,7 @@ func TestGeneratorInt64Attribute_ModelField(t *testing.T) {
 					},
 					nil,
 					"",
+					nil,
 				),
 			},
 			expected: model.Field{
@@ -362,6 +366,7 @@ func TestGeneratorInt64Attribute_ModelField(t *testing.T) {
 						Type: "*api.Int64Attribute",
 					},
 					"int64_attribute",
+					nil,
 				),
 			},
 			expected: model.Field{
@@ -380,6 +385,7 @@ func TestGeneratorInt64Attribute_ModelField(t *testing.T) {
 						Type: "*api.Int64Attribute",
 					},
 					"",
+					nil,
 				),
 			},
 			expected: model.Field{
@@ -25,9 +25,11 @@ type GeneratorListAttribute struct {
 	ElementTypeCollection    convert.ElementType
 	Sensitive                convert.Sensitive
 	Validators               convert.Validators
+
+	options *generatorschema.Options
 }
 
-func NewGeneratorListAttribute(name string, a *datasource.ListAttribute) (GeneratorListAttribute, error) {
+func NewGeneratorListAttribute(name string, a *datasource.ListAttribute, opts *generatorschema.Options) (GeneratorListAttribute, error) {
 	if a == nil {
 		return GeneratorListAttribute{}, fmt.Errorf("*datasource.ListAttribute is nil")
 	}
@@ -36,7 +38,7 @@ func NewGeneratorListAttribute(name string, a *datasource.ListAttribute) (Genera
 
 	c := convert.NewComputedOptionalRequired(a.ComputedOptionalRequired)
 
-	ctc := convert.NewCustomTypeCollection(a.CustomType, a.AssociatedExternalType, convert.CustomCollectionTypeList, string(et.ElementType()), name)
+	ctc := convert.NewCustomTypeCollection(a.CustomType, a.AssociatedExternalType, convert.CustomCollectionTypeList, string(et.ElementType()), name, opts)
 
 	d := convert.NewDescription(a.Description)
 
@@ -47,7 +49,7 @@ func NewGeneratorListAttribute(name string, a *datasource.ListAttribute) (Genera
 	v := convert.NewValidators(convert.ValidatorTypeList, a.Validators.CustomValidators())
 
 	return GeneratorListAttribute{
-		AssociatedExternalType:   generatorschema.NewAssocExtType(a.AssociatedExternalType),
+		AssociatedExternalType:   generatorschema.NewAssocExtType(a.AssociatedExternalType, opts),
 		ComputedOptionalRequired: c,
 		CustomType:               ctc,
 		DeprecationMessage:       dm,
@@ -56,6 +58,7 @@ func NewGeneratorListAttribute(name string, a *datasource.ListAttribute) (Genera
 		ElementTypeCollection:    et,
 		Sensitive:                s,
 		Validators:               v,
+		options:                  opts,
 	}, nil
 }
 
@@ -151,7 +154,7 @@ func (g GeneratorListAttribute) Schema(name generatorschema.FrameworkIdentifier)
 
 func (g GeneratorListAttribute) ModelField(name generatorschema.FrameworkIdentifier) (model.Field, error) {
 	field := model.Field{
-		Name:      name.ToPascalCase(),
+		Name:      g.options.ToPascalCase(name),
 		TfsdkName: name.ToString(),
 		ValueType: model.ListValueType,
 	}
@@ -172,7 +175,7 @@ func (g GeneratorListAttribute) CustomTypeAndValue(name string) ([]byte, error)
 
 	var buf bytes.Buffer
 
-	listType := generatorschema.NewCustomListType(name)
+	listType := generatorschema.NewCustomListType(name, g.options)
 
 	b, err := listType.Render()
 
@@ -184,7 +187,7 @@ func (g GeneratorListAttribute) CustomTypeAndValue(name string) ([]byte, error)
 
 	elemType := generatorschema.GetElementType(g.ElementType)
 
-	listValue := generatorschema.NewCustomListValue(name, elemType)
+	listValue := generatorschema.NewCustomListValue(name, elemType, g.options)
 
 	b, err = listValue.Render()
 
@@ -197,6 +200,10 @@ func (g GeneratorListAttribute) CustomTypeAndValue(name string) ([]byte, error)
 	return buf.Bytes(), nil
 }
 
+func (g GeneratorListAttribute) GetAssociatedExternalType() *generatorschema.AssocExtType {
+	return g.AssociatedExternalType
+}
+
 func (g GeneratorListAttribute) ToFromFunctions(name string) ([]byte, error) {
 	if g.AssociatedExternalType == nil {
 		return nil, nil
@@ -211,7 +218,7 @@ func (g GeneratorListAttribute) ToFromFunctions(name string) ([]byte, error) {
 		return nil, err
 	}
 
-	toFrom := generatorschema.NewToFromList(name, g.AssociatedExternalType, elementTypeType, elementTypeValue, elementFrom)
+	toFrom := generatorschema.NewToFromList(name, g.AssociatedExternalType, elementTypeType, elementTypeValue, elementFrom, g.options)
 
 	b, err := toFrom.Render()
 
@@ -231,7 +238,7 @@ func (g GeneratorListAttribute) AttrType(name generatorschema.FrameworkIdentifie
 	}
 
 	if g.AssociatedExternalType != nil {
-		return fmt.Sprintf("%sType{\nbasetypes.ListType{\nElemType: %s,\n}}", name.ToPascalCase(), elemType), nil
+		return fmt.Sprintf("%sType{\nbasetypes.ListType{\nElemType: %s,\n}}", g.options.ToPascalCase(name), elemType), nil
 	}
 
 	return fmt.Sprintf("basetypes.ListType{\nElemType: %s,\n}", elemType), nil
@@ -240,7 +247,7 @@ func (g GeneratorListAttribute) AttrType(name generatorschema.FrameworkIdentifie
 // AttrValue returns a string representation of a basetypes.ListValuable type.
 func (g GeneratorListAttribute) AttrValue(name generatorschema.FrameworkIdentifier) string {
 	if g.AssociatedExternalType != nil {
-		return fmt.Sprintf("%sValue", name.ToPascalCase())
+		return fmt.Sprintf("%sValue", g.options.ToPascalCase(name))
 	}
 
 	return "basetypes.ListValue"
@@ -305,3 +312,14 @@ func (g GeneratorListAttribute) CollectionType() (map[string]string, error) {
 		"TypeValueFunc": "types.ListValue",
 	}, nil
 }
+
+func (g GeneratorListAttribute) Properties() generatorschema.FieldProperties {
+	return generatorschema.FieldProperties{
+		Computed:           g.ComputedOptionalRequired.IsComputed(),
+		Optional:           g.ComputedOptionalRequired.IsOptional(),
+		Required:           g.ComputedOptionalRequired.IsRequired(),
+		Sensitive:          g.Sensitive.IsSensitive(),
+		Description:        g.Description.Description(),
+		DeprecationMessage: g.DeprecationMessage.DeprecationMessage(),
+	}
+}
@@ -41,6 +41,7 @@ func TestGeneratorListAttribute_New(t *testing.T) {
 					convert.CustomCollectionTypeList,
 					"types.BoolType",
 					"name",
+					nil,
 				),
 				ElementType: specschema.ElementType{
 					Bool: &specschema.BoolType{},
@@ -64,6 +65,7 @@ func TestGeneratorListAttribute_New(t *testing.T) {
 					convert.CustomCollectionTypeList,
 					"types.StringType",
 					"name",
+					nil,
 				),
 				ElementType: specschema.ElementType{
 					String: &specschema.StringType{},
@@ -91,6 +93,7 @@ func TestGeneratorListAttribute_New(t *testing.T) {
 					convert.CustomCollectionTypeList,
 					"types.ListType{\nElemType: types.StringType,\n}",
 					"name",
+					nil,
 				),
 				ElementType: specschema.ElementType{
 					List: &specschema.ListType{
@@ -126,6 +129,7 @@ func TestGeneratorListAttribute_New(t *testing.T) {
 					convert.CustomCollectionTypeList,
 					"types.MapType{\nElemType: types.StringType,\n}",
 					"name",
+					nil,
 				),
 				ElementType: specschema.ElementType{
 					Map: &specschema.MapType{
@@ -168,6 +172,7 @@ func TestGeneratorListAttribute_New(t *testing.T) {
 					convert.CustomCollectionTypeList,
 					"types.ListType{\nElemType: types.ObjectType{\nAttrTypes: map[string]attr.Type{\n\"str\": types.StringType,\n},\n},\n}",
 					"name",
+					nil,
 				),
 				ElementType: specschema.ElementType{
 					List: &specschema.ListType{
@@ -220,6 +225,7 @@ func TestGeneratorListAttribute_New(t *testing.T) {
 					convert.CustomCollectionTypeList,
 					"types.ObjectType{\nAttrTypes: map[string]attr.Type{\n\"str\": types.StringType,\n},\n}",
 					"name",
+					nil,
 				),
 				ElementType: specschema.ElementType{
 					Object: &specschema.ObjectType{
@@ -268,6 +274,7 @@ func TestGeneratorListAttribute_New(t *testing.T) {
 					convert.CustomCollectionTypeList,
 					"types.ObjectType{\nAttrTypes: map[string]attr.Type{\n\"list\": types.ListType{\nElemType: types.StringType,\n},\n},\n}",
 					"name",
+					nil,
 				),
 				ElementType: specschema.ElementType{
 					Object: &specschema.ObjectType{
@@ -315,6 +322,7 @@ func TestGeneratorListAttribute_New(t *testing.T) {
 					convert.CustomCollectionTypeList,
 					"types.StringType",
 					"name",
+					nil,
 				),
 				ElementType: specschema.ElementType{
 					String: &specschema.StringType{},
@@ -340,6 +348,7 @@ func TestGeneratorListAttribute_New(t *testing.T) {
 					convert.CustomCollectionTypeList,
 					"types.StringType",
 					"name",
+					nil,
 				),
 				ElementType: specschema.ElementType{
 					String: &specschema.StringType{},
@@ -365,6 +374,7 @@ func TestGeneratorListAttribute_New(t *testing.T) {
 					convert.CustomCollectionTypeList,
 					"types.StringType",
 					"name",
+					nil,
 				),
 				ElementType: specschema.ElementType{
 					String: &specschema.StringType{},
@@ -390,6 +400,7 @@ func TestGeneratorListAttribute_New(t *testing.T) {
 					convert.CustomCollectionTypeList,
 					"types.StringType",
 					"name",
+					nil,
 				),
 				ElementType: specschema.ElementType{
 					String: &specschema.StringType{},
@@ -426,6 +437,7 @@ func TestGeneratorListAttribute_New(t *testing.T) {
 					convert.CustomCollectionTypeList,
 					"types.StringType",
 					"name",
+					nil,
 				),
 				ElementType: specschema.ElementType{
 					String: &specschema.StringType{},
@@ -450,6 +462,7 @@ func TestGeneratorListAttribute_New(t *testing.T) {
 					convert.CustomCollectionTypeList,
 					"types.StringType",
 					"name",
+					nil,
 				),
 				DeprecationMessage: convert.NewDeprecationMessage(pointer("deprecation message")),
 				ElementType: specschema.ElementType{
@@ -475,6 +488,7 @@ func TestGeneratorListAttribute_New(t *testing.T) {
 					convert.CustomCollectionTypeList,
 					"types.StringType",
 					"name",
+					nil,
 				),
 				Description: convert.NewDescription(pointer("description")),
 				ElementType: specschema.ElementType{
@@ -500,6 +514,7 @@ func TestGeneratorListAttribute_New(t *testing.T) {
 					convert.CustomCollectionTypeList,
 					"types.StringType",
 					"name",
+					nil,
 				),
 				ElementType: specschema.ElementType{
 					String: &specschema.StringType{},
@@ -536,6 +551,7 @@ func TestGeneratorListAttribute_New(t *testing.T) {
 					convert.CustomCollectionTypeList,
 					"types.StringType",
 					"name",
+					nil,
 				),
 				ElementType: specschema.ElementType{
 					String: &specschema.StringType{},
@@ -562,7 +578,7 @@ func TestGeneratorListAttribute_New(t *testing.T) {
 		t.Run(name, func(t *testing.T) {
 			t.Parallel()
 
-			got, err := NewGeneratorListAttribute("name", testCase.input)
+			got, err := NewGeneratorListAttribute("name", testCase.input, nil)
 
 			if diff := cmp.Diff(err, testCase.expectedError, equateErrorMessage); diff != "" {
 				t.Errorf("unexpected error: %s", diff)
@@ -597,6 +613,7 @@ func TestGeneratorListAttribute_Imports(t *testing.T) {
 					convert.CustomCollectionTypeList,
 					"",
 					"",
+					nil,
 				),
 			},
 			expected: []code.Import{},
@@ -613,6 +630,7 @@ func TestGeneratorListAttribute_Imports(t *testing.T) {
 					convert.CustomCollectionTypeList,
 					"",
 					"",
+					nil,
 				),
 			},
 			expected: []code.Import{},
@@ -629,6 +647,7 @@ func TestGeneratorListAttribute_Imports(t *testing.T) {
 					convert.CustomCollectionTypeList,
 					"",
 					"",
+					nil,
 				),
 			},
 			expected: []code.Import{
@@ -971,6 +990,7 @@ func TestGeneratorListAttribute_Imports(t *testing.T) {
 					convert.CustomCollectionTypeList,
 					"",
 					"",
+					nil,
 				),
 			},
 			expected: []code.Import{
@@ -1272,6 +1292,7 @@ ElementType: types.StringType,
 					convert.CustomCollectionTypeList,
 					"",
 					"",
+					nil,
 				),
 				ElementTypeCollection: convert.NewElementType(specschema.ElementType{
 					String: &specschema.StringType{},
@@ -1290,6 +1311,7 @@ CustomType: my_custom_type,
 					convert.CustomCollectionTypeList,
 					"types.StringType",
 					"list_attribute",
+					nil,
 				),
 				ElementTypeCollection: convert.NewElementType(specschema.ElementType{
 					String: &specschema.StringType{},
@@ -1312,6 +1334,7 @@ ElemType: types.StringType,
 					convert.CustomCollectionTypeList,
 					"types.StringType",
 					"name",
+					nil,
 				),
 			},
 			expected: `"list_attribute": schema.ListAttribute{
@@ -1633,6 +1656,7 @@ func TestGeneratorListAttribute_ModelField(t *testing.T) {
 					convert.CustomCollectionTypeList,
 					"",
 					"",
+					nil,
 				),
 			},
 			expected: model.Field{
@@ -1651,6 +1675,7 @@ func TestGeneratorListAttribute_ModelField(t *testing.T) {
 					convert.CustomCollectionTypeList,
 					"",
 					"list_attribute",
+					nil,
 				),
 			},
 			expected: model.Field{
@@ -1671,6 +1696,7 @@ func TestGeneratorListAttribute_ModelField(t *testing.T) {
 					convert.CustomCollectionTypeList,
 					"",
 					"list_attribute",
+					nil,
 				),
 			},
 			expected: model.Field{
@@ -20,7 +20,7 @@ import (
 func TestGeneratorListNestedAttribute_New(t *testing.T) {
 	t.Parallel()
 
-	attributes, err := NewAttributes(datasource.Attributes{})
+	attributes, err := NewAttributes(datasource.Attributes{}, "", nil)
 
 	if err != nil {
 		t.Error(err)
@@ -66,7 +66,7 @@ func TestGeneratorListNestedAttribute_New(t *testing.T) {
 					Attributes: generatorschema.GeneratorAttributes{
 						"bool_attribute": GeneratorBoolAttribute{
 							ComputedOptionalRequired: convert.NewComputedOptionalRequired(specschema.Optional),
-							CustomType:               convert.NewCustomTypePrimitive(nil, nil, "bool_attribute"),
+							CustomType:               convert.NewCustomTypePrimitive(nil, nil, "bool_attribute", nil),
 							Validators:               convert.NewValidators(convert.ValidatorTypeBool, specschema.CustomValidators{}),
 						},
 					},
@@ -75,13 +75,14 @@ func TestGeneratorListNestedAttribute_New(t *testing.T) {
 					generatorschema.GeneratorAttributes{
 						"bool_attribute": GeneratorBoolAttribute{
 							ComputedOptionalRequired: convert.NewComputedOptionalRequired(specschema.Optional),
-							CustomType:               convert.NewCustomTypePrimitive(nil, nil, "bool_attribute"),
+							CustomType:               convert.NewCustomTypePrimitive(nil, nil, "bool_attribute", nil),
 							Validators:               convert.NewValidators(convert.ValidatorTypeBool, specschema.CustomValidators{}),
 						},
 					},
 					nil,
 					convert.NewValidators(convert.ValidatorTypeObject, specschema.CustomValidators{}),
 					"name",
+					nil,
 				),
 				Validators: convert.NewValidators(convert.ValidatorTypeList, specschema.CustomValidators{}),
 			},
@@ -113,6 +114,7 @@ func TestGeneratorListNestedAttribute_New(t *testing.T) {
 								convert.CustomCollectionTypeList,
 								"types.BoolType",
 								"list_attribute",
+								nil,
 							),
 							ElementType: specschema.ElementType{
 								Bool: &specschema.BoolType{},
@@ -134,6 +136,7 @@ func TestGeneratorListNestedAttribute_New(t *testing.T) {
 								convert.CustomCollectionTypeList,
 								"types.BoolType",
 								"list_attribute",
+								nil,
 							),
 							ElementType: specschema.ElementType{
 								Bool: &specschema.BoolType{},
@@ -147,6 +150,7 @@ func TestGeneratorListNestedAttribute_New(t *testing.T) {
 					nil,
 					convert.NewValidators(convert.ValidatorTypeObject, specschema.CustomValidators{}),
 					"name",
+					nil,
 				),
 				Validators: convert.NewValidators(convert.ValidatorTypeList, specschema.CustomValidators{}),
 			},
@@ -182,7 +186,7 @@ func TestGeneratorListNestedAttribute_New(t *testing.T) {
 								Attributes: generatorschema.GeneratorAttributes{
 									"nested_bool": GeneratorBoolAttribute{
 										ComputedOptionalRequired: convert.NewComputedOptionalRequired(specschema.Computed),
-										CustomType:               convert.NewCustomTypePrimitive(nil, nil, "nested_bool"),
+										CustomType:               convert.NewCustomTypePrimitive(nil, nil, "nested_bool", nil),
 										Validators:               convert.NewValidators(convert.ValidatorTypeBool, specschema.CustomValidators{}),
 									},
 								},
@@ -192,13 +196,14 @@ func TestGeneratorListNestedAttribute_New(t *testing.T) {
 								generatorschema.GeneratorAttributes{
 									"nested_bool": GeneratorBoolAttribute{
 										ComputedOptionalRequired: convert.NewComputedOptionalRequired(specschema.Computed),
-										CustomType:               convert.NewCustomTypePrimitive(nil, nil, "nested_bool"),
+										CustomType:               convert.NewCustomTypePrimitive(nil, nil, "nested_bool", nil),
 										Validators:               convert.NewValidators(convert.ValidatorTypeBool, specschema.CustomValidators{}),
 									},
 								},
 								nil,
 								convert.NewValidators(convert.ValidatorTypeObject, specschema.CustomValidators{}),
 								"nested_attribute",
+								nil,
 							),
 							Validators: convert.NewValidators(convert.ValidatorTypeList, specschema.CustomValidators{}),
 						},
@@ -211,7 +216,7 @@ func TestGeneratorListNestedAttribute_New(t *testing.T) {
 								Attributes: generatorschema.GeneratorAttributes{
 									"nested_bool": GeneratorBoolAttribute{
 										ComputedOptionalRequired: convert.NewComputedOptionalRequired(specschema.Computed),
-										CustomType:               convert.NewCustomTypePrimitive(nil, nil, "nested_bool"),
+										CustomType:               convert.NewCustomTypePrimitive(nil, nil, "nested_bool", nil),
 										Validators:               convert.NewValidators(convert.ValidatorTypeBool, specschema.CustomValidators{}),
 									},
 								},
@@ -221,13 +226,14 @@ func TestGeneratorListNestedAttribute_New(t *testing.T) {
 								generatorschema.GeneratorAttributes{
 									"nested_bool": GeneratorBoolAttribute{
 										ComputedOptionalRequired: convert.NewComputedOptionalRequired(specschema.Computed),
-										CustomType:               convert.NewCustomTypePrimitive(nil, nil, "nested_bool"),
+										CustomType:               convert.NewCustomTypePrimitive(nil, nil, "nested_bool", nil),
 										Validators:               convert.NewValidators(convert.ValidatorTypeBool, specschema.CustomValidators{}),
 									},
 								},
 								nil,
 								convert.NewValidators(convert.ValidatorTypeObject, specschema.CustomValidators{}),
 								"nested_attribute",
+								nil,
 							),
 							Validators: convert.NewValidators(convert.ValidatorTypeList, specschema.CustomValidators{}),
 						},
@@ -235,6 +241,7 @@ func TestGeneratorListNestedAttribute_New(t *testing.T) {
 					nil,
 					convert.NewValidators(convert.ValidatorTypeObject, specschema.CustomValidators{}),
 					"name",
+					nil,
 				),
 				Validators: convert.NewValidators(convert.ValidatorTypeList, specschema.CustomValidators{}),
 			},
@@ -275,7 +282,7 @@ func TestGeneratorListNestedAttribute_New(t *testing.T) {
 								},
 							}),
 							ComputedOptionalRequired: convert.NewComputedOptionalRequired(specschema.Optional),
-							CustomType:               convert.NewCustomTypeObject(nil, nil, "object_attribute"),
+							CustomType:               convert.NewCustomTypeObject(nil, nil, "object_attribute", nil),
 							Validators:               convert.NewValidators(convert.ValidatorTypeObject, specschema.CustomValidators{}),
 						},
 					},
@@ -296,13 +303,14 @@ func TestGeneratorListNestedAttribute_New(t *testing.T) {
 								},
 							}),
 							ComputedOptionalRequired: convert.NewComputedOptionalRequired(specschema.Optional),
-							CustomType:               convert.NewCustomTypeObject(nil, nil, "object_attribute"),
+							CustomType:               convert.NewCustomTypeObject(nil, nil, "object_attribute", nil),
 							Validators:               convert.NewValidators(convert.ValidatorTypeObject, specschema.CustomValidators{}),
 						},
 					},
 					nil,
 					convert.NewValidators(convert.ValidatorTypeObject, specschema.CustomValidators{}),
 					"name",
+					nil,
 				),
 				Validators: convert.NewValidators(convert.ValidatorTypeList, specschema.CustomValidators{}),
 			},
@@ -335,12 +343,12 @@ func TestGeneratorListNestedAttribute_New(t *testing.T) {
 							Attributes: generatorschema.GeneratorAttributes{
 								"nested_bool": GeneratorBoolAttribute{
 									ComputedOptionalRequired: convert.NewComputedOptionalRequired(specschema.Computed),
-									CustomType:               convert.NewCustomTypePrimitive(nil, nil, "nested_bool"),
+									CustomType:               convert.NewCustomTypePrimitive(nil, nil, "nested_bool", nil),
 									Validators:               convert.NewValidators(convert.ValidatorTypeBool, specschema.CustomValidators{}),
 								},
 							},
 							ComputedOptionalRequired: convert.NewComputedOptionalRequired(specschema.Optional),
-							CustomType:               convert.NewCustomTypeNestedObject(nil, "nested_attribute"),
+							CustomType:               convert.NewCustomTypeNestedObject(nil, "nested_attribute", nil),
 							Validators:               convert.NewValidators(convert.ValidatorTypeObject, specschema.CustomValidators{}),
 						},
 					},
@@ -351,18 +359,19 @@ func TestGeneratorListNestedAttribute_New(t *testing.T) {
 							Attributes: generatorschema.GeneratorAttributes{
 								"nested_bool": GeneratorBoolAttribute{
 									ComputedOptionalRequired: convert.NewComputedOptionalRequired(specschema.Computed),
-									CustomType:               convert.NewCustomTypePrimitive(nil, nil, "nested_bool"),
+									CustomType:               convert.NewCustomTypePrimitive(nil, nil, "nested_bool", nil),
 									Validators:               convert.NewValidators(convert.ValidatorTypeBool, specschema.CustomValidators{}),
 								},
 							},
 							ComputedOptionalRequired: convert.NewComputedOptionalRequired(specschema.Optional),
-							CustomType:               convert.NewCustomTypeNestedObject(nil, "nested_attribute"),
+							CustomType:               convert.NewCustomTypeNestedObject(nil, "nested_attribute", nil),
 							Validators:               convert.NewValidators(convert.ValidatorTypeObject, specschema.CustomValidators{}),
 						},
 					},
 					nil,
 					convert.NewValidators(convert.ValidatorTypeObject, specschema.CustomValidators{}),
 					"name",
+					nil,
 				),
 				Validators: convert.NewValidators(convert.ValidatorTypeList, specschema.CustomValidators{}),
 			},
@@ -381,6 +390,7 @@ func TestGeneratorListNestedAttribute_New(t *testing.T) {
 					nil,
 					convert.NewValidators(convert.ValidatorTypeObject, specschema.CustomValidators{}),
 					"name",
+					nil,
 				),
 				Validators: convert.NewValidators(convert.ValidatorTypeList, specschema.CustomValidators{}),
 			},
@@ -399,6 +409,7 @@ func TestGeneratorListNestedAttribute_New(t *testing.T) {
 					nil,
 					convert.NewValidators(convert.ValidatorTypeObject, specschema.CustomValidators{}),
 					"name",
+					nil,
 				),
 				Validators: convert.NewValidators(convert.ValidatorTypeList, specschema.CustomValidators{}),
 			},
@@ -417,6 +428,7 @@ func TestGeneratorListNestedAttribute_New(t *testing.T) {
 					nil,
 					convert.NewValidators(convert.ValidatorTypeObject, specschema.CustomValidators{}),
 					"name",
+					nil,
 				),
 				Validators: convert.NewValidators(convert.ValidatorTypeList, specschema.CustomValidators{})},
 		},
@@ -434,6 +446,7 @@ func TestGeneratorListNestedAttribute_New(t *testing.T) {
 					nil,
 					convert.NewValidators(convert.ValidatorTypeObject, specschema.CustomValidators{}),
 					"name",
+					nil,
 				),
 				Validators: convert.NewValidators(convert.ValidatorTypeList, specschema.CustomValidators{}),
 			},
@@ -464,6 +477,7 @@ func TestGeneratorListNestedAttribute_New(t *testing.T) {
 					nil,
 					convert.NewValidators(convert.ValidatorTypeObject, nil),
 					"name",
+					nil,
 				),
 				Validators: convert.NewValidators(convert.ValidatorTypeList, nil),
 			},
@@ -482,6 +496,7 @@ func TestGeneratorListNestedAttribute_New(t *testing.T) {
 					nil,
 					convert.NewValidators(convert.ValidatorTypeObject, nil),
 					"name",
+					nil,
 				),
 				Validators: convert.NewValidators(convert.ValidatorTypeList, nil),
 			},
@@ -500,6 +515,7 @@ func TestGeneratorListNestedAttribute_New(t *testing.T) {
 					nil,
 					convert.NewValidators(convert.ValidatorTypeObject, nil),
 					"name",
+					nil,
 				),
 				Validators: convert.NewValidators(convert.ValidatorTypeList, nil),
 			},
@@ -517,6 +533,7 @@ func TestGeneratorListNestedAttribute_New(t *testing.T) {
 					nil,
 					convert.NewValidators(convert.ValidatorTypeObject, nil),
 					"name",
+					nil,
 				),
 				Sensitive:  convert.NewSensitive(pointer(true)),
 				Validators: convert.NewValidators(convert.ValidatorTypeList, nil),
@@ -546,6 +563,7 @@ func TestGeneratorListNestedAttribute_New(t *testing.T) {
 					nil,
 					convert.NewValidators(convert.ValidatorTypeObject, nil),
 					"name",
+					nil,
 				),
 				Validators: convert.NewValidators(convert.ValidatorTypeList, specschema.CustomValidators{
 					&specschema.CustomValidator{
@@ -566,7 +584,7 @@ func TestGeneratorListNestedAttribute_New(t *testing.T) {
 		t.Run(name, func(t *testing.T) {
 			t.Parallel()
 
-			got, err := NewGeneratorListNestedAttribute("name", testCase.input)
+			got, err := NewGeneratorListNestedAttribute("name", testCase.input, nil)
 
 			if diff := cmp.Diff(err, testCase.expectedError, equateErrorMessage); diff != "" {
 				t.Errorf("unexpected error: %s", diff)
@@ -634,6 +652,7 @@ func TestGeneratorListNestedAttribute_Imports(t *testing.T) {
 					&specschema.CustomType{},
 					convert.NewValidators(convert.ValidatorTypeObject, nil),
 					"",
+					nil,
 				),
 			},
 			expected: []code.Import{
@@ -696,6 +715,7 @@ func TestGeneratorListNestedAttribute_Imports(t *testing.T) {
 					},
 					convert.NewValidators(convert.ValidatorTypeObject, nil),
 					"",
+					nil,
 				),
 			},
 			expected: []code.Import{
@@ -737,6 +757,7 @@ func TestGeneratorListNestedAttribute_Imports(t *testing.T) {
 					},
 					convert.NewValidators(convert.ValidatorTypeObject, nil),
 					"",
+					nil,
 				),
 			},
 			expected: []code.Import{
@@ -769,6 +790,7 @@ func TestGeneratorListNestedAttribute_Imports(t *testing.T) {
 					},
 					convert.NewValidators(convert.ValidatorTypeObject, nil),
 					"",
+					nil,
 				),
 			},
 			expected: []code.Import{
@@ -817,12 +839,14 @@ func TestGeneratorListNestedAttribute_Imports(t *testing.T) {
 								convert.CustomCollectionTypeList,
 								"",
 								"",
+								nil,
 							),
 						},
 					},
 					nil,
 					convert.NewValidators(convert.ValidatorTypeObject, nil),
 					"",
+					nil,
 				),
 			},
 			expected: []code.Import{
@@ -852,6 +876,7 @@ func TestGeneratorListNestedAttribute_Imports(t *testing.T) {
 								convert.CustomCollectionTypeList,
 								"",
 								"",
+								nil,
 							),
 							ElementTypeCollection: convert.NewElementType(specschema.ElementType{
 								Bool: &specschema.BoolType{
@@ -867,6 +892,7 @@ func TestGeneratorListNestedAttribute_Imports(t *testing.T) {
 					nil,
 					convert.NewValidators(convert.ValidatorTypeObject, nil),
 					"",
+					nil,
 				),
 			},
 			expected: []code.Import{
@@ -921,12 +947,14 @@ func TestGeneratorListNestedAttribute_Imports(t *testing.T) {
 								},
 								nil,
 								"",
+								nil,
 							),
 						},
 					},
 					nil,
 					convert.NewValidators(convert.ValidatorTypeObject, nil),
 					"",
+					nil,
 				),
 			},
 			expected: []code.Import{
@@ -966,12 +994,14 @@ func TestGeneratorListNestedAttribute_Imports(t *testing.T) {
 								},
 								nil,
 								"",
+								nil,
 							),
 						},
 					},
 					nil,
 					convert.NewValidators(convert.ValidatorTypeObject, nil),
 					"",
+					nil,
 				),
 			},
 			expected: []code.Import{
@@ -1160,6 +1190,7 @@ func TestGeneratorListNestedAttribute_Imports(t *testing.T) {
 						},
 					}),
 					"",
+					nil,
 				),
 			},
 			expected: []code.Import{
@@ -1217,6 +1248,7 @@ func TestGeneratorListNestedAttribute_Schema(t *testing.T) {
 					nil,
 					convert.NewValidators(convert.ValidatorTypeObject, nil),
 					attributeName,
+					nil,
 				),
 			},
 			expected: `"list_nested_attribute": schema.ListNestedAttribute{
@@ -1249,6 +1281,7 @@ AttrTypes: ListNestedAttributeValue{}.AttributeTypes(ctx),
 					nil,
 					convert.NewValidators(convert.ValidatorTypeObject, nil),
 					attributeName,
+					nil,
 				),
 			},
 			expected: `"list_nested_attribute": schema.ListNestedAttribute{
@@ -1282,12 +1315,14 @@ AttrTypes: ListNestedAttributeValue{}.AttributeTypes(ctx),
 								nil,
 								convert.NewValidators(convert.ValidatorTypeObject, nil),
 								"nested_list_nested",
+								nil,
 							),
 						},
 					},
 					nil,
 					convert.NewValidators(convert.ValidatorTypeObject, nil),
 					attributeName,
+					nil,
 				),
 			},
 			expected: `"list_nested_attribute": schema.ListNestedAttribute{
@@ -1334,6 +1369,7 @@ AttrTypes: ListNestedAttributeValue{}.AttributeTypes(ctx),
 					nil,
 					convert.NewValidators(convert.ValidatorTypeObject, nil),
 					attributeName,
+					nil,
 				),
 			},
 			expected: `"list_nested_attribute": schema.ListNestedAttribute{
@@ -1365,12 +1401,13 @@ AttrTypes: ListNestedAttributeValue{}.AttributeTypes(ctx),
 									ComputedOptionalRequired: convert.NewComputedOptionalRequired(specschema.Optional),
 								},
 							},
-							CustomType: convert.NewCustomTypeNestedObject(nil, "nested_single_nested"),
+							CustomType: convert.NewCustomTypeNestedObject(nil, "nested_single_nested", nil),
 						},
 					},
 					nil,
 					convert.NewValidators(convert.ValidatorTypeObject, nil),
 					attributeName,
+					nil,
 				),
 			},
 			expected: `"list_nested_attribute": schema.ListNestedAttribute{
@@ -1408,6 +1445,7 @@ AttrTypes: ListNestedAttributeValue{}.AttributeTypes(ctx),
 					nil,
 					convert.NewValidators(convert.ValidatorTypeObject, nil),
 					attributeName,
+					nil,
 				),
 			},
 			expected: `"list_nested_attribute": schema.ListNestedAttribute{
@@ -1432,6 +1470,7 @@ CustomType: my_custom_type,
 					nil,
 					convert.Validators{},
 					"list_nested_attribute",
+					nil,
 				),
 			},
 			expected: `"list_nested_attribute": schema.ListNestedAttribute{
@@ -1456,6 +1495,7 @@ Required: true,
 					nil,
 					convert.Validators{},
 					"list_nested_attribute",
+					nil,
 				),
 			},
 			expected: `"list_nested_attribute": schema.ListNestedAttribute{
@@ -1480,6 +1520,7 @@ Optional: true,
 					nil,
 					convert.Validators{},
 					"list_nested_attribute",
+					nil,
 				),
 			},
 			expected: `"list_nested_attribute": schema.ListNestedAttribute{
@@ -1503,6 +1544,7 @@ Computed: true,
 					nil,
 					convert.Validators{},
 					"list_nested_attribute",
+					nil,
 				),
 				Sensitive: convert.NewSensitive(pointer(true)),
 			},
@@ -1528,6 +1570,7 @@ Sensitive: true,
 					nil,
 					convert.Validators{},
 					"list_nested_attribute",
+					nil,
 				),
 			},
 			expected: `"list_nested_attribute": schema.ListNestedAttribute{
@@ -1553,6 +1596,7 @@ MarkdownDescription: "description",
 					nil,
 					convert.Validators{},
 					"list_nested_attribute",
+					nil,
 				),
 			},
 			expected: `"list_nested_attribute": schema.ListNestedAttribute{
@@ -1576,6 +1620,7 @@ DeprecationMessage: "deprecated",
 					nil,
 					convert.NewValidators(convert.ValidatorTypeObject, nil),
 					attributeName,
+					nil,
 				),
 				Validators: convert.NewValidators(convert.ValidatorTypeList, specschema.CustomValidators{
 					&specschema.CustomValidator{
@@ -1612,6 +1657,7 @@ my_other_validator.Validate(),
 					},
 					convert.NewValidators(convert.ValidatorTypeObject, nil),
 					attributeName,
+					nil,
 				),
 			},
 			expected: `"list_nested_attribute": schema.ListNestedAttribute{
@@ -1637,6 +1683,7 @@ CustomType: my_custom_type,
 						},
 					}),
 					attributeName,
+					nil,
 				),
 			},
 			expected: `"list_nested_attribute": schema.ListNestedAttribute{
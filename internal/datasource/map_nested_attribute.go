@@ -24,14 +24,16 @@ type GeneratorMapNestedAttribute struct {
 	NestedAttributeObject    convert.NestedAttributeObject
 	Sensitive                convert.Sensitive
 	Validators               convert.Validators
+
+	options *schema.Options
 }
 
-func NewGeneratorMapNestedAttribute(name string, a *datasource.MapNestedAttribute) (GeneratorMapNestedAttribute, error) {
+func NewGeneratorMapNestedAttribute(name string, a *datasource.MapNestedAttribute, opts *schema.Options) (GeneratorMapNestedAttribute, error) {
 	if a == nil {
 		return GeneratorMapNestedAttribute{}, fmt.Errorf("*datasource.MapNestedAttribute is nil")
 	}
 
-	attributes, err := NewAttributes(a.NestedObject.Attributes)
+	attributes, err := NewAttributes(a.NestedObject.Attributes, name, opts)
 
 	if err != nil {
 		return GeneratorMapNestedAttribute{}, err
@@ -47,7 +49,7 @@ func NewGeneratorMapNestedAttribute(name string, a *datasource.MapNestedAttribut
 
 	vo := convert.NewValidators(convert.ValidatorTypeObject, a.NestedObject.Validators.CustomValidators())
 
-	nat := convert.NewNestedAttributeObject(attributes, a.NestedObject.CustomType, vo, name)
+	nat := convert.NewNestedAttributeObject(attributes, a.NestedObject.CustomType, vo, name, opts)
 
 	s := convert.NewSensitive(a.Sensitive)
 
@@ -59,7 +61,7 @@ func NewGeneratorMapNestedAttribute(name string, a *datasource.MapNestedAttribut
 		DeprecationMessage:       dm,
 		Description:              d,
 		NestedObject: GeneratorNestedAttributeObject{
-			AssociatedExternalType: schema.NewAssocExtType(a.NestedObject.AssociatedExternalType),
+			AssociatedExternalType: schema.NewAssocExtType(a.NestedObject.AssociatedExternalType, opts),
 			Attributes:             attributes,
 			CustomType:             a.NestedObject.CustomType,
 			Validators:             a.NestedObject.Validators,
@@ -67,6 +69,7 @@ func NewGeneratorMapNestedAttribute(name string, a *datasource.MapNestedAttribut
 		NestedAttributeObject: nat,
 		Sensitive:             s,
 		Validators:            vm,
+		options:               opts,
 	}, nil
 }
 
@@ -152,7 +155,7 @@ func (g GeneratorMapNestedAttribute) Schema(name schema.FrameworkIdentifier) (st
 
 func (g GeneratorMapNestedAttribute) ModelField(name schema.FrameworkIdentifier) (model.Field, error) {
 	f := model.Field{
-		Name:      name.ToPascalCase(),
+		Name:      g.options.ToPascalCase(name),
 		TfsdkName: name.ToString(),
 		ValueType: model.MapValueType,
 	}
@@ -173,13 +176,13 @@ func (g GeneratorMapNestedAttribute) GetAttributes() schema.GeneratorAttributes
 func (g GeneratorMapNestedAttribute) CustomTypeAndValue(name string) ([]byte, error) {
 	var buf bytes.Buffer
 
-	attributeAttrValues, err := g.NestedObject.Attributes.AttrValues()
+	attributeAttrValues, err := g.NestedObject.Attributes.AttrValues(name, g.options)
 
 	if err != nil {
 		return nil, err
 	}
 
-	objectType := schema.NewCustomNestedObjectType(name, attributeAttrValues)
+	objectType := schema.NewCustomNestedObjectType(name, attributeAttrValues, g.options)
 
 	b, err := objectType.Render()
 
@@ -195,7 +198,7 @@ func (g GeneratorMapNestedAttribute) CustomTypeAndValue(name string) ([]byte, er
 		return nil, err
 	}
 
-	attributeAttrTypes, err := g.NestedObject.Attributes.AttrTypes()
+	attributeAttrTypes, err := g.NestedObject.Attributes.AttrTypes(name, g.options)
 
 	if err != nil {
 		return nil, err
@@ -207,7 +210,7 @@ func (g GeneratorMapNestedAttribute) CustomTypeAndValue(name string) ([]byte, er
 		return nil, err
 	}
 
-	objectValue := schema.NewCustomNestedObjectValue(name, attributeTypes, attributeAttrTypes, attributeAttrValues, attributeCollectionTypes)
+	objectValue := schema.NewCustomNestedObjectValue(name, attributeTypes, attributeAttrTypes, attributeAttrValues, attributeCollectionTypes, g.options)
 
 	b, err = objectValue.Render()
 
@@ -223,7 +226,7 @@ func (g GeneratorMapNestedAttribute) CustomTypeAndValue(name string) ([]byte, er
 	// CustomTypeAndValue interface (i.e, nested attributes).
 	for _, k := range attributeKeys {
 		if c, ok := g.NestedObject.Attributes[k].(schema.CustomTypeAndValue); ok {
-			b, err := c.CustomTypeAndValue(k)
+			b, err := g.options.NestedCustomTypeAndValue(c, g.options.NestedTypeName(name, k))
 
 			if err != nil {
 				return nil, err
@@ -236,6 +239,10 @@ func (g GeneratorMapNestedAttribute) CustomTypeAndValue(name string) ([]byte, er
 	return buf.Bytes(), nil
 }
 
+func (g GeneratorMapNestedAttribute) GetAssociatedExternalType() *schema.AssocExtType {
+	return g.NestedObject.AssociatedExternalType
+}
+
 func (g GeneratorMapNestedAttribute) ToFromFunctions(name string) ([]byte, error) {
 	if g.NestedObject.AssociatedExternalType == nil {
 		return nil, nil
@@ -255,7 +262,7 @@ func (g GeneratorMapNestedAttribute) ToFromFunctions(name string) ([]byte, error
 		return nil, err
 	}
 
-	toFrom := schema.NewToFromNestedObject(name, g.NestedObject.AssociatedExternalType, toFuncs, fromFuncs)
+	toFrom := schema.NewToFromNestedObject(name, g.NestedObject.AssociatedExternalType, toFuncs, fromFuncs, g.options)
 
 	b, err := toFrom.Render()
 
@@ -271,7 +278,7 @@ func (g GeneratorMapNestedAttribute) ToFromFunctions(name string) ([]byte, error
 	// ToFrom interface.
 	for _, k := range attributeKeys {
 		if c, ok := g.NestedObject.Attributes[k].(schema.ToFrom); ok {
-			b, err := c.ToFromFunctions(k)
+			b, err := g.options.NestedToFromFunctions(c, g.options.NestedTypeName(name, k))
 
 			if err != nil {
 				return nil, err
@@ -291,3 +298,14 @@ func (g GeneratorMapNestedAttribute) To() (schema.ToFromConversion, error) {
 func (g GeneratorMapNestedAttribute) From() (schema.ToFromConversion, error) {
 	return schema.ToFromConversion{}, schema.NewUnimplementedError(errors.New("map nested type is not yet implemented"))
 }
+
+func (g GeneratorMapNestedAttribute) Properties() schema.FieldProperties {
+	return schema.FieldProperties{
+		Computed:           g.ComputedOptionalRequired.IsComputed(),
+		Optional:           g.ComputedOptionalRequired.IsOptional(),
+		Required:           g.ComputedOptionalRequired.IsRequired(),
+		Sensitive:          g.Sensitive.IsSensitive(),
+		Description:        g.Description.Description(),
+		DeprecationMessage: g.DeprecationMessage.DeprecationMessage(),
+	}
+}
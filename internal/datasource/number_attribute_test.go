@@ -33,7 +33,7 @@ func TestGeneratorNumberAttribute_New(t *testing.T) {
 			},
 			expected: GeneratorNumberAttribute{
 				ComputedOptionalRequired: convert.NewComputedOptionalRequired(specschema.Computed),
-				CustomType:               convert.NewCustomTypePrimitive(nil, nil, "name"),
+				CustomType:               convert.NewCustomTypePrimitive(nil, nil, "name", nil),
 				Validators:               convert.NewValidators(convert.ValidatorTypeNumber, specschema.CustomValidators{}),
 			},
 		},
@@ -43,7 +43,7 @@ func TestGeneratorNumberAttribute_New(t *testing.T) {
 			},
 			expected: GeneratorNumberAttribute{
 				ComputedOptionalRequired: convert.NewComputedOptionalRequired(specschema.ComputedOptional),
-				CustomType:               convert.NewCustomTypePrimitive(nil, nil, "name"),
+				CustomType:               convert.NewCustomTypePrimitive(nil, nil, "name", nil),
 				Validators:               convert.NewValidators(convert.ValidatorTypeNumber, specschema.CustomValidators{}),
 			},
 		},
@@ -53,7 +53,7 @@ func TestGeneratorNumberAttribute_New(t *testing.T) {
 			},
 			expected: GeneratorNumberAttribute{
 				ComputedOptionalRequired: convert.NewComputedOptionalRequired(specschema.Optional),
-				CustomType:               convert.NewCustomTypePrimitive(nil, nil, "name"),
+				CustomType:               convert.NewCustomTypePrimitive(nil, nil, "name", nil),
 				Validators:               convert.NewValidators(convert.ValidatorTypeNumber, specschema.CustomValidators{}),
 			},
 		},
@@ -63,7 +63,7 @@ func TestGeneratorNumberAttribute_New(t *testing.T) {
 			},
 			expected: GeneratorNumberAttribute{
 				ComputedOptionalRequired: convert.NewComputedOptionalRequired(specschema.Required),
-				CustomType:               convert.NewCustomTypePrimitive(nil, nil, "name"),
+				CustomType:               convert.NewCustomTypePrimitive(nil, nil, "name", nil),
 				Validators:               convert.NewValidators(convert.ValidatorTypeNumber, specschema.CustomValidators{}),
 			},
 		},
@@ -84,7 +84,7 @@ func TestGeneratorNumberAttribute_New(t *testing.T) {
 					},
 					Type:      "my_type",
 					ValueType: "myvalue_type",
-				}, nil, "name"),
+				}, nil, "name", nil),
 				Validators: convert.NewValidators(convert.ValidatorTypeNumber, nil),
 			},
 		},
@@ -93,7 +93,7 @@ func TestGeneratorNumberAttribute_New(t *testing.T) {
 				DeprecationMessage: pointer("deprecation message"),
 			},
 			expected: GeneratorNumberAttribute{
-				CustomType:         convert.NewCustomTypePrimitive(nil, nil, "name"),
+				CustomType:         convert.NewCustomTypePrimitive(nil, nil, "name", nil),
 				DeprecationMessage: convert.NewDeprecationMessage(pointer("deprecation message")),
 				Validators:         convert.NewValidators(convert.ValidatorTypeNumber, specschema.CustomValidators{}),
 			},
@@ -103,7 +103,7 @@ func TestGeneratorNumberAttribute_New(t *testing.T) {
 				Description: pointer("description"),
 			},
 			expected: GeneratorNumberAttribute{
-				CustomType:  convert.NewCustomTypePrimitive(nil, nil, "name"),
+				CustomType:  convert.NewCustomTypePrimitive(nil, nil, "name", nil),
 				Description: convert.NewDescription(pointer("description")),
 				Validators:  convert.NewValidators(convert.ValidatorTypeNumber, specschema.CustomValidators{}),
 			},
@@ -113,7 +113,7 @@ func TestGeneratorNumberAttribute_New(t *testing.T) {
 				Sensitive: pointer(true),
 			},
 			expected: GeneratorNumberAttribute{
-				CustomType: convert.NewCustomTypePrimitive(nil, nil, "name"),
+				CustomType: convert.NewCustomTypePrimitive(nil, nil, "name", nil),
 				Sensitive:  convert.NewSensitive(pointer(true)),
 				Validators: convert.NewValidators(convert.ValidatorTypeNumber, specschema.CustomValidators{}),
 			},
@@ -134,7 +134,7 @@ func TestGeneratorNumberAttribute_New(t *testing.T) {
 				},
 			},
 			expected: GeneratorNumberAttribute{
-				CustomType: convert.NewCustomTypePrimitive(nil, nil, "name"),
+				CustomType: convert.NewCustomTypePrimitive(nil, nil, "name", nil),
 				Validators: convert.NewValidators(convert.ValidatorTypeNumber, specschema.CustomValidators{
 					&specschema.CustomValidator{
 						Imports: []code.Import{
@@ -154,7 +154,7 @@ func TestGeneratorNumberAttribute_New(t *testing.T) {
 		t.Run(name, func(t *testing.T) {
 			t.Parallel()
 
-			got, err := NewGeneratorNumberAttribute("name", testCase.input)
+			got, err := NewGeneratorNumberAttribute("name", testCase.input, nil)
 
 			if diff := cmp.Diff(err, testCase.expectedError, equateErrorMessage); diff != "" {
 				t.Errorf("unexpected error: %s", diff)
@@ -183,6 +183,7 @@ func TestGeneratorNumberAttribute_Schema(t *testing.T) {
 					},
 					nil,
 					"number_attribute",
+					nil,
 				),
 			},
 			expected: `"number_attribute": schema.NumberAttribute{
@@ -198,6 +199,7 @@ CustomType: my_custom_type,
 						Type: "*api.ExtNumber",
 					},
 					"number_attribute",
+					nil,
 				),
 			},
 			expected: `"number_attribute": schema.NumberAttribute{
@@ -215,6 +217,7 @@ CustomType: NumberAttributeType{},
 						Type: "*api.ExtNumber",
 					},
 					"number_attribute",
+					nil,
 				),
 			},
 			expected: `"number_attribute": schema.NumberAttribute{
@@ -346,6 +349,7 @@ func TestGeneratorNumberAttribute_ModelField(t *testing.T) {
 					},
 					nil,
 					"",
+					nil,
 				),
 			},
 			expected: model.Field{
@@ -362,6 +366,7 @@ func TestGeneratorNumberAttribute_ModelField(t *testing.T) {
 						Type: "*api.NumberAttribute",
 					},
 					"number_attribute",
+					nil,
 				),
 			},
 			expected: model.Field{
@@ -380,6 +385,7 @@ func TestGeneratorNumberAttribute_ModelField(t *testing.T) {
 						Type: "*api.NumberAttribute",
 					},
 					"",
+					nil,
 				),
 			},
 			expected: model.Field{
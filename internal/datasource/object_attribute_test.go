@@ -50,7 +50,7 @@ func TestGeneratorObjectAttribute_New(t *testing.T) {
 						Bool: &specschema.BoolType{},
 					},
 				}),
-				CustomType: convert.NewCustomTypeObject(nil, nil, "name"),
+				CustomType: convert.NewCustomTypeObject(nil, nil, "name", nil),
 				Validators: convert.NewValidators(convert.ValidatorTypeObject, specschema.CustomValidators{}),
 			},
 		},
@@ -76,7 +76,7 @@ func TestGeneratorObjectAttribute_New(t *testing.T) {
 						String: &specschema.StringType{},
 					},
 				}),
-				CustomType: convert.NewCustomTypeObject(nil, nil, "name"),
+				CustomType: convert.NewCustomTypeObject(nil, nil, "name", nil),
 				Validators: convert.NewValidators(convert.ValidatorTypeObject, specschema.CustomValidators{}),
 			},
 		},
@@ -114,7 +114,7 @@ func TestGeneratorObjectAttribute_New(t *testing.T) {
 						},
 					},
 				}),
-				CustomType: convert.NewCustomTypeObject(nil, nil, "name"),
+				CustomType: convert.NewCustomTypeObject(nil, nil, "name", nil),
 				Validators: convert.NewValidators(convert.ValidatorTypeObject, specschema.CustomValidators{}),
 			},
 		},
@@ -152,7 +152,7 @@ func TestGeneratorObjectAttribute_New(t *testing.T) {
 						},
 					},
 				}),
-				CustomType: convert.NewCustomTypeObject(nil, nil, "name"),
+				CustomType: convert.NewCustomTypeObject(nil, nil, "name", nil),
 				Validators: convert.NewValidators(convert.ValidatorTypeObject, specschema.CustomValidators{}),
 			},
 		},
@@ -211,7 +211,7 @@ func TestGeneratorObjectAttribute_New(t *testing.T) {
 						},
 					},
 				}),
-				CustomType: convert.NewCustomTypeObject(nil, nil, "name"),
+				CustomType: convert.NewCustomTypeObject(nil, nil, "name", nil),
 				Validators: convert.NewValidators(convert.ValidatorTypeObject, specschema.CustomValidators{}),
 			},
 		},
@@ -237,7 +237,7 @@ func TestGeneratorObjectAttribute_New(t *testing.T) {
 						String: &specschema.StringType{},
 					},
 				}),
-				CustomType: convert.NewCustomTypeObject(nil, nil, "name"),
+				CustomType: convert.NewCustomTypeObject(nil, nil, "name", nil),
 				Validators: convert.NewValidators(convert.ValidatorTypeObject, specschema.CustomValidators{}),
 			},
 		},
@@ -275,7 +275,7 @@ func TestGeneratorObjectAttribute_New(t *testing.T) {
 						},
 					},
 				}),
-				CustomType: convert.NewCustomTypeObject(nil, nil, "name"),
+				CustomType: convert.NewCustomTypeObject(nil, nil, "name", nil),
 				Validators: convert.NewValidators(convert.ValidatorTypeObject, specschema.CustomValidators{}),
 			},
 		},
@@ -285,7 +285,7 @@ func TestGeneratorObjectAttribute_New(t *testing.T) {
 			},
 			expected: GeneratorObjectAttribute{
 				ComputedOptionalRequired: convert.NewComputedOptionalRequired(specschema.Computed),
-				CustomType:               convert.NewCustomTypeObject(nil, nil, "name"),
+				CustomType:               convert.NewCustomTypeObject(nil, nil, "name", nil),
 				Validators:               convert.NewValidators(convert.ValidatorTypeObject, specschema.CustomValidators{}),
 			},
 		},
@@ -295,7 +295,7 @@ func TestGeneratorObjectAttribute_New(t *testing.T) {
 			},
 			expected: GeneratorObjectAttribute{
 				ComputedOptionalRequired: convert.NewComputedOptionalRequired(specschema.ComputedOptional),
-				CustomType:               convert.NewCustomTypeObject(nil, nil, "name"),
+				CustomType:               convert.NewCustomTypeObject(nil, nil, "name", nil),
 				Validators:               convert.NewValidators(convert.ValidatorTypeObject, specschema.CustomValidators{}),
 			},
 		},
@@ -305,7 +305,7 @@ func TestGeneratorObjectAttribute_New(t *testing.T) {
 			},
 			expected: GeneratorObjectAttribute{
 				ComputedOptionalRequired: convert.NewComputedOptionalRequired(specschema.Optional),
-				CustomType:               convert.NewCustomTypeObject(nil, nil, "name"),
+				CustomType:               convert.NewCustomTypeObject(nil, nil, "name", nil),
 				Validators:               convert.NewValidators(convert.ValidatorTypeObject, specschema.CustomValidators{})},
 		},
 		"required": {
@@ -314,7 +314,7 @@ func TestGeneratorObjectAttribute_New(t *testing.T) {
 			},
 			expected: GeneratorObjectAttribute{
 				ComputedOptionalRequired: convert.NewComputedOptionalRequired(specschema.Required),
-				CustomType:               convert.NewCustomTypeObject(nil, nil, "name"),
+				CustomType:               convert.NewCustomTypeObject(nil, nil, "name", nil),
 				Validators:               convert.NewValidators(convert.ValidatorTypeObject, specschema.CustomValidators{}),
 			},
 		},
@@ -338,6 +338,7 @@ func TestGeneratorObjectAttribute_New(t *testing.T) {
 				},
 					nil,
 					"name",
+					nil,
 				),
 				Validators: convert.NewValidators(convert.ValidatorTypeObject, specschema.CustomValidators{})},
 		},
@@ -346,7 +347,7 @@ func TestGeneratorObjectAttribute_New(t *testing.T) {
 				DeprecationMessage: pointer("deprecation message"),
 			},
 			expected: GeneratorObjectAttribute{
-				CustomType:         convert.NewCustomTypeObject(nil, nil, "name"),
+				CustomType:         convert.NewCustomTypeObject(nil, nil, "name", nil),
 				DeprecationMessage: convert.NewDeprecationMessage(pointer("deprecation message")),
 				Validators:         convert.NewValidators(convert.ValidatorTypeObject, specschema.CustomValidators{})},
 		},
@@ -355,7 +356,7 @@ func TestGeneratorObjectAttribute_New(t *testing.T) {
 				Description: pointer("description"),
 			},
 			expected: GeneratorObjectAttribute{
-				CustomType:  convert.NewCustomTypeObject(nil, nil, "name"),
+				CustomType:  convert.NewCustomTypeObject(nil, nil, "name", nil),
 				Description: convert.NewDescription(pointer("description")),
 				Validators:  convert.NewValidators(convert.ValidatorTypeObject, specschema.CustomValidators{}),
 			},
@@ -365,7 +366,7 @@ func TestGeneratorObjectAttribute_New(t *testing.T) {
 				Sensitive: pointer(true),
 			},
 			expected: GeneratorObjectAttribute{
-				CustomType: convert.NewCustomTypeObject(nil, nil, "name"),
+				CustomType: convert.NewCustomTypeObject(nil, nil, "name", nil),
 				Sensitive:  convert.NewSensitive(pointer(true)),
 				Validators: convert.NewValidators(convert.ValidatorTypeObject, specschema.CustomValidators{}),
 			},
@@ -386,7 +387,7 @@ func TestGeneratorObjectAttribute_New(t *testing.T) {
 				},
 			},
 			expected: GeneratorObjectAttribute{
-				CustomType: convert.NewCustomTypeObject(nil, nil, "name"),
+				CustomType: convert.NewCustomTypeObject(nil, nil, "name", nil),
 				Validators: convert.NewValidators(convert.ValidatorTypeObject, specschema.CustomValidators{
 					&specschema.CustomValidator{
 						Imports: []code.Import{
@@ -406,7 +407,7 @@ func TestGeneratorObjectAttribute_New(t *testing.T) {
 		t.Run(name, func(t *testing.T) {
 			t.Parallel()
 
-			got, err := NewGeneratorObjectAttribute("name", testCase.input)
+			got, err := NewGeneratorObjectAttribute("name", testCase.input, nil)
 
 			if diff := cmp.Diff(err, testCase.expectedError, equateErrorMessage); diff != "" {
 				t.Errorf("unexpected error: %s", diff)
@@ -439,6 +440,7 @@ func TestGeneratorObjectAttribute_Imports(t *testing.T) {
 					&specschema.CustomType{},
 					nil,
 					"",
+					nil,
 				),
 			},
 			expected: []code.Import{},
@@ -453,6 +455,7 @@ func TestGeneratorObjectAttribute_Imports(t *testing.T) {
 					},
 					nil,
 					"",
+					nil,
 				),
 			},
 			expected: []code.Import{},
@@ -467,6 +470,7 @@ func TestGeneratorObjectAttribute_Imports(t *testing.T) {
 					},
 					nil,
 					"",
+					nil,
 				),
 			},
 			expected: []code.Import{
@@ -781,6 +785,7 @@ func TestGeneratorObjectAttribute_Imports(t *testing.T) {
 						Type: "*api.ObjectAttribute",
 					},
 					"",
+					nil,
 				),
 			},
 			expected: []code.Import{
@@ -1204,6 +1209,7 @@ AttributeTypes: map[string]attr.Type{
 					},
 					nil,
 					"",
+					nil,
 				),
 			},
 			expected: `"object_attribute": schema.ObjectAttribute{
@@ -1225,6 +1231,7 @@ CustomType: my_custom_type,
 						Type: "*api.ObjectAttribute",
 					},
 					"object_attribute",
+					nil,
 				),
 			},
 			expected: `"object_attribute": schema.ObjectAttribute{
@@ -1252,6 +1259,7 @@ AttrTypes: ObjectAttributeValue{}.AttributeTypes(ctx),
 						Type: "*api.ObjectAttribute",
 					},
 					"object_attribute",
+					nil,
 				),
 			},
 			expected: `"object_attribute": schema.ObjectAttribute{
@@ -1657,6 +1665,7 @@ func TestGeneratorObjectAttribute_ModelField(t *testing.T) {
 					},
 					nil,
 					"",
+					nil,
 				),
 			},
 			expected: model.Field{
@@ -1673,6 +1682,7 @@ func TestGeneratorObjectAttribute_ModelField(t *testing.T) {
 						Type: "*api.ObjectAttribute",
 					},
 					"object_attribute",
+					nil,
 				),
 			},
 			expected: model.Field{
@@ -1691,6 +1701,7 @@ func TestGeneratorObjectAttribute_ModelField(t *testing.T) {
 						Type: "*api.ObjectAttribute",
 					},
 					"object_attribute",
+					nil,
 				),
 			},
 			expected: model.Field{
@@ -34,6 +34,7 @@ func TestGeneratorDataSourceSchemas_ModelsBytes(t *testing.T) {
 								},
 								nil,
 								"",
+								nil,
 							),
 						},
 						"float64_attribute": GeneratorFloat64Attribute{},
@@ -44,6 +45,7 @@ func TestGeneratorDataSourceSchemas_ModelsBytes(t *testing.T) {
 								},
 								nil,
 								"",
+								nil,
 							),
 						},
 						"int64_attribute": GeneratorInt64Attribute{},
@@ -54,6 +56,7 @@ func TestGeneratorDataSourceSchemas_ModelsBytes(t *testing.T) {
 								},
 								nil,
 								"",
+								nil,
 							),
 						},
 						"list_attribute": GeneratorListAttribute{},
@@ -66,6 +69,7 @@ func TestGeneratorDataSourceSchemas_ModelsBytes(t *testing.T) {
 								convert.CustomCollectionTypeList,
 								"",
 								"",
+								nil,
 							),
 						},
 						"list_nested_attribute": GeneratorListNestedAttribute{
@@ -97,6 +101,7 @@ func TestGeneratorDataSourceSchemas_ModelsBytes(t *testing.T) {
 								convert.CustomCollectionTypeList,
 								"",
 								"",
+								nil,
 							),
 						},
 						"map_nested_attribute": GeneratorMapNestedAttribute{
@@ -126,6 +131,7 @@ func TestGeneratorDataSourceSchemas_ModelsBytes(t *testing.T) {
 								},
 								nil,
 								"",
+								nil,
 							),
 						},
 						"object_attribute": GeneratorObjectAttribute{},
@@ -136,6 +142,7 @@ func TestGeneratorDataSourceSchemas_ModelsBytes(t *testing.T) {
 								},
 								nil,
 								"",
+								nil,
 							),
 						},
 						"set_attribute": GeneratorSetAttribute{},
@@ -148,6 +155,7 @@ func TestGeneratorDataSourceSchemas_ModelsBytes(t *testing.T) {
 								convert.CustomCollectionTypeList,
 								"",
 								"",
+								nil,
 							),
 						},
 						"set_nested_attribute": GeneratorSetNestedAttribute{
@@ -180,6 +188,7 @@ func TestGeneratorDataSourceSchemas_ModelsBytes(t *testing.T) {
 									ValueType: "my_single_nested_value_type",
 								},
 								"",
+								nil,
 							),
 							Attributes: schema.GeneratorAttributes{
 								"bool_attribute": GeneratorBoolAttribute{},
@@ -193,6 +202,7 @@ func TestGeneratorDataSourceSchemas_ModelsBytes(t *testing.T) {
 								},
 								nil,
 								"",
+								nil,
 							),
 						},
 					},
@@ -246,6 +256,7 @@ func TestGeneratorDataSourceSchemas_ModelsBytes(t *testing.T) {
 									ValueType: "my_single_nested_value_type",
 								},
 								"",
+								nil,
 							),
 							Attributes: schema.GeneratorAttributes{
 								"bool_attribute": GeneratorBoolAttribute{},
@@ -263,7 +274,7 @@ func TestGeneratorDataSourceSchemas_ModelsBytes(t *testing.T) {
 		t.Run(name, func(t *testing.T) {
 			t.Parallel()
 
-			g := schema.NewGeneratorSchemas(testCase.input)
+			g := schema.NewGeneratorSchemas(testCase.input, nil)
 			got, err := g.Models()
 
 			if diff := cmp.Diff(err, testCase.expectedError, equateErrorMessage); diff != "" {
@@ -40,6 +40,7 @@ func TestGeneratorSetAttribute_New(t *testing.T) {
 					convert.CustomCollectionTypeSet,
 					"types.BoolType",
 					"name",
+					nil,
 				),
 				ElementType: specschema.ElementType{
 					Bool: &specschema.BoolType{},
@@ -63,6 +64,7 @@ func TestGeneratorSetAttribute_New(t *testing.T) {
 					convert.CustomCollectionTypeSet,
 					"types.StringType",
 					"name",
+					nil,
 				),
 				ElementType: specschema.ElementType{
 					String: &specschema.StringType{},
@@ -90,6 +92,7 @@ func TestGeneratorSetAttribute_New(t *testing.T) {
 					convert.CustomCollectionTypeSet,
 					"types.ListType{\nElemType: types.StringType,\n}",
 					"name",
+					nil,
 				),
 				ElementType: specschema.ElementType{
 					List: &specschema.ListType{
@@ -125,6 +128,7 @@ func TestGeneratorSetAttribute_New(t *testing.T) {
 					convert.CustomCollectionTypeSet,
 					"types.MapType{\nElemType: types.StringType,\n}",
 					"name",
+					nil,
 				),
 				ElementType: specschema.ElementType{
 					Map: &specschema.MapType{
@@ -167,6 +171,7 @@ func TestGeneratorSetAttribute_New(t *testing.T) {
 					convert.CustomCollectionTypeSet,
 					"types.ListType{\nElemType: types.ObjectType{\nAttrTypes: map[string]attr.Type{\n\"str\": types.StringType,\n},\n},\n}",
 					"name",
+					nil,
 				),
 				ElementType: specschema.ElementType{
 					List: &specschema.ListType{
@@ -219,6 +224,7 @@ func TestGeneratorSetAttribute_New(t *testing.T) {
 					convert.CustomCollectionTypeSet,
 					"types.ObjectType{\nAttrTypes: map[string]attr.Type{\n\"str\": types.StringType,\n},\n}",
 					"name",
+					nil,
 				),
 				ElementType: specschema.ElementType{
 					Object: &specschema.ObjectType{
@@ -267,6 +273,7 @@ func TestGeneratorSetAttribute_New(t *testing.T) {
 					convert.CustomCollectionTypeSet,
 					"types.ObjectType{\nAttrTypes: map[string]attr.Type{\n\"list\": types.ListType{\nElemType: types.StringType,\n},\n},\n}",
 					"name",
+					nil,
 				),
 				ElementType: specschema.ElementType{
 					Object: &specschema.ObjectType{
@@ -314,6 +321,7 @@ func TestGeneratorSetAttribute_New(t *testing.T) {
 					convert.CustomCollectionTypeSet,
 					"types.StringType",
 					"name",
+					nil,
 				),
 				ElementType: specschema.ElementType{
 					String: &specschema.StringType{},
@@ -339,6 +347,7 @@ func TestGeneratorSetAttribute_New(t *testing.T) {
 					convert.CustomCollectionTypeSet,
 					"types.StringType",
 					"name",
+					nil,
 				),
 				ElementType: specschema.ElementType{
 					String: &specschema.StringType{},
@@ -364,6 +373,7 @@ func TestGeneratorSetAttribute_New(t *testing.T) {
 					convert.CustomCollectionTypeSet,
 					"types.StringType",
 					"name",
+					nil,
 				),
 				ElementType: specschema.ElementType{
 					String: &specschema.StringType{},
@@ -389,6 +399,7 @@ func TestGeneratorSetAttribute_New(t *testing.T) {
 					convert.CustomCollectionTypeSet,
 					"types.StringType",
 					"name",
+					nil,
 				),
 				ElementType: specschema.ElementType{
 					String: &specschema.StringType{},
@@ -425,6 +436,7 @@ func TestGeneratorSetAttribute_New(t *testing.T) {
 					convert.CustomCollectionTypeSet,
 					"types.StringType",
 					"name",
+					nil,
 				),
 				ElementType: specschema.ElementType{
 					String: &specschema.StringType{},
@@ -449,6 +461,7 @@ func TestGeneratorSetAttribute_New(t *testing.T) {
 					convert.CustomCollectionTypeSet,
 					"types.StringType",
 					"name",
+					nil,
 				),
 				DeprecationMessage: convert.NewDeprecationMessage(pointer("deprecation message")),
 				ElementType: specschema.ElementType{
@@ -474,6 +487,7 @@ func TestGeneratorSetAttribute_New(t *testing.T) {
 					convert.CustomCollectionTypeSet,
 					"types.StringType",
 					"name",
+					nil,
 				),
 				Description: convert.NewDescription(pointer("description")),
 				ElementType: specschema.ElementType{
@@ -499,6 +513,7 @@ func TestGeneratorSetAttribute_New(t *testing.T) {
 					convert.CustomCollectionTypeSet,
 					"types.StringType",
 					"name",
+					nil,
 				),
 				ElementType: specschema.ElementType{
 					String: &specschema.StringType{},
@@ -535,6 +550,7 @@ func TestGeneratorSetAttribute_New(t *testing.T) {
 					convert.CustomCollectionTypeSet,
 					"types.StringType",
 					"name",
+					nil,
 				),
 				ElementType: specschema.ElementType{
 					String: &specschema.StringType{},
@@ -561,7 +577,7 @@ func TestGeneratorSetAttribute_New(t *testing.T) {
 		t.Run(name, func(t *testing.T) {
 			t.Parallel()
 
-			got, err := NewGeneratorSetAttribute("name", testCase.input)
+			got, err := NewGeneratorSetAttribute("name", testCase.input, nil)
 
 			if diff := cmp.Diff(err, testCase.expectedError, equateErrorMessage); diff != "" {
 				t.Errorf("unexpected error: %s", diff)
@@ -833,6 +849,7 @@ ElementType: types.StringType,
 					convert.CustomCollectionTypeSet,
 					"",
 					"",
+					nil,
 				),
 				ElementTypeCollection: convert.NewElementType(specschema.ElementType{
 					String: &specschema.StringType{},
@@ -851,6 +868,7 @@ CustomType: my_custom_type,
 					convert.CustomCollectionTypeSet,
 					"types.StringType",
 					"set_attribute",
+					nil,
 				),
 				ElementTypeCollection: convert.NewElementType(specschema.ElementType{
 					String: &specschema.StringType{},
@@ -873,6 +891,7 @@ ElemType: types.StringType,
 					convert.CustomCollectionTypeSet,
 					"types.StringType",
 					"name",
+					nil,
 				),
 			},
 			expected: `"set_attribute": schema.SetAttribute{
@@ -1194,6 +1213,7 @@ func TestGeneratorSetAttribute_ModelField(t *testing.T) {
 					convert.CustomCollectionTypeSet,
 					"",
 					"",
+					nil,
 				),
 			},
 			expected: model.Field{
@@ -1212,6 +1232,7 @@ func TestGeneratorSetAttribute_ModelField(t *testing.T) {
 					convert.CustomCollectionTypeSet,
 					"",
 					"set_attribute",
+					nil,
 				),
 			},
 			expected: model.Field{
@@ -1232,6 +1253,7 @@ func TestGeneratorSetAttribute_ModelField(t *testing.T) {
 					convert.CustomCollectionTypeSet,
 					"",
 					"set_attribute",
+					nil,
 				),
 			},
 			expected: model.Field{
@@ -24,14 +24,16 @@ type GeneratorSetNestedAttribute struct {
 	NestedAttributeObject    convert.NestedAttributeObject
 	Sensitive                convert.Sensitive
 	Validators               convert.Validators
+
+	options *schema.Options
 }
 
-func NewGeneratorSetNestedAttribute(name string, a *datasource.SetNestedAttribute) (GeneratorSetNestedAttribute, error) {
+func NewGeneratorSetNestedAttribute(name string, a *datasource.SetNestedAttribute, opts *schema.Options) (GeneratorSetNestedAttribute, error) {
 	if a == nil {
 		return GeneratorSetNestedAttribute{}, fmt.Errorf("*datasource.SetNestedAttribute is nil")
 	}
 
-	attributes, err := NewAttributes(a.NestedObject.Attributes)
+	attributes, err := NewAttributes(a.NestedObject.Attributes, name, opts)
 
 	if err != nil {
 		return GeneratorSetNestedAttribute{}, err
@@ -47,7 +49,7 @@ func NewGeneratorSetNestedAttribute(name string, a *datasource.SetNestedAttribut
 
 	vo := convert.NewValidators(convert.ValidatorTypeObject, a.NestedObject.Validators.CustomValidators())
 
-	nat := convert.NewNestedAttributeObject(attributes, a.NestedObject.CustomType, vo, name)
+	nat := convert.NewNestedAttributeObject(attributes, a.NestedObject.CustomType, vo, name, opts)
 
 	s := convert.NewSensitive(a.Sensitive)
 
@@ -59,7 +61,7 @@ func NewGeneratorSetNestedAttribute(name string, a *datasource.SetNestedAttribut
 		DeprecationMessage:       dm,
 		Description:              d,
 		NestedObject: GeneratorNestedAttributeObject{
-			AssociatedExternalType: schema.NewAssocExtType(a.NestedObject.AssociatedExternalType),
+			AssociatedExternalType: schema.NewAssocExtType(a.NestedObject.AssociatedExternalType, opts),
 			Attributes:             attributes,
 			CustomType:             a.NestedObject.CustomType,
 			Validators:             a.NestedObject.Validators,
@@ -67,6 +69,7 @@ func NewGeneratorSetNestedAttribute(name string, a *datasource.SetNestedAttribut
 		NestedAttributeObject: nat,
 		Sensitive:             s,
 		Validators:            vs,
+		options:               opts,
 	}, nil
 }
 
@@ -152,7 +155,7 @@ func (g GeneratorSetNestedAttribute) Schema(name schema.FrameworkIdentifier) (st
 
 func (g GeneratorSetNestedAttribute) ModelField(name schema.FrameworkIdentifier) (model.Field, error) {
 	f := model.Field{
-		Name:      name.ToPascalCase(),
+		Name:      g.options.ToPascalCase(name),
 		TfsdkName: name.ToString(),
 		ValueType: model.SetValueType,
 	}
@@ -173,13 +176,13 @@ func (g GeneratorSetNestedAttribute) GetAttributes() schema.GeneratorAttributes
 func (g GeneratorSetNestedAttribute) CustomTypeAndValue(name string) ([]byte, error) {
 	var buf bytes.Buffer
 
-	attributeAttrValues, err := g.NestedObject.Attributes.AttrValues()
+	attributeAttrValues, err := g.NestedObject.Attributes.AttrValues(name, g.options)
 
 	if err != nil {
 		return nil, err
 	}
 
-	objectType := schema.NewCustomNestedObjectType(name, attributeAttrValues)
+	objectType := schema.NewCustomNestedObjectType(name, attributeAttrValues, g.options)
 
 	b, err := objectType.Render()
 
@@ -195,7 +198,7 @@ func (g GeneratorSetNestedAttribute) CustomTypeAndValue(name string) ([]byte, er
 		return nil, err
 	}
 
-	attributeAttrTypes, err := g.NestedObject.Attributes.AttrTypes()
+	attributeAttrTypes, err := g.NestedObject.Attributes.AttrTypes(name, g.options)
 
 	if err != nil {
 		return nil, err
@@ -207,7 +210,7 @@ func (g GeneratorSetNestedAttribute) CustomTypeAndValue(name string) ([]byte, er
 		return nil, err
 	}
 
-	objectValue := schema.NewCustomNestedObjectValue(name, attributeTypes, attributeAttrTypes, attributeAttrValues, attributeCollectionTypes)
+	objectValue := schema.NewCustomNestedObjectValue(name, attributeTypes, attributeAttrTypes, attributeAttrValues, attributeCollectionTypes, g.options)
 
 	b, err = objectValue.Render()
 
@@ -223,7 +226,7 @@ func (g GeneratorSetNestedAttribute) CustomTypeAndValue(name string) ([]byte, er
 	// CustomTypeAndValue interface (i.e, nested attributes).
 	for _, k := range attributeKeys {
 		if c, ok := g.NestedObject.Attributes[k].(schema.CustomTypeAndValue); ok {
-			b, err := c.CustomTypeAndValue(k)
+			b, err := g.options.NestedCustomTypeAndValue(c, g.options.NestedTypeName(name, k))
 
 			if err != nil {
 				return nil, err
@@ -236,6 +239,10 @@ func (g GeneratorSetNestedAttribute) CustomTypeAndValue(name string) ([]byte, er
 	return buf.Bytes(), nil
 }
 
+func (g GeneratorSetNestedAttribute) GetAssociatedExternalType() *schema.AssocExtType {
+	return g.NestedObject.AssociatedExternalType
+}
+
 func (g GeneratorSetNestedAttribute) ToFromFunctions(name string) ([]byte, error) {
 	if g.NestedObject.AssociatedExternalType == nil {
 		return nil, nil
@@ -255,7 +262,7 @@ func (g GeneratorSetNestedAttribute) ToFromFunctions(name string) ([]byte, error
 		return nil, err
 	}
 
-	toFrom := schema.NewToFromNestedObject(name, g.NestedObject.AssociatedExternalType, toFuncs, fromFuncs)
+	toFrom := schema.NewToFromNestedObject(name, g.NestedObject.AssociatedExternalType, toFuncs, fromFuncs, g.options)
 
 	b, err := toFrom.Render()
 
@@ -271,7 +278,7 @@ func (g GeneratorSetNestedAttribute) ToFromFunctions(name string) ([]byte, error
 	// ToFrom interface.
 	for _, k := range attributeKeys {
 		if c, ok := g.NestedObject.Attributes[k].(schema.ToFrom); ok {
-			b, err := c.ToFromFunctions(k)
+			b, err := g.options.NestedToFromFunctions(c, g.options.NestedTypeName(name, k))
 
 			if err != nil {
 				return nil, err
@@ -291,3 +298,14 @@ func (g GeneratorSetNestedAttribute) To() (schema.ToFromConversion, error) {
 func (g GeneratorSetNestedAttribute) From() (schema.ToFromConversion, error) {
 	return schema.ToFromConversion{}, schema.NewUnimplementedError(errors.New("set nested type is not yet implemented"))
 }
+
+func (g GeneratorSetNestedAttribute) Properties() schema.FieldProperties {
+	return schema.FieldProperties{
+		Computed:           g.ComputedOptionalRequired.IsComputed(),
+		Optional:           g.ComputedOptionalRequired.IsOptional(),
+		Required:           g.ComputedOptionalRequired.IsRequired(),
+		Sensitive:          g.Sensitive.IsSensitive(),
+		Description:        g.Description.Description(),
+		DeprecationMessage: g.DeprecationMessage.DeprecationMessage(),
+	}
+}
@@ -24,20 +24,22 @@ type GeneratorSetNestedBlock struct {
 	NestedBlockObject        convert.NestedBlockObject
 	Sensitive                convert.Sensitive
 	Validators               convert.Validators
+
+	options *schema.Options
 }
 
-func NewGeneratorSetNestedBlock(name string, b *datasource.SetNestedBlock) (GeneratorSetNestedBlock, error) {
+func NewGeneratorSetNestedBlock(name string, b *datasource.SetNestedBlock, opts *schema.Options) (GeneratorSetNestedBlock, error) {
 	if b == nil {
 		return GeneratorSetNestedBlock{}, fmt.Errorf("*datasource.SetNestedBlock is nil")
 	}
 
-	attributes, err := NewAttributes(b.NestedObject.Attributes)
+	attributes, err := NewAttributes(b.NestedObject.Attributes, name, opts)
 
 	if err != nil {
 		return GeneratorSetNestedBlock{}, err
 	}
 
-	blocks, err := NewBlocks(b.NestedObject.Blocks)
+	blocks, err := NewBlocks(b.NestedObject.Blocks, name, opts)
 
 	if err != nil {
 		return GeneratorSetNestedBlock{}, err
@@ -53,7 +55,7 @@ func NewGeneratorSetNestedBlock(name string, b *datasource.SetNestedBlock) (Gene
 
 	vo := convert.NewValidators(convert.ValidatorTypeObject, b.NestedObject.Validators.CustomValidators())
 
-	nbo := convert.NewNestedBlockObject(attributes, blocks, b.NestedObject.CustomType, vo, name)
+	nbo := convert.NewNestedBlockObject(attributes, blocks, b.NestedObject.CustomType, vo, name, opts)
 
 	s := convert.NewSensitive(b.Sensitive)
 
@@ -65,7 +67,7 @@ func NewGeneratorSetNestedBlock(name string, b *datasource.SetNestedBlock) (Gene
 		DeprecationMessage:       dm,
 		Description:              d,
 		NestedObject: GeneratorNestedBlockObject{
-			AssociatedExternalType: schema.NewAssocExtType(b.NestedObject.AssociatedExternalType),
+			AssociatedExternalType: schema.NewAssocExtType(b.NestedObject.AssociatedExternalType, opts),
 			Attributes:             attributes,
 			Blocks:                 blocks,
 			CustomType:             b.NestedObject.CustomType,
@@ -74,6 +76,7 @@ func NewGeneratorSetNestedBlock(name string, b *datasource.SetNestedBlock) (Gene
 		NestedBlockObject: nbo,
 		Sensitive:         s,
 		Validators:        vs,
+		options:           opts,
 	}, nil
 }
 
@@ -159,7 +162,7 @@ func (g GeneratorSetNestedBlock) Schema(name schema.FrameworkIdentifier) (string
 
 func (g GeneratorSetNestedBlock) ModelField(name schema.FrameworkIdentifier) (model.Field, error) {
 	f := model.Field{
-		Name:      name.ToPascalCase(),
+		Name:      g.options.ToPascalCase(name),
 		TfsdkName: name.ToString(),
 		ValueType: model.SetValueType,
 	}
@@ -184,7 +187,7 @@ func (g GeneratorSetNestedBlock) GetBlocks() schema.GeneratorBlocks {
 func (g GeneratorSetNestedBlock) CustomTypeAndValue(name string) ([]byte, error) {
 	var buf bytes.Buffer
 
-	attributeAttrValues, err := g.NestedObject.Attributes.AttrValues()
+	attributeAttrValues, err := g.NestedObject.Attributes.AttrValues(name, g.options)
 
 	if err != nil {
 		return nil, err
@@ -206,7 +209,7 @@ func (g GeneratorSetNestedBlock) CustomTypeAndValue(name string) ([]byte, error)
 		attributesBlocksAttrValues[k] = v
 	}
 
-	objectType := schema.NewCustomNestedObjectType(name, attributesBlocksAttrValues)
+	objectType := schema.NewCustomNestedObjectType(name, attributesBlocksAttrValues, g.options)
 
 	b, err := objectType.Render()
 
@@ -238,13 +241,13 @@ func (g GeneratorSetNestedBlock) CustomTypeAndValue(name string) ([]byte, error)
 		attributesBlocksTypes[k] = v
 	}
 
-	attributeAttrTypes, err := g.NestedObject.Attributes.AttrTypes()
+	attributeAttrTypes, err := g.NestedObject.Attributes.AttrTypes(name, g.options)
 
 	if err != nil {
 		return nil, err
 	}
 
-	blockAttrTypes, err := g.NestedObject.Blocks.AttrTypes()
+	blockAttrTypes, err := g.NestedObject.Blocks.AttrTypes(name, g.options)
 
 	if err != nil {
 		return nil, err
@@ -267,7 +270,7 @@ func (g GeneratorSetNestedBlock) CustomTypeAndValue(name string) ([]byte, error)
 		return nil, err
 	}
 
-	objectValue := schema.NewCustomNestedObjectValue(name, attributesBlocksTypes, attributesBlocksAttrTypes, attributesBlocksAttrValues, attributeCollectionTypes)
+	objectValue := schema.NewCustomNestedObjectValue(name, attributesBlocksTypes, attributesBlocksAttrTypes, attributesBlocksAttrValues, attributeCollectionTypes, g.options)
 
 	b, err = objectValue.Render()
 
@@ -285,7 +288,7 @@ func (g GeneratorSetNestedBlock) CustomTypeAndValue(name string) ([]byte, error)
 	// CustomTypeAndValue interface (i.e, nested attributes).
 	for _, k := range attributeKeys {
 		if c, ok := g.NestedObject.Attributes[k].(schema.CustomTypeAndValue); ok {
-			b, err := c.CustomTypeAndValue(k)
+			b, err := g.options.NestedCustomTypeAndValue(c, g.options.NestedTypeName(name, k))
 
 			if err != nil {
 				return nil, err
@@ -297,7 +300,7 @@ func (g GeneratorSetNestedBlock) CustomTypeAndValue(name string) ([]byte, error)
 
 	for _, k := range blockKeys {
 		if c, ok := g.NestedObject.Blocks[k].(schema.CustomTypeAndValue); ok {
-			b, err := c.CustomTypeAndValue(k)
+			b, err := g.options.NestedCustomTypeAndValue(c, g.options.NestedTypeName(name, k))
 
 			if err != nil {
 				return nil, err
@@ -310,6 +313,10 @@ func (g GeneratorSetNestedBlock) CustomTypeAndValue(name string) ([]byte, error)
 	return buf.Bytes(), nil
 }
 
+func (g GeneratorSetNestedBlock) GetAssociatedExternalType() *schema.AssocExtType {
+	return g.NestedObject.AssociatedExternalType
+}
+
 func (g GeneratorSetNestedBlock) ToFromFunctions(name string) ([]byte, error) {
 	if g.NestedObject.AssociatedExternalType == nil {
 		return nil, nil
@@ -329,7 +336,7 @@ func (g GeneratorSetNestedBlock) ToFromFunctions(name string) ([]byte, error) {
 		return nil, err
 	}
 
-	toFrom := schema.NewToFromNestedObject(name, g.NestedObject.AssociatedExternalType, toFuncs, fromFuncs)
+	toFrom := schema.NewToFromNestedObject(name, g.NestedObject.AssociatedExternalType, toFuncs, fromFuncs, g.options)
 
 	b, err := toFrom.Render()
 
@@ -345,7 +352,7 @@ func (g GeneratorSetNestedBlock) ToFromFunctions(name string) ([]byte, error) {
 	// ToFrom interface.
 	for _, k := range attributeKeys {
 		if c, ok := g.NestedObject.Attributes[k].(schema.ToFrom); ok {
-			b, err := c.ToFromFunctions(k)
+			b, err := g.options.NestedToFromFunctions(c, g.options.NestedTypeName(name, k))
 
 			if err != nil {
 				return nil, err
@@ -365,3 +372,14 @@ func (g GeneratorSetNestedBlock) To() (schema.ToFromConversion, error) {
 func (g GeneratorSetNestedBlock) From() (schema.ToFromConversion, error) {
 	return schema.ToFromConversion{}, schema.NewUnimplementedError(errors.New("set nested type is not yet implemented"))
 }
+
+func (g GeneratorSetNestedBlock) Properties() schema.FieldProperties {
+	return schema.FieldProperties{
+		Computed:           g.ComputedOptionalRequired.IsComputed(),
+		Optional:           g.ComputedOptionalRequired.IsOptional(),
+		Required:           g.ComputedOptionalRequired.IsRequired(),
+		Sensitive:          g.Sensitive.IsSensitive(),
+		Description:        g.Description.Description(),
+		DeprecationMessage: g.DeprecationMessage.DeprecationMessage(),
+	}
+}
@@ -60,7 +60,7 @@ func TestGeneratorSetNestedBlock_New(t *testing.T) {
 					Attributes: generatorschema.GeneratorAttributes{
 						"bool_attribute": GeneratorBoolAttribute{
 							ComputedOptionalRequired: convert.NewComputedOptionalRequired(specschema.Optional),
-							CustomType:               convert.NewCustomTypePrimitive(nil, nil, "bool_attribute"),
+							CustomType:               convert.NewCustomTypePrimitive(nil, nil, "bool_attribute", nil),
 							Validators:               convert.NewValidators(convert.ValidatorTypeBool, specschema.CustomValidators{}),
 						},
 					},
@@ -69,7 +69,7 @@ func TestGeneratorSetNestedBlock_New(t *testing.T) {
 					generatorschema.GeneratorAttributes{
 						"bool_attribute": GeneratorBoolAttribute{
 							ComputedOptionalRequired: convert.NewComputedOptionalRequired(specschema.Optional),
-							CustomType:               convert.NewCustomTypePrimitive(nil, nil, "bool_attribute"),
+							CustomType:               convert.NewCustomTypePrimitive(nil, nil, "bool_attribute", nil),
 							Validators:               convert.NewValidators(convert.ValidatorTypeBool, specschema.CustomValidators{}),
 						},
 					},
@@ -77,6 +77,7 @@ func TestGeneratorSetNestedBlock_New(t *testing.T) {
 					nil,
 					convert.NewValidators(convert.ValidatorTypeObject, specschema.CustomValidators{}),
 					"name",
+					nil,
 				),
 				Validators: convert.NewValidators(convert.ValidatorTypeSet, specschema.CustomValidators{}),
 			},
@@ -108,6 +109,7 @@ func TestGeneratorSetNestedBlock_New(t *testing.T) {
 								convert.CustomCollectionTypeList,
 								"types.BoolType",
 								"list_attribute",
+								nil,
 							),
 							ElementType: specschema.ElementType{
 								Bool: &specschema.BoolType{},
@@ -129,6 +131,7 @@ func TestGeneratorSetNestedBlock_New(t *testing.T) {
 								convert.CustomCollectionTypeList,
 								"types.BoolType",
 								"list_attribute",
+								nil,
 							),
 							ElementType: specschema.ElementType{
 								Bool: &specschema.BoolType{},
@@ -143,6 +146,7 @@ func TestGeneratorSetNestedBlock_New(t *testing.T) {
 					nil,
 					convert.NewValidators(convert.ValidatorTypeObject, specschema.CustomValidators{}),
 					"name",
+					nil,
 				),
 				Validators: convert.NewValidators(convert.ValidatorTypeSet, specschema.CustomValidators{}),
 			},
@@ -178,7 +182,7 @@ func TestGeneratorSetNestedBlock_New(t *testing.T) {
 								Attributes: generatorschema.GeneratorAttributes{
 									"nested_bool": GeneratorBoolAttribute{
 										ComputedOptionalRequired: convert.NewComputedOptionalRequired(specschema.Computed),
-										CustomType:               convert.NewCustomTypePrimitive(nil, nil, "nested_bool"),
+										CustomType:               convert.NewCustomTypePrimitive(nil, nil, "nested_bool", nil),
 										Validators:               convert.NewValidators(convert.ValidatorTypeBool, specschema.CustomValidators{}),
 									},
 								},
@@ -188,13 +192,14 @@ func TestGeneratorSetNestedBlock_New(t *testing.T) {
 								generatorschema.GeneratorAttributes{
 									"nested_bool": GeneratorBoolAttribute{
 										ComputedOptionalRequired: convert.NewComputedOptionalRequired(specschema.Computed),
-										CustomType:               convert.NewCustomTypePrimitive(nil, nil, "nested_bool"),
+										CustomType:               convert.NewCustomTypePrimitive(nil, nil, "nested_bool", nil),
 										Validators:               convert.NewValidators(convert.ValidatorTypeBool, specschema.CustomValidators{}),
 									},
 								},
 								nil,
 								convert.NewValidators(convert.ValidatorTypeObject, specschema.CustomValidators{}),
 								"nested_attribute",
+								nil,
 							),
 							Validators: convert.NewValidators(convert.ValidatorTypeSet, specschema.CustomValidators{}),
 						},
@@ -207,7 +212,7 @@ func TestGeneratorSetNestedBlock_New(t *testing.T) {
 								Attributes: generatorschema.GeneratorAttributes{
 									"nested_bool": GeneratorBoolAttribute{
 										ComputedOptionalRequired: convert.NewComputedOptionalRequired(specschema.Computed),
-										CustomType:               convert.NewCustomTypePrimitive(nil, nil, "nested_bool"),
+										CustomType:               convert.NewCustomTypePrimitive(nil, nil, "nested_bool", nil),
 										Validators:               convert.NewValidators(convert.ValidatorTypeBool, specschema.CustomValidators{}),
 									},
 								},
@@ -217,13 +222,14 @@ func TestGeneratorSetNestedBlock_New(t *testing.T) {
 								generatorschema.GeneratorAttributes{
 									"nested_bool": GeneratorBoolAttribute{
 										ComputedOptionalRequired: convert.NewComputedOptionalRequired(specschema.Computed),
-										CustomType:               convert.NewCustomTypePrimitive(nil, nil, "nested_bool"),
+										CustomType:               convert.NewCustomTypePrimitive(nil, nil, "nested_bool", nil),
 										Validators:               convert.NewValidators(convert.ValidatorTypeBool, specschema.CustomValidators{}),
 									},
 								},
 								nil,
 								convert.NewValidators(convert.ValidatorTypeObject, specschema.CustomValidators{}),
 								"nested_attribute",
+								nil,
 							),
 							Validators: convert.NewValidators(convert.ValidatorTypeSet, specschema.CustomValidators{}),
 						},
@@ -232,6 +238,7 @@ func TestGeneratorSetNestedBlock_New(t *testing.T) {
 					nil,
 					convert.NewValidators(convert.ValidatorTypeObject, specschema.CustomValidators{}),
 					"name",
+					nil,
 				),
 				Validators: convert.NewValidators(convert.ValidatorTypeSet, specschema.CustomValidators{}),
 			},
@@ -272,7 +279,7 @@ func TestGeneratorSetNestedBlock_New(t *testing.T) {
 								},
 							}),
 							ComputedOptionalRequired: convert.NewComputedOptionalRequired(specschema.Optional),
-							CustomType:               convert.NewCustomTypeObject(nil, nil, "object_attribute"),
+							CustomType:               convert.NewCustomTypeObject(nil, nil, "object_attribute", nil),
 							Validators:               convert.NewValidators(convert.ValidatorTypeObject, specschema.CustomValidators{}),
 						},
 					},
@@ -293,7 +300,7 @@ func TestGeneratorSetNestedBlock_New(t *testing.T) {
 								},
 							}),
 							ComputedOptionalRequired: convert.NewComputedOptionalRequired(specschema.Optional),
-							CustomType:               convert.NewCustomTypeObject(nil, nil, "object_attribute"),
+							CustomType:               convert.NewCustomTypeObject(nil, nil, "object_attribute", nil),
 							Validators:               convert.NewValidators(convert.ValidatorTypeObject, specschema.CustomValidators{}),
 						},
 					},
@@ -301,6 +308,7 @@ func TestGeneratorSetNestedBlock_New(t *testing.T) {
 					nil,
 					convert.NewValidators(convert.ValidatorTypeObject, specschema.CustomValidators{}),
 					"name",
+					nil,
 				),
 				Validators: convert.NewValidators(convert.ValidatorTypeSet, specschema.CustomValidators{}),
 			},
@@ -333,12 +341,12 @@ func TestGeneratorSetNestedBlock_New(t *testing.T) {
 							Attributes: generatorschema.GeneratorAttributes{
 								"nested_bool": GeneratorBoolAttribute{
 									ComputedOptionalRequired: convert.NewComputedOptionalRequired(specschema.Computed),
-									CustomType:               convert.NewCustomTypePrimitive(nil, nil, "nested_bool"),
+									CustomType:               convert.NewCustomTypePrimitive(nil, nil, "nested_bool", nil),
 									Validators:               convert.NewValidators(convert.ValidatorTypeBool, specschema.CustomValidators{}),
 								},
 							},
 							ComputedOptionalRequired: convert.NewComputedOptionalRequired(specschema.Optional),
-							CustomType:               convert.NewCustomTypeNestedObject(nil, "nested_attribute"),
+							CustomType:               convert.NewCustomTypeNestedObject(nil, "nested_attribute", nil),
 							Validators:               convert.NewValidators(convert.ValidatorTypeObject, specschema.CustomValidators{}),
 						},
 					},
@@ -349,12 +357,12 @@ func TestGeneratorSetNestedBlock_New(t *testing.T) {
 							Attributes: generatorschema.GeneratorAttributes{
 								"nested_bool": GeneratorBoolAttribute{
 									ComputedOptionalRequired: convert.NewComputedOptionalRequired(specschema.Computed),
-									CustomType:               convert.NewCustomTypePrimitive(nil, nil, "nested_bool"),
+									CustomType:               convert.NewCustomTypePrimitive(nil, nil, "nested_bool", nil),
 									Validators:               convert.NewValidators(convert.ValidatorTypeBool, specschema.CustomValidators{}),
 								},
 							},
 							ComputedOptionalRequired: convert.NewComputedOptionalRequired(specschema.Optional),
-							CustomType:               convert.NewCustomTypeNestedObject(nil, "nested_attribute"),
+							CustomType:               convert.NewCustomTypeNestedObject(nil, "nested_attribute", nil),
 							Validators:               convert.NewValidators(convert.ValidatorTypeObject, specschema.CustomValidators{}),
 						},
 					},
@@ -362,6 +370,7 @@ func TestGeneratorSetNestedBlock_New(t *testing.T) {
 					nil,
 					convert.NewValidators(convert.ValidatorTypeObject, specschema.CustomValidators{}),
 					"name",
+					nil,
 				),
 				Validators: convert.NewValidators(convert.ValidatorTypeSet, specschema.CustomValidators{}),
 			},
@@ -412,7 +421,7 @@ func TestGeneratorSetNestedBlock_New(t *testing.T) {
 								Attributes: generatorschema.GeneratorAttributes{
 									"bool_attribute": GeneratorBoolAttribute{
 										ComputedOptionalRequired: convert.NewComputedOptionalRequired(specschema.Optional),
-										CustomType:               convert.NewCustomTypePrimitive(nil, nil, "bool_attribute"),
+										CustomType:               convert.NewCustomTypePrimitive(nil, nil, "bool_attribute", nil),
 										Validators:               convert.NewValidators(convert.ValidatorTypeBool, specschema.CustomValidators{}),
 									},
 								},
@@ -421,7 +430,7 @@ func TestGeneratorSetNestedBlock_New(t *testing.T) {
 								generatorschema.GeneratorAttributes{
 									"bool_attribute": GeneratorBoolAttribute{
 										ComputedOptionalRequired: convert.NewComputedOptionalRequired(specschema.Optional),
-										CustomType:               convert.NewCustomTypePrimitive(nil, nil, "bool_attribute"),
+										CustomType:               convert.NewCustomTypePrimitive(nil, nil, "bool_attribute", nil),
 										Validators:               convert.NewValidators(convert.ValidatorTypeBool, specschema.CustomValidators{}),
 									},
 								},
@@ -429,6 +438,7 @@ func TestGeneratorSetNestedBlock_New(t *testing.T) {
 								nil,
 								convert.NewValidators(convert.ValidatorTypeObject, specschema.CustomValidators{}),
 								"nested_block",
+								nil,
 							),
 							Validators: convert.NewValidators(convert.ValidatorTypeSet, specschema.CustomValidators{}),
 						},
@@ -442,7 +452,7 @@ func TestGeneratorSetNestedBlock_New(t *testing.T) {
 								Attributes: generatorschema.GeneratorAttributes{
 									"bool_attribute": GeneratorBoolAttribute{
 										ComputedOptionalRequired: convert.NewComputedOptionalRequired(specschema.Optional),
-										CustomType:               convert.NewCustomTypePrimitive(nil, nil, "bool_attribute"),
+										CustomType:               convert.NewCustomTypePrimitive(nil, nil, "bool_attribute", nil),
 										Validators:               convert.NewValidators(convert.ValidatorTypeBool, specschema.CustomValidators{}),
 									},
 								},
@@ -451,7 +461,7 @@ func TestGeneratorSetNestedBlock_New(t *testing.T) {
 								generatorschema.GeneratorAttributes{
 									"bool_attribute": GeneratorBoolAttribute{
 										ComputedOptionalRequired: convert.NewComputedOptionalRequired(specschema.Optional),
-										CustomType:               convert.NewCustomTypePrimitive(nil, nil, "bool_attribute"),
+										CustomType:               convert.NewCustomTypePrimitive(nil, nil, "bool_attribute", nil),
 										Validators:               convert.NewValidators(convert.ValidatorTypeBool, specschema.CustomValidators{}),
 									},
 								},
@@ -459,6 +469,7 @@ func TestGeneratorSetNestedBlock_New(t *testing.T) {
 								nil,
 								convert.NewValidators(convert.ValidatorTypeObject, specschema.CustomValidators{}),
 								"nested_block",
+								nil,
 							),
 							Validators: convert.NewValidators(convert.ValidatorTypeSet, specschema.CustomValidators{}),
 						},
@@ -466,6 +477,7 @@ func TestGeneratorSetNestedBlock_New(t *testing.T) {
 					nil,
 					convert.NewValidators(convert.ValidatorTypeObject, specschema.CustomValidators{}),
 					"name",
+					nil,
 				),
 				Validators: convert.NewValidators(convert.ValidatorTypeSet, specschema.CustomValidators{}),
 			},
@@ -498,11 +510,11 @@ func TestGeneratorSetNestedBlock_New(t *testing.T) {
 							Attributes: generatorschema.GeneratorAttributes{
 								"bool_attribute": GeneratorBoolAttribute{
 									ComputedOptionalRequired: convert.NewComputedOptionalRequired(specschema.Optional),
-									CustomType:               convert.NewCustomTypePrimitive(nil, nil, "bool_attribute"),
+									CustomType:               convert.NewCustomTypePrimitive(nil, nil, "bool_attribute", nil),
 									Validators:               convert.NewValidators(convert.ValidatorTypeBool, specschema.CustomValidators{}),
 								},
 							},
-							CustomType: convert.NewCustomTypeNestedObject(nil, "nested_block"),
+							CustomType: convert.NewCustomTypeNestedObject(nil, "nested_block", nil),
 							Validators: convert.NewValidators(convert.ValidatorTypeObject, specschema.CustomValidators{}),
 						},
 					},
@@ -514,17 +526,18 @@ func TestGeneratorSetNestedBlock_New(t *testing.T) {
 							Attributes: generatorschema.GeneratorAttributes{
 								"bool_attribute": GeneratorBoolAttribute{
 									ComputedOptionalRequired: convert.NewComputedOptionalRequired(specschema.Optional),
-									CustomType:               convert.NewCustomTypePrimitive(nil, nil, "bool_attribute"),
+									CustomType:               convert.NewCustomTypePrimitive(nil, nil, "bool_attribute", nil),
 									Validators:               convert.NewValidators(convert.ValidatorTypeBool, specschema.CustomValidators{}),
 								},
 							},
-							CustomType: convert.NewCustomTypeNestedObject(nil, "nested_block"),
+							CustomType: convert.NewCustomTypeNestedObject(nil, "nested_block", nil),
 							Validators: convert.NewValidators(convert.ValidatorTypeObject, specschema.CustomValidators{}),
 						},
 					},
 					nil,
 					convert.NewValidators(convert.ValidatorTypeObject, specschema.CustomValidators{}),
 					"name",
+					nil,
 				),
 				Validators: convert.NewValidators(convert.ValidatorTypeSet, specschema.CustomValidators{}),
 			},
@@ -554,6 +567,7 @@ func TestGeneratorSetNestedBlock_New(t *testing.T) {
 					nil,
 					convert.NewValidators(convert.ValidatorTypeObject, specschema.CustomValidators{}),
 					"name",
+					nil,
 				),
 				Validators: convert.NewValidators(convert.ValidatorTypeSet, specschema.CustomValidators{}),
 			},
@@ -571,6 +585,7 @@ func TestGeneratorSetNestedBlock_New(t *testing.T) {
 					nil,
 					convert.NewValidators(convert.ValidatorTypeObject, specschema.CustomValidators{}),
 					"name",
+					nil,
 				),
 				Validators: convert.NewValidators(convert.ValidatorTypeSet, specschema.CustomValidators{}),
 			},
@@ -588,6 +603,7 @@ func TestGeneratorSetNestedBlock_New(t *testing.T) {
 					nil,
 					convert.NewValidators(convert.ValidatorTypeObject, specschema.CustomValidators{}),
 					"name",
+					nil,
 				),
 				Validators: convert.NewValidators(convert.ValidatorTypeSet, specschema.CustomValidators{}),
 			},
@@ -614,6 +630,7 @@ func TestGeneratorSetNestedBlock_New(t *testing.T) {
 					nil,
 					convert.NewValidators(convert.ValidatorTypeObject, specschema.CustomValidators{}),
 					"name",
+					nil,
 				),
 				Validators: convert.NewValidators(convert.ValidatorTypeSet, specschema.CustomValidators{
 					&specschema.CustomValidator{
@@ -634,7 +651,7 @@ func TestGeneratorSetNestedBlock_New(t *testing.T) {
 		t.Run(name, func(t *testing.T) {
 			t.Parallel()
 
-			got, err := NewGeneratorSetNestedBlock("name", testCase.input)
+			got, err := NewGeneratorSetNestedBlock("name", testCase.input, nil)
 
 			if diff := cmp.Diff(err, testCase.expectedError, equateErrorMessage); diff != "" {
 				t.Errorf("unexpected error: %s", diff)
@@ -704,6 +721,7 @@ func TestGeneratorSetNestedBlock_Imports(t *testing.T) {
 					&specschema.CustomType{},
 					convert.NewValidators(convert.ValidatorTypeObject, nil),
 					"",
+					nil,
 				),
 			},
 			expected: []code.Import{
@@ -769,6 +787,7 @@ func TestGeneratorSetNestedBlock_Imports(t *testing.T) {
 					},
 					convert.NewValidators(convert.ValidatorTypeObject, nil),
 					"",
+					nil,
 				),
 			},
 			expected: []code.Import{
@@ -811,6 +830,7 @@ func TestGeneratorSetNestedBlock_Imports(t *testing.T) {
 					},
 					convert.Validators{},
 					"",
+					nil,
 				),
 			},
 			expected: []code.Import{
@@ -844,6 +864,7 @@ func TestGeneratorSetNestedBlock_Imports(t *testing.T) {
 					},
 					convert.NewValidators(convert.ValidatorTypeObject, nil),
 					"",
+					nil,
 				),
 			},
 			expected: []code.Import{
@@ -894,6 +915,7 @@ func TestGeneratorSetNestedBlock_Imports(t *testing.T) {
 								convert.CustomCollectionTypeList,
 								"",
 								"",
+								nil,
 							),
 						},
 					},
@@ -901,6 +923,7 @@ func TestGeneratorSetNestedBlock_Imports(t *testing.T) {
 					nil,
 					convert.Validators{},
 					"",
+					nil,
 				),
 			},
 			expected: []code.Import{
@@ -930,6 +953,7 @@ func TestGeneratorSetNestedBlock_Imports(t *testing.T) {
 								convert.CustomCollectionTypeList,
 								"",
 								"",
+								nil,
 							),
 							ElementTypeCollection: convert.NewElementType(specschema.ElementType{
 								Bool: &specschema.BoolType{
@@ -946,6 +970,7 @@ func TestGeneratorSetNestedBlock_Imports(t *testing.T) {
 					nil,
 					convert.Validators{},
 					"",
+					nil,
 				),
 			},
 			expected: []code.Import{
@@ -1000,6 +1025,7 @@ func TestGeneratorSetNestedBlock_Imports(t *testing.T) {
 								},
 								nil,
 								"",
+								nil,
 							),
 						},
 					},
@@ -1007,6 +1033,7 @@ func TestGeneratorSetNestedBlock_Imports(t *testing.T) {
 					nil,
 					convert.Validators{},
 					"",
+					nil,
 				),
 			},
 			expected: []code.Import{
@@ -1046,6 +1073,7 @@ func TestGeneratorSetNestedBlock_Imports(t *testing.T) {
 								},
 								nil,
 								"",
+								nil,
 							),
 						},
 					},
@@ -1053,6 +1081,7 @@ func TestGeneratorSetNestedBlock_Imports(t *testing.T) {
 					nil,
 					convert.Validators{},
 					"",
+					nil,
 				),
 			},
 			expected: []code.Import{
@@ -1088,6 +1117,7 @@ func TestGeneratorSetNestedBlock_Imports(t *testing.T) {
 					nil,
 					convert.Validators{},
 					"",
+					nil,
 				),
 			},
 			expected: []code.Import{
@@ -1196,6 +1226,7 @@ func TestGeneratorSetNestedBlock_Imports(t *testing.T) {
 					nil,
 					convert.NewValidators(convert.ValidatorTypeObject, nil),
 					"",
+					nil,
 				),
 			},
 			expected: []code.Import{
@@ -1217,6 +1248,7 @@ func TestGeneratorSetNestedBlock_Imports(t *testing.T) {
 						&specschema.CustomValidator{},
 					}),
 					"",
+					nil,
 				),
 			},
 			expected: []code.Import{
@@ -1244,6 +1276,7 @@ func TestGeneratorSetNestedBlock_Imports(t *testing.T) {
 						},
 					}),
 					"",
+					nil,
 				),
 			},
 			expected: []code.Import{
@@ -1278,6 +1311,7 @@ func TestGeneratorSetNestedBlock_Imports(t *testing.T) {
 						},
 					}),
 					"",
+					nil,
 				),
 			},
 			expected: []code.Import{
@@ -1336,6 +1370,7 @@ func TestGeneratorSetNestedBlock_Schema(t *testing.T) {
 					nil,
 					convert.NewValidators(convert.ValidatorTypeObject, specschema.CustomValidators{}),
 					blockName,
+					nil,
 				),
 			},
 			expected: `"set_nested_block": schema.SetNestedBlock{
@@ -1369,6 +1404,7 @@ AttrTypes: SetNestedBlockValue{}.AttributeTypes(ctx),
 					nil,
 					convert.NewValidators(convert.ValidatorTypeObject, specschema.CustomValidators{}),
 					blockName,
+					nil,
 				),
 			},
 			expected: `"set_nested_block": schema.SetNestedBlock{
@@ -1402,6 +1438,7 @@ AttrTypes: SetNestedBlockValue{}.AttributeTypes(ctx),
 								nil,
 								convert.NewValidators(convert.ValidatorTypeObject, nil),
 								"nested_list_nested",
+								nil,
 							),
 						},
 					},
@@ -1409,6 +1446,7 @@ AttrTypes: SetNestedBlockValue{}.AttributeTypes(ctx),
 					nil,
 					convert.NewValidators(convert.ValidatorTypeObject, specschema.CustomValidators{}),
 					blockName,
+					nil,
 				),
 			},
 			expected: `"set_nested_block": schema.SetNestedBlock{
@@ -1456,6 +1494,7 @@ AttrTypes: SetNestedBlockValue{}.AttributeTypes(ctx),
 					nil,
 					convert.NewValidators(convert.ValidatorTypeObject, specschema.CustomValidators{}),
 					blockName,
+					nil,
 				),
 			},
 			expected: `"set_nested_block": schema.SetNestedBlock{
@@ -1487,13 +1526,14 @@ AttrTypes: SetNestedBlockValue{}.AttributeTypes(ctx),
 									ComputedOptionalRequired: convert.NewComputedOptionalRequired(specschema.Optional),
 								},
 							},
-							CustomType: convert.NewCustomTypeNestedObject(nil, "nested_single_nested"),
+							CustomType: convert.NewCustomTypeNestedObject(nil, "nested_single_nested", nil),
 						},
 					},
 					generatorschema.GeneratorBlocks{},
 					nil,
 					convert.NewValidators(convert.ValidatorTypeObject, specschema.CustomValidators{}),
 					blockName,
+					nil,
 				),
 			},
 			expected: `"set_nested_block": schema.SetNestedBlock{
@@ -1537,12 +1577,14 @@ AttrTypes: SetNestedBlockValue{}.AttributeTypes(ctx),
 								nil,
 								convert.NewValidators(convert.ValidatorTypeObject, specschema.CustomValidators{}),
 								"nested_list_nested",
+								nil,
 							),
 						},
 					},
 					nil,
 					convert.NewValidators(convert.ValidatorTypeObject, specschema.CustomValidators{}),
 					blockName,
+					nil,
 				),
 			},
 			expected: `"set_nested_block": schema.SetNestedBlock{
@@ -1583,12 +1625,13 @@ AttrTypes: SetNestedBlockValue{}.AttributeTypes(ctx),
 									ComputedOptionalRequired: convert.NewComputedOptionalRequired(specschema.Optional),
 								},
 							},
-							CustomType: convert.NewCustomTypeNestedObject(nil, "nested_single_nested"),
+							CustomType: convert.NewCustomTypeNestedObject(nil, "nested_single_nested", nil),
 						},
 					},
 					nil,
 					convert.NewValidators(convert.ValidatorTypeObject, specschema.CustomValidators{}),
 					blockName,
+					nil,
 				),
 			},
 			expected: `"set_nested_block": schema.SetNestedBlock{
@@ -1627,6 +1670,7 @@ AttrTypes: SetNestedBlockValue{}.AttributeTypes(ctx),
 					nil,
 					convert.NewValidators(convert.ValidatorTypeObject, specschema.CustomValidators{}),
 					blockName,
+					nil,
 				),
 			},
 			expected: `"set_nested_block": schema.SetNestedBlock{
@@ -1650,6 +1694,7 @@ CustomType: my_custom_type,
 					nil,
 					convert.NewValidators(convert.ValidatorTypeObject, specschema.CustomValidators{}),
 					blockName,
+					nil,
 				),
 			},
 			expected: `"set_nested_block": schema.SetNestedBlock{
@@ -1674,6 +1719,7 @@ MarkdownDescription: "description",
 					nil,
 					convert.NewValidators(convert.ValidatorTypeObject, specschema.CustomValidators{}),
 					blockName,
+					nil,
 				),
 			},
 			expected: `"set_nested_block": schema.SetNestedBlock{
@@ -1696,6 +1742,7 @@ DeprecationMessage: "deprecated",
 					nil,
 					convert.NewValidators(convert.ValidatorTypeObject, specschema.CustomValidators{}),
 					blockName,
+					nil,
 				),
 				Validators: convert.NewValidators(convert.ValidatorTypeSet, specschema.CustomValidators{
 					&specschema.CustomValidator{
@@ -1731,6 +1778,7 @@ my_other_validator.Validate(),
 					},
 					convert.NewValidators(convert.ValidatorTypeObject, specschema.CustomValidators{}),
 					blockName,
+					nil,
 				),
 			},
 			expected: `"set_nested_block": schema.SetNestedBlock{
@@ -1755,6 +1803,7 @@ CustomType: my_custom_type,
 						},
 					}),
 					blockName,
+					nil,
 				),
 			},
 			expected: `"set_nested_block": schema.SetNestedBlock{
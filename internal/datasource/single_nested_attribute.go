@@ -24,14 +24,16 @@ type GeneratorSingleNestedAttribute struct {
 	Description              convert.Description
 	Sensitive                convert.Sensitive
 	Validators               convert.Validators
+
+	options *schema.Options
 }
 
-func NewGeneratorSingleNestedAttribute(name string, a *datasource.SingleNestedAttribute) (GeneratorSingleNestedAttribute, error) {
+func NewGeneratorSingleNestedAttribute(name string, a *datasource.SingleNestedAttribute, opts *schema.Options) (GeneratorSingleNestedAttribute, error) {
 	if a == nil {
 		return GeneratorSingleNestedAttribute{}, fmt.Errorf("*datasource.SingleNestedAttribute is nil")
 	}
 
-	attributes, err := NewAttributes(a.Attributes)
+	attributes, err := NewAttributes(a.Attributes, name, opts)
 
 	if err != nil {
 		return GeneratorSingleNestedAttribute{}, err
@@ -39,7 +41,7 @@ func NewGeneratorSingleNestedAttribute(name string, a *datasource.SingleNestedAt
 
 	c := convert.NewComputedOptionalRequired(a.ComputedOptionalRequired)
 
-	ct := convert.NewCustomTypeNestedObject(a.CustomType, name)
+	ct := convert.NewCustomTypeNestedObject(a.CustomType, name, opts)
 
 	d := convert.NewDescription(a.Description)
 
@@ -50,7 +52,7 @@ func NewGeneratorSingleNestedAttribute(name string, a *datasource.SingleNestedAt
 	v := convert.NewValidators(convert.ValidatorTypeObject, a.Validators.CustomValidators())
 
 	return GeneratorSingleNestedAttribute{
-		AssociatedExternalType:   schema.NewAssocExtType(a.AssociatedExternalType),
+		AssociatedExternalType:   schema.NewAssocExtType(a.AssociatedExternalType, opts),
 		Attributes:               attributes,
 		ComputedOptionalRequired: c,
 		CustomType:               ct,
@@ -58,6 +60,7 @@ func NewGeneratorSingleNestedAttribute(name string, a *datasource.SingleNestedAt
 		Description:              d,
 		Sensitive:                s,
 		Validators:               v,
+		options:                  opts,
 	}, nil
 }
 
@@ -145,9 +148,9 @@ func (g GeneratorSingleNestedAttribute) Schema(name schema.FrameworkIdentifier)
 
 func (g GeneratorSingleNestedAttribute) ModelField(name schema.FrameworkIdentifier) (model.Field, error) {
 	f := model.Field{
-		Name:      name.ToPascalCase(),
+		Name:      g.options.ToPascalCase(name),
 		TfsdkName: name.ToString(),
-		ValueType: name.ToPascalCase() + "Value",
+		ValueType: g.options.TypeReference(string(name)) + "Value",
 	}
 
 	customValueType := g.CustomType.ValueType()
@@ -166,13 +169,13 @@ func (g GeneratorSingleNestedAttribute) GetAttributes() schema.GeneratorAttribut
 func (g GeneratorSingleNestedAttribute) CustomTypeAndValue(name string) ([]byte, error) {
 	var buf bytes.Buffer
 
-	attributeAttrValues, err := g.Attributes.AttrValues()
+	attributeAttrValues, err := g.Attributes.AttrValues(name, g.options)
 
 	if err != nil {
 		return nil, err
 	}
 
-	objectType := schema.NewCustomNestedObjectType(name, attributeAttrValues)
+	objectType := schema.NewCustomNestedObjectType(name, attributeAttrValues, g.options)
 
 	b, err := objectType.Render()
 
@@ -188,7 +191,7 @@ func (g GeneratorSingleNestedAttribute) CustomTypeAndValue(name string) ([]byte,
 		return nil, err
 	}
 
-	attributeAttrTypes, err := g.Attributes.AttrTypes()
+	attributeAttrTypes, err := g.Attributes.AttrTypes(name, g.options)
 
 	if err != nil {
 		return nil, err
@@ -200,7 +203,7 @@ func (g GeneratorSingleNestedAttribute) CustomTypeAndValue(name string) ([]byte,
 		return nil, err
 	}
 
-	objectValue := schema.NewCustomNestedObjectValue(name, attributeTypes, attributeAttrTypes, attributeAttrValues, attributeCollectionTypes)
+	objectValue := schema.NewCustomNestedObjectValue(name, attributeTypes, attributeAttrTypes, attributeAttrValues, attributeCollectionTypes, g.options)
 
 	b, err = objectValue.Render()
 
@@ -216,7 +219,7 @@ func (g GeneratorSingleNestedAttribute) CustomTypeAndValue(name string) ([]byte,
 	// CustomTypeAndValue interface (i.e, nested attributes).
 	for _, k := range attributeKeys {
 		if c, ok := g.Attributes[k].(schema.CustomTypeAndValue); ok {
-			b, err := c.CustomTypeAndValue(k)
+			b, err := g.options.NestedCustomTypeAndValue(c, g.options.NestedTypeName(name, k))
 
 			if err != nil {
 				return nil, err
@@ -229,6 +232,10 @@ func (g GeneratorSingleNestedAttribute) CustomTypeAndValue(name string) ([]byte,
 	return buf.Bytes(), nil
 }
 
+func (g GeneratorSingleNestedAttribute) GetAssociatedExternalType() *schema.AssocExtType {
+	return g.AssociatedExternalType
+}
+
 func (g GeneratorSingleNestedAttribute) ToFromFunctions(name string) ([]byte, error) {
 	if g.AssociatedExternalType == nil {
 		return nil, nil
@@ -244,7 +251,7 @@ func (g GeneratorSingleNestedAttribute) ToFromFunctions(name string) ([]byte, er
 
 	fromFuncs, _ := g.Attributes.FromFuncs()
 
-	toFrom := schema.NewToFromNestedObject(name, g.AssociatedExternalType, toFuncs, fromFuncs)
+	toFrom := schema.NewToFromNestedObject(name, g.AssociatedExternalType, toFuncs, fromFuncs, g.options)
 
 	b, err := toFrom.Render()
 
@@ -260,7 +267,7 @@ func (g GeneratorSingleNestedAttribute) ToFromFunctions(name string) ([]byte, er
 	// ToFrom interface.
 	for _, k := range attributeKeys {
 		if c, ok := g.Attributes[k].(schema.ToFrom); ok {
-			b, err := c.ToFromFunctions(k)
+			b, err := g.options.NestedToFromFunctions(c, g.options.NestedTypeName(name, k))
 
 			if err != nil {
 				return nil, err
@@ -280,3 +287,27 @@ func (g GeneratorSingleNestedAttribute) To() (schema.ToFromConversion, error) {
 func (g GeneratorSingleNestedAttribute) From() (schema.ToFromConversion, error) {
 	return schema.ToFromConversion{}, schema.NewUnimplementedError(errors.New("single nested type is not yet implemented"))
 }
+
+// ModelToFrom returns the conversion used for the attribute when it is a field of the
+// top-level data model, in which case the field is a custom value type which
+// implements conversion to and from the associated external type.
+func (g GeneratorSingleNestedAttribute) ModelToFrom() (schema.ToFromConversion, error) {
+	if g.AssociatedExternalType == nil {
+		return schema.ToFromConversion{}, schema.NewUnimplementedError(errors.New("single nested type without an associated external type is not yet implemented"))
+	}
+
+	return schema.ToFromConversion{
+		AssocExtType: g.AssociatedExternalType,
+	}, nil
+}
+
+func (g GeneratorSingleNestedAttribute) Properties() schema.FieldProperties {
+	return schema.FieldProperties{
+		Computed:           g.ComputedOptionalRequired.IsComputed(),
+		Optional:           g.ComputedOptionalRequired.IsOptional(),
+		Required:           g.ComputedOptionalRequired.IsRequired(),
+		Sensitive:          g.Sensitive.IsSensitive(),
+		Description:        g.Description.Description(),
+		DeprecationMessage: g.DeprecationMessage.DeprecationMessage(),
+	}
+}
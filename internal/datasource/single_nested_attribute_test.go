@@ -20,7 +20,7 @@ import (
 func TestGeneratorSingleNestedAttribute_New(t *testing.T) {
 	t.Parallel()
 
-	attributes, err := NewAttributes(datasource.Attributes{})
+	attributes, err := NewAttributes(datasource.Attributes{}, "", nil)
 
 	if err != nil {
 		t.Error(err)
@@ -61,11 +61,11 @@ func TestGeneratorSingleNestedAttribute_New(t *testing.T) {
 				Attributes: generatorschema.GeneratorAttributes{
 					"bool_attribute": GeneratorBoolAttribute{
 						ComputedOptionalRequired: convert.NewComputedOptionalRequired(specschema.Optional),
-						CustomType:               convert.NewCustomTypePrimitive(nil, nil, "bool_attribute"),
+						CustomType:               convert.NewCustomTypePrimitive(nil, nil, "bool_attribute", nil),
 						Validators:               convert.NewValidators(convert.ValidatorTypeBool, specschema.CustomValidators{}),
 					},
 				},
-				CustomType: convert.NewCustomTypeNestedObject(nil, "name"),
+				CustomType: convert.NewCustomTypeNestedObject(nil, "name", nil),
 				Validators: convert.NewValidators(convert.ValidatorTypeObject, specschema.CustomValidators{}),
 			},
 		},
@@ -93,6 +93,7 @@ func TestGeneratorSingleNestedAttribute_New(t *testing.T) {
 							convert.CustomCollectionTypeList,
 							"types.BoolType",
 							"list_attribute",
+							nil,
 						),
 						ElementType: specschema.ElementType{
 							Bool: &specschema.BoolType{},
@@ -103,7 +104,7 @@ func TestGeneratorSingleNestedAttribute_New(t *testing.T) {
 						Validators: convert.NewValidators(convert.ValidatorTypeList, specschema.CustomValidators{}),
 					},
 				},
-				CustomType: convert.NewCustomTypeNestedObject(nil, "name"),
+				CustomType: convert.NewCustomTypeNestedObject(nil, "name", nil),
 				Validators: convert.NewValidators(convert.ValidatorTypeObject, specschema.CustomValidators{}),
 			},
 		},
@@ -135,7 +136,7 @@ func TestGeneratorSingleNestedAttribute_New(t *testing.T) {
 							Attributes: generatorschema.GeneratorAttributes{
 								"nested_bool": GeneratorBoolAttribute{
 									ComputedOptionalRequired: convert.NewComputedOptionalRequired(specschema.Computed),
-									CustomType:               convert.NewCustomTypePrimitive(nil, nil, "nested_bool"),
+									CustomType:               convert.NewCustomTypePrimitive(nil, nil, "nested_bool", nil),
 									Validators:               convert.NewValidators(convert.ValidatorTypeBool, specschema.CustomValidators{}),
 								},
 							},
@@ -145,18 +146,19 @@ func TestGeneratorSingleNestedAttribute_New(t *testing.T) {
 							generatorschema.GeneratorAttributes{
 								"nested_bool": GeneratorBoolAttribute{
 									ComputedOptionalRequired: convert.NewComputedOptionalRequired(specschema.Computed),
-									CustomType:               convert.NewCustomTypePrimitive(nil, nil, "nested_bool"),
+									CustomType:               convert.NewCustomTypePrimitive(nil, nil, "nested_bool", nil),
 									Validators:               convert.NewValidators(convert.ValidatorTypeBool, specschema.CustomValidators{}),
 								},
 							},
 							nil,
 							convert.NewValidators(convert.ValidatorTypeObject, specschema.CustomValidators{}),
 							"nested_attribute",
+							nil,
 						),
 						Validators: convert.NewValidators(convert.ValidatorTypeList, specschema.CustomValidators{}),
 					},
 				},
-				CustomType: convert.NewCustomTypeNestedObject(nil, "name"),
+				CustomType: convert.NewCustomTypeNestedObject(nil, "name", nil),
 				Validators: convert.NewValidators(convert.ValidatorTypeObject, specschema.CustomValidators{}),
 			},
 		},
@@ -193,11 +195,11 @@ func TestGeneratorSingleNestedAttribute_New(t *testing.T) {
 							},
 						}),
 						ComputedOptionalRequired: convert.NewComputedOptionalRequired(specschema.Optional),
-						CustomType:               convert.NewCustomTypeObject(nil, nil, "object_attribute"),
+						CustomType:               convert.NewCustomTypeObject(nil, nil, "object_attribute", nil),
 						Validators:               convert.NewValidators(convert.ValidatorTypeObject, specschema.CustomValidators{}),
 					},
 				},
-				CustomType: convert.NewCustomTypeNestedObject(nil, "name"),
+				CustomType: convert.NewCustomTypeNestedObject(nil, "name", nil),
 				Validators: convert.NewValidators(convert.ValidatorTypeObject, specschema.CustomValidators{}),
 			},
 		},
@@ -226,16 +228,16 @@ func TestGeneratorSingleNestedAttribute_New(t *testing.T) {
 						Attributes: generatorschema.GeneratorAttributes{
 							"nested_bool": GeneratorBoolAttribute{
 								ComputedOptionalRequired: convert.NewComputedOptionalRequired(specschema.Computed),
-								CustomType:               convert.NewCustomTypePrimitive(nil, nil, "nested_bool"),
+								CustomType:               convert.NewCustomTypePrimitive(nil, nil, "nested_bool", nil),
 								Validators:               convert.NewValidators(convert.ValidatorTypeBool, specschema.CustomValidators{}),
 							},
 						},
 						ComputedOptionalRequired: convert.NewComputedOptionalRequired(specschema.Optional),
-						CustomType:               convert.NewCustomTypeNestedObject(nil, "nested_attribute"),
+						CustomType:               convert.NewCustomTypeNestedObject(nil, "nested_attribute", nil),
 						Validators:               convert.NewValidators(convert.ValidatorTypeObject, specschema.CustomValidators{}),
 					},
 				},
-				CustomType: convert.NewCustomTypeNestedObject(nil, "name"),
+				CustomType: convert.NewCustomTypeNestedObject(nil, "name", nil),
 				Validators: convert.NewValidators(convert.ValidatorTypeObject, nil),
 			},
 		},
@@ -245,7 +247,7 @@ func TestGeneratorSingleNestedAttribute_New(t *testing.T) {
 			},
 			expected: GeneratorSingleNestedAttribute{
 				ComputedOptionalRequired: convert.NewComputedOptionalRequired(specschema.Computed),
-				CustomType:               convert.NewCustomTypeNestedObject(nil, "name"),
+				CustomType:               convert.NewCustomTypeNestedObject(nil, "name", nil),
 				Validators:               convert.NewValidators(convert.ValidatorTypeObject, specschema.CustomValidators{}),
 			},
 		},
@@ -255,7 +257,7 @@ func TestGeneratorSingleNestedAttribute_New(t *testing.T) {
 			},
 			expected: GeneratorSingleNestedAttribute{
 				ComputedOptionalRequired: convert.NewComputedOptionalRequired(specschema.ComputedOptional),
-				CustomType:               convert.NewCustomTypeNestedObject(nil, "name"),
+				CustomType:               convert.NewCustomTypeNestedObject(nil, "name", nil),
 				Validators:               convert.NewValidators(convert.ValidatorTypeObject, specschema.CustomValidators{}),
 			},
 		},
@@ -265,7 +267,7 @@ func TestGeneratorSingleNestedAttribute_New(t *testing.T) {
 			},
 			expected: GeneratorSingleNestedAttribute{
 				ComputedOptionalRequired: convert.NewComputedOptionalRequired(specschema.Optional),
-				CustomType:               convert.NewCustomTypeNestedObject(nil, "name"),
+				CustomType:               convert.NewCustomTypeNestedObject(nil, "name", nil),
 				Validators:               convert.NewValidators(convert.ValidatorTypeObject, specschema.CustomValidators{}),
 			},
 		},
@@ -275,7 +277,7 @@ func TestGeneratorSingleNestedAttribute_New(t *testing.T) {
 			},
 			expected: GeneratorSingleNestedAttribute{
 				ComputedOptionalRequired: convert.NewComputedOptionalRequired(specschema.Required),
-				CustomType:               convert.NewCustomTypeNestedObject(nil, "name"),
+				CustomType:               convert.NewCustomTypeNestedObject(nil, "name", nil),
 				Validators:               convert.NewValidators(convert.ValidatorTypeObject, specschema.CustomValidators{}),
 			},
 		},
@@ -297,7 +299,7 @@ func TestGeneratorSingleNestedAttribute_New(t *testing.T) {
 					},
 					Type:      "my_type",
 					ValueType: "myvalue_type",
-				}, "name"),
+				}, "name", nil),
 				Validators: convert.NewValidators(convert.ValidatorTypeObject, specschema.CustomValidators{}),
 			},
 		},
@@ -307,7 +309,7 @@ func TestGeneratorSingleNestedAttribute_New(t *testing.T) {
 			},
 			expected: GeneratorSingleNestedAttribute{
 				Attributes:         attributes,
-				CustomType:         convert.NewCustomTypeNestedObject(nil, "name"),
+				CustomType:         convert.NewCustomTypeNestedObject(nil, "name", nil),
 				DeprecationMessage: convert.NewDeprecationMessage(pointer("deprecation message")),
 				Validators:         convert.NewValidators(convert.ValidatorTypeObject, specschema.CustomValidators{}),
 			},
@@ -318,7 +320,7 @@ func TestGeneratorSingleNestedAttribute_New(t *testing.T) {
 			},
 			expected: GeneratorSingleNestedAttribute{
 				Attributes:  attributes,
-				CustomType:  convert.NewCustomTypeNestedObject(nil, "name"),
+				CustomType:  convert.NewCustomTypeNestedObject(nil, "name", nil),
 				Description: convert.NewDescription(pointer("description")),
 				Validators:  convert.NewValidators(convert.ValidatorTypeObject, specschema.CustomValidators{}),
 			},
@@ -329,7 +331,7 @@ func TestGeneratorSingleNestedAttribute_New(t *testing.T) {
 			},
 			expected: GeneratorSingleNestedAttribute{
 				Attributes: attributes,
-				CustomType: convert.NewCustomTypeNestedObject(nil, "name"),
+				CustomType: convert.NewCustomTypeNestedObject(nil, "name", nil),
 				Sensitive:  convert.NewSensitive(pointer(true)),
 				Validators: convert.NewValidators(convert.ValidatorTypeObject, specschema.CustomValidators{}),
 			},
@@ -351,7 +353,7 @@ func TestGeneratorSingleNestedAttribute_New(t *testing.T) {
 			},
 			expected: GeneratorSingleNestedAttribute{
 				Attributes: attributes,
-				CustomType: convert.NewCustomTypeNestedObject(nil, "name"),
+				CustomType: convert.NewCustomTypeNestedObject(nil, "name", nil),
 				Validators: convert.NewValidators(convert.ValidatorTypeObject, specschema.CustomValidators{
 					&specschema.CustomValidator{
 						Imports: []code.Import{
@@ -371,7 +373,7 @@ func TestGeneratorSingleNestedAttribute_New(t *testing.T) {
 		t.Run(name, func(t *testing.T) {
 			t.Parallel()
 
-			got, err := NewGeneratorSingleNestedAttribute("name", testCase.input)
+			got, err := NewGeneratorSingleNestedAttribute("name", testCase.input, nil)
 
 			if diff := cmp.Diff(err, testCase.expectedError, equateErrorMessage); diff != "" {
 				t.Errorf("unexpected error: %s", diff)
@@ -406,6 +408,7 @@ func TestGeneratorSingleNestedAttribute_Imports(t *testing.T) {
 				CustomType: convert.NewCustomTypeNestedObject(
 					&specschema.CustomType{},
 					"",
+					nil,
 				),
 			},
 			expected: []code.Import{
@@ -423,6 +426,7 @@ func TestGeneratorSingleNestedAttribute_Imports(t *testing.T) {
 						},
 					},
 					"",
+					nil,
 				),
 			},
 			expected: []code.Import{
@@ -440,6 +444,7 @@ func TestGeneratorSingleNestedAttribute_Imports(t *testing.T) {
 						},
 					},
 					"",
+					nil,
 				),
 			},
 			expected: []code.Import{
@@ -484,6 +489,7 @@ func TestGeneratorSingleNestedAttribute_Imports(t *testing.T) {
 							convert.CustomCollectionTypeList,
 							"",
 							"",
+							nil,
 						),
 					},
 				},
@@ -514,6 +520,7 @@ func TestGeneratorSingleNestedAttribute_Imports(t *testing.T) {
 							convert.CustomCollectionTypeList,
 							"",
 							"",
+							nil,
 						),
 						ElementTypeCollection: convert.NewElementType(specschema.ElementType{
 							Bool: &specschema.BoolType{
@@ -576,6 +583,7 @@ func TestGeneratorSingleNestedAttribute_Imports(t *testing.T) {
 							},
 							nil,
 							"",
+							nil,
 						),
 					},
 				},
@@ -616,6 +624,7 @@ func TestGeneratorSingleNestedAttribute_Imports(t *testing.T) {
 							},
 							nil,
 							"",
+							nil,
 						),
 					},
 				},
@@ -766,7 +775,7 @@ func TestGeneratorSingleNestedAttribute_Schema(t *testing.T) {
 						ComputedOptionalRequired: convert.NewComputedOptionalRequired(specschema.Optional),
 					},
 				},
-				CustomType: convert.NewCustomTypeNestedObject(nil, attributeName),
+				CustomType: convert.NewCustomTypeNestedObject(nil, attributeName, nil),
 			},
 			expected: `"single_nested_attribute": schema.SingleNestedAttribute{
 Attributes: map[string]schema.Attribute{
@@ -792,7 +801,7 @@ AttrTypes: SingleNestedAttributeValue{}.AttributeTypes(ctx),
 						}),
 					},
 				},
-				CustomType: convert.NewCustomTypeNestedObject(nil, attributeName),
+				CustomType: convert.NewCustomTypeNestedObject(nil, attributeName, nil),
 			},
 			expected: `"single_nested_attribute": schema.SingleNestedAttribute{
 Attributes: map[string]schema.Attribute{
@@ -822,10 +831,11 @@ AttrTypes: SingleNestedAttributeValue{}.AttributeTypes(ctx),
 							nil,
 							convert.NewValidators(convert.ValidatorTypeObject, nil),
 							"nested_list_nested",
+							nil,
 						),
 					},
 				},
-				CustomType: convert.NewCustomTypeNestedObject(nil, attributeName),
+				CustomType: convert.NewCustomTypeNestedObject(nil, attributeName, nil),
 			},
 			expected: `"single_nested_attribute": schema.SingleNestedAttribute{
 Attributes: map[string]schema.Attribute{
@@ -865,7 +875,7 @@ AttrTypes: SingleNestedAttributeValue{}.AttributeTypes(ctx),
 						ComputedOptionalRequired: convert.NewComputedOptionalRequired(specschema.Optional),
 					},
 				},
-				CustomType: convert.NewCustomTypeNestedObject(nil, attributeName),
+				CustomType: convert.NewCustomTypeNestedObject(nil, attributeName, nil),
 			},
 			expected: `"single_nested_attribute": schema.SingleNestedAttribute{
 Attributes: map[string]schema.Attribute{
@@ -893,10 +903,10 @@ AttrTypes: SingleNestedAttributeValue{}.AttributeTypes(ctx),
 								ComputedOptionalRequired: convert.NewComputedOptionalRequired(specschema.Optional),
 							},
 						},
-						CustomType: convert.NewCustomTypeNestedObject(nil, "nested_single_nested"),
+						CustomType: convert.NewCustomTypeNestedObject(nil, "nested_single_nested", nil),
 					},
 				},
-				CustomType: convert.NewCustomTypeNestedObject(nil, attributeName),
+				CustomType: convert.NewCustomTypeNestedObject(nil, attributeName, nil),
 			},
 			expected: `"single_nested_attribute": schema.SingleNestedAttribute{
 Attributes: map[string]schema.Attribute{
@@ -925,7 +935,7 @@ AttrTypes: SingleNestedAttributeValue{}.AttributeTypes(ctx),
 			input: GeneratorSingleNestedAttribute{
 				CustomType: convert.NewCustomTypeNestedObject(&specschema.CustomType{
 					Type: "my_custom_type",
-				}, attributeName),
+				}, attributeName, nil),
 			},
 			expected: `"single_nested_attribute": schema.SingleNestedAttribute{
 Attributes: map[string]schema.Attribute{
@@ -937,7 +947,7 @@ CustomType: my_custom_type,
 		"required": {
 			input: GeneratorSingleNestedAttribute{
 				ComputedOptionalRequired: convert.NewComputedOptionalRequired(specschema.Required),
-				CustomType:               convert.NewCustomTypeNestedObject(nil, attributeName),
+				CustomType:               convert.NewCustomTypeNestedObject(nil, attributeName, nil),
 			},
 			expected: `"single_nested_attribute": schema.SingleNestedAttribute{
 Attributes: map[string]schema.Attribute{
@@ -954,7 +964,7 @@ Required: true,
 		"optional": {
 			input: GeneratorSingleNestedAttribute{
 				ComputedOptionalRequired: convert.NewComputedOptionalRequired(specschema.Optional),
-				CustomType:               convert.NewCustomTypeNestedObject(nil, attributeName),
+				CustomType:               convert.NewCustomTypeNestedObject(nil, attributeName, nil),
 			},
 			expected: `"single_nested_attribute": schema.SingleNestedAttribute{
 Attributes: map[string]schema.Attribute{
@@ -971,7 +981,7 @@ Optional: true,
 		"computed": {
 			input: GeneratorSingleNestedAttribute{
 				ComputedOptionalRequired: convert.NewComputedOptionalRequired(specschema.Computed),
-				CustomType:               convert.NewCustomTypeNestedObject(nil, attributeName),
+				CustomType:               convert.NewCustomTypeNestedObject(nil, attributeName, nil),
 			},
 			expected: `"single_nested_attribute": schema.SingleNestedAttribute{
 Attributes: map[string]schema.Attribute{
@@ -987,7 +997,7 @@ Computed: true,
 
 		"sensitive": {
 			input: GeneratorSingleNestedAttribute{
-				CustomType: convert.NewCustomTypeNestedObject(nil, attributeName),
+				CustomType: convert.NewCustomTypeNestedObject(nil, attributeName, nil),
 				Sensitive:  convert.NewSensitive(pointer(true)),
 			},
 			expected: `"single_nested_attribute": schema.SingleNestedAttribute{
@@ -1004,7 +1014,7 @@ Sensitive: true,
 
 		"description": {
 			input: GeneratorSingleNestedAttribute{
-				CustomType:  convert.NewCustomTypeNestedObject(nil, attributeName),
+				CustomType:  convert.NewCustomTypeNestedObject(nil, attributeName, nil),
 				Description: convert.NewDescription(pointer("description")),
 			},
 			expected: `"single_nested_attribute": schema.SingleNestedAttribute{
@@ -1022,7 +1032,7 @@ MarkdownDescription: "description",
 
 		"deprecation-message": {
 			input: GeneratorSingleNestedAttribute{
-				CustomType:         convert.NewCustomTypeNestedObject(nil, attributeName),
+				CustomType:         convert.NewCustomTypeNestedObject(nil, attributeName, nil),
 				DeprecationMessage: convert.NewDeprecationMessage(pointer("deprecated")),
 			},
 			expected: `"single_nested_attribute": schema.SingleNestedAttribute{
@@ -1039,7 +1049,7 @@ DeprecationMessage: "deprecated",
 
 		"validators": {
 			input: GeneratorSingleNestedAttribute{
-				CustomType: convert.NewCustomTypeNestedObject(nil, attributeName),
+				CustomType: convert.NewCustomTypeNestedObject(nil, attributeName, nil),
 				Validators: convert.NewValidators(convert.ValidatorTypeObject, specschema.CustomValidators{
 					&specschema.CustomValidator{
 						SchemaDefinition: "my_validator.Validate()",
@@ -1105,6 +1115,7 @@ func TestGeneratorSingleNestedAttribute_ModelField(t *testing.T) {
 						ValueType: "my_custom_value_type",
 					},
 					"single_nested_attribute",
+					nil,
 				),
 			},
 			expected: model.Field{
@@ -55,11 +55,11 @@ func TestGeneratorSingleNestedBlock_New(t *testing.T) {
 				Attributes: generatorschema.GeneratorAttributes{
 					"bool_attribute": GeneratorBoolAttribute{
 						ComputedOptionalRequired: convert.NewComputedOptionalRequired(specschema.Optional),
-						CustomType:               convert.NewCustomTypePrimitive(nil, nil, "bool_attribute"),
+						CustomType:               convert.NewCustomTypePrimitive(nil, nil, "bool_attribute", nil),
 						Validators:               convert.NewValidators(convert.ValidatorTypeBool, specschema.CustomValidators{}),
 					},
 				},
-				CustomType: convert.NewCustomTypeNestedObject(nil, "name"),
+				CustomType: convert.NewCustomTypeNestedObject(nil, "name", nil),
 				Validators: convert.NewValidators(convert.ValidatorTypeObject, specschema.CustomValidators{}),
 			},
 		},
@@ -87,6 +87,7 @@ func TestGeneratorSingleNestedBlock_New(t *testing.T) {
 							convert.CustomCollectionTypeList,
 							"types.BoolType",
 							"list_attribute",
+							nil,
 						),
 						ElementType: specschema.ElementType{
 							Bool: &specschema.BoolType{},
@@ -97,7 +98,7 @@ func TestGeneratorSingleNestedBlock_New(t *testing.T) {
 						Validators: convert.NewValidators(convert.ValidatorTypeList, specschema.CustomValidators{}),
 					},
 				},
-				CustomType: convert.NewCustomTypeNestedObject(nil, "name"),
+				CustomType: convert.NewCustomTypeNestedObject(nil, "name", nil),
 				Validators: convert.NewValidators(convert.ValidatorTypeObject, specschema.CustomValidators{}),
 			},
 		},
@@ -129,7 +130,7 @@ func TestGeneratorSingleNestedBlock_New(t *testing.T) {
 							Attributes: generatorschema.GeneratorAttributes{
 								"nested_bool": GeneratorBoolAttribute{
 									ComputedOptionalRequired: convert.NewComputedOptionalRequired(specschema.Computed),
-									CustomType:               convert.NewCustomTypePrimitive(nil, nil, "nested_bool"),
+									CustomType:               convert.NewCustomTypePrimitive(nil, nil, "nested_bool", nil),
 									Validators:               convert.NewValidators(convert.ValidatorTypeBool, specschema.CustomValidators{}),
 								},
 							},
@@ -139,18 +140,19 @@ func TestGeneratorSingleNestedBlock_New(t *testing.T) {
 							generatorschema.GeneratorAttributes{
 								"nested_bool": GeneratorBoolAttribute{
 									ComputedOptionalRequired: convert.NewComputedOptionalRequired(specschema.Computed),
-									CustomType:               convert.NewCustomTypePrimitive(nil, nil, "nested_bool"),
+									CustomType:               convert.NewCustomTypePrimitive(nil, nil, "nested_bool", nil),
 									Validators:               convert.NewValidators(convert.ValidatorTypeBool, specschema.CustomValidators{}),
 								},
 							},
 							nil,
 							convert.NewValidators(convert.ValidatorTypeObject, specschema.CustomValidators{}),
 							"nested_attribute",
+							nil,
 						),
 						Validators: convert.NewValidators(convert.ValidatorTypeList, specschema.CustomValidators{}),
 					},
 				},
-				CustomType: convert.NewCustomTypeNestedObject(nil, "name"),
+				CustomType: convert.NewCustomTypeNestedObject(nil, "name", nil),
 				Validators: convert.NewValidators(convert.ValidatorTypeObject, specschema.CustomValidators{}),
 			},
 		},
@@ -186,12 +188,12 @@ func TestGeneratorSingleNestedBlock_New(t *testing.T) {
 								Bool: &specschema.BoolType{},
 							},
 						}),
-						CustomType:               convert.NewCustomTypeObject(nil, nil, "object_attribute"),
+						CustomType:               convert.NewCustomTypeObject(nil, nil, "object_attribute", nil),
 						ComputedOptionalRequired: convert.NewComputedOptionalRequired(specschema.Optional),
 						Validators:               convert.NewValidators(convert.ValidatorTypeObject, specschema.CustomValidators{}),
 					},
 				},
-				CustomType: convert.NewCustomTypeNestedObject(nil, "name"),
+				CustomType: convert.NewCustomTypeNestedObject(nil, "name", nil),
 				Validators: convert.NewValidators(convert.ValidatorTypeObject, specschema.CustomValidators{}),
 			},
 		},
@@ -220,16 +222,16 @@ func TestGeneratorSingleNestedBlock_New(t *testing.T) {
 						Attributes: generatorschema.GeneratorAttributes{
 							"nested_bool": GeneratorBoolAttribute{
 								ComputedOptionalRequired: convert.NewComputedOptionalRequired(specschema.Computed),
-								CustomType:               convert.NewCustomTypePrimitive(nil, nil, "nested_bool"),
+								CustomType:               convert.NewCustomTypePrimitive(nil, nil, "nested_bool", nil),
 								Validators:               convert.NewValidators(convert.ValidatorTypeBool, specschema.CustomValidators{}),
 							},
 						},
 						ComputedOptionalRequired: convert.NewComputedOptionalRequired(specschema.Optional),
-						CustomType:               convert.NewCustomTypeNestedObject(nil, "nested_attribute"),
+						CustomType:               convert.NewCustomTypeNestedObject(nil, "nested_attribute", nil),
 						Validators:               convert.NewValidators(convert.ValidatorTypeObject, specschema.CustomValidators{}),
 					},
 				},
-				CustomType: convert.NewCustomTypeNestedObject(nil, "name"),
+				CustomType: convert.NewCustomTypeNestedObject(nil, "name", nil),
 				Validators: convert.NewValidators(convert.ValidatorTypeObject, specschema.CustomValidators{}),
 			},
 		},
@@ -272,7 +274,7 @@ func TestGeneratorSingleNestedBlock_New(t *testing.T) {
 							Attributes: generatorschema.GeneratorAttributes{
 								"bool_attribute": GeneratorBoolAttribute{
 									ComputedOptionalRequired: convert.NewComputedOptionalRequired(specschema.Optional),
-									CustomType:               convert.NewCustomTypePrimitive(nil, nil, "bool_attribute"),
+									CustomType:               convert.NewCustomTypePrimitive(nil, nil, "bool_attribute", nil),
 									Validators:               convert.NewValidators(convert.ValidatorTypeBool, specschema.CustomValidators{}),
 								},
 							},
@@ -281,7 +283,7 @@ func TestGeneratorSingleNestedBlock_New(t *testing.T) {
 							generatorschema.GeneratorAttributes{
 								"bool_attribute": GeneratorBoolAttribute{
 									ComputedOptionalRequired: convert.NewComputedOptionalRequired(specschema.Optional),
-									CustomType:               convert.NewCustomTypePrimitive(nil, nil, "bool_attribute"),
+									CustomType:               convert.NewCustomTypePrimitive(nil, nil, "bool_attribute", nil),
 									Validators:               convert.NewValidators(convert.ValidatorTypeBool, specschema.CustomValidators{}),
 								},
 							},
@@ -289,11 +291,12 @@ func TestGeneratorSingleNestedBlock_New(t *testing.T) {
 							nil,
 							convert.NewValidators(convert.ValidatorTypeObject, specschema.CustomValidators{}),
 							"nested_block",
+							nil,
 						),
 						Validators: convert.NewValidators(convert.ValidatorTypeList, specschema.CustomValidators{}),
 					},
 				},
-				CustomType: convert.NewCustomTypeNestedObject(nil, "name"),
+				CustomType: convert.NewCustomTypeNestedObject(nil, "name", nil),
 				Validators: convert.NewValidators(convert.ValidatorTypeObject, specschema.CustomValidators{}),
 			},
 		},
@@ -321,15 +324,15 @@ func TestGeneratorSingleNestedBlock_New(t *testing.T) {
 						Attributes: generatorschema.GeneratorAttributes{
 							"bool_attribute": GeneratorBoolAttribute{
 								ComputedOptionalRequired: convert.NewComputedOptionalRequired(specschema.Optional),
-								CustomType:               convert.NewCustomTypePrimitive(nil, nil, "bool_attribute"),
+								CustomType:               convert.NewCustomTypePrimitive(nil, nil, "bool_attribute", nil),
 								Validators:               convert.NewValidators(convert.ValidatorTypeBool, specschema.CustomValidators{}),
 							},
 						},
-						CustomType: convert.NewCustomTypeNestedObject(nil, "nested_block"),
+						CustomType: convert.NewCustomTypeNestedObject(nil, "nested_block", nil),
 						Validators: convert.NewValidators(convert.ValidatorTypeObject, specschema.CustomValidators{}),
 					},
 				},
-				CustomType: convert.NewCustomTypeNestedObject(nil, "name"),
+				CustomType: convert.NewCustomTypeNestedObject(nil, "name", nil),
 				Validators: convert.NewValidators(convert.ValidatorTypeObject, specschema.CustomValidators{}),
 			},
 		},
@@ -353,6 +356,7 @@ func TestGeneratorSingleNestedBlock_New(t *testing.T) {
 						ValueType: "myvalue_type",
 					},
 					"name",
+					nil,
 				),
 				Validators: convert.NewValidators(convert.ValidatorTypeObject, specschema.CustomValidators{}),
 			},
@@ -362,7 +366,7 @@ func TestGeneratorSingleNestedBlock_New(t *testing.T) {
 				DeprecationMessage: pointer("deprecation message"),
 			},
 			expected: GeneratorSingleNestedBlock{
-				CustomType:         convert.NewCustomTypeNestedObject(nil, "name"),
+				CustomType:         convert.NewCustomTypeNestedObject(nil, "name", nil),
 				DeprecationMessage: convert.NewDeprecationMessage(pointer("deprecation message")),
 				Validators:         convert.NewValidators(convert.ValidatorTypeObject, specschema.CustomValidators{}),
 			},
@@ -372,7 +376,7 @@ func TestGeneratorSingleNestedBlock_New(t *testing.T) {
 				Description: pointer("description"),
 			},
 			expected: GeneratorSingleNestedBlock{
-				CustomType:  convert.NewCustomTypeNestedObject(nil, "name"),
+				CustomType:  convert.NewCustomTypeNestedObject(nil, "name", nil),
 				Description: convert.NewDescription(pointer("description")),
 				Validators:  convert.NewValidators(convert.ValidatorTypeObject, specschema.CustomValidators{}),
 			},
@@ -393,7 +397,7 @@ func TestGeneratorSingleNestedBlock_New(t *testing.T) {
 				},
 			},
 			expected: GeneratorSingleNestedBlock{
-				CustomType: convert.NewCustomTypeNestedObject(nil, "name"),
+				CustomType: convert.NewCustomTypeNestedObject(nil, "name", nil),
 				Validators: convert.NewValidators(convert.ValidatorTypeObject, specschema.CustomValidators{
 					&specschema.CustomValidator{
 						Imports: []code.Import{
@@ -413,7 +417,7 @@ func TestGeneratorSingleNestedBlock_New(t *testing.T) {
 		t.Run(name, func(t *testing.T) {
 			t.Parallel()
 
-			got, err := NewGeneratorSingleNestedBlock("name", testCase.input)
+			got, err := NewGeneratorSingleNestedBlock("name", testCase.input, nil)
 
 			if diff := cmp.Diff(err, testCase.expectedError, equateErrorMessage); diff != "" {
 				t.Errorf("unexpected error: %s", diff)
@@ -448,6 +452,7 @@ func TestGeneratorSingleNestedBlock_Imports(t *testing.T) {
 				CustomType: convert.NewCustomTypeNestedObject(
 					&specschema.CustomType{},
 					"",
+					nil,
 				),
 			},
 			expected: []code.Import{
@@ -465,6 +470,7 @@ func TestGeneratorSingleNestedBlock_Imports(t *testing.T) {
 						},
 					},
 					"",
+					nil,
 				),
 			},
 			expected: []code.Import{
@@ -482,6 +488,7 @@ func TestGeneratorSingleNestedBlock_Imports(t *testing.T) {
 						},
 					},
 					"",
+					nil,
 				),
 			},
 			expected: []code.Import{
@@ -526,6 +533,7 @@ func TestGeneratorSingleNestedBlock_Imports(t *testing.T) {
 							convert.CustomCollectionTypeList,
 							"",
 							"",
+							nil,
 						),
 					},
 				},
@@ -556,6 +564,7 @@ func TestGeneratorSingleNestedBlock_Imports(t *testing.T) {
 							convert.CustomCollectionTypeList,
 							"",
 							"",
+							nil,
 						),
 						ElementTypeCollection: convert.NewElementType(specschema.ElementType{
 							Bool: &specschema.BoolType{
@@ -618,6 +627,7 @@ func TestGeneratorSingleNestedBlock_Imports(t *testing.T) {
 							},
 							nil,
 							"",
+							nil,
 						),
 					},
 				},
@@ -658,6 +668,7 @@ func TestGeneratorSingleNestedBlock_Imports(t *testing.T) {
 							},
 							nil,
 							"",
+							nil,
 						),
 					},
 				},
@@ -822,7 +833,7 @@ func TestGeneratorSingleNestedBlock_Schema(t *testing.T) {
 						ComputedOptionalRequired: convert.NewComputedOptionalRequired(specschema.Optional),
 					},
 				},
-				CustomType: convert.NewCustomTypeNestedObject(nil, blockName),
+				CustomType: convert.NewCustomTypeNestedObject(nil, blockName, nil),
 			},
 			expected: `"single_nested_block": schema.SingleNestedBlock{
 Attributes: map[string]schema.Attribute{
@@ -848,7 +859,7 @@ AttrTypes: SingleNestedBlockValue{}.AttributeTypes(ctx),
 						}),
 					},
 				},
-				CustomType: convert.NewCustomTypeNestedObject(nil, blockName),
+				CustomType: convert.NewCustomTypeNestedObject(nil, blockName, nil),
 			},
 			expected: `"single_nested_block": schema.SingleNestedBlock{
 Attributes: map[string]schema.Attribute{
@@ -878,10 +889,11 @@ AttrTypes: SingleNestedBlockValue{}.AttributeTypes(ctx),
 							nil,
 							convert.NewValidators(convert.ValidatorTypeObject, nil),
 							"nested_list_nested",
+							nil,
 						),
 					},
 				},
-				CustomType: convert.NewCustomTypeNestedObject(nil, blockName),
+				CustomType: convert.NewCustomTypeNestedObject(nil, blockName, nil),
 			},
 			expected: `"single_nested_block": schema.SingleNestedBlock{
 Attributes: map[string]schema.Attribute{
@@ -921,7 +933,7 @@ AttrTypes: SingleNestedBlockValue{}.AttributeTypes(ctx),
 						ComputedOptionalRequired: convert.NewComputedOptionalRequired(specschema.Optional),
 					},
 				},
-				CustomType: convert.NewCustomTypeNestedObject(nil, blockName),
+				CustomType: convert.NewCustomTypeNestedObject(nil, blockName, nil),
 			},
 			expected: `"single_nested_block": schema.SingleNestedBlock{
 Attributes: map[string]schema.Attribute{
@@ -949,10 +961,10 @@ AttrTypes: SingleNestedBlockValue{}.AttributeTypes(ctx),
 								ComputedOptionalRequired: convert.NewComputedOptionalRequired(specschema.Optional),
 							},
 						},
-						CustomType: convert.NewCustomTypeNestedObject(nil, "nested_single_nested"),
+						CustomType: convert.NewCustomTypeNestedObject(nil, "nested_single_nested", nil),
 					},
 				},
-				CustomType: convert.NewCustomTypeNestedObject(nil, blockName),
+				CustomType: convert.NewCustomTypeNestedObject(nil, blockName, nil),
 			},
 			expected: `"single_nested_block": schema.SingleNestedBlock{
 Attributes: map[string]schema.Attribute{
@@ -991,10 +1003,11 @@ AttrTypes: SingleNestedBlockValue{}.AttributeTypes(ctx),
 							nil,
 							convert.NewValidators(convert.ValidatorTypeObject, specschema.CustomValidators{}),
 							"nested_list_nested",
+							nil,
 						),
 					},
 				},
-				CustomType: convert.NewCustomTypeNestedObject(nil, blockName),
+				CustomType: convert.NewCustomTypeNestedObject(nil, blockName, nil),
 			},
 			expected: `"single_nested_block": schema.SingleNestedBlock{
 Blocks: map[string]schema.Block{
@@ -1030,10 +1043,10 @@ AttrTypes: SingleNestedBlockValue{}.AttributeTypes(ctx),
 								ComputedOptionalRequired: convert.NewComputedOptionalRequired(specschema.Optional),
 							},
 						},
-						CustomType: convert.NewCustomTypeNestedObject(nil, "nested_single_nested"),
+						CustomType: convert.NewCustomTypeNestedObject(nil, "nested_single_nested", nil),
 					},
 				},
-				CustomType: convert.NewCustomTypeNestedObject(nil, blockName),
+				CustomType: convert.NewCustomTypeNestedObject(nil, blockName, nil),
 			},
 			expected: `"single_nested_block": schema.SingleNestedBlock{
 Blocks: map[string]schema.Block{
@@ -1065,6 +1078,7 @@ AttrTypes: SingleNestedBlockValue{}.AttributeTypes(ctx),
 						Type: "my_custom_type",
 					},
 					blockName,
+					nil,
 				),
 			},
 			expected: `"single_nested_block": schema.SingleNestedBlock{
@@ -1074,7 +1088,7 @@ CustomType: my_custom_type,
 
 		"description": {
 			input: GeneratorSingleNestedBlock{
-				CustomType:  convert.NewCustomTypeNestedObject(nil, blockName),
+				CustomType:  convert.NewCustomTypeNestedObject(nil, blockName, nil),
 				Description: convert.NewDescription(pointer("description")),
 			},
 			expected: `"single_nested_block": schema.SingleNestedBlock{
@@ -1090,7 +1104,7 @@ MarkdownDescription: "description",
 
 		"deprecation-message": {
 			input: GeneratorSingleNestedBlock{
-				CustomType:         convert.NewCustomTypeNestedObject(nil, blockName),
+				CustomType:         convert.NewCustomTypeNestedObject(nil, blockName, nil),
 				DeprecationMessage: convert.NewDeprecationMessage(pointer("deprecated")),
 			},
 			expected: `"single_nested_block": schema.SingleNestedBlock{
@@ -1105,7 +1119,7 @@ DeprecationMessage: "deprecated",
 
 		"validators": {
 			input: GeneratorSingleNestedBlock{
-				CustomType: convert.NewCustomTypeNestedObject(nil, blockName),
+				CustomType: convert.NewCustomTypeNestedObject(nil, blockName, nil),
 				Validators: convert.NewValidators(convert.ValidatorTypeObject, specschema.CustomValidators{
 					&specschema.CustomValidator{
 						SchemaDefinition: "my_validator.Validate()",
@@ -1169,6 +1183,7 @@ func TestGeneratorSingleNestedBlock_ModelField(t *testing.T) {
 						ValueType: "my_custom_value_type",
 					},
 					"",
+					nil,
 				),
 			},
 			expected: model.Field{
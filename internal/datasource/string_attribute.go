@@ -22,16 +22,18 @@ type GeneratorStringAttribute struct {
 	Description              convert.Description
 	Sensitive                convert.Sensitive
 	Validators               convert.Validators
+
+	options *schema.Options
 }
 
-func NewGeneratorStringAttribute(name string, a *datasource.StringAttribute) (GeneratorStringAttribute, error) {
+func NewGeneratorStringAttribute(name string, a *datasource.StringAttribute, opts *schema.Options) (GeneratorStringAttribute, error) {
 	if a == nil {
 		return GeneratorStringAttribute{}, fmt.Errorf("*datasource.StringAttribute is nil")
 	}
 
 	c := convert.NewComputedOptionalRequired(a.ComputedOptionalRequired)
 
-	ctp := convert.NewCustomTypePrimitive(a.CustomType, a.AssociatedExternalType, name)
+	ctp := convert.NewCustomTypePrimitive(a.CustomType, a.AssociatedExternalType, name, opts)
 
 	d := convert.NewDescription(a.Description)
 
@@ -42,13 +44,14 @@ func NewGeneratorStringAttribute(name string, a *datasource.StringAttribute) (Ge
 	v := convert.NewValidators(convert.ValidatorTypeString, a.Validators.CustomValidators())
 
 	return GeneratorStringAttribute{
-		AssociatedExternalType:   schema.NewAssocExtType(a.AssociatedExternalType),
+		AssociatedExternalType:   schema.NewAssocExtType(a.AssociatedExternalType, opts),
 		ComputedOptionalRequired: c,
 		CustomType:               ctp,
 		DeprecationMessage:       dm,
 		Description:              d,
 		Sensitive:                s,
 		Validators:               v,
+		options:                  opts,
 	}, nil
 }
 
@@ -123,7 +126,7 @@ func (g GeneratorStringAttribute) Schema(name schema.FrameworkIdentifier) (strin
 
 func (g GeneratorStringAttribute) ModelField(name schema.FrameworkIdentifier) (model.Field, error) {
 	field := model.Field{
-		Name:      name.ToPascalCase(),
+		Name:      g.options.ToPascalCase(name),
 		TfsdkName: name.ToString(),
 		ValueType: model.StringValueType,
 	}
@@ -144,7 +147,7 @@ func (g GeneratorStringAttribute) CustomTypeAndValue(name string) ([]byte, error
 
 	var buf bytes.Buffer
 
-	stringType := schema.NewCustomStringType(name)
+	stringType := schema.NewCustomStringType(name, g.options)
 
 	b, err := stringType.Render()
 
@@ -154,7 +157,7 @@ func (g GeneratorStringAttribute) CustomTypeAndValue(name string) ([]byte, error
 
 	buf.Write(b)
 
-	stringValue := schema.NewCustomStringValue(name)
+	stringValue := schema.NewCustomStringValue(name, g.options)
 
 	b, err = stringValue.Render()
 
@@ -167,12 +170,16 @@ func (g GeneratorStringAttribute) CustomTypeAndValue(name string) ([]byte, error
 	return buf.Bytes(), nil
 }
 
+func (g GeneratorStringAttribute) GetAssociatedExternalType() *schema.AssocExtType {
+	return g.AssociatedExternalType
+}
+
 func (g GeneratorStringAttribute) ToFromFunctions(name string) ([]byte, error) {
 	if g.AssociatedExternalType == nil {
 		return nil, nil
 	}
 
-	toFrom := schema.NewToFromString(name, g.AssociatedExternalType)
+	toFrom := schema.NewToFromString(name, g.AssociatedExternalType, g.options)
 
 	b, err := toFrom.Render()
 
@@ -186,7 +193,7 @@ func (g GeneratorStringAttribute) ToFromFunctions(name string) ([]byte, error) {
 // AttrType returns a string representation of a basetypes.StringTypable type.
 func (g GeneratorStringAttribute) AttrType(name schema.FrameworkIdentifier) (string, error) {
 	if g.AssociatedExternalType != nil {
-		return fmt.Sprintf("%sType{}", name.ToPascalCase()), nil
+		return fmt.Sprintf("%sType{}", g.options.ToPascalCase(name)), nil
 	}
 
 	return "basetypes.StringType{}", nil
@@ -195,7 +202,7 @@ func (g GeneratorStringAttribute) AttrType(name schema.FrameworkIdentifier) (str
 // AttrValue returns a string representation of a basetypes.StringValuable type.
 func (g GeneratorStringAttribute) AttrValue(name schema.FrameworkIdentifier) string {
 	if g.AssociatedExternalType != nil {
-		return fmt.Sprintf("%sValue", name.ToPascalCase())
+		return fmt.Sprintf("%sValue", g.options.ToPascalCase(name))
 	}
 
 	return "basetypes.StringValue"
@@ -224,3 +231,14 @@ func (g GeneratorStringAttribute) From() (schema.ToFromConversion, error) {
 		Default: "StringPointerValue",
 	}, nil
 }
+
+func (g GeneratorStringAttribute) Properties() schema.FieldProperties {
+	return schema.FieldProperties{
+		Computed:           g.ComputedOptionalRequired.IsComputed(),
+		Optional:           g.ComputedOptionalRequired.IsOptional(),
+		Required:           g.ComputedOptionalRequired.IsRequired(),
+		Sensitive:          g.Sensitive.IsSensitive(),
+		Description:        g.Description.Description(),
+		DeprecationMessage: g.DeprecationMessage.DeprecationMessage(),
+	}
+}
@@ -33,7 +33,7 @@ func TestGeneratorStringAttribute_New(t *testing.T) {
 			},
 			expected: GeneratorStringAttribute{
 				ComputedOptionalRequired: convert.NewComputedOptionalRequired(specschema.Computed),
-				CustomType:               convert.NewCustomTypePrimitive(nil, nil, "name"),
+				CustomType:               convert.NewCustomTypePrimitive(nil, nil, "name", nil),
 				Validators:               convert.NewValidators(convert.ValidatorTypeString, specschema.CustomValidators{}),
 			},
 		},
@@ -43,7 +43,7 @@ func TestGeneratorStringAttribute_New(t *testing.T) {
 			},
 			expected: GeneratorStringAttribute{
 				ComputedOptionalRequired: convert.NewComputedOptionalRequired(specschema.ComputedOptional),
-				CustomType:               convert.NewCustomTypePrimitive(nil, nil, "name"),
+				CustomType:               convert.NewCustomTypePrimitive(nil, nil, "name", nil),
 				Validators:               convert.NewValidators(convert.ValidatorTypeString, specschema.CustomValidators{}),
 			},
 		},
@@ -53,7 +53,7 @@ func TestGeneratorStringAttribute_New(t *testing.T) {
 			},
 			expected: GeneratorStringAttribute{
 				ComputedOptionalRequired: convert.NewComputedOptionalRequired(specschema.Optional),
-				CustomType:               convert.NewCustomTypePrimitive(nil, nil, "name"),
+				CustomType:               convert.NewCustomTypePrimitive(nil, nil, "name", nil),
 				Validators:               convert.NewValidators(convert.ValidatorTypeString, specschema.CustomValidators{}),
 			},
 		},
@@ -63,7 +63,7 @@ func TestGeneratorStringAttribute_New(t *testing.T) {
 			},
 			expected: GeneratorStringAttribute{
 				ComputedOptionalRequired: convert.NewComputedOptionalRequired(specschema.Required),
-				CustomType:               convert.NewCustomTypePrimitive(nil, nil, "name"),
+				CustomType:               convert.NewCustomTypePrimitive(nil, nil, "name", nil),
 				Validators:               convert.NewValidators(convert.ValidatorTypeString, specschema.CustomValidators{}),
 			},
 		},
@@ -84,7 +84,7 @@ func TestGeneratorStringAttribute_New(t *testing.T) {
 					},
 					Type:      "my_type",
 					ValueType: "myvalue_type",
-				}, nil, "name"),
+				}, nil, "name", nil),
 				Validators: convert.NewValidators(convert.ValidatorTypeString, nil),
 			},
 		},
@@ -93,7 +93,7 @@ func TestGeneratorStringAttribute_New(t *testing.T) {
 				DeprecationMessage: pointer("deprecation message"),
 			},
 			expected: GeneratorStringAttribute{
-				CustomType:         convert.NewCustomTypePrimitive(nil, nil, "name"),
+				CustomType:         convert.NewCustomTypePrimitive(nil, nil, "name", nil),
 				DeprecationMessage: convert.NewDeprecationMessage(pointer("deprecation message")),
 				Validators:         convert.NewValidators(convert.ValidatorTypeString, specschema.CustomValidators{}),
 			},
@@ -103,7 +103,7 @@ func TestGeneratorStringAttribute_New(t *testing.T) {
 				Description: pointer("description"),
 			},
 			expected: GeneratorStringAttribute{
-				CustomType:  convert.NewCustomTypePrimitive(nil, nil, "name"),
+				CustomType:  convert.NewCustomTypePrimitive(nil, nil, "name", nil),
 				Description: convert.NewDescription(pointer("description")),
 				Validators:  convert.NewValidators(convert.ValidatorTypeString, specschema.CustomValidators{}),
 			},
@@ -113,7 +113,7 @@ func TestGeneratorStringAttribute_New(t *testing.T) {
 				Sensitive: pointer(true),
 			},
 			expected: GeneratorStringAttribute{
-				CustomType: convert.NewCustomTypePrimitive(nil, nil, "name"),
+				CustomType: convert.NewCustomTypePrimitive(nil, nil, "name", nil),
 				Sensitive:  convert.NewSensitive(pointer(true)),
 				Validators: convert.NewValidators(convert.ValidatorTypeString, specschema.CustomValidators{}),
 			},
@@ -134,7 +134,7 @@ func TestGeneratorStringAttribute_New(t *testing.T) {
 				},
 			},
 			expected: GeneratorStringAttribute{
-				CustomType: convert.NewCustomTypePrimitive(nil, nil, "name"),
+				CustomType: convert.NewCustomTypePrimitive(nil, nil, "name", nil),
 				Validators: convert.NewValidators(convert.ValidatorTypeString, specschema.CustomValidators{
 					&specschema.CustomValidator{
 						Imports: []code.Import{
@@ -154,7 +154,7 @@ func TestGeneratorStringAttribute_New(t *testing.T) {
 		t.Run(name, func(t *testing.T) {
 			t.Parallel()
 
-			got, err := NewGeneratorStringAttribute("name", testCase.input)
+			got, err := NewGeneratorStringAttribute("name", testCase.input, nil)
 
 			if diff := cmp.Diff(err, testCase.expectedError, equateErrorMessage); diff != "" {
 				t.Errorf("unexpected error: %s", diff)
@@ -183,6 +183,7 @@ func TestGeneratorStringAttribute_Schema(t *testing.T) {
 					},
 					nil,
 					"string_attribute",
+					nil,
 				),
 			},
 			expected: `"string_attribute": schema.StringAttribute{
@@ -198,6 +199,7 @@ CustomType: my_custom_type,
 						Type: "*api.ExtString",
 					},
 					"string_attribute",
+					nil,
 				),
 			},
 			expected: `"string_attribute": schema.StringAttribute{
@@ -215,6 +217,7 @@ CustomType: StringAttributeType{},
 						Type: "*api.ExtString",
 					},
 					"string_attribute",
+					nil,
 				),
 			},
 			expected: `"string_attribute": schema.StringAttribute{
@@ -345,6 +348,7 @@ func TestGeneratorStringAttribute_ModelField(t *testing.T) {
 					},
 					nil,
 					"",
+					nil,
 				),
 			},
 			expected: model.Field{
@@ -361,6 +365,7 @@ func TestGeneratorStringAttribute_ModelField(t *testing.T) {
 						Type: "*api.StringAttribute",
 					},
 					"string_attribute",
+					nil,
 				),
 			},
 			expected: model.Field{
@@ -379,6 +384,7 @@ func TestGeneratorStringAttribute_ModelField(t *testing.T) {
 						Type: "*api.StringAttribute",
 					},
 					"",
+					nil,
 				),
 			},
 			expected: model.Field{
@@ -0,0 +1,471 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+// Package diff compares the provider, resource and data source schemas in two
+// specifications, and classifies each change as breaking or non-breaking for
+// practitioners upgrading the provider.
+package diff
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	specschema "github.com/hashicorp/terraform-plugin-codegen-spec/schema"
+	"github.com/hashicorp/terraform-plugin-codegen-spec/spec"
+
+	"github.com/hashicorp/terraform-plugin-codegen-framework/internal/specmodel"
+)
+
+const (
+	SchemaProvider   = "provider"
+	SchemaResource   = "resource"
+	SchemaDataSource = "data source"
+)
+
+// Change is a change to the provider, or to a resource or data source.
+type Change struct {
+	// Breaking is true if existing configurations or state may no longer be
+	// valid after the change.
+	Breaking bool `json:"breaking"`
+
+	// Schema is the kind of schema changed: provider, resource or data
+	// source.
+	Schema string `json:"schema"`
+
+	// Name is the name of the provider, resource or data source.
+	Name string `json:"name"`
+
+	// Path is the dot-separated path of the attribute or block changed
+	// (e.g., rules.port), which is empty for changes to the schema itself.
+	Path string `json:"path,omitempty"`
+
+	// Message describes the change (e.g., attribute removed).
+	Message string `json:"message"`
+}
+
+// String returns a description of the change, such as
+// resource "example" rules.port: attribute removed.
+func (c Change) String() string {
+	if c.Path == "" {
+		return fmt.Sprintf("%s %q: %s", c.Schema, c.Name, c.Message)
+	}
+
+	return fmt.Sprintf("%s %q %s: %s", c.Schema, c.Name, c.Path, c.Message)
+}
+
+// HasBreaking returns true if any of the changes are breaking.
+func HasBreaking(changes []Change) bool {
+	for _, c := range changes {
+		if c.Breaking {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Compare returns the changes to the provider, resources and data sources from
+// the old to the new specification. Changes to each schema are in the order of
+// the old specification, followed by additions in the order of the new.
+//
+// Removing a resource, data source, attribute or block, changing a type or
+// nesting mode, making an attribute required, and an attribute no longer being
+// configurable or computed are breaking changes. Adding a required attribute is
+// also breaking, as existing configurations do not set it.
+//
+// Only the Terraform schemas are compared. Changes which affect the generated
+// code alone, such as custom types, associated external types and the naming
+// settings of the generator configuration, are not reported.
+func Compare(old, new spec.Specification) ([]Change, error) {
+	var changes []Change
+
+	switch {
+	case old.Provider != nil && new.Provider != nil:
+		if old.Provider.Name != new.Provider.Name {
+			changes = append(changes, Change{
+				Breaking: true,
+				Schema:   SchemaProvider,
+				Name:     new.Provider.Name,
+				Message:  fmt.Sprintf("name changed from %q", old.Provider.Name),
+			})
+		}
+
+		c, err := compareSchemas(SchemaProvider, new.Provider.Name, old.Provider.Schema, new.Provider.Schema)
+		if err != nil {
+			return nil, err
+		}
+
+		changes = append(changes, c...)
+	case old.Provider != nil:
+		changes = append(changes, Change{Breaking: true, Schema: SchemaProvider, Name: old.Provider.Name, Message: "removed"})
+	case new.Provider != nil:
+		changes = append(changes, Change{Schema: SchemaProvider, Name: new.Provider.Name, Message: "added"})
+	}
+
+	var oldResources, newResources schemas
+
+	for _, r := range old.Resources {
+		oldResources.add(r.Name, r.Schema)
+	}
+
+	for _, r := range new.Resources {
+		newResources.add(r.Name, r.Schema)
+	}
+
+	c, err := compareNamed(SchemaResource, oldResources, newResources)
+	if err != nil {
+		return nil, err
+	}
+
+	changes = append(changes, c...)
+
+	var oldDataSources, newDataSources schemas
+
+	for _, d := range old.DataSources {
+		oldDataSources.add(d.Name, d.Schema)
+	}
+
+	for _, d := range new.DataSources {
+		newDataSources.add(d.Name, d.Schema)
+	}
+
+	c, err = compareNamed(SchemaDataSource, oldDataSources, newDataSources)
+	if err != nil {
+		return nil, err
+	}
+
+	return append(changes, c...), nil
+}
+
+// schemas are the resource or data source schemas in a specification, in order.
+type schemas struct {
+	names  []string
+	byName map[string]any
+}
+
+func (s *schemas) add(name string, schema any) {
+	if s.byName == nil {
+		s.byName = make(map[string]any)
+	}
+
+	s.names = append(s.names, name)
+	s.byName[name] = schema
+}
+
+// compareNamed compares the resource or data source schemas, keyed on name.
+func compareNamed(kind string, oldSchemas, newSchemas schemas) ([]Change, error) {
+	var changes []Change
+
+	for _, name := range oldSchemas.names {
+		newSchema, ok := newSchemas.byName[name]
+		if !ok {
+			changes = append(changes, Change{Breaking: true, Schema: kind, Name: name, Message: "removed"})
+			continue
+		}
+
+		c, err := compareSchemas(kind, name, oldSchemas.byName[name], newSchema)
+		if err != nil {
+			return nil, err
+		}
+
+		changes = append(changes, c...)
+	}
+
+	for _, name := range newSchemas.names {
+		if _, ok := oldSchemas.byName[name]; !ok {
+			changes = append(changes, Change{Schema: kind, Name: name, Message: "added"})
+		}
+	}
+
+	return changes, nil
+}
+
+// comparison accumulates the changes to a single schema.
+type comparison struct {
+	schema  string
+	name    string
+	changes []Change
+}
+
+func (c *comparison) add(breaking bool, path, format string, a ...any) {
+	c.changes = append(c.changes, Change{
+		Breaking: breaking,
+		Schema:   c.schema,
+		Name:     c.name,
+		Path:     path,
+		Message:  fmt.Sprintf(format, a...),
+	})
+}
+
+func compareSchemas(kind, name string, oldSchema, newSchema any) ([]Change, error) {
+	o, err := specmodel.New(oldSchema)
+	if err != nil {
+		return nil, fmt.Errorf("error reading old %s %q schema: %w", kind, name, err)
+	}
+
+	n, err := specmodel.New(newSchema)
+	if err != nil {
+		return nil, fmt.Errorf("error reading new %s %q schema: %w", kind, name, err)
+	}
+
+	c := &comparison{
+		schema: kind,
+		name:   name,
+	}
+
+	if o.DeprecationMessage == nil && n.DeprecationMessage != nil {
+		c.add(false, "", "deprecated")
+	}
+
+	c.fields("", o.Attributes, o.Blocks, n.Attributes, n.Blocks)
+
+	return c.changes, nil
+}
+
+// field is an attribute or block.
+type field struct {
+	specmodel.Field
+
+	block bool
+}
+
+func (f field) kind() string {
+	if f.block {
+		return "block"
+	}
+
+	return "attribute"
+}
+
+func fields(attributes, blocks []specmodel.Field) []field {
+	result := make([]field, 0, len(attributes)+len(blocks))
+
+	for _, f := range attributes {
+		result = append(result, field{Field: f})
+	}
+
+	for _, f := range blocks {
+		result = append(result, field{Field: f, block: true})
+	}
+
+	return result
+}
+
+// fields compares the attributes and blocks nested under the parent path.
+func (c *comparison) fields(parent string, oldAttributes, oldBlocks, newAttributes, newBlocks []specmodel.Field) {
+	oldFields := fields(oldAttributes, oldBlocks)
+	newFields := fields(newAttributes, newBlocks)
+
+	newByName := make(map[string]field, len(newFields))
+	for _, f := range newFields {
+		newByName[f.Name] = f
+	}
+
+	oldByName := make(map[string]field, len(oldFields))
+	for _, f := range oldFields {
+		oldByName[f.Name] = f
+	}
+
+	for _, o := range oldFields {
+		path := joinPath(parent, o.Name)
+
+		n, ok := newByName[o.Name]
+		if !ok {
+			c.add(true, path, "%s removed", o.kind())
+			continue
+		}
+
+		c.field(path, o, n)
+	}
+
+	for _, n := range newFields {
+		if _, ok := oldByName[n.Name]; ok {
+			continue
+		}
+
+		path := joinPath(parent, n.Name)
+
+		if n.IsRequired() {
+			c.add(true, path, "required %s added", n.kind())
+			continue
+		}
+
+		c.add(false, path, "%s added", n.kind())
+	}
+}
+
+// field compares an attribute or block present in both schemas.
+func (c *comparison) field(path string, o, n field) {
+	if o.block != n.block {
+		c.add(true, path, "changed from %s to %s", o.kind(), n.kind())
+		return
+	}
+
+	oldType, newType := fieldType(o.Field), fieldType(n.Field)
+
+	switch {
+	case oldType == newType:
+	case o.IsNested() && n.IsNested():
+		c.add(true, path, "nesting mode changed from %s to %s", nestingMode(o.Type), nestingMode(n.Type))
+	default:
+		c.add(true, path, "type changed from %s to %s", oldType, newType)
+	}
+
+	if oldMode, newMode := mode(o.Field), mode(n.Field); oldMode != newMode {
+		c.add(modeBreaking(o.Field, n.Field), path, "changed from %s to %s", oldMode, newMode)
+	}
+
+	if !isSensitive(o.Field) && isSensitive(n.Field) {
+		c.add(false, path, "now sensitive")
+	}
+
+	if isSensitive(o.Field) && !isSensitive(n.Field) {
+		c.add(false, path, "no longer sensitive")
+	}
+
+	if o.DeprecationMessage == nil && n.DeprecationMessage != nil {
+		c.add(false, path, "deprecated")
+	}
+
+	if o.IsNested() && n.IsNested() {
+		oldAttributes, oldBlocks := o.Nested()
+		newAttributes, newBlocks := n.Nested()
+
+		c.fields(path, oldAttributes, oldBlocks, newAttributes, newBlocks)
+	}
+}
+
+// mode returns whether the attribute is required, optional or computed, which
+// is empty for blocks.
+func mode(f specmodel.Field) string {
+	switch {
+	case f.ComputedOptionalRequired == "computed_optional":
+		return "optional and computed"
+	case f.ComputedOptionalRequired != "":
+		return f.ComputedOptionalRequired
+	}
+
+	return f.OptionalRequired
+}
+
+// modeBreaking returns true if an attribute becomes required, can no longer be
+// configured, or is no longer computed.
+func modeBreaking(o, n specmodel.Field) bool {
+	configurable := func(f specmodel.Field) bool {
+		return f.IsRequired() || f.IsOptional()
+	}
+
+	switch {
+	case !o.IsRequired() && n.IsRequired():
+		return true
+	case configurable(o) && !configurable(n):
+		return true
+	case o.IsComputed() && !n.IsComputed():
+		return true
+	}
+
+	return false
+}
+
+func isSensitive(f specmodel.Field) bool {
+	return f.Sensitive != nil && *f.Sensitive
+}
+
+// nestingMode returns the nesting mode of a nested attribute or block type
+// (e.g., list for list_nested).
+func nestingMode(t string) string {
+	return strings.TrimSuffix(t, "_nested")
+}
+
+// fieldType returns the type of an attribute or block, including element and
+// object attribute types (e.g., list(string) or object({id = int64})). Custom
+// types are ignored, as they do not change the Terraform type.
+func fieldType(f specmodel.Field) string {
+	switch f.Type {
+	case "list", "map", "set":
+		if f.ElementType == nil {
+			return f.Type
+		}
+
+		return fmt.Sprintf("%s(%s)", f.Type, elementType(*f.ElementType))
+	case "object":
+		return objectType(f.AttributeTypes)
+	}
+
+	return f.Type
+}
+
+func elementType(e specschema.ElementType) string {
+	switch {
+	case e.Bool != nil:
+		return "bool"
+	case e.Float64 != nil:
+		return "float64"
+	case e.Int64 != nil:
+		return "int64"
+	case e.List != nil:
+		return "list(" + elementType(e.List.ElementType) + ")"
+	case e.Map != nil:
+		return "map(" + elementType(e.Map.ElementType) + ")"
+	case e.Number != nil:
+		return "number"
+	case e.Object != nil:
+		return objectType(e.Object.AttributeTypes)
+	case e.Set != nil:
+		return "set(" + elementType(e.Set.ElementType) + ")"
+	case e.String != nil:
+		return "string"
+	}
+
+	return ""
+}
+
+// objectType returns the object type with the attribute types sorted by name,
+// as the order of object attributes is not significant.
+func objectType(attributeTypes specschema.ObjectAttributeTypes) string {
+	attributes := make([]string, 0, len(attributeTypes))
+
+	for _, a := range attributeTypes {
+		attributes = append(attributes, a.Name+" = "+objectAttributeType(a))
+	}
+
+	sort.Strings(attributes)
+
+	return "object({" + strings.Join(attributes, ", ") + "})"
+}
+
+func objectAttributeType(a specschema.ObjectAttributeType) string {
+	switch {
+	case a.Bool != nil:
+		return "bool"
+	case a.Dynamic != nil:
+		return "dynamic"
+	case a.Float64 != nil:
+		return "float64"
+	case a.Int64 != nil:
+		return "int64"
+	case a.List != nil:
+		return "list(" + elementType(a.List.ElementType) + ")"
+	case a.Map != nil:
+		return "map(" + elementType(a.Map.ElementType) + ")"
+	case a.Number != nil:
+		return "number"
+	case a.Object != nil:
+		return objectType(a.Object.AttributeTypes)
+	case a.Set != nil:
+		return "set(" + elementType(a.Set.ElementType) + ")"
+	case a.String != nil:
+		return "string"
+	}
+
+	return ""
+}
+
+func joinPath(parent, name string) string {
+	if parent == "" {
+		return name
+	}
+
+	return parent + "." + name
+}
@@ -0,0 +1,246 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package diff_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-codegen-spec/spec"
+
+	"github.com/hashicorp/terraform-plugin-codegen-framework/internal/diff"
+)
+
+func TestCompare(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		old      string
+		new      string
+		expected []diff.Change
+	}{
+		"unchanged": {
+			old: `{
+  "version": "0.1",
+  "provider": {"name": "example"},
+  "resources": [{"name": "one", "schema": {"attributes": [{"name": "id", "string": {"computed_optional_required": "computed"}}]}}]
+}`,
+			new: `{
+  "version": "0.1",
+  "provider": {"name": "example"},
+  "resources": [{"name": "one", "schema": {"attributes": [{"name": "id", "string": {"computed_optional_required": "computed", "description": "Changed."}}]}}]
+}`,
+		},
+		"generated_code_only": {
+			old: `{
+  "version": "0.1",
+  "provider": {"name": "example"},
+  "resources": [{"name": "one", "schema": {"attributes": [
+    {"name": "id", "string": {"computed_optional_required": "computed"}},
+    {"name": "config", "single_nested": {"computed_optional_required": "optional", "attributes": [{"name": "port", "int64": {"computed_optional_required": "optional"}}]}}
+  ]}}]
+}`,
+			new: `{
+  "version": "0.1",
+  "provider": {"name": "example"},
+  "resources": [{"name": "one", "schema": {"attributes": [
+    {"name": "id", "string": {"computed_optional_required": "computed", "custom_type": {"import": {"path": "example.com/types"}, "type": "types.IDType", "value_type": "types.ID"}}},
+    {"name": "config", "single_nested": {"computed_optional_required": "optional", "associated_external_type": {"import": {"path": "example.com/api"}, "type": "*api.Config"}, "attributes": [{"name": "port", "int64": {"computed_optional_required": "optional", "validators": [{"custom": {"schema_definition": "int64validator.Between(1, 65535)"}}]}}]}}
+  ]}}]
+}`,
+		},
+		"resources_data_sources": {
+			old: `{
+  "version": "0.1",
+  "provider": {"name": "example"},
+  "resources": [{"name": "one", "schema": {"description": "Example."}}, {"name": "two", "schema": {"description": "Example."}}],
+  "datasources": [{"name": "one", "schema": {"description": "Example."}}]
+}`,
+			new: `{
+  "version": "0.1",
+  "provider": {"name": "example"},
+  "resources": [{"name": "three", "schema": {"description": "Example."}}, {"name": "one", "schema": {"deprecation_message": "Use three."}}],
+  "datasources": [{"name": "two", "schema": {"description": "Example."}}]
+}`,
+			expected: []diff.Change{
+				{Schema: diff.SchemaResource, Name: "one", Message: "deprecated"},
+				{Breaking: true, Schema: diff.SchemaResource, Name: "two", Message: "removed"},
+				{Schema: diff.SchemaResource, Name: "three", Message: "added"},
+				{Breaking: true, Schema: diff.SchemaDataSource, Name: "one", Message: "removed"},
+				{Schema: diff.SchemaDataSource, Name: "two", Message: "added"},
+			},
+		},
+		"attributes": {
+			old: `{
+  "version": "0.1",
+  "provider": {"name": "example"},
+  "resources": [
+    {
+      "name": "one",
+      "schema": {
+        "attributes": [
+          {"name": "removed", "string": {"computed_optional_required": "optional"}},
+          {"name": "retyped", "string": {"computed_optional_required": "optional"}},
+          {"name": "elements", "list": {"computed_optional_required": "optional", "element_type": {"string": {}}}},
+          {"name": "object", "object": {"computed_optional_required": "optional", "attribute_types": [{"name": "a", "string": {}}, {"name": "b", "bool": {}}]}},
+          {"name": "now_required", "string": {"computed_optional_required": "optional"}},
+          {"name": "now_optional", "string": {"computed_optional_required": "required"}},
+          {"name": "now_computed_optional", "string": {"computed_optional_required": "optional"}},
+          {"name": "no_longer_computed", "string": {"computed_optional_required": "computed_optional"}},
+          {"name": "no_longer_configurable", "string": {"computed_optional_required": "optional"}},
+          {"name": "secret", "string": {"computed_optional_required": "optional"}}
+        ]
+      }
+    }
+  ]
+}`,
+			new: `{
+  "version": "0.1",
+  "provider": {"name": "example"},
+  "resources": [
+    {
+      "name": "one",
+      "schema": {
+        "attributes": [
+          {"name": "retyped", "int64": {"computed_optional_required": "optional"}},
+          {"name": "elements", "list": {"computed_optional_required": "optional", "element_type": {"int64": {}}}},
+          {"name": "object", "object": {"computed_optional_required": "optional", "attribute_types": [{"name": "b", "bool": {}}, {"name": "a", "string": {}}]}},
+          {"name": "now_required", "string": {"computed_optional_required": "required"}},
+          {"name": "now_optional", "string": {"computed_optional_required": "optional"}},
+          {"name": "now_computed_optional", "string": {"computed_optional_required": "computed_optional"}},
+          {"name": "no_longer_computed", "string": {"computed_optional_required": "optional"}},
+          {"name": "no_longer_configurable", "string": {"computed_optional_required": "computed"}},
+          {"name": "secret", "string": {"computed_optional_required": "optional", "sensitive": true, "deprecation_message": "Use token."}},
+          {"name": "added_optional", "string": {"computed_optional_required": "optional"}},
+          {"name": "added_required", "string": {"computed_optional_required": "required"}}
+        ]
+      }
+    }
+  ]
+}`,
+			expected: []diff.Change{
+				{Breaking: true, Schema: diff.SchemaResource, Name: "one", Path: "removed", Message: "attribute removed"},
+				{Breaking: true, Schema: diff.SchemaResource, Name: "one", Path: "retyped", Message: "type changed from string to int64"},
+				{Breaking: true, Schema: diff.SchemaResource, Name: "one", Path: "elements", Message: "type changed from list(string) to list(int64)"},
+				{Breaking: true, Schema: diff.SchemaResource, Name: "one", Path: "now_required", Message: "changed from optional to required"},
+				{Schema: diff.SchemaResource, Name: "one", Path: "now_optional", Message: "changed from required to optional"},
+				{Schema: diff.SchemaResource, Name: "one", Path: "now_computed_optional", Message: "changed from optional to optional and computed"},
+				{Breaking: true, Schema: diff.SchemaResource, Name: "one", Path: "no_longer_computed", Message: "changed from optional and computed to optional"},
+				{Breaking: true, Schema: diff.SchemaResource, Name: "one", Path: "no_longer_configurable", Message: "changed from optional to computed"},
+				{Schema: diff.SchemaResource, Name: "one", Path: "secret", Message: "now sensitive"},
+				{Schema: diff.SchemaResource, Name: "one", Path: "secret", Message: "deprecated"},
+				{Schema: diff.SchemaResource, Name: "one", Path: "added_optional", Message: "attribute added"},
+				{Breaking: true, Schema: diff.SchemaResource, Name: "one", Path: "added_required", Message: "required attribute added"},
+			},
+		},
+		"nested": {
+			old: `{
+  "version": "0.1",
+  "provider": {"name": "example"},
+  "resources": [
+    {
+      "name": "one",
+      "schema": {
+        "attributes": [
+          {
+            "name": "rules",
+            "list_nested": {
+              "computed_optional_required": "optional",
+              "nested_object": {
+                "attributes": [
+                  {"name": "port", "int64": {"computed_optional_required": "required"}},
+                  {"name": "protocol", "string": {"computed_optional_required": "optional"}}
+                ]
+              }
+            }
+          },
+          {"name": "settings", "single_nested": {"computed_optional_required": "optional", "attributes": [{"name": "mode", "string": {"computed_optional_required": "optional"}}]}}
+        ],
+        "blocks": [
+          {"name": "timeouts", "single_nested": {"attributes": [{"name": "create", "string": {"computed_optional_required": "optional"}}]}}
+        ]
+      }
+    }
+  ]
+}`,
+			new: `{
+  "version": "0.1",
+  "provider": {"name": "example"},
+  "resources": [
+    {
+      "name": "one",
+      "schema": {
+        "attributes": [
+          {
+            "name": "rules",
+            "set_nested": {
+              "computed_optional_required": "optional",
+              "nested_object": {
+                "attributes": [
+                  {"name": "port", "int64": {"computed_optional_required": "required"}},
+                  {"name": "cidr", "string": {"computed_optional_required": "required"}}
+                ]
+              }
+            }
+          },
+          {"name": "timeouts", "single_nested": {"computed_optional_required": "optional", "attributes": [{"name": "create", "string": {"computed_optional_required": "optional"}}]}}
+        ],
+        "blocks": [
+          {"name": "settings", "single_nested": {"attributes": [{"name": "mode", "string": {"computed_optional_required": "optional"}}]}}
+        ]
+      }
+    }
+  ]
+}`,
+			expected: []diff.Change{
+				{Breaking: true, Schema: diff.SchemaResource, Name: "one", Path: "rules", Message: "nesting mode changed from list to set"},
+				{Breaking: true, Schema: diff.SchemaResource, Name: "one", Path: "rules.protocol", Message: "attribute removed"},
+				{Breaking: true, Schema: diff.SchemaResource, Name: "one", Path: "rules.cidr", Message: "required attribute added"},
+				{Breaking: true, Schema: diff.SchemaResource, Name: "one", Path: "settings", Message: "changed from attribute to block"},
+				{Breaking: true, Schema: diff.SchemaResource, Name: "one", Path: "timeouts", Message: "changed from block to attribute"},
+			},
+		},
+		"provider": {
+			old: `{
+  "version": "0.1",
+  "provider": {"name": "example", "schema": {"attributes": [{"name": "endpoint", "string": {"optional_required": "optional"}}]}}
+}`,
+			new: `{
+  "version": "0.1",
+  "provider": {"name": "other", "schema": {"attributes": [{"name": "endpoint", "string": {"optional_required": "required"}}]}}
+}`,
+			expected: []diff.Change{
+				{Breaking: true, Schema: diff.SchemaProvider, Name: "other", Message: `name changed from "example"`},
+				{Breaking: true, Schema: diff.SchemaProvider, Name: "other", Path: "endpoint", Message: "changed from optional to required"},
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			oldSpec, err := spec.Parse(context.Background(), []byte(testCase.old))
+			if err != nil {
+				t.Fatalf("unexpected error parsing old spec: %s", err)
+			}
+
+			newSpec, err := spec.Parse(context.Background(), []byte(testCase.new))
+			if err != nil {
+				t.Fatalf("unexpected error parsing new spec: %s", err)
+			}
+
+			got, err := diff.Compare(oldSpec, newSpec)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,327 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+// Package docs generates markdown documentation for the provider, resources and
+// data sources in a specification, in the layout used by the Terraform Registry.
+package docs
+
+import (
+	"bytes"
+	"fmt"
+	"path"
+	"sort"
+	"strings"
+	"text/template"
+
+	"github.com/hashicorp/terraform-plugin-codegen-spec/spec"
+
+	"github.com/hashicorp/terraform-plugin-codegen-framework/internal/config"
+	"github.com/hashicorp/terraform-plugin-codegen-framework/internal/schema"
+)
+
+// Page is the data supplied to page templates.
+type Page struct {
+	// Kind is Data Source, Provider or Resource.
+	Kind string
+
+	// Name is the name of the data source, resource or provider in the
+	// specification (e.g., instance).
+	Name string
+
+	// ProviderName is the name of the provider in the specification (e.g.,
+	// example).
+	ProviderName string
+
+	// TypeName is the name used in Terraform configuration, which is the
+	// provider name and the data source or resource name (e.g.,
+	// example_instance), or the provider name.
+	TypeName string
+
+	// Description is the markdown description of the schema, or the
+	// description if no markdown description is set.
+	Description string
+
+	// DeprecationMessage is set if the schema is deprecated.
+	DeprecationMessage string
+
+	// Arguments are the required and optional attributes and blocks.
+	Arguments []Field
+
+	// Attributes are the computed attributes and blocks which cannot be
+	// configured.
+	Attributes []Field
+
+	// NestedSchemas are the schemas of nested attributes and blocks.
+	NestedSchemas []NestedSchema
+
+	// SchemaMarkdown is the argument reference, attribute reference and
+	// nested schema sections rendered as markdown.
+	SchemaMarkdown string
+}
+
+// kind defines the page generated for a data source, resource or provider.
+type kind struct {
+	// name is used in page titles.
+	name string
+
+	// dir is the directory which pages are generated into, and is used to
+	// name templates which override the default template (e.g., resources
+	// and resources.md.tmpl).
+	dir string
+
+	// template is the default page template.
+	template *string
+}
+
+var (
+	dataSourceKind = kind{
+		name:     "Data Source",
+		dir:      "data-sources",
+		template: &dataSourceTemplate,
+	}
+	providerKind = kind{
+		name:     "Provider",
+		template: &providerTemplate,
+	}
+	resourceKind = kind{
+		name:     "Resource",
+		dir:      "resources",
+		template: &resourceTemplate,
+	}
+)
+
+// TemplateExtension is the file extension of page templates.
+const TemplateExtension = ".md.tmpl"
+
+// Generate returns the markdown documentation generated for the provider,
+// resources and data sources in the specification, keyed on slash-separated
+// file path (e.g., index.md, resources/instance.md and
+// data-sources/instance.md). The schemas are converted with the generator
+// configuration, as when generating code.
+//
+// Page templates can be overridden for all pages of a kind, with templates
+// named index.md.tmpl, resources.md.tmpl and data-sources.md.tmpl, or for
+// individual pages, with templates named after the page (e.g.,
+// resources/instance.md.tmpl). Templates are executed with a Page.
+func Generate(s spec.Specification, cfg config.Config, templates map[string]string) (map[string][]byte, error) {
+	providerName := ""
+
+	if s.Provider != nil {
+		providerName = s.Provider.Name
+	}
+
+	files := make(map[string][]byte)
+	used := make(map[string]bool, len(templates))
+
+	render := func(k kind, name string, generatorSchema schema.GeneratorSchema) error {
+		page := newPage(k, name, providerName, generatorSchema)
+
+		filename := path.Join(k.dir, name+".md")
+		defaultTemplateName := k.dir + TemplateExtension
+
+		if k.dir == "" {
+			filename = "index.md"
+			defaultTemplateName = "index" + TemplateExtension
+		}
+
+		templateName := strings.TrimSuffix(filename, ".md") + TemplateExtension
+		text, ok := templates[templateName]
+
+		if !ok {
+			templateName = defaultTemplateName
+			text, ok = templates[templateName]
+		}
+
+		if !ok {
+			templateName = ""
+			text = *k.template
+		}
+
+		used[templateName] = true
+
+		b, err := renderPage(templateName, text, page)
+		if err != nil {
+			return fmt.Errorf("error rendering %s: %w", filename, err)
+		}
+
+		files[filename] = b
+
+		return nil
+	}
+
+	if s.Provider != nil {
+		schemas, err := cfg.ProviderSchemas(s, cfg.Naming.Options())
+		if err != nil {
+			return nil, err
+		}
+
+		err = render(providerKind, s.Provider.Name, schemas[s.Provider.Name])
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	resourceSchemas, err := cfg.ResourceSchemas(s, cfg.Naming.Options())
+	if err != nil {
+		return nil, err
+	}
+
+	for _, r := range s.Resources {
+		err = render(resourceKind, r.Name, resourceSchemas[r.Name])
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	dataSourceSchemas, err := cfg.DataSourceSchemas(s, cfg.Naming.Options())
+	if err != nil {
+		return nil, err
+	}
+
+	for _, d := range s.DataSources {
+		err = render(dataSourceKind, d.Name, dataSourceSchemas[d.Name])
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	var unused []string
+
+	for name := range templates {
+		if !used[name] {
+			unused = append(unused, name)
+		}
+	}
+
+	if len(unused) > 0 {
+		sort.Strings(unused)
+
+		return nil, fmt.Errorf("templates do not match any page: %s", strings.Join(unused, ", "))
+	}
+
+	return files, nil
+}
+
+func newPage(k kind, name, providerName string, s schema.GeneratorSchema) Page {
+	page := Page{
+		Kind:         k.name,
+		Name:         name,
+		ProviderName: providerName,
+		TypeName:     providerName + "_" + name,
+	}
+
+	if k.dir == "" {
+		page.TypeName = providerName
+	}
+
+	switch {
+	case s.MarkdownDescription != nil:
+		page.Description = strings.TrimSpace(*s.MarkdownDescription)
+	case s.Description != nil:
+		page.Description = strings.TrimSpace(*s.Description)
+	}
+
+	if s.DeprecationMessage != nil {
+		page.DeprecationMessage = strings.TrimSpace(*s.DeprecationMessage)
+	}
+
+	fields, nested := newFields(s.Attributes, s.Blocks, "", "")
+
+	for _, f := range fields {
+		if fieldGroup(f) == 2 {
+			page.Attributes = append(page.Attributes, f)
+			continue
+		}
+
+		page.Arguments = append(page.Arguments, f)
+	}
+
+	page.NestedSchemas = nested
+	page.SchemaMarkdown = schemaMarkdown(page)
+
+	return page
+}
+
+// schemaMarkdown renders the argument reference, attribute reference and nested
+// schema sections of the page.
+func schemaMarkdown(p Page) string {
+	var b strings.Builder
+
+	if len(p.Arguments) > 0 {
+		b.WriteString("## Argument Reference\n\n")
+		b.WriteString("The following arguments are supported:\n\n")
+
+		for _, f := range p.Arguments {
+			b.WriteString(f.String() + "\n")
+		}
+
+		b.WriteString("\n")
+	}
+
+	if len(p.Attributes) > 0 {
+		b.WriteString("## Attribute Reference\n\n")
+
+		if len(p.Arguments) > 0 {
+			b.WriteString("In addition to all arguments above, the following attributes are exported:\n\n")
+		} else {
+			b.WriteString("The following attributes are exported:\n\n")
+		}
+
+		for _, f := range p.Attributes {
+			b.WriteString(f.String() + "\n")
+		}
+
+		b.WriteString("\n")
+	}
+
+	for _, n := range p.NestedSchemas {
+		b.WriteString(fmt.Sprintf("<a id=%q></a>\n", n.Anchor))
+		b.WriteString(fmt.Sprintf("### Nested Schema for `%s`\n\n", n.Path))
+
+		for _, f := range n.Fields {
+			b.WriteString(f.String() + "\n")
+		}
+
+		b.WriteString("\n")
+	}
+
+	return b.String()
+}
+
+// templateFuncs are the functions available to page templates.
+var templateFuncs = template.FuncMap{
+	"indent": func(n int, s string) string {
+		lines := strings.Split(s, "\n")
+
+		for i, line := range lines {
+			if line != "" {
+				lines[i] = strings.Repeat(" ", n) + line
+			}
+		}
+
+		return strings.Join(lines, "\n")
+	},
+	"lower":     strings.ToLower,
+	"trimspace": strings.TrimSpace,
+	"upper":     strings.ToUpper,
+}
+
+func renderPage(name, text string, page Page) ([]byte, error) {
+	if name == "" {
+		name = "default"
+	}
+
+	t, err := template.New(name).Funcs(templateFuncs).Parse(text)
+	if err != nil {
+		return nil, err
+	}
+
+	var buf bytes.Buffer
+
+	err = t.Execute(&buf, page)
+	if err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
@@ -0,0 +1,138 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package docs_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-codegen-spec/spec"
+
+	"github.com/hashicorp/terraform-plugin-codegen-framework/internal/config"
+	"github.com/hashicorp/terraform-plugin-codegen-framework/internal/docs"
+)
+
+const testSpec = `{
+  "version": "0.1",
+  "provider": {
+    "name": "example"
+  },
+  "resources": [
+    {
+      "name": "one",
+      "schema": {
+        "description": "Resource one.",
+        "attributes": [
+          {
+            "name": "id",
+            "string": {
+              "computed_optional_required": "computed"
+            }
+          }
+        ]
+      }
+    },
+    {
+      "name": "two",
+      "schema": {
+        "description": "Resource two."
+      }
+    }
+  ]
+}`
+
+func TestGenerate(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		config        config.Config
+		templates     map[string]string
+		expected      map[string]string
+		expectedError string
+	}{
+		"default_templates": {
+			expected: map[string]string{
+				"index.md": "---\npage_title: \"example Provider\"\nsubcategory: \"\"\ndescription: |-\n\n---\n\n# example Provider\n",
+				"resources/one.md": "---\npage_title: \"example_one Resource - example\"\nsubcategory: \"\"\ndescription: |-\n  Resource one.\n---\n\n# example_one (Resource)\n\nResource one.\n\n" +
+					"## Attribute Reference\n\nThe following attributes are exported:\n\n- `id` (String, Computed)\n",
+				"resources/two.md": "---\npage_title: \"example_two Resource - example\"\nsubcategory: \"\"\ndescription: |-\n  Resource two.\n---\n\n# example_two (Resource)\n\nResource two.\n\n\n",
+			},
+		},
+		"kind_and_page_templates": {
+			templates: map[string]string{
+				"index.md.tmpl":         "{{.TypeName}}",
+				"resources.md.tmpl":     "{{.TypeName}}: {{.Description}}",
+				"resources/two.md.tmpl": "{{upper .Name}}",
+			},
+			expected: map[string]string{
+				"index.md":         "example",
+				"resources/one.md": "example_one: Resource one.",
+				"resources/two.md": "TWO",
+			},
+		},
+		"unmatched_templates": {
+			templates: map[string]string{
+				"data-sources.md.tmpl":    "",
+				"resources/three.md.tmpl": "",
+			},
+			expectedError: "templates do not match any page: data-sources.md.tmpl, resources/three.md.tmpl",
+		},
+		// The schemas are converted with the generator configuration, as when
+		// generating code.
+		"configured_resource_not_found": {
+			config: config.Config{
+				Resources: map[string]config.Resource{
+					"three": {},
+				},
+			},
+			expectedError: `error applying generator configuration: resource "three" is not defined in the specification`,
+		},
+		"invalid_template": {
+			templates: map[string]string{
+				"resources.md.tmpl": "{{.Unknown}}",
+			},
+			expectedError: `error rendering resources/one.md: template: resources.md.tmpl:1:2: executing "resources.md.tmpl" at <.Unknown>: can't evaluate field Unknown in type docs.Page`,
+		},
+	}
+
+	s, err := spec.Parse(context.Background(), []byte(testSpec))
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := docs.Generate(s, testCase.config, testCase.templates)
+
+			if err != nil {
+				if testCase.expectedError == "" {
+					t.Fatalf("unexpected error: %s", err)
+				}
+
+				if diff := cmp.Diff(testCase.expectedError, err.Error()); diff != "" {
+					t.Errorf("unexpected error difference: %s", diff)
+				}
+
+				return
+			}
+
+			if testCase.expectedError != "" {
+				t.Fatalf("expected error: %s", testCase.expectedError)
+			}
+
+			gotStrings := make(map[string]string, len(got))
+
+			for k, v := range got {
+				gotStrings[k] = string(v)
+			}
+
+			if diff := cmp.Diff(testCase.expected, gotStrings); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,17 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package docs
+
+import (
+	_ "embed"
+)
+
+//go:embed templates/data_source.md.gotmpl
+var dataSourceTemplate string
+
+//go:embed templates/provider.md.gotmpl
+var providerTemplate string
+
+//go:embed templates/resource.md.gotmpl
+var resourceTemplate string
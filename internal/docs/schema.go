@@ -0,0 +1,249 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package docs
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	specschema "github.com/hashicorp/terraform-plugin-codegen-spec/schema"
+
+	"github.com/hashicorp/terraform-plugin-codegen-framework/internal/schema"
+)
+
+// Field is a documented attribute or block.
+type Field struct {
+	// Name is the name of the attribute or block.
+	Name string
+
+	// Type describes the type of the attribute or block (e.g., String, List
+	// of String, Attributes List or Block List).
+	Type string
+
+	// Labels are Required, Optional, Computed, Sensitive and Deprecated, as
+	// applicable.
+	Labels []string
+
+	// Description is the description of the attribute or block.
+	Description string
+
+	// DeprecationMessage is set if the attribute or block is deprecated.
+	DeprecationMessage string
+
+	// NestedSchema is the anchor of the nested schema of a nested attribute
+	// or block (e.g., nestedatt--network_interface), if any.
+	NestedSchema string
+}
+
+// NestedSchema documents the attributes and blocks of a nested attribute or
+// block.
+type NestedSchema struct {
+	// Anchor is the HTML anchor of the nested schema (e.g.,
+	// nestedatt--network_interface).
+	Anchor string
+
+	// Path is the dot-separated path of the nested attribute or block (e.g.,
+	// settings.limits).
+	Path string
+
+	// Fields are ordered with required fields first, then optional and
+	// computed fields, and are sorted by name within each group.
+	Fields []Field
+}
+
+// String returns the markdown list item for the field.
+func (f Field) String() string {
+	var b strings.Builder
+
+	b.WriteString(fmt.Sprintf("- `%s` (%s)", f.Name, strings.Join(append([]string{f.Type}, f.Labels...), ", ")))
+
+	if f.Description != "" {
+		b.WriteString(" " + f.Description)
+	}
+
+	if f.DeprecationMessage != "" {
+		b.WriteString(" **Deprecated:** " + f.DeprecationMessage)
+	}
+
+	if f.NestedSchema != "" {
+		b.WriteString(fmt.Sprintf(" (see [below for nested schema](#%s))", f.NestedSchema))
+	}
+
+	return b.String()
+}
+
+// newFields returns the documentation for attributes and blocks, ordered with
+// required fields first, then optional and computed fields, and sorted by name
+// within each group. The nested schemas of the fields, and of any fields nested
+// within them, are returned in the order they are referenced.
+func newFields(attributes schema.GeneratorAttributes, blocks schema.GeneratorBlocks, parentAnchor, parentPath string) ([]Field, []NestedSchema) {
+	type documented struct {
+		field  Field
+		nested []NestedSchema
+	}
+
+	var all []documented
+
+	for _, f := range schema.Fields(attributes, blocks) {
+		field, nested := newField(f, parentAnchor, parentPath)
+		all = append(all, documented{field, nested})
+	}
+
+	sort.SliceStable(all, func(i, j int) bool {
+		gi, gj := fieldGroup(all[i].field), fieldGroup(all[j].field)
+
+		if gi != gj {
+			return gi < gj
+		}
+
+		return all[i].field.Name < all[j].field.Name
+	})
+
+	fields := make([]Field, 0, len(all))
+
+	var nested []NestedSchema
+
+	for _, d := range all {
+		fields = append(fields, d.field)
+		nested = append(nested, d.nested...)
+	}
+
+	return fields, nested
+}
+
+// newField returns the documentation for an attribute or block. For nested
+// attributes and blocks, the nested schema is returned, followed by any schemas
+// nested within it.
+func newField(f schema.Field, parentAnchor, parentPath string) (Field, []NestedSchema) {
+	field := Field{
+		Name: f.Name,
+		Type: fieldType(f),
+	}
+
+	if f.Required {
+		field.Labels = append(field.Labels, "Required")
+	}
+
+	if f.Optional {
+		field.Labels = append(field.Labels, "Optional")
+	}
+
+	if f.Computed {
+		field.Labels = append(field.Labels, "Computed")
+	}
+
+	if f.Sensitive {
+		field.Labels = append(field.Labels, "Sensitive")
+	}
+
+	field.Description = strings.TrimSpace(f.Description)
+
+	if f.DeprecationMessage != "" {
+		field.Labels = append(field.Labels, "Deprecated")
+		field.DeprecationMessage = strings.TrimSpace(f.DeprecationMessage)
+	}
+
+	if !f.IsNested() {
+		return field, nil
+	}
+
+	prefix := "nestedatt"
+
+	if f.Block {
+		prefix = "nestedblock"
+	}
+
+	nestedSchema := NestedSchema{
+		Anchor: prefix + "--" + f.Name,
+		Path:   f.Name,
+	}
+
+	if parentAnchor != "" {
+		nestedSchema.Anchor = parentAnchor + "--" + f.Name
+		nestedSchema.Path = parentPath + "." + f.Name
+	}
+
+	field.NestedSchema = nestedSchema.Anchor
+
+	var nested []NestedSchema
+
+	nestedSchema.Fields, nested = newFields(f.Attributes, f.Blocks, nestedSchema.Anchor, nestedSchema.Path)
+
+	return field, append([]NestedSchema{nestedSchema}, nested...)
+}
+
+// fieldType returns the documented type of an attribute or block.
+func fieldType(f schema.Field) string {
+	switch f.Type {
+	case schema.GeneratorListNestedBlock:
+		return "Block List"
+	case schema.GeneratorSetNestedBlock:
+		return "Block Set"
+	case schema.GeneratorSingleNestedBlock:
+		return "Block"
+	case schema.GeneratorListNestedAttribute:
+		return "Attributes List"
+	case schema.GeneratorMapNestedAttribute:
+		return "Attributes Map"
+	case schema.GeneratorSetNestedAttribute:
+		return "Attributes Set"
+	case schema.GeneratorSingleNestedAttribute:
+		return "Attributes"
+	case schema.GeneratorListAttribute:
+		return "List of " + elementType(*f.ElementType)
+	case schema.GeneratorMapAttribute:
+		return "Map of " + elementType(*f.ElementType)
+	case schema.GeneratorSetAttribute:
+		return "Set of " + elementType(*f.ElementType)
+	case schema.GeneratorBoolAttribute:
+		return "Bool"
+	case schema.GeneratorFloat64Attribute, schema.GeneratorInt64Attribute, schema.GeneratorNumberAttribute:
+		return "Number"
+	case schema.GeneratorObjectAttribute:
+		return "Object"
+	case schema.GeneratorStringAttribute:
+		return "String"
+	}
+
+	return ""
+}
+
+func elementType(e specschema.ElementType) string {
+	switch {
+	case e.Bool != nil:
+		return "Bool"
+	case e.Float64 != nil, e.Int64 != nil, e.Number != nil:
+		return "Number"
+	case e.List != nil:
+		return "List of " + elementType(e.List.ElementType)
+	case e.Map != nil:
+		return "Map of " + elementType(e.Map.ElementType)
+	case e.Object != nil:
+		return "Object"
+	case e.Set != nil:
+		return "Set of " + elementType(e.Set.ElementType)
+	case e.String != nil:
+		return "String"
+	}
+
+	return ""
+}
+
+// fieldGroup orders required fields first, followed by optional and then
+// computed fields.
+func fieldGroup(f Field) int {
+	for _, l := range f.Labels {
+		switch l {
+		case "Required":
+			return 0
+		case "Optional":
+			return 1
+		case "Computed":
+			return 2
+		}
+	}
+
+	return 1
+}
@@ -0,0 +1,214 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package docs
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	specprovider "github.com/hashicorp/terraform-plugin-codegen-spec/provider"
+	specresource "github.com/hashicorp/terraform-plugin-codegen-spec/resource"
+
+	"github.com/hashicorp/terraform-plugin-codegen-framework/internal/provider"
+	"github.com/hashicorp/terraform-plugin-codegen-framework/internal/resource"
+	"github.com/hashicorp/terraform-plugin-codegen-framework/internal/schema"
+)
+
+func TestNewFields(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		// provider attributes and blocks use optional_required in place
+		// of computed_optional_required.
+		provider       bool
+		attributes     string
+		blocks         string
+		expectedFields []string
+		expectedNested []NestedSchema
+	}{
+		"ordering": {
+			attributes: `[
+				{"name": "c", "string": {"computed_optional_required": "computed"}},
+				{"name": "b", "string": {"computed_optional_required": "optional"}},
+				{"name": "z", "string": {"computed_optional_required": "required"}},
+				{"name": "a", "string": {"computed_optional_required": "computed_optional"}}
+			]`,
+			expectedFields: []string{
+				"- `z` (String, Required)",
+				"- `a` (String, Optional, Computed)",
+				"- `b` (String, Optional)",
+				"- `c` (String, Computed)",
+			},
+		},
+		"labels": {
+			attributes: `[
+				{"name": "password", "string": {"computed_optional_required": "required", "sensitive": true, "description": " Password. "}},
+				{"name": "size", "int64": {"computed_optional_required": "optional", "deprecation_message": "Use flavor instead."}}
+			]`,
+			expectedFields: []string{
+				"- `password` (String, Required, Sensitive) Password.",
+				"- `size` (Number, Optional, Deprecated) **Deprecated:** Use flavor instead.",
+			},
+		},
+		"provider": {
+			provider: true,
+			attributes: `[
+				{"name": "token", "string": {"optional_required": "required", "sensitive": true}},
+				{"name": "region", "string": {"optional_required": "optional"}}
+			]`,
+			expectedFields: []string{
+				"- `token` (String, Required, Sensitive)",
+				"- `region` (String, Optional)",
+			},
+		},
+		"element_types": {
+			attributes: `[
+				{"name": "a", "list": {"computed_optional_required": "optional", "element_type": {"map": {"element_type": {"float64": {}}}}}},
+				{"name": "b", "set": {"computed_optional_required": "optional", "element_type": {"object": {"attribute_types": []}}}},
+				{"name": "c", "object": {"computed_optional_required": "optional", "attribute_types": []}}
+			]`,
+			expectedFields: []string{
+				"- `a` (List of Map of Number, Optional)",
+				"- `b` (Set of Object, Optional)",
+				"- `c` (Object, Optional)",
+			},
+		},
+		"nested": {
+			attributes: `[
+				{"name": "settings", "map_nested": {"computed_optional_required": "optional", "nested_object": {"attributes": [
+					{"name": "limits", "single_nested": {"computed_optional_required": "computed", "attributes": [
+						{"name": "max", "number": {"computed_optional_required": "computed"}}
+					]}}
+				]}}}
+			]`,
+			blocks: `[
+				{"name": "rule", "set_nested": {"nested_object": {"blocks": [
+					{"name": "match", "list_nested": {"nested_object": {}}}
+				]}}}
+			]`,
+			expectedFields: []string{
+				"- `rule` (Block Set) (see [below for nested schema](#nestedblock--rule))",
+				"- `settings` (Attributes Map, Optional) (see [below for nested schema](#nestedatt--settings))",
+			},
+			expectedNested: []NestedSchema{
+				{
+					Anchor: "nestedblock--rule",
+					Path:   "rule",
+					Fields: []Field{
+						{
+							Name:         "match",
+							Type:         "Block List",
+							NestedSchema: "nestedblock--rule--match",
+						},
+					},
+				},
+				{
+					Anchor: "nestedblock--rule--match",
+					Path:   "rule.match",
+					Fields: []Field{},
+				},
+				{
+					Anchor: "nestedatt--settings",
+					Path:   "settings",
+					Fields: []Field{
+						{
+							Name:         "limits",
+							Type:         "Attributes",
+							Labels:       []string{"Computed"},
+							NestedSchema: "nestedatt--settings--limits",
+						},
+					},
+				},
+				{
+					Anchor: "nestedatt--settings--limits",
+					Path:   "settings.limits",
+					Fields: []Field{
+						{
+							Name:   "max",
+							Type:   "Number",
+							Labels: []string{"Computed"},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			attributes, blocks := generatorFields(t, testCase.provider, testCase.attributes, testCase.blocks)
+
+			fields, nested := newFields(attributes, blocks, "", "")
+
+			got := make([]string, 0, len(fields))
+
+			for _, f := range fields {
+				got = append(got, f.String())
+			}
+
+			if diff := cmp.Diff(testCase.expectedFields, got); diff != "" {
+				t.Errorf("unexpected fields difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(testCase.expectedNested, nested); diff != "" {
+				t.Errorf("unexpected nested schemas difference: %s", diff)
+			}
+		})
+	}
+}
+
+// generatorFields converts the JSON attributes and blocks of a resource, or of
+// the provider, to generator attributes and blocks.
+func generatorFields(t *testing.T, isProvider bool, attributesJSON, blocksJSON string) (schema.GeneratorAttributes, schema.GeneratorBlocks) {
+	t.Helper()
+
+	unmarshal := func(s string, v any) {
+		if s == "" {
+			return
+		}
+
+		if err := json.Unmarshal([]byte(s), v); err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+	}
+
+	var (
+		attributes schema.GeneratorAttributes
+		blocks     schema.GeneratorBlocks
+		err        error
+	)
+
+	if isProvider {
+		var a specprovider.Attributes
+		var b specprovider.Blocks
+
+		unmarshal(attributesJSON, &a)
+		unmarshal(blocksJSON, &b)
+
+		attributes, err = provider.NewAttributes(a, "", nil)
+		if err == nil {
+			blocks, err = provider.NewBlocks(b, "", nil)
+		}
+	} else {
+		var a specresource.Attributes
+		var b specresource.Blocks
+
+		unmarshal(attributesJSON, &a)
+		unmarshal(blocksJSON, &b)
+
+		attributes, err = resource.NewAttributes(a, "", nil)
+		if err == nil {
+			blocks, err = resource.NewBlocks(b, "", nil)
+		}
+	}
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	return attributes, blocks
+}
@@ -0,0 +1,366 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+// Package examples generates example Terraform configurations for the provider,
+// resources and data sources in a specification.
+package examples
+
+import (
+	"fmt"
+	"path"
+	"strings"
+
+	specschema "github.com/hashicorp/terraform-plugin-codegen-spec/schema"
+	"github.com/hashicorp/terraform-plugin-codegen-spec/spec"
+
+	"github.com/hashicorp/terraform-plugin-codegen-framework/internal/config"
+	"github.com/hashicorp/terraform-plugin-codegen-framework/internal/schema"
+)
+
+// indent is the indentation of each nested level of configuration.
+const indent = "  "
+
+// Generate returns the example configuration generated for the provider,
+// resources and data sources in the specification, keyed on slash-separated
+// file path (e.g., provider/provider.tf, resources/instance/resource.tf and
+// data-sources/instance/data-source.tf). The schemas are converted with the
+// generator configuration, as when generating code.
+//
+// Each example sets the required attributes and blocks to placeholder values,
+// and includes optional attributes and blocks commented out. Computed
+// attributes which cannot be configured are omitted. Static defaults declared
+// in the specification are used as values in place of placeholders.
+func Generate(s spec.Specification, cfg config.Config) (map[string][]byte, error) {
+	files := make(map[string][]byte)
+
+	providerName := ""
+
+	if s.Provider != nil {
+		providerName = s.Provider.Name
+
+		schemas, err := cfg.ProviderSchemas(s, cfg.Naming.Options())
+		if err != nil {
+			return nil, fmt.Errorf("error generating provider example: %w", err)
+		}
+
+		files["provider/provider.tf"] = Configuration(fmt.Sprintf("provider %q", providerName), schemas[providerName], false)
+	}
+
+	resourceSchemas, err := cfg.ResourceSchemas(s, cfg.Naming.Options())
+	if err != nil {
+		return nil, fmt.Errorf("error generating resource examples: %w", err)
+	}
+
+	for _, r := range s.Resources {
+		b := Configuration(fmt.Sprintf("resource %q %q", providerName+"_"+r.Name, "example"), resourceSchemas[r.Name], false)
+
+		files[path.Join("resources", r.Name, "resource.tf")] = b
+	}
+
+	dataSourceSchemas, err := cfg.DataSourceSchemas(s, cfg.Naming.Options())
+	if err != nil {
+		return nil, fmt.Errorf("error generating data source examples: %w", err)
+	}
+
+	for _, d := range s.DataSources {
+		b := Configuration(fmt.Sprintf("data %q %q", providerName+"_"+d.Name, "example"), dataSourceSchemas[d.Name], false)
+
+		files[path.Join("data-sources", d.Name, "data-source.tf")] = b
+	}
+
+	return files, nil
+}
+
+// Configuration returns the configuration of a block with the header (e.g.,
+// resource "example_instance" "example"), and a body generated from the data
+// source, provider or resource schema. Optional attributes and blocks are
+// commented out, or omitted if omitOptional is true.
+func Configuration(header string, s schema.GeneratorSchema, omitOptional bool) []byte {
+	var b strings.Builder
+
+	for _, line := range block(header, body(s.Attributes, s.Blocks, omitOptional)) {
+		b.WriteString(strings.TrimRight(line, " ") + "\n")
+	}
+
+	return []byte(b.String())
+}
+
+// item is an attribute or block within a body.
+type item struct {
+	// name is the name of an attribute, which is prefixed to the first
+	// line by group. It is empty for blocks.
+	name string
+
+	// lines are the lines of the attribute or block.
+	lines []string
+
+	// optional items are commented out.
+	optional bool
+}
+
+// body returns the lines of a block body or object containing the attributes
+// and blocks. Required attributes and blocks are followed by optional
+// attributes and blocks, which are commented out, or omitted if omitOptional is
+// true. Optional attributes and blocks are always omitted when the body is
+// itself commented out, as nested comments would be unreadable. Consecutive
+// single line attributes are aligned, as with terraform fmt, and multiple line
+// attributes and blocks are separated by blank lines.
+func body(attributes schema.GeneratorAttributes, blocks schema.GeneratorBlocks, omitOptional bool) []string {
+	var required, optional []item
+
+	for _, f := range schema.Fields(attributes, blocks) {
+		var i item
+
+		switch {
+		case f.Block && f.Computed && !f.Optional:
+			continue
+		case f.Block:
+			i = item{
+				lines:    block(f.Name, body(f.Attributes, f.Blocks, omitOptional || !f.Required)),
+				optional: !f.Required,
+			}
+		case !f.Required && !f.Optional:
+			continue
+		default:
+			i = item{
+				name:     f.Name,
+				lines:    attribute(f, omitOptional || !f.Required),
+				optional: !f.Required,
+			}
+		}
+
+		if i.optional {
+			optional = append(optional, i)
+		} else {
+			required = append(required, i)
+		}
+	}
+
+	lines := group(required, "")
+
+	if omitOptional || len(optional) == 0 {
+		return lines
+	}
+
+	if len(lines) > 0 {
+		lines = append(lines, "")
+	}
+
+	return append(lines, group(optional, "# ")...)
+}
+
+// group returns the lines of the items, prefixed with prefix. Blocks, and
+// attributes spanning multiple lines, are separated by blank lines.
+func group(items []item, prefix string) []string {
+	var lines []string
+
+	// width is the width of the names of consecutive single line attributes.
+	width := 0
+
+	// separate indicates whether the previous item is followed by a blank
+	// line.
+	separate := false
+
+	for n, i := range items {
+		single := i.name != "" && len(i.lines) == 1
+
+		if len(lines) > 0 && (separate || !single) {
+			lines = append(lines, "")
+		}
+
+		first := i.lines[0]
+
+		switch {
+		case single && (n == 0 || separate):
+			width = alignWidth(items[n:])
+			fallthrough
+		case single:
+			first = fmt.Sprintf("%-*s", width, i.name) + first
+		default:
+			first = i.name + first
+		}
+
+		lines = append(lines, prefix+first)
+
+		for _, line := range i.lines[1:] {
+			lines = append(lines, prefix+line)
+		}
+
+		separate = !single
+	}
+
+	return lines
+}
+
+// alignWidth returns the width of the names of the consecutive single line
+// attributes at the start of items.
+func alignWidth(items []item) int {
+	width := 0
+
+	for _, i := range items {
+		if i.name == "" || len(i.lines) != 1 {
+			break
+		}
+
+		width = max(width, len(i.name))
+	}
+
+	return width
+}
+
+// attribute returns the lines of an attribute, without the name, which is
+// aligned by group.
+func attribute(f schema.Field, omitOptional bool) []string {
+	lines := value(f, omitOptional)
+	lines[0] = " = " + lines[0]
+
+	return lines
+}
+
+// block returns the lines of a block with the header and body.
+func block(header string, body []string) []string {
+	if len(body) == 0 {
+		return []string{header + " {}"}
+	}
+
+	lines := []string{header + " {"}
+	lines = append(lines, indentLines(body)...)
+
+	return append(lines, "}")
+}
+
+// value returns the lines of the value of an attribute, which is the static
+// default if declared, or otherwise a placeholder.
+func value(f schema.Field, omitOptional bool) []string {
+	if f.StaticDefault != "" {
+		return []string{f.StaticDefault}
+	}
+
+	switch f.Type {
+	case schema.GeneratorListAttribute, schema.GeneratorSetAttribute:
+		return []string{"[" + elementValue(*f.ElementType) + "]"}
+	case schema.GeneratorMapAttribute:
+		return []string{"{ key = " + elementValue(*f.ElementType) + " }"}
+	case schema.GeneratorObjectAttribute:
+		return []string{objectValue(f.AttributeTypes)}
+	case schema.GeneratorListNestedAttribute, schema.GeneratorSetNestedAttribute:
+		lines := []string{"["}
+		lines = append(lines, indentLines(appendComma(object(f.Attributes, f.Blocks, omitOptional)))...)
+
+		return append(lines, "]")
+	case schema.GeneratorMapNestedAttribute:
+		lines := []string{"{"}
+		lines = append(lines, indentLines(prefixFirst("key = ", object(f.Attributes, f.Blocks, omitOptional)))...)
+
+		return append(lines, "}")
+	case schema.GeneratorSingleNestedAttribute:
+		return object(f.Attributes, f.Blocks, omitOptional)
+	}
+
+	return []string{PrimitiveValue(f.Type)}
+}
+
+// object returns the lines of an object value containing the attributes.
+func object(attributes schema.GeneratorAttributes, blocks schema.GeneratorBlocks, omitOptional bool) []string {
+	body := body(attributes, blocks, omitOptional)
+
+	if len(body) == 0 {
+		return []string{"{}"}
+	}
+
+	lines := []string{"{"}
+	lines = append(lines, indentLines(body)...)
+
+	return append(lines, "}")
+}
+
+// elementValue returns a placeholder for an element of a collection.
+func elementValue(e specschema.ElementType) string {
+	switch {
+	case e.Bool != nil:
+		return PrimitiveValue(schema.GeneratorBoolAttribute)
+	case e.Float64 != nil:
+		return PrimitiveValue(schema.GeneratorFloat64Attribute)
+	case e.Int64 != nil:
+		return PrimitiveValue(schema.GeneratorInt64Attribute)
+	case e.List != nil:
+		return "[" + elementValue(e.List.ElementType) + "]"
+	case e.Map != nil:
+		return "{ key = " + elementValue(e.Map.ElementType) + " }"
+	case e.Number != nil:
+		return PrimitiveValue(schema.GeneratorNumberAttribute)
+	case e.Object != nil:
+		return objectValue(e.Object.AttributeTypes)
+	case e.Set != nil:
+		return "[" + elementValue(e.Set.ElementType) + "]"
+	}
+
+	return PrimitiveValue(schema.GeneratorStringAttribute)
+}
+
+// objectValue returns a placeholder for an object with the attribute types.
+func objectValue(attributeTypes specschema.ObjectAttributeTypes) string {
+	if len(attributeTypes) == 0 {
+		return "{}"
+	}
+
+	values := make([]string, 0, len(attributeTypes))
+
+	for _, a := range attributeTypes {
+		values = append(values, a.Name+" = "+elementValue(specschema.ElementType{
+			Bool:    a.Bool,
+			Float64: a.Float64,
+			Int64:   a.Int64,
+			List:    a.List,
+			Map:     a.Map,
+			Number:  a.Number,
+			Object:  a.Object,
+			Set:     a.Set,
+			String:  a.String,
+		}))
+	}
+
+	return "{ " + strings.Join(values, ", ") + " }"
+}
+
+// PrimitiveValue returns the placeholder value of an attribute with a primitive
+// type (e.g., "example" for a string attribute), as HCL, which is also a valid
+// Go literal.
+func PrimitiveValue(t schema.Type) string {
+	switch t {
+	case schema.GeneratorBoolAttribute:
+		return "true"
+	case schema.GeneratorFloat64Attribute, schema.GeneratorNumberAttribute:
+		return "1.5"
+	case schema.GeneratorInt64Attribute:
+		return "1"
+	}
+
+	return `"example"`
+}
+
+func indentLines(lines []string) []string {
+	result := make([]string, len(lines))
+
+	for i, line := range lines {
+		if line != "" {
+			line = indent + line
+		}
+
+		result[i] = line
+	}
+
+	return result
+}
+
+func appendComma(lines []string) []string {
+	lines[len(lines)-1] += ","
+
+	return lines
+}
+
+func prefixFirst(prefix string, lines []string) []string {
+	lines[0] = prefix + lines[0]
+
+	return lines
+}
@@ -0,0 +1,151 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package examples_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-codegen-spec/spec"
+
+	"github.com/hashicorp/terraform-plugin-codegen-framework/internal/config"
+	"github.com/hashicorp/terraform-plugin-codegen-framework/internal/examples"
+)
+
+func TestGenerate(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		spec     string
+		expected map[string]string
+	}{
+		"empty_schemas": {
+			spec: `{
+  "version": "0.1",
+  "provider": {"name": "example"},
+  "resources": [{"name": "one", "schema": {"description": "One."}}],
+  "datasources": [{"name": "one", "schema": {"description": "One."}}]
+}`,
+			expected: map[string]string{
+				"provider/provider.tf":            "provider \"example\" {}\n",
+				"resources/one/resource.tf":       "resource \"example_one\" \"example\" {}\n",
+				"data-sources/one/data-source.tf": "data \"example_one\" \"example\" {}\n",
+			},
+		},
+		"required_optional_computed": {
+			spec: `{
+  "version": "0.1",
+  "provider": {"name": "example"},
+  "resources": [
+    {
+      "name": "one",
+      "schema": {
+        "attributes": [
+          {"name": "id", "string": {"computed_optional_required": "computed"}},
+          {"name": "name", "string": {"computed_optional_required": "required"}},
+          {"name": "enabled", "bool": {"computed_optional_required": "required"}},
+          {"name": "description", "string": {"computed_optional_required": "optional"}},
+          {"name": "count", "int64": {"computed_optional_required": "computed_optional", "default": {"static": 3}}}
+        ]
+      }
+    }
+  ]
+}`,
+			expected: map[string]string{
+				"provider/provider.tf": "provider \"example\" {}\n",
+				"resources/one/resource.tf": `resource "example_one" "example" {
+  enabled = true
+  name    = "example"
+
+  # count       = 3
+  # description = "example"
+}
+`,
+			},
+		},
+		"nested": {
+			spec: `{
+  "version": "0.1",
+  "provider": {"name": "example"},
+  "resources": [
+    {
+      "name": "one",
+      "schema": {
+        "attributes": [
+          {
+            "name": "rules",
+            "set_nested": {
+              "computed_optional_required": "optional",
+              "nested_object": {
+                "attributes": [
+                  {"name": "port", "int64": {"computed_optional_required": "required"}},
+                  {"name": "protocol", "string": {"computed_optional_required": "optional"}}
+                ]
+              }
+            }
+          },
+          {"name": "tags", "map": {"computed_optional_required": "required", "element_type": {"list": {"element_type": {"number": {}}}}}}
+        ],
+        "blocks": [
+          {
+            "name": "settings",
+            "single_nested": {
+              "attributes": [
+                {"name": "mode", "string": {"computed_optional_required": "required"}},
+                {"name": "level", "int64": {"computed_optional_required": "optional"}}
+              ]
+            }
+          }
+        ]
+      }
+    }
+  ]
+}`,
+			expected: map[string]string{
+				"provider/provider.tf": "provider \"example\" {}\n",
+				"resources/one/resource.tf": `resource "example_one" "example" {
+  tags = { key = [1.5] }
+
+  # rules = [
+  #   {
+  #     port = 1
+  #   },
+  # ]
+
+  # settings {
+  #   mode = "example"
+  # }
+}
+`,
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			s, err := spec.Parse(context.Background(), []byte(testCase.spec))
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			got, err := examples.Generate(s, config.Config{})
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			gotStrings := make(map[string]string, len(got))
+
+			for k, v := range got {
+				gotStrings[k] = string(v)
+			}
+
+			if diff := cmp.Diff(testCase.expected, gotStrings); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,187 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package format
+
+import (
+	"regexp"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// CommonInitialisms is the list of initialisms used by golint, which are
+// upper-cased in their entirety when initialism-aware casing is enabled.
+var CommonInitialisms = []string{
+	"ACL", "API", "ASCII", "CPU", "CSS", "DNS", "EOF", "GUID", "HTML", "HTTP",
+	"HTTPS", "ID", "IP", "JSON", "LHS", "QPS", "RAM", "RHS", "RPC", "SLA",
+	"SMTP", "SQL", "SSH", "TCP", "TLS", "TTL", "UDP", "UI", "UID", "UUID",
+	"URI", "URL", "UTF8", "VM", "XML", "XMPP", "XSRF", "XSS",
+}
+
+// snakeLetters will match to the first letter and an underscore followed by a letter
+var snakeLetters = regexp.MustCompile("(^[a-z])|_[a-z0-9]")
+
+// Casing converts snake case identifiers to pascal and camel case Go identifiers.
+// The zero value converts each word by upper-casing its first letter. A Casing
+// returned by NewCasing is initialism-aware.
+type Casing struct {
+	// initialisms is nil if initialism-aware casing is disabled.
+	initialisms map[string]struct{}
+}
+
+// NewCasing returns an initialism-aware Casing, which upper-cases words which are
+// CommonInitialisms, or any of the supplied extra initialisms, in their entirety.
+func NewCasing(extra []string) Casing {
+	initialisms := make(map[string]struct{}, len(CommonInitialisms)+len(extra))
+
+	for _, v := range CommonInitialisms {
+		initialisms[v] = struct{}{}
+	}
+
+	for _, v := range extra {
+		initialisms[strings.ToUpper(v)] = struct{}{}
+	}
+
+	return Casing{
+		initialisms: initialisms,
+	}
+}
+
+// ToPascalCase will return a pascal case formatted string of the snake case input.
+// Example:
+//   - example_resource_thing -> ExampleResourceThing
+//
+// Refer to Casing.PascalCase for the handling of initialisms.
+func ToPascalCase(str string) string {
+	return Casing{}.PascalCase(str)
+}
+
+// ToCamelCase will return a camel case formatted string of the snake case input.
+// Example:
+//   - example_resource_thing -> exampleResourceThing
+//
+// Refer to Casing.CamelCase for the handling of initialisms.
+func ToCamelCase(str string) string {
+	return Casing{}.CamelCase(str)
+}
+
+// ToPascalCaseWord will return the input with the first letter upper-cased.
+func ToPascalCaseWord(word string) string {
+	return Casing{}.PascalCaseWord(word)
+}
+
+// LowerFirst returns a camel case formatted string of the pascal case input.
+// Example:
+//   - ExampleThing -> exampleThing
+func LowerFirst(pascal string) string {
+	return Casing{}.LowerFirst(pascal)
+}
+
+// PascalCase will return a pascal case formatted string of the snake case input.
+// Example:
+//   - example_resource_thing -> ExampleResourceThing
+//
+// If the casing is initialism-aware, words which are initialisms are upper-cased.
+// Example:
+//   - vpc_arn -> VPCARN (with VPC and ARN as extra initialisms)
+//   - api_url -> APIURL
+func (c Casing) PascalCase(str string) string {
+	if c.initialisms == nil {
+		return snakeLetters.ReplaceAllStringFunc(str, func(s string) string {
+			return strings.ToUpper(strings.Replace(s, "_", "", -1))
+		})
+	}
+
+	var sb strings.Builder
+
+	for _, word := range strings.Split(str, "_") {
+		sb.WriteString(c.PascalCaseWord(word))
+	}
+
+	return sb.String()
+}
+
+// CamelCase will return a camel case formatted string of the snake case input.
+// Example:
+//   - example_resource_thing -> exampleResourceThing
+//
+// If the casing is initialism-aware, a leading initialism is lower-cased and any
+// subsequent initialisms are upper-cased.
+// Example:
+//   - id -> id
+//   - api_url -> apiURL
+func (c Casing) CamelCase(str string) string {
+	if c.initialisms == nil {
+		return c.LowerFirst(c.PascalCase(str))
+	}
+
+	var sb strings.Builder
+
+	for _, word := range strings.Split(str, "_") {
+		if sb.Len() == 0 {
+			sb.WriteString(strings.ToLower(word))
+			continue
+		}
+
+		sb.WriteString(c.PascalCaseWord(word))
+	}
+
+	return sb.String()
+}
+
+// PascalCaseWord will return the input with the first letter upper-cased. If the
+// casing is initialism-aware and the input is an initialism, the input is
+// upper-cased in its entirety. Only exact words are matched, so plurals and words
+// which merely contain an initialism are not upper-cased.
+// Example:
+//   - ids -> Ids
+//   - oauth -> Oauth
+func (c Casing) PascalCaseWord(word string) string {
+	if _, ok := c.initialisms[strings.ToUpper(word)]; ok {
+		return strings.ToUpper(word)
+	}
+
+	return upperFirst(word)
+}
+
+// LowerFirst returns a camel case formatted string of the pascal case input. If
+// the casing is initialism-aware, a leading run of upper case letters is
+// lower-cased, so that a leading initialism remains a single word.
+// Example:
+//   - ExampleThing -> exampleThing
+//   - VPCConfig -> vpcConfig (with initialism-aware casing)
+func (c Casing) LowerFirst(pascal string) string {
+	if c.initialisms == nil {
+		// Grab first rune and lower case it
+		firstLetter, size := utf8.DecodeRuneInString(pascal)
+		if firstLetter == utf8.RuneError && size <= 1 {
+			return pascal
+		}
+
+		return string(unicode.ToLower(firstLetter)) + pascal[size:]
+	}
+
+	runes := []rune(pascal)
+
+	for i := 0; i < len(runes) && unicode.IsUpper(runes[i]); i++ {
+		// Leave the final upper case letter of a run alone if it begins the
+		// next word (e.g., the C of VPCConfig).
+		if i > 0 && i+1 < len(runes) && unicode.IsLower(runes[i+1]) {
+			break
+		}
+
+		runes[i] = unicode.ToLower(runes[i])
+	}
+
+	return string(runes)
+}
+
+func upperFirst(word string) string {
+	firstLetter, size := utf8.DecodeRuneInString(word)
+	if firstLetter == utf8.RuneError && size <= 1 {
+		return word
+	}
+
+	return string(unicode.ToUpper(firstLetter)) + word[size:]
+}
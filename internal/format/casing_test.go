@@ -0,0 +1,222 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package format_test
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/hashicorp/terraform-plugin-codegen-framework/internal/format"
+)
+
+func TestCasing_PascalCase(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		input       string
+		initialisms bool
+		extra       []string
+		expected    string
+	}{
+		"legacy": {
+			input:    "example_resource_thing",
+			expected: "ExampleResourceThing",
+		},
+		"legacy-id": {
+			input:    "id",
+			expected: "Id",
+		},
+		"legacy-api-url": {
+			input:    "api_url",
+			expected: "ApiUrl",
+		},
+		"legacy-digit": {
+			input:    "ipv4_address_2",
+			expected: "Ipv4Address2",
+		},
+		"initialisms": {
+			input:       "example_resource_thing",
+			initialisms: true,
+			expected:    "ExampleResourceThing",
+		},
+		"initialisms-id": {
+			input:       "id",
+			initialisms: true,
+			expected:    "ID",
+		},
+		"initialisms-api-url": {
+			input:       "api_url",
+			initialisms: true,
+			expected:    "APIURL",
+		},
+		"initialisms-vpc-arn": {
+			input:       "vpc_arn",
+			initialisms: true,
+			expected:    "VpcArn",
+		},
+		"initialisms-extra-vpc-arn": {
+			input:       "vpc_arn",
+			initialisms: true,
+			extra:       []string{"VPC", "arn"},
+			expected:    "VPCARN",
+		},
+		"initialisms-suffix": {
+			input:       "subnet_id",
+			initialisms: true,
+			expected:    "SubnetID",
+		},
+		"initialisms-plural": {
+			input:       "subnet_ids",
+			initialisms: true,
+			expected:    "SubnetIds",
+		},
+		"initialisms-partial": {
+			input:       "oauth_token",
+			initialisms: true,
+			expected:    "OauthToken",
+		},
+		"initialisms-extra-plural": {
+			input:       "subnet_ids",
+			initialisms: true,
+			extra:       []string{"IDS"},
+			expected:    "SubnetIDS",
+		},
+		"initialisms-extra-partial": {
+			input:       "oauth_token",
+			initialisms: true,
+			extra:       []string{"OAuth"},
+			expected:    "OAUTHToken",
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var casing format.Casing
+
+			if testCase.initialisms {
+				casing = format.NewCasing(testCase.extra)
+			}
+
+			got := casing.PascalCase(testCase.input)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestCasing_CamelCase(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		input       string
+		initialisms bool
+		extra       []string
+		expected    string
+	}{
+		"legacy": {
+			input:    "example_resource_thing",
+			expected: "exampleResourceThing",
+		},
+		"legacy-api-url": {
+			input:    "api_url",
+			expected: "apiUrl",
+		},
+		"initialisms-id": {
+			input:       "id",
+			initialisms: true,
+			expected:    "id",
+		},
+		"initialisms-api-url": {
+			input:       "api_url",
+			initialisms: true,
+			expected:    "apiURL",
+		},
+		"initialisms-subnet-id": {
+			input:       "subnet_id",
+			initialisms: true,
+			expected:    "subnetID",
+		},
+		"initialisms-extra-vpc-config": {
+			input:       "vpc_config",
+			initialisms: true,
+			extra:       []string{"VPC"},
+			expected:    "vpcConfig",
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var casing format.Casing
+
+			if testCase.initialisms {
+				casing = format.NewCasing(testCase.extra)
+			}
+
+			got := casing.CamelCase(testCase.input)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestCasing_LowerFirst(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		input       string
+		initialisms bool
+		expected    string
+	}{
+		"legacy": {
+			input:    "ExampleThing",
+			expected: "exampleThing",
+		},
+		"legacy-leading-initialism": {
+			input:    "VPCConfig",
+			expected: "vPCConfig",
+		},
+		"initialisms": {
+			input:       "ExampleThing",
+			initialisms: true,
+			expected:    "exampleThing",
+		},
+		"initialisms-leading-initialism": {
+			input:       "VPCConfig",
+			initialisms: true,
+			expected:    "vpcConfig",
+		},
+		"initialisms-only-initialism": {
+			input:       "ID",
+			initialisms: true,
+			expected:    "id",
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var casing format.Casing
+
+			if testCase.initialisms {
+				casing = format.NewCasing(nil)
+			}
+
+			got := casing.LowerFirst(testCase.input)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,108 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package format
+
+import (
+	"bytes"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"path"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// majorVersionRegex matches the major version suffix of an import path.
+var majorVersionRegex = regexp.MustCompile(`^v[0-9]+$`)
+
+// RemoveUnusedImports removes imports which are not referenced by the Go
+// source file, and formats the result. Packages without an alias are assumed
+// to be named after the last element of their import path, ignoring any major
+// version suffix. Blank and dot imports, and imports whose last path element
+// is not a valid identifier, are left in place.
+func RemoveUnusedImports(src []byte) ([]byte, error) {
+	fset := token.NewFileSet()
+
+	f, err := parser.ParseFile(fset, "", src, parser.ParseComments)
+	if err != nil {
+		return nil, err
+	}
+
+	used := make(map[string]struct{})
+
+	ast.Inspect(f, func(n ast.Node) bool {
+		if s, ok := n.(*ast.SelectorExpr); ok {
+			if x, ok := s.X.(*ast.Ident); ok {
+				used[x.Name] = struct{}{}
+			}
+		}
+
+		return true
+	})
+
+	// lines holds the 1-based line numbers of unused import specs.
+	lines := make(map[int]struct{})
+
+	for _, i := range f.Imports {
+		name, ok := importName(i)
+
+		if !ok {
+			continue
+		}
+
+		if _, ok := used[name]; ok {
+			continue
+		}
+
+		lines[fset.Position(i.Pos()).Line] = struct{}{}
+	}
+
+	if len(lines) == 0 {
+		return format.Source(src)
+	}
+
+	var buf bytes.Buffer
+
+	for n, line := range bytes.SplitAfter(src, []byte("\n")) {
+		if _, ok := lines[n+1]; ok {
+			continue
+		}
+
+		buf.Write(line)
+	}
+
+	return format.Source(buf.Bytes())
+}
+
+// importName returns the name used to refer to an imported package, and
+// whether the import can safely be removed if the name is unused.
+func importName(i *ast.ImportSpec) (string, bool) {
+	if i.Name != nil {
+		switch i.Name.Name {
+		case "_", ".":
+			return "", false
+		default:
+			return i.Name.Name, true
+		}
+	}
+
+	p, err := strconv.Unquote(i.Path.Value)
+	if err != nil {
+		return "", false
+	}
+
+	name := path.Base(p)
+
+	if majorVersionRegex.MatchString(name) {
+		name = path.Base(path.Dir(p))
+	}
+
+	if strings.ContainsAny(name, "-.") {
+		return "", false
+	}
+
+	return name, true
+}
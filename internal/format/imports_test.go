@@ -0,0 +1,120 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package format_test
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/hashicorp/terraform-plugin-codegen-framework/internal/format"
+)
+
+func TestRemoveUnusedImports(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		input    string
+		expected string
+	}{
+		"used": {
+			input: `package example
+
+import (
+	"context"
+	"fmt"
+)
+
+func Example(ctx context.Context) string {
+	return fmt.Sprint(ctx)
+}
+`,
+			expected: `package example
+
+import (
+	"context"
+	"fmt"
+)
+
+func Example(ctx context.Context) string {
+	return fmt.Sprint(ctx)
+}
+`,
+		},
+		"unused": {
+			input: `package example
+
+import (
+	"context"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	apisdk "example.com/sdk"
+)
+
+func Example(ctx context.Context) {}
+`,
+			expected: `package example
+
+import (
+	"context"
+)
+
+func Example(ctx context.Context) {}
+`,
+		},
+		"major-version": {
+			input: `package example
+
+import (
+	"example.com/sdk/v2"
+	"example.com/other/v3"
+)
+
+var _ = sdk.Example
+`,
+			expected: `package example
+
+import (
+	"example.com/sdk/v2"
+)
+
+var _ = sdk.Example
+`,
+		},
+		"not-inferred": {
+			input: `package example
+
+import (
+	_ "embed"
+	"gopkg.in/yaml.v3"
+	"example.com/go-sdk"
+)
+`,
+			expected: `package example
+
+import (
+	_ "embed"
+	"example.com/go-sdk"
+	"gopkg.in/yaml.v3"
+)
+`,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := format.RemoveUnusedImports([]byte(testCase.input))
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if diff := cmp.Diff(string(got), testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,118 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+// Package gomod reads the module path declared by the go.mod file of the Go
+// module containing a directory, which is used to derive import paths.
+package gomod
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+// ErrNotFound is returned if there is no go.mod file in a directory or any of
+// its parent directories.
+var ErrNotFound = errors.New("go.mod not found")
+
+// ModulePath returns the path of the Go module containing dir, read from the
+// go.mod file in dir or its closest parent directory.
+func ModulePath(dir string) (string, error) {
+	_, modPath, err := find(dir)
+
+	return modPath, err
+}
+
+// ImportPath returns the import path of the package in dir, from the path of
+// the Go module containing dir and the path of dir relative to the go.mod file.
+func ImportPath(dir string) (string, error) {
+	abs, err := filepath.Abs(dir)
+	if err != nil {
+		return "", err
+	}
+
+	modDir, modPath, err := find(abs)
+	if err != nil {
+		return "", err
+	}
+
+	rel, err := filepath.Rel(modDir, abs)
+	if err != nil {
+		return "", err
+	}
+
+	return path.Join(modPath, filepath.ToSlash(rel)), nil
+}
+
+// find returns the directory containing the go.mod file in dir or its closest
+// parent directory, and the module path it declares.
+func find(dir string) (string, string, error) {
+	abs, err := filepath.Abs(dir)
+	if err != nil {
+		return "", "", err
+	}
+
+	for d := abs; ; d = filepath.Dir(d) {
+		f, err := os.Open(filepath.Join(d, "go.mod"))
+
+		if errors.Is(err, fs.ErrNotExist) {
+			if filepath.Dir(d) == d {
+				return "", "", fmt.Errorf("%w in %s or any parent directory", ErrNotFound, abs)
+			}
+
+			continue
+		}
+
+		if err != nil {
+			return "", "", err
+		}
+
+		defer f.Close()
+
+		modPath, err := modulePath(f)
+		if err != nil {
+			return "", "", fmt.Errorf("error reading %s: %w", f.Name(), err)
+		}
+
+		return d, modPath, nil
+	}
+}
+
+// modulePath returns the module path declared by the contents of a go.mod file.
+func modulePath(r io.Reader) (string, error) {
+	s := bufio.NewScanner(r)
+
+	for s.Scan() {
+		line := strings.TrimSpace(s.Text())
+
+		modPath, ok := strings.CutPrefix(line, "module")
+
+		if !ok || modPath == "" || (modPath[0] != ' ' && modPath[0] != '\t' && modPath[0] != '"') {
+			continue
+		}
+
+		modPath, _, _ = strings.Cut(modPath, "//")
+		modPath = strings.TrimSpace(modPath)
+
+		if unquoted, err := strconv.Unquote(modPath); err == nil {
+			modPath = unquoted
+		}
+
+		if modPath != "" {
+			return modPath, nil
+		}
+	}
+
+	if err := s.Err(); err != nil {
+		return "", err
+	}
+
+	return "", errors.New("no module directive")
+}
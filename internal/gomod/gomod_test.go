@@ -0,0 +1,72 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package gomod_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/hashicorp/terraform-plugin-codegen-framework/internal/gomod"
+)
+
+func TestImportPath(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		goMod         string
+		expected      string
+		expectedError bool
+	}{
+		"module": {
+			goMod:    "module github.com/example/terraform-provider-example\n\ngo 1.22\n",
+			expected: "github.com/example/terraform-provider-example/internal/provider",
+		},
+		"quoted_module_with_comment": {
+			goMod:    "// Provider module.\nmodule \"github.com/example/terraform-provider-example\" // comment\n",
+			expected: "github.com/example/terraform-provider-example/internal/provider",
+		},
+		"raw_quoted_module": {
+			goMod:    "module `github.com/example/terraform-provider-example`\n",
+			expected: "github.com/example/terraform-provider-example/internal/provider",
+		},
+		"no_module_directive": {
+			goMod:         "go 1.22\n",
+			expectedError: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			dir := t.TempDir()
+
+			err := os.WriteFile(filepath.Join(dir, "go.mod"), []byte(testCase.goMod), 0666)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			got, err := gomod.ImportPath(filepath.Join(dir, "internal", "provider"))
+
+			if testCase.expectedError {
+				if err == nil {
+					t.Fatal("expected error")
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
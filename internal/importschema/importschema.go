@@ -0,0 +1,615 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+// Package importschema converts the provider schemas output by `terraform
+// providers schema -json` into a Provider Code Specification, for providers
+// which are migrating to generated code.
+package importschema
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-codegen-framework/internal/specjson"
+)
+
+// ProviderSchemas is the output of `terraform providers schema -json`.
+type ProviderSchemas struct {
+	FormatVersion string `json:"format_version"`
+
+	// Schemas are keyed on provider source address (e.g.,
+	// registry.terraform.io/hashicorp/example).
+	Schemas map[string]*ProviderSchema `json:"provider_schemas"`
+}
+
+// ProviderSchema holds the schemas of a provider, and of its resources and data
+// sources, keyed on type name (e.g., example_thing).
+type ProviderSchema struct {
+	ConfigSchema      *Schema            `json:"provider"`
+	ResourceSchemas   map[string]*Schema `json:"resource_schemas"`
+	DataSourceSchemas map[string]*Schema `json:"data_source_schemas"`
+}
+
+// Schema is the schema of a provider, resource or data source.
+type Schema struct {
+	Version int64  `json:"version"`
+	Block   *Block `json:"block"`
+}
+
+// Block is the body of a schema or of a nested block.
+type Block struct {
+	Attributes      map[string]*Attribute `json:"attributes"`
+	NestedBlocks    map[string]*BlockType `json:"block_types"`
+	Description     string                `json:"description"`
+	DescriptionKind string                `json:"description_kind"`
+	Deprecated      bool                  `json:"deprecated"`
+}
+
+// Attribute is an attribute of a block or of a nested attribute type. Exactly
+// one of AttributeType and AttributeNestedType is set.
+type Attribute struct {
+	// AttributeType is the type constraint in its JSON encoding (e.g.,
+	// "string" or ["list","string"]).
+	AttributeType       json.RawMessage `json:"type"`
+	AttributeNestedType *NestedType     `json:"nested_type"`
+	Description         string          `json:"description"`
+	DescriptionKind     string          `json:"description_kind"`
+	Deprecated          bool            `json:"deprecated"`
+	Required            bool            `json:"required"`
+	Optional            bool            `json:"optional"`
+	Computed            bool            `json:"computed"`
+	Sensitive           bool            `json:"sensitive"`
+}
+
+// NestedType is the type of a nested attribute.
+type NestedType struct {
+	Attributes  map[string]*Attribute `json:"attributes"`
+	NestingMode string                `json:"nesting_mode"`
+}
+
+// BlockType is a nested block.
+type BlockType struct {
+	NestingMode string `json:"nesting_mode"`
+	Block       *Block `json:"block"`
+	MinItems    uint64 `json:"min_items"`
+	MaxItems    uint64 `json:"max_items"`
+}
+
+// Warning is part of a schema which is not translated exactly into the
+// specification.
+type Warning struct {
+	// Schema is the kind of schema: provider, resource or data source.
+	Schema string
+
+	// Name is the type name of the resource or data source, or the source
+	// address of the provider.
+	Name string
+
+	// Path is the dot-separated path of the attribute or block (e.g.,
+	// rule.port).
+	Path string
+
+	// Message describes how the schema is translated (e.g., the description
+	// is used as plain text).
+	Message string
+}
+
+// String returns a description of the warning, prefixed with where it is.
+func (w Warning) String() string {
+	return fmt.Sprintf("%s %q %s: %s", w.Schema, w.Name, w.Path, w.Message)
+}
+
+// validatorImportPath is the import path of the validators for a type (e.g.,
+// list) in terraform-plugin-framework-validators.
+const validatorImportPath = "github.com/hashicorp/terraform-plugin-framework-validators/%svalidator"
+
+// Parse returns the provider schemas in the JSON output of `terraform providers
+// schema -json`.
+func Parse(src []byte) (ProviderSchemas, error) {
+	var s ProviderSchemas
+
+	err := json.Unmarshal(src, &s)
+	if err != nil {
+		return ProviderSchemas{}, err
+	}
+
+	if s.FormatVersion == "" {
+		return ProviderSchemas{}, errors.New("format_version is missing, expected the output of `terraform providers schema -json`")
+	}
+
+	if !strings.HasPrefix(s.FormatVersion, "1.") {
+		return ProviderSchemas{}, fmt.Errorf("unsupported format_version %q", s.FormatVersion)
+	}
+
+	if len(s.Schemas) == 0 {
+		return ProviderSchemas{}, errors.New("no provider schemas found")
+	}
+
+	return s, nil
+}
+
+// Specification returns the specification of the provider, and of its
+// resources and data sources. The provider is selected by source address or by
+// name (e.g., registry.terraform.io/hashicorp/example or example), and may be
+// empty if there is only one provider.
+//
+// The provider name is the last part of the source address, with hyphens
+// replaced by underscores, and is removed from the start of resource and data
+// source type names (e.g., example_thing becomes thing).
+//
+// The minimum and maximum number of nested blocks become size validators.
+// Markdown descriptions of attributes and blocks, which the specification does
+// not support, are used as plain text descriptions, and returned as warnings.
+func (p ProviderSchemas) Specification(provider string) (specjson.Specification, []Warning, error) {
+	address, err := p.address(provider)
+	if err != nil {
+		return specjson.Specification{}, nil, err
+	}
+
+	ps := p.Schemas[address]
+	providerName := strings.ReplaceAll(address[strings.LastIndex(address, "/")+1:], "-", "_")
+
+	s := specjson.Specification{
+		Provider: &specjson.Provider{
+			Name: providerName,
+		},
+		Version: specjson.Version,
+	}
+
+	var warnings []Warning
+
+	if ps.ConfigSchema != nil && ps.ConfigSchema.Block != nil {
+		c := converter{
+			provider: true,
+			schema:   "provider",
+			name:     address,
+			warnings: &warnings,
+		}
+
+		body, err := c.schemaBody(ps.ConfigSchema.Block, "provider")
+		if err != nil {
+			return specjson.Specification{}, nil, fmt.Errorf("provider: %w", err)
+		}
+
+		if len(body.Attributes) > 0 || len(body.Blocks) > 0 || body.Description != "" || body.MarkdownDescription != "" || body.DeprecationMessage != "" {
+			s.Provider.Schema = &body
+		}
+	}
+
+	s.Resources, err = schemas(ps.ResourceSchemas, providerName, "resource", &warnings)
+	if err != nil {
+		return specjson.Specification{}, nil, err
+	}
+
+	s.DataSources, err = schemas(ps.DataSourceSchemas, providerName, "data source", &warnings)
+	if err != nil {
+		return specjson.Specification{}, nil, err
+	}
+
+	return s, warnings, nil
+}
+
+// address returns the source address of the selected provider.
+func (p ProviderSchemas) address(provider string) (string, error) {
+	addresses := sortedNames(p.Schemas)
+
+	if provider == "" {
+		if len(addresses) > 1 {
+			return "", fmt.Errorf("multiple providers found, select one of: %s", strings.Join(addresses, ", "))
+		}
+
+		return addresses[0], nil
+	}
+
+	for _, address := range addresses {
+		if address == provider || address[strings.LastIndex(address, "/")+1:] == provider {
+			return address, nil
+		}
+	}
+
+	return "", fmt.Errorf("provider %q not found, select one of: %s", provider, strings.Join(addresses, ", "))
+}
+
+// schemas returns the resource or data source schemas in order of name.
+func schemas(typeSchemas map[string]*Schema, providerName, description string, warnings *[]Warning) ([]specjson.Schema, error) {
+	var result []specjson.Schema
+
+	for _, typeName := range sortedNames(typeSchemas) {
+		name := typeName
+
+		if trimmed := strings.TrimPrefix(typeName, providerName+"_"); trimmed != "" {
+			name = trimmed
+		}
+
+		s := typeSchemas[typeName]
+
+		if s == nil || s.Block == nil {
+			return nil, fmt.Errorf("%s %q: schema is missing", description, typeName)
+		}
+
+		c := converter{
+			schema:   description,
+			name:     typeName,
+			warnings: warnings,
+		}
+
+		body, err := c.schemaBody(s.Block, description)
+		if err != nil {
+			return nil, fmt.Errorf("%s %q: %w", description, typeName, err)
+		}
+
+		result = append(result, specjson.Schema{
+			Name:   name,
+			Schema: body,
+		})
+	}
+
+	return result, nil
+}
+
+// converter converts provider, or resource and data source, schemas.
+type converter struct {
+	// provider indicates that attributes are optional or required, rather
+	// than computed, optional or required.
+	provider bool
+
+	// schema and name are the kind and name of the schema being converted,
+	// which are used in warnings.
+	schema string
+	name   string
+
+	warnings *[]Warning
+}
+
+func (c converter) warn(path, format string, a ...any) {
+	*c.warnings = append(*c.warnings, Warning{
+		Schema:  c.schema,
+		Name:    c.name,
+		Path:    path,
+		Message: fmt.Sprintf(format, a...),
+	})
+}
+
+// description returns the description of an attribute or block, warning if it
+// is markdown, which the specification only supports for schemas.
+func (c converter) description(description, kind, path string) string {
+	if description != "" && kind == "markdown" {
+		c.warn(path, "markdown description is used as a plain text description, as the specification only supports markdown descriptions for schemas")
+	}
+
+	return description
+}
+
+// schemaBody returns the schema of a provider, resource or data source. The
+// description is used in the deprecation message.
+func (c converter) schemaBody(b *Block, description string) (specjson.SchemaBody, error) {
+	attributes, err := c.attributes(b.Attributes, "")
+	if err != nil {
+		return specjson.SchemaBody{}, err
+	}
+
+	blocks, err := c.blocks(b.NestedBlocks, "")
+	if err != nil {
+		return specjson.SchemaBody{}, err
+	}
+
+	body := specjson.SchemaBody{
+		Attributes: attributes,
+		Blocks:     blocks,
+	}
+
+	if b.DescriptionKind == "markdown" {
+		body.MarkdownDescription = b.Description
+	} else {
+		body.Description = b.Description
+	}
+
+	if b.Deprecated {
+		body.DeprecationMessage = fmt.Sprintf("This %s is deprecated.", description)
+	}
+
+	return body, nil
+}
+
+// attributes returns the attributes in order of name. The path of the parent
+// attribute or block is used in error messages.
+func (c converter) attributes(attributes map[string]*Attribute, path string) ([]specjson.Attribute, error) {
+	var result []specjson.Attribute
+
+	for _, name := range sortedNames(attributes) {
+		a, err := c.attribute(name, attributes[name], path+name)
+		if err != nil {
+			return nil, err
+		}
+
+		result = append(result, a)
+	}
+
+	return result, nil
+}
+
+// attribute returns the attribute of the name.
+func (c converter) attribute(name string, a *Attribute, path string) (specjson.Attribute, error) {
+	if a == nil {
+		return specjson.Attribute{}, fmt.Errorf("attribute %q: schema is missing", path)
+	}
+
+	t := &specjson.AttributeType{
+		Description: c.description(a.Description, a.DescriptionKind, path),
+	}
+
+	switch {
+	case c.provider && a.Required:
+		t.OptionalRequired = "required"
+	case c.provider:
+		t.OptionalRequired = "optional"
+	case a.Required:
+		t.ComputedOptionalRequired = "required"
+	case a.Optional && a.Computed:
+		t.ComputedOptionalRequired = "computed_optional"
+	case a.Optional:
+		t.ComputedOptionalRequired = "optional"
+	default:
+		t.ComputedOptionalRequired = "computed"
+	}
+
+	if a.Deprecated {
+		t.DeprecationMessage = "This attribute is deprecated."
+	}
+
+	if a.Sensitive {
+		sensitive := true
+		t.Sensitive = &sensitive
+	}
+
+	result := specjson.Attribute{
+		Name: name,
+	}
+
+	if a.AttributeNestedType != nil {
+		attributes, err := c.attributes(a.AttributeNestedType.Attributes, path+".")
+		if err != nil {
+			return specjson.Attribute{}, err
+		}
+
+		switch a.AttributeNestedType.NestingMode {
+		case "single":
+			t.Attributes = attributes
+			result.SingleNested = t
+		case "list":
+			t.NestedObject = &specjson.NestedObject{Attributes: attributes}
+			result.ListNested = t
+		case "map":
+			t.NestedObject = &specjson.NestedObject{Attributes: attributes}
+			result.MapNested = t
+		case "set":
+			t.NestedObject = &specjson.NestedObject{Attributes: attributes}
+			result.SetNested = t
+		default:
+			return specjson.Attribute{}, fmt.Errorf("attribute %q: unsupported nesting mode %q", path, a.AttributeNestedType.NestingMode)
+		}
+
+		return result, nil
+	}
+
+	typ, err := parseType(a.AttributeType)
+	if err != nil {
+		return specjson.Attribute{}, fmt.Errorf("attribute %q: %w", path, err)
+	}
+
+	switch {
+	case typ.Bool != nil:
+		result.Bool = t
+	case typ.Number != nil:
+		result.Number = t
+	case typ.String != nil:
+		result.String = t
+	case typ.List != nil:
+		t.ElementType = &typ.List.ElementType
+		result.List = t
+	case typ.Map != nil:
+		t.ElementType = &typ.Map.ElementType
+		result.Map = t
+	case typ.Set != nil:
+		t.ElementType = &typ.Set.ElementType
+		result.Set = t
+	case typ.Object != nil:
+		t.AttributeTypes = typ.Object.AttributeTypes
+		result.Object = t
+	}
+
+	return result, nil
+}
+
+// blocks returns the nested blocks in order of name. The path of the parent
+// block is used in error messages.
+func (c converter) blocks(blocks map[string]*BlockType, path string) ([]specjson.Block, error) {
+	var result []specjson.Block
+
+	for _, name := range sortedNames(blocks) {
+		b := blocks[name]
+
+		if b == nil || b.Block == nil {
+			return nil, fmt.Errorf("block %q: schema is missing", path+name)
+		}
+
+		attributes, err := c.attributes(b.Block.Attributes, path+name+".")
+		if err != nil {
+			return nil, err
+		}
+
+		nestedBlocks, err := c.blocks(b.Block.NestedBlocks, path+name+".")
+		if err != nil {
+			return nil, err
+		}
+
+		t := &specjson.BlockType{
+			Description: c.description(b.Block.Description, b.Block.DescriptionKind, path+name),
+			Validators:  sizeValidators(b),
+		}
+
+		if b.Block.Deprecated {
+			t.DeprecationMessage = "This block is deprecated."
+		}
+
+		block := specjson.Block{
+			Name: name,
+		}
+
+		switch b.NestingMode {
+		case "single", "group":
+			t.Attributes = attributes
+			t.Blocks = nestedBlocks
+			block.SingleNested = t
+		case "list":
+			t.NestedObject = &specjson.NestedObject{Attributes: attributes, Blocks: nestedBlocks}
+			block.ListNested = t
+		case "set":
+			t.NestedObject = &specjson.NestedObject{Attributes: attributes, Blocks: nestedBlocks}
+			block.SetNested = t
+		default:
+			return nil, fmt.Errorf("block %q: unsupported nesting mode %q", path+name, b.NestingMode)
+		}
+
+		result = append(result, block)
+	}
+
+	return result, nil
+}
+
+// sizeValidators returns validators of the minimum and maximum number of nested
+// blocks. A single nested block with a minimum of one is required, whereas the
+// maximum of one is implied.
+func sizeValidators(b *BlockType) []specjson.Custom {
+	switch b.NestingMode {
+	case "single", "group":
+		if b.MinItems > 0 {
+			return []specjson.Custom{validator("object", "objectvalidator.IsRequired()")}
+		}
+
+		return nil
+	}
+
+	var validators []specjson.Custom
+
+	if b.MinItems > 0 {
+		validators = append(validators, validator(b.NestingMode, fmt.Sprintf("%svalidator.SizeAtLeast(%d)", b.NestingMode, b.MinItems)))
+	}
+
+	if b.MaxItems > 0 {
+		validators = append(validators, validator(b.NestingMode, fmt.Sprintf("%svalidator.SizeAtMost(%d)", b.NestingMode, b.MaxItems)))
+	}
+
+	return validators
+}
+
+// validator returns a validator of terraform-plugin-framework-validators for
+// the type (e.g., list).
+func validator(typeKey, definition string) specjson.Custom {
+	return specjson.Custom{
+		Custom: specjson.CustomDefinition{
+			Imports: []specjson.Import{
+				{Path: fmt.Sprintf(validatorImportPath, typeKey)},
+			},
+			SchemaDefinition: definition,
+		},
+	}
+}
+
+// parseType returns the type of the JSON encoding of a type constraint, such as
+// "string", ["list","string"] or ["object",{"name":"string"}].
+func parseType(src json.RawMessage) (specjson.Type, error) {
+	if len(src) == 0 {
+		return specjson.Type{}, errors.New("type is missing")
+	}
+
+	var primitive string
+
+	if json.Unmarshal(src, &primitive) == nil {
+		switch primitive {
+		case "bool":
+			return specjson.Type{Bool: &struct{}{}}, nil
+		case "number":
+			return specjson.Type{Number: &struct{}{}}, nil
+		case "string":
+			return specjson.Type{String: &struct{}{}}, nil
+		}
+
+		// dynamic types are not supported by the generator
+		return specjson.Type{}, fmt.Errorf("unsupported type %q", primitive)
+	}
+
+	var complex []json.RawMessage
+
+	err := json.Unmarshal(src, &complex)
+	if err != nil || len(complex) < 2 {
+		return specjson.Type{}, fmt.Errorf("invalid type %s", src)
+	}
+
+	var kind string
+
+	err = json.Unmarshal(complex[0], &kind)
+	if err != nil {
+		return specjson.Type{}, fmt.Errorf("invalid type %s", src)
+	}
+
+	switch kind {
+	case "list", "map", "set":
+		elementType, err := parseType(complex[1])
+		if err != nil {
+			return specjson.Type{}, err
+		}
+
+		c := &specjson.CollectionType{ElementType: elementType}
+
+		switch kind {
+		case "list":
+			return specjson.Type{List: c}, nil
+		case "map":
+			return specjson.Type{Map: c}, nil
+		default:
+			return specjson.Type{Set: c}, nil
+		}
+	case "object":
+		var attributeTypes map[string]json.RawMessage
+
+		err = json.Unmarshal(complex[1], &attributeTypes)
+		if err != nil {
+			return specjson.Type{}, fmt.Errorf("invalid type %s", src)
+		}
+
+		o := &specjson.ObjectType{
+			AttributeTypes: []specjson.Type{},
+		}
+
+		for _, name := range sortedNames(attributeTypes) {
+			attributeType, err := parseType(attributeTypes[name])
+			if err != nil {
+				return specjson.Type{}, err
+			}
+
+			attributeType.Name = name
+			o.AttributeTypes = append(o.AttributeTypes, attributeType)
+		}
+
+		return specjson.Type{Object: o}, nil
+	}
+
+	return specjson.Type{}, fmt.Errorf("unsupported type %s", src)
+}
+
+// sortedNames returns the keys of the map in order.
+func sortedNames[T any](m map[string]T) []string {
+	names := make([]string, 0, len(m))
+
+	for name := range m {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+
+	return names
+}
@@ -0,0 +1,353 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package importschema_test
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/hashicorp/terraform-plugin-codegen-framework/internal/importschema"
+	"github.com/hashicorp/terraform-plugin-codegen-framework/internal/specjson"
+)
+
+func TestProviderSchemas_Specification(t *testing.T) {
+	t.Parallel()
+
+	sensitive := true
+
+	testCases := map[string]struct {
+		src              string
+		provider         string
+		expected         specjson.Specification
+		expectedWarnings []importschema.Warning
+		expectedError    string
+	}{
+		"empty_provider": {
+			src: `{
+  "format_version": "1.0",
+  "provider_schemas": {
+    "registry.terraform.io/hashicorp/example-beta": {
+      "provider": {"block": {"description_kind": "plain"}},
+      "resource_schemas": {
+        "example_beta_thing": {"block": {"attributes": {"id": {"type": "string", "computed": true}}}},
+        "example_beta": {"block": {"attributes": {"id": {"type": "string", "computed": true}}}}
+      }
+    }
+  }
+}`,
+			expected: specjson.Specification{
+				Provider: &specjson.Provider{Name: "example_beta"},
+				Resources: []specjson.Schema{
+					{
+						Name: "example_beta",
+						Schema: specjson.SchemaBody{
+							Attributes: []specjson.Attribute{
+								{Name: "id", String: &specjson.AttributeType{ComputedOptionalRequired: "computed"}},
+							},
+						},
+					},
+					{
+						Name: "thing",
+						Schema: specjson.SchemaBody{
+							Attributes: []specjson.Attribute{
+								{Name: "id", String: &specjson.AttributeType{ComputedOptionalRequired: "computed"}},
+							},
+						},
+					},
+				},
+				Version: specjson.Version,
+			},
+		},
+		"provider_attributes": {
+			src: `{
+  "format_version": "1.0",
+  "provider_schemas": {
+    "registry.terraform.io/hashicorp/example": {
+      "provider": {
+        "block": {
+          "attributes": {
+            "token": {"type": "string", "optional": true, "computed": true, "sensitive": true},
+            "regions": {"type": ["list", ["object", {"name": "string"}]], "required": true}
+          },
+          "description": "Example provider.",
+          "description_kind": "markdown"
+        }
+      }
+    }
+  }
+}`,
+			expected: specjson.Specification{
+				Provider: &specjson.Provider{
+					Name: "example",
+					Schema: &specjson.SchemaBody{
+						Attributes: []specjson.Attribute{
+							{
+								Name: "regions",
+								List: &specjson.AttributeType{
+									OptionalRequired: "required",
+									ElementType: &specjson.Type{
+										Object: &specjson.ObjectType{
+											AttributeTypes: []specjson.Type{
+												{Name: "name", String: &struct{}{}},
+											},
+										},
+									},
+								},
+							},
+							{
+								Name:   "token",
+								String: &specjson.AttributeType{OptionalRequired: "optional", Sensitive: &sensitive},
+							},
+						},
+						MarkdownDescription: "Example provider.",
+					},
+				},
+				Version: specjson.Version,
+			},
+		},
+		"block_sizes": {
+			src: `{
+  "format_version": "1.0",
+  "provider_schemas": {
+    "registry.terraform.io/hashicorp/example": {
+      "resource_schemas": {
+        "example_thing": {
+          "block": {
+            "block_types": {
+              "config": {"nesting_mode": "single", "block": {}, "min_items": 1, "max_items": 1},
+              "rule": {"nesting_mode": "list", "block": {}, "min_items": 1, "max_items": 3},
+              "tag": {"nesting_mode": "set", "block": {}, "max_items": 10}
+            }
+          }
+        }
+      }
+    }
+  }
+}`,
+			expected: specjson.Specification{
+				Provider: &specjson.Provider{Name: "example"},
+				Resources: []specjson.Schema{
+					{
+						Name: "thing",
+						Schema: specjson.SchemaBody{
+							Blocks: []specjson.Block{
+								{
+									Name: "config",
+									SingleNested: &specjson.BlockType{
+										Validators: []specjson.Custom{
+											validator("objectvalidator", "objectvalidator.IsRequired()"),
+										},
+									},
+								},
+								{
+									Name: "rule",
+									ListNested: &specjson.BlockType{
+										NestedObject: &specjson.NestedObject{},
+										Validators: []specjson.Custom{
+											validator("listvalidator", "listvalidator.SizeAtLeast(1)"),
+											validator("listvalidator", "listvalidator.SizeAtMost(3)"),
+										},
+									},
+								},
+								{
+									Name: "tag",
+									SetNested: &specjson.BlockType{
+										NestedObject: &specjson.NestedObject{},
+										Validators: []specjson.Custom{
+											validator("setvalidator", "setvalidator.SizeAtMost(10)"),
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+				Version: specjson.Version,
+			},
+		},
+		"markdown_descriptions": {
+			src: `{
+  "format_version": "1.0",
+  "provider_schemas": {
+    "registry.terraform.io/hashicorp/example": {
+      "data_source_schemas": {
+        "example_thing": {
+          "block": {
+            "attributes": {
+              "name": {"type": "string", "computed": true, "description": "The ` + "`name`" + `.", "description_kind": "markdown"}
+            },
+            "block_types": {
+              "rule": {"nesting_mode": "list", "block": {"description": "A **rule**.", "description_kind": "markdown"}}
+            },
+            "description": "A **thing**.",
+            "description_kind": "markdown"
+          }
+        }
+      }
+    }
+  }
+}`,
+			expected: specjson.Specification{
+				Provider: &specjson.Provider{Name: "example"},
+				DataSources: []specjson.Schema{
+					{
+						Name: "thing",
+						Schema: specjson.SchemaBody{
+							Attributes: []specjson.Attribute{
+								{Name: "name", String: &specjson.AttributeType{ComputedOptionalRequired: "computed", Description: "The `name`."}},
+							},
+							Blocks: []specjson.Block{
+								{
+									Name: "rule",
+									ListNested: &specjson.BlockType{
+										Description:  "A **rule**.",
+										NestedObject: &specjson.NestedObject{},
+									},
+								},
+							},
+							MarkdownDescription: "A **thing**.",
+						},
+					},
+				},
+				Version: specjson.Version,
+			},
+			expectedWarnings: []importschema.Warning{
+				{
+					Schema:  "data source",
+					Name:    "example_thing",
+					Path:    "name",
+					Message: "markdown description is used as a plain text description, as the specification only supports markdown descriptions for schemas",
+				},
+				{
+					Schema:  "data source",
+					Name:    "example_thing",
+					Path:    "rule",
+					Message: "markdown description is used as a plain text description, as the specification only supports markdown descriptions for schemas",
+				},
+			},
+		},
+		"multiple_providers": {
+			src: `{
+  "format_version": "1.0",
+  "provider_schemas": {
+    "registry.terraform.io/hashicorp/one": {},
+    "registry.terraform.io/hashicorp/two": {}
+  }
+}`,
+			expectedError: "multiple providers found, select one of: registry.terraform.io/hashicorp/one, registry.terraform.io/hashicorp/two",
+		},
+		"select_provider": {
+			src: `{
+  "format_version": "1.0",
+  "provider_schemas": {
+    "registry.terraform.io/hashicorp/one": {},
+    "registry.terraform.io/hashicorp/two": {}
+  }
+}`,
+			provider: "two",
+			expected: specjson.Specification{
+				Provider: &specjson.Provider{Name: "two"},
+				Version:  specjson.Version,
+			},
+		},
+		"dynamic_attribute": {
+			src: `{
+  "format_version": "1.0",
+  "provider_schemas": {
+    "registry.terraform.io/hashicorp/example": {
+      "data_source_schemas": {
+        "example_thing": {"block": {"attributes": {"value": {"type": "dynamic", "computed": true}}}}
+      }
+    }
+  }
+}`,
+			expectedError: `data source "example_thing": attribute "value": unsupported type "dynamic"`,
+		},
+		"tuple_attribute": {
+			src: `{
+  "format_version": "1.0",
+  "provider_schemas": {
+    "registry.terraform.io/hashicorp/example": {
+      "resource_schemas": {
+        "example_thing": {
+          "block": {
+            "block_types": {
+              "rule": {
+                "nesting_mode": "list",
+                "block": {"attributes": {"pair": {"type": ["tuple", ["string", "number"]], "optional": true}}}
+              }
+            }
+          }
+        }
+      }
+    }
+  }
+}`,
+			expectedError: `resource "example_thing": attribute "rule.pair": unsupported type ["tuple", ["string", "number"]]`,
+		},
+		"map_block": {
+			src: `{
+  "format_version": "1.0",
+  "provider_schemas": {
+    "registry.terraform.io/hashicorp/example": {
+      "resource_schemas": {
+        "example_thing": {"block": {"block_types": {"rule": {"nesting_mode": "map", "block": {}}}}}
+      }
+    }
+  }
+}`,
+			expectedError: `resource "example_thing": block "rule": unsupported nesting mode "map"`,
+		},
+	}
+
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			p, err := importschema.Parse([]byte(testCase.src))
+			if err != nil {
+				t.Fatalf("unexpected error parsing provider schemas: %s", err)
+			}
+
+			got, warnings, err := p.Specification(testCase.provider)
+
+			if testCase.expectedError != "" {
+				if err == nil {
+					t.Fatalf("expected error %q", testCase.expectedError)
+				}
+
+				if diff := cmp.Diff(err.Error(), testCase.expectedError); diff != "" {
+					t.Errorf("unexpected error difference: %s", diff)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(warnings, testCase.expectedWarnings); diff != "" {
+				t.Errorf("unexpected warnings difference: %s", diff)
+			}
+		})
+	}
+}
+
+func validator(pkg, definition string) specjson.Custom {
+	return specjson.Custom{
+		Custom: specjson.CustomDefinition{
+			Imports: []specjson.Import{
+				{Path: "github.com/hashicorp/terraform-plugin-framework-validators/" + pkg},
+			},
+			SchemaDefinition: definition,
+		},
+	}
+}
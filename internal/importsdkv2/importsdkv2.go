@@ -0,0 +1,675 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+// Package importsdkv2 converts the schemas of a provider written with
+// terraform-plugin-sdk/v2 into a Provider Code Specification, by parsing the Go
+// source of the provider package, for providers which are migrating to the
+// Plugin Framework.
+//
+// Schemas must be declared as composite literals, either directly or returned
+// by functions or assigned to package variables in the same package. Anything
+// which cannot be translated, such as a DiffSuppressFunc or a schema which is
+// built at runtime, is returned as an Untranslated entry for manual migration.
+package importsdkv2
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/hashicorp/terraform-plugin-codegen-framework/internal/specjson"
+)
+
+const (
+	schemaImportPath     = "github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	validationImportPath = "github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+const (
+	SchemaProvider   = "provider"
+	SchemaResource   = "resource"
+	SchemaDataSource = "data source"
+)
+
+// Untranslated is part of an SDKv2 schema which could not be translated into the
+// specification.
+type Untranslated struct {
+	// Schema is the kind of schema: provider, resource or data source.
+	Schema string
+
+	// Name is the name of the provider, resource or data source.
+	Name string
+
+	// Path is the dot-separated path of the attribute or block (e.g.,
+	// rule.port), which is empty for the schema itself.
+	Path string
+
+	// Message describes what was not translated (e.g., DiffSuppressFunc is
+	// not translated).
+	Message string
+}
+
+// String returns a description of what was not translated, prefixed with where
+// it is.
+func (u Untranslated) String() string {
+	if u.Path == "" {
+		return fmt.Sprintf("%s %q: %s", u.Schema, u.Name, u.Message)
+	}
+
+	return fmt.Sprintf("%s %q %s: %s", u.Schema, u.Name, u.Path, u.Message)
+}
+
+// Import returns the specification of the provider, resources and data sources
+// declared in the Go package in the directory, together with anything which
+// could not be translated.
+//
+// Resources and data sources are found in the ResourcesMap and DataSourcesMap
+// of the schema.Provider literal in the package. If there is none, functions
+// named resourceX or dataSourceX which return a schema.Resource literal are
+// used. The provider name is removed from the start of resource and data source
+// type names (e.g., example_thing becomes thing). If providerName is empty, it
+// is the part of the type names before the first underscore.
+func Import(dir, providerName string) (specjson.Specification, []Untranslated, error) {
+	p, err := parsePackage(dir)
+	if err != nil {
+		return specjson.Specification{}, nil, err
+	}
+
+	var providerLit *ast.CompositeLit
+
+	for _, f := range p.files {
+		ast.Inspect(f, func(n ast.Node) bool {
+			lit, ok := n.(*ast.CompositeLit)
+			if ok && providerLit == nil && p.isSchemaType(lit.Type, "Provider") {
+				providerLit = lit
+			}
+
+			return providerLit == nil
+		})
+	}
+
+	var resources, dataSources map[string]*ast.CompositeLit
+
+	if providerLit != nil {
+		fields := p.fields(providerLit)
+
+		resources, err = p.schemaMap(fields["ResourcesMap"])
+		if err != nil {
+			return specjson.Specification{}, nil, fmt.Errorf("ResourcesMap: %w", err)
+		}
+
+		dataSources, err = p.schemaMap(fields["DataSourcesMap"])
+		if err != nil {
+			return specjson.Specification{}, nil, fmt.Errorf("DataSourcesMap: %w", err)
+		}
+	} else {
+		resources, dataSources = p.schemaFuncs()
+	}
+
+	if providerName == "" {
+		providerName = typeNamePrefix(resources, dataSources)
+	}
+
+	if providerName == "" {
+		return specjson.Specification{}, nil, errors.New("provider name could not be determined from resource and data source type names, and must be set")
+	}
+
+	var untranslated []Untranslated
+
+	s := specjson.Specification{
+		Provider: &specjson.Provider{
+			Name: providerName,
+		},
+		Version: specjson.Version,
+	}
+
+	if providerLit != nil {
+		c := converter{
+			pkg:          p,
+			kind:         SchemaProvider,
+			name:         providerName,
+			untranslated: &untranslated,
+		}
+
+		attributes, blocks := c.schema(p.fields(providerLit)["Schema"], "", false)
+
+		if len(attributes) > 0 || len(blocks) > 0 {
+			s.Provider.Schema = &specjson.SchemaBody{
+				Attributes: attributes,
+				Blocks:     blocks,
+			}
+		}
+	}
+
+	s.Resources = p.schemas(resources, SchemaResource, providerName, &untranslated)
+	s.DataSources = p.schemas(dataSources, SchemaDataSource, providerName, &untranslated)
+
+	return s, untranslated, nil
+}
+
+// pkg is a parsed Go package.
+type pkg struct {
+	fset  *token.FileSet
+	files []*ast.File
+
+	// funcs and values are the package functions, and the values of package
+	// variables and constants, keyed on name.
+	funcs  map[string]*ast.FuncDecl
+	values map[string]ast.Expr
+
+	// schemaNames and validationNames are the names the SDKv2 schema and
+	// validation packages are imported as.
+	schemaNames     map[string]bool
+	validationNames map[string]bool
+}
+
+// parsePackage parses the non-test Go files in the directory.
+func parsePackage(dir string) (*pkg, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	p := &pkg{
+		fset:            token.NewFileSet(),
+		funcs:           make(map[string]*ast.FuncDecl),
+		values:          make(map[string]ast.Expr),
+		schemaNames:     make(map[string]bool),
+		validationNames: make(map[string]bool),
+	}
+
+	for _, entry := range entries {
+		name := entry.Name()
+
+		if entry.IsDir() || !strings.HasSuffix(name, ".go") || strings.HasSuffix(name, "_test.go") {
+			continue
+		}
+
+		f, err := parser.ParseFile(p.fset, filepath.Join(dir, name), nil, 0)
+		if err != nil {
+			return nil, err
+		}
+
+		p.files = append(p.files, f)
+
+		for _, i := range f.Imports {
+			path, _ := strconv.Unquote(i.Path.Value)
+
+			var names map[string]bool
+
+			switch path {
+			case schemaImportPath:
+				names = p.schemaNames
+			case validationImportPath:
+				names = p.validationNames
+			default:
+				continue
+			}
+
+			if i.Name != nil {
+				names[i.Name.Name] = true
+			} else {
+				names[path[strings.LastIndex(path, "/")+1:]] = true
+			}
+		}
+
+		for _, decl := range f.Decls {
+			switch d := decl.(type) {
+			case *ast.FuncDecl:
+				if d.Recv == nil && d.Body != nil {
+					p.funcs[d.Name.Name] = d
+				}
+			case *ast.GenDecl:
+				for _, spec := range d.Specs {
+					v, ok := spec.(*ast.ValueSpec)
+					if !ok {
+						continue
+					}
+
+					for i, name := range v.Names {
+						if i < len(v.Values) {
+							p.values[name.Name] = v.Values[i]
+						}
+					}
+				}
+			}
+		}
+	}
+
+	if len(p.files) == 0 {
+		return nil, fmt.Errorf("no Go files found in %s", dir)
+	}
+
+	if len(p.schemaNames) == 0 {
+		return nil, fmt.Errorf("no Go files in %s import %s", dir, schemaImportPath)
+	}
+
+	return p, nil
+}
+
+// schemaMap returns the schema.Resource literals in a ResourcesMap or
+// DataSourcesMap, keyed on type name.
+func (p *pkg) schemaMap(expr ast.Expr) (map[string]*ast.CompositeLit, error) {
+	result := make(map[string]*ast.CompositeLit)
+
+	if expr == nil {
+		return result, nil
+	}
+
+	lit := p.resolve(expr)
+	if lit == nil {
+		return nil, errors.New("not a map literal")
+	}
+
+	for _, elt := range lit.Elts {
+		kv, ok := elt.(*ast.KeyValueExpr)
+		if !ok {
+			continue
+		}
+
+		typeName, ok := p.stringValue(kv.Key)
+		if !ok {
+			return nil, fmt.Errorf("key %s is not a string", p.source(kv.Key))
+		}
+
+		r := p.resolve(kv.Value)
+		if r == nil {
+			return nil, fmt.Errorf("%s: %s is not a schema.Resource literal", typeName, p.source(kv.Value))
+		}
+
+		result[typeName] = r
+	}
+
+	return result, nil
+}
+
+// schemaFuncs returns the schema.Resource literals returned by functions named
+// resourceX and dataSourceX, keyed on the snake case of X.
+func (p *pkg) schemaFuncs() (map[string]*ast.CompositeLit, map[string]*ast.CompositeLit) {
+	resources := make(map[string]*ast.CompositeLit)
+	dataSources := make(map[string]*ast.CompositeLit)
+
+	for name, fn := range p.funcs {
+		lit := p.returnValue(fn, 0)
+
+		if lit == nil || !p.isSchemaType(lit.Type, "Resource") {
+			continue
+		}
+
+		for prefix, m := range map[string]map[string]*ast.CompositeLit{"resource": resources, "dataSource": dataSources} {
+			rest, ok := strings.CutPrefix(name, prefix)
+			if !ok {
+				rest, ok = strings.CutPrefix(name, strings.ToUpper(prefix[:1])+prefix[1:])
+			}
+
+			if ok && rest != "" && unicode.IsUpper(rune(rest[0])) {
+				m[snakeCase(rest)] = lit
+			}
+		}
+	}
+
+	return resources, dataSources
+}
+
+// schemas returns the specification of the resources or data sources, in order
+// of name.
+func (p *pkg) schemas(lits map[string]*ast.CompositeLit, kind, providerName string, untranslated *[]Untranslated) []specjson.Schema {
+	typeNames := make([]string, 0, len(lits))
+
+	for typeName := range lits {
+		typeNames = append(typeNames, typeName)
+	}
+
+	sort.Strings(typeNames)
+
+	var result []specjson.Schema
+
+	for _, typeName := range typeNames {
+		name := typeName
+
+		if trimmed := strings.TrimPrefix(typeName, providerName+"_"); trimmed != "" {
+			name = trimmed
+		}
+
+		c := converter{
+			pkg:          p,
+			kind:         kind,
+			name:         name,
+			untranslated: untranslated,
+		}
+
+		result = append(result, specjson.Schema{
+			Name:   name,
+			Schema: c.resource(lits[typeName]),
+		})
+	}
+
+	return result
+}
+
+// typeNamePrefix returns the part of the resource and data source type names
+// before the first underscore, if it is the same for all type names.
+func typeNamePrefix(lits ...map[string]*ast.CompositeLit) string {
+	var prefix string
+
+	for _, m := range lits {
+		for typeName := range m {
+			before, _, ok := strings.Cut(typeName, "_")
+			if !ok || (prefix != "" && before != prefix) {
+				return ""
+			}
+
+			prefix = before
+		}
+	}
+
+	return prefix
+}
+
+// isSchemaType returns true if the expression is the named type, or a pointer
+// to it, in the SDKv2 schema package.
+func (p *pkg) isSchemaType(expr ast.Expr, name string) bool {
+	if star, ok := expr.(*ast.StarExpr); ok {
+		expr = star.X
+	}
+
+	sel, ok := p.schemaSelector(expr)
+
+	return ok && sel == name
+}
+
+// schemaSelector returns the name selected from the SDKv2 schema package (e.g.,
+// TypeString for schema.TypeString).
+func (p *pkg) schemaSelector(expr ast.Expr) (string, bool) {
+	return selector(expr, p.schemaNames)
+}
+
+// selector returns the name selected from one of the named packages.
+func selector(expr ast.Expr, packageNames map[string]bool) (string, bool) {
+	sel, ok := expr.(*ast.SelectorExpr)
+	if !ok {
+		return "", false
+	}
+
+	x, ok := sel.X.(*ast.Ident)
+	if !ok || !packageNames[x.Name] {
+		return "", false
+	}
+
+	return sel.Sel.Name, true
+}
+
+// resolve returns the composite literal which the expression evaluates to,
+// following package variables, and calls to package functions whose last
+// statement returns a composite literal or a variable assigned one. Nil is
+// returned if there is no such literal.
+func (p *pkg) resolve(expr ast.Expr) *ast.CompositeLit {
+	return p.resolveDepth(expr, 0)
+}
+
+func (p *pkg) resolveDepth(expr ast.Expr, depth int) *ast.CompositeLit {
+	// guard against recursive functions and variables
+	if depth > 10 {
+		return nil
+	}
+
+	switch e := expr.(type) {
+	case *ast.CompositeLit:
+		return e
+	case *ast.ParenExpr:
+		return p.resolveDepth(e.X, depth+1)
+	case *ast.UnaryExpr:
+		if e.Op == token.AND {
+			return p.resolveDepth(e.X, depth+1)
+		}
+	case *ast.Ident:
+		if v, ok := p.values[e.Name]; ok {
+			return p.resolveDepth(v, depth+1)
+		}
+	case *ast.CallExpr:
+		if ident, ok := e.Fun.(*ast.Ident); ok {
+			if fn, ok := p.funcs[ident.Name]; ok {
+				return p.returnValue(fn, depth+1)
+			}
+		}
+	}
+
+	return nil
+}
+
+// returnValue returns the composite literal returned by the last statement of
+// the function.
+func (p *pkg) returnValue(fn *ast.FuncDecl, depth int) *ast.CompositeLit {
+	stmts := fn.Body.List
+
+	if len(stmts) == 0 {
+		return nil
+	}
+
+	ret, ok := stmts[len(stmts)-1].(*ast.ReturnStmt)
+	if !ok || len(ret.Results) == 0 {
+		return nil
+	}
+
+	result := ret.Results[0]
+
+	ident, ok := result.(*ast.Ident)
+	if !ok {
+		return p.resolveDepth(result, depth)
+	}
+
+	// find the variable returned, assigned in the function body
+	for _, stmt := range stmts {
+		switch s := stmt.(type) {
+		case *ast.AssignStmt:
+			for i, lhs := range s.Lhs {
+				if l, ok := lhs.(*ast.Ident); ok && l.Name == ident.Name && i < len(s.Rhs) {
+					return p.resolveDepth(s.Rhs[i], depth)
+				}
+			}
+		case *ast.DeclStmt:
+			gen, ok := s.Decl.(*ast.GenDecl)
+			if !ok {
+				continue
+			}
+
+			for _, spec := range gen.Specs {
+				v, ok := spec.(*ast.ValueSpec)
+				if !ok {
+					continue
+				}
+
+				for i, name := range v.Names {
+					if name.Name == ident.Name && i < len(v.Values) {
+						return p.resolveDepth(v.Values[i], depth)
+					}
+				}
+			}
+		}
+	}
+
+	return p.resolveDepth(result, depth)
+}
+
+// fields returns the values of the fields of a struct literal, keyed on field
+// name.
+func (p *pkg) fields(lit *ast.CompositeLit) map[string]ast.Expr {
+	fields := make(map[string]ast.Expr, len(lit.Elts))
+
+	for _, elt := range lit.Elts {
+		kv, ok := elt.(*ast.KeyValueExpr)
+		if !ok {
+			continue
+		}
+
+		if key, ok := kv.Key.(*ast.Ident); ok {
+			fields[key.Name] = kv.Value
+		}
+	}
+
+	return fields
+}
+
+// fieldNames returns the names of the fields of a struct literal, in order.
+func fieldNames(lit *ast.CompositeLit) []string {
+	var names []string
+
+	for _, elt := range lit.Elts {
+		if kv, ok := elt.(*ast.KeyValueExpr); ok {
+			if key, ok := kv.Key.(*ast.Ident); ok {
+				names = append(names, key.Name)
+			}
+		}
+	}
+
+	return names
+}
+
+// stringValue returns the value of a string literal, constant or concatenation
+// of them.
+func (p *pkg) stringValue(expr ast.Expr) (string, bool) {
+	switch e := expr.(type) {
+	case *ast.BasicLit:
+		if e.Kind != token.STRING {
+			return "", false
+		}
+
+		s, err := strconv.Unquote(e.Value)
+
+		return s, err == nil
+	case *ast.ParenExpr:
+		return p.stringValue(e.X)
+	case *ast.BinaryExpr:
+		if e.Op != token.ADD {
+			return "", false
+		}
+
+		x, ok := p.stringValue(e.X)
+		if !ok {
+			return "", false
+		}
+
+		y, ok := p.stringValue(e.Y)
+
+		return x + y, ok
+	case *ast.Ident:
+		if v, ok := p.values[e.Name]; ok {
+			return p.stringValue(v)
+		}
+	}
+
+	return "", false
+}
+
+// boolValue returns the value of true or false.
+func (p *pkg) boolValue(expr ast.Expr) (bool, bool) {
+	ident, ok := expr.(*ast.Ident)
+	if !ok {
+		return false, false
+	}
+
+	switch ident.Name {
+	case "true":
+		return true, true
+	case "false":
+		return false, true
+	}
+
+	if v, ok := p.values[ident.Name]; ok {
+		return p.boolValue(v)
+	}
+
+	return false, false
+}
+
+// numberValue returns the value of an integer or floating point literal or
+// constant, optionally negated. Integers are parsed as int64, rather than as
+// float64, so that large values such as math.MaxInt64 are kept exactly.
+func (p *pkg) numberValue(expr ast.Expr) (json.Number, bool) {
+	switch e := expr.(type) {
+	case *ast.BasicLit:
+		if e.Kind != token.INT && e.Kind != token.FLOAT {
+			return "", false
+		}
+
+		if e.Kind == token.INT {
+			n, err := strconv.ParseInt(e.Value, 0, 64)
+			if err != nil {
+				return "", false
+			}
+
+			return json.Number(strconv.FormatInt(n, 10)), true
+		}
+
+		f, err := strconv.ParseFloat(strings.ReplaceAll(e.Value, "_", ""), 64)
+		if err != nil {
+			return "", false
+		}
+
+		return json.Number(strconv.FormatFloat(f, 'f', -1, 64)), true
+	case *ast.ParenExpr:
+		return p.numberValue(e.X)
+	case *ast.UnaryExpr:
+		if e.Op != token.SUB {
+			return "", false
+		}
+
+		n, ok := p.numberValue(e.X)
+		if !ok {
+			return "", false
+		}
+
+		return "-" + n, true
+	case *ast.Ident:
+		if v, ok := p.values[e.Name]; ok {
+			return p.numberValue(v)
+		}
+	}
+
+	return "", false
+}
+
+// source returns the Go source of the expression.
+func (p *pkg) source(expr ast.Expr) string {
+	var buf bytes.Buffer
+
+	err := format.Node(&buf, p.fset, expr)
+	if err != nil {
+		return fmt.Sprintf("%T", expr)
+	}
+
+	return buf.String()
+}
+
+// snakeCase returns the snake case of a camel case name (e.g., server_group for
+// ServerGroup).
+func snakeCase(name string) string {
+	var b strings.Builder
+
+	runes := []rune(name)
+
+	for i, r := range runes {
+		if unicode.IsUpper(r) {
+			if i > 0 && (unicode.IsLower(runes[i-1]) || (i+1 < len(runes) && unicode.IsLower(runes[i+1]))) {
+				b.WriteByte('_')
+			}
+
+			r = unicode.ToLower(r)
+		}
+
+		b.WriteRune(r)
+	}
+
+	return b.String()
+}
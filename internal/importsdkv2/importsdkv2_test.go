@@ -0,0 +1,305 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package importsdkv2_test
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/hashicorp/terraform-plugin-codegen-framework/internal/importsdkv2"
+	"github.com/hashicorp/terraform-plugin-codegen-framework/internal/specjson"
+)
+
+func TestImport(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		src                  string
+		providerName         string
+		expected             specjson.Specification
+		expectedUntranslated []importsdkv2.Untranslated
+		expectedError        string
+	}{
+		"resource_functions": {
+			src: `package example
+
+import sdkschema "github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+var thingSchema = map[string]*sdkschema.Schema{
+	"id": {
+		Type:     sdkschema.TypeString,
+		Computed: true,
+	},
+	"rule": {
+		Type:     sdkschema.TypeSet,
+		Optional: true,
+		ForceNew: true,
+		Elem: &sdkschema.Resource{
+			Schema: map[string]*sdkschema.Schema{
+				"ports": {
+					Type:     sdkschema.TypeList,
+					Optional: true,
+					MinItems: 1,
+					Elem:     &sdkschema.Schema{Type: sdkschema.TypeList, Elem: &sdkschema.Schema{Type: sdkschema.TypeInt}},
+				},
+			},
+		},
+	},
+}
+
+func resourceThing() *sdkschema.Resource {
+	return &sdkschema.Resource{
+		SchemaVersion: 1,
+		Schema:        thingSchema,
+	}
+}
+
+func dataSourceOtherThing() *sdkschema.Resource {
+	return &sdkschema.Resource{
+		Schema: map[string]*sdkschema.Schema{
+			"filter": {
+				Type:     sdkschema.TypeString,
+				Optional: true,
+				Default:  "all",
+				ForceNew: true,
+			},
+		},
+	}
+}
+`,
+			providerName: "example",
+			expected: specjson.Specification{
+				Provider: &specjson.Provider{Name: "example"},
+				Resources: []specjson.Schema{
+					{
+						Name: "thing",
+						Schema: specjson.SchemaBody{
+							Attributes: []specjson.Attribute{
+								{Name: "id", String: &specjson.AttributeType{ComputedOptionalRequired: "computed"}},
+							},
+							Blocks: []specjson.Block{
+								{
+									Name: "rule",
+									SetNested: &specjson.BlockType{
+										NestedObject: &specjson.NestedObject{
+											Attributes: []specjson.Attribute{
+												{
+													Name: "ports",
+													List: &specjson.AttributeType{
+														ComputedOptionalRequired: "optional",
+														ElementType: &specjson.Type{
+															List: &specjson.CollectionType{
+																ElementType: specjson.Type{Int64: &struct{}{}},
+															},
+														},
+														Validators: []specjson.Custom{
+															{
+																Custom: specjson.CustomDefinition{
+																	Imports:          []specjson.Import{{Path: "github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"}},
+																	SchemaDefinition: "listvalidator.SizeAtLeast(1)",
+																},
+															},
+														},
+													},
+												},
+											},
+										},
+										PlanModifiers: []specjson.Custom{
+											{
+												Custom: specjson.CustomDefinition{
+													Imports:          []specjson.Import{{Path: "github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"}},
+													SchemaDefinition: "setplanmodifier.RequiresReplace()",
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+				DataSources: []specjson.Schema{
+					{
+						Name: "other_thing",
+						Schema: specjson.SchemaBody{
+							Attributes: []specjson.Attribute{
+								{Name: "id", String: &specjson.AttributeType{ComputedOptionalRequired: "computed"}},
+								{Name: "filter", String: &specjson.AttributeType{ComputedOptionalRequired: "optional"}},
+							},
+						},
+					},
+				},
+				Version: specjson.Version,
+			},
+			expectedUntranslated: []importsdkv2.Untranslated{
+				{Schema: importsdkv2.SchemaResource, Name: "thing", Message: "SchemaVersion is not translated"},
+				{Schema: importsdkv2.SchemaDataSource, Name: "other_thing", Path: "filter", Message: "Default is not translated for a data source"},
+				{Schema: importsdkv2.SchemaDataSource, Name: "other_thing", Path: "filter", Message: "ForceNew is not translated for a data source"},
+			},
+		},
+		"runtime_schema": {
+			src: `package example
+
+import "github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+func Provider() *schema.Provider {
+	return &schema.Provider{
+		ResourcesMap: map[string]*schema.Resource{
+			"example_thing": {
+				Schema: map[string]*schema.Schema{
+					"name":  nameSchema(true),
+					"count": {Type: schema.TypeInt, Optional: true, Default: defaultCount()},
+				},
+			},
+		},
+	}
+}
+`,
+			expected: specjson.Specification{
+				Provider: &specjson.Provider{Name: "example"},
+				Resources: []specjson.Schema{
+					{
+						Name: "thing",
+						Schema: specjson.SchemaBody{
+							Attributes: []specjson.Attribute{
+								{
+									Name: "id",
+									String: &specjson.AttributeType{
+										ComputedOptionalRequired: "computed",
+										PlanModifiers: []specjson.Custom{
+											{
+												Custom: specjson.CustomDefinition{
+													Imports:          []specjson.Import{{Path: "github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"}},
+													SchemaDefinition: "stringplanmodifier.UseStateForUnknown()",
+												},
+											},
+										},
+									},
+								},
+								{Name: "count", Int64: &specjson.AttributeType{ComputedOptionalRequired: "optional"}},
+							},
+						},
+					},
+				},
+				Version: specjson.Version,
+			},
+			expectedUntranslated: []importsdkv2.Untranslated{
+				{Schema: importsdkv2.SchemaResource, Name: "thing", Path: "name", Message: "schema nameSchema(true) is not a schema.Schema literal, and is not translated"},
+				{Schema: importsdkv2.SchemaResource, Name: "thing", Path: "count", Message: "Default defaultCount() is not translated"},
+			},
+		},
+		"int_literals": {
+			src: `package example
+
+import "github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+func resourceThing() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"limit": {Type: schema.TypeInt, Optional: true, Default: 9223372036854775807},
+			"mask":  {Type: schema.TypeInt, Optional: true, Default: 0x1_00},
+		},
+	}
+}
+`,
+			providerName: "example",
+			expected: specjson.Specification{
+				Provider: &specjson.Provider{Name: "example"},
+				Resources: []specjson.Schema{
+					{
+						Name: "thing",
+						Schema: specjson.SchemaBody{
+							Attributes: []specjson.Attribute{
+								{
+									Name: "id",
+									String: &specjson.AttributeType{
+										ComputedOptionalRequired: "computed",
+										PlanModifiers: []specjson.Custom{
+											{
+												Custom: specjson.CustomDefinition{
+													Imports:          []specjson.Import{{Path: "github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"}},
+													SchemaDefinition: "stringplanmodifier.UseStateForUnknown()",
+												},
+											},
+										},
+									},
+								},
+								{
+									Name: "limit",
+									Int64: &specjson.AttributeType{
+										ComputedOptionalRequired: "computed_optional",
+										Default:                  &specjson.Default{Static: json.Number("9223372036854775807")},
+									},
+								},
+								{
+									Name: "mask",
+									Int64: &specjson.AttributeType{
+										ComputedOptionalRequired: "computed_optional",
+										Default:                  &specjson.Default{Static: json.Number("256")},
+									},
+								},
+							},
+						},
+					},
+				},
+				Version: specjson.Version,
+			},
+		},
+		"provider_name_missing": {
+			src: `package example
+
+import "github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+func resourceThing() *schema.Resource {
+	return &schema.Resource{}
+}
+`,
+			expectedError: "provider name could not be determined from resource and data source type names, and must be set",
+		},
+	}
+
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			dir := t.TempDir()
+
+			err := os.WriteFile(filepath.Join(dir, "provider.go"), []byte(testCase.src), 0644)
+			if err != nil {
+				t.Fatalf("unexpected error writing source: %s", err)
+			}
+
+			got, untranslated, err := importsdkv2.Import(dir, testCase.providerName)
+
+			if testCase.expectedError != "" {
+				if err == nil {
+					t.Fatalf("expected error %q", testCase.expectedError)
+				}
+
+				if diff := cmp.Diff(err.Error(), testCase.expectedError); diff != "" {
+					t.Errorf("unexpected error difference: %s", diff)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(untranslated, testCase.expectedUntranslated); diff != "" {
+				t.Errorf("unexpected untranslated difference: %s", diff)
+			}
+		})
+	}
+}
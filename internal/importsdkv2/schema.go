@@ -0,0 +1,652 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package importsdkv2
+
+import (
+	"fmt"
+	"go/ast"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-codegen-framework/internal/specjson"
+)
+
+const (
+	planModifierImportPath = "github.com/hashicorp/terraform-plugin-framework/resource/schema/%splanmodifier"
+	validatorImportPath    = "github.com/hashicorp/terraform-plugin-framework-validators/%svalidator"
+)
+
+// translatedSchemaFields are the fields of schema.Schema which are translated.
+// Other fields which are set are reported as untranslated.
+var translatedSchemaFields = map[string]bool{
+	"Computed":         true,
+	"ConfigMode":       true,
+	"Default":          true,
+	"Deprecated":       true,
+	"Description":      true,
+	"Elem":             true,
+	"ForceNew":         true,
+	"MaxItems":         true,
+	"MinItems":         true,
+	"Optional":         true,
+	"Required":         true,
+	"Sensitive":        true,
+	"Type":             true,
+	"ValidateDiagFunc": true,
+	"ValidateFunc":     true,
+}
+
+// untranslatedResourceFields are the fields of schema.Resource which affect the
+// schema, and are reported as untranslated if set.
+var untranslatedResourceFields = map[string]bool{
+	"CustomizeDiff":  true,
+	"MigrateState":   true,
+	"SchemaFunc":     true,
+	"SchemaVersion":  true,
+	"StateUpgraders": true,
+	"Timeouts":       true,
+}
+
+// converter converts the schemas of the provider, or of a resource or data
+// source.
+type converter struct {
+	pkg *pkg
+
+	// kind and name are those of the provider, resource or data source.
+	kind string
+	name string
+
+	untranslated *[]Untranslated
+}
+
+// note records something which was not translated.
+func (c converter) note(path, format string, a ...any) {
+	*c.untranslated = append(*c.untranslated, Untranslated{
+		Schema:  c.kind,
+		Name:    c.name,
+		Path:    path,
+		Message: fmt.Sprintf(format, a...),
+	})
+}
+
+// resource returns the schema of a resource or data source. As in SDKv2, an id
+// attribute is added if the schema does not declare one, which is computed and
+// keeps its prior value during planning.
+func (c converter) resource(lit *ast.CompositeLit) specjson.SchemaBody {
+	fields := c.pkg.fields(lit)
+
+	for _, name := range fieldNames(lit) {
+		if untranslatedResourceFields[name] {
+			c.note("", "%s is not translated", name)
+		}
+	}
+
+	var body specjson.SchemaBody
+
+	body.Attributes, body.Blocks = c.schema(fields["Schema"], "", false)
+
+	if v, ok := fields["Description"]; ok {
+		body.Description = c.stringField(v, "", "Description")
+	}
+
+	if v, ok := fields["DeprecationMessage"]; ok {
+		body.DeprecationMessage = c.stringField(v, "", "DeprecationMessage")
+	}
+
+	for _, a := range body.Attributes {
+		if a.Name == "id" {
+			return body
+		}
+	}
+
+	id := specjson.Attribute{
+		Name: "id",
+		String: &specjson.AttributeType{
+			ComputedOptionalRequired: "computed",
+		},
+	}
+
+	if c.kind == SchemaResource {
+		id.String.PlanModifiers = []specjson.Custom{
+			custom(fmt.Sprintf(planModifierImportPath, "string"), "stringplanmodifier.UseStateForUnknown()"),
+		}
+	}
+
+	body.Attributes = append([]specjson.Attribute{id}, body.Attributes...)
+
+	return body
+}
+
+// schema returns the attributes and blocks of a map[string]*schema.Schema, in
+// source order. If asAttributes is true, nested resources are converted to
+// nested attributes rather than blocks.
+func (c converter) schema(expr ast.Expr, path string, asAttributes bool) ([]specjson.Attribute, []specjson.Block) {
+	if expr == nil {
+		return nil, nil
+	}
+
+	lit := c.pkg.resolve(expr)
+	if lit == nil {
+		c.note(strings.TrimSuffix(path, "."), "schema %s is not a map literal, and is not translated", c.pkg.source(expr))
+
+		return nil, nil
+	}
+
+	var attributes []specjson.Attribute
+	var blocks []specjson.Block
+
+	for _, elt := range lit.Elts {
+		kv, ok := elt.(*ast.KeyValueExpr)
+		if !ok {
+			continue
+		}
+
+		name, ok := c.pkg.stringValue(kv.Key)
+		if !ok {
+			c.note(strings.TrimSuffix(path, "."), "schema key %s is not a string, and is not translated", c.pkg.source(kv.Key))
+			continue
+		}
+
+		s := c.pkg.resolve(kv.Value)
+		if s == nil {
+			c.note(path+name, "schema %s is not a schema.Schema literal, and is not translated", c.pkg.source(kv.Value))
+			continue
+		}
+
+		a, b := c.field(name, s, path+name, asAttributes)
+
+		if a != nil {
+			attributes = append(attributes, *a)
+		}
+
+		if b != nil {
+			blocks = append(blocks, *b)
+		}
+	}
+
+	return attributes, blocks
+}
+
+// field returns the attribute or block of a schema.Schema literal, or neither if
+// it cannot be translated.
+func (c converter) field(name string, lit *ast.CompositeLit, path string, asAttributes bool) (*specjson.Attribute, *specjson.Block) {
+	fields := c.pkg.fields(lit)
+
+	for _, f := range fieldNames(lit) {
+		if !translatedSchemaFields[f] {
+			c.note(path, "%s is not translated", f)
+		}
+	}
+
+	typeName, ok := c.pkg.schemaSelector(fields["Type"])
+	if !ok {
+		c.note(path, "Type is not set to a schema type, and the attribute is not translated")
+
+		return nil, nil
+	}
+
+	required := c.boolField(fields, path, "Required")
+	optional := c.boolField(fields, path, "Optional")
+	computed := c.boolField(fields, path, "Computed")
+
+	elem := fields["Elem"]
+	var nested *ast.CompositeLit
+
+	if elem != nil {
+		if lit := c.pkg.resolve(elem); lit != nil && c.pkg.isSchemaType(lit.Type, "Resource") {
+			nested = lit
+		}
+	}
+
+	configModeAttr := false
+
+	if v, ok := fields["ConfigMode"]; ok {
+		mode, _ := c.pkg.schemaSelector(v)
+		configModeAttr = mode == "SchemaConfigModeAttr"
+	}
+
+	// nested resources in lists and sets are blocks, unless they are
+	// computed and not configurable, or configured as attributes
+	if nested != nil && (typeName == "TypeList" || typeName == "TypeSet") && !asAttributes && !configModeAttr && (required || optional || !computed) {
+		return nil, c.block(name, typeName, fields, nested, path, required)
+	}
+
+	t := &specjson.AttributeType{}
+
+	switch {
+	case c.kind == SchemaProvider && required:
+		t.OptionalRequired = "required"
+	case c.kind == SchemaProvider:
+		t.OptionalRequired = "optional"
+
+		if computed {
+			c.note(path, "Computed is not translated, provider attributes cannot be computed")
+		}
+	case required:
+		t.ComputedOptionalRequired = "required"
+	case optional && computed:
+		t.ComputedOptionalRequired = "computed_optional"
+	case optional:
+		t.ComputedOptionalRequired = "optional"
+	default:
+		t.ComputedOptionalRequired = "computed"
+	}
+
+	if c.boolField(fields, path, "Sensitive") {
+		sensitive := true
+		t.Sensitive = &sensitive
+	}
+
+	if v, ok := fields["Description"]; ok {
+		t.Description = c.stringField(v, path, "Description")
+	}
+
+	if v, ok := fields["Deprecated"]; ok {
+		t.DeprecationMessage = c.stringField(v, path, "Deprecated")
+	}
+
+	a := &specjson.Attribute{
+		Name: name,
+	}
+
+	// typeKey is used in the names of framework plan modifier and
+	// validator packages (e.g., stringplanmodifier)
+	var typeKey string
+
+	switch typeName {
+	case "TypeBool":
+		a.Bool, typeKey = t, "bool"
+	case "TypeFloat":
+		a.Float64, typeKey = t, "float64"
+	case "TypeInt":
+		a.Int64, typeKey = t, "int64"
+	case "TypeString":
+		a.String, typeKey = t, "string"
+	case "TypeList", "TypeSet", "TypeMap":
+		typeKey = strings.ToLower(strings.TrimPrefix(typeName, "Type"))
+
+		switch {
+		case nested != nil && typeName == "TypeMap":
+			c.note(path, "Elem schema.Resource is not translated for TypeMap, which is a map of strings")
+
+			t.ElementType = &specjson.Type{String: &struct{}{}}
+		case nested != nil:
+			attributes, _ := c.schema(c.pkg.fields(nested)["Schema"], path+".", true)
+			t.NestedObject = &specjson.NestedObject{Attributes: attributes}
+		case elem == nil && typeName == "TypeMap":
+			t.ElementType = &specjson.Type{String: &struct{}{}}
+		default:
+			elementType, ok := c.elementType(elem, path)
+			if !ok {
+				return nil, nil
+			}
+
+			t.ElementType = &elementType
+		}
+
+		switch {
+		case typeName == "TypeList" && nested != nil:
+			a.ListNested = t
+		case typeName == "TypeList":
+			a.List = t
+		case typeName == "TypeSet" && nested != nil:
+			a.SetNested = t
+		case typeName == "TypeSet":
+			a.Set = t
+		default:
+			a.Map = t
+		}
+
+		if typeName != "TypeMap" {
+			t.Validators = append(t.Validators, c.sizeValidators(typeKey, fields, path, false)...)
+		}
+	default:
+		c.note(path, "Type %s is not translated", typeName)
+
+		return nil, nil
+	}
+
+	if v, ok := fields["Default"]; ok {
+		c.defaultValue(t, typeName, v, path)
+	}
+
+	for _, f := range []string{"ValidateFunc", "ValidateDiagFunc"} {
+		if v, ok := fields[f]; ok {
+			if validator, ok := c.validator(v, typeName); ok {
+				t.Validators = append(t.Validators, validator)
+			} else {
+				c.note(path, "%s %s is not translated", f, c.pkg.source(v))
+			}
+		}
+	}
+
+	if c.boolField(fields, path, "ForceNew") {
+		if c.kind == SchemaResource {
+			t.PlanModifiers = append(t.PlanModifiers, custom(fmt.Sprintf(planModifierImportPath, typeKey), typeKey+"planmodifier.RequiresReplace()"))
+		} else {
+			c.note(path, "ForceNew is not translated for a %s", c.kind)
+		}
+	}
+
+	return a, nil
+}
+
+// block returns the list or set nested block of a nested resource.
+func (c converter) block(name, typeName string, fields map[string]ast.Expr, nested *ast.CompositeLit, path string, required bool) *specjson.Block {
+	attributes, blocks := c.schema(c.pkg.fields(nested)["Schema"], path+".", false)
+
+	typeKey := strings.ToLower(strings.TrimPrefix(typeName, "Type"))
+
+	t := &specjson.BlockType{
+		NestedObject: &specjson.NestedObject{
+			Attributes: attributes,
+			Blocks:     blocks,
+		},
+		Validators: c.sizeValidators(typeKey, fields, path, required),
+	}
+
+	if v, ok := fields["Description"]; ok {
+		t.Description = c.stringField(v, path, "Description")
+	}
+
+	if v, ok := fields["Deprecated"]; ok {
+		t.DeprecationMessage = c.stringField(v, path, "Deprecated")
+	}
+
+	for _, f := range []string{"Computed", "Sensitive", "Default", "ValidateFunc", "ValidateDiagFunc"} {
+		if _, ok := fields[f]; ok {
+			c.note(path, "%s is not translated for blocks", f)
+		}
+	}
+
+	if c.boolField(fields, path, "ForceNew") {
+		if c.kind == SchemaResource {
+			t.PlanModifiers = append(t.PlanModifiers, custom(fmt.Sprintf(planModifierImportPath, typeKey), typeKey+"planmodifier.RequiresReplace()"))
+		} else {
+			c.note(path, "ForceNew is not translated for a %s", c.kind)
+		}
+	}
+
+	b := &specjson.Block{
+		Name: name,
+	}
+
+	if typeName == "TypeSet" {
+		b.SetNested = t
+	} else {
+		b.ListNested = t
+	}
+
+	return b
+}
+
+// elementType returns the element type of a list, map or set from its Elem,
+// which is a schema.Schema literal or a schema type.
+func (c converter) elementType(elem ast.Expr, path string) (specjson.Type, bool) {
+	if elem == nil {
+		c.note(path, "Elem is not set, and the attribute is not translated")
+
+		return specjson.Type{}, false
+	}
+
+	typeName, ok := c.pkg.schemaSelector(elem)
+
+	var fields map[string]ast.Expr
+
+	if !ok {
+		lit := c.pkg.resolve(elem)
+		if lit == nil || !c.pkg.isSchemaType(lit.Type, "Schema") {
+			c.note(path, "Elem %s is not a schema.Schema literal, and the attribute is not translated", c.pkg.source(elem))
+
+			return specjson.Type{}, false
+		}
+
+		fields = c.pkg.fields(lit)
+
+		for _, f := range fieldNames(lit) {
+			if f != "Type" && f != "Elem" {
+				c.note(path, "Elem %s is not translated", f)
+			}
+		}
+
+		typeName, ok = c.pkg.schemaSelector(fields["Type"])
+		if !ok {
+			c.note(path, "Elem Type is not set to a schema type, and the attribute is not translated")
+
+			return specjson.Type{}, false
+		}
+	}
+
+	switch typeName {
+	case "TypeBool":
+		return specjson.Type{Bool: &struct{}{}}, true
+	case "TypeFloat":
+		return specjson.Type{Float64: &struct{}{}}, true
+	case "TypeInt":
+		return specjson.Type{Int64: &struct{}{}}, true
+	case "TypeString":
+		return specjson.Type{String: &struct{}{}}, true
+	case "TypeList", "TypeMap", "TypeSet":
+		elem := fields["Elem"]
+
+		var elementType specjson.Type
+
+		if elem == nil && typeName == "TypeMap" {
+			elementType = specjson.Type{String: &struct{}{}}
+		} else {
+			elementType, ok = c.elementType(elem, path)
+			if !ok {
+				return specjson.Type{}, false
+			}
+		}
+
+		collection := &specjson.CollectionType{ElementType: elementType}
+
+		switch typeName {
+		case "TypeList":
+			return specjson.Type{List: collection}, true
+		case "TypeMap":
+			return specjson.Type{Map: collection}, true
+		default:
+			return specjson.Type{Set: collection}, true
+		}
+	}
+
+	c.note(path, "Elem Type %s is not translated, and the attribute is not translated", typeName)
+
+	return specjson.Type{}, false
+}
+
+// sizeValidators returns validators of the number of elements of a list or set
+// from MinItems and MaxItems. Required blocks must have at least one element.
+func (c converter) sizeValidators(typeKey string, fields map[string]ast.Expr, path string, required bool) []specjson.Custom {
+	var validators []specjson.Custom
+
+	minItems := ""
+
+	if v, ok := fields["MinItems"]; ok {
+		n, ok := c.pkg.numberValue(v)
+		if !ok {
+			c.note(path, "MinItems %s is not translated", c.pkg.source(v))
+		} else if n != "0" {
+			minItems = string(n)
+		}
+	}
+
+	if minItems == "" && required {
+		minItems = "1"
+	}
+
+	if minItems != "" {
+		validators = append(validators, custom(fmt.Sprintf(validatorImportPath, typeKey), fmt.Sprintf("%svalidator.SizeAtLeast(%s)", typeKey, minItems)))
+	}
+
+	if v, ok := fields["MaxItems"]; ok {
+		n, ok := c.pkg.numberValue(v)
+		if !ok {
+			c.note(path, "MaxItems %s is not translated", c.pkg.source(v))
+		} else if n != "0" {
+			validators = append(validators, custom(fmt.Sprintf(validatorImportPath, typeKey), fmt.Sprintf("%svalidator.SizeAtMost(%s)", typeKey, n)))
+		}
+	}
+
+	return validators
+}
+
+// defaultValue sets the static default of a resource attribute from a literal
+// value. As the framework requires attributes with defaults to be computed,
+// optional attributes become computed and optional.
+func (c converter) defaultValue(t *specjson.AttributeType, typeName string, expr ast.Expr, path string) {
+	if c.kind != SchemaResource {
+		c.note(path, "Default is not translated for a %s", c.kind)
+
+		return
+	}
+
+	var value any
+	var ok bool
+
+	switch typeName {
+	case "TypeBool":
+		value, ok = c.pkg.boolValue(expr)
+	case "TypeFloat", "TypeInt":
+		value, ok = c.pkg.numberValue(expr)
+	case "TypeString":
+		value, ok = c.pkg.stringValue(expr)
+	}
+
+	if !ok {
+		c.note(path, "Default %s is not translated", c.pkg.source(expr))
+
+		return
+	}
+
+	t.Default = &specjson.Default{Static: value}
+
+	if t.ComputedOptionalRequired == "optional" {
+		t.ComputedOptionalRequired = "computed_optional"
+	}
+}
+
+// validator returns the framework validator equivalent to a ValidateFunc or
+// ValidateDiagFunc of the SDKv2 validation package, where there is one.
+func (c converter) validator(expr ast.Expr, typeName string) (specjson.Custom, bool) {
+	call, ok := expr.(*ast.CallExpr)
+	if !ok {
+		return specjson.Custom{}, false
+	}
+
+	name, ok := selector(call.Fun, c.pkg.validationNames)
+	if !ok {
+		return specjson.Custom{}, false
+	}
+
+	if name == "ToDiagFunc" && len(call.Args) == 1 {
+		return c.validator(call.Args[0], typeName)
+	}
+
+	var typeKey, function string
+	var args []ast.Expr
+
+	// stringArgs indicates that the arguments are strings rather than numbers
+	stringArgs := false
+
+	switch {
+	case typeName == "TypeString" && name == "StringInSlice" && len(call.Args) == 2:
+		ignoreCase, ok := c.pkg.boolValue(call.Args[1])
+		if !ok {
+			return specjson.Custom{}, false
+		}
+
+		values := c.pkg.resolve(call.Args[0])
+		if values == nil {
+			return specjson.Custom{}, false
+		}
+
+		typeKey, function, args, stringArgs = "string", "OneOf", values.Elts, true
+
+		if ignoreCase {
+			function = "OneOfCaseInsensitive"
+		}
+	case typeName == "TypeString" && name == "StringLenBetween":
+		typeKey, function, args = "string", "LengthBetween", call.Args
+	case typeName == "TypeString" && name == "StringIsNotEmpty":
+		return custom(fmt.Sprintf(validatorImportPath, "string"), "stringvalidator.LengthAtLeast(1)"), true
+	case typeName == "TypeInt" && name == "IntInSlice" && len(call.Args) == 1:
+		values := c.pkg.resolve(call.Args[0])
+		if values == nil {
+			return specjson.Custom{}, false
+		}
+
+		typeKey, function, args = "int64", "OneOf", values.Elts
+	case typeName == "TypeInt" && (name == "IntBetween" || name == "IntAtLeast" || name == "IntAtMost"):
+		typeKey, function, args = "int64", strings.TrimPrefix(name, "Int"), call.Args
+	case typeName == "TypeFloat" && (name == "FloatBetween" || name == "FloatAtLeast" || name == "FloatAtMost"):
+		typeKey, function, args = "float64", strings.TrimPrefix(name, "Float"), call.Args
+	default:
+		return specjson.Custom{}, false
+	}
+
+	values := make([]string, 0, len(args))
+
+	for _, arg := range args {
+		if stringArgs {
+			s, ok := c.pkg.stringValue(arg)
+			if !ok {
+				return specjson.Custom{}, false
+			}
+
+			values = append(values, fmt.Sprintf("%q", s))
+
+			continue
+		}
+
+		n, ok := c.pkg.numberValue(arg)
+		if !ok {
+			return specjson.Custom{}, false
+		}
+
+		values = append(values, string(n))
+	}
+
+	return custom(fmt.Sprintf(validatorImportPath, typeKey), fmt.Sprintf("%svalidator.%s(%s)", typeKey, function, strings.Join(values, ", "))), true
+}
+
+// boolField returns the value of a bool field, noting values which are not true
+// or false.
+func (c converter) boolField(fields map[string]ast.Expr, path, name string) bool {
+	v, ok := fields[name]
+	if !ok {
+		return false
+	}
+
+	b, ok := c.pkg.boolValue(v)
+	if !ok {
+		c.note(path, "%s %s is not translated", name, c.pkg.source(v))
+	}
+
+	return b
+}
+
+// stringField returns the value of a string field, noting values which are not
+// string literals or constants.
+func (c converter) stringField(v ast.Expr, path, name string) string {
+	s, ok := c.pkg.stringValue(v)
+	if !ok {
+		c.note(path, "%s %s is not translated", name, c.pkg.source(v))
+	}
+
+	return s
+}
+
+// custom returns a custom plan modifier or validator.
+func custom(importPath, definition string) specjson.Custom {
+	return specjson.Custom{
+		Custom: specjson.CustomDefinition{
+			Imports: []specjson.Import{
+				{Path: importPath},
+			},
+			SchemaDefinition: definition,
+		},
+	}
+}
@@ -0,0 +1,197 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+// Package importstruct derives specification attributes from a Go struct type,
+// such as a type of an API SDK, by loading the Go package which declares it with
+// go/types. Each field of the struct becomes an attribute, and nested struct
+// types are set as the associated external type of the nested attribute, so
+// that the generated conversion functions use the SDK types.
+package importstruct
+
+import (
+	"errors"
+	"fmt"
+	"go/ast"
+	"go/build"
+	"go/importer"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"unicode"
+
+	"github.com/hashicorp/terraform-plugin-codegen-framework/internal/gomod"
+	"github.com/hashicorp/terraform-plugin-codegen-framework/internal/specjson"
+)
+
+// validIdentifier matches the attribute names which are valid in a
+// specification.
+var validIdentifier = regexp.MustCompile(`^[a-z_][a-z0-9_]*$`)
+
+// Warning describes a field of the struct which could not be translated, or
+// for which the generated conversion functions will not compile.
+type Warning struct {
+	// Path is the dot-separated path of the attribute (e.g., volumes.size).
+	Path string
+
+	// Message describes the problem (e.g., type chan int is not supported).
+	Message string
+}
+
+// String returns the message, prefixed with the path of the attribute.
+func (w Warning) String() string {
+	return fmt.Sprintf("%s: %s", w.Path, w.Message)
+}
+
+// Result is the translation of a Go struct type.
+type Result struct {
+	// Name is the snake case of the struct type name (e.g., server_group for
+	// ServerGroup).
+	Name string
+
+	// Attributes are the attributes of the fields of the struct.
+	Attributes []specjson.Attribute
+
+	// AssociatedExternalType is a pointer to the struct type, for use as the
+	// associated external type of a resource or data source in the generator
+	// configuration.
+	AssociatedExternalType specjson.AssociatedExternalType
+
+	// Warnings describe the fields which could not be translated, or for which
+	// the generated conversion functions will not compile.
+	Warnings []Warning
+}
+
+// Import returns the attributes of the fields of the named struct type declared
+// in the Go package in the directory.
+//
+// Attribute names are the snake case of the name in the json struct tag of the
+// field, or of the field name if there is none, and fields tagged with "-" are
+// skipped. Fields which are pointers or tagged with omitempty are optional, and
+// other fields are required. Fields of embedded structs without a json name are
+// promoted, as they are by encoding/json.
+//
+// If importPath is empty, the import path of the package is determined from the
+// go.mod file of the module containing the directory.
+func Import(dir, structName, importPath string) (Result, error) {
+	if importPath == "" {
+		var err error
+
+		importPath, err = modulePackagePath(dir)
+		if err != nil {
+			return Result{}, err
+		}
+	}
+
+	pkg, err := loadPackage(dir, importPath)
+	if err != nil {
+		return Result{}, err
+	}
+
+	obj, ok := pkg.Scope().Lookup(structName).(*types.TypeName)
+	if !ok {
+		return Result{}, fmt.Errorf("type %s not found in package %s", structName, importPath)
+	}
+
+	named, ok := types.Unalias(obj.Type()).(*types.Named)
+	if !ok {
+		return Result{}, fmt.Errorf("type %s is not a defined struct type", structName)
+	}
+
+	s, ok := named.Underlying().(*types.Struct)
+	if !ok {
+		return Result{}, fmt.Errorf("type %s is not a struct type", structName)
+	}
+
+	if named.TypeParams().Len() > 0 {
+		return Result{}, fmt.Errorf("type %s is generic, which is not supported", structName)
+	}
+
+	var warnings []Warning
+
+	c := converter{
+		pkg:      pkg,
+		visiting: map[*types.Named]bool{named: true},
+		warnings: &warnings,
+	}
+
+	return Result{
+		Name:                   snakeCase(structName),
+		Attributes:             c.attributes(s, ""),
+		AssociatedExternalType: c.associatedExternalType(named),
+		Warnings:               warnings,
+	}, nil
+}
+
+// modulePackagePath returns the import path of the package in the directory,
+// from the module path in the go.mod file of the directory or its closest
+// parent directory which has one.
+func modulePackagePath(dir string) (string, error) {
+	importPath, err := gomod.ImportPath(dir)
+
+	if errors.Is(err, gomod.ErrNotFound) {
+		return "", fmt.Errorf("%w, the import path of the package must be set", err)
+	}
+
+	return importPath, err
+}
+
+// loadPackage parses and type checks the Go files of the package in the
+// directory which match the current build context, excluding tests. Imported
+// packages are loaded from source. Type checking errors are ignored, as fields
+// whose types could not be determined are reported as warnings.
+func loadPackage(dir, importPath string) (*types.Package, error) {
+	bp, err := build.ImportDir(dir, 0)
+	if err != nil {
+		return nil, fmt.Errorf("error loading Go package in %s: %w", dir, err)
+	}
+
+	fset := token.NewFileSet()
+	files := make([]*ast.File, 0, len(bp.GoFiles))
+
+	for _, name := range bp.GoFiles {
+		f, err := parser.ParseFile(fset, filepath.Join(bp.Dir, name), nil, 0)
+		if err != nil {
+			return nil, err
+		}
+
+		files = append(files, f)
+	}
+
+	conf := types.Config{
+		Importer: importer.ForCompiler(fset, "source", nil),
+		Error:    func(error) {},
+	}
+
+	pkg, _ := conf.Check(importPath, fset, files, nil)
+
+	return pkg, nil
+}
+
+// snakeCase returns the snake case of a camel case name (e.g., server_group for
+// ServerGroup or serverGroup), with hyphens replaced by underscores.
+func snakeCase(name string) string {
+	var b strings.Builder
+
+	runes := []rune(name)
+
+	for i, r := range runes {
+		if unicode.IsUpper(r) {
+			if i > 0 && runes[i-1] != '_' && (unicode.IsLower(runes[i-1]) || unicode.IsDigit(runes[i-1]) || (i+1 < len(runes) && unicode.IsLower(runes[i+1]))) {
+				b.WriteByte('_')
+			}
+
+			r = unicode.ToLower(r)
+		}
+
+		if r == '-' {
+			r = '_'
+		}
+
+		b.WriteRune(r)
+	}
+
+	return b.String()
+}
@@ -0,0 +1,216 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package importstruct_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/hashicorp/terraform-plugin-codegen-framework/internal/importstruct"
+	"github.com/hashicorp/terraform-plugin-codegen-framework/internal/specjson"
+)
+
+func TestImport(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		src           string
+		structName    string
+		importPath    string
+		expected      importstruct.Result
+		expectedError string
+	}{
+		"go_mod_import_path": {
+			src: `package sdk
+
+type Base struct {
+	Region *string ` + "`json:\"region\"`" + `
+}
+
+type ServerGroup struct {
+	*Base
+
+	Name     *string            ` + "`json:\"name\"`" + `
+	Priority *int64             ` + "`json:\"priority,omitempty\"`" + `
+	Scores   map[string]*float64 ` + "`json:\"scoresByZone\"`" + `
+	Rules    []*Rule            ` + "`json:\"rules,omitempty\"`" + `
+	Points   []struct {
+		X *int64
+		Y *int64
+	} ` + "`json:\"points\"`" + `
+	Ignored  *string ` + "`json:\"-\"`" + `
+	hidden   *string
+}
+
+type Rule struct {
+	Port   *int64 ` + "`json:\"port\"`" + `
+	Action *Action
+}
+
+type Action struct {
+	Allow *bool ` + "`json:\"allow\"`" + `
+}
+`,
+			structName: "ServerGroup",
+			expected: importstruct.Result{
+				Name: "server_group",
+				Attributes: []specjson.Attribute{
+					{Name: "region", String: &specjson.AttributeType{ComputedOptionalRequired: "optional"}},
+					{Name: "name", String: &specjson.AttributeType{ComputedOptionalRequired: "optional"}},
+					{Name: "priority", Int64: &specjson.AttributeType{ComputedOptionalRequired: "optional"}},
+					{
+						Name: "scores_by_zone",
+						Map: &specjson.AttributeType{
+							ComputedOptionalRequired: "required",
+							ElementType:              &specjson.Type{Float64: &struct{}{}},
+						},
+					},
+					{
+						Name: "rules",
+						ListNested: &specjson.AttributeType{
+							ComputedOptionalRequired: "optional",
+							NestedObject: &specjson.NestedObject{
+								AssociatedExternalType: &specjson.AssociatedExternalType{
+									Import: &specjson.Import{Path: "example.com/api/internal/sdk"},
+									Type:   "*sdk.Rule",
+								},
+								Attributes: []specjson.Attribute{
+									{Name: "port", Int64: &specjson.AttributeType{ComputedOptionalRequired: "optional"}},
+									{
+										Name: "action",
+										SingleNested: &specjson.AttributeType{
+											AssociatedExternalType: &specjson.AssociatedExternalType{
+												Import: &specjson.Import{Path: "example.com/api/internal/sdk"},
+												Type:   "*sdk.Action",
+											},
+											Attributes: []specjson.Attribute{
+												{Name: "allow", Bool: &specjson.AttributeType{ComputedOptionalRequired: "optional"}},
+											},
+											ComputedOptionalRequired: "optional",
+										},
+									},
+								},
+							},
+						},
+					},
+					{
+						Name: "points",
+						ListNested: &specjson.AttributeType{
+							ComputedOptionalRequired: "required",
+							NestedObject: &specjson.NestedObject{
+								Attributes: []specjson.Attribute{
+									{Name: "x", Int64: &specjson.AttributeType{ComputedOptionalRequired: "optional"}},
+									{Name: "y", Int64: &specjson.AttributeType{ComputedOptionalRequired: "optional"}},
+								},
+							},
+						},
+					},
+				},
+				AssociatedExternalType: specjson.AssociatedExternalType{
+					Import: &specjson.Import{Path: "example.com/api/internal/sdk"},
+					Type:   "*sdk.ServerGroup",
+				},
+				Warnings: []importstruct.Warning{
+					{Path: "region", Message: "field Region: conversion functions set fields promoted from embedded field Base in struct literals, which requires Go 1.27 or later"},
+					{Path: "scores_by_zone", Message: "field Scores: conversion functions expect the field to be named ScoresByZone"},
+				},
+			},
+		},
+		"import_path": {
+			src: `package client
+
+type Thing struct {
+	Tags []*string
+	Next *Thing
+}
+`,
+			structName: "Thing",
+			importPath: "example.com/api/v2",
+			expected: importstruct.Result{
+				Name: "thing",
+				Attributes: []specjson.Attribute{
+					{
+						Name: "tags",
+						List: &specjson.AttributeType{
+							ComputedOptionalRequired: "required",
+							ElementType:              &specjson.Type{String: &struct{}{}},
+						},
+					},
+				},
+				AssociatedExternalType: specjson.AssociatedExternalType{
+					Import: &specjson.Import{Alias: "client", Path: "example.com/api/v2"},
+					Type:   "*client.Thing",
+				},
+				Warnings: []importstruct.Warning{
+					{Path: "next", Message: "recursive type Thing is not supported"},
+				},
+			},
+		},
+		"not_found": {
+			src: `package sdk
+
+type Thing struct{}
+`,
+			structName:    "Other",
+			expectedError: "type Other not found in package example.com/api/internal/sdk",
+		},
+		"not_struct": {
+			src: `package sdk
+
+type Thing string
+`,
+			structName:    "Thing",
+			expectedError: "type Thing is not a struct type",
+		},
+	}
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			root := t.TempDir()
+			dir := filepath.Join(root, "internal", "sdk")
+
+			err := os.MkdirAll(dir, 0755)
+			if err != nil {
+				t.Fatalf("unexpected error creating package directory: %s", err)
+			}
+
+			err = os.WriteFile(filepath.Join(root, "go.mod"), []byte("module example.com/api\n\ngo 1.22\n"), 0644)
+			if err != nil {
+				t.Fatalf("unexpected error writing go.mod: %s", err)
+			}
+
+			err = os.WriteFile(filepath.Join(dir, "sdk.go"), []byte(testCase.src), 0644)
+			if err != nil {
+				t.Fatalf("unexpected error writing source: %s", err)
+			}
+
+			got, err := importstruct.Import(dir, testCase.structName, testCase.importPath)
+
+			if testCase.expectedError != "" {
+				if err == nil {
+					t.Fatalf("expected error: %s", testCase.expectedError)
+				}
+
+				if diff := cmp.Diff(err.Error(), testCase.expectedError); diff != "" {
+					t.Errorf("unexpected error difference: %s", diff)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
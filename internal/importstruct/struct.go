@@ -0,0 +1,525 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package importstruct
+
+import (
+	"cmp"
+	"fmt"
+	"go/types"
+	"path"
+	"reflect"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-codegen-framework/internal/format"
+	"github.com/hashicorp/terraform-plugin-codegen-framework/internal/specjson"
+)
+
+// converter translates the fields of struct types into attributes.
+type converter struct {
+	pkg *types.Package
+
+	// visiting holds the struct types being translated, to detect recursive
+	// types.
+	visiting map[*types.Named]bool
+
+	warnings *[]Warning
+}
+
+func (c converter) warn(path, format string, a ...any) {
+	*c.warnings = append(*c.warnings, Warning{
+		Path:    path,
+		Message: fmt.Sprintf(format, a...),
+	})
+}
+
+// typeString returns the Go source of the type, qualified by package name for
+// types declared in other packages than the loaded package.
+func (c converter) typeString(t types.Type) string {
+	return types.TypeString(t, func(p *types.Package) string {
+		if p == c.pkg {
+			return ""
+		}
+
+		return p.Name()
+	})
+}
+
+// associatedExternalType returns a pointer to the named type, qualified by the
+// name of the package declaring it.
+func (c converter) associatedExternalType(named *types.Named) specjson.AssociatedExternalType {
+	p := named.Obj().Pkg()
+
+	i := &specjson.Import{
+		Path: p.Path(),
+	}
+
+	if p.Name() != path.Base(p.Path()) {
+		i.Alias = p.Name()
+	}
+
+	return specjson.AssociatedExternalType{
+		Import: i,
+		Type:   fmt.Sprintf("*%s.%s", p.Name(), named.Obj().Name()),
+	}
+}
+
+// field is an exported field of a struct, or of an embedded struct, with the
+// attribute name derived from its json tag.
+type field struct {
+	*types.Var
+	name      string
+	omitEmpty bool
+
+	// embedded is the name of the embedded field which the field is promoted
+	// from, if any.
+	embedded string
+}
+
+// fields returns the fields of the struct which are encoded by encoding/json,
+// with the fields of embedded structs without a json name promoted.
+func (c converter) fields(s *types.Struct) []field {
+	return c.embeddedFields(s, "", map[*types.Struct]bool{})
+}
+
+func (c converter) embeddedFields(s *types.Struct, embedded string, seen map[*types.Struct]bool) []field {
+	if seen[s] {
+		return nil
+	}
+
+	seen[s] = true
+
+	var fields []field
+
+	for i := 0; i < s.NumFields(); i++ {
+		v := s.Field(i)
+
+		tag := reflect.StructTag(s.Tag(i)).Get("json")
+
+		if tag == "-" {
+			continue
+		}
+
+		name, options, _ := strings.Cut(tag, ",")
+
+		if v.Embedded() && name == "" {
+			if embeddedStruct, ok := pointerElem(v.Type()).Underlying().(*types.Struct); ok {
+				fields = append(fields, c.embeddedFields(embeddedStruct, cmp.Or(embedded, v.Name()), seen)...)
+
+				continue
+			}
+		}
+
+		if !v.Exported() {
+			continue
+		}
+
+		if name == "" {
+			name = v.Name()
+		}
+
+		fields = append(fields, field{
+			Var:       v,
+			name:      snakeCase(name),
+			omitEmpty: strings.Contains(","+options+",", ",omitempty,"),
+			embedded:  embedded,
+		})
+	}
+
+	return fields
+}
+
+// attributes returns the attributes of the fields of the struct.
+func (c converter) attributes(s *types.Struct, parentPath string) []specjson.Attribute {
+	var attributes []specjson.Attribute
+
+	names := make(map[string]bool)
+
+	for _, f := range c.fields(s) {
+		p := f.name
+		if parentPath != "" {
+			p = parentPath + "." + f.name
+		}
+
+		if !validIdentifier.MatchString(f.name) {
+			c.warn(p, "field %s: attribute name is not a valid identifier", f.Name())
+
+			continue
+		}
+
+		if names[f.name] {
+			c.warn(p, "field %s: attribute name is used by another field", f.Name())
+
+			continue
+		}
+
+		names[f.name] = true
+
+		a, ok := c.attribute(f, p)
+		if !ok {
+			continue
+		}
+
+		if expected := format.ToPascalCase(f.name); expected != f.Name() {
+			if strings.EqualFold(expected, f.Name()) {
+				c.warn(p, "field %s: conversion functions expect the field to be named %s, unless naming initialisms are enabled in the generator configuration", f.Name(), expected)
+			} else {
+				c.warn(p, "field %s: conversion functions expect the field to be named %s", f.Name(), expected)
+			}
+		}
+
+		if f.embedded != "" {
+			c.warn(p, "field %s: conversion functions set fields promoted from embedded field %s in struct literals, which requires Go 1.27 or later", f.Name(), f.embedded)
+		}
+
+		attributes = append(attributes, a)
+	}
+
+	return attributes
+}
+
+// attribute returns the attribute of the field, and false if the type of the
+// field is not supported.
+func (c converter) attribute(f field, p string) (specjson.Attribute, bool) {
+	a := specjson.Attribute{
+		Name: f.name,
+	}
+
+	t := types.Unalias(f.Type())
+
+	_, pointer := t.(*types.Pointer)
+
+	t = pointerElem(t)
+
+	at := &specjson.AttributeType{
+		ComputedOptionalRequired: "required",
+	}
+
+	if pointer || f.omitEmpty {
+		at.ComputedOptionalRequired = "optional"
+	}
+
+	if typeName, ok := c.primitive(t); ok {
+		c.expectType(f, p, "*"+typeName)
+
+		setAttributeType(&a, typeName, at)
+
+		return a, true
+	}
+
+	switch u := t.Underlying().(type) {
+	case *types.Struct:
+		named, ok := t.(*types.Named)
+
+		if !c.enter(named, p) {
+			return a, false
+		}
+
+		defer c.leave(named)
+
+		at.Attributes = c.attributes(u, p)
+
+		if ok {
+			aet := c.associatedExternalType(named)
+			at.AssociatedExternalType = &aet
+
+			c.expectType(f, p, "*"+c.typeString(t))
+		}
+
+		a.SingleNested = at
+
+		return a, true
+	case *types.Slice:
+		if nested, ok := c.nestedObject(u.Elem(), p); ok {
+			at.NestedObject = nested
+			a.ListNested = at
+
+			return a, nested != nil
+		}
+
+		elem, ok := c.elementType(u.Elem(), p)
+		if !ok {
+			return a, false
+		}
+
+		if typeName, ok := c.primitive(pointerElem(u.Elem())); ok {
+			c.expectType(f, p, "[]*"+typeName)
+		}
+
+		at.ElementType = &elem
+		a.List = at
+
+		return a, true
+	case *types.Map:
+		if !c.stringKey(u.Key(), p) {
+			return a, false
+		}
+
+		if nested, ok := c.nestedObject(u.Elem(), p); ok {
+			at.NestedObject = nested
+			a.MapNested = at
+
+			return a, nested != nil
+		}
+
+		elem, ok := c.elementType(u.Elem(), p)
+		if !ok {
+			return a, false
+		}
+
+		if typeName, ok := c.primitive(pointerElem(u.Elem())); ok {
+			c.expectType(f, p, "map[string]*"+typeName)
+		}
+
+		at.ElementType = &elem
+		a.Map = at
+
+		return a, true
+	}
+
+	c.unsupported(t, p)
+
+	return a, false
+}
+
+// expectType warns if the type of the field is not the type which the generated
+// conversion functions use for the attribute.
+func (c converter) expectType(f field, p, expected string) {
+	if actual := c.typeString(f.Type()); actual != expected {
+		c.warn(p, "field %s: conversion functions expect type %s, not %s", f.Name(), expected, actual)
+	}
+}
+
+// nestedObject returns the nested object of a struct element type, or of a
+// pointer to one, and false if the element type is not a struct. The nested
+// object is nil if the struct is recursive.
+func (c converter) nestedObject(elem types.Type, p string) (*specjson.NestedObject, bool) {
+	t := pointerElem(elem)
+
+	if _, ok := c.primitive(t); ok {
+		return nil, false
+	}
+
+	s, ok := t.Underlying().(*types.Struct)
+	if !ok {
+		return nil, false
+	}
+
+	named, ok := t.(*types.Named)
+
+	if !c.enter(named, p) {
+		return nil, true
+	}
+
+	defer c.leave(named)
+
+	nested := &specjson.NestedObject{
+		Attributes: c.attributes(s, p),
+	}
+
+	if ok {
+		aet := c.associatedExternalType(named)
+		nested.AssociatedExternalType = &aet
+	}
+
+	return nested, true
+}
+
+// elementType returns the element type of a list or map, and false if the
+// type is not supported. Structs are object types.
+func (c converter) elementType(elem types.Type, p string) (specjson.Type, bool) {
+	t := pointerElem(elem)
+
+	if typeName, ok := c.primitive(t); ok {
+		var et specjson.Type
+
+		setType(&et, typeName)
+
+		return et, true
+	}
+
+	switch u := t.Underlying().(type) {
+	case *types.Struct:
+		named, _ := t.(*types.Named)
+
+		if !c.enter(named, p) {
+			return specjson.Type{}, false
+		}
+
+		defer c.leave(named)
+
+		attributeTypes := []specjson.Type{}
+
+		for _, f := range c.fields(u) {
+			attributeType, ok := c.elementType(f.Type(), p+"."+f.name)
+			if !ok {
+				continue
+			}
+
+			attributeType.Name = f.name
+			attributeTypes = append(attributeTypes, attributeType)
+		}
+
+		return specjson.Type{
+			Object: &specjson.ObjectType{
+				AttributeTypes: attributeTypes,
+			},
+		}, true
+	case *types.Slice:
+		et, ok := c.elementType(u.Elem(), p)
+		if !ok {
+			return specjson.Type{}, false
+		}
+
+		return specjson.Type{
+			List: &specjson.CollectionType{
+				ElementType: et,
+			},
+		}, true
+	case *types.Map:
+		if !c.stringKey(u.Key(), p) {
+			return specjson.Type{}, false
+		}
+
+		et, ok := c.elementType(u.Elem(), p)
+		if !ok {
+			return specjson.Type{}, false
+		}
+
+		return specjson.Type{
+			Map: &specjson.CollectionType{
+				ElementType: et,
+			},
+		}, true
+	}
+
+	c.unsupported(t, p)
+
+	return specjson.Type{}, false
+}
+
+// primitive returns the name of the attribute type of a primitive Go type, and
+// false if the type is not primitive. Types which implement
+// encoding.TextMarshaler, such as time.Time, are strings.
+func (c converter) primitive(t types.Type) (string, bool) {
+	if implementsTextMarshaler(t) {
+		return "string", true
+	}
+
+	b, ok := t.Underlying().(*types.Basic)
+	if !ok {
+		return "", false
+	}
+
+	switch {
+	case b.Info()&types.IsBoolean != 0:
+		return "bool", true
+	case b.Info()&types.IsInteger != 0:
+		return "int64", true
+	case b.Info()&types.IsFloat != 0:
+		return "float64", true
+	case b.Info()&types.IsString != 0:
+		return "string", true
+	}
+
+	return "", false
+}
+
+// stringKey returns whether the map key type is a string, and warns if not.
+func (c converter) stringKey(key types.Type, p string) bool {
+	if b, ok := key.Underlying().(*types.Basic); ok && b.Info()&types.IsString != 0 {
+		return true
+	}
+
+	c.warn(p, "map key type %s is not supported, only string keys are", c.typeString(key))
+
+	return false
+}
+
+// unsupported warns that the type is not supported.
+func (c converter) unsupported(t types.Type, p string) {
+	if b, ok := t.(*types.Basic); ok && b.Kind() == types.Invalid {
+		c.warn(p, "type could not be determined, check that the package and its imports compile")
+
+		return
+	}
+
+	c.warn(p, "type %s is not supported", c.typeString(t))
+}
+
+// enter records that the named struct type is being translated, and returns
+// false, with a warning, if it already is. Anonymous struct types, for which
+// named is nil, cannot be recursive.
+func (c converter) enter(named *types.Named, p string) bool {
+	if named == nil {
+		return true
+	}
+
+	if c.visiting[named] {
+		c.warn(p, "recursive type %s is not supported", c.typeString(named))
+
+		return false
+	}
+
+	c.visiting[named] = true
+
+	return true
+}
+
+func (c converter) leave(named *types.Named) {
+	if named != nil {
+		delete(c.visiting, named)
+	}
+}
+
+// pointerElem returns the element type of a pointer type, or the type itself if
+// it is not a pointer.
+func pointerElem(t types.Type) types.Type {
+	t = types.Unalias(t)
+
+	if p, ok := t.(*types.Pointer); ok {
+		return types.Unalias(p.Elem())
+	}
+
+	return t
+}
+
+// implementsTextMarshaler returns whether the type, or a pointer to it, has a
+// MarshalText method.
+func implementsTextMarshaler(t types.Type) bool {
+	if _, ok := t.(*types.Named); !ok {
+		return false
+	}
+
+	obj, _, _ := types.LookupFieldOrMethod(types.NewPointer(t), false, nil, "MarshalText")
+
+	_, ok := obj.(*types.Func)
+
+	return ok
+}
+
+func setAttributeType(a *specjson.Attribute, typeName string, at *specjson.AttributeType) {
+	switch typeName {
+	case "bool":
+		a.Bool = at
+	case "float64":
+		a.Float64 = at
+	case "int64":
+		a.Int64 = at
+	case "string":
+		a.String = at
+	}
+}
+
+func setType(t *specjson.Type, typeName string) {
+	switch typeName {
+	case "bool":
+		t.Bool = &struct{}{}
+	case "float64":
+		t.Float64 = &struct{}{}
+	case "int64":
+		t.Int64 = &struct{}{}
+	case "string":
+		t.String = &struct{}{}
+	}
+}
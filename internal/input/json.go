@@ -0,0 +1,101 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package input
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+// locateJSON returns the region of the value at the JSON pointer in the
+// document. Columns are in Unicode code points.
+func locateJSON(document []byte, pointer string) (Region, bool) {
+	dec := json.NewDecoder(bytes.NewReader(document))
+
+	start, end, err := find(dec, document, "", pointer)
+	if !errors.Is(err, errFound) {
+		return Region{}, false
+	}
+
+	return Region{
+		Start: offsetPosition(document, start),
+		End:   offsetPosition(document, end),
+	}, true
+}
+
+// errFound stops find once the value at the pointer has been read.
+var errFound = errors.New("found")
+
+// find reads the next value from the decoder, which is at the current pointer,
+// and returns the byte offsets of the start and end of the value at the target
+// pointer, with errFound, if it is within the value.
+func find(dec *json.Decoder, document []byte, current, target string) (int, int, error) {
+	start := skip(document, int(dec.InputOffset()))
+
+	tok, err := dec.Token()
+	if err != nil {
+		return 0, 0, err
+	}
+
+	switch tok {
+	case json.Delim('{'):
+		for dec.More() {
+			key, err := dec.Token()
+			if err != nil {
+				return 0, 0, err
+			}
+
+			s, e, err := find(dec, document, current+"/"+escapePointer(key.(string)), target)
+			if err != nil {
+				return s, e, err
+			}
+		}
+
+		_, err = dec.Token()
+	case json.Delim('['):
+		for i := 0; dec.More(); i++ {
+			s, e, err := find(dec, document, current+"/"+strconv.Itoa(i), target)
+			if err != nil {
+				return s, e, err
+			}
+		}
+
+		_, err = dec.Token()
+	}
+
+	if err != nil {
+		return 0, 0, err
+	}
+
+	if current == target {
+		return start, int(dec.InputOffset()), errFound
+	}
+
+	return 0, 0, nil
+}
+
+// skip returns the offset of the first byte at or after the offset which is
+// not whitespace or a separator.
+func skip(document []byte, offset int) int {
+	for offset < len(document) && strings.IndexByte(" \t\r\n,:", document[offset]) >= 0 {
+		offset++
+	}
+
+	return offset
+}
+
+// offsetPosition returns the position of the byte offset in the document.
+func offsetPosition(document []byte, offset int) Position {
+	before := document[:offset]
+	lineStart := bytes.LastIndexByte(before, '\n') + 1
+
+	return Position{
+		Line:   bytes.Count(before, []byte("\n")) + 1,
+		Column: utf8.RuneCount(before[lineStart:]) + 1,
+	}
+}
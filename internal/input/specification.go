@@ -0,0 +1,214 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package input
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"path/filepath"
+	"regexp"
+	"strings"
+)
+
+const (
+	FormatJSON = "json"
+	FormatYAML = "yaml"
+)
+
+// Specification is a specification document, which is converted to JSON if it
+// was written in YAML, as the specification is parsed from JSON.
+type Specification struct {
+	// Path is the path of the document, which is empty for stdin.
+	Path string
+
+	// Format is the format the document was written in, FormatJSON or
+	// FormatYAML.
+	Format string
+
+	// JSON is the document, or its conversion to JSON.
+	JSON []byte
+
+	// positions are the positions of the values of a YAML document, keyed on
+	// JSON pointer. The position of an object member is that of its key.
+	positions map[string]Position
+}
+
+// Position is a 1-based line and column in a document.
+type Position struct {
+	Line   int
+	Column int
+}
+
+// Region is the start and end of a value in a document. End is not set for
+// values in YAML documents.
+type Region struct {
+	Start Position
+	End   Position
+}
+
+// SpecificationFormat returns the format of the specification at the path,
+// which is the format, if set, or otherwise FormatYAML for paths with a .yaml
+// or .yml extension, and FormatJSON for any other path, including stdin.
+func SpecificationFormat(path, format string) (string, error) {
+	switch format {
+	case FormatJSON, FormatYAML:
+		return format, nil
+	case "":
+	default:
+		return "", fmt.Errorf("unsupported input format %q, expected json or yaml", format)
+	}
+
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".yaml", ".yml":
+		return FormatYAML, nil
+	}
+
+	return FormatJSON, nil
+}
+
+// ReadSpecification reads the specification at the path, or from stdin if the
+// path is empty, in the format determined by SpecificationFormat, and converts
+// it to JSON if it is YAML.
+func ReadSpecification(path, format string) (Specification, error) {
+	format, err := SpecificationFormat(path, format)
+	if err != nil {
+		return Specification{}, err
+	}
+
+	src, err := Read(path)
+	if err != nil {
+		return Specification{}, err
+	}
+
+	s := Specification{
+		Path:   path,
+		Format: format,
+		JSON:   src,
+	}
+
+	if format == FormatYAML {
+		s.JSON, s.positions, err = yamlToJSON(src)
+
+		var nodeErr *nodeError
+
+		if errors.As(err, &nodeErr) {
+			return Specification{}, fmt.Errorf("%s:%d:%d: %s", s.name(), nodeErr.Position.Line, nodeErr.Position.Column, nodeErr.Message)
+		}
+
+		if err != nil {
+			return Specification{}, fmt.Errorf("%s: %w", s.name(), err)
+		}
+	}
+
+	return s, nil
+}
+
+// name returns the path of the document, or <stdin>.
+func (s Specification) name() string {
+	if s.Path == "" {
+		return "<stdin>"
+	}
+
+	return s.Path
+}
+
+// Region returns the region of the value at the JSON pointer (RFC 6901) in the
+// document, as it was written.
+func (s Specification) Region(pointer string) (Region, bool) {
+	if s.Format == FormatYAML {
+		p, ok := s.position(pointer)
+
+		return Region{Start: p}, ok
+	}
+
+	return locateJSON(s.JSON, pointer)
+}
+
+// Location returns the path, line and column of the value at the JSON pointer
+// in a YAML document (e.g., spec.yaml:12:7). An empty string is returned for
+// JSON documents, whose problems are located by JSON pointer alone.
+func (s Specification) Location(pointer string) string {
+	if s.Format != FormatYAML {
+		return ""
+	}
+
+	p, ok := s.position(pointer)
+	if !ok {
+		return ""
+	}
+
+	return fmt.Sprintf("%s:%d:%d", s.name(), p.Line, p.Column)
+}
+
+// position returns the position of the value at the JSON pointer in a YAML
+// document. Problems are reported for missing values too, such as a required
+// property, so the position of the closest value containing the pointer is
+// returned if there is no value at the pointer.
+func (s Specification) position(pointer string) (Position, bool) {
+	for {
+		p, ok := s.positions[pointer]
+		if ok {
+			return p, true
+		}
+
+		i := strings.LastIndex(pointer, "/")
+		if i < 0 {
+			return Position{}, false
+		}
+
+		pointer = pointer[:i]
+	}
+}
+
+// fieldError matches a JSON schema validation error, which is prefixed with the
+// dot-separated path of the invalid value (e.g., resources.0.schema: ...).
+var fieldError = regexp.MustCompile(`^(\(root\)|[a-z0-9_]+(?:\.[a-z0-9_]+)*): `)
+
+// Error returns the error from parsing the JSON of a YAML document, with each
+// line which refers to a value in the converted JSON prefixed with the
+// location of the value in the YAML document. Errors for JSON documents are
+// returned unchanged.
+func (s Specification) Error(err error) error {
+	if err == nil || s.Format != FormatYAML {
+		return err
+	}
+
+	var typeErr *json.UnmarshalTypeError
+
+	if errors.As(err, &typeErr) && typeErr.Field != "" {
+		message := fmt.Sprintf("%s: expected %s, got %s", typeErr.Field, typeErr.Type, typeErr.Value)
+
+		if location := s.Location(fieldPointer(typeErr.Field)); location != "" {
+			message = location + ": " + message
+		}
+
+		return errors.New(message)
+	}
+
+	lines := strings.Split(err.Error(), "\n")
+
+	for i, line := range lines {
+		m := fieldError.FindStringSubmatch(line)
+		if m == nil {
+			continue
+		}
+
+		if location := s.Location(fieldPointer(m[1])); location != "" {
+			lines[i] = location + ": " + line
+		}
+	}
+
+	return errors.New(strings.Join(lines, "\n"))
+}
+
+// fieldPointer returns the JSON pointer of a dot-separated path, such as
+// resources.0.schema, where (root) is the document.
+func fieldPointer(field string) string {
+	if field == "(root)" {
+		return ""
+	}
+
+	return "/" + strings.ReplaceAll(field, ".", "/")
+}
@@ -0,0 +1,201 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package input
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// yamlToJSON converts a YAML document to JSON, keeping the order of mapping
+// keys, and returns the positions of its values keyed on JSON pointer.
+func yamlToJSON(src []byte) ([]byte, map[string]Position, error) {
+	var root yaml.Node
+
+	err := yaml.Unmarshal(src, &root)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if root.Kind != yaml.DocumentNode || len(root.Content) == 0 {
+		return nil, nil, errors.New("empty document")
+	}
+
+	c := &yamlConverter{
+		positions: make(map[string]Position),
+	}
+
+	err = c.convert(root.Content[0], "", position(root.Content[0]))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return c.buf.Bytes(), c.positions, nil
+}
+
+// yamlConverter writes the JSON of YAML nodes.
+type yamlConverter struct {
+	buf       bytes.Buffer
+	positions map[string]Position
+}
+
+func position(n *yaml.Node) Position {
+	return Position{Line: n.Line, Column: n.Column}
+}
+
+// nodeError is an error converting a YAML node, at the position of the node.
+type nodeError struct {
+	Position Position
+	Message  string
+}
+
+func (e *nodeError) Error() string {
+	return fmt.Sprintf("line %d, column %d: %s", e.Position.Line, e.Position.Column, e.Message)
+}
+
+func yamlError(n *yaml.Node, format string, a ...any) error {
+	return &nodeError{Position: position(n), Message: fmt.Sprintf(format, a...)}
+}
+
+// convert writes the JSON of the node at the pointer. The position is that of
+// the node, or of its key if it is the value of a mapping key.
+func (c *yamlConverter) convert(n *yaml.Node, pointer string, p Position) error {
+	c.positions[pointer] = p
+
+	switch n.Kind {
+	case yaml.AliasNode:
+		return c.convert(n.Alias, pointer, p)
+	case yaml.MappingNode:
+		return c.mapping(n, pointer)
+	case yaml.SequenceNode:
+		c.buf.WriteByte('[')
+
+		for i, e := range n.Content {
+			if i > 0 {
+				c.buf.WriteByte(',')
+			}
+
+			err := c.convert(e, pointer+"/"+strconv.Itoa(i), position(e))
+			if err != nil {
+				return err
+			}
+		}
+
+		c.buf.WriteByte(']')
+
+		return nil
+	case yaml.ScalarNode:
+		return c.scalar(n)
+	}
+
+	return yamlError(n, "unsupported YAML node")
+}
+
+// mapping writes the JSON object of a mapping node, whose keys must be strings.
+func (c *yamlConverter) mapping(n *yaml.Node, pointer string) error {
+	keys := make(map[string]bool, len(n.Content)/2)
+
+	c.buf.WriteByte('{')
+
+	for i := 0; i+1 < len(n.Content); i += 2 {
+		k, v := n.Content[i], n.Content[i+1]
+
+		if k.Kind != yaml.ScalarNode || (k.Tag != "!!str" && k.Tag != "!!merge") {
+			return yamlError(k, "mapping keys must be strings")
+		}
+
+		if k.Tag == "!!merge" {
+			return yamlError(k, "merge keys are not supported")
+		}
+
+		if keys[k.Value] {
+			return yamlError(k, "mapping key %q already defined", k.Value)
+		}
+
+		keys[k.Value] = true
+
+		if i > 0 {
+			c.buf.WriteByte(',')
+		}
+
+		err := c.string(k.Value)
+		if err != nil {
+			return err
+		}
+
+		c.buf.WriteByte(':')
+
+		err = c.convert(v, pointer+"/"+escapePointer(k.Value), position(k))
+		if err != nil {
+			return err
+		}
+	}
+
+	c.buf.WriteByte('}')
+
+	return nil
+}
+
+// scalar writes the JSON of a scalar node. Values which cannot be represented
+// in JSON, such as infinity, are errors.
+func (c *yamlConverter) scalar(n *yaml.Node) error {
+	switch n.Tag {
+	case "!!null":
+		c.buf.WriteString("null")
+	case "!!bool":
+		var v bool
+
+		err := n.Decode(&v)
+		if err != nil {
+			return yamlError(n, "%s", err)
+		}
+
+		c.buf.WriteString(strconv.FormatBool(v))
+	case "!!int", "!!float":
+		var v any
+
+		err := n.Decode(&v)
+		if err != nil {
+			return yamlError(n, "%s", err)
+		}
+
+		if f, ok := v.(float64); ok && (math.IsInf(f, 0) || math.IsNaN(f)) {
+			return yamlError(n, "%s cannot be represented in JSON", n.Value)
+		}
+
+		b, err := json.Marshal(v)
+		if err != nil {
+			return yamlError(n, "%s", err)
+		}
+
+		c.buf.Write(b)
+	default:
+		return c.string(n.Value)
+	}
+
+	return nil
+}
+
+func (c *yamlConverter) string(s string) error {
+	b, err := json.Marshal(s)
+	if err != nil {
+		return err
+	}
+
+	c.buf.Write(b)
+
+	return nil
+}
+
+// escapePointer escapes a reference token of a JSON pointer.
+func escapePointer(s string) string {
+	return strings.NewReplacer("~", "~0", "/", "~1").Replace(s)
+}
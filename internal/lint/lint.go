@@ -0,0 +1,239 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+// Package lint checks the provider, resource and data source schemas in a
+// specification against provider conventions, such as attributes having
+// descriptions, which are not required for the specification to be valid.
+// Each rule has a severity, which can be changed, and findings can be
+// suppressed, with an overlay file.
+package lint
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-codegen-spec/spec"
+
+	"github.com/hashicorp/terraform-plugin-codegen-framework/internal/specmodel"
+)
+
+const (
+	SchemaProvider   = "provider"
+	SchemaResource   = "resource"
+	SchemaDataSource = "data source"
+)
+
+// Severity is the severity of the findings of a rule. The severities, other
+// than SeverityOff, are the levels of results in SARIF.
+type Severity string
+
+const (
+	SeverityError   Severity = "error"
+	SeverityWarning Severity = "warning"
+	SeverityNote    Severity = "note"
+
+	// SeverityOff disables a rule.
+	SeverityOff Severity = "off"
+)
+
+// Valid returns true for the known severities.
+func (s Severity) Valid() bool {
+	switch s {
+	case SeverityError, SeverityWarning, SeverityNote, SeverityOff:
+		return true
+	}
+
+	return false
+}
+
+// Finding is a violation of a rule by the provider, a resource or data source,
+// or one of their attributes or blocks.
+type Finding struct {
+	// Rule is the identifier of the rule (e.g., missing-description).
+	Rule string `json:"rule"`
+
+	// Severity is the severity of the rule, after applying the overlay.
+	Severity Severity `json:"severity"`
+
+	// Schema is the kind of schema: provider, resource or data source.
+	Schema string `json:"schema"`
+
+	// Name is the name of the provider, resource or data source.
+	Name string `json:"name"`
+
+	// Path is the dot-separated path of the attribute or block (e.g.,
+	// rules.port), which is empty for findings for the schema itself.
+	Path string `json:"path,omitempty"`
+
+	// Pointer is the JSON pointer (RFC 6901) of the provider, resource, data
+	// source, attribute or block in the specification (e.g.,
+	// /resources/0/schema/attributes/1).
+	Pointer string `json:"pointer"`
+
+	// Message describes the violation (e.g., attribute has no description).
+	Message string `json:"message"`
+
+	// Suppression is the suppression in the overlay which matches the
+	// finding, if any.
+	Suppression *Suppression `json:"suppression,omitempty"`
+}
+
+// String returns a description of the finding, such as
+// warning: resource "example" rules.port: attribute has no description [missing-description].
+func (f Finding) String() string {
+	return fmt.Sprintf("%s: %s: %s [%s]", f.Severity, f.Location(), f.Message, f.Rule)
+}
+
+// Location returns the schema, name and path of the finding, such as
+// resource "example" rules.port.
+func (f Finding) Location() string {
+	if f.Path == "" {
+		return fmt.Sprintf("%s %q", f.Schema, f.Name)
+	}
+
+	return fmt.Sprintf("%s %q %s", f.Schema, f.Name, f.Path)
+}
+
+// HasErrors returns true if any of the findings which are not suppressed have
+// SeverityError.
+func HasErrors(findings []Finding) bool {
+	for _, f := range findings {
+		if f.Suppression == nil && f.Severity == SeverityError {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Lint returns the findings of the rules which are not disabled by the overlay
+// for the provider, resources and data sources in the specification, in the
+// order of the specification. Findings matching a suppression in the overlay
+// are returned with the suppression set.
+func Lint(s spec.Specification, overlay Overlay) ([]Finding, error) {
+	l := &linter{
+		overlay: overlay,
+	}
+
+	if s.Provider != nil && s.Provider.Schema != nil {
+		err := l.schema(SchemaProvider, s.Provider.Name, "/provider", s.Provider.Schema)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	for i, r := range s.Resources {
+		err := l.schema(SchemaResource, r.Name, fmt.Sprintf("/resources/%d", i), r.Schema)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	for i, d := range s.DataSources {
+		err := l.schema(SchemaDataSource, d.Name, fmt.Sprintf("/datasources/%d", i), d.Schema)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return l.findings, nil
+}
+
+// linter accumulates the findings for a specification.
+type linter struct {
+	overlay  Overlay
+	findings []Finding
+}
+
+// schema checks a provider, resource or data source schema, and its
+// attributes and blocks.
+func (l *linter) schema(kind, name, pointer string, s any) error {
+	m, err := specmodel.New(s)
+	if err != nil {
+		return fmt.Errorf("error reading %s %q schema: %w", kind, name, err)
+	}
+
+	t := target{
+		kind:    kind,
+		name:    name,
+		pointer: pointer,
+		schema:  &m,
+	}
+
+	l.check(t)
+	l.fields(t, pointer+"/schema", m.Attributes, m.Blocks)
+
+	return nil
+}
+
+// fields checks the attributes and blocks of the parent, which are located
+// under the parent pointer.
+func (l *linter) fields(parent target, pointer string, attributes, blocks []specmodel.Field) {
+	for i, f := range attributes {
+		l.field(parent, fmt.Sprintf("%s/attributes/%d", pointer, i), f, false)
+	}
+
+	for i, f := range blocks {
+		l.field(parent, fmt.Sprintf("%s/blocks/%d", pointer, i), f, true)
+	}
+}
+
+// field checks an attribute or block, and its nested attributes and blocks.
+func (l *linter) field(parent target, pointer string, f specmodel.Field, block bool) {
+	path := f.Name
+	if parent.path != "" {
+		path = parent.path + "." + f.Name
+	}
+
+	t := target{
+		kind:             parent.kind,
+		name:             parent.name,
+		path:             path,
+		pointer:          pointer,
+		field:            &f,
+		block:            block,
+		computedAncestor: parent.computedAncestor || (parent.field != nil && parent.field.ComputedOptionalRequired == "computed"),
+	}
+
+	l.check(t)
+
+	if !f.IsNested() {
+		return
+	}
+
+	nestedPointer := pointer + "/" + f.Type
+
+	if f.NestedObject != nil {
+		nestedPointer += "/nested_object"
+	}
+
+	attributes, blocks := f.Nested()
+
+	l.fields(t, nestedPointer, attributes, blocks)
+}
+
+// check adds the findings of each enabled rule for the target.
+func (l *linter) check(t target) {
+	for _, r := range rules {
+		severity := l.overlay.severity(r)
+
+		if severity == SeverityOff {
+			continue
+		}
+
+		for _, message := range r.check(t) {
+			f := Finding{
+				Rule:     r.ID,
+				Severity: severity,
+				Schema:   t.kind,
+				Name:     t.name,
+				Path:     t.path,
+				Pointer:  t.pointer,
+				Message:  message,
+			}
+
+			f.Suppression = l.overlay.suppression(f)
+
+			l.findings = append(l.findings, f)
+		}
+	}
+}
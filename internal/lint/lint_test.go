@@ -0,0 +1,206 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package lint_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-codegen-spec/spec"
+
+	"github.com/hashicorp/terraform-plugin-codegen-framework/internal/lint"
+)
+
+func TestLint(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		spec     string
+		overlay  lint.Overlay
+		expected []lint.Finding
+	}{
+		"no_findings": {
+			spec: `{
+  "version": "0.1",
+  "provider": {"name": "example"},
+  "resources": [{"name": "one", "schema": {"attributes": [{"name": "id", "string": {"computed_optional_required": "required", "description": "Identifier."}}]}}]
+}`,
+		},
+		"missing_description": {
+			spec: `{
+  "version": "0.1",
+  "provider": {"name": "example", "schema": {"blocks": [{"name": "settings", "single_nested": {"attributes": [{"name": "region", "string": {"optional_required": "optional", "description": " "}}]}}]}}
+}`,
+			expected: []lint.Finding{
+				{Rule: "missing-description", Severity: lint.SeverityWarning, Schema: lint.SchemaProvider, Name: "example", Path: "settings", Pointer: "/provider/schema/blocks/0", Message: "block has no description"},
+				{Rule: "missing-description", Severity: lint.SeverityWarning, Schema: lint.SchemaProvider, Name: "example", Path: "settings.region", Pointer: "/provider/schema/blocks/0/single_nested/attributes/0", Message: "attribute has no description"},
+			},
+		},
+		"sensitive_name": {
+			spec: `{
+  "version": "0.1",
+  "provider": {"name": "example"},
+  "datasources": [{"name": "one", "schema": {"attributes": [
+    {"name": "client_secret", "string": {"computed_optional_required": "computed", "description": "Secret."}},
+    {"name": "auth_token", "string": {"computed_optional_required": "computed", "description": "Token.", "sensitive": true}},
+    {"name": "token_ttl", "int64": {"computed_optional_required": "computed", "description": "TTL."}},
+    {"name": "secretary", "string": {"computed_optional_required": "computed", "description": "Secretary."}}
+  ]}}]
+}`,
+			expected: []lint.Finding{
+				{Rule: "sensitive-name", Severity: lint.SeverityError, Schema: lint.SchemaDataSource, Name: "one", Path: "client_secret", Pointer: "/datasources/0/schema/attributes/0", Message: "attribute name suggests a secret, but the attribute is not sensitive"},
+			},
+		},
+		"use_state_for_unknown": {
+			spec: `{
+  "version": "0.1",
+  "provider": {"name": "example"},
+  "resources": [{"name": "one", "schema": {"attributes": [
+    {"name": "id", "string": {"computed_optional_required": "computed", "description": "Identifier.", "plan_modifiers": [{"custom": {"schema_definition": "stringplanmodifier.UseStateForUnknown()"}}]}},
+    {"name": "status", "string": {"computed_optional_required": "computed", "description": "Status."}},
+    {"name": "size", "int64": {"computed_optional_required": "computed_optional", "description": "Size."}},
+    {"name": "endpoints", "list_nested": {"computed_optional_required": "computed", "description": "Endpoints.", "nested_object": {"attributes": [
+      {"name": "url", "string": {"computed_optional_required": "computed", "description": "URL."}}
+    ]}}}
+  ]}}]
+}`,
+			expected: []lint.Finding{
+				{Rule: "use-state-for-unknown", Severity: lint.SeverityNote, Schema: lint.SchemaResource, Name: "one", Path: "status", Pointer: "/resources/0/schema/attributes/1", Message: "computed attribute has no UseStateForUnknown plan modifier, so its value is unknown in every plan"},
+				{Rule: "use-state-for-unknown", Severity: lint.SeverityNote, Schema: lint.SchemaResource, Name: "one", Path: "endpoints", Pointer: "/resources/0/schema/attributes/3", Message: "computed attribute has no UseStateForUnknown plan modifier, so its value is unknown in every plan"},
+			},
+		},
+		"resource_id": {
+			spec: `{
+  "version": "0.1",
+  "provider": {"name": "example"},
+  "resources": [{"name": "one", "schema": {"description": "One."}}],
+  "datasources": [{"name": "one", "schema": {"description": "One."}}]
+}`,
+			expected: []lint.Finding{
+				{Rule: "resource-id", Severity: lint.SeverityWarning, Schema: lint.SchemaResource, Name: "one", Pointer: "/resources/0", Message: "resource has no id attribute"},
+			},
+		},
+		"deprecation_message": {
+			spec: `{
+  "version": "0.1",
+  "provider": {"name": "example"},
+  "datasources": [{"name": "one", "schema": {"description": "Deprecated, use two.", "attributes": [
+    {"name": "size", "int64": {"computed_optional_required": "computed", "description": "Size.", "deprecation_message": ""}},
+    {"name": "name", "string": {"computed_optional_required": "computed", "description": "This attribute is deprecated.", "deprecation_message": "Use title."}}
+  ]}}]
+}`,
+			expected: []lint.Finding{
+				{Rule: "deprecation-message", Severity: lint.SeverityWarning, Schema: lint.SchemaDataSource, Name: "one", Pointer: "/datasources/0", Message: "schema is described as deprecated, but has no deprecation message"},
+				{Rule: "deprecation-message", Severity: lint.SeverityWarning, Schema: lint.SchemaDataSource, Name: "one", Path: "size", Pointer: "/datasources/0/schema/attributes/0", Message: "attribute has an empty deprecation message"},
+			},
+		},
+		"overlay": {
+			spec: `{
+  "version": "0.1",
+  "provider": {"name": "example"},
+  "resources": [
+    {"name": "one", "schema": {"blocks": [{"name": "auth", "single_nested": {"description": "Auth.", "attributes": [
+      {"name": "password", "string": {"computed_optional_required": "required", "description": "Password."}}
+    ]}}]}},
+    {"name": "two", "schema": {"attributes": [
+      {"name": "password", "string": {"computed_optional_required": "required", "description": "Password."}}
+    ]}}
+  ]
+}`,
+			overlay: lint.Overlay{
+				Rules: map[string]lint.Severity{
+					"resource-id":    lint.SeverityOff,
+					"sensitive-name": lint.SeverityWarning,
+				},
+				Suppressions: []lint.Suppression{
+					{Rule: "sensitive-name", Resource: "one", Path: "auth", Justification: "Write-only."},
+				},
+			},
+			expected: []lint.Finding{
+				{Rule: "sensitive-name", Severity: lint.SeverityWarning, Schema: lint.SchemaResource, Name: "one", Path: "auth.password", Pointer: "/resources/0/schema/blocks/0/single_nested/attributes/0", Message: "attribute name suggests a secret, but the attribute is not sensitive", Suppression: &lint.Suppression{Rule: "sensitive-name", Resource: "one", Path: "auth", Justification: "Write-only."}},
+				{Rule: "sensitive-name", Severity: lint.SeverityWarning, Schema: lint.SchemaResource, Name: "two", Path: "password", Pointer: "/resources/1/schema/attributes/0", Message: "attribute name suggests a secret, but the attribute is not sensitive"},
+			},
+		},
+	}
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			s, err := spec.Parse(context.Background(), []byte(testCase.spec))
+			if err != nil {
+				t.Fatalf("unexpected error parsing spec: %s", err)
+			}
+
+			got, err := lint.Lint(s, testCase.overlay)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestParseOverlay(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		document      string
+		expected      lint.Overlay
+		expectedError string
+	}{
+		"empty": {},
+		"valid": {
+			document: `{"rules": {"missing-description": "off"}, "suppressions": [{"rule": "resource-id", "resource": "one"}]}`,
+			expected: lint.Overlay{
+				Rules:        map[string]lint.Severity{"missing-description": lint.SeverityOff},
+				Suppressions: []lint.Suppression{{Rule: "resource-id", Resource: "one"}},
+			},
+		},
+		"invalid_json": {
+			document:      `{`,
+			expectedError: "invalid JSON",
+		},
+		"invalid": {
+			document: `{"rules": {"unknown": "error", "resource-id": "fatal"}, "suppressions": [{"rule": "resource-id"}, {"rule": "unknown", "resource": "one", "datasource": "one"}]}`,
+			expectedError: `rules "resource-id": severity must be one of error, warning, note or off, got "fatal"
+rules: unknown rule "unknown"
+suppressions 0: exactly one of provider, resource or datasource is required
+suppressions 1: unknown rule "unknown"
+suppressions 1: exactly one of provider, resource or datasource is required`,
+		},
+	}
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := lint.ParseOverlay([]byte(testCase.document))
+
+			if testCase.expectedError != "" {
+				if err == nil {
+					t.Fatalf("expected error %q, got none", testCase.expectedError)
+				}
+
+				if diff := cmp.Diff(err.Error(), testCase.expectedError); diff != "" {
+					t.Errorf("unexpected error difference: %s", diff)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
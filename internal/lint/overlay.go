@@ -0,0 +1,158 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package lint
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// Overlay changes the severities of rules, and suppresses findings, without
+// changing the specification.
+type Overlay struct {
+	// Rules maps rule IDs to severities, which replace the default severity
+	// of the rule. SeverityOff disables the rule.
+	Rules map[string]Severity `json:"rules,omitempty"`
+
+	// Suppressions suppress the findings of a rule for the provider, a
+	// resource or a data source, or for one of their attributes or blocks.
+	Suppressions []Suppression `json:"suppressions,omitempty"`
+}
+
+// Suppression suppresses the findings of a rule. Exactly one of Provider,
+// Resource and DataSource must be set.
+type Suppression struct {
+	// Rule is the ID of the rule.
+	Rule string `json:"rule"`
+
+	// Provider is the name of the provider.
+	Provider string `json:"provider,omitempty"`
+
+	// Resource is the name of a resource.
+	Resource string `json:"resource,omitempty"`
+
+	// DataSource is the name of a data source.
+	DataSource string `json:"datasource,omitempty"`
+
+	// Path is the dot-separated path of an attribute or block (e.g.,
+	// rules.port). Findings for the attribute or block, and for those nested
+	// within it, are suppressed. If empty, all findings for the schema are
+	// suppressed.
+	Path string `json:"path,omitempty"`
+
+	// Justification explains why the findings are suppressed.
+	Justification string `json:"justification,omitempty"`
+}
+
+// ParseOverlay returns an Overlay from the JSON document contents. An empty
+// document returns an empty Overlay.
+func ParseOverlay(document []byte) (Overlay, error) {
+	var o Overlay
+
+	if len(document) == 0 {
+		return o, nil
+	}
+
+	if !json.Valid(document) {
+		return o, errors.New("invalid JSON")
+	}
+
+	if err := json.Unmarshal(document, &o); err != nil {
+		return o, err
+	}
+
+	if err := o.Validate(); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// Validate checks that rules and suppressions refer to known rules, that
+// severities are known, and that each suppression sets exactly one of
+// provider, resource and datasource.
+func (o Overlay) Validate() error {
+	var errs []error
+
+	ids := make([]string, 0, len(o.Rules))
+
+	for id := range o.Rules {
+		ids = append(ids, id)
+	}
+
+	sort.Strings(ids)
+
+	for _, id := range ids {
+		if _, ok := ruleByID(id); !ok {
+			errs = append(errs, fmt.Errorf("rules: unknown rule %q", id))
+		}
+
+		if !o.Rules[id].Valid() {
+			errs = append(errs, fmt.Errorf("rules %q: severity must be one of error, warning, note or off, got %q", id, o.Rules[id]))
+		}
+	}
+
+	for i, s := range o.Suppressions {
+		if _, ok := ruleByID(s.Rule); !ok {
+			errs = append(errs, fmt.Errorf("suppressions %d: unknown rule %q", i, s.Rule))
+		}
+
+		set := 0
+
+		for _, name := range []string{s.Provider, s.Resource, s.DataSource} {
+			if name != "" {
+				set++
+			}
+		}
+
+		if set != 1 {
+			errs = append(errs, fmt.Errorf("suppressions %d: exactly one of provider, resource or datasource is required", i))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// severity returns the severity of the rule, which is the default severity
+// unless changed by the overlay.
+func (o Overlay) severity(r Rule) Severity {
+	if s, ok := o.Rules[r.ID]; ok {
+		return s
+	}
+
+	return r.Severity
+}
+
+// suppression returns the first suppression matching the finding, if any.
+func (o Overlay) suppression(f Finding) *Suppression {
+	for i, s := range o.Suppressions {
+		if s.Rule != f.Rule || s.name(f.Schema) != f.Name || s.name(f.Schema) == "" {
+			continue
+		}
+
+		if s.Path == "" || s.Path == f.Path || strings.HasPrefix(f.Path, s.Path+".") {
+			return &o.Suppressions[i]
+		}
+	}
+
+	return nil
+}
+
+// name returns the name of the provider, resource or data source the
+// suppression applies to, for the kind of schema.
+func (s Suppression) name(kind string) string {
+	switch kind {
+	case SchemaProvider:
+		return s.Provider
+	case SchemaResource:
+		return s.Resource
+	case SchemaDataSource:
+		return s.DataSource
+	}
+
+	return ""
+}
@@ -0,0 +1,204 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package lint
+
+import (
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-codegen-framework/internal/specmodel"
+)
+
+// Rule is a provider convention checked for each schema, attribute and block.
+type Rule struct {
+	// ID identifies the rule in findings and overlays (e.g.,
+	// missing-description).
+	ID string
+
+	// Description describes the convention.
+	Description string
+
+	// Severity is the severity of findings unless changed by an overlay.
+	Severity Severity
+
+	// check returns a message for each violation by the target.
+	check func(t target) []string
+}
+
+// target is a provider, resource or data source schema, or one of its
+// attributes or blocks.
+type target struct {
+	kind    string
+	name    string
+	path    string
+	pointer string
+
+	// schema is set for provider, resource and data source schemas.
+	schema *specmodel.Schema
+
+	// field is set for attributes and blocks.
+	field *specmodel.Field
+	block bool
+
+	// computedAncestor is true for attributes and blocks nested within a
+	// computed attribute or block, which cannot be configured.
+	computedAncestor bool
+}
+
+// fieldKind returns attribute or block.
+func (t target) fieldKind() string {
+	if t.block {
+		return "block"
+	}
+
+	return "attribute"
+}
+
+// rules are the rules, in the order they are checked.
+var rules = []Rule{
+	{
+		ID:          "missing-description",
+		Description: "Attributes and blocks should have a description, which is used in documentation.",
+		Severity:    SeverityWarning,
+		check:       checkMissingDescription,
+	},
+	{
+		ID:          "sensitive-name",
+		Description: "Attributes named after secrets, such as passwords and tokens, should be sensitive.",
+		Severity:    SeverityError,
+		check:       checkSensitiveName,
+	},
+	{
+		ID:          "use-state-for-unknown",
+		Description: "Computed resource attributes should have a UseStateForUnknown plan modifier, unless their value changes on update.",
+		Severity:    SeverityNote,
+		check:       checkUseStateForUnknown,
+	},
+	{
+		ID:          "resource-id",
+		Description: "Resources should have an id attribute.",
+		Severity:    SeverityWarning,
+		check:       checkResourceID,
+	},
+	{
+		ID:          "deprecation-message",
+		Description: "Deprecated schemas, attributes and blocks should have a deprecation message, which tells practitioners what to use instead.",
+		Severity:    SeverityWarning,
+		check:       checkDeprecationMessage,
+	},
+}
+
+// Rules returns the rules, in the order they are checked.
+func Rules() []Rule {
+	return slices.Clone(rules)
+}
+
+// ruleByID returns the rule with the ID.
+func ruleByID(id string) (Rule, bool) {
+	for _, r := range rules {
+		if r.ID == id {
+			return r, true
+		}
+	}
+
+	return Rule{}, false
+}
+
+func checkMissingDescription(t target) []string {
+	if t.field == nil {
+		return nil
+	}
+
+	if t.field.Description != nil && strings.TrimSpace(*t.field.Description) != "" {
+		return nil
+	}
+
+	return []string{t.fieldKind() + " has no description"}
+}
+
+// secretWords are the words of attribute names which suggest a secret value.
+var secretWords = []string{"passphrase", "password", "secret", "token"}
+
+func checkSensitiveName(t target) []string {
+	if t.field == nil || t.block {
+		return nil
+	}
+
+	switch t.field.Type {
+	case "bool", "float64", "int64", "number":
+		return nil
+	}
+
+	if t.field.Sensitive != nil && *t.field.Sensitive {
+		return nil
+	}
+
+	for _, word := range strings.Split(t.field.Name, "_") {
+		if slices.Contains(secretWords, word) {
+			return []string{"attribute name suggests a secret, but the attribute is not sensitive"}
+		}
+	}
+
+	return nil
+}
+
+func checkUseStateForUnknown(t target) []string {
+	if t.kind != SchemaResource || t.field == nil || t.block || t.computedAncestor {
+		return nil
+	}
+
+	if t.field.ComputedOptionalRequired != "computed" {
+		return nil
+	}
+
+	for _, p := range t.field.PlanModifiers {
+		if p.Custom != nil && strings.Contains(p.Custom.SchemaDefinition, "UseStateForUnknown") {
+			return nil
+		}
+	}
+
+	return []string{"computed attribute has no UseStateForUnknown plan modifier, so its value is unknown in every plan"}
+}
+
+func checkResourceID(t target) []string {
+	if t.kind != SchemaResource || t.schema == nil {
+		return nil
+	}
+
+	for _, a := range t.schema.Attributes {
+		if a.Name == "id" {
+			return nil
+		}
+	}
+
+	return []string{"resource has no id attribute"}
+}
+
+func checkDeprecationMessage(t target) []string {
+	var deprecationMessage, description *string
+
+	kind := "schema"
+
+	switch {
+	case t.schema != nil:
+		deprecationMessage, description = t.schema.DeprecationMessage, t.schema.Description
+	case t.field != nil:
+		kind = t.fieldKind()
+		deprecationMessage, description = t.field.DeprecationMessage, t.field.Description
+	}
+
+	if deprecationMessage != nil {
+		if strings.TrimSpace(*deprecationMessage) == "" {
+			return []string{kind + " has an empty deprecation message"}
+		}
+
+		return nil
+	}
+
+	if description != nil && strings.Contains(strings.ToLower(*description), "deprecated") {
+		return []string{kind + " is described as deprecated, but has no deprecation message"}
+	}
+
+	return nil
+}
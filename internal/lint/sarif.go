@@ -0,0 +1,172 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package lint
+
+import (
+	"encoding/json"
+	"path/filepath"
+
+	"github.com/hashicorp/terraform-plugin-codegen-framework/internal/input"
+)
+
+const (
+	sarifSchema  = "https://json.schemastore.org/sarif-2.1.0.json"
+	sarifVersion = "2.1.0"
+
+	toolName           = "tfplugingen-framework"
+	toolInformationURI = "https://github.com/hashicorp/terraform-plugin-codegen-framework"
+)
+
+type sarifLog struct {
+	Schema  string     `json:"$schema"`
+	Version string     `json:"version"`
+	Runs    []sarifRun `json:"runs"`
+}
+
+type sarifRun struct {
+	Tool       sarifTool     `json:"tool"`
+	ColumnKind string        `json:"columnKind"`
+	Results    []sarifResult `json:"results"`
+}
+
+type sarifTool struct {
+	Driver sarifDriver `json:"driver"`
+}
+
+type sarifDriver struct {
+	Name           string      `json:"name"`
+	InformationURI string      `json:"informationUri"`
+	Rules          []sarifRule `json:"rules"`
+}
+
+type sarifRule struct {
+	ID                   string             `json:"id"`
+	ShortDescription     sarifMessage       `json:"shortDescription"`
+	DefaultConfiguration sarifConfiguration `json:"defaultConfiguration"`
+}
+
+type sarifConfiguration struct {
+	Level string `json:"level"`
+}
+
+type sarifMessage struct {
+	Text string `json:"text"`
+}
+
+type sarifResult struct {
+	RuleID       string             `json:"ruleId"`
+	RuleIndex    int                `json:"ruleIndex"`
+	Level        string             `json:"level"`
+	Message      sarifMessage       `json:"message"`
+	Locations    []sarifLocation    `json:"locations"`
+	Suppressions []sarifSuppression `json:"suppressions,omitempty"`
+}
+
+type sarifLocation struct {
+	PhysicalLocation *sarifPhysicalLocation `json:"physicalLocation,omitempty"`
+	LogicalLocations []sarifLogicalLocation `json:"logicalLocations"`
+}
+
+type sarifPhysicalLocation struct {
+	ArtifactLocation sarifArtifactLocation `json:"artifactLocation"`
+	Region           *sarifRegion          `json:"region,omitempty"`
+}
+
+type sarifArtifactLocation struct {
+	URI string `json:"uri"`
+}
+
+type sarifRegion struct {
+	StartLine   int `json:"startLine"`
+	StartColumn int `json:"startColumn"`
+	EndLine     int `json:"endLine,omitempty"`
+	EndColumn   int `json:"endColumn,omitempty"`
+}
+
+type sarifLogicalLocation struct {
+	FullyQualifiedName string `json:"fullyQualifiedName"`
+}
+
+type sarifSuppression struct {
+	Kind          string `json:"kind"`
+	Justification string `json:"justification,omitempty"`
+}
+
+// SARIF returns the findings as a SARIF 2.1.0 log, for code scanning. Findings
+// are located within the specification, whose path should be relative to the
+// repository root, and have no physical location if it was read from stdin.
+// Suppressed findings are included, with an external suppression.
+func SARIF(findings []Finding, s input.Specification) ([]byte, error) {
+	uri := filepath.ToSlash(s.Path)
+
+	driver := sarifDriver{
+		Name:           toolName,
+		InformationURI: toolInformationURI,
+		Rules:          make([]sarifRule, 0, len(rules)),
+	}
+
+	ruleIndexes := make(map[string]int, len(rules))
+
+	for i, r := range rules {
+		driver.Rules = append(driver.Rules, sarifRule{
+			ID:                   r.ID,
+			ShortDescription:     sarifMessage{Text: r.Description},
+			DefaultConfiguration: sarifConfiguration{Level: string(r.Severity)},
+		})
+
+		ruleIndexes[r.ID] = i
+	}
+
+	results := make([]sarifResult, 0, len(findings))
+
+	for _, f := range findings {
+		location := sarifLocation{
+			LogicalLocations: []sarifLogicalLocation{{FullyQualifiedName: f.Location()}},
+		}
+
+		if uri != "" {
+			location.PhysicalLocation = &sarifPhysicalLocation{
+				ArtifactLocation: sarifArtifactLocation{URI: uri},
+			}
+
+			if r, ok := s.Region(f.Pointer); ok {
+				location.PhysicalLocation.Region = &sarifRegion{
+					StartLine:   r.Start.Line,
+					StartColumn: r.Start.Column,
+					EndLine:     r.End.Line,
+					EndColumn:   r.End.Column,
+				}
+			}
+		}
+
+		result := sarifResult{
+			RuleID:    f.Rule,
+			RuleIndex: ruleIndexes[f.Rule],
+			Level:     string(f.Severity),
+			Message:   sarifMessage{Text: f.Message},
+			Locations: []sarifLocation{location},
+		}
+
+		if f.Suppression != nil {
+			result.Suppressions = []sarifSuppression{{
+				Kind:          "external",
+				Justification: f.Suppression.Justification,
+			}}
+		}
+
+		results = append(results, result)
+	}
+
+	log := sarifLog{
+		Schema:  sarifSchema,
+		Version: sarifVersion,
+		Runs: []sarifRun{{
+			Tool:       sarifTool{Driver: driver},
+			ColumnKind: "unicodeCodePoints",
+			Results:    results,
+		}},
+	}
+
+	return json.MarshalIndent(log, "", "  ")
+}
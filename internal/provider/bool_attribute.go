@@ -22,16 +22,18 @@ type GeneratorBoolAttribute struct {
 	Description            convert.Description
 	Sensitive              convert.Sensitive
 	Validators             convert.Validators
+
+	options *schema.Options
 }
 
-func NewGeneratorBoolAttribute(name string, a *provider.BoolAttribute) (GeneratorBoolAttribute, error) {
+func NewGeneratorBoolAttribute(name string, a *provider.BoolAttribute, opts *schema.Options) (GeneratorBoolAttribute, error) {
 	if a == nil {
 		return GeneratorBoolAttribute{}, fmt.Errorf("*provider.BoolAttribute is nil")
 	}
 
 	c := convert.NewOptionalRequired(a.OptionalRequired)
 
-	ctp := convert.NewCustomTypePrimitive(a.CustomType, a.AssociatedExternalType, name)
+	ctp := convert.NewCustomTypePrimitive(a.CustomType, a.AssociatedExternalType, name, opts)
 
 	d := convert.NewDescription(a.Description)
 
@@ -42,13 +44,14 @@ func NewGeneratorBoolAttribute(name string, a *provider.BoolAttribute) (Generato
 	v := convert.NewValidators(convert.ValidatorTypeBool, a.Validators.CustomValidators())
 
 	return GeneratorBoolAttribute{
-		AssociatedExternalType: schema.NewAssocExtType(a.AssociatedExternalType),
+		AssociatedExternalType: schema.NewAssocExtType(a.AssociatedExternalType, opts),
 		OptionalRequired:       c,
 		CustomType:             ctp,
 		DeprecationMessage:     dm,
 		Description:            d,
 		Sensitive:              s,
 		Validators:             v,
+		options:                opts,
 	}, nil
 }
 
@@ -123,7 +126,7 @@ func (g GeneratorBoolAttribute) Schema(name schema.FrameworkIdentifier) (string,
 
 func (g GeneratorBoolAttribute) ModelField(name schema.FrameworkIdentifier) (model.Field, error) {
 	field := model.Field{
-		Name:      name.ToPascalCase(),
+		Name:      g.options.ToPascalCase(name),
 		TfsdkName: name.ToString(),
 		ValueType: model.BoolValueType,
 	}
@@ -144,7 +147,7 @@ func (g GeneratorBoolAttribute) CustomTypeAndValue(name string) ([]byte, error)
 
 	var buf bytes.Buffer
 
-	boolType := schema.NewCustomBoolType(name)
+	boolType := schema.NewCustomBoolType(name, g.options)
 
 	b, err := boolType.Render()
 
@@ -154,7 +157,7 @@ func (g GeneratorBoolAttribute) CustomTypeAndValue(name string) ([]byte, error)
 
 	buf.Write(b)
 
-	boolValue := schema.NewCustomBoolValue(name)
+	boolValue := schema.NewCustomBoolValue(name, g.options)
 
 	b, err = boolValue.Render()
 
@@ -167,12 +170,16 @@ func (g GeneratorBoolAttribute) CustomTypeAndValue(name string) ([]byte, error)
 	return buf.Bytes(), nil
 }
 
+func (g GeneratorBoolAttribute) GetAssociatedExternalType() *schema.AssocExtType {
+	return g.AssociatedExternalType
+}
+
 func (g GeneratorBoolAttribute) ToFromFunctions(name string) ([]byte, error) {
 	if g.AssociatedExternalType == nil {
 		return nil, nil
 	}
 
-	toFrom := schema.NewToFromBool(name, g.AssociatedExternalType)
+	toFrom := schema.NewToFromBool(name, g.AssociatedExternalType, g.options)
 
 	b, err := toFrom.Render()
 
@@ -186,7 +193,7 @@ func (g GeneratorBoolAttribute) ToFromFunctions(name string) ([]byte, error) {
 // AttrType returns a string representation of a basetypes.BoolTypable type.
 func (g GeneratorBoolAttribute) AttrType(name schema.FrameworkIdentifier) (string, error) {
 	if g.AssociatedExternalType != nil {
-		return fmt.Sprintf("%sType{}", name.ToPascalCase()), nil
+		return fmt.Sprintf("%sType{}", g.options.ToPascalCase(name)), nil
 	}
 
 	return "basetypes.BoolType{}", nil
@@ -195,7 +202,7 @@ func (g GeneratorBoolAttribute) AttrType(name schema.FrameworkIdentifier) (strin
 // AttrValue returns a string representation of a basetypes.BoolValuable type.
 func (g GeneratorBoolAttribute) AttrValue(name schema.FrameworkIdentifier) string {
 	if g.AssociatedExternalType != nil {
-		return fmt.Sprintf("%sValue", name.ToPascalCase())
+		return fmt.Sprintf("%sValue", g.options.ToPascalCase(name))
 	}
 
 	return "basetypes.BoolValue"
@@ -224,3 +231,13 @@ func (g GeneratorBoolAttribute) From() (schema.ToFromConversion, error) {
 		Default: "BoolPointerValue",
 	}, nil
 }
+
+func (g GeneratorBoolAttribute) Properties() schema.FieldProperties {
+	return schema.FieldProperties{
+		Optional:           g.OptionalRequired.IsOptional(),
+		Required:           g.OptionalRequired.IsRequired(),
+		Sensitive:          g.Sensitive.IsSensitive(),
+		Description:        g.Description.Description(),
+		DeprecationMessage: g.DeprecationMessage.DeprecationMessage(),
+	}
+}
@@ -34,7 +34,7 @@ func TestGeneratorBoolAttribute_New(t *testing.T) {
 			},
 			expected: GeneratorBoolAttribute{
 				OptionalRequired: convert.NewOptionalRequired(specschema.ComputedOptional),
-				CustomType:       convert.NewCustomTypePrimitive(nil, nil, "name"),
+				CustomType:       convert.NewCustomTypePrimitive(nil, nil, "name", nil),
 				Validators:       convert.NewValidators(convert.ValidatorTypeBool, specschema.CustomValidators{}),
 			},
 		},
@@ -44,7 +44,7 @@ func TestGeneratorBoolAttribute_New(t *testing.T) {
 			},
 			expected: GeneratorBoolAttribute{
 				OptionalRequired: convert.NewOptionalRequired(specschema.Optional),
-				CustomType:       convert.NewCustomTypePrimitive(nil, nil, "name"),
+				CustomType:       convert.NewCustomTypePrimitive(nil, nil, "name", nil),
 				Validators:       convert.NewValidators(convert.ValidatorTypeBool, specschema.CustomValidators{}),
 			},
 		},
@@ -54,7 +54,7 @@ func TestGeneratorBoolAttribute_New(t *testing.T) {
 			},
 			expected: GeneratorBoolAttribute{
 				OptionalRequired: convert.NewOptionalRequired(specschema.Required),
-				CustomType:       convert.NewCustomTypePrimitive(nil, nil, "name"),
+				CustomType:       convert.NewCustomTypePrimitive(nil, nil, "name", nil),
 				Validators:       convert.NewValidators(convert.ValidatorTypeBool, specschema.CustomValidators{}),
 			},
 		},
@@ -75,7 +75,7 @@ func TestGeneratorBoolAttribute_New(t *testing.T) {
 					},
 					Type:      "my_type",
 					ValueType: "myvalue_type",
-				}, nil, "name"),
+				}, nil, "name", nil),
 				Validators: convert.NewValidators(convert.ValidatorTypeBool, nil),
 			},
 		},
@@ -84,7 +84,7 @@ func TestGeneratorBoolAttribute_New(t *testing.T) {
 				DeprecationMessage: pointer("deprecation message"),
 			},
 			expected: GeneratorBoolAttribute{
-				CustomType:         convert.NewCustomTypePrimitive(nil, nil, "name"),
+				CustomType:         convert.NewCustomTypePrimitive(nil, nil, "name", nil),
 				DeprecationMessage: convert.NewDeprecationMessage(pointer("deprecation message")),
 				Validators:         convert.NewValidators(convert.ValidatorTypeBool, specschema.CustomValidators{}),
 			},
@@ -94,7 +94,7 @@ func TestGeneratorBoolAttribute_New(t *testing.T) {
 				Description: pointer("description"),
 			},
 			expected: GeneratorBoolAttribute{
-				CustomType:  convert.NewCustomTypePrimitive(nil, nil, "name"),
+				CustomType:  convert.NewCustomTypePrimitive(nil, nil, "name", nil),
 				Description: convert.NewDescription(pointer("description")),
 				Validators:  convert.NewValidators(convert.ValidatorTypeBool, specschema.CustomValidators{}),
 			},
@@ -104,7 +104,7 @@ func TestGeneratorBoolAttribute_New(t *testing.T) {
 				Sensitive: pointer(true),
 			},
 			expected: GeneratorBoolAttribute{
-				CustomType: convert.NewCustomTypePrimitive(nil, nil, "name"),
+				CustomType: convert.NewCustomTypePrimitive(nil, nil, "name", nil),
 				Sensitive:  convert.NewSensitive(pointer(true)),
 				Validators: convert.NewValidators(convert.ValidatorTypeBool, specschema.CustomValidators{}),
 			},
@@ -125,7 +125,7 @@ func TestGeneratorBoolAttribute_New(t *testing.T) {
 				},
 			},
 			expected: GeneratorBoolAttribute{
-				CustomType: convert.NewCustomTypePrimitive(nil, nil, "name"),
+				CustomType: convert.NewCustomTypePrimitive(nil, nil, "name", nil),
 				Validators: convert.NewValidators(convert.ValidatorTypeBool, specschema.CustomValidators{
 					&specschema.CustomValidator{
 						Imports: []code.Import{
@@ -145,7 +145,7 @@ func TestGeneratorBoolAttribute_New(t *testing.T) {
 		t.Run(name, func(t *testing.T) {
 			t.Parallel()
 
-			got, err := NewGeneratorBoolAttribute("name", testCase.input)
+			got, err := NewGeneratorBoolAttribute("name", testCase.input, nil)
 
 			if diff := cmp.Diff(err, testCase.expectedError, equateErrorMessage); diff != "" {
 				t.Errorf("unexpected error: %s", diff)
@@ -174,7 +174,7 @@ func TestGeneratorBoolAttribute_Imports(t *testing.T) {
 		},
 		"custom-type-without-import": {
 			input: GeneratorBoolAttribute{
-				CustomType: convert.NewCustomTypePrimitive(&specschema.CustomType{}, nil, ""),
+				CustomType: convert.NewCustomTypePrimitive(&specschema.CustomType{}, nil, "", nil),
 			},
 			expected: []code.Import{},
 		},
@@ -188,6 +188,7 @@ func TestGeneratorBoolAttribute_Imports(t *testing.T) {
 					},
 					nil,
 					"",
+					nil,
 				),
 			},
 			expected: []code.Import{},
@@ -202,6 +203,7 @@ func TestGeneratorBoolAttribute_Imports(t *testing.T) {
 					},
 					nil,
 					"",
+					nil,
 				),
 			},
 			expected: []code.Import{
@@ -364,6 +366,7 @@ func TestGeneratorBoolAttribute_Imports(t *testing.T) {
 					},
 					nil,
 					"",
+					nil,
 				),
 			},
 			expected: []code.Import{
@@ -422,6 +425,7 @@ func TestGeneratorBoolAttribute_Schema(t *testing.T) {
 					},
 					nil,
 					"bool_attribute",
+					nil,
 				),
 			},
 			expected: `"bool_attribute": schema.BoolAttribute{
@@ -437,6 +441,7 @@ CustomType: my_custom_type,
 						Type: "*api.ExtBool",
 					},
 					"bool_attribute",
+					nil,
 				),
 			},
 			expected: `"bool_attribute": schema.BoolAttribute{
@@ -454,6 +459,7 @@ CustomType: BoolAttributeType{},
 						Type: "*api.ExtBool",
 					},
 					"bool_attribute",
+					nil,
 				),
 			},
 			expected: `"bool_attribute": schema.BoolAttribute{
@@ -576,6 +582,7 @@ func TestGeneratorBoolAttribute_ModelField(t *testing.T) {
 					},
 					nil,
 					"",
+					nil,
 				),
 			},
 			expected: model.Field{
@@ -592,6 +599,7 @@ func TestGeneratorBoolAttribute_ModelField(t *testing.T) {
 						Type: "*api.BoolAttribute",
 					},
 					"bool_attribute",
+					nil,
 				),
 			},
 			expected: model.Field{
@@ -610,6 +618,7 @@ func TestGeneratorBoolAttribute_ModelField(t *testing.T) {
 						Type: "*api.BoolAttribute",
 					},
 					"",
+					nil,
 				),
 			},
 			expected: model.Field{
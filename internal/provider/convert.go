@@ -12,10 +12,10 @@ import (
 	generatorschema "github.com/hashicorp/terraform-plugin-codegen-framework/internal/schema"
 )
 
-func NewSchemas(spec spec.Specification) (map[string]generatorschema.GeneratorSchema, error) {
+func NewSchemas(spec spec.Specification, opts *generatorschema.Options) (map[string]generatorschema.GeneratorSchema, error) {
 	providerSchemas := make(map[string]generatorschema.GeneratorSchema, 1)
 
-	providerSchema, err := NewSchema(spec.Provider)
+	providerSchema, err := NewSchema(spec.Provider, opts)
 
 	if err != nil {
 		return nil, err
@@ -26,8 +26,10 @@ func NewSchemas(spec spec.Specification) (map[string]generatorschema.GeneratorSc
 	return providerSchemas, nil
 }
 
-func NewSchema(p *provider.Provider) (generatorschema.GeneratorSchema, error) {
-	var s generatorschema.GeneratorSchema
+func NewSchema(p *provider.Provider, opts *generatorschema.Options) (generatorschema.GeneratorSchema, error) {
+	s := generatorschema.GeneratorSchema{
+		Options: opts,
+	}
 
 	if p.Schema == nil {
 		return s, nil
@@ -37,7 +39,7 @@ func NewSchema(p *provider.Provider) (generatorschema.GeneratorSchema, error) {
 	blocks := make(generatorschema.GeneratorBlocks, len(p.Schema.Blocks))
 
 	for _, v := range p.Schema.Attributes {
-		a, err := NewAttribute(v)
+		a, err := NewAttribute(v, opts)
 
 		if err != nil {
 			return s, err
@@ -49,7 +51,7 @@ func NewSchema(p *provider.Provider) (generatorschema.GeneratorSchema, error) {
 	s.Attributes = attributes
 
 	for _, v := range p.Schema.Blocks {
-		b, err := NewBlock(v)
+		b, err := NewBlock(v, opts)
 
 		if err != nil {
 			return s, err
@@ -69,11 +71,13 @@ func NewSchema(p *provider.Provider) (generatorschema.GeneratorSchema, error) {
 	return s, nil
 }
 
-func NewAttributes(a provider.Attributes) (generatorschema.GeneratorAttributes, error) {
+// NewAttributes converts the attributes nested within an attribute or block. The parent
+// is the name of the custom type and value types generated for the attribute or block.
+func NewAttributes(a provider.Attributes, parent string, opts *generatorschema.Options) (generatorschema.GeneratorAttributes, error) {
 	attributes := make(generatorschema.GeneratorAttributes, len(a))
 
 	for _, v := range a {
-		attribute, err := NewAttribute(v)
+		attribute, err := newAttribute(v, opts.NestedTypeName(parent, v.Name), opts)
 
 		if err != nil {
 			return generatorschema.GeneratorAttributes{}, err
@@ -85,44 +89,52 @@ func NewAttributes(a provider.Attributes) (generatorschema.GeneratorAttributes,
 	return attributes, nil
 }
 
-func NewAttribute(a provider.Attribute) (generatorschema.GeneratorAttribute, error) {
+func NewAttribute(a provider.Attribute, opts *generatorschema.Options) (generatorschema.GeneratorAttribute, error) {
+	return newAttribute(a, a.Name, opts)
+}
+
+// newAttribute converts the attribute, using name for any custom type and value types
+// generated for the attribute.
+func newAttribute(a provider.Attribute, name string, opts *generatorschema.Options) (generatorschema.GeneratorAttribute, error) {
 	switch {
 	case a.Bool != nil:
-		return NewGeneratorBoolAttribute(a.Name, a.Bool)
+		return NewGeneratorBoolAttribute(name, a.Bool, opts)
 	case a.Float64 != nil:
-		return NewGeneratorFloat64Attribute(a.Name, a.Float64)
+		return NewGeneratorFloat64Attribute(name, a.Float64, opts)
 	case a.Int64 != nil:
-		return NewGeneratorInt64Attribute(a.Name, a.Int64)
+		return NewGeneratorInt64Attribute(name, a.Int64, opts)
 	case a.List != nil:
-		return NewGeneratorListAttribute(a.Name, a.List)
+		return NewGeneratorListAttribute(name, a.List, opts)
 	case a.ListNested != nil:
-		return NewGeneratorListNestedAttribute(a.Name, a.ListNested)
+		return NewGeneratorListNestedAttribute(name, a.ListNested, opts)
 	case a.Map != nil:
-		return NewGeneratorMapAttribute(a.Name, a.Map)
+		return NewGeneratorMapAttribute(name, a.Map, opts)
 	case a.MapNested != nil:
-		return NewGeneratorMapNestedAttribute(a.Name, a.MapNested)
+		return NewGeneratorMapNestedAttribute(name, a.MapNested, opts)
 	case a.Number != nil:
-		return NewGeneratorNumberAttribute(a.Name, a.Number)
+		return NewGeneratorNumberAttribute(name, a.Number, opts)
 	case a.Object != nil:
-		return NewGeneratorObjectAttribute(a.Name, a.Object)
+		return NewGeneratorObjectAttribute(name, a.Object, opts)
 	case a.Set != nil:
-		return NewGeneratorSetAttribute(a.Name, a.Set)
+		return NewGeneratorSetAttribute(name, a.Set, opts)
 	case a.SetNested != nil:
-		return NewGeneratorSetNestedAttribute(a.Name, a.SetNested)
+		return NewGeneratorSetNestedAttribute(name, a.SetNested, opts)
 	case a.SingleNested != nil:
-		return NewGeneratorSingleNestedAttribute(a.Name, a.SingleNested)
+		return NewGeneratorSingleNestedAttribute(name, a.SingleNested, opts)
 	case a.String != nil:
-		return NewGeneratorStringAttribute(a.Name, a.String)
+		return NewGeneratorStringAttribute(name, a.String, opts)
 	}
 
 	return nil, fmt.Errorf("attribute type not defined: %+v", a)
 }
 
-func NewBlocks(b provider.Blocks) (generatorschema.GeneratorBlocks, error) {
+// NewBlocks converts the blocks nested within a block. The parent is the name of the
+// custom type and value types generated for the block.
+func NewBlocks(b provider.Blocks, parent string, opts *generatorschema.Options) (generatorschema.GeneratorBlocks, error) {
 	blocks := make(generatorschema.GeneratorBlocks, len(b))
 
 	for _, v := range b {
-		block, err := NewBlock(v)
+		block, err := newBlock(v, opts.NestedTypeName(parent, v.Name), opts)
 
 		if err != nil {
 			return generatorschema.GeneratorBlocks{}, err
@@ -134,14 +146,20 @@ func NewBlocks(b provider.Blocks) (generatorschema.GeneratorBlocks, error) {
 	return blocks, nil
 }
 
-func NewBlock(b provider.Block) (generatorschema.GeneratorBlock, error) {
+func NewBlock(b provider.Block, opts *generatorschema.Options) (generatorschema.GeneratorBlock, error) {
+	return newBlock(b, b.Name, opts)
+}
+
+// newBlock converts the block, using name for the custom type and value types generated
+// for the block.
+func newBlock(b provider.Block, name string, opts *generatorschema.Options) (generatorschema.GeneratorBlock, error) {
 	switch {
 	case b.ListNested != nil:
-		return NewGeneratorListNestedBlock(b.Name, b.ListNested)
+		return NewGeneratorListNestedBlock(name, b.ListNested, opts)
 	case b.SetNested != nil:
-		return NewGeneratorSetNestedBlock(b.Name, b.SetNested)
+		return NewGeneratorSetNestedBlock(name, b.SetNested, opts)
 	case b.SingleNested != nil:
-		return NewGeneratorSingleNestedBlock(b.Name, b.SingleNested)
+		return NewGeneratorSingleNestedBlock(name, b.SingleNested, opts)
 	}
 
 	return nil, fmt.Errorf("block type not defined: %+v", b)
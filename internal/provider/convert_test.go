@@ -181,7 +181,7 @@ func Test_NewSchemas(t *testing.T) {
 					Attributes: generatorschema.GeneratorAttributes{
 						"bool_attribute": GeneratorBoolAttribute{
 							OptionalRequired: convert.NewOptionalRequired(specschema.Optional),
-							CustomType:       convert.NewCustomTypePrimitive(nil, nil, "bool_attribute"),
+							CustomType:       convert.NewCustomTypePrimitive(nil, nil, "bool_attribute", nil),
 							Sensitive:        convert.NewSensitive(pointer(true)),
 							Validators:       convert.NewValidators(convert.ValidatorTypeBool, specschema.CustomValidators{}),
 						},
@@ -193,6 +193,7 @@ func Test_NewSchemas(t *testing.T) {
 								convert.CustomCollectionTypeList,
 								"types.ListType{\nElemType: types.StringType,\n}",
 								"list_attribute",
+								nil,
 							),
 							ElementType: specschema.ElementType{
 								List: &specschema.ListType{
@@ -218,6 +219,7 @@ func Test_NewSchemas(t *testing.T) {
 								convert.CustomCollectionTypeMap,
 								"types.MapType{\nElemType: types.StringType,\n}",
 								"map_attribute",
+								nil,
 							),
 							ElementType: specschema.ElementType{
 								Map: &specschema.MapType{
@@ -243,6 +245,7 @@ func Test_NewSchemas(t *testing.T) {
 								convert.CustomCollectionTypeSet,
 								"types.SetType{\nElemType: types.StringType,\n}",
 								"set_attribute",
+								nil,
 							),
 							ElementType: specschema.ElementType{
 								Set: &specschema.SetType{
@@ -266,7 +269,7 @@ func Test_NewSchemas(t *testing.T) {
 								Attributes: generatorschema.GeneratorAttributes{
 									"nested_bool_attribute": GeneratorBoolAttribute{
 										OptionalRequired: convert.NewOptionalRequired(specschema.Optional),
-										CustomType:       convert.NewCustomTypePrimitive(nil, nil, "nested_bool_attribute"),
+										CustomType:       convert.NewCustomTypePrimitive(nil, nil, "nested_bool_attribute", nil),
 										Validators:       convert.NewValidators(convert.ValidatorTypeBool, specschema.CustomValidators{}),
 									},
 									"nested_list_attribute": GeneratorListAttribute{
@@ -277,6 +280,7 @@ func Test_NewSchemas(t *testing.T) {
 											convert.CustomCollectionTypeList,
 											"types.StringType",
 											"nested_list_attribute",
+											nil,
 										),
 										ElementType: specschema.ElementType{
 											String: &specschema.StringType{},
@@ -292,7 +296,7 @@ func Test_NewSchemas(t *testing.T) {
 								generatorschema.GeneratorAttributes{
 									"nested_bool_attribute": GeneratorBoolAttribute{
 										OptionalRequired: convert.NewOptionalRequired(specschema.Optional),
-										CustomType:       convert.NewCustomTypePrimitive(nil, nil, "nested_bool_attribute"),
+										CustomType:       convert.NewCustomTypePrimitive(nil, nil, "nested_bool_attribute", nil),
 										Validators:       convert.NewValidators(convert.ValidatorTypeBool, specschema.CustomValidators{}),
 									},
 									"nested_list_attribute": GeneratorListAttribute{
@@ -303,6 +307,7 @@ func Test_NewSchemas(t *testing.T) {
 											convert.CustomCollectionTypeList,
 											"types.StringType",
 											"nested_list_attribute",
+											nil,
 										),
 										ElementType: specschema.ElementType{
 											String: &specschema.StringType{},
@@ -316,6 +321,7 @@ func Test_NewSchemas(t *testing.T) {
 								nil,
 								convert.NewValidators(convert.ValidatorTypeObject, specschema.CustomValidators{}),
 								"list_nested_attribute",
+								nil,
 							),
 							Validators: convert.NewValidators(convert.ValidatorTypeList, specschema.CustomValidators{}),
 						},
@@ -349,14 +355,14 @@ func Test_NewSchemas(t *testing.T) {
 								},
 							}),
 							OptionalRequired: convert.NewOptionalRequired(specschema.Optional),
-							CustomType:       convert.NewCustomTypeObject(nil, nil, "object_attribute"),
+							CustomType:       convert.NewCustomTypeObject(nil, nil, "object_attribute", nil),
 							Validators:       convert.NewValidators(convert.ValidatorTypeObject, specschema.CustomValidators{}),
 						},
 						"single_nested_attribute": GeneratorSingleNestedAttribute{
 							Attributes: generatorschema.GeneratorAttributes{
 								"nested_bool_attribute": GeneratorBoolAttribute{
 									OptionalRequired: convert.NewOptionalRequired(specschema.Optional),
-									CustomType:       convert.NewCustomTypePrimitive(nil, nil, "nested_bool_attribute"),
+									CustomType:       convert.NewCustomTypePrimitive(nil, nil, "nested_bool_attribute", nil),
 									Validators:       convert.NewValidators(convert.ValidatorTypeBool, specschema.CustomValidators{}),
 								},
 								"nested_list_attribute": GeneratorListAttribute{
@@ -367,6 +373,7 @@ func Test_NewSchemas(t *testing.T) {
 										convert.CustomCollectionTypeList,
 										"types.StringType",
 										"nested_list_attribute",
+										nil,
 									),
 									ElementType: specschema.ElementType{
 										String: &specschema.StringType{},
@@ -378,7 +385,7 @@ func Test_NewSchemas(t *testing.T) {
 								},
 							},
 							OptionalRequired: convert.NewOptionalRequired(specschema.Optional),
-							CustomType:       convert.NewCustomTypeNestedObject(nil, "single_nested_attribute"),
+							CustomType:       convert.NewCustomTypeNestedObject(nil, "single_nested_attribute", nil),
 							Validators:       convert.NewValidators(convert.ValidatorTypeObject, specschema.CustomValidators{}),
 						},
 					},
@@ -388,7 +395,7 @@ func Test_NewSchemas(t *testing.T) {
 								Attributes: generatorschema.GeneratorAttributes{
 									"nested_bool_attribute": GeneratorBoolAttribute{
 										OptionalRequired: convert.NewOptionalRequired(specschema.Optional),
-										CustomType:       convert.NewCustomTypePrimitive(nil, nil, "nested_bool_attribute"),
+										CustomType:       convert.NewCustomTypePrimitive(nil, nil, "nested_bool_attribute", nil),
 										Validators:       convert.NewValidators(convert.ValidatorTypeBool, specschema.CustomValidators{}),
 									},
 								},
@@ -397,7 +404,7 @@ func Test_NewSchemas(t *testing.T) {
 								generatorschema.GeneratorAttributes{
 									"nested_bool_attribute": GeneratorBoolAttribute{
 										OptionalRequired: convert.NewOptionalRequired(specschema.Optional),
-										CustomType:       convert.NewCustomTypePrimitive(nil, nil, "nested_bool_attribute"),
+										CustomType:       convert.NewCustomTypePrimitive(nil, nil, "nested_bool_attribute", nil),
 										Validators:       convert.NewValidators(convert.ValidatorTypeBool, specschema.CustomValidators{}),
 									},
 								},
@@ -405,6 +412,7 @@ func Test_NewSchemas(t *testing.T) {
 								nil,
 								convert.NewValidators(convert.ValidatorTypeObject, specschema.CustomValidators{}),
 								"list_nested_block",
+								nil,
 							),
 							Validators: convert.NewValidators(convert.ValidatorTypeList, specschema.CustomValidators{}),
 						},
@@ -412,11 +420,11 @@ func Test_NewSchemas(t *testing.T) {
 							Attributes: generatorschema.GeneratorAttributes{
 								"nested_bool_attribute": GeneratorBoolAttribute{
 									OptionalRequired: convert.NewOptionalRequired(specschema.Optional),
-									CustomType:       convert.NewCustomTypePrimitive(nil, nil, "nested_bool_attribute"),
+									CustomType:       convert.NewCustomTypePrimitive(nil, nil, "nested_bool_attribute", nil),
 									Validators:       convert.NewValidators(convert.ValidatorTypeBool, specschema.CustomValidators{}),
 								},
 							},
-							CustomType: convert.NewCustomTypeNestedObject(nil, "single_nested_block"),
+							CustomType: convert.NewCustomTypeNestedObject(nil, "single_nested_block", nil),
 							Validators: convert.NewValidators(convert.ValidatorTypeObject, specschema.CustomValidators{}),
 						},
 					},
@@ -430,7 +438,7 @@ func Test_NewSchemas(t *testing.T) {
 		t.Run(name, func(t *testing.T) {
 			t.Parallel()
 
-			got, err := NewSchemas(testCase.spec)
+			got, err := NewSchemas(testCase.spec, nil)
 
 			if err != nil {
 				t.Error(err)
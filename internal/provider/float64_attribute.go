@@ -22,16 +22,18 @@ type GeneratorFloat64Attribute struct {
 	Description            convert.Description
 	Sensitive              convert.Sensitive
 	Validators             convert.Validators
+
+	options *schema.Options
 }
 
-func NewGeneratorFloat64Attribute(name string, a *provider.Float64Attribute) (GeneratorFloat64Attribute, error) {
+func NewGeneratorFloat64Attribute(name string, a *provider.Float64Attribute, opts *schema.Options) (GeneratorFloat64Attribute, error) {
 	if a == nil {
 		return GeneratorFloat64Attribute{}, fmt.Errorf("*provider.Float64Attribute is nil")
 	}
 
 	c := convert.NewOptionalRequired(a.OptionalRequired)
 
-	ctp := convert.NewCustomTypePrimitive(a.CustomType, a.AssociatedExternalType, name)
+	ctp := convert.NewCustomTypePrimitive(a.CustomType, a.AssociatedExternalType, name, opts)
 
 	d := convert.NewDescription(a.Description)
 
@@ -42,13 +44,14 @@ func NewGeneratorFloat64Attribute(name string, a *provider.Float64Attribute) (Ge
 	v := convert.NewValidators(convert.ValidatorTypeFloat64, a.Validators.CustomValidators())
 
 	return GeneratorFloat64Attribute{
-		AssociatedExternalType: schema.NewAssocExtType(a.AssociatedExternalType),
+		AssociatedExternalType: schema.NewAssocExtType(a.AssociatedExternalType, opts),
 		OptionalRequired:       c,
 		CustomType:             ctp,
 		DeprecationMessage:     dm,
 		Description:            d,
 		Sensitive:              s,
 		Validators:             v,
+		options:                opts,
 	}, nil
 }
 
@@ -123,7 +126,7 @@ func (g GeneratorFloat64Attribute) Schema(name schema.FrameworkIdentifier) (stri
 
 func (g GeneratorFloat64Attribute) ModelField(name schema.FrameworkIdentifier) (model.Field, error) {
 	field := model.Field{
-		Name:      name.ToPascalCase(),
+		Name:      g.options.ToPascalCase(name),
 		TfsdkName: name.ToString(),
 		ValueType: model.Float64ValueType,
 	}
@@ -144,7 +147,7 @@ func (g GeneratorFloat64Attribute) CustomTypeAndValue(name string) ([]byte, erro
 
 	var buf bytes.Buffer
 
-	float64Type := schema.NewCustomFloat64Type(name)
+	float64Type := schema.NewCustomFloat64Type(name, g.options)
 
 	b, err := float64Type.Render()
 
@@ -154,7 +157,7 @@ func (g GeneratorFloat64Attribute) CustomTypeAndValue(name string) ([]byte, erro
 
 	buf.Write(b)
 
-	float64Value := schema.NewCustomFloat64Value(name)
+	float64Value := schema.NewCustomFloat64Value(name, g.options)
 
 	b, err = float64Value.Render()
 
@@ -167,12 +170,16 @@ func (g GeneratorFloat64Attribute) CustomTypeAndValue(name string) ([]byte, erro
 	return buf.Bytes(), nil
 }
 
+func (g GeneratorFloat64Attribute) GetAssociatedExternalType() *schema.AssocExtType {
+	return g.AssociatedExternalType
+}
+
 func (g GeneratorFloat64Attribute) ToFromFunctions(name string) ([]byte, error) {
 	if g.AssociatedExternalType == nil {
 		return nil, nil
 	}
 
-	toFrom := schema.NewToFromFloat64(name, g.AssociatedExternalType)
+	toFrom := schema.NewToFromFloat64(name, g.AssociatedExternalType, g.options)
 
 	b, err := toFrom.Render()
 
@@ -186,7 +193,7 @@ func (g GeneratorFloat64Attribute) ToFromFunctions(name string) ([]byte, error)
 // AttrType returns a string representation of a basetypes.Float64Typable type.
 func (g GeneratorFloat64Attribute) AttrType(name schema.FrameworkIdentifier) (string, error) {
 	if g.AssociatedExternalType != nil {
-		return fmt.Sprintf("%sType{}", name.ToPascalCase()), nil
+		return fmt.Sprintf("%sType{}", g.options.ToPascalCase(name)), nil
 	}
 
 	return "basetypes.Float64Type{}", nil
@@ -195,7 +202,7 @@ func (g GeneratorFloat64Attribute) AttrType(name schema.FrameworkIdentifier) (st
 // AttrValue returns a string representation of a basetypes.Float64Valuable type.
 func (g GeneratorFloat64Attribute) AttrValue(name schema.FrameworkIdentifier) string {
 	if g.AssociatedExternalType != nil {
-		return fmt.Sprintf("%sValue", name.ToPascalCase())
+		return fmt.Sprintf("%sValue", g.options.ToPascalCase(name))
 	}
 
 	return "basetypes.Float64Value"
@@ -224,3 +231,13 @@ func (g GeneratorFloat64Attribute) From() (schema.ToFromConversion, error) {
 		Default: "Float64PointerValue",
 	}, nil
 }
+
+func (g GeneratorFloat64Attribute) Properties() schema.FieldProperties {
+	return schema.FieldProperties{
+		Optional:           g.OptionalRequired.IsOptional(),
+		Required:           g.OptionalRequired.IsRequired(),
+		Sensitive:          g.Sensitive.IsSensitive(),
+		Description:        g.Description.Description(),
+		DeprecationMessage: g.DeprecationMessage.DeprecationMessage(),
+	}
+}
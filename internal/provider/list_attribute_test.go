@@ -41,6 +41,7 @@ func TestGeneratorListAttribute_New(t *testing.T) {
 					convert.CustomCollectionTypeList,
 					"types.BoolType",
 					"name",
+					nil,
 				),
 				ElementType: specschema.ElementType{
 					Bool: &specschema.BoolType{},
@@ -64,6 +65,7 @@ func TestGeneratorListAttribute_New(t *testing.T) {
 					convert.CustomCollectionTypeList,
 					"types.StringType",
 					"name",
+					nil,
 				),
 				ElementType: specschema.ElementType{
 					String: &specschema.StringType{},
@@ -91,6 +93,7 @@ func TestGeneratorListAttribute_New(t *testing.T) {
 					convert.CustomCollectionTypeList,
 					"types.ListType{\nElemType: types.StringType,\n}",
 					"name",
+					nil,
 				),
 				ElementType: specschema.ElementType{
 					List: &specschema.ListType{
@@ -126,6 +129,7 @@ func TestGeneratorListAttribute_New(t *testing.T) {
 					convert.CustomCollectionTypeList,
 					"types.MapType{\nElemType: types.StringType,\n}",
 					"name",
+					nil,
 				),
 				ElementType: specschema.ElementType{
 					Map: &specschema.MapType{
@@ -168,6 +172,7 @@ func TestGeneratorListAttribute_New(t *testing.T) {
 					convert.CustomCollectionTypeList,
 					"types.ListType{\nElemType: types.ObjectType{\nAttrTypes: map[string]attr.Type{\n\"str\": types.StringType,\n},\n},\n}",
 					"name",
+					nil,
 				),
 				ElementType: specschema.ElementType{
 					List: &specschema.ListType{
@@ -220,6 +225,7 @@ func TestGeneratorListAttribute_New(t *testing.T) {
 					convert.CustomCollectionTypeList,
 					"types.ObjectType{\nAttrTypes: map[string]attr.Type{\n\"str\": types.StringType,\n},\n}",
 					"name",
+					nil,
 				),
 				ElementType: specschema.ElementType{
 					Object: &specschema.ObjectType{
@@ -268,6 +274,7 @@ func TestGeneratorListAttribute_New(t *testing.T) {
 					convert.CustomCollectionTypeList,
 					"types.ObjectType{\nAttrTypes: map[string]attr.Type{\n\"list\": types.ListType{\nElemType: types.StringType,\n},\n},\n}",
 					"name",
+					nil,
 				),
 				ElementType: specschema.ElementType{
 					Object: &specschema.ObjectType{
@@ -315,6 +322,7 @@ func TestGeneratorListAttribute_New(t *testing.T) {
 					convert.CustomCollectionTypeList,
 					"types.StringType",
 					"name",
+					nil,
 				),
 				ElementType: specschema.ElementType{
 					String: &specschema.StringType{},
@@ -340,6 +348,7 @@ func TestGeneratorListAttribute_New(t *testing.T) {
 					convert.CustomCollectionTypeList,
 					"types.StringType",
 					"name",
+					nil,
 				),
 				ElementType: specschema.ElementType{
 					String: &specschema.StringType{},
@@ -376,6 +385,7 @@ func TestGeneratorListAttribute_New(t *testing.T) {
 					convert.CustomCollectionTypeList,
 					"types.StringType",
 					"name",
+					nil,
 				),
 				ElementType: specschema.ElementType{
 					String: &specschema.StringType{},
@@ -400,6 +410,7 @@ func TestGeneratorListAttribute_New(t *testing.T) {
 					convert.CustomCollectionTypeList,
 					"types.StringType",
 					"name",
+					nil,
 				),
 				DeprecationMessage: convert.NewDeprecationMessage(pointer("deprecation message")),
 				ElementType: specschema.ElementType{
@@ -425,6 +436,7 @@ func TestGeneratorListAttribute_New(t *testing.T) {
 					convert.CustomCollectionTypeList,
 					"types.StringType",
 					"name",
+					nil,
 				),
 				Description: convert.NewDescription(pointer("description")),
 				ElementType: specschema.ElementType{
@@ -450,6 +462,7 @@ func TestGeneratorListAttribute_New(t *testing.T) {
 					convert.CustomCollectionTypeList,
 					"types.StringType",
 					"name",
+					nil,
 				),
 				ElementType: specschema.ElementType{
 					String: &specschema.StringType{},
@@ -486,6 +499,7 @@ func TestGeneratorListAttribute_New(t *testing.T) {
 					convert.CustomCollectionTypeList,
 					"types.StringType",
 					"name",
+					nil,
 				),
 				ElementType: specschema.ElementType{
 					String: &specschema.StringType{},
@@ -512,7 +526,7 @@ func TestGeneratorListAttribute_New(t *testing.T) {
 		t.Run(name, func(t *testing.T) {
 			t.Parallel()
 
-			got, err := NewGeneratorListAttribute("name", testCase.input)
+			got, err := NewGeneratorListAttribute("name", testCase.input, nil)
 
 			if diff := cmp.Diff(err, testCase.expectedError, equateErrorMessage); diff != "" {
 				t.Errorf("unexpected error: %s", diff)
@@ -547,6 +561,7 @@ func TestGeneratorListAttribute_Imports(t *testing.T) {
 					convert.CustomCollectionTypeList,
 					"",
 					"",
+					nil,
 				),
 			},
 			expected: []code.Import{},
@@ -563,6 +578,7 @@ func TestGeneratorListAttribute_Imports(t *testing.T) {
 					convert.CustomCollectionTypeList,
 					"",
 					"",
+					nil,
 				),
 			},
 			expected: []code.Import{},
@@ -579,6 +595,7 @@ func TestGeneratorListAttribute_Imports(t *testing.T) {
 					convert.CustomCollectionTypeList,
 					"",
 					"",
+					nil,
 				),
 			},
 			expected: []code.Import{
@@ -921,6 +938,7 @@ func TestGeneratorListAttribute_Imports(t *testing.T) {
 					convert.CustomCollectionTypeList,
 					"",
 					"",
+					nil,
 				),
 			},
 			expected: []code.Import{
@@ -1222,6 +1240,7 @@ ElementType: types.StringType,
 					convert.CustomCollectionTypeList,
 					"",
 					"",
+					nil,
 				),
 				ElementTypeCollection: convert.NewElementType(specschema.ElementType{
 					String: &specschema.StringType{},
@@ -1240,6 +1259,7 @@ CustomType: my_custom_type,
 					convert.CustomCollectionTypeList,
 					"types.StringType",
 					"list_attribute",
+					nil,
 				),
 				ElementTypeCollection: convert.NewElementType(specschema.ElementType{
 					String: &specschema.StringType{},
@@ -1262,6 +1282,7 @@ ElemType: types.StringType,
 					convert.CustomCollectionTypeList,
 					"types.StringType",
 					"name",
+					nil,
 				),
 			},
 			expected: `"list_attribute": schema.ListAttribute{
@@ -1570,6 +1591,7 @@ func TestGeneratorListAttribute_ModelField(t *testing.T) {
 					convert.CustomCollectionTypeList,
 					"",
 					"",
+					nil,
 				),
 			},
 			expected: model.Field{
@@ -1588,6 +1610,7 @@ func TestGeneratorListAttribute_ModelField(t *testing.T) {
 					convert.CustomCollectionTypeList,
 					"",
 					"list_attribute",
+					nil,
 				),
 			},
 			expected: model.Field{
@@ -1608,6 +1631,7 @@ func TestGeneratorListAttribute_ModelField(t *testing.T) {
 					convert.CustomCollectionTypeList,
 					"",
 					"list_attribute",
+					nil,
 				),
 			},
 			expected: model.Field{
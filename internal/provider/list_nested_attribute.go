@@ -24,14 +24,16 @@ type GeneratorListNestedAttribute struct {
 	NestedAttributeObject convert.NestedAttributeObject
 	Sensitive             convert.Sensitive
 	Validators            convert.Validators
+
+	options *schema.Options
 }
 
-func NewGeneratorListNestedAttribute(name string, a *provider.ListNestedAttribute) (GeneratorListNestedAttribute, error) {
+func NewGeneratorListNestedAttribute(name string, a *provider.ListNestedAttribute, opts *schema.Options) (GeneratorListNestedAttribute, error) {
 	if a == nil {
 		return GeneratorListNestedAttribute{}, fmt.Errorf("*provider.ListNestedAttribute is nil")
 	}
 
-	attributes, err := NewAttributes(a.NestedObject.Attributes)
+	attributes, err := NewAttributes(a.NestedObject.Attributes, name, opts)
 
 	if err != nil {
 		return GeneratorListNestedAttribute{}, err
@@ -47,7 +49,7 @@ func NewGeneratorListNestedAttribute(name string, a *provider.ListNestedAttribut
 
 	vo := convert.NewValidators(convert.ValidatorTypeObject, a.NestedObject.Validators.CustomValidators())
 
-	nat := convert.NewNestedAttributeObject(attributes, a.NestedObject.CustomType, vo, name)
+	nat := convert.NewNestedAttributeObject(attributes, a.NestedObject.CustomType, vo, name, opts)
 
 	s := convert.NewSensitive(a.Sensitive)
 
@@ -59,7 +61,7 @@ func NewGeneratorListNestedAttribute(name string, a *provider.ListNestedAttribut
 		DeprecationMessage: dm,
 		Description:        d,
 		NestedObject: GeneratorNestedAttributeObject{
-			AssociatedExternalType: schema.NewAssocExtType(a.NestedObject.AssociatedExternalType),
+			AssociatedExternalType: schema.NewAssocExtType(a.NestedObject.AssociatedExternalType, opts),
 			Attributes:             attributes,
 			CustomType:             a.NestedObject.CustomType,
 			Validators:             a.NestedObject.Validators,
@@ -67,6 +69,7 @@ func NewGeneratorListNestedAttribute(name string, a *provider.ListNestedAttribut
 		NestedAttributeObject: nat,
 		Sensitive:             s,
 		Validators:            vl,
+		options:               opts,
 	}, nil
 }
 
@@ -152,7 +155,7 @@ func (g GeneratorListNestedAttribute) Schema(name schema.FrameworkIdentifier) (s
 
 func (g GeneratorListNestedAttribute) ModelField(name schema.FrameworkIdentifier) (model.Field, error) {
 	f := model.Field{
-		Name:      name.ToPascalCase(),
+		Name:      g.options.ToPascalCase(name),
 		TfsdkName: name.ToString(),
 		ValueType: model.ListValueType,
 	}
@@ -173,13 +176,13 @@ func (g GeneratorListNestedAttribute) GetAttributes() schema.GeneratorAttributes
 func (g GeneratorListNestedAttribute) CustomTypeAndValue(name string) ([]byte, error) {
 	var buf bytes.Buffer
 
-	attributeAttrValues, err := g.NestedObject.Attributes.AttrValues()
+	attributeAttrValues, err := g.NestedObject.Attributes.AttrValues(name, g.options)
 
 	if err != nil {
 		return nil, err
 	}
 
-	objectType := schema.NewCustomNestedObjectType(name, attributeAttrValues)
+	objectType := schema.NewCustomNestedObjectType(name, attributeAttrValues, g.options)
 
 	b, err := objectType.Render()
 
@@ -195,7 +198,7 @@ func (g GeneratorListNestedAttribute) CustomTypeAndValue(name string) ([]byte, e
 		return nil, err
 	}
 
-	attributeAttrTypes, err := g.NestedObject.Attributes.AttrTypes()
+	attributeAttrTypes, err := g.NestedObject.Attributes.AttrTypes(name, g.options)
 
 	if err != nil {
 		return nil, err
@@ -207,7 +210,7 @@ func (g GeneratorListNestedAttribute) CustomTypeAndValue(name string) ([]byte, e
 		return nil, err
 	}
 
-	objectValue := schema.NewCustomNestedObjectValue(name, attributeTypes, attributeAttrTypes, attributeAttrValues, attributeCollectionTypes)
+	objectValue := schema.NewCustomNestedObjectValue(name, attributeTypes, attributeAttrTypes, attributeAttrValues, attributeCollectionTypes, g.options)
 
 	b, err = objectValue.Render()
 
@@ -223,7 +226,7 @@ func (g GeneratorListNestedAttribute) CustomTypeAndValue(name string) ([]byte, e
 	// CustomTypeAndValue interface.
 	for _, k := range attributeKeys {
 		if c, ok := g.NestedObject.Attributes[k].(schema.CustomTypeAndValue); ok {
-			b, err := c.CustomTypeAndValue(k)
+			b, err := g.options.NestedCustomTypeAndValue(c, g.options.NestedTypeName(name, k))
 
 			if err != nil {
 				return nil, err
@@ -236,6 +239,10 @@ func (g GeneratorListNestedAttribute) CustomTypeAndValue(name string) ([]byte, e
 	return buf.Bytes(), nil
 }
 
+func (g GeneratorListNestedAttribute) GetAssociatedExternalType() *schema.AssocExtType {
+	return g.NestedObject.AssociatedExternalType
+}
+
 func (g GeneratorListNestedAttribute) ToFromFunctions(name string) ([]byte, error) {
 	if g.NestedObject.AssociatedExternalType == nil {
 		return nil, nil
@@ -255,7 +262,7 @@ func (g GeneratorListNestedAttribute) ToFromFunctions(name string) ([]byte, erro
 		return nil, err
 	}
 
-	toFrom := schema.NewToFromNestedObject(name, g.NestedObject.AssociatedExternalType, toFuncs, fromFuncs)
+	toFrom := schema.NewToFromNestedObject(name, g.NestedObject.AssociatedExternalType, toFuncs, fromFuncs, g.options)
 
 	b, err := toFrom.Render()
 
@@ -271,7 +278,7 @@ func (g GeneratorListNestedAttribute) ToFromFunctions(name string) ([]byte, erro
 	// ToFrom interface.
 	for _, k := range attributeKeys {
 		if c, ok := g.NestedObject.Attributes[k].(schema.ToFrom); ok {
-			b, err := c.ToFromFunctions(k)
+			b, err := g.options.NestedToFromFunctions(c, g.options.NestedTypeName(name, k))
 
 			if err != nil {
 				return nil, err
@@ -291,3 +298,13 @@ func (g GeneratorListNestedAttribute) To() (schema.ToFromConversion, error) {
 func (g GeneratorListNestedAttribute) From() (schema.ToFromConversion, error) {
 	return schema.ToFromConversion{}, schema.NewUnimplementedError(errors.New("list nested type is not yet implemented"))
 }
+
+func (g GeneratorListNestedAttribute) Properties() schema.FieldProperties {
+	return schema.FieldProperties{
+		Optional:           g.OptionalRequired.IsOptional(),
+		Required:           g.OptionalRequired.IsRequired(),
+		Sensitive:          g.Sensitive.IsSensitive(),
+		Description:        g.Description.Description(),
+		DeprecationMessage: g.DeprecationMessage.DeprecationMessage(),
+	}
+}
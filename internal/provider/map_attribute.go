@@ -25,9 +25,11 @@ type GeneratorMapAttribute struct {
 	ElementTypeCollection  convert.ElementType
 	Sensitive              convert.Sensitive
 	Validators             convert.Validators
+
+	options *generatorschema.Options
 }
 
-func NewGeneratorMapAttribute(name string, a *provider.MapAttribute) (GeneratorMapAttribute, error) {
+func NewGeneratorMapAttribute(name string, a *provider.MapAttribute, opts *generatorschema.Options) (GeneratorMapAttribute, error) {
 	if a == nil {
 		return GeneratorMapAttribute{}, fmt.Errorf("*provider.MapAttribute is nil")
 	}
@@ -36,7 +38,7 @@ func NewGeneratorMapAttribute(name string, a *provider.MapAttribute) (GeneratorM
 
 	c := convert.NewOptionalRequired(a.OptionalRequired)
 
-	ctc := convert.NewCustomTypeCollection(a.CustomType, a.AssociatedExternalType, convert.CustomCollectionTypeMap, string(et.ElementType()), name)
+	ctc := convert.NewCustomTypeCollection(a.CustomType, a.AssociatedExternalType, convert.CustomCollectionTypeMap, string(et.ElementType()), name, opts)
 
 	d := convert.NewDescription(a.Description)
 
@@ -47,7 +49,7 @@ func NewGeneratorMapAttribute(name string, a *provider.MapAttribute) (GeneratorM
 	v := convert.NewValidators(convert.ValidatorTypeMap, a.Validators.CustomValidators())
 
 	return GeneratorMapAttribute{
-		AssociatedExternalType: generatorschema.NewAssocExtType(a.AssociatedExternalType),
+		AssociatedExternalType: generatorschema.NewAssocExtType(a.AssociatedExternalType, opts),
 		OptionalRequired:       c,
 		CustomType:             ctc,
 		DeprecationMessage:     dm,
@@ -56,6 +58,7 @@ func NewGeneratorMapAttribute(name string, a *provider.MapAttribute) (GeneratorM
 		ElementTypeCollection:  et,
 		Sensitive:              s,
 		Validators:             v,
+		options:                opts,
 	}, nil
 }
 
@@ -151,7 +154,7 @@ func (g GeneratorMapAttribute) Schema(name generatorschema.FrameworkIdentifier)
 
 func (g GeneratorMapAttribute) ModelField(name generatorschema.FrameworkIdentifier) (model.Field, error) {
 	field := model.Field{
-		Name:      name.ToPascalCase(),
+		Name:      g.options.ToPascalCase(name),
 		TfsdkName: name.ToString(),
 		ValueType: model.MapValueType,
 	}
@@ -172,7 +175,7 @@ func (g GeneratorMapAttribute) CustomTypeAndValue(name string) ([]byte, error) {
 
 	var buf bytes.Buffer
 
-	listType := generatorschema.NewCustomMapType(name)
+	listType := generatorschema.NewCustomMapType(name, g.options)
 
 	b, err := listType.Render()
 
@@ -184,7 +187,7 @@ func (g GeneratorMapAttribute) CustomTypeAndValue(name string) ([]byte, error) {
 
 	elemType := generatorschema.GetElementType(g.ElementType)
 
-	listValue := generatorschema.NewCustomMapValue(name, elemType)
+	listValue := generatorschema.NewCustomMapValue(name, elemType, g.options)
 
 	b, err = listValue.Render()
 
@@ -197,6 +200,10 @@ func (g GeneratorMapAttribute) CustomTypeAndValue(name string) ([]byte, error) {
 	return buf.Bytes(), nil
 }
 
+func (g GeneratorMapAttribute) GetAssociatedExternalType() *generatorschema.AssocExtType {
+	return g.AssociatedExternalType
+}
+
 func (g GeneratorMapAttribute) ToFromFunctions(name string) ([]byte, error) {
 	if g.AssociatedExternalType == nil {
 		return nil, nil
@@ -211,7 +218,7 @@ func (g GeneratorMapAttribute) ToFromFunctions(name string) ([]byte, error) {
 		return nil, err
 	}
 
-	toFrom := generatorschema.NewToFromMap(name, g.AssociatedExternalType, elementTypeType, elementTypeValue, elementFrom)
+	toFrom := generatorschema.NewToFromMap(name, g.AssociatedExternalType, elementTypeType, elementTypeValue, elementFrom, g.options)
 
 	b, err := toFrom.Render()
 
@@ -231,7 +238,7 @@ func (g GeneratorMapAttribute) AttrType(name generatorschema.FrameworkIdentifier
 	}
 
 	if g.AssociatedExternalType != nil {
-		return fmt.Sprintf("%sType{\nbasetypes.MapType{\nElemType: %s,\n}}", name.ToPascalCase(), elemType), nil
+		return fmt.Sprintf("%sType{\nbasetypes.MapType{\nElemType: %s,\n}}", g.options.ToPascalCase(name), elemType), nil
 	}
 
 	return fmt.Sprintf("basetypes.MapType{\nElemType: %s,\n}", elemType), nil
@@ -240,7 +247,7 @@ func (g GeneratorMapAttribute) AttrType(name generatorschema.FrameworkIdentifier
 // AttrValue returns a string representation of a basetypes.MapValuable type.
 func (g GeneratorMapAttribute) AttrValue(name generatorschema.FrameworkIdentifier) string {
 	if g.AssociatedExternalType != nil {
-		return fmt.Sprintf("%sValue", name.ToPascalCase())
+		return fmt.Sprintf("%sValue", g.options.ToPascalCase(name))
 	}
 
 	return "basetypes.MapValue"
@@ -305,3 +312,13 @@ func (g GeneratorMapAttribute) CollectionType() (map[string]string, error) {
 		"TypeValueFunc": "types.MapValue",
 	}, nil
 }
+
+func (g GeneratorMapAttribute) Properties() generatorschema.FieldProperties {
+	return generatorschema.FieldProperties{
+		Optional:           g.OptionalRequired.IsOptional(),
+		Required:           g.OptionalRequired.IsRequired(),
+		Sensitive:          g.Sensitive.IsSensitive(),
+		Description:        g.Description.Description(),
+		DeprecationMessage: g.DeprecationMessage.DeprecationMessage(),
+	}
+}
@@ -22,16 +22,18 @@ type GeneratorNumberAttribute struct {
 	Description            convert.Description
 	Sensitive              convert.Sensitive
 	Validators             convert.Validators
+
+	options *schema.Options
 }
 
-func NewGeneratorNumberAttribute(name string, a *provider.NumberAttribute) (GeneratorNumberAttribute, error) {
+func NewGeneratorNumberAttribute(name string, a *provider.NumberAttribute, opts *schema.Options) (GeneratorNumberAttribute, error) {
 	if a == nil {
 		return GeneratorNumberAttribute{}, fmt.Errorf("*provider.NumberAttribute is nil")
 	}
 
 	c := convert.NewOptionalRequired(a.OptionalRequired)
 
-	ctp := convert.NewCustomTypePrimitive(a.CustomType, a.AssociatedExternalType, name)
+	ctp := convert.NewCustomTypePrimitive(a.CustomType, a.AssociatedExternalType, name, opts)
 
 	d := convert.NewDescription(a.Description)
 
@@ -42,13 +44,14 @@ func NewGeneratorNumberAttribute(name string, a *provider.NumberAttribute) (Gene
 	v := convert.NewValidators(convert.ValidatorTypeNumber, a.Validators.CustomValidators())
 
 	return GeneratorNumberAttribute{
-		AssociatedExternalType: schema.NewAssocExtType(a.AssociatedExternalType),
+		AssociatedExternalType: schema.NewAssocExtType(a.AssociatedExternalType, opts),
 		OptionalRequired:       c,
 		CustomType:             ctp,
 		DeprecationMessage:     dm,
 		Description:            d,
 		Sensitive:              s,
 		Validators:             v,
+		options:                opts,
 	}, nil
 }
 
@@ -123,7 +126,7 @@ func (g GeneratorNumberAttribute) Schema(name schema.FrameworkIdentifier) (strin
 
 func (g GeneratorNumberAttribute) ModelField(name schema.FrameworkIdentifier) (model.Field, error) {
 	field := model.Field{
-		Name:      name.ToPascalCase(),
+		Name:      g.options.ToPascalCase(name),
 		TfsdkName: name.ToString(),
 		ValueType: model.NumberValueType,
 	}
@@ -144,7 +147,7 @@ func (g GeneratorNumberAttribute) CustomTypeAndValue(name string) ([]byte, error
 
 	var buf bytes.Buffer
 
-	numberType := schema.NewCustomNumberType(name)
+	numberType := schema.NewCustomNumberType(name, g.options)
 
 	b, err := numberType.Render()
 
@@ -154,7 +157,7 @@ func (g GeneratorNumberAttribute) CustomTypeAndValue(name string) ([]byte, error
 
 	buf.Write(b)
 
-	numberValue := schema.NewCustomNumberValue(name)
+	numberValue := schema.NewCustomNumberValue(name, g.options)
 
 	b, err = numberValue.Render()
 
@@ -167,12 +170,16 @@ func (g GeneratorNumberAttribute) CustomTypeAndValue(name string) ([]byte, error
 	return buf.Bytes(), nil
 }
 
+func (g GeneratorNumberAttribute) GetAssociatedExternalType() *schema.AssocExtType {
+	return g.AssociatedExternalType
+}
+
 func (g GeneratorNumberAttribute) ToFromFunctions(name string) ([]byte, error) {
 	if g.AssociatedExternalType == nil {
 		return nil, nil
 	}
 
-	toFrom := schema.NewToFromNumber(name, g.AssociatedExternalType)
+	toFrom := schema.NewToFromNumber(name, g.AssociatedExternalType, g.options)
 
 	b, err := toFrom.Render()
 
@@ -186,7 +193,7 @@ func (g GeneratorNumberAttribute) ToFromFunctions(name string) ([]byte, error) {
 // AttrType returns a string representation of a basetypes.NumberTypable type.
 func (g GeneratorNumberAttribute) AttrType(name schema.FrameworkIdentifier) (string, error) {
 	if g.AssociatedExternalType != nil {
-		return fmt.Sprintf("%sType{}", name.ToPascalCase()), nil
+		return fmt.Sprintf("%sType{}", g.options.ToPascalCase(name)), nil
 	}
 
 	return "basetypes.NumberType{}", nil
@@ -195,7 +202,7 @@ func (g GeneratorNumberAttribute) AttrType(name schema.FrameworkIdentifier) (str
 // AttrValue returns a string representation of a basetypes.NumberValuable type.
 func (g GeneratorNumberAttribute) AttrValue(name schema.FrameworkIdentifier) string {
 	if g.AssociatedExternalType != nil {
-		return fmt.Sprintf("%sValue", name.ToPascalCase())
+		return fmt.Sprintf("%sValue", g.options.ToPascalCase(name))
 	}
 
 	return "basetypes.NumberValue"
@@ -224,3 +231,13 @@ func (g GeneratorNumberAttribute) From() (schema.ToFromConversion, error) {
 		Default: "NumberValue",
 	}, nil
 }
+
+func (g GeneratorNumberAttribute) Properties() schema.FieldProperties {
+	return schema.FieldProperties{
+		Optional:           g.OptionalRequired.IsOptional(),
+		Required:           g.OptionalRequired.IsRequired(),
+		Sensitive:          g.Sensitive.IsSensitive(),
+		Description:        g.Description.Description(),
+		DeprecationMessage: g.DeprecationMessage.DeprecationMessage(),
+	}
+}
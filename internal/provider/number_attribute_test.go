@@ -33,7 +33,7 @@ func TestGeneratorNumberAttribute_New(t *testing.T) {
 			},
 			expected: GeneratorNumberAttribute{
 				OptionalRequired: convert.NewOptionalRequired(specschema.Optional),
-				CustomType:       convert.NewCustomTypePrimitive(nil, nil, "name"),
+				CustomType:       convert.NewCustomTypePrimitive(nil, nil, "name", nil),
 				Validators:       convert.NewValidators(convert.ValidatorTypeNumber, specschema.CustomValidators{}),
 			},
 		},
@@ -43,7 +43,7 @@ func TestGeneratorNumberAttribute_New(t *testing.T) {
 			},
 			expected: GeneratorNumberAttribute{
 				OptionalRequired: convert.NewOptionalRequired(specschema.Required),
-				CustomType:       convert.NewCustomTypePrimitive(nil, nil, "name"),
+				CustomType:       convert.NewCustomTypePrimitive(nil, nil, "name", nil),
 				Validators:       convert.NewValidators(convert.ValidatorTypeNumber, specschema.CustomValidators{}),
 			},
 		},
@@ -64,7 +64,7 @@ func TestGeneratorNumberAttribute_New(t *testing.T) {
 					},
 					Type:      "my_type",
 					ValueType: "myvalue_type",
-				}, nil, "name"),
+				}, nil, "name", nil),
 				Validators: convert.NewValidators(convert.ValidatorTypeNumber, nil),
 			},
 		},
@@ -73,7 +73,7 @@ func TestGeneratorNumberAttribute_New(t *testing.T) {
 				DeprecationMessage: pointer("deprecation message"),
 			},
 			expected: GeneratorNumberAttribute{
-				CustomType:         convert.NewCustomTypePrimitive(nil, nil, "name"),
+				CustomType:         convert.NewCustomTypePrimitive(nil, nil, "name", nil),
 				DeprecationMessage: convert.NewDeprecationMessage(pointer("deprecation message")),
 				Validators:         convert.NewValidators(convert.ValidatorTypeNumber, specschema.CustomValidators{}),
 			},
@@ -83,7 +83,7 @@ func TestGeneratorNumberAttribute_New(t *testing.T) {
 				Description: pointer("description"),
 			},
 			expected: GeneratorNumberAttribute{
-				CustomType:  convert.NewCustomTypePrimitive(nil, nil, "name"),
+				CustomType:  convert.NewCustomTypePrimitive(nil, nil, "name", nil),
 				Description: convert.NewDescription(pointer("description")),
 				Validators:  convert.NewValidators(convert.ValidatorTypeNumber, specschema.CustomValidators{}),
 			},
@@ -93,7 +93,7 @@ func TestGeneratorNumberAttribute_New(t *testing.T) {
 				Sensitive: pointer(true),
 			},
 			expected: GeneratorNumberAttribute{
-				CustomType: convert.NewCustomTypePrimitive(nil, nil, "name"),
+				CustomType: convert.NewCustomTypePrimitive(nil, nil, "name", nil),
 				Sensitive:  convert.NewSensitive(pointer(true)),
 				Validators: convert.NewValidators(convert.ValidatorTypeNumber, specschema.CustomValidators{}),
 			},
@@ -114,7 +114,7 @@ func TestGeneratorNumberAttribute_New(t *testing.T) {
 				},
 			},
 			expected: GeneratorNumberAttribute{
-				CustomType: convert.NewCustomTypePrimitive(nil, nil, "name"),
+				CustomType: convert.NewCustomTypePrimitive(nil, nil, "name", nil),
 				Validators: convert.NewValidators(convert.ValidatorTypeNumber, specschema.CustomValidators{
 					&specschema.CustomValidator{
 						Imports: []code.Import{
@@ -134,7 +134,7 @@ func TestGeneratorNumberAttribute_New(t *testing.T) {
 		t.Run(name, func(t *testing.T) {
 			t.Parallel()
 
-			got, err := NewGeneratorNumberAttribute("name", testCase.input)
+			got, err := NewGeneratorNumberAttribute("name", testCase.input, nil)
 
 			if diff := cmp.Diff(err, testCase.expectedError, equateErrorMessage); diff != "" {
 				t.Errorf("unexpected error: %s", diff)
@@ -163,6 +163,7 @@ func TestGeneratorNumberAttribute_Schema(t *testing.T) {
 					},
 					nil,
 					"number_attribute",
+					nil,
 				),
 			},
 			expected: `"number_attribute": schema.NumberAttribute{
@@ -178,6 +179,7 @@ CustomType: my_custom_type,
 						Type: "*api.ExtNumber",
 					},
 					"number_attribute",
+					nil,
 				),
 			},
 			expected: `"number_attribute": schema.NumberAttribute{
@@ -195,6 +197,7 @@ CustomType: NumberAttributeType{},
 						Type: "*api.ExtNumber",
 					},
 					"number_attribute",
+					nil,
 				),
 			},
 			expected: `"number_attribute": schema.NumberAttribute{
@@ -317,6 +320,7 @@ func TestGeneratorNumberAttribute_ModelField(t *testing.T) {
 					},
 					nil,
 					"",
+					nil,
 				),
 			},
 			expected: model.Field{
@@ -333,6 +337,7 @@ func TestGeneratorNumberAttribute_ModelField(t *testing.T) {
 						Type: "*api.NumberAttribute",
 					},
 					"number_attribute",
+					nil,
 				),
 			},
 			expected: model.Field{
@@ -351,6 +356,7 @@ func TestGeneratorNumberAttribute_ModelField(t *testing.T) {
 						Type: "*api.NumberAttribute",
 					},
 					"",
+					nil,
 				),
 			},
 			expected: model.Field{
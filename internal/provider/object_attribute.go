@@ -26,16 +26,18 @@ type GeneratorObjectAttribute struct {
 	Description            convert.Description
 	Sensitive              convert.Sensitive
 	Validators             convert.Validators
+
+	options *generatorschema.Options
 }
 
-func NewGeneratorObjectAttribute(name string, a *provider.ObjectAttribute) (GeneratorObjectAttribute, error) {
+func NewGeneratorObjectAttribute(name string, a *provider.ObjectAttribute, opts *generatorschema.Options) (GeneratorObjectAttribute, error) {
 	if a == nil {
 		return GeneratorObjectAttribute{}, fmt.Errorf("*provider.ObjectAttribute is nil")
 	}
 
 	c := convert.NewOptionalRequired(a.OptionalRequired)
 
-	cto := convert.NewCustomTypeObject(a.CustomType, a.AssociatedExternalType, name)
+	cto := convert.NewCustomTypeObject(a.CustomType, a.AssociatedExternalType, name, opts)
 
 	d := convert.NewDescription(a.Description)
 
@@ -48,7 +50,7 @@ func NewGeneratorObjectAttribute(name string, a *provider.ObjectAttribute) (Gene
 	v := convert.NewValidators(convert.ValidatorTypeObject, a.Validators.CustomValidators())
 
 	return GeneratorObjectAttribute{
-		AssociatedExternalType: generatorschema.NewAssocExtType(a.AssociatedExternalType),
+		AssociatedExternalType: generatorschema.NewAssocExtType(a.AssociatedExternalType, opts),
 		AttributeTypes:         a.AttributeTypes,
 		AttributeTypesObject:   oat,
 		OptionalRequired:       c,
@@ -57,6 +59,7 @@ func NewGeneratorObjectAttribute(name string, a *provider.ObjectAttribute) (Gene
 		Description:            d,
 		Sensitive:              s,
 		Validators:             v,
+		options:                opts,
 	}, nil
 }
 
@@ -158,7 +161,7 @@ func (g GeneratorObjectAttribute) Schema(name generatorschema.FrameworkIdentifie
 
 func (g GeneratorObjectAttribute) ModelField(name generatorschema.FrameworkIdentifier) (model.Field, error) {
 	field := model.Field{
-		Name:      name.ToPascalCase(),
+		Name:      g.options.ToPascalCase(name),
 		TfsdkName: name.ToString(),
 		ValueType: model.ObjectValueType,
 	}
@@ -179,7 +182,7 @@ func (g GeneratorObjectAttribute) CustomTypeAndValue(name string) ([]byte, error
 
 	var buf bytes.Buffer
 
-	objectType := generatorschema.NewCustomObjectType(name)
+	objectType := generatorschema.NewCustomObjectType(name, g.options)
 
 	b, err := objectType.Render()
 
@@ -191,7 +194,7 @@ func (g GeneratorObjectAttribute) CustomTypeAndValue(name string) ([]byte, error
 
 	attrTypes := generatorschema.GetAttrTypes(g.AttrTypes())
 
-	objectValue := generatorschema.NewCustomObjectValue(name, attrTypes)
+	objectValue := generatorschema.NewCustomObjectValue(name, attrTypes, g.options)
 
 	b, err = objectValue.Render()
 
@@ -204,6 +207,10 @@ func (g GeneratorObjectAttribute) CustomTypeAndValue(name string) ([]byte, error
 	return buf.Bytes(), nil
 }
 
+func (g GeneratorObjectAttribute) GetAssociatedExternalType() *generatorschema.AssocExtType {
+	return g.AssociatedExternalType
+}
+
 func (g GeneratorObjectAttribute) ToFromFunctions(name string) ([]byte, error) {
 	if g.AssociatedExternalType == nil {
 		return nil, nil
@@ -221,7 +228,7 @@ func (g GeneratorObjectAttribute) ToFromFunctions(name string) ([]byte, error) {
 		return nil, err
 	}
 
-	toFrom := generatorschema.NewToFromObject(name, g.AssociatedExternalType, attrTypesToFuncs, attrTypesFromFuncs)
+	toFrom := generatorschema.NewToFromObject(name, g.AssociatedExternalType, attrTypesToFuncs, attrTypesFromFuncs, g.options)
 
 	b, err := toFrom.Render()
 
@@ -235,7 +242,7 @@ func (g GeneratorObjectAttribute) ToFromFunctions(name string) ([]byte, error) {
 // AttrType returns a string representation of a basetypes.ObjectTypable type.
 func (g GeneratorObjectAttribute) AttrType(name generatorschema.FrameworkIdentifier) (string, error) {
 	if g.AssociatedExternalType != nil {
-		return fmt.Sprintf("%sType{\nbasetypes.ObjectType{\nAttrTypes: %sValue{}.AttributeTypes(ctx),\n}}", name.ToPascalCase(), name.ToPascalCase()), nil
+		return fmt.Sprintf("%sType{\nbasetypes.ObjectType{\nAttrTypes: %sValue{}.AttributeTypes(ctx),\n}}", g.options.ToPascalCase(name), g.options.ToPascalCase(name)), nil
 	}
 
 	aTypes, err := generatorschema.AttrTypesString(g.AttrTypes())
@@ -250,7 +257,7 @@ func (g GeneratorObjectAttribute) AttrType(name generatorschema.FrameworkIdentif
 // AttrValue returns a string representation of a basetypes.ListValuable type.
 func (g GeneratorObjectAttribute) AttrValue(name generatorschema.FrameworkIdentifier) string {
 	if g.AssociatedExternalType != nil {
-		return fmt.Sprintf("%sValue", name.ToPascalCase())
+		return fmt.Sprintf("%sValue", g.options.ToPascalCase(name))
 	}
 
 	return "basetypes.ObjectValue"
@@ -303,3 +310,13 @@ func (g GeneratorObjectAttribute) From() (generatorschema.ToFromConversion, erro
 		ObjectType: objectFields,
 	}, nil
 }
+
+func (g GeneratorObjectAttribute) Properties() generatorschema.FieldProperties {
+	return generatorschema.FieldProperties{
+		Optional:           g.OptionalRequired.IsOptional(),
+		Required:           g.OptionalRequired.IsRequired(),
+		Sensitive:          g.Sensitive.IsSensitive(),
+		Description:        g.Description.Description(),
+		DeprecationMessage: g.DeprecationMessage.DeprecationMessage(),
+	}
+}
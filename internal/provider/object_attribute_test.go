@@ -50,7 +50,7 @@ func TestGeneratorObjectAttribute_New(t *testing.T) {
 						Bool: &specschema.BoolType{},
 					},
 				}),
-				CustomType: convert.NewCustomTypeObject(nil, nil, "name"),
+				CustomType: convert.NewCustomTypeObject(nil, nil, "name", nil),
 				Validators: convert.NewValidators(convert.ValidatorTypeObject, specschema.CustomValidators{}),
 			},
 		},
@@ -76,7 +76,7 @@ func TestGeneratorObjectAttribute_New(t *testing.T) {
 						String: &specschema.StringType{},
 					},
 				}),
-				CustomType: convert.NewCustomTypeObject(nil, nil, "name"),
+				CustomType: convert.NewCustomTypeObject(nil, nil, "name", nil),
 				Validators: convert.NewValidators(convert.ValidatorTypeObject, specschema.CustomValidators{}),
 			},
 		},
@@ -114,7 +114,7 @@ func TestGeneratorObjectAttribute_New(t *testing.T) {
 						},
 					},
 				}),
-				CustomType: convert.NewCustomTypeObject(nil, nil, "name"),
+				CustomType: convert.NewCustomTypeObject(nil, nil, "name", nil),
 				Validators: convert.NewValidators(convert.ValidatorTypeObject, specschema.CustomValidators{}),
 			},
 		},
@@ -152,7 +152,7 @@ func TestGeneratorObjectAttribute_New(t *testing.T) {
 						},
 					},
 				}),
-				CustomType: convert.NewCustomTypeObject(nil, nil, "name"),
+				CustomType: convert.NewCustomTypeObject(nil, nil, "name", nil),
 				Validators: convert.NewValidators(convert.ValidatorTypeObject, specschema.CustomValidators{}),
 			},
 		},
@@ -211,7 +211,7 @@ func TestGeneratorObjectAttribute_New(t *testing.T) {
 						},
 					},
 				}),
-				CustomType: convert.NewCustomTypeObject(nil, nil, "name"),
+				CustomType: convert.NewCustomTypeObject(nil, nil, "name", nil),
 				Validators: convert.NewValidators(convert.ValidatorTypeObject, specschema.CustomValidators{}),
 			},
 		},
@@ -237,7 +237,7 @@ func TestGeneratorObjectAttribute_New(t *testing.T) {
 						String: &specschema.StringType{},
 					},
 				}),
-				CustomType: convert.NewCustomTypeObject(nil, nil, "name"),
+				CustomType: convert.NewCustomTypeObject(nil, nil, "name", nil),
 				Validators: convert.NewValidators(convert.ValidatorTypeObject, specschema.CustomValidators{}),
 			},
 		},
@@ -275,7 +275,7 @@ func TestGeneratorObjectAttribute_New(t *testing.T) {
 						},
 					},
 				}),
-				CustomType: convert.NewCustomTypeObject(nil, nil, "name"),
+				CustomType: convert.NewCustomTypeObject(nil, nil, "name", nil),
 				Validators: convert.NewValidators(convert.ValidatorTypeObject, specschema.CustomValidators{}),
 			},
 		},
@@ -285,7 +285,7 @@ func TestGeneratorObjectAttribute_New(t *testing.T) {
 			},
 			expected: GeneratorObjectAttribute{
 				OptionalRequired: convert.NewOptionalRequired(specschema.Optional),
-				CustomType:       convert.NewCustomTypeObject(nil, nil, "name"),
+				CustomType:       convert.NewCustomTypeObject(nil, nil, "name", nil),
 				Validators:       convert.NewValidators(convert.ValidatorTypeObject, specschema.CustomValidators{})},
 		},
 		"required": {
@@ -294,7 +294,7 @@ func TestGeneratorObjectAttribute_New(t *testing.T) {
 			},
 			expected: GeneratorObjectAttribute{
 				OptionalRequired: convert.NewOptionalRequired(specschema.Required),
-				CustomType:       convert.NewCustomTypeObject(nil, nil, "name"),
+				CustomType:       convert.NewCustomTypeObject(nil, nil, "name", nil),
 				Validators:       convert.NewValidators(convert.ValidatorTypeObject, specschema.CustomValidators{}),
 			},
 		},
@@ -318,6 +318,7 @@ func TestGeneratorObjectAttribute_New(t *testing.T) {
 				},
 					nil,
 					"name",
+					nil,
 				),
 				Validators: convert.NewValidators(convert.ValidatorTypeObject, specschema.CustomValidators{})},
 		},
@@ -326,7 +327,7 @@ func TestGeneratorObjectAttribute_New(t *testing.T) {
 				DeprecationMessage: pointer("deprecation message"),
 			},
 			expected: GeneratorObjectAttribute{
-				CustomType:         convert.NewCustomTypeObject(nil, nil, "name"),
+				CustomType:         convert.NewCustomTypeObject(nil, nil, "name", nil),
 				DeprecationMessage: convert.NewDeprecationMessage(pointer("deprecation message")),
 				Validators:         convert.NewValidators(convert.ValidatorTypeObject, specschema.CustomValidators{})},
 		},
@@ -335,7 +336,7 @@ func TestGeneratorObjectAttribute_New(t *testing.T) {
 				Description: pointer("description"),
 			},
 			expected: GeneratorObjectAttribute{
-				CustomType:  convert.NewCustomTypeObject(nil, nil, "name"),
+				CustomType:  convert.NewCustomTypeObject(nil, nil, "name", nil),
 				Description: convert.NewDescription(pointer("description")),
 				Validators:  convert.NewValidators(convert.ValidatorTypeObject, specschema.CustomValidators{}),
 			},
@@ -345,7 +346,7 @@ func TestGeneratorObjectAttribute_New(t *testing.T) {
 				Sensitive: pointer(true),
 			},
 			expected: GeneratorObjectAttribute{
-				CustomType: convert.NewCustomTypeObject(nil, nil, "name"),
+				CustomType: convert.NewCustomTypeObject(nil, nil, "name", nil),
 				Sensitive:  convert.NewSensitive(pointer(true)),
 				Validators: convert.NewValidators(convert.ValidatorTypeObject, specschema.CustomValidators{}),
 			},
@@ -366,7 +367,7 @@ func TestGeneratorObjectAttribute_New(t *testing.T) {
 				},
 			},
 			expected: GeneratorObjectAttribute{
-				CustomType: convert.NewCustomTypeObject(nil, nil, "name"),
+				CustomType: convert.NewCustomTypeObject(nil, nil, "name", nil),
 				Validators: convert.NewValidators(convert.ValidatorTypeObject, specschema.CustomValidators{
 					&specschema.CustomValidator{
 						Imports: []code.Import{
@@ -386,7 +387,7 @@ func TestGeneratorObjectAttribute_New(t *testing.T) {
 		t.Run(name, func(t *testing.T) {
 			t.Parallel()
 
-			got, err := NewGeneratorObjectAttribute("name", testCase.input)
+			got, err := NewGeneratorObjectAttribute("name", testCase.input, nil)
 
 			if diff := cmp.Diff(err, testCase.expectedError, equateErrorMessage); diff != "" {
 				t.Errorf("unexpected error: %s", diff)
@@ -419,6 +420,7 @@ func TestGeneratorObjectAttribute_Imports(t *testing.T) {
 					&specschema.CustomType{},
 					nil,
 					"",
+					nil,
 				),
 			},
 			expected: []code.Import{},
@@ -433,6 +435,7 @@ func TestGeneratorObjectAttribute_Imports(t *testing.T) {
 					},
 					nil,
 					"",
+					nil,
 				),
 			},
 			expected: []code.Import{},
@@ -447,6 +450,7 @@ func TestGeneratorObjectAttribute_Imports(t *testing.T) {
 					},
 					nil,
 					"",
+					nil,
 				),
 			},
 			expected: []code.Import{
@@ -761,6 +765,7 @@ func TestGeneratorObjectAttribute_Imports(t *testing.T) {
 						Type: "*api.ObjectAttribute",
 					},
 					"",
+					nil,
 				),
 			},
 			expected: []code.Import{
@@ -1184,6 +1189,7 @@ AttributeTypes: map[string]attr.Type{
 					},
 					nil,
 					"",
+					nil,
 				),
 			},
 			expected: `"object_attribute": schema.ObjectAttribute{
@@ -1205,6 +1211,7 @@ CustomType: my_custom_type,
 						Type: "*api.ObjectAttribute",
 					},
 					"object_attribute",
+					nil,
 				),
 			},
 			expected: `"object_attribute": schema.ObjectAttribute{
@@ -1232,6 +1239,7 @@ AttrTypes: ObjectAttributeValue{}.AttributeTypes(ctx),
 						Type: "*api.ObjectAttribute",
 					},
 					"object_attribute",
+					nil,
 				),
 			},
 			expected: `"object_attribute": schema.ObjectAttribute{
@@ -1618,6 +1626,7 @@ func TestGeneratorObjectAttribute_ModelField(t *testing.T) {
 					},
 					nil,
 					"",
+					nil,
 				),
 			},
 			expected: model.Field{
@@ -1634,6 +1643,7 @@ func TestGeneratorObjectAttribute_ModelField(t *testing.T) {
 						Type: "*api.ObjectAttribute",
 					},
 					"object_attribute",
+					nil,
 				),
 			},
 			expected: model.Field{
@@ -1652,6 +1662,7 @@ func TestGeneratorObjectAttribute_ModelField(t *testing.T) {
 						Type: "*api.ObjectAttribute",
 					},
 					"object_attribute",
+					nil,
 				),
 			},
 			expected: model.Field{
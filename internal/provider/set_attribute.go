@@ -25,9 +25,11 @@ type GeneratorSetAttribute struct {
 	ElementTypeCollection  convert.ElementType
 	Sensitive              convert.Sensitive
 	Validators             convert.Validators
+
+	options *generatorschema.Options
 }
 
-func NewGeneratorSetAttribute(name string, a *provider.SetAttribute) (GeneratorSetAttribute, error) {
+func NewGeneratorSetAttribute(name string, a *provider.SetAttribute, opts *generatorschema.Options) (GeneratorSetAttribute, error) {
 	if a == nil {
 		return GeneratorSetAttribute{}, fmt.Errorf("*provider.SetAttribute is nil")
 	}
@@ -36,7 +38,7 @@ func NewGeneratorSetAttribute(name string, a *provider.SetAttribute) (GeneratorS
 
 	c := convert.NewOptionalRequired(a.OptionalRequired)
 
-	ctc := convert.NewCustomTypeCollection(a.CustomType, a.AssociatedExternalType, convert.CustomCollectionTypeSet, string(et.ElementType()), name)
+	ctc := convert.NewCustomTypeCollection(a.CustomType, a.AssociatedExternalType, convert.CustomCollectionTypeSet, string(et.ElementType()), name, opts)
 
 	d := convert.NewDescription(a.Description)
 
@@ -47,7 +49,7 @@ func NewGeneratorSetAttribute(name string, a *provider.SetAttribute) (GeneratorS
 	v := convert.NewValidators(convert.ValidatorTypeSet, a.Validators.CustomValidators())
 
 	return GeneratorSetAttribute{
-		AssociatedExternalType: generatorschema.NewAssocExtType(a.AssociatedExternalType),
+		AssociatedExternalType: generatorschema.NewAssocExtType(a.AssociatedExternalType, opts),
 		OptionalRequired:       c,
 		CustomType:             ctc,
 		DeprecationMessage:     dm,
@@ -56,6 +58,7 @@ func NewGeneratorSetAttribute(name string, a *provider.SetAttribute) (GeneratorS
 		ElementTypeCollection:  et,
 		Sensitive:              s,
 		Validators:             v,
+		options:                opts,
 	}, nil
 }
 
@@ -151,7 +154,7 @@ func (g GeneratorSetAttribute) Schema(name generatorschema.FrameworkIdentifier)
 
 func (g GeneratorSetAttribute) ModelField(name generatorschema.FrameworkIdentifier) (model.Field, error) {
 	field := model.Field{
-		Name:      name.ToPascalCase(),
+		Name:      g.options.ToPascalCase(name),
 		TfsdkName: name.ToString(),
 		ValueType: model.SetValueType,
 	}
@@ -172,7 +175,7 @@ func (g GeneratorSetAttribute) CustomTypeAndValue(name string) ([]byte, error) {
 
 	var buf bytes.Buffer
 
-	listType := generatorschema.NewCustomSetType(name)
+	listType := generatorschema.NewCustomSetType(name, g.options)
 
 	b, err := listType.Render()
 
@@ -184,7 +187,7 @@ func (g GeneratorSetAttribute) CustomTypeAndValue(name string) ([]byte, error) {
 
 	elemType := generatorschema.GetElementType(g.ElementType)
 
-	listValue := generatorschema.NewCustomSetValue(name, elemType)
+	listValue := generatorschema.NewCustomSetValue(name, elemType, g.options)
 
 	b, err = listValue.Render()
 
@@ -197,6 +200,10 @@ func (g GeneratorSetAttribute) CustomTypeAndValue(name string) ([]byte, error) {
 	return buf.Bytes(), nil
 }
 
+func (g GeneratorSetAttribute) GetAssociatedExternalType() *generatorschema.AssocExtType {
+	return g.AssociatedExternalType
+}
+
 func (g GeneratorSetAttribute) ToFromFunctions(name string) ([]byte, error) {
 	if g.AssociatedExternalType == nil {
 		return nil, nil
@@ -211,7 +218,7 @@ func (g GeneratorSetAttribute) ToFromFunctions(name string) ([]byte, error) {
 		return nil, err
 	}
 
-	toFrom := generatorschema.NewToFromSet(name, g.AssociatedExternalType, elementTypeType, elementTypeValue, elementFrom)
+	toFrom := generatorschema.NewToFromSet(name, g.AssociatedExternalType, elementTypeType, elementTypeValue, elementFrom, g.options)
 
 	b, err := toFrom.Render()
 
@@ -231,7 +238,7 @@ func (g GeneratorSetAttribute) AttrType(name generatorschema.FrameworkIdentifier
 	}
 
 	if g.AssociatedExternalType != nil {
-		return fmt.Sprintf("%sType{\nbasetypes.SetType{\nElemType: %s,\n}}", name.ToPascalCase(), elemType), nil
+		return fmt.Sprintf("%sType{\nbasetypes.SetType{\nElemType: %s,\n}}", g.options.ToPascalCase(name), elemType), nil
 	}
 
 	return fmt.Sprintf("basetypes.SetType{\nElemType: %s,\n}", elemType), nil
@@ -240,7 +247,7 @@ func (g GeneratorSetAttribute) AttrType(name generatorschema.FrameworkIdentifier
 // AttrValue returns a string representation of a basetypes.SetValuable type.
 func (g GeneratorSetAttribute) AttrValue(name generatorschema.FrameworkIdentifier) string {
 	if g.AssociatedExternalType != nil {
-		return fmt.Sprintf("%sValue", name.ToPascalCase())
+		return fmt.Sprintf("%sValue", g.options.ToPascalCase(name))
 	}
 
 	return "basetypes.SetValue"
@@ -305,3 +312,13 @@ func (g GeneratorSetAttribute) CollectionType() (map[string]string, error) {
 		"TypeValueFunc": "types.SetValue",
 	}, nil
 }
+
+func (g GeneratorSetAttribute) Properties() generatorschema.FieldProperties {
+	return generatorschema.FieldProperties{
+		Optional:           g.OptionalRequired.IsOptional(),
+		Required:           g.OptionalRequired.IsRequired(),
+		Sensitive:          g.Sensitive.IsSensitive(),
+		Description:        g.Description.Description(),
+		DeprecationMessage: g.DeprecationMessage.DeprecationMessage(),
+	}
+}
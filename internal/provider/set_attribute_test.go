@@ -40,6 +40,7 @@ func TestGeneratorSetAttribute_New(t *testing.T) {
 					convert.CustomCollectionTypeSet,
 					"types.BoolType",
 					"name",
+					nil,
 				),
 				ElementType: specschema.ElementType{
 					Bool: &specschema.BoolType{},
@@ -63,6 +64,7 @@ func TestGeneratorSetAttribute_New(t *testing.T) {
 					convert.CustomCollectionTypeSet,
 					"types.StringType",
 					"name",
+					nil,
 				),
 				ElementType: specschema.ElementType{
 					String: &specschema.StringType{},
@@ -90,6 +92,7 @@ func TestGeneratorSetAttribute_New(t *testing.T) {
 					convert.CustomCollectionTypeSet,
 					"types.ListType{\nElemType: types.StringType,\n}",
 					"name",
+					nil,
 				),
 				ElementType: specschema.ElementType{
 					List: &specschema.ListType{
@@ -125,6 +128,7 @@ func TestGeneratorSetAttribute_New(t *testing.T) {
 					convert.CustomCollectionTypeSet,
 					"types.MapType{\nElemType: types.StringType,\n}",
 					"name",
+					nil,
 				),
 				ElementType: specschema.ElementType{
 					Map: &specschema.MapType{
@@ -167,6 +171,7 @@ func TestGeneratorSetAttribute_New(t *testing.T) {
 					convert.CustomCollectionTypeSet,
 					"types.ListType{\nElemType: types.ObjectType{\nAttrTypes: map[string]attr.Type{\n\"str\": types.StringType,\n},\n},\n}",
 					"name",
+					nil,
 				),
 				ElementType: specschema.ElementType{
 					List: &specschema.ListType{
@@ -219,6 +224,7 @@ func TestGeneratorSetAttribute_New(t *testing.T) {
 					convert.CustomCollectionTypeSet,
 					"types.ObjectType{\nAttrTypes: map[string]attr.Type{\n\"str\": types.StringType,\n},\n}",
 					"name",
+					nil,
 				),
 				ElementType: specschema.ElementType{
 					Object: &specschema.ObjectType{
@@ -267,6 +273,7 @@ func TestGeneratorSetAttribute_New(t *testing.T) {
 					convert.CustomCollectionTypeSet,
 					"types.ObjectType{\nAttrTypes: map[string]attr.Type{\n\"list\": types.ListType{\nElemType: types.StringType,\n},\n},\n}",
 					"name",
+					nil,
 				),
 				ElementType: specschema.ElementType{
 					Object: &specschema.ObjectType{
@@ -314,6 +321,7 @@ func TestGeneratorSetAttribute_New(t *testing.T) {
 					convert.CustomCollectionTypeSet,
 					"types.StringType",
 					"name",
+					nil,
 				),
 				ElementType: specschema.ElementType{
 					String: &specschema.StringType{},
@@ -339,6 +347,7 @@ func TestGeneratorSetAttribute_New(t *testing.T) {
 					convert.CustomCollectionTypeSet,
 					"types.StringType",
 					"name",
+					nil,
 				),
 				ElementType: specschema.ElementType{
 					String: &specschema.StringType{},
@@ -375,6 +384,7 @@ func TestGeneratorSetAttribute_New(t *testing.T) {
 					convert.CustomCollectionTypeSet,
 					"types.StringType",
 					"name",
+					nil,
 				),
 				ElementType: specschema.ElementType{
 					String: &specschema.StringType{},
@@ -399,6 +409,7 @@ func TestGeneratorSetAttribute_New(t *testing.T) {
 					convert.CustomCollectionTypeSet,
 					"types.StringType",
 					"name",
+					nil,
 				),
 				DeprecationMessage: convert.NewDeprecationMessage(pointer("deprecation message")),
 				ElementType: specschema.ElementType{
@@ -424,6 +435,7 @@ func TestGeneratorSetAttribute_New(t *testing.T) {
 					convert.CustomCollectionTypeSet,
 					"types.StringType",
 					"name",
+					nil,
 				),
 				Description: convert.NewDescription(pointer("description")),
 				ElementType: specschema.ElementType{
@@ -449,6 +461,7 @@ func TestGeneratorSetAttribute_New(t *testing.T) {
 					convert.CustomCollectionTypeSet,
 					"types.StringType",
 					"name",
+					nil,
 				),
 				ElementType: specschema.ElementType{
 					String: &specschema.StringType{},
@@ -485,6 +498,7 @@ func TestGeneratorSetAttribute_New(t *testing.T) {
 					convert.CustomCollectionTypeSet,
 					"types.StringType",
 					"name",
+					nil,
 				),
 				ElementType: specschema.ElementType{
 					String: &specschema.StringType{},
@@ -511,7 +525,7 @@ func TestGeneratorSetAttribute_New(t *testing.T) {
 		t.Run(name, func(t *testing.T) {
 			t.Parallel()
 
-			got, err := NewGeneratorSetAttribute("name", testCase.input)
+			got, err := NewGeneratorSetAttribute("name", testCase.input, nil)
 
 			if diff := cmp.Diff(err, testCase.expectedError, equateErrorMessage); diff != "" {
 				t.Errorf("unexpected error: %s", diff)
@@ -783,6 +797,7 @@ ElementType: types.StringType,
 					convert.CustomCollectionTypeSet,
 					"",
 					"",
+					nil,
 				),
 				ElementTypeCollection: convert.NewElementType(specschema.ElementType{
 					String: &specschema.StringType{},
@@ -801,6 +816,7 @@ CustomType: my_custom_type,
 					convert.CustomCollectionTypeSet,
 					"types.StringType",
 					"set_attribute",
+					nil,
 				),
 				ElementTypeCollection: convert.NewElementType(specschema.ElementType{
 					String: &specschema.StringType{},
@@ -823,6 +839,7 @@ ElemType: types.StringType,
 					convert.CustomCollectionTypeSet,
 					"types.StringType",
 					"name",
+					nil,
 				),
 			},
 			expected: `"set_attribute": schema.SetAttribute{
@@ -1131,6 +1148,7 @@ func TestGeneratorSetAttribute_ModelField(t *testing.T) {
 					convert.CustomCollectionTypeSet,
 					"",
 					"",
+					nil,
 				),
 			},
 			expected: model.Field{
@@ -1149,6 +1167,7 @@ func TestGeneratorSetAttribute_ModelField(t *testing.T) {
 					convert.CustomCollectionTypeSet,
 					"",
 					"set_attribute",
+					nil,
 				),
 			},
 			expected: model.Field{
@@ -1169,6 +1188,7 @@ func TestGeneratorSetAttribute_ModelField(t *testing.T) {
 					convert.CustomCollectionTypeSet,
 					"",
 					"set_attribute",
+					nil,
 				),
 			},
 			expected: model.Field{
@@ -20,7 +20,7 @@ import (
 func TestGeneratorSetNestedAttribute_New(t *testing.T) {
 	t.Parallel()
 
-	attributes, err := NewAttributes(provider.Attributes{})
+	attributes, err := NewAttributes(provider.Attributes{}, "", nil)
 
 	if err != nil {
 		t.Error(err)
@@ -66,7 +66,7 @@ func TestGeneratorSetNestedAttribute_New(t *testing.T) {
 					Attributes: generatorschema.GeneratorAttributes{
 						"bool_attribute": GeneratorBoolAttribute{
 							OptionalRequired: convert.NewOptionalRequired(specschema.Optional),
-							CustomType:       convert.NewCustomTypePrimitive(nil, nil, "bool_attribute"),
+							CustomType:       convert.NewCustomTypePrimitive(nil, nil, "bool_attribute", nil),
 							Validators:       convert.NewValidators(convert.ValidatorTypeBool, specschema.CustomValidators{}),
 						},
 					},
@@ -75,13 +75,14 @@ func TestGeneratorSetNestedAttribute_New(t *testing.T) {
 					generatorschema.GeneratorAttributes{
 						"bool_attribute": GeneratorBoolAttribute{
 							OptionalRequired: convert.NewOptionalRequired(specschema.Optional),
-							CustomType:       convert.NewCustomTypePrimitive(nil, nil, "bool_attribute"),
+							CustomType:       convert.NewCustomTypePrimitive(nil, nil, "bool_attribute", nil),
 							Validators:       convert.NewValidators(convert.ValidatorTypeBool, specschema.CustomValidators{}),
 						},
 					},
 					nil,
 					convert.NewValidators(convert.ValidatorTypeObject, specschema.CustomValidators{}),
 					"name",
+					nil,
 				),
 				Validators: convert.NewValidators(convert.ValidatorTypeSet, specschema.CustomValidators{}),
 			},
@@ -113,6 +114,7 @@ func TestGeneratorSetNestedAttribute_New(t *testing.T) {
 								convert.CustomCollectionTypeList,
 								"types.BoolType",
 								"list_attribute",
+								nil,
 							),
 							ElementType: specschema.ElementType{
 								Bool: &specschema.BoolType{},
@@ -134,6 +136,7 @@ func TestGeneratorSetNestedAttribute_New(t *testing.T) {
 								convert.CustomCollectionTypeList,
 								"types.BoolType",
 								"list_attribute",
+								nil,
 							),
 							ElementType: specschema.ElementType{
 								Bool: &specschema.BoolType{},
@@ -147,6 +150,7 @@ func TestGeneratorSetNestedAttribute_New(t *testing.T) {
 					nil,
 					convert.NewValidators(convert.ValidatorTypeObject, specschema.CustomValidators{}),
 					"name",
+					nil,
 				),
 				Validators: convert.NewValidators(convert.ValidatorTypeSet, specschema.CustomValidators{}),
 			},
@@ -182,7 +186,7 @@ func TestGeneratorSetNestedAttribute_New(t *testing.T) {
 								Attributes: generatorschema.GeneratorAttributes{
 									"nested_bool": GeneratorBoolAttribute{
 										OptionalRequired: convert.NewOptionalRequired(specschema.Optional),
-										CustomType:       convert.NewCustomTypePrimitive(nil, nil, "nested_bool"),
+										CustomType:       convert.NewCustomTypePrimitive(nil, nil, "nested_bool", nil),
 										Validators:       convert.NewValidators(convert.ValidatorTypeBool, specschema.CustomValidators{}),
 									},
 								},
@@ -192,13 +196,14 @@ func TestGeneratorSetNestedAttribute_New(t *testing.T) {
 								generatorschema.GeneratorAttributes{
 									"nested_bool": GeneratorBoolAttribute{
 										OptionalRequired: convert.NewOptionalRequired(specschema.Optional),
-										CustomType:       convert.NewCustomTypePrimitive(nil, nil, "nested_bool"),
+										CustomType:       convert.NewCustomTypePrimitive(nil, nil, "nested_bool", nil),
 										Validators:       convert.NewValidators(convert.ValidatorTypeBool, specschema.CustomValidators{}),
 									},
 								},
 								nil,
 								convert.NewValidators(convert.ValidatorTypeObject, specschema.CustomValidators{}),
 								"nested_attribute",
+								nil,
 							),
 							Validators: convert.NewValidators(convert.ValidatorTypeSet, specschema.CustomValidators{}),
 						},
@@ -211,7 +216,7 @@ func TestGeneratorSetNestedAttribute_New(t *testing.T) {
 								Attributes: generatorschema.GeneratorAttributes{
 									"nested_bool": GeneratorBoolAttribute{
 										OptionalRequired: convert.NewOptionalRequired(specschema.Optional),
-										CustomType:       convert.NewCustomTypePrimitive(nil, nil, "nested_bool"),
+										CustomType:       convert.NewCustomTypePrimitive(nil, nil, "nested_bool", nil),
 										Validators:       convert.NewValidators(convert.ValidatorTypeBool, specschema.CustomValidators{}),
 									},
 								},
@@ -221,13 +226,14 @@ func TestGeneratorSetNestedAttribute_New(t *testing.T) {
 								generatorschema.GeneratorAttributes{
 									"nested_bool": GeneratorBoolAttribute{
 										OptionalRequired: convert.NewOptionalRequired(specschema.Optional),
-										CustomType:       convert.NewCustomTypePrimitive(nil, nil, "nested_bool"),
+										CustomType:       convert.NewCustomTypePrimitive(nil, nil, "nested_bool", nil),
 										Validators:       convert.NewValidators(convert.ValidatorTypeBool, specschema.CustomValidators{}),
 									},
 								},
 								nil,
 								convert.NewValidators(convert.ValidatorTypeObject, specschema.CustomValidators{}),
 								"nested_attribute",
+								nil,
 							),
 							Validators: convert.NewValidators(convert.ValidatorTypeSet, specschema.CustomValidators{}),
 						},
@@ -235,6 +241,7 @@ func TestGeneratorSetNestedAttribute_New(t *testing.T) {
 					nil,
 					convert.NewValidators(convert.ValidatorTypeObject, specschema.CustomValidators{}),
 					"name",
+					nil,
 				),
 				Validators: convert.NewValidators(convert.ValidatorTypeSet, specschema.CustomValidators{}),
 			},
@@ -275,7 +282,7 @@ func TestGeneratorSetNestedAttribute_New(t *testing.T) {
 								},
 							}),
 							OptionalRequired: convert.NewOptionalRequired(specschema.Optional),
-							CustomType:       convert.NewCustomTypeObject(nil, nil, "object_attribute"),
+							CustomType:       convert.NewCustomTypeObject(nil, nil, "object_attribute", nil),
 							Validators:       convert.NewValidators(convert.ValidatorTypeObject, specschema.CustomValidators{}),
 						},
 					},
@@ -296,13 +303,14 @@ func TestGeneratorSetNestedAttribute_New(t *testing.T) {
 								},
 							}),
 							OptionalRequired: convert.NewOptionalRequired(specschema.Optional),
-							CustomType:       convert.NewCustomTypeObject(nil, nil, "object_attribute"),
+							CustomType:       convert.NewCustomTypeObject(nil, nil, "object_attribute", nil),
 							Validators:       convert.NewValidators(convert.ValidatorTypeObject, specschema.CustomValidators{}),
 						},
 					},
 					nil,
 					convert.NewValidators(convert.ValidatorTypeObject, specschema.CustomValidators{}),
 					"name",
+					nil,
 				),
 				Validators: convert.NewValidators(convert.ValidatorTypeSet, specschema.CustomValidators{}),
 			},
@@ -335,12 +343,12 @@ func TestGeneratorSetNestedAttribute_New(t *testing.T) {
 							Attributes: generatorschema.GeneratorAttributes{
 								"nested_bool": GeneratorBoolAttribute{
 									OptionalRequired: convert.NewOptionalRequired(specschema.Optional),
-									CustomType:       convert.NewCustomTypePrimitive(nil, nil, "nested_bool"),
+									CustomType:       convert.NewCustomTypePrimitive(nil, nil, "nested_bool", nil),
 									Validators:       convert.NewValidators(convert.ValidatorTypeBool, specschema.CustomValidators{}),
 								},
 							},
 							OptionalRequired: convert.NewOptionalRequired(specschema.Optional),
-							CustomType:       convert.NewCustomTypeNestedObject(nil, "nested_attribute"),
+							CustomType:       convert.NewCustomTypeNestedObject(nil, "nested_attribute", nil),
 							Validators:       convert.NewValidators(convert.ValidatorTypeObject, specschema.CustomValidators{}),
 						},
 					},
@@ -351,18 +359,19 @@ func TestGeneratorSetNestedAttribute_New(t *testing.T) {
 							Attributes: generatorschema.GeneratorAttributes{
 								"nested_bool": GeneratorBoolAttribute{
 									OptionalRequired: convert.NewOptionalRequired(specschema.Optional),
-									CustomType:       convert.NewCustomTypePrimitive(nil, nil, "nested_bool"),
+									CustomType:       convert.NewCustomTypePrimitive(nil, nil, "nested_bool", nil),
 									Validators:       convert.NewValidators(convert.ValidatorTypeBool, specschema.CustomValidators{}),
 								},
 							},
 							OptionalRequired: convert.NewOptionalRequired(specschema.Optional),
-							CustomType:       convert.NewCustomTypeNestedObject(nil, "nested_attribute"),
+							CustomType:       convert.NewCustomTypeNestedObject(nil, "nested_attribute", nil),
 							Validators:       convert.NewValidators(convert.ValidatorTypeObject, specschema.CustomValidators{}),
 						},
 					},
 					nil,
 					convert.NewValidators(convert.ValidatorTypeObject, specschema.CustomValidators{}),
 					"name",
+					nil,
 				),
 				Validators: convert.NewValidators(convert.ValidatorTypeSet, specschema.CustomValidators{}),
 			},
@@ -381,6 +390,7 @@ func TestGeneratorSetNestedAttribute_New(t *testing.T) {
 					nil,
 					convert.NewValidators(convert.ValidatorTypeObject, specschema.CustomValidators{}),
 					"name",
+					nil,
 				),
 				Validators: convert.NewValidators(convert.ValidatorTypeSet, specschema.CustomValidators{})},
 		},
@@ -398,6 +408,7 @@ func TestGeneratorSetNestedAttribute_New(t *testing.T) {
 					nil,
 					convert.NewValidators(convert.ValidatorTypeObject, specschema.CustomValidators{}),
 					"name",
+					nil,
 				),
 				Validators: convert.NewValidators(convert.ValidatorTypeSet, specschema.CustomValidators{}),
 			},
@@ -428,6 +439,7 @@ func TestGeneratorSetNestedAttribute_New(t *testing.T) {
 					nil,
 					convert.NewValidators(convert.ValidatorTypeObject, nil),
 					"name",
+					nil,
 				),
 				Validators: convert.NewValidators(convert.ValidatorTypeSet, nil),
 			},
@@ -446,6 +458,7 @@ func TestGeneratorSetNestedAttribute_New(t *testing.T) {
 					nil,
 					convert.NewValidators(convert.ValidatorTypeObject, nil),
 					"name",
+					nil,
 				),
 				Validators: convert.NewValidators(convert.ValidatorTypeSet, nil),
 			},
@@ -464,6 +477,7 @@ func TestGeneratorSetNestedAttribute_New(t *testing.T) {
 					nil,
 					convert.NewValidators(convert.ValidatorTypeObject, nil),
 					"name",
+					nil,
 				),
 				Validators: convert.NewValidators(convert.ValidatorTypeSet, nil),
 			},
@@ -481,6 +495,7 @@ func TestGeneratorSetNestedAttribute_New(t *testing.T) {
 					nil,
 					convert.NewValidators(convert.ValidatorTypeObject, nil),
 					"name",
+					nil,
 				),
 				Sensitive:  convert.NewSensitive(pointer(true)),
 				Validators: convert.NewValidators(convert.ValidatorTypeSet, nil),
@@ -510,6 +525,7 @@ func TestGeneratorSetNestedAttribute_New(t *testing.T) {
 					nil,
 					convert.NewValidators(convert.ValidatorTypeObject, nil),
 					"name",
+					nil,
 				),
 				Validators: convert.NewValidators(convert.ValidatorTypeSet, specschema.CustomValidators{
 					&specschema.CustomValidator{
@@ -530,7 +546,7 @@ func TestGeneratorSetNestedAttribute_New(t *testing.T) {
 		t.Run(name, func(t *testing.T) {
 			t.Parallel()
 
-			got, err := NewGeneratorSetNestedAttribute("name", testCase.input)
+			got, err := NewGeneratorSetNestedAttribute("name", testCase.input, nil)
 
 			if diff := cmp.Diff(err, testCase.expectedError, equateErrorMessage); diff != "" {
 				t.Errorf("unexpected error: %s", diff)
@@ -598,6 +614,7 @@ func TestGeneratorSetNestedAttribute_Imports(t *testing.T) {
 					&specschema.CustomType{},
 					convert.NewValidators(convert.ValidatorTypeObject, nil),
 					"",
+					nil,
 				),
 			},
 			expected: []code.Import{
@@ -660,6 +677,7 @@ func TestGeneratorSetNestedAttribute_Imports(t *testing.T) {
 					},
 					convert.NewValidators(convert.ValidatorTypeObject, nil),
 					"",
+					nil,
 				),
 			},
 			expected: []code.Import{
@@ -701,6 +719,7 @@ func TestGeneratorSetNestedAttribute_Imports(t *testing.T) {
 					},
 					convert.NewValidators(convert.ValidatorTypeObject, nil),
 					"",
+					nil,
 				),
 			},
 			expected: []code.Import{
@@ -733,6 +752,7 @@ func TestGeneratorSetNestedAttribute_Imports(t *testing.T) {
 					},
 					convert.NewValidators(convert.ValidatorTypeObject, nil),
 					"",
+					nil,
 				),
 			},
 			expected: []code.Import{
@@ -781,12 +801,14 @@ func TestGeneratorSetNestedAttribute_Imports(t *testing.T) {
 								convert.CustomCollectionTypeList,
 								"",
 								"",
+								nil,
 							),
 						},
 					},
 					nil,
 					convert.NewValidators(convert.ValidatorTypeObject, nil),
 					"",
+					nil,
 				),
 			},
 			expected: []code.Import{
@@ -816,6 +838,7 @@ func TestGeneratorSetNestedAttribute_Imports(t *testing.T) {
 								convert.CustomCollectionTypeList,
 								"",
 								"",
+								nil,
 							),
 							ElementTypeCollection: convert.NewElementType(specschema.ElementType{
 								Bool: &specschema.BoolType{
@@ -831,6 +854,7 @@ func TestGeneratorSetNestedAttribute_Imports(t *testing.T) {
 					nil,
 					convert.NewValidators(convert.ValidatorTypeObject, nil),
 					"",
+					nil,
 				),
 			},
 			expected: []code.Import{
@@ -885,12 +909,14 @@ func TestGeneratorSetNestedAttribute_Imports(t *testing.T) {
 								},
 								nil,
 								"",
+								nil,
 							),
 						},
 					},
 					nil,
 					convert.NewValidators(convert.ValidatorTypeObject, nil),
 					"",
+					nil,
 				),
 			},
 			expected: []code.Import{
@@ -930,12 +956,14 @@ func TestGeneratorSetNestedAttribute_Imports(t *testing.T) {
 								},
 								nil,
 								"",
+								nil,
 							),
 						},
 					},
 					nil,
 					convert.NewValidators(convert.ValidatorTypeObject, nil),
 					"",
+					nil,
 				),
 			},
 			expected: []code.Import{
@@ -1124,6 +1152,7 @@ func TestGeneratorSetNestedAttribute_Imports(t *testing.T) {
 						},
 					}),
 					"",
+					nil,
 				),
 			},
 			expected: []code.Import{
@@ -1181,6 +1210,7 @@ func TestGeneratorSetNestedAttribute_Schema(t *testing.T) {
 					nil,
 					convert.NewValidators(convert.ValidatorTypeObject, nil),
 					attributeName,
+					nil,
 				),
 			},
 			expected: `"set_nested_attribute": schema.SetNestedAttribute{
@@ -1213,6 +1243,7 @@ AttrTypes: SetNestedAttributeValue{}.AttributeTypes(ctx),
 					nil,
 					convert.NewValidators(convert.ValidatorTypeObject, nil),
 					attributeName,
+					nil,
 				),
 			},
 			expected: `"set_nested_attribute": schema.SetNestedAttribute{
@@ -1246,12 +1277,14 @@ AttrTypes: SetNestedAttributeValue{}.AttributeTypes(ctx),
 								nil,
 								convert.NewValidators(convert.ValidatorTypeObject, nil),
 								"nested_set_nested",
+								nil,
 							),
 						},
 					},
 					nil,
 					convert.NewValidators(convert.ValidatorTypeObject, nil),
 					attributeName,
+					nil,
 				),
 			},
 			expected: `"set_nested_attribute": schema.SetNestedAttribute{
@@ -1298,6 +1331,7 @@ AttrTypes: SetNestedAttributeValue{}.AttributeTypes(ctx),
 					nil,
 					convert.NewValidators(convert.ValidatorTypeObject, nil),
 					attributeName,
+					nil,
 				),
 			},
 			expected: `"set_nested_attribute": schema.SetNestedAttribute{
@@ -1329,12 +1363,13 @@ AttrTypes: SetNestedAttributeValue{}.AttributeTypes(ctx),
 									OptionalRequired: convert.NewOptionalRequired(specschema.Optional),
 								},
 							},
-							CustomType: convert.NewCustomTypeNestedObject(nil, "nested_single_nested"),
+							CustomType: convert.NewCustomTypeNestedObject(nil, "nested_single_nested", nil),
 						},
 					},
 					nil,
 					convert.NewValidators(convert.ValidatorTypeObject, nil),
 					attributeName,
+					nil,
 				),
 			},
 			expected: `"set_nested_attribute": schema.SetNestedAttribute{
@@ -1372,6 +1407,7 @@ AttrTypes: SetNestedAttributeValue{}.AttributeTypes(ctx),
 					nil,
 					convert.NewValidators(convert.ValidatorTypeObject, nil),
 					attributeName,
+					nil,
 				),
 			},
 			expected: `"set_nested_attribute": schema.SetNestedAttribute{
@@ -1396,6 +1432,7 @@ CustomType: my_custom_type,
 					nil,
 					convert.Validators{},
 					attributeName,
+					nil,
 				),
 			},
 			expected: `"set_nested_attribute": schema.SetNestedAttribute{
@@ -1420,6 +1457,7 @@ Required: true,
 					nil,
 					convert.Validators{},
 					attributeName,
+					nil,
 				),
 			},
 			expected: `"set_nested_attribute": schema.SetNestedAttribute{
@@ -1443,6 +1481,7 @@ Optional: true,
 					nil,
 					convert.Validators{},
 					attributeName,
+					nil,
 				),
 				Sensitive: convert.NewSensitive(pointer(true)),
 			},
@@ -1468,6 +1507,7 @@ Sensitive: true,
 					nil,
 					convert.Validators{},
 					attributeName,
+					nil,
 				),
 			},
 			expected: `"set_nested_attribute": schema.SetNestedAttribute{
@@ -1493,6 +1533,7 @@ MarkdownDescription: "description",
 					nil,
 					convert.Validators{},
 					attributeName,
+					nil,
 				),
 			},
 			expected: `"set_nested_attribute": schema.SetNestedAttribute{
@@ -1516,6 +1557,7 @@ DeprecationMessage: "deprecated",
 					nil,
 					convert.NewValidators(convert.ValidatorTypeObject, nil),
 					attributeName,
+					nil,
 				),
 				Validators: convert.NewValidators(convert.ValidatorTypeSet, specschema.CustomValidators{
 					&specschema.CustomValidator{
@@ -1552,6 +1594,7 @@ my_other_validator.Validate(),
 					},
 					convert.NewValidators(convert.ValidatorTypeObject, nil),
 					attributeName,
+					nil,
 				),
 			},
 			expected: `"set_nested_attribute": schema.SetNestedAttribute{
@@ -1577,6 +1620,7 @@ CustomType: my_custom_type,
 						},
 					}),
 					attributeName,
+					nil,
 				),
 			},
 			expected: `"set_nested_attribute": schema.SetNestedAttribute{
@@ -20,7 +20,7 @@ import (
 func TestGeneratorSingleNestedAttribute_New(t *testing.T) {
 	t.Parallel()
 
-	attributes, err := NewAttributes(provider.Attributes{})
+	attributes, err := NewAttributes(provider.Attributes{}, "", nil)
 
 	if err != nil {
 		t.Error(err)
@@ -61,11 +61,11 @@ func TestGeneratorSingleNestedAttribute_New(t *testing.T) {
 				Attributes: generatorschema.GeneratorAttributes{
 					"bool_attribute": GeneratorBoolAttribute{
 						OptionalRequired: convert.NewOptionalRequired(specschema.Optional),
-						CustomType:       convert.NewCustomTypePrimitive(nil, nil, "bool_attribute"),
+						CustomType:       convert.NewCustomTypePrimitive(nil, nil, "bool_attribute", nil),
 						Validators:       convert.NewValidators(convert.ValidatorTypeBool, specschema.CustomValidators{}),
 					},
 				},
-				CustomType: convert.NewCustomTypeNestedObject(nil, "name"),
+				CustomType: convert.NewCustomTypeNestedObject(nil, "name", nil),
 				Validators: convert.NewValidators(convert.ValidatorTypeObject, specschema.CustomValidators{}),
 			},
 		},
@@ -93,6 +93,7 @@ func TestGeneratorSingleNestedAttribute_New(t *testing.T) {
 							convert.CustomCollectionTypeList,
 							"types.BoolType",
 							"list_attribute",
+							nil,
 						),
 						ElementType: specschema.ElementType{
 							Bool: &specschema.BoolType{},
@@ -103,7 +104,7 @@ func TestGeneratorSingleNestedAttribute_New(t *testing.T) {
 						Validators: convert.NewValidators(convert.ValidatorTypeList, specschema.CustomValidators{}),
 					},
 				},
-				CustomType: convert.NewCustomTypeNestedObject(nil, "name"),
+				CustomType: convert.NewCustomTypeNestedObject(nil, "name", nil),
 				Validators: convert.NewValidators(convert.ValidatorTypeObject, specschema.CustomValidators{}),
 			},
 		},
@@ -135,7 +136,7 @@ func TestGeneratorSingleNestedAttribute_New(t *testing.T) {
 							Attributes: generatorschema.GeneratorAttributes{
 								"nested_bool": GeneratorBoolAttribute{
 									OptionalRequired: convert.NewOptionalRequired(specschema.Optional),
-									CustomType:       convert.NewCustomTypePrimitive(nil, nil, "nested_bool"),
+									CustomType:       convert.NewCustomTypePrimitive(nil, nil, "nested_bool", nil),
 									Validators:       convert.NewValidators(convert.ValidatorTypeBool, specschema.CustomValidators{}),
 								},
 							},
@@ -145,18 +146,19 @@ func TestGeneratorSingleNestedAttribute_New(t *testing.T) {
 							generatorschema.GeneratorAttributes{
 								"nested_bool": GeneratorBoolAttribute{
 									OptionalRequired: convert.NewOptionalRequired(specschema.Optional),
-									CustomType:       convert.NewCustomTypePrimitive(nil, nil, "nested_bool"),
+									CustomType:       convert.NewCustomTypePrimitive(nil, nil, "nested_bool", nil),
 									Validators:       convert.NewValidators(convert.ValidatorTypeBool, specschema.CustomValidators{}),
 								},
 							},
 							nil,
 							convert.NewValidators(convert.ValidatorTypeObject, specschema.CustomValidators{}),
 							"nested_attribute",
+							nil,
 						),
 						Validators: convert.NewValidators(convert.ValidatorTypeList, specschema.CustomValidators{}),
 					},
 				},
-				CustomType: convert.NewCustomTypeNestedObject(nil, "name"),
+				CustomType: convert.NewCustomTypeNestedObject(nil, "name", nil),
 				Validators: convert.NewValidators(convert.ValidatorTypeObject, specschema.CustomValidators{}),
 			},
 		},
@@ -193,11 +195,11 @@ func TestGeneratorSingleNestedAttribute_New(t *testing.T) {
 							},
 						}),
 						OptionalRequired: convert.NewOptionalRequired(specschema.Optional),
-						CustomType:       convert.NewCustomTypeObject(nil, nil, "object_attribute"),
+						CustomType:       convert.NewCustomTypeObject(nil, nil, "object_attribute", nil),
 						Validators:       convert.NewValidators(convert.ValidatorTypeObject, specschema.CustomValidators{}),
 					},
 				},
-				CustomType: convert.NewCustomTypeNestedObject(nil, "name"),
+				CustomType: convert.NewCustomTypeNestedObject(nil, "name", nil),
 				Validators: convert.NewValidators(convert.ValidatorTypeObject, specschema.CustomValidators{}),
 			},
 		},
@@ -226,16 +228,16 @@ func TestGeneratorSingleNestedAttribute_New(t *testing.T) {
 						Attributes: generatorschema.GeneratorAttributes{
 							"nested_bool": GeneratorBoolAttribute{
 								OptionalRequired: convert.NewOptionalRequired(specschema.Optional),
-								CustomType:       convert.NewCustomTypePrimitive(nil, nil, "nested_bool"),
+								CustomType:       convert.NewCustomTypePrimitive(nil, nil, "nested_bool", nil),
 								Validators:       convert.NewValidators(convert.ValidatorTypeBool, specschema.CustomValidators{}),
 							},
 						},
 						OptionalRequired: convert.NewOptionalRequired(specschema.Optional),
-						CustomType:       convert.NewCustomTypeNestedObject(nil, "nested_attribute"),
+						CustomType:       convert.NewCustomTypeNestedObject(nil, "nested_attribute", nil),
 						Validators:       convert.NewValidators(convert.ValidatorTypeObject, specschema.CustomValidators{}),
 					},
 				},
-				CustomType: convert.NewCustomTypeNestedObject(nil, "name"),
+				CustomType: convert.NewCustomTypeNestedObject(nil, "name", nil),
 				Validators: convert.NewValidators(convert.ValidatorTypeObject, nil),
 			},
 		},
@@ -245,7 +247,7 @@ func TestGeneratorSingleNestedAttribute_New(t *testing.T) {
 			},
 			expected: GeneratorSingleNestedAttribute{
 				OptionalRequired: convert.NewOptionalRequired(specschema.Optional),
-				CustomType:       convert.NewCustomTypeNestedObject(nil, "name"),
+				CustomType:       convert.NewCustomTypeNestedObject(nil, "name", nil),
 				Validators:       convert.NewValidators(convert.ValidatorTypeObject, specschema.CustomValidators{}),
 			},
 		},
@@ -255,7 +257,7 @@ func TestGeneratorSingleNestedAttribute_New(t *testing.T) {
 			},
 			expected: GeneratorSingleNestedAttribute{
 				OptionalRequired: convert.NewOptionalRequired(specschema.Required),
-				CustomType:       convert.NewCustomTypeNestedObject(nil, "name"),
+				CustomType:       convert.NewCustomTypeNestedObject(nil, "name", nil),
 				Validators:       convert.NewValidators(convert.ValidatorTypeObject, specschema.CustomValidators{}),
 			},
 		},
@@ -277,7 +279,7 @@ func TestGeneratorSingleNestedAttribute_New(t *testing.T) {
 					},
 					Type:      "my_type",
 					ValueType: "myvalue_type",
-				}, "name"),
+				}, "name", nil),
 				Validators: convert.NewValidators(convert.ValidatorTypeObject, specschema.CustomValidators{}),
 			},
 		},
@@ -287,7 +289,7 @@ func TestGeneratorSingleNestedAttribute_New(t *testing.T) {
 			},
 			expected: GeneratorSingleNestedAttribute{
 				Attributes:         attributes,
-				CustomType:         convert.NewCustomTypeNestedObject(nil, "name"),
+				CustomType:         convert.NewCustomTypeNestedObject(nil, "name", nil),
 				DeprecationMessage: convert.NewDeprecationMessage(pointer("deprecation message")),
 				Validators:         convert.NewValidators(convert.ValidatorTypeObject, specschema.CustomValidators{}),
 			},
@@ -298,7 +300,7 @@ func TestGeneratorSingleNestedAttribute_New(t *testing.T) {
 			},
 			expected: GeneratorSingleNestedAttribute{
 				Attributes:  attributes,
-				CustomType:  convert.NewCustomTypeNestedObject(nil, "name"),
+				CustomType:  convert.NewCustomTypeNestedObject(nil, "name", nil),
 				Description: convert.NewDescription(pointer("description")),
 				Validators:  convert.NewValidators(convert.ValidatorTypeObject, specschema.CustomValidators{}),
 			},
@@ -309,7 +311,7 @@ func TestGeneratorSingleNestedAttribute_New(t *testing.T) {
 			},
 			expected: GeneratorSingleNestedAttribute{
 				Attributes: attributes,
-				CustomType: convert.NewCustomTypeNestedObject(nil, "name"),
+				CustomType: convert.NewCustomTypeNestedObject(nil, "name", nil),
 				Sensitive:  convert.NewSensitive(pointer(true)),
 				Validators: convert.NewValidators(convert.ValidatorTypeObject, specschema.CustomValidators{}),
 			},
@@ -331,7 +333,7 @@ func TestGeneratorSingleNestedAttribute_New(t *testing.T) {
 			},
 			expected: GeneratorSingleNestedAttribute{
 				Attributes: attributes,
-				CustomType: convert.NewCustomTypeNestedObject(nil, "name"),
+				CustomType: convert.NewCustomTypeNestedObject(nil, "name", nil),
 				Validators: convert.NewValidators(convert.ValidatorTypeObject, specschema.CustomValidators{
 					&specschema.CustomValidator{
 						Imports: []code.Import{
@@ -351,7 +353,7 @@ func TestGeneratorSingleNestedAttribute_New(t *testing.T) {
 		t.Run(name, func(t *testing.T) {
 			t.Parallel()
 
-			got, err := NewGeneratorSingleNestedAttribute("name", testCase.input)
+			got, err := NewGeneratorSingleNestedAttribute("name", testCase.input, nil)
 
 			if diff := cmp.Diff(err, testCase.expectedError, equateErrorMessage); diff != "" {
 				t.Errorf("unexpected error: %s", diff)
@@ -386,6 +388,7 @@ func TestGeneratorSingleNestedAttribute_Imports(t *testing.T) {
 				CustomType: convert.NewCustomTypeNestedObject(
 					&specschema.CustomType{},
 					"",
+					nil,
 				),
 			},
 			expected: []code.Import{
@@ -403,6 +406,7 @@ func TestGeneratorSingleNestedAttribute_Imports(t *testing.T) {
 						},
 					},
 					"",
+					nil,
 				),
 			},
 			expected: []code.Import{
@@ -420,6 +424,7 @@ func TestGeneratorSingleNestedAttribute_Imports(t *testing.T) {
 						},
 					},
 					"",
+					nil,
 				),
 			},
 			expected: []code.Import{
@@ -464,6 +469,7 @@ func TestGeneratorSingleNestedAttribute_Imports(t *testing.T) {
 							convert.CustomCollectionTypeList,
 							"",
 							"",
+							nil,
 						),
 					},
 				},
@@ -494,6 +500,7 @@ func TestGeneratorSingleNestedAttribute_Imports(t *testing.T) {
 							convert.CustomCollectionTypeList,
 							"",
 							"",
+							nil,
 						),
 						ElementTypeCollection: convert.NewElementType(specschema.ElementType{
 							Bool: &specschema.BoolType{
@@ -556,6 +563,7 @@ func TestGeneratorSingleNestedAttribute_Imports(t *testing.T) {
 							},
 							nil,
 							"",
+							nil,
 						),
 					},
 				},
@@ -596,6 +604,7 @@ func TestGeneratorSingleNestedAttribute_Imports(t *testing.T) {
 							},
 							nil,
 							"",
+							nil,
 						),
 					},
 				},
@@ -746,7 +755,7 @@ func TestGeneratorSingleNestedAttribute_Schema(t *testing.T) {
 						OptionalRequired: convert.NewOptionalRequired(specschema.Optional),
 					},
 				},
-				CustomType: convert.NewCustomTypeNestedObject(nil, attributeName),
+				CustomType: convert.NewCustomTypeNestedObject(nil, attributeName, nil),
 			},
 			expected: `"single_nested_attribute": schema.SingleNestedAttribute{
 Attributes: map[string]schema.Attribute{
@@ -772,7 +781,7 @@ AttrTypes: SingleNestedAttributeValue{}.AttributeTypes(ctx),
 						}),
 					},
 				},
-				CustomType: convert.NewCustomTypeNestedObject(nil, attributeName),
+				CustomType: convert.NewCustomTypeNestedObject(nil, attributeName, nil),
 			},
 			expected: `"single_nested_attribute": schema.SingleNestedAttribute{
 Attributes: map[string]schema.Attribute{
@@ -802,10 +811,11 @@ AttrTypes: SingleNestedAttributeValue{}.AttributeTypes(ctx),
 							nil,
 							convert.NewValidators(convert.ValidatorTypeObject, nil),
 							"nested_list_nested",
+							nil,
 						),
 					},
 				},
-				CustomType: convert.NewCustomTypeNestedObject(nil, attributeName),
+				CustomType: convert.NewCustomTypeNestedObject(nil, attributeName, nil),
 			},
 			expected: `"single_nested_attribute": schema.SingleNestedAttribute{
 Attributes: map[string]schema.Attribute{
@@ -845,7 +855,7 @@ AttrTypes: SingleNestedAttributeValue{}.AttributeTypes(ctx),
 						OptionalRequired: convert.NewOptionalRequired(specschema.Optional),
 					},
 				},
-				CustomType: convert.NewCustomTypeNestedObject(nil, attributeName),
+				CustomType: convert.NewCustomTypeNestedObject(nil, attributeName, nil),
 			},
 			expected: `"single_nested_attribute": schema.SingleNestedAttribute{
 Attributes: map[string]schema.Attribute{
@@ -873,10 +883,10 @@ AttrTypes: SingleNestedAttributeValue{}.AttributeTypes(ctx),
 								OptionalRequired: convert.NewOptionalRequired(specschema.Optional),
 							},
 						},
-						CustomType: convert.NewCustomTypeNestedObject(nil, "nested_single_nested"),
+						CustomType: convert.NewCustomTypeNestedObject(nil, "nested_single_nested", nil),
 					},
 				},
-				CustomType: convert.NewCustomTypeNestedObject(nil, attributeName),
+				CustomType: convert.NewCustomTypeNestedObject(nil, attributeName, nil),
 			},
 			expected: `"single_nested_attribute": schema.SingleNestedAttribute{
 Attributes: map[string]schema.Attribute{
@@ -905,7 +915,7 @@ AttrTypes: SingleNestedAttributeValue{}.AttributeTypes(ctx),
 			input: GeneratorSingleNestedAttribute{
 				CustomType: convert.NewCustomTypeNestedObject(&specschema.CustomType{
 					Type: "my_custom_type",
-				}, attributeName),
+				}, attributeName, nil),
 			},
 			expected: `"single_nested_attribute": schema.SingleNestedAttribute{
 Attributes: map[string]schema.Attribute{
@@ -917,7 +927,7 @@ CustomType: my_custom_type,
 		"required": {
 			input: GeneratorSingleNestedAttribute{
 				OptionalRequired: convert.NewOptionalRequired(specschema.Required),
-				CustomType:       convert.NewCustomTypeNestedObject(nil, attributeName),
+				CustomType:       convert.NewCustomTypeNestedObject(nil, attributeName, nil),
 			},
 			expected: `"single_nested_attribute": schema.SingleNestedAttribute{
 Attributes: map[string]schema.Attribute{
@@ -934,7 +944,7 @@ Required: true,
 		"optional": {
 			input: GeneratorSingleNestedAttribute{
 				OptionalRequired: convert.NewOptionalRequired(specschema.Optional),
-				CustomType:       convert.NewCustomTypeNestedObject(nil, attributeName),
+				CustomType:       convert.NewCustomTypeNestedObject(nil, attributeName, nil),
 			},
 			expected: `"single_nested_attribute": schema.SingleNestedAttribute{
 Attributes: map[string]schema.Attribute{
@@ -950,7 +960,7 @@ Optional: true,
 
 		"sensitive": {
 			input: GeneratorSingleNestedAttribute{
-				CustomType: convert.NewCustomTypeNestedObject(nil, attributeName),
+				CustomType: convert.NewCustomTypeNestedObject(nil, attributeName, nil),
 				Sensitive:  convert.NewSensitive(pointer(true)),
 			},
 			expected: `"single_nested_attribute": schema.SingleNestedAttribute{
@@ -967,7 +977,7 @@ Sensitive: true,
 
 		"description": {
 			input: GeneratorSingleNestedAttribute{
-				CustomType:  convert.NewCustomTypeNestedObject(nil, attributeName),
+				CustomType:  convert.NewCustomTypeNestedObject(nil, attributeName, nil),
 				Description: convert.NewDescription(pointer("description")),
 			},
 			expected: `"single_nested_attribute": schema.SingleNestedAttribute{
@@ -985,7 +995,7 @@ MarkdownDescription: "description",
 
 		"deprecation-message": {
 			input: GeneratorSingleNestedAttribute{
-				CustomType:         convert.NewCustomTypeNestedObject(nil, attributeName),
+				CustomType:         convert.NewCustomTypeNestedObject(nil, attributeName, nil),
 				DeprecationMessage: convert.NewDeprecationMessage(pointer("deprecated")),
 			},
 			expected: `"single_nested_attribute": schema.SingleNestedAttribute{
@@ -1002,7 +1012,7 @@ DeprecationMessage: "deprecated",
 
 		"validators": {
 			input: GeneratorSingleNestedAttribute{
-				CustomType: convert.NewCustomTypeNestedObject(nil, attributeName),
+				CustomType: convert.NewCustomTypeNestedObject(nil, attributeName, nil),
 				Validators: convert.NewValidators(convert.ValidatorTypeObject, specschema.CustomValidators{
 					&specschema.CustomValidator{
 						SchemaDefinition: "my_validator.Validate()",
@@ -1068,6 +1078,7 @@ func TestGeneratorSingleNestedAttribute_ModelField(t *testing.T) {
 						ValueType: "my_custom_value_type",
 					},
 					"single_nested_attribute",
+					nil,
 				),
 			},
 			expected: model.Field{
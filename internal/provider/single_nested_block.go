@@ -25,20 +25,22 @@ type GeneratorSingleNestedBlock struct {
 	Description            convert.Description
 	Sensitive              convert.Sensitive
 	Validators             convert.Validators
+
+	options *schema.Options
 }
 
-func NewGeneratorSingleNestedBlock(name string, b *provider.SingleNestedBlock) (GeneratorSingleNestedBlock, error) {
+func NewGeneratorSingleNestedBlock(name string, b *provider.SingleNestedBlock, opts *schema.Options) (GeneratorSingleNestedBlock, error) {
 	if b == nil {
 		return GeneratorSingleNestedBlock{}, fmt.Errorf("*provider.SingleNestedBlock is nil")
 	}
 
-	attributes, err := NewAttributes(b.Attributes)
+	attributes, err := NewAttributes(b.Attributes, name, opts)
 
 	if err != nil {
 		return GeneratorSingleNestedBlock{}, err
 	}
 
-	blocks, err := NewBlocks(b.Blocks)
+	blocks, err := NewBlocks(b.Blocks, name, opts)
 
 	if err != nil {
 		return GeneratorSingleNestedBlock{}, err
@@ -46,7 +48,7 @@ func NewGeneratorSingleNestedBlock(name string, b *provider.SingleNestedBlock) (
 
 	c := convert.NewOptionalRequired(b.OptionalRequired)
 
-	ct := convert.NewCustomTypeNestedObject(b.CustomType, name)
+	ct := convert.NewCustomTypeNestedObject(b.CustomType, name, opts)
 
 	d := convert.NewDescription(b.Description)
 
@@ -57,7 +59,7 @@ func NewGeneratorSingleNestedBlock(name string, b *provider.SingleNestedBlock) (
 	v := convert.NewValidators(convert.ValidatorTypeObject, b.Validators.CustomValidators())
 
 	return GeneratorSingleNestedBlock{
-		AssociatedExternalType: schema.NewAssocExtType(b.AssociatedExternalType),
+		AssociatedExternalType: schema.NewAssocExtType(b.AssociatedExternalType, opts),
 		Attributes:             attributes,
 		Blocks:                 blocks,
 		OptionalRequired:       c,
@@ -66,6 +68,7 @@ func NewGeneratorSingleNestedBlock(name string, b *provider.SingleNestedBlock) (
 		Description:            d,
 		Sensitive:              s,
 		Validators:             v,
+		options:                opts,
 	}, nil
 }
 
@@ -172,9 +175,9 @@ func (g GeneratorSingleNestedBlock) Schema(name schema.FrameworkIdentifier) (str
 
 func (g GeneratorSingleNestedBlock) ModelField(name schema.FrameworkIdentifier) (model.Field, error) {
 	f := model.Field{
-		Name:      name.ToPascalCase(),
+		Name:      g.options.ToPascalCase(name),
 		TfsdkName: name.ToString(),
-		ValueType: name.ToPascalCase() + "Value",
+		ValueType: g.options.TypeReference(string(name)) + "Value",
 	}
 
 	customValueType := g.CustomType.ValueType()
@@ -197,7 +200,7 @@ func (g GeneratorSingleNestedBlock) GetBlocks() schema.GeneratorBlocks {
 func (g GeneratorSingleNestedBlock) CustomTypeAndValue(name string) ([]byte, error) {
 	var buf bytes.Buffer
 
-	attributeAttrValues, err := g.Attributes.AttrValues()
+	attributeAttrValues, err := g.Attributes.AttrValues(name, g.options)
 
 	if err != nil {
 		return nil, err
@@ -219,7 +222,7 @@ func (g GeneratorSingleNestedBlock) CustomTypeAndValue(name string) ([]byte, err
 		attributesBlocksAttrValues[k] = v
 	}
 
-	objectType := schema.NewCustomNestedObjectType(name, attributesBlocksAttrValues)
+	objectType := schema.NewCustomNestedObjectType(name, attributesBlocksAttrValues, g.options)
 
 	b, err := objectType.Render()
 
@@ -251,13 +254,13 @@ func (g GeneratorSingleNestedBlock) CustomTypeAndValue(name string) ([]byte, err
 		attributesBlocksTypes[k] = v
 	}
 
-	attributeAttrTypes, err := g.Attributes.AttrTypes()
+	attributeAttrTypes, err := g.Attributes.AttrTypes(name, g.options)
 
 	if err != nil {
 		return nil, err
 	}
 
-	blockAttrTypes, err := g.Blocks.AttrTypes()
+	blockAttrTypes, err := g.Blocks.AttrTypes(name, g.options)
 
 	if err != nil {
 		return nil, err
@@ -280,7 +283,7 @@ func (g GeneratorSingleNestedBlock) CustomTypeAndValue(name string) ([]byte, err
 		return nil, err
 	}
 
-	objectValue := schema.NewCustomNestedObjectValue(name, attributesBlocksTypes, attributesBlocksAttrTypes, attributesBlocksAttrValues, attributeCollectionTypes)
+	objectValue := schema.NewCustomNestedObjectValue(name, attributesBlocksTypes, attributesBlocksAttrTypes, attributesBlocksAttrValues, attributeCollectionTypes, g.options)
 
 	b, err = objectValue.Render()
 
@@ -298,7 +301,7 @@ func (g GeneratorSingleNestedBlock) CustomTypeAndValue(name string) ([]byte, err
 	// CustomTypeAndValue interface (i.e, nested attributes).
 	for _, k := range attributeKeys {
 		if c, ok := g.Attributes[k].(schema.CustomTypeAndValue); ok {
-			b, err := c.CustomTypeAndValue(k)
+			b, err := g.options.NestedCustomTypeAndValue(c, g.options.NestedTypeName(name, k))
 
 			if err != nil {
 				return nil, err
@@ -310,7 +313,7 @@ func (g GeneratorSingleNestedBlock) CustomTypeAndValue(name string) ([]byte, err
 
 	for _, k := range blockKeys {
 		if c, ok := g.Blocks[k].(schema.CustomTypeAndValue); ok {
-			b, err := c.CustomTypeAndValue(k)
+			b, err := g.options.NestedCustomTypeAndValue(c, g.options.NestedTypeName(name, k))
 
 			if err != nil {
 				return nil, err
@@ -323,6 +326,10 @@ func (g GeneratorSingleNestedBlock) CustomTypeAndValue(name string) ([]byte, err
 	return buf.Bytes(), nil
 }
 
+func (g GeneratorSingleNestedBlock) GetAssociatedExternalType() *schema.AssocExtType {
+	return g.AssociatedExternalType
+}
+
 func (g GeneratorSingleNestedBlock) ToFromFunctions(name string) ([]byte, error) {
 	if g.AssociatedExternalType == nil {
 		return nil, nil
@@ -338,7 +345,7 @@ func (g GeneratorSingleNestedBlock) ToFromFunctions(name string) ([]byte, error)
 
 	fromFuncs, _ := g.Attributes.FromFuncs()
 
-	toFrom := schema.NewToFromNestedObject(name, g.AssociatedExternalType, toFuncs, fromFuncs)
+	toFrom := schema.NewToFromNestedObject(name, g.AssociatedExternalType, toFuncs, fromFuncs, g.options)
 
 	b, err := toFrom.Render()
 
@@ -354,7 +361,7 @@ func (g GeneratorSingleNestedBlock) ToFromFunctions(name string) ([]byte, error)
 	// ToFrom interface.
 	for _, k := range attributeKeys {
 		if c, ok := g.Attributes[k].(schema.ToFrom); ok {
-			b, err := c.ToFromFunctions(k)
+			b, err := g.options.NestedToFromFunctions(c, g.options.NestedTypeName(name, k))
 
 			if err != nil {
 				return nil, err
@@ -374,3 +381,26 @@ func (g GeneratorSingleNestedBlock) To() (schema.ToFromConversion, error) {
 func (g GeneratorSingleNestedBlock) From() (schema.ToFromConversion, error) {
 	return schema.ToFromConversion{}, schema.NewUnimplementedError(errors.New("single nested type is not yet implemented"))
 }
+
+// ModelToFrom returns the conversion used for the block when it is a field of the
+// top-level data model, in which case the field is a custom value type which
+// implements conversion to and from the associated external type.
+func (g GeneratorSingleNestedBlock) ModelToFrom() (schema.ToFromConversion, error) {
+	if g.AssociatedExternalType == nil {
+		return schema.ToFromConversion{}, schema.NewUnimplementedError(errors.New("single nested type without an associated external type is not yet implemented"))
+	}
+
+	return schema.ToFromConversion{
+		AssocExtType: g.AssociatedExternalType,
+	}, nil
+}
+
+func (g GeneratorSingleNestedBlock) Properties() schema.FieldProperties {
+	return schema.FieldProperties{
+		Optional:           g.OptionalRequired.IsOptional(),
+		Required:           g.OptionalRequired.IsRequired(),
+		Sensitive:          g.Sensitive.IsSensitive(),
+		Description:        g.Description.Description(),
+		DeprecationMessage: g.DeprecationMessage.DeprecationMessage(),
+	}
+}
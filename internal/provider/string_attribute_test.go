@@ -33,7 +33,7 @@ func TestGeneratorStringAttribute_New(t *testing.T) {
 			},
 			expected: GeneratorStringAttribute{
 				OptionalRequired: convert.NewOptionalRequired(specschema.Optional),
-				CustomType:       convert.NewCustomTypePrimitive(nil, nil, "name"),
+				CustomType:       convert.NewCustomTypePrimitive(nil, nil, "name", nil),
 				Validators:       convert.NewValidators(convert.ValidatorTypeString, specschema.CustomValidators{}),
 			},
 		},
@@ -43,7 +43,7 @@ func TestGeneratorStringAttribute_New(t *testing.T) {
 			},
 			expected: GeneratorStringAttribute{
 				OptionalRequired: convert.NewOptionalRequired(specschema.Required),
-				CustomType:       convert.NewCustomTypePrimitive(nil, nil, "name"),
+				CustomType:       convert.NewCustomTypePrimitive(nil, nil, "name", nil),
 				Validators:       convert.NewValidators(convert.ValidatorTypeString, specschema.CustomValidators{}),
 			},
 		},
@@ -64,7 +64,7 @@ func TestGeneratorStringAttribute_New(t *testing.T) {
 					},
 					Type:      "my_type",
 					ValueType: "myvalue_type",
-				}, nil, "name"),
+				}, nil, "name", nil),
 				Validators: convert.NewValidators(convert.ValidatorTypeString, nil),
 			},
 		},
@@ -73,7 +73,7 @@ func TestGeneratorStringAttribute_New(t *testing.T) {
 				DeprecationMessage: pointer("deprecation message"),
 			},
 			expected: GeneratorStringAttribute{
-				CustomType:         convert.NewCustomTypePrimitive(nil, nil, "name"),
+				CustomType:         convert.NewCustomTypePrimitive(nil, nil, "name", nil),
 				DeprecationMessage: convert.NewDeprecationMessage(pointer("deprecation message")),
 				Validators:         convert.NewValidators(convert.ValidatorTypeString, specschema.CustomValidators{}),
 			},
@@ -83,7 +83,7 @@ func TestGeneratorStringAttribute_New(t *testing.T) {
 				Description: pointer("description"),
 			},
 			expected: GeneratorStringAttribute{
-				CustomType:  convert.NewCustomTypePrimitive(nil, nil, "name"),
+				CustomType:  convert.NewCustomTypePrimitive(nil, nil, "name", nil),
 				Description: convert.NewDescription(pointer("description")),
 				Validators:  convert.NewValidators(convert.ValidatorTypeString, specschema.CustomValidators{}),
 			},
@@ -93,7 +93,7 @@ func TestGeneratorStringAttribute_New(t *testing.T) {
 				Sensitive: pointer(true),
 			},
 			expected: GeneratorStringAttribute{
-				CustomType: convert.NewCustomTypePrimitive(nil, nil, "name"),
+				CustomType: convert.NewCustomTypePrimitive(nil, nil, "name", nil),
 				Sensitive:  convert.NewSensitive(pointer(true)),
 				Validators: convert.NewValidators(convert.ValidatorTypeString, specschema.CustomValidators{}),
 			},
@@ -114,7 +114,7 @@ func TestGeneratorStringAttribute_New(t *testing.T) {
 				},
 			},
 			expected: GeneratorStringAttribute{
-				CustomType: convert.NewCustomTypePrimitive(nil, nil, "name"),
+				CustomType: convert.NewCustomTypePrimitive(nil, nil, "name", nil),
 				Validators: convert.NewValidators(convert.ValidatorTypeString, specschema.CustomValidators{
 					&specschema.CustomValidator{
 						Imports: []code.Import{
@@ -134,7 +134,7 @@ func TestGeneratorStringAttribute_New(t *testing.T) {
 		t.Run(name, func(t *testing.T) {
 			t.Parallel()
 
-			got, err := NewGeneratorStringAttribute("name", testCase.input)
+			got, err := NewGeneratorStringAttribute("name", testCase.input, nil)
 
 			if diff := cmp.Diff(err, testCase.expectedError, equateErrorMessage); diff != "" {
 				t.Errorf("unexpected error: %s", diff)
@@ -163,6 +163,7 @@ func TestGeneratorStringAttribute_Schema(t *testing.T) {
 					},
 					nil,
 					"string_attribute",
+					nil,
 				),
 			},
 			expected: `"string_attribute": schema.StringAttribute{
@@ -178,6 +179,7 @@ CustomType: my_custom_type,
 						Type: "*api.ExtString",
 					},
 					"string_attribute",
+					nil,
 				),
 			},
 			expected: `"string_attribute": schema.StringAttribute{
@@ -195,6 +197,7 @@ CustomType: StringAttributeType{},
 						Type: "*api.ExtString",
 					},
 					"string_attribute",
+					nil,
 				),
 			},
 			expected: `"string_attribute": schema.StringAttribute{
@@ -316,6 +319,7 @@ func TestGeneratorStringAttribute_ModelField(t *testing.T) {
 					},
 					nil,
 					"",
+					nil,
 				),
 			},
 			expected: model.Field{
@@ -332,6 +336,7 @@ func TestGeneratorStringAttribute_ModelField(t *testing.T) {
 						Type: "*api.StringAttribute",
 					},
 					"string_attribute",
+					nil,
 				),
 			},
 			expected: model.Field{
@@ -350,6 +355,7 @@ func TestGeneratorStringAttribute_ModelField(t *testing.T) {
 						Type: "*api.StringAttribute",
 					},
 					"",
+					nil,
 				),
 			},
 			expected: model.Field{
@@ -24,16 +24,18 @@ type GeneratorBoolAttribute struct {
 	PlanModifiers            convert.PlanModifiers
 	Sensitive                convert.Sensitive
 	Validators               convert.Validators
+
+	options *generatorschema.Options
 }
 
-func NewGeneratorBoolAttribute(name string, a *resource.BoolAttribute) (GeneratorBoolAttribute, error) {
+func NewGeneratorBoolAttribute(name string, a *resource.BoolAttribute, opts *generatorschema.Options) (GeneratorBoolAttribute, error) {
 	if a == nil {
 		return GeneratorBoolAttribute{}, fmt.Errorf("*resource.BoolAttribute is nil")
 	}
 
 	c := convert.NewComputedOptionalRequired(a.ComputedOptionalRequired)
 
-	ctp := convert.NewCustomTypePrimitive(a.CustomType, a.AssociatedExternalType, name)
+	ctp := convert.NewCustomTypePrimitive(a.CustomType, a.AssociatedExternalType, name, opts)
 
 	db := convert.NewDefaultBool(a.Default)
 
@@ -48,7 +50,7 @@ func NewGeneratorBoolAttribute(name string, a *resource.BoolAttribute) (Generato
 	v := convert.NewValidators(convert.ValidatorTypeBool, a.Validators.CustomValidators())
 
 	return GeneratorBoolAttribute{
-		AssociatedExternalType:   generatorschema.NewAssocExtType(a.AssociatedExternalType),
+		AssociatedExternalType:   generatorschema.NewAssocExtType(a.AssociatedExternalType, opts),
 		ComputedOptionalRequired: c,
 		CustomType:               ctp,
 		Default:                  db,
@@ -57,6 +59,7 @@ func NewGeneratorBoolAttribute(name string, a *resource.BoolAttribute) (Generato
 		PlanModifiers:            pm,
 		Sensitive:                s,
 		Validators:               v,
+		options:                  opts,
 	}, nil
 }
 
@@ -145,7 +148,7 @@ func (g GeneratorBoolAttribute) Schema(name generatorschema.FrameworkIdentifier)
 
 func (g GeneratorBoolAttribute) ModelField(name generatorschema.FrameworkIdentifier) (model.Field, error) {
 	field := model.Field{
-		Name:      name.ToPascalCase(),
+		Name:      g.options.ToPascalCase(name),
 		TfsdkName: name.ToString(),
 		ValueType: model.BoolValueType,
 	}
@@ -166,7 +169,7 @@ func (g GeneratorBoolAttribute) CustomTypeAndValue(name string) ([]byte, error)
 
 	var buf bytes.Buffer
 
-	boolType := generatorschema.NewCustomBoolType(name)
+	boolType := generatorschema.NewCustomBoolType(name, g.options)
 
 	b, err := boolType.Render()
 
@@ -176,7 +179,7 @@ func (g GeneratorBoolAttribute) CustomTypeAndValue(name string) ([]byte, error)
 
 	buf.Write(b)
 
-	boolValue := generatorschema.NewCustomBoolValue(name)
+	boolValue := generatorschema.NewCustomBoolValue(name, g.options)
 
 	b, err = boolValue.Render()
 
@@ -189,12 +192,16 @@ func (g GeneratorBoolAttribute) CustomTypeAndValue(name string) ([]byte, error)
 	return buf.Bytes(), nil
 }
 
+func (g GeneratorBoolAttribute) GetAssociatedExternalType() *generatorschema.AssocExtType {
+	return g.AssociatedExternalType
+}
+
 func (g GeneratorBoolAttribute) ToFromFunctions(name string) ([]byte, error) {
 	if g.AssociatedExternalType == nil {
 		return nil, nil
 	}
 
-	toFrom := generatorschema.NewToFromBool(name, g.AssociatedExternalType)
+	toFrom := generatorschema.NewToFromBool(name, g.AssociatedExternalType, g.options)
 
 	b, err := toFrom.Render()
 
@@ -208,7 +215,7 @@ func (g GeneratorBoolAttribute) ToFromFunctions(name string) ([]byte, error) {
 // AttrType returns a string representation of a basetypes.BoolTypable type.
 func (g GeneratorBoolAttribute) AttrType(name generatorschema.FrameworkIdentifier) (string, error) {
 	if g.AssociatedExternalType != nil {
-		return fmt.Sprintf("%sType{}", name.ToPascalCase()), nil
+		return fmt.Sprintf("%sType{}", g.options.ToPascalCase(name)), nil
 	}
 
 	return "basetypes.BoolType{}", nil
@@ -217,7 +224,7 @@ func (g GeneratorBoolAttribute) AttrType(name generatorschema.FrameworkIdentifie
 // AttrValue returns a string representation of a basetypes.BoolValuable type.
 func (g GeneratorBoolAttribute) AttrValue(name generatorschema.FrameworkIdentifier) string {
 	if g.AssociatedExternalType != nil {
-		return fmt.Sprintf("%sValue", name.ToPascalCase())
+		return fmt.Sprintf("%sValue", g.options.ToPascalCase(name))
 	}
 
 	return "basetypes.BoolValue"
@@ -246,3 +253,15 @@ func (g GeneratorBoolAttribute) From() (generatorschema.ToFromConversion, error)
 		Default: "BoolPointerValue",
 	}, nil
 }
+
+func (g GeneratorBoolAttribute) Properties() generatorschema.FieldProperties {
+	return generatorschema.FieldProperties{
+		Computed:           g.ComputedOptionalRequired.IsComputed(),
+		Optional:           g.ComputedOptionalRequired.IsOptional(),
+		Required:           g.ComputedOptionalRequired.IsRequired(),
+		Sensitive:          g.Sensitive.IsSensitive(),
+		Description:        g.Description.Description(),
+		DeprecationMessage: g.DeprecationMessage.DeprecationMessage(),
+		StaticDefault:      g.Default.Static(),
+	}
+}
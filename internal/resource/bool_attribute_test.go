@@ -34,7 +34,7 @@ func TestGeneratorBoolAttribute_New(t *testing.T) {
 			},
 			expected: GeneratorBoolAttribute{
 				ComputedOptionalRequired: convert.NewComputedOptionalRequired(specschema.Computed),
-				CustomType:               convert.NewCustomTypePrimitive(nil, nil, "name"),
+				CustomType:               convert.NewCustomTypePrimitive(nil, nil, "name", nil),
 				PlanModifiers:            convert.NewPlanModifiers(convert.PlanModifierTypeBool, specschema.CustomPlanModifiers{}),
 				Validators:               convert.NewValidators(convert.ValidatorTypeBool, specschema.CustomValidators{}),
 			},
@@ -45,7 +45,7 @@ func TestGeneratorBoolAttribute_New(t *testing.T) {
 			},
 			expected: GeneratorBoolAttribute{
 				ComputedOptionalRequired: convert.NewComputedOptionalRequired(specschema.ComputedOptional),
-				CustomType:               convert.NewCustomTypePrimitive(nil, nil, "name"),
+				CustomType:               convert.NewCustomTypePrimitive(nil, nil, "name", nil),
 				PlanModifiers:            convert.NewPlanModifiers(convert.PlanModifierTypeBool, specschema.CustomPlanModifiers{}),
 				Validators:               convert.NewValidators(convert.ValidatorTypeBool, specschema.CustomValidators{}),
 			},
@@ -56,7 +56,7 @@ func TestGeneratorBoolAttribute_New(t *testing.T) {
 			},
 			expected: GeneratorBoolAttribute{
 				ComputedOptionalRequired: convert.NewComputedOptionalRequired(specschema.Optional),
-				CustomType:               convert.NewCustomTypePrimitive(nil, nil, "name"),
+				CustomType:               convert.NewCustomTypePrimitive(nil, nil, "name", nil),
 				PlanModifiers:            convert.NewPlanModifiers(convert.PlanModifierTypeBool, specschema.CustomPlanModifiers{}),
 				Validators:               convert.NewValidators(convert.ValidatorTypeBool, specschema.CustomValidators{}),
 			},
@@ -67,7 +67,7 @@ func TestGeneratorBoolAttribute_New(t *testing.T) {
 			},
 			expected: GeneratorBoolAttribute{
 				ComputedOptionalRequired: convert.NewComputedOptionalRequired(specschema.Required),
-				CustomType:               convert.NewCustomTypePrimitive(nil, nil, "name"),
+				CustomType:               convert.NewCustomTypePrimitive(nil, nil, "name", nil),
 				PlanModifiers:            convert.NewPlanModifiers(convert.PlanModifierTypeBool, specschema.CustomPlanModifiers{}),
 				Validators:               convert.NewValidators(convert.ValidatorTypeBool, specschema.CustomValidators{}),
 			},
@@ -89,7 +89,7 @@ func TestGeneratorBoolAttribute_New(t *testing.T) {
 					},
 					Type:      "my_type",
 					ValueType: "myvalue_type",
-				}, nil, "name"),
+				}, nil, "name", nil),
 				PlanModifiers: convert.NewPlanModifiers(convert.PlanModifierTypeBool, nil),
 				Validators:    convert.NewValidators(convert.ValidatorTypeBool, nil),
 			},
@@ -99,7 +99,7 @@ func TestGeneratorBoolAttribute_New(t *testing.T) {
 				DeprecationMessage: pointer("deprecation message"),
 			},
 			expected: GeneratorBoolAttribute{
-				CustomType:         convert.NewCustomTypePrimitive(nil, nil, "name"),
+				CustomType:         convert.NewCustomTypePrimitive(nil, nil, "name", nil),
 				DeprecationMessage: convert.NewDeprecationMessage(pointer("deprecation message")),
 				PlanModifiers:      convert.NewPlanModifiers(convert.PlanModifierTypeBool, specschema.CustomPlanModifiers{}),
 				Validators:         convert.NewValidators(convert.ValidatorTypeBool, specschema.CustomValidators{}),
@@ -110,7 +110,7 @@ func TestGeneratorBoolAttribute_New(t *testing.T) {
 				Description: pointer("description"),
 			},
 			expected: GeneratorBoolAttribute{
-				CustomType:    convert.NewCustomTypePrimitive(nil, nil, "name"),
+				CustomType:    convert.NewCustomTypePrimitive(nil, nil, "name", nil),
 				Description:   convert.NewDescription(pointer("description")),
 				PlanModifiers: convert.NewPlanModifiers(convert.PlanModifierTypeBool, specschema.CustomPlanModifiers{}),
 				Validators:    convert.NewValidators(convert.ValidatorTypeBool, specschema.CustomValidators{}),
@@ -121,7 +121,7 @@ func TestGeneratorBoolAttribute_New(t *testing.T) {
 				Sensitive: pointer(true),
 			},
 			expected: GeneratorBoolAttribute{
-				CustomType:    convert.NewCustomTypePrimitive(nil, nil, "name"),
+				CustomType:    convert.NewCustomTypePrimitive(nil, nil, "name", nil),
 				PlanModifiers: convert.NewPlanModifiers(convert.PlanModifierTypeBool, specschema.CustomPlanModifiers{}),
 				Sensitive:     convert.NewSensitive(pointer(true)),
 				Validators:    convert.NewValidators(convert.ValidatorTypeBool, specschema.CustomValidators{}),
@@ -143,7 +143,7 @@ func TestGeneratorBoolAttribute_New(t *testing.T) {
 				},
 			},
 			expected: GeneratorBoolAttribute{
-				CustomType:    convert.NewCustomTypePrimitive(nil, nil, "name"),
+				CustomType:    convert.NewCustomTypePrimitive(nil, nil, "name", nil),
 				PlanModifiers: convert.NewPlanModifiers(convert.PlanModifierTypeBool, nil),
 				Validators: convert.NewValidators(convert.ValidatorTypeBool, specschema.CustomValidators{
 					&specschema.CustomValidator{
@@ -173,7 +173,7 @@ func TestGeneratorBoolAttribute_New(t *testing.T) {
 				},
 			},
 			expected: GeneratorBoolAttribute{
-				CustomType: convert.NewCustomTypePrimitive(nil, nil, "name"),
+				CustomType: convert.NewCustomTypePrimitive(nil, nil, "name", nil),
 				PlanModifiers: convert.NewPlanModifiers(convert.PlanModifierTypeBool, specschema.CustomPlanModifiers{
 					&specschema.CustomPlanModifier{
 						Imports: []code.Import{
@@ -202,7 +202,7 @@ func TestGeneratorBoolAttribute_New(t *testing.T) {
 				},
 			},
 			expected: GeneratorBoolAttribute{
-				CustomType: convert.NewCustomTypePrimitive(nil, nil, "name"),
+				CustomType: convert.NewCustomTypePrimitive(nil, nil, "name", nil),
 				Default: convert.NewDefaultBool(&specschema.BoolDefault{
 					Custom: &specschema.CustomDefault{
 						Imports: []code.Import{
@@ -225,7 +225,7 @@ func TestGeneratorBoolAttribute_New(t *testing.T) {
 		t.Run(name, func(t *testing.T) {
 			t.Parallel()
 
-			got, err := NewGeneratorBoolAttribute("name", testCase.input)
+			got, err := NewGeneratorBoolAttribute("name", testCase.input, nil)
 
 			if diff := cmp.Diff(err, testCase.expectedError, equateErrorMessage); diff != "" {
 				t.Errorf("unexpected error: %s", diff)
@@ -254,7 +254,7 @@ func TestGeneratorBoolAttribute_Imports(t *testing.T) {
 		},
 		"custom-type-without-import": {
 			input: GeneratorBoolAttribute{
-				CustomType: convert.NewCustomTypePrimitive(&specschema.CustomType{}, nil, ""),
+				CustomType: convert.NewCustomTypePrimitive(&specschema.CustomType{}, nil, "", nil),
 			},
 			expected: []code.Import{},
 		},
@@ -268,6 +268,7 @@ func TestGeneratorBoolAttribute_Imports(t *testing.T) {
 					},
 					nil,
 					"",
+					nil,
 				),
 			},
 			expected: []code.Import{},
@@ -282,6 +283,7 @@ func TestGeneratorBoolAttribute_Imports(t *testing.T) {
 					},
 					nil,
 					"",
+					nil,
 				),
 			},
 			expected: []code.Import{
@@ -604,6 +606,7 @@ func TestGeneratorBoolAttribute_Imports(t *testing.T) {
 					},
 					nil,
 					"",
+					nil,
 				),
 			},
 			expected: []code.Import{
@@ -662,6 +665,7 @@ func TestGeneratorBoolAttribute_Schema(t *testing.T) {
 					},
 					nil,
 					"bool_attribute",
+					nil,
 				),
 			},
 			expected: `"bool_attribute": schema.BoolAttribute{
@@ -677,6 +681,7 @@ CustomType: my_custom_type,
 						Type: "*api.ExtBool",
 					},
 					"bool_attribute",
+					nil,
 				),
 			},
 			expected: `"bool_attribute": schema.BoolAttribute{
@@ -694,6 +699,7 @@ CustomType: BoolAttributeType{},
 						Type: "*api.ExtBool",
 					},
 					"bool_attribute",
+					nil,
 				),
 			},
 			expected: `"bool_attribute": schema.BoolAttribute{
@@ -861,6 +867,7 @@ func TestGeneratorBoolAttribute_ModelField(t *testing.T) {
 					},
 					nil,
 					"",
+					nil,
 				),
 			},
 			expected: model.Field{
@@ -877,6 +884,7 @@ func TestGeneratorBoolAttribute_ModelField(t *testing.T) {
 						Type: "*api.BoolAttribute",
 					},
 					"bool_attribute",
+					nil,
 				),
 			},
 			expected: model.Field{
@@ -895,6 +903,7 @@ func TestGeneratorBoolAttribute_ModelField(t *testing.T) {
 						Type: "*api.BoolAttribute",
 					},
 					"",
+					nil,
 				),
 			},
 			expected: model.Field{
@@ -183,7 +183,7 @@ func Test_NewSchemas(t *testing.T) {
 					Attributes: generatorschema.GeneratorAttributes{
 						"bool_attribute": GeneratorBoolAttribute{
 							ComputedOptionalRequired: convert.NewComputedOptionalRequired(specschema.Optional),
-							CustomType:               convert.NewCustomTypePrimitive(nil, nil, "bool_attribute"),
+							CustomType:               convert.NewCustomTypePrimitive(nil, nil, "bool_attribute", nil),
 							Sensitive:                convert.NewSensitive(pointer(true)),
 							PlanModifiers:            convert.NewPlanModifiers(convert.PlanModifierTypeBool, specschema.CustomPlanModifiers{}),
 							Validators:               convert.NewValidators(convert.ValidatorTypeBool, specschema.CustomValidators{}),
@@ -196,6 +196,7 @@ func Test_NewSchemas(t *testing.T) {
 								convert.CustomCollectionTypeList,
 								"types.ListType{\nElemType: types.StringType,\n}",
 								"list_attribute",
+								nil,
 							),
 							ElementType: specschema.ElementType{
 								List: &specschema.ListType{
@@ -222,6 +223,7 @@ func Test_NewSchemas(t *testing.T) {
 								convert.CustomCollectionTypeMap,
 								"types.MapType{\nElemType: types.StringType,\n}",
 								"map_attribute",
+								nil,
 							),
 							ElementType: specschema.ElementType{
 								Map: &specschema.MapType{
@@ -248,6 +250,7 @@ func Test_NewSchemas(t *testing.T) {
 								convert.CustomCollectionTypeSet,
 								"types.SetType{\nElemType: types.StringType,\n}",
 								"set_attribute",
+								nil,
 							),
 							ElementType: specschema.ElementType{
 								Set: &specschema.SetType{
@@ -272,7 +275,7 @@ func Test_NewSchemas(t *testing.T) {
 								Attributes: generatorschema.GeneratorAttributes{
 									"nested_bool_attribute": GeneratorBoolAttribute{
 										ComputedOptionalRequired: convert.NewComputedOptionalRequired(specschema.Optional),
-										CustomType:               convert.NewCustomTypePrimitive(nil, nil, "nested_bool_attribute"),
+										CustomType:               convert.NewCustomTypePrimitive(nil, nil, "nested_bool_attribute", nil),
 										PlanModifiers:            convert.NewPlanModifiers(convert.PlanModifierTypeBool, specschema.CustomPlanModifiers{}),
 										Validators:               convert.NewValidators(convert.ValidatorTypeBool, specschema.CustomValidators{}),
 									},
@@ -284,6 +287,7 @@ func Test_NewSchemas(t *testing.T) {
 											convert.CustomCollectionTypeList,
 											"types.StringType",
 											"nested_list_attribute",
+											nil,
 										),
 										ElementType: specschema.ElementType{
 											String: &specschema.StringType{},
@@ -300,7 +304,7 @@ func Test_NewSchemas(t *testing.T) {
 								generatorschema.GeneratorAttributes{
 									"nested_bool_attribute": GeneratorBoolAttribute{
 										ComputedOptionalRequired: convert.NewComputedOptionalRequired(specschema.Optional),
-										CustomType:               convert.NewCustomTypePrimitive(nil, nil, "nested_bool_attribute"),
+										CustomType:               convert.NewCustomTypePrimitive(nil, nil, "nested_bool_attribute", nil),
 										PlanModifiers:            convert.NewPlanModifiers(convert.PlanModifierTypeBool, specschema.CustomPlanModifiers{}),
 										Validators:               convert.NewValidators(convert.ValidatorTypeBool, specschema.CustomValidators{}),
 									},
@@ -312,6 +316,7 @@ func Test_NewSchemas(t *testing.T) {
 											convert.CustomCollectionTypeList,
 											"types.StringType",
 											"nested_list_attribute",
+											nil,
 										),
 										ElementType: specschema.ElementType{
 											String: &specschema.StringType{},
@@ -327,6 +332,7 @@ func Test_NewSchemas(t *testing.T) {
 								convert.NewPlanModifiers(convert.PlanModifierTypeObject, specschema.CustomPlanModifiers{}),
 								convert.NewValidators(convert.ValidatorTypeObject, specschema.CustomValidators{}),
 								"list_nested_attribute",
+								nil,
 							),
 							PlanModifiers: convert.NewPlanModifiers(convert.PlanModifierTypeList, specschema.CustomPlanModifiers{}),
 							Validators:    convert.NewValidators(convert.ValidatorTypeList, specschema.CustomValidators{}),
@@ -361,7 +367,7 @@ func Test_NewSchemas(t *testing.T) {
 								},
 							}),
 							ComputedOptionalRequired: convert.NewComputedOptionalRequired(specschema.Optional),
-							CustomType:               convert.NewCustomTypeObject(nil, nil, "object_attribute"),
+							CustomType:               convert.NewCustomTypeObject(nil, nil, "object_attribute", nil),
 							PlanModifiers:            convert.NewPlanModifiers(convert.PlanModifierTypeObject, specschema.CustomPlanModifiers{}),
 							Validators:               convert.NewValidators(convert.ValidatorTypeObject, specschema.CustomValidators{}),
 						},
@@ -369,7 +375,7 @@ func Test_NewSchemas(t *testing.T) {
 							Attributes: generatorschema.GeneratorAttributes{
 								"nested_bool_attribute": GeneratorBoolAttribute{
 									ComputedOptionalRequired: convert.NewComputedOptionalRequired(specschema.Optional),
-									CustomType:               convert.NewCustomTypePrimitive(nil, nil, "nested_bool_attribute"),
+									CustomType:               convert.NewCustomTypePrimitive(nil, nil, "nested_bool_attribute", nil),
 									PlanModifiers:            convert.NewPlanModifiers(convert.PlanModifierTypeBool, specschema.CustomPlanModifiers{}),
 									Validators:               convert.NewValidators(convert.ValidatorTypeBool, specschema.CustomValidators{}),
 								},
@@ -381,6 +387,7 @@ func Test_NewSchemas(t *testing.T) {
 										convert.CustomCollectionTypeList,
 										"types.StringType",
 										"nested_list_attribute",
+										nil,
 									),
 									ElementType: specschema.ElementType{
 										String: &specschema.StringType{},
@@ -393,7 +400,7 @@ func Test_NewSchemas(t *testing.T) {
 								},
 							},
 							ComputedOptionalRequired: convert.NewComputedOptionalRequired(specschema.Optional),
-							CustomType:               convert.NewCustomTypeNestedObject(nil, "single_nested_attribute"),
+							CustomType:               convert.NewCustomTypeNestedObject(nil, "single_nested_attribute", nil),
 							PlanModifiers:            convert.NewPlanModifiers(convert.PlanModifierTypeObject, specschema.CustomPlanModifiers{}),
 							Validators:               convert.NewValidators(convert.ValidatorTypeObject, specschema.CustomValidators{}),
 						},
@@ -404,7 +411,7 @@ func Test_NewSchemas(t *testing.T) {
 								Attributes: generatorschema.GeneratorAttributes{
 									"nested_bool_attribute": GeneratorBoolAttribute{
 										ComputedOptionalRequired: convert.NewComputedOptionalRequired(specschema.Optional),
-										CustomType:               convert.NewCustomTypePrimitive(nil, nil, "nested_bool_attribute"),
+										CustomType:               convert.NewCustomTypePrimitive(nil, nil, "nested_bool_attribute", nil),
 										PlanModifiers:            convert.NewPlanModifiers(convert.PlanModifierTypeBool, specschema.CustomPlanModifiers{}),
 										Validators:               convert.NewValidators(convert.ValidatorTypeBool, specschema.CustomValidators{}),
 									},
@@ -414,7 +421,7 @@ func Test_NewSchemas(t *testing.T) {
 								generatorschema.GeneratorAttributes{
 									"nested_bool_attribute": GeneratorBoolAttribute{
 										ComputedOptionalRequired: convert.NewComputedOptionalRequired(specschema.Optional),
-										CustomType:               convert.NewCustomTypePrimitive(nil, nil, "nested_bool_attribute"),
+										CustomType:               convert.NewCustomTypePrimitive(nil, nil, "nested_bool_attribute", nil),
 										PlanModifiers:            convert.NewPlanModifiers(convert.PlanModifierTypeBool, specschema.CustomPlanModifiers{}),
 										Validators:               convert.NewValidators(convert.ValidatorTypeBool, specschema.CustomValidators{}),
 									},
@@ -424,6 +431,7 @@ func Test_NewSchemas(t *testing.T) {
 								convert.NewPlanModifiers(convert.PlanModifierTypeObject, specschema.CustomPlanModifiers{}),
 								convert.NewValidators(convert.ValidatorTypeObject, specschema.CustomValidators{}),
 								"list_nested_block",
+								nil,
 							),
 							PlanModifiers: convert.NewPlanModifiers(convert.PlanModifierTypeList, specschema.CustomPlanModifiers{}),
 							Validators:    convert.NewValidators(convert.ValidatorTypeList, specschema.CustomValidators{}),
@@ -432,12 +440,12 @@ func Test_NewSchemas(t *testing.T) {
 							Attributes: generatorschema.GeneratorAttributes{
 								"nested_bool_attribute": GeneratorBoolAttribute{
 									ComputedOptionalRequired: convert.NewComputedOptionalRequired(specschema.Optional),
-									CustomType:               convert.NewCustomTypePrimitive(nil, nil, "nested_bool_attribute"),
+									CustomType:               convert.NewCustomTypePrimitive(nil, nil, "nested_bool_attribute", nil),
 									PlanModifiers:            convert.NewPlanModifiers(convert.PlanModifierTypeBool, specschema.CustomPlanModifiers{}),
 									Validators:               convert.NewValidators(convert.ValidatorTypeBool, specschema.CustomValidators{}),
 								},
 							},
-							CustomType:    convert.NewCustomTypeNestedObject(nil, "single_nested_block"),
+							CustomType:    convert.NewCustomTypeNestedObject(nil, "single_nested_block", nil),
 							PlanModifiers: convert.NewPlanModifiers(convert.PlanModifierTypeObject, specschema.CustomPlanModifiers{}),
 							Validators:    convert.NewValidators(convert.ValidatorTypeObject, specschema.CustomValidators{}),
 						},
@@ -452,7 +460,7 @@ func Test_NewSchemas(t *testing.T) {
 		t.Run(name, func(t *testing.T) {
 			t.Parallel()
 
-			got, err := NewSchemas(testCase.spec)
+			got, err := NewSchemas(testCase.spec, nil)
 
 			if err != nil {
 				t.Error(err)
@@ -24,16 +24,18 @@ type GeneratorFloat64Attribute struct {
 	PlanModifiers            convert.PlanModifiers
 	Sensitive                convert.Sensitive
 	Validators               convert.Validators
+
+	options *generatorschema.Options
 }
 
-func NewGeneratorFloat64Attribute(name string, a *resource.Float64Attribute) (GeneratorFloat64Attribute, error) {
+func NewGeneratorFloat64Attribute(name string, a *resource.Float64Attribute, opts *generatorschema.Options) (GeneratorFloat64Attribute, error) {
 	if a == nil {
 		return GeneratorFloat64Attribute{}, fmt.Errorf("*resource.Float64Attribute is nil")
 	}
 
 	c := convert.NewComputedOptionalRequired(a.ComputedOptionalRequired)
 
-	ctp := convert.NewCustomTypePrimitive(a.CustomType, a.AssociatedExternalType, name)
+	ctp := convert.NewCustomTypePrimitive(a.CustomType, a.AssociatedExternalType, name, opts)
 
 	df := convert.NewDefaultFloat64(a.Default)
 
@@ -48,7 +50,7 @@ func NewGeneratorFloat64Attribute(name string, a *resource.Float64Attribute) (Ge
 	v := convert.NewValidators(convert.ValidatorTypeFloat64, a.Validators.CustomValidators())
 
 	return GeneratorFloat64Attribute{
-		AssociatedExternalType:   generatorschema.NewAssocExtType(a.AssociatedExternalType),
+		AssociatedExternalType:   generatorschema.NewAssocExtType(a.AssociatedExternalType, opts),
 		ComputedOptionalRequired: c,
 		CustomType:               ctp,
 		Default:                  df,
@@ -57,6 +59,7 @@ func NewGeneratorFloat64Attribute(name string, a *resource.Float64Attribute) (Ge
 		PlanModifiers:            pm,
 		Sensitive:                s,
 		Validators:               v,
+		options:                  opts,
 	}, nil
 }
 
@@ -145,7 +148,7 @@ func (g GeneratorFloat64Attribute) Schema(name generatorschema.FrameworkIdentifi
 
 func (g GeneratorFloat64Attribute) ModelField(name generatorschema.FrameworkIdentifier) (model.Field, error) {
 	field := model.Field{
-		Name:      name.ToPascalCase(),
+		Name:      g.options.ToPascalCase(name),
 		TfsdkName: name.ToString(),
 		ValueType: model.Float64ValueType,
 	}
@@ -166,7 +169,7 @@ func (g GeneratorFloat64Attribute) CustomTypeAndValue(name string) ([]byte, erro
 
 	var buf bytes.Buffer
 
-	float64Type := generatorschema.NewCustomFloat64Type(name)
+	float64Type := generatorschema.NewCustomFloat64Type(name, g.options)
 
 	b, err := float64Type.Render()
 
@@ -176,7 +179,7 @@ func (g GeneratorFloat64Attribute) CustomTypeAndValue(name string) ([]byte, erro
 
 	buf.Write(b)
 
-	float64Value := generatorschema.NewCustomFloat64Value(name)
+	float64Value := generatorschema.NewCustomFloat64Value(name, g.options)
 
 	b, err = float64Value.Render()
 
@@ -189,12 +192,16 @@ func (g GeneratorFloat64Attribute) CustomTypeAndValue(name string) ([]byte, erro
 	return buf.Bytes(), nil
 }
 
+func (g GeneratorFloat64Attribute) GetAssociatedExternalType() *generatorschema.AssocExtType {
+	return g.AssociatedExternalType
+}
+
 func (g GeneratorFloat64Attribute) ToFromFunctions(name string) ([]byte, error) {
 	if g.AssociatedExternalType == nil {
 		return nil, nil
 	}
 
-	toFrom := generatorschema.NewToFromFloat64(name, g.AssociatedExternalType)
+	toFrom := generatorschema.NewToFromFloat64(name, g.AssociatedExternalType, g.options)
 
 	b, err := toFrom.Render()
 
@@ -208,7 +215,7 @@ func (g GeneratorFloat64Attribute) ToFromFunctions(name string) ([]byte, error)
 // AttrType returns a string representation of a basetypes.Float64Typable type.
 func (g GeneratorFloat64Attribute) AttrType(name generatorschema.FrameworkIdentifier) (string, error) {
 	if g.AssociatedExternalType != nil {
-		return fmt.Sprintf("%sType{}", name.ToPascalCase()), nil
+		return fmt.Sprintf("%sType{}", g.options.ToPascalCase(name)), nil
 	}
 
 	return "basetypes.Float64Type{}", nil
@@ -217,7 +224,7 @@ func (g GeneratorFloat64Attribute) AttrType(name generatorschema.FrameworkIdenti
 // AttrValue returns a string representation of a basetypes.Float64Valuable type.
 func (g GeneratorFloat64Attribute) AttrValue(name generatorschema.FrameworkIdentifier) string {
 	if g.AssociatedExternalType != nil {
-		return fmt.Sprintf("%sValue", name.ToPascalCase())
+		return fmt.Sprintf("%sValue", g.options.ToPascalCase(name))
 	}
 
 	return "basetypes.Float64Value"
@@ -246,3 +253,15 @@ func (g GeneratorFloat64Attribute) From() (generatorschema.ToFromConversion, err
 		Default: "Float64PointerValue",
 	}, nil
 }
+
+func (g GeneratorFloat64Attribute) Properties() generatorschema.FieldProperties {
+	return generatorschema.FieldProperties{
+		Computed:           g.ComputedOptionalRequired.IsComputed(),
+		Optional:           g.ComputedOptionalRequired.IsOptional(),
+		Required:           g.ComputedOptionalRequired.IsRequired(),
+		Sensitive:          g.Sensitive.IsSensitive(),
+		Description:        g.Description.Description(),
+		DeprecationMessage: g.DeprecationMessage.DeprecationMessage(),
+		StaticDefault:      g.Default.Static(),
+	}
+}
@@ -34,7 +34,7 @@ func TestGeneratorFloat64Attribute_New(t *testing.T) {
 			},
 			expected: GeneratorFloat64Attribute{
 				ComputedOptionalRequired: convert.NewComputedOptionalRequired(specschema.Computed),
-				CustomType:               convert.NewCustomTypePrimitive(nil, nil, "name"),
+				CustomType:               convert.NewCustomTypePrimitive(nil, nil, "name", nil),
 				PlanModifiers:            convert.NewPlanModifiers(convert.PlanModifierTypeFloat64, specschema.CustomPlanModifiers{}),
 				Validators:               convert.NewValidators(convert.ValidatorTypeFloat64, specschema.CustomValidators{}),
 			},
@@ -45,7 +45,7 @@ func TestGeneratorFloat64Attribute_New(t *testing.T) {
 			},
 			expected: GeneratorFloat64Attribute{
 				ComputedOptionalRequired: convert.NewComputedOptionalRequired(specschema.ComputedOptional),
-				CustomType:               convert.NewCustomTypePrimitive(nil, nil, "name"),
+				CustomType:               convert.NewCustomTypePrimitive(nil, nil, "name", nil),
 				PlanModifiers:            convert.NewPlanModifiers(convert.PlanModifierTypeFloat64, specschema.CustomPlanModifiers{}),
 				Validators:               convert.NewValidators(convert.ValidatorTypeFloat64, specschema.CustomValidators{}),
 			},
@@ -56,7 +56,7 @@ func TestGeneratorFloat64Attribute_New(t *testing.T) {
 			},
 			expected: GeneratorFloat64Attribute{
 				ComputedOptionalRequired: convert.NewComputedOptionalRequired(specschema.Optional),
-				CustomType:               convert.NewCustomTypePrimitive(nil, nil, "name"),
+				CustomType:               convert.NewCustomTypePrimitive(nil, nil, "name", nil),
 				PlanModifiers:            convert.NewPlanModifiers(convert.PlanModifierTypeFloat64, specschema.CustomPlanModifiers{}),
 				Validators:               convert.NewValidators(convert.ValidatorTypeFloat64, specschema.CustomValidators{}),
 			},
@@ -67,7 +67,7 @@ func TestGeneratorFloat64Attribute_New(t *testing.T) {
 			},
 			expected: GeneratorFloat64Attribute{
 				ComputedOptionalRequired: convert.NewComputedOptionalRequired(specschema.Required),
-				CustomType:               convert.NewCustomTypePrimitive(nil, nil, "name"),
+				CustomType:               convert.NewCustomTypePrimitive(nil, nil, "name", nil),
 				PlanModifiers:            convert.NewPlanModifiers(convert.PlanModifierTypeFloat64, specschema.CustomPlanModifiers{}),
 				Validators:               convert.NewValidators(convert.ValidatorTypeFloat64, specschema.CustomValidators{}),
 			},
@@ -89,7 +89,7 @@ func TestGeneratorFloat64Attribute_New(t *testing.T) {
 					},
 					Type:      "my_type",
 					ValueType: "myvalue_type",
-				}, nil, "name"),
+				}, nil, "name", nil),
 				PlanModifiers: convert.NewPlanModifiers(convert.PlanModifierTypeFloat64, nil),
 				Validators:    convert.NewValidators(convert.ValidatorTypeFloat64, nil),
 			},
@@ -99,7 +99,7 @@ func TestGeneratorFloat64Attribute_New(t *testing.T) {
 				DeprecationMessage: pointer("deprecation message"),
 			},
 			expected: GeneratorFloat64Attribute{
-				CustomType:         convert.NewCustomTypePrimitive(nil, nil, "name"),
+				CustomType:         convert.NewCustomTypePrimitive(nil, nil, "name", nil),
 				DeprecationMessage: convert.NewDeprecationMessage(pointer("deprecation message")),
 				PlanModifiers:      convert.NewPlanModifiers(convert.PlanModifierTypeFloat64, specschema.CustomPlanModifiers{}),
 				Validators:         convert.NewValidators(convert.ValidatorTypeFloat64, specschema.CustomValidators{}),
@@ -110,7 +110,7 @@ func TestGeneratorFloat64Attribute_New(t *testing.T) {
 				Description: pointer("description"),
 			},
 			expected: GeneratorFloat64Attribute{
-				CustomType:    convert.NewCustomTypePrimitive(nil, nil, "name"),
+				CustomType:    convert.NewCustomTypePrimitive(nil, nil, "name", nil),
 				Description:   convert.NewDescription(pointer("description")),
 				PlanModifiers: convert.NewPlanModifiers(convert.PlanModifierTypeFloat64, specschema.CustomPlanModifiers{}),
 				Validators:    convert.NewValidators(convert.ValidatorTypeFloat64, specschema.CustomValidators{}),
@@ -121,7 +121,7 @@ func TestGeneratorFloat64Attribute_New(t *testing.T) {
 				Sensitive: pointer(true),
 			},
 			expected: GeneratorFloat64Attribute{
-				CustomType:    convert.NewCustomTypePrimitive(nil, nil, "name"),
+				CustomType:    convert.NewCustomTypePrimitive(nil, nil, "name", nil),
 				Sensitive:     convert.NewSensitive(pointer(true)),
 				PlanModifiers: convert.NewPlanModifiers(convert.PlanModifierTypeFloat64, specschema.CustomPlanModifiers{}),
 				Validators:    convert.NewValidators(convert.ValidatorTypeFloat64, specschema.CustomValidators{}),
@@ -143,7 +143,7 @@ func TestGeneratorFloat64Attribute_New(t *testing.T) {
 				},
 			},
 			expected: GeneratorFloat64Attribute{
-				CustomType:    convert.NewCustomTypePrimitive(nil, nil, "name"),
+				CustomType:    convert.NewCustomTypePrimitive(nil, nil, "name", nil),
 				PlanModifiers: convert.NewPlanModifiers(convert.PlanModifierTypeFloat64, nil),
 				Validators: convert.NewValidators(convert.ValidatorTypeFloat64, specschema.CustomValidators{
 					&specschema.CustomValidator{
@@ -173,7 +173,7 @@ func TestGeneratorFloat64Attribute_New(t *testing.T) {
 				},
 			},
 			expected: GeneratorFloat64Attribute{
-				CustomType: convert.NewCustomTypePrimitive(nil, nil, "name"),
+				CustomType: convert.NewCustomTypePrimitive(nil, nil, "name", nil),
 				PlanModifiers: convert.NewPlanModifiers(convert.PlanModifierTypeFloat64, specschema.CustomPlanModifiers{
 					&specschema.CustomPlanModifier{
 						Imports: []code.Import{
@@ -202,7 +202,7 @@ func TestGeneratorFloat64Attribute_New(t *testing.T) {
 				},
 			},
 			expected: GeneratorFloat64Attribute{
-				CustomType: convert.NewCustomTypePrimitive(nil, nil, "name"),
+				CustomType: convert.NewCustomTypePrimitive(nil, nil, "name", nil),
 				Default: convert.NewDefaultFloat64(&specschema.Float64Default{
 					Custom: &specschema.CustomDefault{
 						Imports: []code.Import{
@@ -225,7 +225,7 @@ func TestGeneratorFloat64Attribute_New(t *testing.T) {
 		t.Run(name, func(t *testing.T) {
 			t.Parallel()
 
-			got, err := NewGeneratorFloat64Attribute("name", testCase.input)
+			got, err := NewGeneratorFloat64Attribute("name", testCase.input, nil)
 
 			if diff := cmp.Diff(err, testCase.expectedError, equateErrorMessage); diff != "" {
 				t.Errorf("unexpected error: %s", diff)
@@ -254,7 +254,7 @@ func TestGeneratorFloat64Attribute_Imports(t *testing.T) {
 		},
 		"custom-type-without-import": {
 			input: GeneratorFloat64Attribute{
-				CustomType: convert.NewCustomTypePrimitive(&specschema.CustomType{}, nil, ""),
+				CustomType: convert.NewCustomTypePrimitive(&specschema.CustomType{}, nil, "", nil),
 			},
 			expected: []code.Import{},
 		},
@@ -268,6 +268,7 @@ func TestGeneratorFloat64Attribute_Imports(t *testing.T) {
 					},
 					nil,
 					"",
+					nil,
 				),
 			},
 			expected: []code.Import{},
@@ -282,6 +283,7 @@ func TestGeneratorFloat64Attribute_Imports(t *testing.T) {
 					},
 					nil,
 					"",
+					nil,
 				),
 			},
 			expected: []code.Import{
@@ -604,6 +606,7 @@ func TestGeneratorFloat64Attribute_Imports(t *testing.T) {
 					},
 					nil,
 					"",
+					nil,
 				),
 			},
 			expected: []code.Import{
@@ -662,6 +665,7 @@ func TestGeneratorFloat64Attribute_Schema(t *testing.T) {
 					},
 					nil,
 					"float64_attribute",
+					nil,
 				),
 			},
 			expected: `"float64_attribute": schema.Float64Attribute{
@@ -677,6 +681,7 @@ CustomType: my_custom_type,
 						Type: "*api.ExtFloat64",
 					},
 					"float64_attribute",
+					nil,
 				),
 			},
 			expected: `"float64_attribute": schema.Float64Attribute{
@@ -694,6 +699,7 @@ CustomType: Float64AttributeType{},
 						Type: "*api.ExtFloat64",
 					},
 					"float64_attribute",
+					nil,
 				),
 			},
 			expected: `"float64_attribute": schema.Float64Attribute{
@@ -861,6 +867,7 @@ func TestGeneratorFloat64Attribute_ModelField(t *testing.T) {
 					},
 					nil,
 					"",
+					nil,
 				),
 			},
 			expected: model.Field{
@@ -877,6 +884,7 @@ func TestGeneratorFloat64Attribute_ModelField(t *testing.T) {
 						Type: "*api.Float64Attribute",
 					},
 					"float64_attribute",
+					nil,
 				),
 			},
 			expected: model.Field{
@@ -895,6 +903,7 @@ func TestGeneratorFloat64Attribute_ModelField(t *testing.T) {
 						Type: "*api.Float64Attribute",
 					},
 					"",
+					nil,
 				),
 			},
 			expected: model.Field{
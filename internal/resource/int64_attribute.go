@@ -24,16 +24,18 @@ type GeneratorInt64Attribute struct {
 	PlanModifiers            convert.PlanModifiers
 	Sensitive                convert.Sensitive
 	Validators               convert.Validators
+
+	options *generatorschema.Options
 }
 
-func NewGeneratorInt64Attribute(name string, a *resource.Int64Attribute) (GeneratorInt64Attribute, error) {
+func NewGeneratorInt64Attribute(name string, a *resource.Int64Attribute, opts *generatorschema.Options) (GeneratorInt64Attribute, error) {
 	if a == nil {
 		return GeneratorInt64Attribute{}, fmt.Errorf("*resource.Int64Attribute is nil")
 	}
 
 	c := convert.NewComputedOptionalRequired(a.ComputedOptionalRequired)
 
-	ctp := convert.NewCustomTypePrimitive(a.CustomType, a.AssociatedExternalType, name)
+	ctp := convert.NewCustomTypePrimitive(a.CustomType, a.AssociatedExternalType, name, opts)
 
 	di := convert.NewDefaultInt64(a.Default)
 
@@ -48,7 +50,7 @@ func NewGeneratorInt64Attribute(name string, a *resource.Int64Attribute) (Genera
 	v := convert.NewValidators(convert.ValidatorTypeInt64, a.Validators.CustomValidators())
 
 	return GeneratorInt64Attribute{
-		AssociatedExternalType:   generatorschema.NewAssocExtType(a.AssociatedExternalType),
+		AssociatedExternalType:   generatorschema.NewAssocExtType(a.AssociatedExternalType, opts),
 		ComputedOptionalRequired: c,
 		CustomType:               ctp,
 		Default:                  di,
@@ -57,6 +59,7 @@ func NewGeneratorInt64Attribute(name string, a *resource.Int64Attribute) (Genera
 		PlanModifiers:            pm,
 		Sensitive:                s,
 		Validators:               v,
+		options:                  opts,
 	}, nil
 }
 
@@ -145,7 +148,7 @@ func (g GeneratorInt64Attribute) Schema(name generatorschema.FrameworkIdentifier
 
 func (g GeneratorInt64Attribute) ModelField(name generatorschema.FrameworkIdentifier) (model.Field, error) {
 	field := model.Field{
-		Name:      name.ToPascalCase(),
+		Name:      g.options.ToPascalCase(name),
 		TfsdkName: name.ToString(),
 		ValueType: model.Int64ValueType,
 	}
@@ -166,7 +169,7 @@ func (g GeneratorInt64Attribute) CustomTypeAndValue(name string) ([]byte, error)
 
 	var buf bytes.Buffer
 
-	int64Type := generatorschema.NewCustomInt64Type(name)
+	int64Type := generatorschema.NewCustomInt64Type(name, g.options)
 
 	b, err := int64Type.Render()
 
@@ -176,7 +179,7 @@ func (g GeneratorInt64Attribute) CustomTypeAndValue(name string) ([]byte, error)
 
 	buf.Write(b)
 
-	int64Value := generatorschema.NewCustomInt64Value(name)
+	int64Value := generatorschema.NewCustomInt64Value(name, g.options)
 
 	b, err = int64Value.Render()
 
@@ -189,12 +192,16 @@ func (g GeneratorInt64Attribute) CustomTypeAndValue(name string) ([]byte, error)
 	return buf.Bytes(), nil
 }
 
+func (g GeneratorInt64Attribute) GetAssociatedExternalType() *generatorschema.AssocExtType {
+	return g.AssociatedExternalType
+}
+
 func (g GeneratorInt64Attribute) ToFromFunctions(name string) ([]byte, error) {
 	if g.AssociatedExternalType == nil {
 		return nil, nil
 	}
 
-	toFrom := generatorschema.NewToFromInt64(name, g.AssociatedExternalType)
+	toFrom := generatorschema.NewToFromInt64(name, g.AssociatedExternalType, g.options)
 
 	b, err := toFrom.Render()
 
@@ -208,7 +215,7 @@ func (g GeneratorInt64Attribute) ToFromFunctions(name string) ([]byte, error) {
 // AttrType returns a string representation of a basetypes.Int64Typable type.
 func (g GeneratorInt64Attribute) AttrType(name generatorschema.FrameworkIdentifier) (string, error) {
 	if g.AssociatedExternalType != nil {
-		return fmt.Sprintf("%sType{}", name.ToPascalCase()), nil
+		return fmt.Sprintf("%sType{}", g.options.ToPascalCase(name)), nil
 	}
 
 	return "basetypes.Int64Type{}", nil
@@ -217,7 +224,7 @@ func (g GeneratorInt64Attribute) AttrType(name generatorschema.FrameworkIdentifi
 // AttrValue returns a string representation of a basetypes.Int64Valuable type.
 func (g GeneratorInt64Attribute) AttrValue(name generatorschema.FrameworkIdentifier) string {
 	if g.AssociatedExternalType != nil {
-		return fmt.Sprintf("%sValue", name.ToPascalCase())
+		return fmt.Sprintf("%sValue", g.options.ToPascalCase(name))
 	}
 
 	return "basetypes.Int64Value"
@@ -246,3 +253,15 @@ func (g GeneratorInt64Attribute) From() (generatorschema.ToFromConversion, error
 		Default: "Int64PointerValue",
 	}, nil
 }
+
+func (g GeneratorInt64Attribute) Properties() generatorschema.FieldProperties {
+	return generatorschema.FieldProperties{
+		Computed:           g.ComputedOptionalRequired.IsComputed(),
+		Optional:           g.ComputedOptionalRequired.IsOptional(),
+		Required:           g.ComputedOptionalRequired.IsRequired(),
+		Sensitive:          g.Sensitive.IsSensitive(),
+		Description:        g.Description.Description(),
+		DeprecationMessage: g.DeprecationMessage.DeprecationMessage(),
+		StaticDefault:      g.Default.Static(),
+	}
+}
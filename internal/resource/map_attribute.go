@@ -27,9 +27,11 @@ type GeneratorMapAttribute struct {
 	PlanModifiers            convert.PlanModifiers
 	Sensitive                convert.Sensitive
 	Validators               convert.Validators
+
+	options *generatorschema.Options
 }
 
-func NewGeneratorMapAttribute(name string, a *resource.MapAttribute) (GeneratorMapAttribute, error) {
+func NewGeneratorMapAttribute(name string, a *resource.MapAttribute, opts *generatorschema.Options) (GeneratorMapAttribute, error) {
 	if a == nil {
 		return GeneratorMapAttribute{}, fmt.Errorf("*resource.MapAttribute is nil")
 	}
@@ -38,7 +40,7 @@ func NewGeneratorMapAttribute(name string, a *resource.MapAttribute) (GeneratorM
 
 	c := convert.NewComputedOptionalRequired(a.ComputedOptionalRequired)
 
-	ctc := convert.NewCustomTypeCollection(a.CustomType, a.AssociatedExternalType, convert.CustomCollectionTypeMap, string(et.ElementType()), name)
+	ctc := convert.NewCustomTypeCollection(a.CustomType, a.AssociatedExternalType, convert.CustomCollectionTypeMap, string(et.ElementType()), name, opts)
 
 	dc := convert.NewDefaultCustom(a.Default.CustomDefault())
 
@@ -53,7 +55,7 @@ func NewGeneratorMapAttribute(name string, a *resource.MapAttribute) (GeneratorM
 	v := convert.NewValidators(convert.ValidatorTypeMap, a.Validators.CustomValidators())
 
 	return GeneratorMapAttribute{
-		AssociatedExternalType:   generatorschema.NewAssocExtType(a.AssociatedExternalType),
+		AssociatedExternalType:   generatorschema.NewAssocExtType(a.AssociatedExternalType, opts),
 		ComputedOptionalRequired: c,
 		CustomType:               ctc,
 		Default:                  dc,
@@ -64,6 +66,7 @@ func NewGeneratorMapAttribute(name string, a *resource.MapAttribute) (GeneratorM
 		ElementTypeCollection:    et,
 		Sensitive:                s,
 		Validators:               v,
+		options:                  opts,
 	}, nil
 }
 
@@ -173,7 +176,7 @@ func (g GeneratorMapAttribute) Schema(name generatorschema.FrameworkIdentifier)
 
 func (g GeneratorMapAttribute) ModelField(name generatorschema.FrameworkIdentifier) (model.Field, error) {
 	field := model.Field{
-		Name:      name.ToPascalCase(),
+		Name:      g.options.ToPascalCase(name),
 		TfsdkName: name.ToString(),
 		ValueType: model.MapValueType,
 	}
@@ -194,7 +197,7 @@ func (g GeneratorMapAttribute) CustomTypeAndValue(name string) ([]byte, error) {
 
 	var buf bytes.Buffer
 
-	listType := generatorschema.NewCustomMapType(name)
+	listType := generatorschema.NewCustomMapType(name, g.options)
 
 	b, err := listType.Render()
 
@@ -206,7 +209,7 @@ func (g GeneratorMapAttribute) CustomTypeAndValue(name string) ([]byte, error) {
 
 	elemType := generatorschema.GetElementType(g.ElementType)
 
-	listValue := generatorschema.NewCustomMapValue(name, elemType)
+	listValue := generatorschema.NewCustomMapValue(name, elemType, g.options)
 
 	b, err = listValue.Render()
 
@@ -219,6 +222,10 @@ func (g GeneratorMapAttribute) CustomTypeAndValue(name string) ([]byte, error) {
 	return buf.Bytes(), nil
 }
 
+func (g GeneratorMapAttribute) GetAssociatedExternalType() *generatorschema.AssocExtType {
+	return g.AssociatedExternalType
+}
+
 func (g GeneratorMapAttribute) ToFromFunctions(name string) ([]byte, error) {
 	if g.AssociatedExternalType == nil {
 		return nil, nil
@@ -233,7 +240,7 @@ func (g GeneratorMapAttribute) ToFromFunctions(name string) ([]byte, error) {
 		return nil, err
 	}
 
-	toFrom := generatorschema.NewToFromMap(name, g.AssociatedExternalType, elementTypeType, elementTypeValue, elementFrom)
+	toFrom := generatorschema.NewToFromMap(name, g.AssociatedExternalType, elementTypeType, elementTypeValue, elementFrom, g.options)
 
 	b, err := toFrom.Render()
 
@@ -253,7 +260,7 @@ func (g GeneratorMapAttribute) AttrType(name generatorschema.FrameworkIdentifier
 	}
 
 	if g.AssociatedExternalType != nil {
-		return fmt.Sprintf("%sType{\nbasetypes.MapType{\nElemType: %s,\n}}", name.ToPascalCase(), elemType), nil
+		return fmt.Sprintf("%sType{\nbasetypes.MapType{\nElemType: %s,\n}}", g.options.ToPascalCase(name), elemType), nil
 	}
 
 	return fmt.Sprintf("basetypes.MapType{\nElemType: %s,\n}", elemType), nil
@@ -262,7 +269,7 @@ func (g GeneratorMapAttribute) AttrType(name generatorschema.FrameworkIdentifier
 // AttrValue returns a string representation of a basetypes.MapValuable type.
 func (g GeneratorMapAttribute) AttrValue(name generatorschema.FrameworkIdentifier) string {
 	if g.AssociatedExternalType != nil {
-		return fmt.Sprintf("%sValue", name.ToPascalCase())
+		return fmt.Sprintf("%sValue", g.options.ToPascalCase(name))
 	}
 
 	return "basetypes.MapValue"
@@ -327,3 +334,14 @@ func (g GeneratorMapAttribute) CollectionType() (map[string]string, error) {
 		"TypeValueFunc": "types.MapValue",
 	}, nil
 }
+
+func (g GeneratorMapAttribute) Properties() generatorschema.FieldProperties {
+	return generatorschema.FieldProperties{
+		Computed:           g.ComputedOptionalRequired.IsComputed(),
+		Optional:           g.ComputedOptionalRequired.IsOptional(),
+		Required:           g.ComputedOptionalRequired.IsRequired(),
+		Sensitive:          g.Sensitive.IsSensitive(),
+		Description:        g.Description.Description(),
+		DeprecationMessage: g.DeprecationMessage.DeprecationMessage(),
+	}
+}
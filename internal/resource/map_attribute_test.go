@@ -41,6 +41,7 @@ func TestGeneratorMapAttribute_New(t *testing.T) {
 					convert.CustomCollectionTypeMap,
 					"types.BoolType",
 					"name",
+					nil,
 				),
 				ElementType: specschema.ElementType{
 					Bool: &specschema.BoolType{},
@@ -65,6 +66,7 @@ func TestGeneratorMapAttribute_New(t *testing.T) {
 					convert.CustomCollectionTypeMap,
 					"types.StringType",
 					"name",
+					nil,
 				),
 				ElementType: specschema.ElementType{
 					String: &specschema.StringType{},
@@ -93,6 +95,7 @@ func TestGeneratorMapAttribute_New(t *testing.T) {
 					convert.CustomCollectionTypeMap,
 					"types.ListType{\nElemType: types.StringType,\n}",
 					"name",
+					nil,
 				),
 				ElementType: specschema.ElementType{
 					List: &specschema.ListType{
@@ -129,6 +132,7 @@ func TestGeneratorMapAttribute_New(t *testing.T) {
 					convert.CustomCollectionTypeMap,
 					"types.MapType{\nElemType: types.StringType,\n}",
 					"name",
+					nil,
 				),
 				ElementType: specschema.ElementType{
 					Map: &specschema.MapType{
@@ -172,6 +176,7 @@ func TestGeneratorMapAttribute_New(t *testing.T) {
 					convert.CustomCollectionTypeMap,
 					"types.ListType{\nElemType: types.ObjectType{\nAttrTypes: map[string]attr.Type{\n\"str\": types.StringType,\n},\n},\n}",
 					"name",
+					nil,
 				),
 				ElementType: specschema.ElementType{
 					List: &specschema.ListType{
@@ -225,6 +230,7 @@ func TestGeneratorMapAttribute_New(t *testing.T) {
 					convert.CustomCollectionTypeMap,
 					"types.ObjectType{\nAttrTypes: map[string]attr.Type{\n\"str\": types.StringType,\n},\n}",
 					"name",
+					nil,
 				),
 				ElementType: specschema.ElementType{
 					Object: &specschema.ObjectType{
@@ -274,6 +280,7 @@ func TestGeneratorMapAttribute_New(t *testing.T) {
 					convert.CustomCollectionTypeMap,
 					"types.ObjectType{\nAttrTypes: map[string]attr.Type{\n\"list\": types.ListType{\nElemType: types.StringType,\n},\n},\n}",
 					"name",
+					nil,
 				),
 				ElementType: specschema.ElementType{
 					Object: &specschema.ObjectType{
@@ -322,6 +329,7 @@ func TestGeneratorMapAttribute_New(t *testing.T) {
 					convert.CustomCollectionTypeMap,
 					"types.StringType",
 					"name",
+					nil,
 				),
 				ElementType: specschema.ElementType{
 					String: &specschema.StringType{},
@@ -348,6 +356,7 @@ func TestGeneratorMapAttribute_New(t *testing.T) {
 					convert.CustomCollectionTypeMap,
 					"types.StringType",
 					"name",
+					nil,
 				),
 				ElementType: specschema.ElementType{
 					String: &specschema.StringType{},
@@ -374,6 +383,7 @@ func TestGeneratorMapAttribute_New(t *testing.T) {
 					convert.CustomCollectionTypeMap,
 					"types.StringType",
 					"name",
+					nil,
 				),
 				ElementType: specschema.ElementType{
 					String: &specschema.StringType{},
@@ -400,6 +410,7 @@ func TestGeneratorMapAttribute_New(t *testing.T) {
 					convert.CustomCollectionTypeMap,
 					"types.StringType",
 					"name",
+					nil,
 				),
 				ElementType: specschema.ElementType{
 					String: &specschema.StringType{},
@@ -437,6 +448,7 @@ func TestGeneratorMapAttribute_New(t *testing.T) {
 					convert.CustomCollectionTypeMap,
 					"types.StringType",
 					"name",
+					nil,
 				),
 				ElementType: specschema.ElementType{
 					String: &specschema.StringType{},
@@ -462,6 +474,7 @@ func TestGeneratorMapAttribute_New(t *testing.T) {
 					convert.CustomCollectionTypeMap,
 					"types.StringType",
 					"name",
+					nil,
 				),
 				DeprecationMessage: convert.NewDeprecationMessage(pointer("deprecation message")),
 				ElementType: specschema.ElementType{
@@ -488,6 +501,7 @@ func TestGeneratorMapAttribute_New(t *testing.T) {
 					convert.CustomCollectionTypeMap,
 					"types.StringType",
 					"name",
+					nil,
 				),
 				Description: convert.NewDescription(pointer("description")),
 				ElementType: specschema.ElementType{
@@ -514,6 +528,7 @@ func TestGeneratorMapAttribute_New(t *testing.T) {
 					convert.CustomCollectionTypeMap,
 					"types.StringType",
 					"name",
+					nil,
 				),
 				ElementType: specschema.ElementType{
 					String: &specschema.StringType{},
@@ -551,6 +566,7 @@ func TestGeneratorMapAttribute_New(t *testing.T) {
 					convert.CustomCollectionTypeMap,
 					"types.StringType",
 					"name",
+					nil,
 				),
 				ElementType: specschema.ElementType{
 					String: &specschema.StringType{},
@@ -596,6 +612,7 @@ func TestGeneratorMapAttribute_New(t *testing.T) {
 					convert.CustomCollectionTypeMap,
 					"types.StringType",
 					"name",
+					nil,
 				),
 				ElementType: specschema.ElementType{
 					String: &specschema.StringType{},
@@ -639,6 +656,7 @@ func TestGeneratorMapAttribute_New(t *testing.T) {
 					convert.CustomCollectionTypeMap,
 					"types.StringType",
 					"name",
+					nil,
 				),
 				Default: convert.NewDefaultCustom(&specschema.CustomDefault{
 					Imports: []code.Import{
@@ -665,7 +683,7 @@ func TestGeneratorMapAttribute_New(t *testing.T) {
 		t.Run(name, func(t *testing.T) {
 			t.Parallel()
 
-			got, err := NewGeneratorMapAttribute("name", testCase.input)
+			got, err := NewGeneratorMapAttribute("name", testCase.input, nil)
 
 			if diff := cmp.Diff(err, testCase.expectedError, equateErrorMessage); diff != "" {
 				t.Errorf("unexpected error: %s", diff)
@@ -700,6 +718,7 @@ func TestGeneratorMapAttribute_Imports(t *testing.T) {
 					convert.CustomCollectionTypeMap,
 					"",
 					"",
+					nil,
 				),
 			},
 			expected: []code.Import{},
@@ -716,6 +735,7 @@ func TestGeneratorMapAttribute_Imports(t *testing.T) {
 					convert.CustomCollectionTypeMap,
 					"",
 					"",
+					nil,
 				),
 			},
 			expected: []code.Import{},
@@ -732,6 +752,7 @@ func TestGeneratorMapAttribute_Imports(t *testing.T) {
 					convert.CustomCollectionTypeMap,
 					"",
 					"",
+					nil,
 				),
 			},
 			expected: []code.Import{
@@ -1219,6 +1240,7 @@ func TestGeneratorMapAttribute_Imports(t *testing.T) {
 					convert.CustomCollectionTypeMap,
 					"",
 					"",
+					nil,
 				),
 			},
 			expected: []code.Import{
@@ -1520,6 +1542,7 @@ ElementType: types.StringType,
 					convert.CustomCollectionTypeMap,
 					"",
 					"",
+					nil,
 				),
 			},
 			expected: `"map_attribute": schema.MapAttribute{
@@ -1537,6 +1560,7 @@ CustomType: my_custom_type,
 					convert.CustomCollectionTypeMap,
 					"types.StringType",
 					"map_attribute",
+					nil,
 				),
 			},
 			expected: `"map_attribute": schema.MapAttribute{
@@ -1560,6 +1584,7 @@ ElemType: types.StringType,
 					convert.CustomCollectionTypeMap,
 					"types.StringType",
 					"map_attribute",
+					nil,
 				),
 			},
 			expected: `"map_attribute": schema.MapAttribute{
@@ -1898,6 +1923,7 @@ func TestGeneratorMapAttribute_ModelField(t *testing.T) {
 					convert.CustomCollectionTypeMap,
 					"",
 					"",
+					nil,
 				),
 			},
 			expected: model.Field{
@@ -1916,6 +1942,7 @@ func TestGeneratorMapAttribute_ModelField(t *testing.T) {
 					convert.CustomCollectionTypeMap,
 					"",
 					"map_attribute",
+					nil,
 				),
 			},
 			expected: model.Field{
@@ -1936,6 +1963,7 @@ func TestGeneratorMapAttribute_ModelField(t *testing.T) {
 					convert.CustomCollectionTypeMap,
 					"",
 					"map_attribute",
+					nil,
 				),
 			},
 			expected: model.Field{
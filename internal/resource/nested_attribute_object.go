@@ -21,10 +21,10 @@ type NestedAttributeObject struct {
 
 // NewNestedAttributeObject constructs a NestedAttributeObject which is used to generate a
 // nested attribute object in the schema.
-func NewNestedAttributeObject(a generatorschema.GeneratorAttributes, c *specschema.CustomType, p convert.PlanModifiers, v convert.Validators, name string) NestedAttributeObject {
+func NewNestedAttributeObject(a generatorschema.GeneratorAttributes, c *specschema.CustomType, p convert.PlanModifiers, v convert.Validators, name string, opts *generatorschema.Options) NestedAttributeObject {
 	return NestedAttributeObject{
 		attributes:    a,
-		customType:    convert.NewCustomTypeNestedObject(c, name),
+		customType:    convert.NewCustomTypeNestedObject(c, name, opts),
 		planModifiers: p,
 		validators:    v,
 	}
@@ -22,11 +22,11 @@ type NestedBlockObject struct {
 
 // NewNestedBlockObject constructs a NestedBlockObject which is used to generate a
 // nested attribute block in the schema.
-func NewNestedBlockObject(a generatorschema.GeneratorAttributes, b generatorschema.GeneratorBlocks, c *specschema.CustomType, p convert.PlanModifiers, v convert.Validators, name string) NestedBlockObject {
+func NewNestedBlockObject(a generatorschema.GeneratorAttributes, b generatorschema.GeneratorBlocks, c *specschema.CustomType, p convert.PlanModifiers, v convert.Validators, name string, opts *generatorschema.Options) NestedBlockObject {
 	return NestedBlockObject{
 		attributes:    a,
 		blocks:        b,
-		customType:    convert.NewCustomTypeNestedObject(c, name),
+		customType:    convert.NewCustomTypeNestedObject(c, name, opts),
 		planModifiers: p,
 		validators:    v,
 	}
@@ -24,16 +24,18 @@ type GeneratorNumberAttribute struct {
 	PlanModifiers            convert.PlanModifiers
 	Sensitive                convert.Sensitive
 	Validators               convert.Validators
+
+	options *generatorschema.Options
 }
 
-func NewGeneratorNumberAttribute(name string, a *resource.NumberAttribute) (GeneratorNumberAttribute, error) {
+func NewGeneratorNumberAttribute(name string, a *resource.NumberAttribute, opts *generatorschema.Options) (GeneratorNumberAttribute, error) {
 	if a == nil {
 		return GeneratorNumberAttribute{}, fmt.Errorf("*resource.NumberAttribute is nil")
 	}
 
 	c := convert.NewComputedOptionalRequired(a.ComputedOptionalRequired)
 
-	ctp := convert.NewCustomTypePrimitive(a.CustomType, a.AssociatedExternalType, name)
+	ctp := convert.NewCustomTypePrimitive(a.CustomType, a.AssociatedExternalType, name, opts)
 
 	dc := convert.NewDefaultCustom(a.Default.CustomDefault())
 
@@ -48,7 +50,7 @@ func NewGeneratorNumberAttribute(name string, a *resource.NumberAttribute) (Gene
 	v := convert.NewValidators(convert.ValidatorTypeNumber, a.Validators.CustomValidators())
 
 	return GeneratorNumberAttribute{
-		AssociatedExternalType:   generatorschema.NewAssocExtType(a.AssociatedExternalType),
+		AssociatedExternalType:   generatorschema.NewAssocExtType(a.AssociatedExternalType, opts),
 		ComputedOptionalRequired: c,
 		CustomType:               ctp,
 		Default:                  dc,
@@ -57,6 +59,7 @@ func NewGeneratorNumberAttribute(name string, a *resource.NumberAttribute) (Gene
 		PlanModifiers:            pm,
 		Sensitive:                s,
 		Validators:               v,
+		options:                  opts,
 	}, nil
 }
 
@@ -145,7 +148,7 @@ func (g GeneratorNumberAttribute) Schema(name generatorschema.FrameworkIdentifie
 
 func (g GeneratorNumberAttribute) ModelField(name generatorschema.FrameworkIdentifier) (model.Field, error) {
 	field := model.Field{
-		Name:      name.ToPascalCase(),
+		Name:      g.options.ToPascalCase(name),
 		TfsdkName: name.ToString(),
 		ValueType: model.NumberValueType,
 	}
@@ -166,7 +169,7 @@ func (g GeneratorNumberAttribute) CustomTypeAndValue(name string) ([]byte, error
 
 	var buf bytes.Buffer
 
-	numberType := generatorschema.NewCustomNumberType(name)
+	numberType := generatorschema.NewCustomNumberType(name, g.options)
 
 	b, err := numberType.Render()
 
@@ -176,7 +179,7 @@ func (g GeneratorNumberAttribute) CustomTypeAndValue(name string) ([]byte, error
 
 	buf.Write(b)
 
-	numberValue := generatorschema.NewCustomNumberValue(name)
+	numberValue := generatorschema.NewCustomNumberValue(name, g.options)
 
 	b, err = numberValue.Render()
 
@@ -189,12 +192,16 @@ func (g GeneratorNumberAttribute) CustomTypeAndValue(name string) ([]byte, error
 	return buf.Bytes(), nil
 }
 
+func (g GeneratorNumberAttribute) GetAssociatedExternalType() *generatorschema.AssocExtType {
+	return g.AssociatedExternalType
+}
+
 func (g GeneratorNumberAttribute) ToFromFunctions(name string) ([]byte, error) {
 	if g.AssociatedExternalType == nil {
 		return nil, nil
 	}
 
-	toFrom := generatorschema.NewToFromNumber(name, g.AssociatedExternalType)
+	toFrom := generatorschema.NewToFromNumber(name, g.AssociatedExternalType, g.options)
 
 	b, err := toFrom.Render()
 
@@ -208,7 +215,7 @@ func (g GeneratorNumberAttribute) ToFromFunctions(name string) ([]byte, error) {
 // AttrType returns a string representation of a basetypes.NumberTypable type.
 func (g GeneratorNumberAttribute) AttrType(name generatorschema.FrameworkIdentifier) (string, error) {
 	if g.AssociatedExternalType != nil {
-		return fmt.Sprintf("%sType{}", name.ToPascalCase()), nil
+		return fmt.Sprintf("%sType{}", g.options.ToPascalCase(name)), nil
 	}
 
 	return "basetypes.NumberType{}", nil
@@ -217,7 +224,7 @@ func (g GeneratorNumberAttribute) AttrType(name generatorschema.FrameworkIdentif
 // AttrValue returns a string representation of a basetypes.NumberValuable type.
 func (g GeneratorNumberAttribute) AttrValue(name generatorschema.FrameworkIdentifier) string {
 	if g.AssociatedExternalType != nil {
-		return fmt.Sprintf("%sValue", name.ToPascalCase())
+		return fmt.Sprintf("%sValue", g.options.ToPascalCase(name))
 	}
 
 	return "basetypes.NumberValue"
@@ -246,3 +253,14 @@ func (g GeneratorNumberAttribute) From() (generatorschema.ToFromConversion, erro
 		Default: "NumberValue",
 	}, nil
 }
+
+func (g GeneratorNumberAttribute) Properties() generatorschema.FieldProperties {
+	return generatorschema.FieldProperties{
+		Computed:           g.ComputedOptionalRequired.IsComputed(),
+		Optional:           g.ComputedOptionalRequired.IsOptional(),
+		Required:           g.ComputedOptionalRequired.IsRequired(),
+		Sensitive:          g.Sensitive.IsSensitive(),
+		Description:        g.Description.Description(),
+		DeprecationMessage: g.DeprecationMessage.DeprecationMessage(),
+	}
+}
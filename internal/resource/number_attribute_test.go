@@ -34,7 +34,7 @@ func TestGeneratorNumberAttribute_New(t *testing.T) {
 			},
 			expected: GeneratorNumberAttribute{
 				ComputedOptionalRequired: convert.NewComputedOptionalRequired(specschema.Computed),
-				CustomType:               convert.NewCustomTypePrimitive(nil, nil, "name"),
+				CustomType:               convert.NewCustomTypePrimitive(nil, nil, "name", nil),
 				PlanModifiers:            convert.NewPlanModifiers(convert.PlanModifierTypeNumber, specschema.CustomPlanModifiers{}),
 				Validators:               convert.NewValidators(convert.ValidatorTypeNumber, specschema.CustomValidators{}),
 			},
@@ -45,7 +45,7 @@ func TestGeneratorNumberAttribute_New(t *testing.T) {
 			},
 			expected: GeneratorNumberAttribute{
 				ComputedOptionalRequired: convert.NewComputedOptionalRequired(specschema.ComputedOptional),
-				CustomType:               convert.NewCustomTypePrimitive(nil, nil, "name"),
+				CustomType:               convert.NewCustomTypePrimitive(nil, nil, "name", nil),
 				PlanModifiers:            convert.NewPlanModifiers(convert.PlanModifierTypeNumber, specschema.CustomPlanModifiers{}),
 				Validators:               convert.NewValidators(convert.ValidatorTypeNumber, specschema.CustomValidators{}),
 			},
@@ -56,7 +56,7 @@ func TestGeneratorNumberAttribute_New(t *testing.T) {
 			},
 			expected: GeneratorNumberAttribute{
 				ComputedOptionalRequired: convert.NewComputedOptionalRequired(specschema.Optional),
-				CustomType:               convert.NewCustomTypePrimitive(nil, nil, "name"),
+				CustomType:               convert.NewCustomTypePrimitive(nil, nil, "name", nil),
 				PlanModifiers:            convert.NewPlanModifiers(convert.PlanModifierTypeNumber, specschema.CustomPlanModifiers{}),
 				Validators:               convert.NewValidators(convert.ValidatorTypeNumber, specschema.CustomValidators{}),
 			},
@@ -67,7 +67,7 @@ func TestGeneratorNumberAttribute_New(t *testing.T) {
 			},
 			expected: GeneratorNumberAttribute{
 				ComputedOptionalRequired: convert.NewComputedOptionalRequired(specschema.Required),
-				CustomType:               convert.NewCustomTypePrimitive(nil, nil, "name"),
+				CustomType:               convert.NewCustomTypePrimitive(nil, nil, "name", nil),
 				PlanModifiers:            convert.NewPlanModifiers(convert.PlanModifierTypeNumber, specschema.CustomPlanModifiers{}),
 				Validators:               convert.NewValidators(convert.ValidatorTypeNumber, specschema.CustomValidators{}),
 			},
@@ -89,7 +89,7 @@ func TestGeneratorNumberAttribute_New(t *testing.T) {
 					},
 					Type:      "my_type",
 					ValueType: "myvalue_type",
-				}, nil, "name"),
+				}, nil, "name", nil),
 				PlanModifiers: convert.NewPlanModifiers(convert.PlanModifierTypeNumber, nil),
 				Validators:    convert.NewValidators(convert.ValidatorTypeNumber, nil),
 			},
@@ -99,7 +99,7 @@ func TestGeneratorNumberAttribute_New(t *testing.T) {
 				DeprecationMessage: pointer("deprecation message"),
 			},
 			expected: GeneratorNumberAttribute{
-				CustomType:         convert.NewCustomTypePrimitive(nil, nil, "name"),
+				CustomType:         convert.NewCustomTypePrimitive(nil, nil, "name", nil),
 				DeprecationMessage: convert.NewDeprecationMessage(pointer("deprecation message")),
 				PlanModifiers:      convert.NewPlanModifiers(convert.PlanModifierTypeNumber, specschema.CustomPlanModifiers{}),
 				Validators:         convert.NewValidators(convert.ValidatorTypeNumber, specschema.CustomValidators{}),
@@ -110,7 +110,7 @@ func TestGeneratorNumberAttribute_New(t *testing.T) {
 				Description: pointer("description"),
 			},
 			expected: GeneratorNumberAttribute{
-				CustomType:    convert.NewCustomTypePrimitive(nil, nil, "name"),
+				CustomType:    convert.NewCustomTypePrimitive(nil, nil, "name", nil),
 				Description:   convert.NewDescription(pointer("description")),
 				PlanModifiers: convert.NewPlanModifiers(convert.PlanModifierTypeNumber, specschema.CustomPlanModifiers{}),
 				Validators:    convert.NewValidators(convert.ValidatorTypeNumber, specschema.CustomValidators{}),
@@ -121,7 +121,7 @@ func TestGeneratorNumberAttribute_New(t *testing.T) {
 				Sensitive: pointer(true),
 			},
 			expected: GeneratorNumberAttribute{
-				CustomType:    convert.NewCustomTypePrimitive(nil, nil, "name"),
+				CustomType:    convert.NewCustomTypePrimitive(nil, nil, "name", nil),
 				Sensitive:     convert.NewSensitive(pointer(true)),
 				PlanModifiers: convert.NewPlanModifiers(convert.PlanModifierTypeNumber, specschema.CustomPlanModifiers{}),
 				Validators:    convert.NewValidators(convert.ValidatorTypeNumber, specschema.CustomValidators{}),
@@ -143,7 +143,7 @@ func TestGeneratorNumberAttribute_New(t *testing.T) {
 				},
 			},
 			expected: GeneratorNumberAttribute{
-				CustomType:    convert.NewCustomTypePrimitive(nil, nil, "name"),
+				CustomType:    convert.NewCustomTypePrimitive(nil, nil, "name", nil),
 				PlanModifiers: convert.NewPlanModifiers(convert.PlanModifierTypeNumber, nil),
 				Validators: convert.NewValidators(convert.ValidatorTypeNumber, specschema.CustomValidators{
 					&specschema.CustomValidator{
@@ -173,7 +173,7 @@ func TestGeneratorNumberAttribute_New(t *testing.T) {
 				},
 			},
 			expected: GeneratorNumberAttribute{
-				CustomType: convert.NewCustomTypePrimitive(nil, nil, "name"),
+				CustomType: convert.NewCustomTypePrimitive(nil, nil, "name", nil),
 				PlanModifiers: convert.NewPlanModifiers(convert.PlanModifierTypeNumber, specschema.CustomPlanModifiers{
 					&specschema.CustomPlanModifier{
 						Imports: []code.Import{
@@ -201,7 +201,7 @@ func TestGeneratorNumberAttribute_New(t *testing.T) {
 				},
 			},
 			expected: GeneratorNumberAttribute{
-				CustomType: convert.NewCustomTypePrimitive(nil, nil, "name"),
+				CustomType: convert.NewCustomTypePrimitive(nil, nil, "name", nil),
 				Default: convert.NewDefaultCustom(&specschema.CustomDefault{
 					Imports: []code.Import{
 						{
@@ -221,7 +221,7 @@ func TestGeneratorNumberAttribute_New(t *testing.T) {
 		t.Run(name, func(t *testing.T) {
 			t.Parallel()
 
-			got, err := NewGeneratorNumberAttribute("name", testCase.input)
+			got, err := NewGeneratorNumberAttribute("name", testCase.input, nil)
 
 			if diff := cmp.Diff(err, testCase.expectedError, equateErrorMessage); diff != "" {
 				t.Errorf("unexpected error: %s", diff)
@@ -250,7 +250,7 @@ func TestGeneratorNumberAttribute_Imports(t *testing.T) {
 		},
 		"custom-type-without-import": {
 			input: GeneratorNumberAttribute{
-				CustomType: convert.NewCustomTypePrimitive(&specschema.CustomType{}, nil, ""),
+				CustomType: convert.NewCustomTypePrimitive(&specschema.CustomType{}, nil, "", nil),
 			},
 			expected: []code.Import{},
 		},
@@ -264,6 +264,7 @@ func TestGeneratorNumberAttribute_Imports(t *testing.T) {
 					},
 					nil,
 					"",
+					nil,
 				),
 			},
 			expected: []code.Import{},
@@ -278,6 +279,7 @@ func TestGeneratorNumberAttribute_Imports(t *testing.T) {
 					},
 					nil,
 					"",
+					nil,
 				),
 			},
 			expected: []code.Import{
@@ -569,6 +571,7 @@ func TestGeneratorNumberAttribute_Imports(t *testing.T) {
 					},
 					nil,
 					"",
+					nil,
 				),
 			},
 			expected: []code.Import{
@@ -627,6 +630,7 @@ func TestGeneratorNumberAttribute_Schema(t *testing.T) {
 					},
 					nil,
 					"number_attribute",
+					nil,
 				),
 			},
 			expected: `"number_attribute": schema.NumberAttribute{
@@ -642,6 +646,7 @@ CustomType: my_custom_type,
 						Type: "*api.ExtNumber",
 					},
 					"number_attribute",
+					nil,
 				),
 			},
 			expected: `"number_attribute": schema.NumberAttribute{
@@ -659,6 +664,7 @@ CustomType: NumberAttributeType{},
 						Type: "*api.ExtNumber",
 					},
 					"number_attribute",
+					nil,
 				),
 			},
 			expected: `"number_attribute": schema.NumberAttribute{
@@ -813,6 +819,7 @@ func TestGeneratorNumberAttribute_ModelField(t *testing.T) {
 					},
 					nil,
 					"",
+					nil,
 				),
 			},
 			expected: model.Field{
@@ -829,6 +836,7 @@ func TestGeneratorNumberAttribute_ModelField(t *testing.T) {
 						Type: "*api.NumberAttribute",
 					},
 					"number_attribute",
+					nil,
 				),
 			},
 			expected: model.Field{
@@ -847,6 +855,7 @@ func TestGeneratorNumberAttribute_ModelField(t *testing.T) {
 						Type: "*api.NumberAttribute",
 					},
 					"",
+					nil,
 				),
 			},
 			expected: model.Field{
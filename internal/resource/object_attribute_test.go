@@ -50,7 +50,7 @@ func TestGeneratorObjectAttribute_New(t *testing.T) {
 						Bool: &specschema.BoolType{},
 					},
 				}),
-				CustomType:    convert.NewCustomTypeObject(nil, nil, "name"),
+				CustomType:    convert.NewCustomTypeObject(nil, nil, "name", nil),
 				PlanModifiers: convert.NewPlanModifiers(convert.PlanModifierTypeObject, specschema.CustomPlanModifiers{}),
 				Validators:    convert.NewValidators(convert.ValidatorTypeObject, specschema.CustomValidators{}),
 			},
@@ -77,7 +77,7 @@ func TestGeneratorObjectAttribute_New(t *testing.T) {
 						String: &specschema.StringType{},
 					},
 				}),
-				CustomType:    convert.NewCustomTypeObject(nil, nil, "name"),
+				CustomType:    convert.NewCustomTypeObject(nil, nil, "name", nil),
 				PlanModifiers: convert.NewPlanModifiers(convert.PlanModifierTypeObject, specschema.CustomPlanModifiers{}),
 				Validators:    convert.NewValidators(convert.ValidatorTypeObject, specschema.CustomValidators{}),
 			},
@@ -116,7 +116,7 @@ func TestGeneratorObjectAttribute_New(t *testing.T) {
 						},
 					},
 				}),
-				CustomType:    convert.NewCustomTypeObject(nil, nil, "name"),
+				CustomType:    convert.NewCustomTypeObject(nil, nil, "name", nil),
 				PlanModifiers: convert.NewPlanModifiers(convert.PlanModifierTypeObject, specschema.CustomPlanModifiers{}),
 				Validators:    convert.NewValidators(convert.ValidatorTypeObject, specschema.CustomValidators{}),
 			},
@@ -155,7 +155,7 @@ func TestGeneratorObjectAttribute_New(t *testing.T) {
 						},
 					},
 				}),
-				CustomType:    convert.NewCustomTypeObject(nil, nil, "name"),
+				CustomType:    convert.NewCustomTypeObject(nil, nil, "name", nil),
 				PlanModifiers: convert.NewPlanModifiers(convert.PlanModifierTypeObject, specschema.CustomPlanModifiers{}),
 				Validators:    convert.NewValidators(convert.ValidatorTypeObject, specschema.CustomValidators{}),
 			},
@@ -215,7 +215,7 @@ func TestGeneratorObjectAttribute_New(t *testing.T) {
 						},
 					},
 				}),
-				CustomType:    convert.NewCustomTypeObject(nil, nil, "name"),
+				CustomType:    convert.NewCustomTypeObject(nil, nil, "name", nil),
 				PlanModifiers: convert.NewPlanModifiers(convert.PlanModifierTypeObject, specschema.CustomPlanModifiers{}),
 				Validators:    convert.NewValidators(convert.ValidatorTypeObject, specschema.CustomValidators{}),
 			},
@@ -242,7 +242,7 @@ func TestGeneratorObjectAttribute_New(t *testing.T) {
 						String: &specschema.StringType{},
 					},
 				}),
-				CustomType:    convert.NewCustomTypeObject(nil, nil, "name"),
+				CustomType:    convert.NewCustomTypeObject(nil, nil, "name", nil),
 				PlanModifiers: convert.NewPlanModifiers(convert.PlanModifierTypeObject, specschema.CustomPlanModifiers{}),
 				Validators:    convert.NewValidators(convert.ValidatorTypeObject, specschema.CustomValidators{}),
 			},
@@ -281,7 +281,7 @@ func TestGeneratorObjectAttribute_New(t *testing.T) {
 						},
 					},
 				}),
-				CustomType:    convert.NewCustomTypeObject(nil, nil, "name"),
+				CustomType:    convert.NewCustomTypeObject(nil, nil, "name", nil),
 				PlanModifiers: convert.NewPlanModifiers(convert.PlanModifierTypeObject, specschema.CustomPlanModifiers{}),
 				Validators:    convert.NewValidators(convert.ValidatorTypeObject, specschema.CustomValidators{}),
 			},
@@ -292,7 +292,7 @@ func TestGeneratorObjectAttribute_New(t *testing.T) {
 			},
 			expected: GeneratorObjectAttribute{
 				ComputedOptionalRequired: convert.NewComputedOptionalRequired(specschema.Computed),
-				CustomType:               convert.NewCustomTypeObject(nil, nil, "name"),
+				CustomType:               convert.NewCustomTypeObject(nil, nil, "name", nil),
 				PlanModifiers:            convert.NewPlanModifiers(convert.PlanModifierTypeObject, specschema.CustomPlanModifiers{}),
 				Validators:               convert.NewValidators(convert.ValidatorTypeObject, specschema.CustomValidators{}),
 			},
@@ -303,7 +303,7 @@ func TestGeneratorObjectAttribute_New(t *testing.T) {
 			},
 			expected: GeneratorObjectAttribute{
 				ComputedOptionalRequired: convert.NewComputedOptionalRequired(specschema.ComputedOptional),
-				CustomType:               convert.NewCustomTypeObject(nil, nil, "name"),
+				CustomType:               convert.NewCustomTypeObject(nil, nil, "name", nil),
 				PlanModifiers:            convert.NewPlanModifiers(convert.PlanModifierTypeObject, specschema.CustomPlanModifiers{}),
 				Validators:               convert.NewValidators(convert.ValidatorTypeObject, specschema.CustomValidators{}),
 			},
@@ -314,7 +314,7 @@ func TestGeneratorObjectAttribute_New(t *testing.T) {
 			},
 			expected: GeneratorObjectAttribute{
 				ComputedOptionalRequired: convert.NewComputedOptionalRequired(specschema.Optional),
-				CustomType:               convert.NewCustomTypeObject(nil, nil, "name"),
+				CustomType:               convert.NewCustomTypeObject(nil, nil, "name", nil),
 				PlanModifiers:            convert.NewPlanModifiers(convert.PlanModifierTypeObject, specschema.CustomPlanModifiers{}),
 				Validators:               convert.NewValidators(convert.ValidatorTypeObject, specschema.CustomValidators{})},
 		},
@@ -324,7 +324,7 @@ func TestGeneratorObjectAttribute_New(t *testing.T) {
 			},
 			expected: GeneratorObjectAttribute{
 				ComputedOptionalRequired: convert.NewComputedOptionalRequired(specschema.Required),
-				CustomType:               convert.NewCustomTypeObject(nil, nil, "name"),
+				CustomType:               convert.NewCustomTypeObject(nil, nil, "name", nil),
 				PlanModifiers:            convert.NewPlanModifiers(convert.PlanModifierTypeObject, specschema.CustomPlanModifiers{}),
 				Validators:               convert.NewValidators(convert.ValidatorTypeObject, specschema.CustomValidators{}),
 			},
@@ -349,6 +349,7 @@ func TestGeneratorObjectAttribute_New(t *testing.T) {
 				},
 					nil,
 					"name",
+					nil,
 				),
 				PlanModifiers: convert.NewPlanModifiers(convert.PlanModifierTypeObject, specschema.CustomPlanModifiers{}),
 				Validators:    convert.NewValidators(convert.ValidatorTypeObject, specschema.CustomValidators{})},
@@ -358,7 +359,7 @@ func TestGeneratorObjectAttribute_New(t *testing.T) {
 				DeprecationMessage: pointer("deprecation message"),
 			},
 			expected: GeneratorObjectAttribute{
-				CustomType:         convert.NewCustomTypeObject(nil, nil, "name"),
+				CustomType:         convert.NewCustomTypeObject(nil, nil, "name", nil),
 				DeprecationMessage: convert.NewDeprecationMessage(pointer("deprecation message")),
 				PlanModifiers:      convert.NewPlanModifiers(convert.PlanModifierTypeObject, specschema.CustomPlanModifiers{}),
 				Validators:         convert.NewValidators(convert.ValidatorTypeObject, specschema.CustomValidators{})},
@@ -368,7 +369,7 @@ func TestGeneratorObjectAttribute_New(t *testing.T) {
 				Description: pointer("description"),
 			},
 			expected: GeneratorObjectAttribute{
-				CustomType:    convert.NewCustomTypeObject(nil, nil, "name"),
+				CustomType:    convert.NewCustomTypeObject(nil, nil, "name", nil),
 				Description:   convert.NewDescription(pointer("description")),
 				PlanModifiers: convert.NewPlanModifiers(convert.PlanModifierTypeObject, specschema.CustomPlanModifiers{}),
 				Validators:    convert.NewValidators(convert.ValidatorTypeObject, specschema.CustomValidators{}),
@@ -379,7 +380,7 @@ func TestGeneratorObjectAttribute_New(t *testing.T) {
 				Sensitive: pointer(true),
 			},
 			expected: GeneratorObjectAttribute{
-				CustomType:    convert.NewCustomTypeObject(nil, nil, "name"),
+				CustomType:    convert.NewCustomTypeObject(nil, nil, "name", nil),
 				Sensitive:     convert.NewSensitive(pointer(true)),
 				PlanModifiers: convert.NewPlanModifiers(convert.PlanModifierTypeObject, specschema.CustomPlanModifiers{}),
 				Validators:    convert.NewValidators(convert.ValidatorTypeObject, specschema.CustomValidators{}),
@@ -401,7 +402,7 @@ func TestGeneratorObjectAttribute_New(t *testing.T) {
 				},
 			},
 			expected: GeneratorObjectAttribute{
-				CustomType:    convert.NewCustomTypeObject(nil, nil, "name"),
+				CustomType:    convert.NewCustomTypeObject(nil, nil, "name", nil),
 				PlanModifiers: convert.NewPlanModifiers(convert.PlanModifierTypeObject, specschema.CustomPlanModifiers{}),
 				Validators: convert.NewValidators(convert.ValidatorTypeObject, specschema.CustomValidators{
 					&specschema.CustomValidator{
@@ -431,7 +432,7 @@ func TestGeneratorObjectAttribute_New(t *testing.T) {
 				},
 			},
 			expected: GeneratorObjectAttribute{
-				CustomType: convert.NewCustomTypeObject(nil, nil, "name"),
+				CustomType: convert.NewCustomTypeObject(nil, nil, "name", nil),
 				PlanModifiers: convert.NewPlanModifiers(convert.PlanModifierTypeObject, specschema.CustomPlanModifiers{
 					&specschema.CustomPlanModifier{
 						Imports: []code.Import{
@@ -459,7 +460,7 @@ func TestGeneratorObjectAttribute_New(t *testing.T) {
 				},
 			},
 			expected: GeneratorObjectAttribute{
-				CustomType: convert.NewCustomTypeObject(nil, nil, "name"),
+				CustomType: convert.NewCustomTypeObject(nil, nil, "name", nil),
 				Default: convert.NewDefaultCustom(&specschema.CustomDefault{
 					Imports: []code.Import{
 						{
@@ -479,7 +480,7 @@ func TestGeneratorObjectAttribute_New(t *testing.T) {
 		t.Run(name, func(t *testing.T) {
 			t.Parallel()
 
-			got, err := NewGeneratorObjectAttribute("name", testCase.input)
+			got, err := NewGeneratorObjectAttribute("name", testCase.input, nil)
 
 			if diff := cmp.Diff(err, testCase.expectedError, equateErrorMessage); diff != "" {
 				t.Errorf("unexpected error: %s", diff)
@@ -512,6 +513,7 @@ func TestGeneratorObjectAttribute_Imports(t *testing.T) {
 					&specschema.CustomType{},
 					nil,
 					"",
+					nil,
 				),
 			},
 			expected: []code.Import{},
@@ -526,6 +528,7 @@ func TestGeneratorObjectAttribute_Imports(t *testing.T) {
 					},
 					nil,
 					"",
+					nil,
 				),
 			},
 			expected: []code.Import{},
@@ -540,6 +543,7 @@ func TestGeneratorObjectAttribute_Imports(t *testing.T) {
 					},
 					nil,
 					"",
+					nil,
 				),
 			},
 			expected: []code.Import{
@@ -993,6 +997,7 @@ func TestGeneratorObjectAttribute_Imports(t *testing.T) {
 						Type: "*api.ObjectAttribute",
 					},
 					"",
+					nil,
 				),
 			},
 			expected: []code.Import{
@@ -1416,6 +1421,7 @@ AttributeTypes: map[string]attr.Type{
 					},
 					nil,
 					"",
+					nil,
 				),
 			},
 			expected: `"object_attribute": schema.ObjectAttribute{
@@ -1437,6 +1443,7 @@ CustomType: my_custom_type,
 						Type: "*api.ObjectAttribute",
 					},
 					"object_attribute",
+					nil,
 				),
 			},
 			expected: `"object_attribute": schema.ObjectAttribute{
@@ -1464,6 +1471,7 @@ AttrTypes: ObjectAttributeValue{}.AttributeTypes(ctx),
 						Type: "*api.ObjectAttribute",
 					},
 					"object_attribute",
+					nil,
 				),
 			},
 			expected: `"object_attribute": schema.ObjectAttribute{
@@ -1917,6 +1925,7 @@ func TestGeneratorObjectAttribute_ModelField(t *testing.T) {
 					},
 					nil,
 					"",
+					nil,
 				),
 			},
 			expected: model.Field{
@@ -1933,6 +1942,7 @@ func TestGeneratorObjectAttribute_ModelField(t *testing.T) {
 						Type: "*api.ObjectAttribute",
 					},
 					"object_attribute",
+					nil,
 				),
 			},
 			expected: model.Field{
@@ -1951,6 +1961,7 @@ func TestGeneratorObjectAttribute_ModelField(t *testing.T) {
 						Type: "*api.ObjectAttribute",
 					},
 					"object_attribute",
+					nil,
 				),
 			},
 			expected: model.Field{
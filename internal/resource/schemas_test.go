@@ -8,7 +8,9 @@ import (
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-codegen-spec/resource"
 	"github.com/hashicorp/terraform-plugin-codegen-spec/schema"
+	"github.com/hashicorp/terraform-plugin-codegen-spec/spec"
 
 	"github.com/hashicorp/terraform-plugin-codegen-framework/internal/convert"
 	generatorschema "github.com/hashicorp/terraform-plugin-codegen-framework/internal/schema"
@@ -34,6 +36,7 @@ func TestGeneratorResourceSchemas_ModelsBytes(t *testing.T) {
 								},
 								nil,
 								"",
+								nil,
 							),
 						},
 						"float64_attribute": GeneratorFloat64Attribute{},
@@ -44,6 +47,7 @@ func TestGeneratorResourceSchemas_ModelsBytes(t *testing.T) {
 								},
 								nil,
 								"",
+								nil,
 							),
 						},
 						"int64_attribute": GeneratorInt64Attribute{},
@@ -54,6 +58,7 @@ func TestGeneratorResourceSchemas_ModelsBytes(t *testing.T) {
 								},
 								nil,
 								"",
+								nil,
 							),
 						},
 						"list_attribute": GeneratorListAttribute{},
@@ -66,6 +71,7 @@ func TestGeneratorResourceSchemas_ModelsBytes(t *testing.T) {
 								convert.CustomCollectionTypeList,
 								"",
 								"",
+								nil,
 							),
 						},
 						"list_nested_attribute": GeneratorListNestedAttribute{
@@ -95,6 +101,7 @@ func TestGeneratorResourceSchemas_ModelsBytes(t *testing.T) {
 								convert.CustomCollectionTypeList,
 								"",
 								"",
+								nil,
 							),
 						},
 						"map_nested_attribute": GeneratorMapNestedAttribute{
@@ -122,6 +129,7 @@ func TestGeneratorResourceSchemas_ModelsBytes(t *testing.T) {
 								},
 								nil,
 								"",
+								nil,
 							),
 						},
 						"object_attribute": GeneratorObjectAttribute{},
@@ -132,6 +140,7 @@ func TestGeneratorResourceSchemas_ModelsBytes(t *testing.T) {
 								},
 								nil,
 								"",
+								nil,
 							),
 						},
 						"set_attribute": GeneratorSetAttribute{},
@@ -144,6 +153,7 @@ func TestGeneratorResourceSchemas_ModelsBytes(t *testing.T) {
 								convert.CustomCollectionTypeList,
 								"",
 								"",
+								nil,
 							),
 						},
 						"set_nested_attribute": GeneratorSetNestedAttribute{
@@ -174,6 +184,7 @@ func TestGeneratorResourceSchemas_ModelsBytes(t *testing.T) {
 									ValueType: "my_single_nested_value_type",
 								},
 								"",
+								nil,
 							),
 							Attributes: generatorschema.GeneratorAttributes{
 								"bool_attribute": GeneratorBoolAttribute{},
@@ -187,6 +198,7 @@ func TestGeneratorResourceSchemas_ModelsBytes(t *testing.T) {
 								},
 								nil,
 								"",
+								nil,
 							),
 						},
 					},
@@ -240,6 +252,7 @@ func TestGeneratorResourceSchemas_ModelsBytes(t *testing.T) {
 									ValueType: "my_single_nested_value_type",
 								},
 								"",
+								nil,
 							),
 							Attributes: generatorschema.GeneratorAttributes{
 								"bool_attribute": GeneratorBoolAttribute{},
@@ -257,7 +270,7 @@ func TestGeneratorResourceSchemas_ModelsBytes(t *testing.T) {
 		t.Run(name, func(t *testing.T) {
 			t.Parallel()
 
-			g := generatorschema.NewGeneratorSchemas(testCase.input)
+			g := generatorschema.NewGeneratorSchemas(testCase.input, nil)
 			got, err := g.Models()
 
 			if diff := cmp.Diff(err, testCase.expectedError, equateErrorMessage); diff != "" {
@@ -275,3 +288,210 @@ func TestGeneratorResourceSchemas_ModelsBytes(t *testing.T) {
 		})
 	}
 }
+
+func TestGeneratorResourceSchemas_SharedTypes(t *testing.T) {
+	t.Parallel()
+
+	tags := func(attributes ...resource.Attribute) resource.Attribute {
+		return resource.Attribute{
+			Name: "tags",
+			ListNested: &resource.ListNestedAttribute{
+				NestedObject: resource.NestedAttributeObject{
+					Attributes: append([]resource.Attribute{
+						{
+							Name: "key",
+							String: &resource.StringAttribute{
+								ComputedOptionalRequired: "required",
+							},
+						},
+					}, attributes...),
+				},
+				ComputedOptionalRequired: "optional",
+			},
+		}
+	}
+
+	settings := func(attributes ...resource.Attribute) resource.Attribute {
+		return resource.Attribute{
+			Name: "settings",
+			SingleNested: &resource.SingleNestedAttribute{
+				Attributes:               attributes,
+				ComputedOptionalRequired: "optional",
+			},
+		}
+	}
+
+	testCases := map[string]struct {
+		input    []resource.Resource
+		expected []string
+	}{
+		"equal": {
+			input: []resource.Resource{
+				{
+					Name: "one",
+					Schema: &resource.Schema{
+						Attributes: []resource.Attribute{tags()},
+					},
+				},
+				{
+					Name: "two",
+					Schema: &resource.Schema{
+						Attributes: []resource.Attribute{tags()},
+					},
+				},
+			},
+			expected: []string{"tags"},
+		},
+		"single-occurrence": {
+			input: []resource.Resource{
+				{
+					Name: "one",
+					Schema: &resource.Schema{
+						Attributes: []resource.Attribute{tags()},
+					},
+				},
+			},
+			expected: []string{},
+		},
+		"not-equal": {
+			input: []resource.Resource{
+				{
+					Name: "one",
+					Schema: &resource.Schema{
+						Attributes: []resource.Attribute{tags()},
+					},
+				},
+				{
+					Name: "two",
+					Schema: &resource.Schema{
+						Attributes: []resource.Attribute{
+							tags(resource.Attribute{
+								Name: "value",
+								String: &resource.StringAttribute{
+									ComputedOptionalRequired: "optional",
+								},
+							}),
+						},
+					},
+				},
+			},
+			expected: []string{},
+		},
+		"nested-not-shared": {
+			input: []resource.Resource{
+				{
+					Name: "one",
+					Schema: &resource.Schema{
+						Attributes: []resource.Attribute{
+							settings(tags()),
+						},
+					},
+				},
+				{
+					Name: "two",
+					Schema: &resource.Schema{
+						Attributes: []resource.Attribute{
+							settings(tags()),
+						},
+					},
+				},
+				{
+					Name: "three",
+					Schema: &resource.Schema{
+						Attributes: []resource.Attribute{
+							tags(resource.Attribute{
+								Name: "value",
+								String: &resource.StringAttribute{
+									ComputedOptionalRequired: "optional",
+								},
+							}),
+						},
+					},
+				},
+			},
+			expected: []string{},
+		},
+		"nested-shared": {
+			input: []resource.Resource{
+				{
+					Name: "one",
+					Schema: &resource.Schema{
+						Attributes: []resource.Attribute{
+							settings(tags()),
+						},
+					},
+				},
+				{
+					Name: "two",
+					Schema: &resource.Schema{
+						Attributes: []resource.Attribute{
+							settings(tags()),
+						},
+					},
+				},
+			},
+			expected: []string{"settings", "tags"},
+		},
+		"associated-external-type-attribute": {
+			input: []resource.Resource{
+				{
+					Name: "one",
+					Schema: &resource.Schema{
+						Attributes: []resource.Attribute{
+							settings(resource.Attribute{
+								Name: "region",
+								String: &resource.StringAttribute{
+									AssociatedExternalType: &schema.AssociatedExternalType{
+										Type: "*apisdk.Region",
+									},
+									ComputedOptionalRequired: "optional",
+								},
+							}),
+						},
+					},
+				},
+				{
+					Name: "two",
+					Schema: &resource.Schema{
+						Attributes: []resource.Attribute{
+							settings(resource.Attribute{
+								Name: "region",
+								String: &resource.StringAttribute{
+									AssociatedExternalType: &schema.AssociatedExternalType{
+										Type: "*apisdk.Region",
+									},
+									ComputedOptionalRequired: "optional",
+								},
+							}),
+						},
+					},
+				},
+			},
+			expected: []string{},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			schemas, err := NewSchemas(spec.Specification{
+				Resources: testCase.input,
+			}, nil)
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			shared, err := generatorschema.NewGeneratorSchemas(schemas, nil).SharedTypes("resource")
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if diff := cmp.Diff(shared.Names(), testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
@@ -20,7 +20,7 @@ import (
 func TestGeneratorSetNestedAttribute_New(t *testing.T) {
 	t.Parallel()
 
-	attributes, err := NewAttributes(resource.Attributes{})
+	attributes, err := NewAttributes(resource.Attributes{}, "", nil)
 
 	if err != nil {
 		t.Error(err)
@@ -66,7 +66,7 @@ func TestGeneratorSetNestedAttribute_New(t *testing.T) {
 					Attributes: generatorschema.GeneratorAttributes{
 						"bool_attribute": GeneratorBoolAttribute{
 							ComputedOptionalRequired: convert.NewComputedOptionalRequired(specschema.Optional),
-							CustomType:               convert.NewCustomTypePrimitive(nil, nil, "bool_attribute"),
+							CustomType:               convert.NewCustomTypePrimitive(nil, nil, "bool_attribute", nil),
 							PlanModifiers:            convert.NewPlanModifiers(convert.PlanModifierTypeBool, specschema.CustomPlanModifiers{}),
 							Validators:               convert.NewValidators(convert.ValidatorTypeBool, specschema.CustomValidators{}),
 						},
@@ -76,7 +76,7 @@ func TestGeneratorSetNestedAttribute_New(t *testing.T) {
 					generatorschema.GeneratorAttributes{
 						"bool_attribute": GeneratorBoolAttribute{
 							ComputedOptionalRequired: convert.NewComputedOptionalRequired(specschema.Optional),
-							CustomType:               convert.NewCustomTypePrimitive(nil, nil, "bool_attribute"),
+							CustomType:               convert.NewCustomTypePrimitive(nil, nil, "bool_attribute", nil),
 							PlanModifiers:            convert.NewPlanModifiers(convert.PlanModifierTypeBool, specschema.CustomPlanModifiers{}),
 							Validators:               convert.NewValidators(convert.ValidatorTypeBool, specschema.CustomValidators{}),
 						},
@@ -85,6 +85,7 @@ func TestGeneratorSetNestedAttribute_New(t *testing.T) {
 					convert.NewPlanModifiers(convert.PlanModifierTypeObject, specschema.CustomPlanModifiers{}),
 					convert.NewValidators(convert.ValidatorTypeObject, specschema.CustomValidators{}),
 					"name",
+					nil,
 				),
 				PlanModifiers: convert.NewPlanModifiers(convert.PlanModifierTypeSet, specschema.CustomPlanModifiers{}),
 				Validators:    convert.NewValidators(convert.ValidatorTypeSet, specschema.CustomValidators{}),
@@ -117,6 +118,7 @@ func TestGeneratorSetNestedAttribute_New(t *testing.T) {
 								convert.CustomCollectionTypeList,
 								"types.BoolType",
 								"list_attribute",
+								nil,
 							),
 							ElementType: specschema.ElementType{
 								Bool: &specschema.BoolType{},
@@ -139,6 +141,7 @@ func TestGeneratorSetNestedAttribute_New(t *testing.T) {
 								convert.CustomCollectionTypeList,
 								"types.BoolType",
 								"list_attribute",
+								nil,
 							),
 							ElementType: specschema.ElementType{
 								Bool: &specschema.BoolType{},
@@ -154,6 +157,7 @@ func TestGeneratorSetNestedAttribute_New(t *testing.T) {
 					convert.NewPlanModifiers(convert.PlanModifierTypeObject, specschema.CustomPlanModifiers{}),
 					convert.NewValidators(convert.ValidatorTypeObject, specschema.CustomValidators{}),
 					"name",
+					nil,
 				),
 				PlanModifiers: convert.NewPlanModifiers(convert.PlanModifierTypeSet, specschema.CustomPlanModifiers{}),
 				Validators:    convert.NewValidators(convert.ValidatorTypeSet, specschema.CustomValidators{}),
@@ -190,7 +194,7 @@ func TestGeneratorSetNestedAttribute_New(t *testing.T) {
 								Attributes: generatorschema.GeneratorAttributes{
 									"nested_bool": GeneratorBoolAttribute{
 										ComputedOptionalRequired: convert.NewComputedOptionalRequired(specschema.Computed),
-										CustomType:               convert.NewCustomTypePrimitive(nil, nil, "nested_bool"),
+										CustomType:               convert.NewCustomTypePrimitive(nil, nil, "nested_bool", nil),
 										PlanModifiers:            convert.NewPlanModifiers(convert.PlanModifierTypeBool, specschema.CustomPlanModifiers{}),
 										Validators:               convert.NewValidators(convert.ValidatorTypeBool, specschema.CustomValidators{}),
 									},
@@ -201,7 +205,7 @@ func TestGeneratorSetNestedAttribute_New(t *testing.T) {
 								generatorschema.GeneratorAttributes{
 									"nested_bool": GeneratorBoolAttribute{
 										ComputedOptionalRequired: convert.NewComputedOptionalRequired(specschema.Computed),
-										CustomType:               convert.NewCustomTypePrimitive(nil, nil, "nested_bool"),
+										CustomType:               convert.NewCustomTypePrimitive(nil, nil, "nested_bool", nil),
 										PlanModifiers:            convert.NewPlanModifiers(convert.PlanModifierTypeBool, specschema.CustomPlanModifiers{}),
 										Validators:               convert.NewValidators(convert.ValidatorTypeBool, specschema.CustomValidators{}),
 									},
@@ -210,6 +214,7 @@ func TestGeneratorSetNestedAttribute_New(t *testing.T) {
 								convert.NewPlanModifiers(convert.PlanModifierTypeObject, specschema.CustomPlanModifiers{}),
 								convert.NewValidators(convert.ValidatorTypeObject, specschema.CustomValidators{}),
 								"nested_attribute",
+								nil,
 							),
 							PlanModifiers: convert.NewPlanModifiers(convert.PlanModifierTypeList, specschema.CustomPlanModifiers{}),
 							Validators:    convert.NewValidators(convert.ValidatorTypeList, specschema.CustomValidators{}),
@@ -223,7 +228,7 @@ func TestGeneratorSetNestedAttribute_New(t *testing.T) {
 								Attributes: generatorschema.GeneratorAttributes{
 									"nested_bool": GeneratorBoolAttribute{
 										ComputedOptionalRequired: convert.NewComputedOptionalRequired(specschema.Computed),
-										CustomType:               convert.NewCustomTypePrimitive(nil, nil, "nested_bool"),
+										CustomType:               convert.NewCustomTypePrimitive(nil, nil, "nested_bool", nil),
 										PlanModifiers:            convert.NewPlanModifiers(convert.PlanModifierTypeBool, specschema.CustomPlanModifiers{}),
 										Validators:               convert.NewValidators(convert.ValidatorTypeBool, specschema.CustomValidators{}),
 									},
@@ -234,7 +239,7 @@ func TestGeneratorSetNestedAttribute_New(t *testing.T) {
 								generatorschema.GeneratorAttributes{
 									"nested_bool": GeneratorBoolAttribute{
 										ComputedOptionalRequired: convert.NewComputedOptionalRequired(specschema.Computed),
-										CustomType:               convert.NewCustomTypePrimitive(nil, nil, "nested_bool"),
+										CustomType:               convert.NewCustomTypePrimitive(nil, nil, "nested_bool", nil),
 										PlanModifiers:            convert.NewPlanModifiers(convert.PlanModifierTypeBool, specschema.CustomPlanModifiers{}),
 										Validators:               convert.NewValidators(convert.ValidatorTypeBool, specschema.CustomValidators{}),
 									},
@@ -243,6 +248,7 @@ func TestGeneratorSetNestedAttribute_New(t *testing.T) {
 								convert.NewPlanModifiers(convert.PlanModifierTypeObject, specschema.CustomPlanModifiers{}),
 								convert.NewValidators(convert.ValidatorTypeObject, specschema.CustomValidators{}),
 								"nested_attribute",
+								nil,
 							),
 							PlanModifiers: convert.NewPlanModifiers(convert.PlanModifierTypeList, specschema.CustomPlanModifiers{}),
 							Validators:    convert.NewValidators(convert.ValidatorTypeList, specschema.CustomValidators{}),
@@ -252,6 +258,7 @@ func TestGeneratorSetNestedAttribute_New(t *testing.T) {
 					convert.NewPlanModifiers(convert.PlanModifierTypeObject, specschema.CustomPlanModifiers{}),
 					convert.NewValidators(convert.ValidatorTypeObject, specschema.CustomValidators{}),
 					"name",
+					nil,
 				),
 				PlanModifiers: convert.NewPlanModifiers(convert.PlanModifierTypeSet, specschema.CustomPlanModifiers{}),
 				Validators:    convert.NewValidators(convert.ValidatorTypeSet, specschema.CustomValidators{}),
@@ -293,7 +300,7 @@ func TestGeneratorSetNestedAttribute_New(t *testing.T) {
 								},
 							}),
 							ComputedOptionalRequired: convert.NewComputedOptionalRequired(specschema.Optional),
-							CustomType:               convert.NewCustomTypeObject(nil, nil, "object_attribute"),
+							CustomType:               convert.NewCustomTypeObject(nil, nil, "object_attribute", nil),
 							PlanModifiers:            convert.NewPlanModifiers(convert.PlanModifierTypeObject, specschema.CustomPlanModifiers{}),
 							Validators:               convert.NewValidators(convert.ValidatorTypeObject, specschema.CustomValidators{}),
 						},
@@ -315,7 +322,7 @@ func TestGeneratorSetNestedAttribute_New(t *testing.T) {
 								},
 							}),
 							ComputedOptionalRequired: convert.NewComputedOptionalRequired(specschema.Optional),
-							CustomType:               convert.NewCustomTypeObject(nil, nil, "object_attribute"),
+							CustomType:               convert.NewCustomTypeObject(nil, nil, "object_attribute", nil),
 							PlanModifiers:            convert.NewPlanModifiers(convert.PlanModifierTypeObject, specschema.CustomPlanModifiers{}),
 							Validators:               convert.NewValidators(convert.ValidatorTypeObject, specschema.CustomValidators{}),
 						},
@@ -324,6 +331,7 @@ func TestGeneratorSetNestedAttribute_New(t *testing.T) {
 					convert.NewPlanModifiers(convert.PlanModifierTypeObject, specschema.CustomPlanModifiers{}),
 					convert.NewValidators(convert.ValidatorTypeObject, specschema.CustomValidators{}),
 					"name",
+					nil,
 				),
 				PlanModifiers: convert.NewPlanModifiers(convert.PlanModifierTypeSet, specschema.CustomPlanModifiers{}),
 				Validators:    convert.NewValidators(convert.ValidatorTypeSet, specschema.CustomValidators{}),
@@ -357,13 +365,13 @@ func TestGeneratorSetNestedAttribute_New(t *testing.T) {
 							Attributes: generatorschema.GeneratorAttributes{
 								"nested_bool": GeneratorBoolAttribute{
 									ComputedOptionalRequired: convert.NewComputedOptionalRequired(specschema.Computed),
-									CustomType:               convert.NewCustomTypePrimitive(nil, nil, "nested_bool"),
+									CustomType:               convert.NewCustomTypePrimitive(nil, nil, "nested_bool", nil),
 									PlanModifiers:            convert.NewPlanModifiers(convert.PlanModifierTypeBool, specschema.CustomPlanModifiers{}),
 									Validators:               convert.NewValidators(convert.ValidatorTypeBool, specschema.CustomValidators{}),
 								},
 							},
 							ComputedOptionalRequired: convert.NewComputedOptionalRequired(specschema.Optional),
-							CustomType:               convert.NewCustomTypeNestedObject(nil, "nested_attribute"),
+							CustomType:               convert.NewCustomTypeNestedObject(nil, "nested_attribute", nil),
 							PlanModifiers:            convert.NewPlanModifiers(convert.PlanModifierTypeObject, specschema.CustomPlanModifiers{}),
 							Validators:               convert.NewValidators(convert.ValidatorTypeObject, specschema.CustomValidators{}),
 						},
@@ -375,13 +383,13 @@ func TestGeneratorSetNestedAttribute_New(t *testing.T) {
 							Attributes: generatorschema.GeneratorAttributes{
 								"nested_bool": GeneratorBoolAttribute{
 									ComputedOptionalRequired: convert.NewComputedOptionalRequired(specschema.Computed),
-									CustomType:               convert.NewCustomTypePrimitive(nil, nil, "nested_bool"),
+									CustomType:               convert.NewCustomTypePrimitive(nil, nil, "nested_bool", nil),
 									PlanModifiers:            convert.NewPlanModifiers(convert.PlanModifierTypeBool, specschema.CustomPlanModifiers{}),
 									Validators:               convert.NewValidators(convert.ValidatorTypeBool, specschema.CustomValidators{}),
 								},
 							},
 							ComputedOptionalRequired: convert.NewComputedOptionalRequired(specschema.Optional),
-							CustomType:               convert.NewCustomTypeNestedObject(nil, "nested_attribute"),
+							CustomType:               convert.NewCustomTypeNestedObject(nil, "nested_attribute", nil),
 							PlanModifiers:            convert.NewPlanModifiers(convert.PlanModifierTypeObject, specschema.CustomPlanModifiers{}),
 							Validators:               convert.NewValidators(convert.ValidatorTypeObject, specschema.CustomValidators{}),
 						},
@@ -390,6 +398,7 @@ func TestGeneratorSetNestedAttribute_New(t *testing.T) {
 					convert.NewPlanModifiers(convert.PlanModifierTypeObject, specschema.CustomPlanModifiers{}),
 					convert.NewValidators(convert.ValidatorTypeObject, specschema.CustomValidators{}),
 					"name",
+					nil,
 				),
 				PlanModifiers: convert.NewPlanModifiers(convert.PlanModifierTypeSet, specschema.CustomPlanModifiers{}),
 				Validators:    convert.NewValidators(convert.ValidatorTypeSet, specschema.CustomValidators{}),
@@ -410,6 +419,7 @@ func TestGeneratorSetNestedAttribute_New(t *testing.T) {
 					convert.NewPlanModifiers(convert.PlanModifierTypeObject, specschema.CustomPlanModifiers{}),
 					convert.NewValidators(convert.ValidatorTypeObject, specschema.CustomValidators{}),
 					"name",
+					nil,
 				),
 				PlanModifiers: convert.NewPlanModifiers(convert.PlanModifierTypeSet, specschema.CustomPlanModifiers{}),
 				Validators:    convert.NewValidators(convert.ValidatorTypeSet, specschema.CustomValidators{}),
@@ -430,6 +440,7 @@ func TestGeneratorSetNestedAttribute_New(t *testing.T) {
 					convert.NewPlanModifiers(convert.PlanModifierTypeObject, specschema.CustomPlanModifiers{}),
 					convert.NewValidators(convert.ValidatorTypeObject, specschema.CustomValidators{}),
 					"name",
+					nil,
 				),
 				PlanModifiers: convert.NewPlanModifiers(convert.PlanModifierTypeSet, specschema.CustomPlanModifiers{}),
 				Validators:    convert.NewValidators(convert.ValidatorTypeSet, specschema.CustomValidators{}),
@@ -450,6 +461,7 @@ func TestGeneratorSetNestedAttribute_New(t *testing.T) {
 					convert.NewPlanModifiers(convert.PlanModifierTypeObject, specschema.CustomPlanModifiers{}),
 					convert.NewValidators(convert.ValidatorTypeObject, specschema.CustomValidators{}),
 					"name",
+					nil,
 				),
 				PlanModifiers: convert.NewPlanModifiers(convert.PlanModifierTypeSet, specschema.CustomPlanModifiers{}),
 				Validators:    convert.NewValidators(convert.ValidatorTypeSet, specschema.CustomValidators{})},
@@ -469,6 +481,7 @@ func TestGeneratorSetNestedAttribute_New(t *testing.T) {
 					convert.NewPlanModifiers(convert.PlanModifierTypeObject, specschema.CustomPlanModifiers{}),
 					convert.NewValidators(convert.ValidatorTypeObject, specschema.CustomValidators{}),
 					"name",
+					nil,
 				),
 				PlanModifiers: convert.NewPlanModifiers(convert.PlanModifierTypeSet, specschema.CustomPlanModifiers{}),
 				Validators:    convert.NewValidators(convert.ValidatorTypeSet, specschema.CustomValidators{}),
@@ -501,6 +514,7 @@ func TestGeneratorSetNestedAttribute_New(t *testing.T) {
 					convert.NewPlanModifiers(convert.PlanModifierTypeObject, specschema.CustomPlanModifiers{}),
 					convert.NewValidators(convert.ValidatorTypeObject, nil),
 					"name",
+					nil,
 				),
 				PlanModifiers: convert.NewPlanModifiers(convert.PlanModifierTypeSet, specschema.CustomPlanModifiers{}),
 				Validators:    convert.NewValidators(convert.ValidatorTypeSet, nil),
@@ -521,6 +535,7 @@ func TestGeneratorSetNestedAttribute_New(t *testing.T) {
 					convert.NewPlanModifiers(convert.PlanModifierTypeObject, specschema.CustomPlanModifiers{}),
 					convert.NewValidators(convert.ValidatorTypeObject, nil),
 					"name",
+					nil,
 				),
 				PlanModifiers: convert.NewPlanModifiers(convert.PlanModifierTypeSet, specschema.CustomPlanModifiers{}),
 				Validators:    convert.NewValidators(convert.ValidatorTypeSet, nil),
@@ -541,6 +556,7 @@ func TestGeneratorSetNestedAttribute_New(t *testing.T) {
 					convert.NewPlanModifiers(convert.PlanModifierTypeObject, specschema.CustomPlanModifiers{}),
 					convert.NewValidators(convert.ValidatorTypeObject, nil),
 					"name",
+					nil,
 				),
 				PlanModifiers: convert.NewPlanModifiers(convert.PlanModifierTypeSet, specschema.CustomPlanModifiers{}),
 				Validators:    convert.NewValidators(convert.ValidatorTypeSet, nil),
@@ -560,6 +576,7 @@ func TestGeneratorSetNestedAttribute_New(t *testing.T) {
 					convert.NewPlanModifiers(convert.PlanModifierTypeObject, specschema.CustomPlanModifiers{}),
 					convert.NewValidators(convert.ValidatorTypeObject, nil),
 					"name",
+					nil,
 				),
 				Sensitive:     convert.NewSensitive(pointer(true)),
 				PlanModifiers: convert.NewPlanModifiers(convert.PlanModifierTypeSet, specschema.CustomPlanModifiers{}),
@@ -591,6 +608,7 @@ func TestGeneratorSetNestedAttribute_New(t *testing.T) {
 					convert.NewPlanModifiers(convert.PlanModifierTypeObject, specschema.CustomPlanModifiers{}),
 					convert.NewValidators(convert.ValidatorTypeObject, nil),
 					"name",
+					nil,
 				),
 				PlanModifiers: convert.NewPlanModifiers(convert.PlanModifierTypeSet, specschema.CustomPlanModifiers{}),
 				Validators: convert.NewValidators(convert.ValidatorTypeSet, specschema.CustomValidators{
@@ -630,6 +648,7 @@ func TestGeneratorSetNestedAttribute_New(t *testing.T) {
 					convert.NewPlanModifiers(convert.PlanModifierTypeObject, specschema.CustomPlanModifiers{}),
 					convert.NewValidators(convert.ValidatorTypeObject, nil),
 					"name",
+					nil,
 				),
 				PlanModifiers: convert.NewPlanModifiers(convert.PlanModifierTypeSet, specschema.CustomPlanModifiers{
 					&specschema.CustomPlanModifier{
@@ -675,6 +694,7 @@ func TestGeneratorSetNestedAttribute_New(t *testing.T) {
 					convert.NewPlanModifiers(convert.PlanModifierTypeObject, specschema.CustomPlanModifiers{}),
 					convert.NewValidators(convert.ValidatorTypeObject, nil),
 					"name",
+					nil,
 				),
 				PlanModifiers: convert.NewPlanModifiers(convert.PlanModifierTypeSet, specschema.CustomPlanModifiers{}),
 				Validators:    convert.NewValidators(convert.ValidatorTypeSet, nil),
@@ -687,7 +707,7 @@ func TestGeneratorSetNestedAttribute_New(t *testing.T) {
 		t.Run(name, func(t *testing.T) {
 			t.Parallel()
 
-			got, err := NewGeneratorSetNestedAttribute("name", testCase.input)
+			got, err := NewGeneratorSetNestedAttribute("name", testCase.input, nil)
 
 			if diff := cmp.Diff(err, testCase.expectedError, equateErrorMessage); diff != "" {
 				t.Errorf("unexpected error: %s", diff)
@@ -756,6 +776,7 @@ func TestGeneratorSetNestedAttribute_Imports(t *testing.T) {
 					convert.NewPlanModifiers(convert.PlanModifierTypeObject, nil),
 					convert.NewValidators(convert.ValidatorTypeObject, nil),
 					"",
+					nil,
 				),
 			},
 			expected: []code.Import{
@@ -819,6 +840,7 @@ func TestGeneratorSetNestedAttribute_Imports(t *testing.T) {
 					convert.NewPlanModifiers(convert.PlanModifierTypeObject, nil),
 					convert.NewValidators(convert.ValidatorTypeObject, nil),
 					"",
+					nil,
 				),
 			},
 			expected: []code.Import{
@@ -861,6 +883,7 @@ func TestGeneratorSetNestedAttribute_Imports(t *testing.T) {
 					convert.NewPlanModifiers(convert.PlanModifierTypeObject, nil),
 					convert.NewValidators(convert.ValidatorTypeObject, nil),
 					"",
+					nil,
 				),
 			},
 			expected: []code.Import{
@@ -894,6 +917,7 @@ func TestGeneratorSetNestedAttribute_Imports(t *testing.T) {
 					convert.NewPlanModifiers(convert.PlanModifierTypeObject, nil),
 					convert.NewValidators(convert.ValidatorTypeObject, nil),
 					"",
+					nil,
 				),
 			},
 			expected: []code.Import{
@@ -942,6 +966,7 @@ func TestGeneratorSetNestedAttribute_Imports(t *testing.T) {
 								convert.CustomCollectionTypeList,
 								"",
 								"",
+								nil,
 							),
 						},
 					},
@@ -949,6 +974,7 @@ func TestGeneratorSetNestedAttribute_Imports(t *testing.T) {
 					convert.NewPlanModifiers(convert.PlanModifierTypeObject, nil),
 					convert.NewValidators(convert.ValidatorTypeObject, nil),
 					"",
+					nil,
 				),
 			},
 			expected: []code.Import{
@@ -978,6 +1004,7 @@ func TestGeneratorSetNestedAttribute_Imports(t *testing.T) {
 								convert.CustomCollectionTypeList,
 								"",
 								"",
+								nil,
 							),
 							ElementTypeCollection: convert.NewElementType(specschema.ElementType{
 								Bool: &specschema.BoolType{
@@ -994,6 +1021,7 @@ func TestGeneratorSetNestedAttribute_Imports(t *testing.T) {
 					convert.NewPlanModifiers(convert.PlanModifierTypeObject, nil),
 					convert.NewValidators(convert.ValidatorTypeObject, nil),
 					"",
+					nil,
 				),
 			},
 			expected: []code.Import{
@@ -1048,6 +1076,7 @@ func TestGeneratorSetNestedAttribute_Imports(t *testing.T) {
 								},
 								nil,
 								"",
+								nil,
 							),
 						},
 					},
@@ -1055,6 +1084,7 @@ func TestGeneratorSetNestedAttribute_Imports(t *testing.T) {
 					convert.NewPlanModifiers(convert.PlanModifierTypeObject, nil),
 					convert.NewValidators(convert.ValidatorTypeObject, nil),
 					"",
+					nil,
 				),
 			},
 			expected: []code.Import{
@@ -1094,6 +1124,7 @@ func TestGeneratorSetNestedAttribute_Imports(t *testing.T) {
 								},
 								nil,
 								"",
+								nil,
 							),
 						},
 					},
@@ -1101,6 +1132,7 @@ func TestGeneratorSetNestedAttribute_Imports(t *testing.T) {
 					convert.NewPlanModifiers(convert.PlanModifierTypeObject, nil),
 					convert.NewValidators(convert.ValidatorTypeObject, nil),
 					"",
+					nil,
 				),
 			},
 			expected: []code.Import{
@@ -1290,6 +1322,7 @@ func TestGeneratorSetNestedAttribute_Imports(t *testing.T) {
 						},
 					}),
 					"",
+					nil,
 				),
 			},
 			expected: []code.Import{
@@ -1333,6 +1366,7 @@ func TestGeneratorSetNestedAttribute_Imports(t *testing.T) {
 					}),
 					convert.NewValidators(convert.ValidatorTypeObject, nil),
 					"",
+					nil,
 				),
 			},
 			expected: []code.Import{
@@ -1391,6 +1425,7 @@ func TestGeneratorSetNestedAttribute_Schema(t *testing.T) {
 					convert.NewPlanModifiers(convert.PlanModifierTypeObject, nil),
 					convert.NewValidators(convert.ValidatorTypeObject, nil),
 					attributeName,
+					nil,
 				),
 			},
 			expected: `"set_nested_attribute": schema.SetNestedAttribute{
@@ -1424,6 +1459,7 @@ AttrTypes: SetNestedAttributeValue{}.AttributeTypes(ctx),
 					convert.NewPlanModifiers(convert.PlanModifierTypeObject, nil),
 					convert.NewValidators(convert.ValidatorTypeObject, nil),
 					attributeName,
+					nil,
 				),
 			},
 			expected: `"set_nested_attribute": schema.SetNestedAttribute{
@@ -1458,6 +1494,7 @@ AttrTypes: SetNestedAttributeValue{}.AttributeTypes(ctx),
 								convert.NewPlanModifiers(convert.PlanModifierTypeObject, nil),
 								convert.NewValidators(convert.ValidatorTypeObject, nil),
 								"nested_set_nested",
+								nil,
 							),
 						},
 					},
@@ -1465,6 +1502,7 @@ AttrTypes: SetNestedAttributeValue{}.AttributeTypes(ctx),
 					convert.NewPlanModifiers(convert.PlanModifierTypeObject, nil),
 					convert.NewValidators(convert.ValidatorTypeObject, nil),
 					attributeName,
+					nil,
 				),
 			},
 			expected: `"set_nested_attribute": schema.SetNestedAttribute{
@@ -1512,6 +1550,7 @@ AttrTypes: SetNestedAttributeValue{}.AttributeTypes(ctx),
 					convert.NewPlanModifiers(convert.PlanModifierTypeObject, nil),
 					convert.NewValidators(convert.ValidatorTypeObject, nil),
 					attributeName,
+					nil,
 				),
 			},
 			expected: `"set_nested_attribute": schema.SetNestedAttribute{
@@ -1543,13 +1582,14 @@ AttrTypes: SetNestedAttributeValue{}.AttributeTypes(ctx),
 									ComputedOptionalRequired: convert.NewComputedOptionalRequired(specschema.Optional),
 								},
 							},
-							CustomType: convert.NewCustomTypeNestedObject(nil, "nested_single_nested"),
+							CustomType: convert.NewCustomTypeNestedObject(nil, "nested_single_nested", nil),
 						},
 					},
 					nil,
 					convert.NewPlanModifiers(convert.PlanModifierTypeObject, nil),
 					convert.NewValidators(convert.ValidatorTypeObject, nil),
 					attributeName,
+					nil,
 				),
 			},
 			expected: `"set_nested_attribute": schema.SetNestedAttribute{
@@ -1588,6 +1628,7 @@ AttrTypes: SetNestedAttributeValue{}.AttributeTypes(ctx),
 					convert.NewPlanModifiers(convert.PlanModifierTypeObject, nil),
 					convert.NewValidators(convert.ValidatorTypeObject, nil),
 					attributeName,
+					nil,
 				),
 			},
 			expected: `"set_nested_attribute": schema.SetNestedAttribute{
@@ -1613,6 +1654,7 @@ CustomType: my_custom_type,
 					convert.PlanModifiers{},
 					convert.Validators{},
 					"set_nested_attribute",
+					nil,
 				),
 			},
 			expected: `"set_nested_attribute": schema.SetNestedAttribute{
@@ -1638,6 +1680,7 @@ Required: true,
 					convert.PlanModifiers{},
 					convert.Validators{},
 					"set_nested_attribute",
+					nil,
 				),
 			},
 			expected: `"set_nested_attribute": schema.SetNestedAttribute{
@@ -1663,6 +1706,7 @@ Optional: true,
 					convert.PlanModifiers{},
 					convert.Validators{},
 					"set_nested_attribute",
+					nil,
 				),
 			},
 			expected: `"set_nested_attribute": schema.SetNestedAttribute{
@@ -1687,6 +1731,7 @@ Computed: true,
 					convert.PlanModifiers{},
 					convert.Validators{},
 					"set_nested_attribute",
+					nil,
 				),
 				Sensitive: convert.NewSensitive(pointer(true)),
 			},
@@ -1713,6 +1758,7 @@ Sensitive: true,
 					convert.PlanModifiers{},
 					convert.Validators{},
 					"set_nested_attribute",
+					nil,
 				),
 			},
 			expected: `"set_nested_attribute": schema.SetNestedAttribute{
@@ -1739,6 +1785,7 @@ MarkdownDescription: "description",
 					convert.PlanModifiers{},
 					convert.Validators{},
 					"set_nested_attribute",
+					nil,
 				),
 			},
 			expected: `"set_nested_attribute": schema.SetNestedAttribute{
@@ -1763,6 +1810,7 @@ DeprecationMessage: "deprecated",
 					convert.NewPlanModifiers(convert.PlanModifierTypeObject, nil),
 					convert.NewValidators(convert.ValidatorTypeObject, nil),
 					attributeName,
+					nil,
 				),
 				Validators: convert.NewValidators(convert.ValidatorTypeSet, specschema.CustomValidators{
 					&specschema.CustomValidator{
@@ -1800,6 +1848,7 @@ my_other_validator.Validate(),
 					convert.NewPlanModifiers(convert.PlanModifierTypeObject, nil),
 					convert.NewValidators(convert.ValidatorTypeObject, nil),
 					attributeName,
+					nil,
 				),
 			},
 			expected: `"set_nested_attribute": schema.SetNestedAttribute{
@@ -1826,6 +1875,7 @@ CustomType: my_custom_type,
 						},
 					}),
 					attributeName,
+					nil,
 				),
 			},
 			expected: `"set_nested_attribute": schema.SetNestedAttribute{
@@ -1853,6 +1903,7 @@ my_other_validator.Validate(),
 					convert.NewPlanModifiers(convert.PlanModifierTypeObject, nil),
 					convert.NewValidators(convert.ValidatorTypeObject, nil),
 					attributeName,
+					nil,
 				),
 				PlanModifiers: convert.NewPlanModifiers(convert.PlanModifierTypeSet, specschema.CustomPlanModifiers{
 					&specschema.CustomPlanModifier{
@@ -1888,6 +1939,7 @@ my_other_plan_modifier.Modify(),
 					convert.NewPlanModifiers(convert.PlanModifierTypeObject, nil),
 					convert.NewValidators(convert.ValidatorTypeObject, nil),
 					attributeName,
+					nil,
 				),
 				Default: convert.NewDefaultCustom(&specschema.CustomDefault{
 					SchemaDefinition: "my_set_default.Default()",
@@ -60,7 +60,7 @@ func TestGeneratorSetNestedBlock_New(t *testing.T) {
 					Attributes: generatorschema.GeneratorAttributes{
 						"bool_attribute": GeneratorBoolAttribute{
 							ComputedOptionalRequired: convert.NewComputedOptionalRequired(specschema.Optional),
-							CustomType:               convert.NewCustomTypePrimitive(nil, nil, "bool_attribute"),
+							CustomType:               convert.NewCustomTypePrimitive(nil, nil, "bool_attribute", nil),
 							PlanModifiers:            convert.NewPlanModifiers(convert.PlanModifierTypeBool, specschema.CustomPlanModifiers{}),
 							Validators:               convert.NewValidators(convert.ValidatorTypeBool, specschema.CustomValidators{}),
 						},
@@ -70,7 +70,7 @@ func TestGeneratorSetNestedBlock_New(t *testing.T) {
 					generatorschema.GeneratorAttributes{
 						"bool_attribute": GeneratorBoolAttribute{
 							ComputedOptionalRequired: convert.NewComputedOptionalRequired(specschema.Optional),
-							CustomType:               convert.NewCustomTypePrimitive(nil, nil, "bool_attribute"),
+							CustomType:               convert.NewCustomTypePrimitive(nil, nil, "bool_attribute", nil),
 							PlanModifiers:            convert.NewPlanModifiers(convert.PlanModifierTypeBool, specschema.CustomPlanModifiers{}),
 							Validators:               convert.NewValidators(convert.ValidatorTypeBool, specschema.CustomValidators{}),
 						},
@@ -80,6 +80,7 @@ func TestGeneratorSetNestedBlock_New(t *testing.T) {
 					convert.NewPlanModifiers(convert.PlanModifierTypeObject, specschema.CustomPlanModifiers{}),
 					convert.NewValidators(convert.ValidatorTypeObject, specschema.CustomValidators{}),
 					"name",
+					nil,
 				),
 				PlanModifiers: convert.NewPlanModifiers(convert.PlanModifierTypeSet, specschema.CustomPlanModifiers{}),
 				Validators:    convert.NewValidators(convert.ValidatorTypeSet, specschema.CustomValidators{}),
@@ -112,6 +113,7 @@ func TestGeneratorSetNestedBlock_New(t *testing.T) {
 								convert.CustomCollectionTypeSet,
 								"types.BoolType",
 								"set_attribute",
+								nil,
 							),
 							ElementType: specschema.ElementType{
 								Bool: &specschema.BoolType{},
@@ -134,6 +136,7 @@ func TestGeneratorSetNestedBlock_New(t *testing.T) {
 								convert.CustomCollectionTypeSet,
 								"types.BoolType",
 								"set_attribute",
+								nil,
 							),
 							ElementType: specschema.ElementType{
 								Bool: &specschema.BoolType{},
@@ -150,6 +153,7 @@ func TestGeneratorSetNestedBlock_New(t *testing.T) {
 					convert.NewPlanModifiers(convert.PlanModifierTypeObject, specschema.CustomPlanModifiers{}),
 					convert.NewValidators(convert.ValidatorTypeObject, specschema.CustomValidators{}),
 					"name",
+					nil,
 				),
 				PlanModifiers: convert.NewPlanModifiers(convert.PlanModifierTypeSet, specschema.CustomPlanModifiers{}),
 				Validators:    convert.NewValidators(convert.ValidatorTypeSet, specschema.CustomValidators{}),
@@ -187,7 +191,7 @@ func TestGeneratorSetNestedBlock_New(t *testing.T) {
 								Attributes: generatorschema.GeneratorAttributes{
 									"nested_bool": GeneratorBoolAttribute{
 										ComputedOptionalRequired: convert.NewComputedOptionalRequired(specschema.Computed),
-										CustomType:               convert.NewCustomTypePrimitive(nil, nil, "nested_bool"),
+										CustomType:               convert.NewCustomTypePrimitive(nil, nil, "nested_bool", nil),
 										PlanModifiers:            convert.NewPlanModifiers(convert.PlanModifierTypeBool, nil),
 										Validators:               convert.NewValidators(convert.ValidatorTypeBool, nil),
 									},
@@ -197,7 +201,7 @@ func TestGeneratorSetNestedBlock_New(t *testing.T) {
 								generatorschema.GeneratorAttributes{
 									"nested_bool": GeneratorBoolAttribute{
 										ComputedOptionalRequired: convert.NewComputedOptionalRequired(specschema.Computed),
-										CustomType:               convert.NewCustomTypePrimitive(nil, nil, "nested_bool"),
+										CustomType:               convert.NewCustomTypePrimitive(nil, nil, "nested_bool", nil),
 										PlanModifiers:            convert.NewPlanModifiers(convert.PlanModifierTypeBool, nil),
 										Validators:               convert.NewValidators(convert.ValidatorTypeBool, nil),
 									},
@@ -206,6 +210,7 @@ func TestGeneratorSetNestedBlock_New(t *testing.T) {
 								convert.NewPlanModifiers(convert.PlanModifierTypeObject, nil),
 								convert.NewValidators(convert.ValidatorTypeObject, nil),
 								"nested_attribute",
+								nil,
 							),
 							PlanModifiers: convert.NewPlanModifiers(convert.PlanModifierTypeSet, nil),
 							Validators:    convert.NewValidators(convert.ValidatorTypeSet, nil),
@@ -220,7 +225,7 @@ func TestGeneratorSetNestedBlock_New(t *testing.T) {
 								Attributes: generatorschema.GeneratorAttributes{
 									"nested_bool": GeneratorBoolAttribute{
 										ComputedOptionalRequired: convert.NewComputedOptionalRequired(specschema.Computed),
-										CustomType:               convert.NewCustomTypePrimitive(nil, nil, "nested_bool"),
+										CustomType:               convert.NewCustomTypePrimitive(nil, nil, "nested_bool", nil),
 										PlanModifiers:            convert.NewPlanModifiers(convert.PlanModifierTypeBool, nil),
 										Validators:               convert.NewValidators(convert.ValidatorTypeBool, nil),
 									},
@@ -230,7 +235,7 @@ func TestGeneratorSetNestedBlock_New(t *testing.T) {
 								generatorschema.GeneratorAttributes{
 									"nested_bool": GeneratorBoolAttribute{
 										ComputedOptionalRequired: convert.NewComputedOptionalRequired(specschema.Computed),
-										CustomType:               convert.NewCustomTypePrimitive(nil, nil, "nested_bool"),
+										CustomType:               convert.NewCustomTypePrimitive(nil, nil, "nested_bool", nil),
 										PlanModifiers:            convert.NewPlanModifiers(convert.PlanModifierTypeBool, nil),
 										Validators:               convert.NewValidators(convert.ValidatorTypeBool, nil),
 									},
@@ -239,6 +244,7 @@ func TestGeneratorSetNestedBlock_New(t *testing.T) {
 								convert.NewPlanModifiers(convert.PlanModifierTypeObject, nil),
 								convert.NewValidators(convert.ValidatorTypeObject, nil),
 								"nested_attribute",
+								nil,
 							),
 							PlanModifiers: convert.NewPlanModifiers(convert.PlanModifierTypeSet, nil),
 							Validators:    convert.NewValidators(convert.ValidatorTypeSet, nil),
@@ -249,6 +255,7 @@ func TestGeneratorSetNestedBlock_New(t *testing.T) {
 					convert.NewPlanModifiers(convert.PlanModifierTypeObject, specschema.CustomPlanModifiers{}),
 					convert.NewValidators(convert.ValidatorTypeObject, specschema.CustomValidators{}),
 					"name",
+					nil,
 				),
 				PlanModifiers: convert.NewPlanModifiers(convert.PlanModifierTypeSet, specschema.CustomPlanModifiers{}),
 				Validators:    convert.NewValidators(convert.ValidatorTypeSet, specschema.CustomValidators{}),
@@ -290,7 +297,7 @@ func TestGeneratorSetNestedBlock_New(t *testing.T) {
 								},
 							}),
 							ComputedOptionalRequired: convert.NewComputedOptionalRequired(specschema.Optional),
-							CustomType:               convert.NewCustomTypeObject(nil, nil, "object_attribute"),
+							CustomType:               convert.NewCustomTypeObject(nil, nil, "object_attribute", nil),
 							PlanModifiers:            convert.NewPlanModifiers(convert.PlanModifierTypeObject, specschema.CustomPlanModifiers{}),
 							Validators:               convert.NewValidators(convert.ValidatorTypeObject, specschema.CustomValidators{}),
 						},
@@ -312,7 +319,7 @@ func TestGeneratorSetNestedBlock_New(t *testing.T) {
 								},
 							}),
 							ComputedOptionalRequired: convert.NewComputedOptionalRequired(specschema.Optional),
-							CustomType:               convert.NewCustomTypeObject(nil, nil, "object_attribute"),
+							CustomType:               convert.NewCustomTypeObject(nil, nil, "object_attribute", nil),
 							PlanModifiers:            convert.NewPlanModifiers(convert.PlanModifierTypeObject, specschema.CustomPlanModifiers{}),
 							Validators:               convert.NewValidators(convert.ValidatorTypeObject, specschema.CustomValidators{}),
 						},
@@ -322,6 +329,7 @@ func TestGeneratorSetNestedBlock_New(t *testing.T) {
 					convert.NewPlanModifiers(convert.PlanModifierTypeObject, specschema.CustomPlanModifiers{}),
 					convert.NewValidators(convert.ValidatorTypeObject, specschema.CustomValidators{}),
 					"name",
+					nil,
 				),
 				PlanModifiers: convert.NewPlanModifiers(convert.PlanModifierTypeSet, specschema.CustomPlanModifiers{}),
 				Validators:    convert.NewValidators(convert.ValidatorTypeSet, specschema.CustomValidators{}),
@@ -355,13 +363,13 @@ func TestGeneratorSetNestedBlock_New(t *testing.T) {
 							Attributes: generatorschema.GeneratorAttributes{
 								"nested_bool": GeneratorBoolAttribute{
 									ComputedOptionalRequired: convert.NewComputedOptionalRequired(specschema.Computed),
-									CustomType:               convert.NewCustomTypePrimitive(nil, nil, "nested_bool"),
+									CustomType:               convert.NewCustomTypePrimitive(nil, nil, "nested_bool", nil),
 									PlanModifiers:            convert.NewPlanModifiers(convert.PlanModifierTypeBool, nil),
 									Validators:               convert.NewValidators(convert.ValidatorTypeBool, nil),
 								},
 							},
 							ComputedOptionalRequired: convert.NewComputedOptionalRequired(specschema.Optional),
-							CustomType:               convert.NewCustomTypeNestedObject(nil, "nested_attribute"),
+							CustomType:               convert.NewCustomTypeNestedObject(nil, "nested_attribute", nil),
 							PlanModifiers:            convert.NewPlanModifiers(convert.PlanModifierTypeObject, specschema.CustomPlanModifiers{}),
 							Validators:               convert.NewValidators(convert.ValidatorTypeObject, specschema.CustomValidators{}),
 						},
@@ -373,13 +381,13 @@ func TestGeneratorSetNestedBlock_New(t *testing.T) {
 							Attributes: generatorschema.GeneratorAttributes{
 								"nested_bool": GeneratorBoolAttribute{
 									ComputedOptionalRequired: convert.NewComputedOptionalRequired(specschema.Computed),
-									CustomType:               convert.NewCustomTypePrimitive(nil, nil, "nested_bool"),
+									CustomType:               convert.NewCustomTypePrimitive(nil, nil, "nested_bool", nil),
 									PlanModifiers:            convert.NewPlanModifiers(convert.PlanModifierTypeBool, nil),
 									Validators:               convert.NewValidators(convert.ValidatorTypeBool, nil),
 								},
 							},
 							ComputedOptionalRequired: convert.NewComputedOptionalRequired(specschema.Optional),
-							CustomType:               convert.NewCustomTypeNestedObject(nil, "nested_attribute"),
+							CustomType:               convert.NewCustomTypeNestedObject(nil, "nested_attribute", nil),
 							PlanModifiers:            convert.NewPlanModifiers(convert.PlanModifierTypeObject, specschema.CustomPlanModifiers{}),
 							Validators:               convert.NewValidators(convert.ValidatorTypeObject, specschema.CustomValidators{}),
 						},
@@ -389,6 +397,7 @@ func TestGeneratorSetNestedBlock_New(t *testing.T) {
 					convert.NewPlanModifiers(convert.PlanModifierTypeObject, specschema.CustomPlanModifiers{}),
 					convert.NewValidators(convert.ValidatorTypeObject, specschema.CustomValidators{}),
 					"name",
+					nil,
 				),
 				PlanModifiers: convert.NewPlanModifiers(convert.PlanModifierTypeSet, specschema.CustomPlanModifiers{}),
 				Validators:    convert.NewValidators(convert.ValidatorTypeSet, specschema.CustomValidators{}),
@@ -440,7 +449,7 @@ func TestGeneratorSetNestedBlock_New(t *testing.T) {
 								Attributes: generatorschema.GeneratorAttributes{
 									"bool_attribute": GeneratorBoolAttribute{
 										ComputedOptionalRequired: convert.NewComputedOptionalRequired(specschema.Optional),
-										CustomType:               convert.NewCustomTypePrimitive(nil, nil, "bool_attribute"),
+										CustomType:               convert.NewCustomTypePrimitive(nil, nil, "bool_attribute", nil),
 										PlanModifiers:            convert.NewPlanModifiers(convert.PlanModifierTypeBool, specschema.CustomPlanModifiers{}),
 										Validators:               convert.NewValidators(convert.ValidatorTypeBool, specschema.CustomValidators{}),
 									},
@@ -450,7 +459,7 @@ func TestGeneratorSetNestedBlock_New(t *testing.T) {
 								generatorschema.GeneratorAttributes{
 									"bool_attribute": GeneratorBoolAttribute{
 										ComputedOptionalRequired: convert.NewComputedOptionalRequired(specschema.Optional),
-										CustomType:               convert.NewCustomTypePrimitive(nil, nil, "bool_attribute"),
+										CustomType:               convert.NewCustomTypePrimitive(nil, nil, "bool_attribute", nil),
 										PlanModifiers:            convert.NewPlanModifiers(convert.PlanModifierTypeBool, specschema.CustomPlanModifiers{}),
 										Validators:               convert.NewValidators(convert.ValidatorTypeBool, specschema.CustomValidators{}),
 									},
@@ -460,6 +469,7 @@ func TestGeneratorSetNestedBlock_New(t *testing.T) {
 								convert.NewPlanModifiers(convert.PlanModifierTypeObject, specschema.CustomPlanModifiers{}),
 								convert.NewValidators(convert.ValidatorTypeObject, specschema.CustomValidators{}),
 								"nested_block",
+								nil,
 							),
 							PlanModifiers: convert.NewPlanModifiers(convert.PlanModifierTypeSet, specschema.CustomPlanModifiers{}),
 							Validators:    convert.NewValidators(convert.ValidatorTypeSet, specschema.CustomValidators{}),
@@ -474,7 +484,7 @@ func TestGeneratorSetNestedBlock_New(t *testing.T) {
 								Attributes: generatorschema.GeneratorAttributes{
 									"bool_attribute": GeneratorBoolAttribute{
 										ComputedOptionalRequired: convert.NewComputedOptionalRequired(specschema.Optional),
-										CustomType:               convert.NewCustomTypePrimitive(nil, nil, "bool_attribute"),
+										CustomType:               convert.NewCustomTypePrimitive(nil, nil, "bool_attribute", nil),
 										PlanModifiers:            convert.NewPlanModifiers(convert.PlanModifierTypeBool, specschema.CustomPlanModifiers{}),
 										Validators:               convert.NewValidators(convert.ValidatorTypeBool, specschema.CustomValidators{}),
 									},
@@ -484,7 +494,7 @@ func TestGeneratorSetNestedBlock_New(t *testing.T) {
 								generatorschema.GeneratorAttributes{
 									"bool_attribute": GeneratorBoolAttribute{
 										ComputedOptionalRequired: convert.NewComputedOptionalRequired(specschema.Optional),
-										CustomType:               convert.NewCustomTypePrimitive(nil, nil, "bool_attribute"),
+										CustomType:               convert.NewCustomTypePrimitive(nil, nil, "bool_attribute", nil),
 										PlanModifiers:            convert.NewPlanModifiers(convert.PlanModifierTypeBool, specschema.CustomPlanModifiers{}),
 										Validators:               convert.NewValidators(convert.ValidatorTypeBool, specschema.CustomValidators{}),
 									},
@@ -494,6 +504,7 @@ func TestGeneratorSetNestedBlock_New(t *testing.T) {
 								convert.NewPlanModifiers(convert.PlanModifierTypeObject, specschema.CustomPlanModifiers{}),
 								convert.NewValidators(convert.ValidatorTypeObject, specschema.CustomValidators{}),
 								"nested_block",
+								nil,
 							),
 							PlanModifiers: convert.NewPlanModifiers(convert.PlanModifierTypeSet, specschema.CustomPlanModifiers{}),
 							Validators:    convert.NewValidators(convert.ValidatorTypeSet, specschema.CustomValidators{}),
@@ -503,6 +514,7 @@ func TestGeneratorSetNestedBlock_New(t *testing.T) {
 					convert.NewPlanModifiers(convert.PlanModifierTypeObject, specschema.CustomPlanModifiers{}),
 					convert.NewValidators(convert.ValidatorTypeObject, specschema.CustomValidators{}),
 					"name",
+					nil,
 				),
 				PlanModifiers: convert.NewPlanModifiers(convert.PlanModifierTypeSet, specschema.CustomPlanModifiers{}),
 				Validators:    convert.NewValidators(convert.ValidatorTypeSet, specschema.CustomValidators{}),
@@ -536,12 +548,12 @@ func TestGeneratorSetNestedBlock_New(t *testing.T) {
 							Attributes: generatorschema.GeneratorAttributes{
 								"bool_attribute": GeneratorBoolAttribute{
 									ComputedOptionalRequired: convert.NewComputedOptionalRequired(specschema.Optional),
-									CustomType:               convert.NewCustomTypePrimitive(nil, nil, "bool_attribute"),
+									CustomType:               convert.NewCustomTypePrimitive(nil, nil, "bool_attribute", nil),
 									PlanModifiers:            convert.NewPlanModifiers(convert.PlanModifierTypeBool, specschema.CustomPlanModifiers{}),
 									Validators:               convert.NewValidators(convert.ValidatorTypeBool, specschema.CustomValidators{}),
 								},
 							},
-							CustomType:    convert.NewCustomTypeNestedObject(nil, "nested_block"),
+							CustomType:    convert.NewCustomTypeNestedObject(nil, "nested_block", nil),
 							PlanModifiers: convert.NewPlanModifiers(convert.PlanModifierTypeObject, specschema.CustomPlanModifiers{}),
 							Validators:    convert.NewValidators(convert.ValidatorTypeObject, specschema.CustomValidators{}),
 						},
@@ -554,12 +566,12 @@ func TestGeneratorSetNestedBlock_New(t *testing.T) {
 							Attributes: generatorschema.GeneratorAttributes{
 								"bool_attribute": GeneratorBoolAttribute{
 									ComputedOptionalRequired: convert.NewComputedOptionalRequired(specschema.Optional),
-									CustomType:               convert.NewCustomTypePrimitive(nil, nil, "bool_attribute"),
+									CustomType:               convert.NewCustomTypePrimitive(nil, nil, "bool_attribute", nil),
 									PlanModifiers:            convert.NewPlanModifiers(convert.PlanModifierTypeBool, specschema.CustomPlanModifiers{}),
 									Validators:               convert.NewValidators(convert.ValidatorTypeBool, specschema.CustomValidators{}),
 								},
 							},
-							CustomType:    convert.NewCustomTypeNestedObject(nil, "nested_block"),
+							CustomType:    convert.NewCustomTypeNestedObject(nil, "nested_block", nil),
 							PlanModifiers: convert.NewPlanModifiers(convert.PlanModifierTypeObject, specschema.CustomPlanModifiers{}),
 							Validators:    convert.NewValidators(convert.ValidatorTypeObject, specschema.CustomValidators{}),
 						},
@@ -567,6 +579,7 @@ func TestGeneratorSetNestedBlock_New(t *testing.T) {
 					convert.NewPlanModifiers(convert.PlanModifierTypeObject, specschema.CustomPlanModifiers{}),
 					convert.NewValidators(convert.ValidatorTypeObject, specschema.CustomValidators{}),
 					"name",
+					nil,
 				),
 				PlanModifiers: convert.NewPlanModifiers(convert.PlanModifierTypeSet, specschema.CustomPlanModifiers{}),
 				Validators:    convert.NewValidators(convert.ValidatorTypeSet, specschema.CustomValidators{}),
@@ -599,6 +612,7 @@ func TestGeneratorSetNestedBlock_New(t *testing.T) {
 					convert.NewPlanModifiers(convert.PlanModifierTypeObject, specschema.CustomPlanModifiers{}),
 					convert.NewValidators(convert.ValidatorTypeObject, specschema.CustomValidators{}),
 					"name",
+					nil,
 				),
 				PlanModifiers: convert.NewPlanModifiers(convert.PlanModifierTypeSet, specschema.CustomPlanModifiers{}),
 				Validators:    convert.NewValidators(convert.ValidatorTypeSet, specschema.CustomValidators{}),
@@ -618,6 +632,7 @@ func TestGeneratorSetNestedBlock_New(t *testing.T) {
 					convert.NewPlanModifiers(convert.PlanModifierTypeObject, specschema.CustomPlanModifiers{}),
 					convert.NewValidators(convert.ValidatorTypeObject, specschema.CustomValidators{}),
 					"name",
+					nil,
 				),
 				PlanModifiers: convert.NewPlanModifiers(convert.PlanModifierTypeSet, specschema.CustomPlanModifiers{}),
 				Validators:    convert.NewValidators(convert.ValidatorTypeSet, specschema.CustomValidators{}),
@@ -637,6 +652,7 @@ func TestGeneratorSetNestedBlock_New(t *testing.T) {
 					convert.NewPlanModifiers(convert.PlanModifierTypeObject, specschema.CustomPlanModifiers{}),
 					convert.NewValidators(convert.ValidatorTypeObject, specschema.CustomValidators{}),
 					"name",
+					nil,
 				),
 				PlanModifiers: convert.NewPlanModifiers(convert.PlanModifierTypeSet, specschema.CustomPlanModifiers{}),
 				Validators:    convert.NewValidators(convert.ValidatorTypeSet, specschema.CustomValidators{}),
@@ -666,6 +682,7 @@ func TestGeneratorSetNestedBlock_New(t *testing.T) {
 					convert.NewPlanModifiers(convert.PlanModifierTypeObject, specschema.CustomPlanModifiers{}),
 					convert.NewValidators(convert.ValidatorTypeObject, specschema.CustomValidators{}),
 					"name",
+					nil,
 				),
 				PlanModifiers: convert.NewPlanModifiers(convert.PlanModifierTypeSet, specschema.CustomPlanModifiers{}),
 				Validators: convert.NewValidators(convert.ValidatorTypeSet, specschema.CustomValidators{
@@ -704,6 +721,7 @@ func TestGeneratorSetNestedBlock_New(t *testing.T) {
 					convert.NewPlanModifiers(convert.PlanModifierTypeObject, specschema.CustomPlanModifiers{}),
 					convert.NewValidators(convert.ValidatorTypeObject, specschema.CustomValidators{}),
 					"name",
+					nil,
 				),
 				PlanModifiers: convert.NewPlanModifiers(convert.PlanModifierTypeSet, specschema.CustomPlanModifiers{
 					&specschema.CustomPlanModifier{
@@ -725,7 +743,7 @@ func TestGeneratorSetNestedBlock_New(t *testing.T) {
 		t.Run(name, func(t *testing.T) {
 			t.Parallel()
 
-			got, err := NewGeneratorSetNestedBlock("name", testCase.input)
+			got, err := NewGeneratorSetNestedBlock("name", testCase.input, nil)
 
 			if diff := cmp.Diff(err, testCase.expectedError, equateErrorMessage); diff != "" {
 				t.Errorf("unexpected error: %s", diff)
@@ -796,6 +814,7 @@ func TestGeneratorSetNestedBlock_Imports(t *testing.T) {
 					convert.NewPlanModifiers(convert.PlanModifierTypeObject, nil),
 					convert.NewValidators(convert.ValidatorTypeObject, nil),
 					"",
+					nil,
 				),
 			},
 			expected: []code.Import{
@@ -862,6 +881,7 @@ func TestGeneratorSetNestedBlock_Imports(t *testing.T) {
 					convert.NewPlanModifiers(convert.PlanModifierTypeObject, nil),
 					convert.NewValidators(convert.ValidatorTypeObject, nil),
 					"",
+					nil,
 				),
 			},
 			expected: []code.Import{
@@ -905,6 +925,7 @@ func TestGeneratorSetNestedBlock_Imports(t *testing.T) {
 					convert.NewPlanModifiers(convert.PlanModifierTypeObject, nil),
 					convert.Validators{},
 					"",
+					nil,
 				),
 			},
 			expected: []code.Import{
@@ -939,6 +960,7 @@ func TestGeneratorSetNestedBlock_Imports(t *testing.T) {
 					convert.NewPlanModifiers(convert.PlanModifierTypeObject, nil),
 					convert.NewValidators(convert.ValidatorTypeObject, nil),
 					"",
+					nil,
 				),
 			},
 			expected: []code.Import{
@@ -989,6 +1011,7 @@ func TestGeneratorSetNestedBlock_Imports(t *testing.T) {
 								convert.CustomCollectionTypeList,
 								"",
 								"",
+								nil,
 							),
 						},
 					},
@@ -997,6 +1020,7 @@ func TestGeneratorSetNestedBlock_Imports(t *testing.T) {
 					convert.NewPlanModifiers(convert.PlanModifierTypeObject, nil),
 					convert.Validators{},
 					"",
+					nil,
 				),
 			},
 			expected: []code.Import{
@@ -1026,6 +1050,7 @@ func TestGeneratorSetNestedBlock_Imports(t *testing.T) {
 								convert.CustomCollectionTypeList,
 								"",
 								"",
+								nil,
 							),
 							ElementTypeCollection: convert.NewElementType(specschema.ElementType{
 								Bool: &specschema.BoolType{
@@ -1043,6 +1068,7 @@ func TestGeneratorSetNestedBlock_Imports(t *testing.T) {
 					convert.NewPlanModifiers(convert.PlanModifierTypeObject, nil),
 					convert.Validators{},
 					"",
+					nil,
 				),
 			},
 			expected: []code.Import{
@@ -1097,6 +1123,7 @@ func TestGeneratorSetNestedBlock_Imports(t *testing.T) {
 								},
 								nil,
 								"",
+								nil,
 							),
 						},
 					},
@@ -1105,6 +1132,7 @@ func TestGeneratorSetNestedBlock_Imports(t *testing.T) {
 					convert.NewPlanModifiers(convert.PlanModifierTypeObject, nil),
 					convert.Validators{},
 					"",
+					nil,
 				),
 			},
 			expected: []code.Import{
@@ -1144,6 +1172,7 @@ func TestGeneratorSetNestedBlock_Imports(t *testing.T) {
 								},
 								nil,
 								"",
+								nil,
 							),
 						},
 					},
@@ -1152,6 +1181,7 @@ func TestGeneratorSetNestedBlock_Imports(t *testing.T) {
 					convert.NewPlanModifiers(convert.PlanModifierTypeObject, nil),
 					convert.Validators{},
 					"",
+					nil,
 				),
 			},
 			expected: []code.Import{
@@ -1188,6 +1218,7 @@ func TestGeneratorSetNestedBlock_Imports(t *testing.T) {
 					convert.NewPlanModifiers(convert.PlanModifierTypeObject, nil),
 					convert.Validators{},
 					"",
+					nil,
 				),
 			},
 			expected: []code.Import{
@@ -1297,6 +1328,7 @@ func TestGeneratorSetNestedBlock_Imports(t *testing.T) {
 					convert.NewPlanModifiers(convert.PlanModifierTypeObject, nil),
 					convert.NewValidators(convert.ValidatorTypeObject, nil),
 					"",
+					nil,
 				),
 			},
 			expected: []code.Import{
@@ -1319,6 +1351,7 @@ func TestGeneratorSetNestedBlock_Imports(t *testing.T) {
 						&specschema.CustomValidator{},
 					}),
 					"",
+					nil,
 				),
 			},
 			expected: []code.Import{
@@ -1347,6 +1380,7 @@ func TestGeneratorSetNestedBlock_Imports(t *testing.T) {
 						},
 					}),
 					"",
+					nil,
 				),
 			},
 			expected: []code.Import{
@@ -1382,6 +1416,7 @@ func TestGeneratorSetNestedBlock_Imports(t *testing.T) {
 						},
 					}),
 					"",
+					nil,
 				),
 			},
 			expected: []code.Import{
@@ -1439,6 +1474,7 @@ func TestGeneratorSetNestedBlock_Schema(t *testing.T) {
 					convert.NewPlanModifiers(convert.PlanModifierTypeObject, specschema.CustomPlanModifiers{}),
 					convert.NewValidators(convert.ValidatorTypeObject, specschema.CustomValidators{}),
 					"set_nested_block",
+					nil,
 				),
 				PlanModifiers: convert.NewPlanModifiers(convert.PlanModifierTypeSet, specschema.CustomPlanModifiers{}),
 				Validators:    convert.NewValidators(convert.ValidatorTypeSet, specschema.CustomValidators{}),
@@ -1475,6 +1511,7 @@ AttrTypes: SetNestedBlockValue{}.AttributeTypes(ctx),
 					convert.NewPlanModifiers(convert.PlanModifierTypeObject, specschema.CustomPlanModifiers{}),
 					convert.NewValidators(convert.ValidatorTypeObject, specschema.CustomValidators{}),
 					"set_nested_block",
+					nil,
 				),
 				PlanModifiers: convert.NewPlanModifiers(convert.PlanModifierTypeSet, specschema.CustomPlanModifiers{}),
 				Validators:    convert.NewValidators(convert.ValidatorTypeSet, specschema.CustomValidators{}),
@@ -1510,7 +1547,7 @@ AttrTypes: SetNestedBlockValue{}.AttributeTypes(ctx),
 								nil,
 								convert.NewPlanModifiers(convert.PlanModifierTypeObject, nil),
 								convert.NewValidators(convert.ValidatorTypeObject, nil),
-								"nested_set_nested"),
+								"nested_set_nested", nil),
 						},
 					},
 					generatorschema.GeneratorBlocks{},
@@ -1518,6 +1555,7 @@ AttrTypes: SetNestedBlockValue{}.AttributeTypes(ctx),
 					convert.NewPlanModifiers(convert.PlanModifierTypeObject, specschema.CustomPlanModifiers{}),
 					convert.NewValidators(convert.ValidatorTypeObject, specschema.CustomValidators{}),
 					"set_nested_block",
+					nil,
 				),
 				PlanModifiers: convert.NewPlanModifiers(convert.PlanModifierTypeSet, specschema.CustomPlanModifiers{}),
 				Validators:    convert.NewValidators(convert.ValidatorTypeSet, specschema.CustomValidators{}),
@@ -1568,6 +1606,7 @@ AttrTypes: SetNestedBlockValue{}.AttributeTypes(ctx),
 					convert.NewPlanModifiers(convert.PlanModifierTypeObject, specschema.CustomPlanModifiers{}),
 					convert.NewValidators(convert.ValidatorTypeObject, specschema.CustomValidators{}),
 					"set_nested_block",
+					nil,
 				),
 				PlanModifiers: convert.NewPlanModifiers(convert.PlanModifierTypeSet, specschema.CustomPlanModifiers{}),
 				Validators:    convert.NewValidators(convert.ValidatorTypeSet, specschema.CustomValidators{}),
@@ -1601,7 +1640,7 @@ AttrTypes: SetNestedBlockValue{}.AttributeTypes(ctx),
 									ComputedOptionalRequired: convert.NewComputedOptionalRequired(specschema.Optional),
 								},
 							},
-							CustomType: convert.NewCustomTypeNestedObject(nil, "nested_single_nested"),
+							CustomType: convert.NewCustomTypeNestedObject(nil, "nested_single_nested", nil),
 						},
 					},
 					generatorschema.GeneratorBlocks{},
@@ -1609,6 +1648,7 @@ AttrTypes: SetNestedBlockValue{}.AttributeTypes(ctx),
 					convert.NewPlanModifiers(convert.PlanModifierTypeObject, specschema.CustomPlanModifiers{}),
 					convert.NewValidators(convert.ValidatorTypeObject, specschema.CustomValidators{}),
 					"set_nested_block",
+					nil,
 				),
 				PlanModifiers: convert.NewPlanModifiers(convert.PlanModifierTypeSet, specschema.CustomPlanModifiers{}),
 				Validators:    convert.NewValidators(convert.ValidatorTypeSet, specschema.CustomValidators{}),
@@ -1655,12 +1695,14 @@ AttrTypes: SetNestedBlockValue{}.AttributeTypes(ctx),
 								convert.NewPlanModifiers(convert.PlanModifierTypeObject, specschema.CustomPlanModifiers{}),
 								convert.NewValidators(convert.ValidatorTypeObject, specschema.CustomValidators{}),
 								"nested_set_nested",
+								nil,
 							),
 						},
 					}, nil,
 					convert.NewPlanModifiers(convert.PlanModifierTypeObject, specschema.CustomPlanModifiers{}),
 					convert.NewValidators(convert.ValidatorTypeObject, specschema.CustomValidators{}),
 					"set_nested_block",
+					nil,
 				),
 				PlanModifiers: convert.NewPlanModifiers(convert.PlanModifierTypeSet, specschema.CustomPlanModifiers{}),
 				Validators:    convert.NewValidators(convert.ValidatorTypeSet, specschema.CustomValidators{}),
@@ -1703,7 +1745,7 @@ AttrTypes: SetNestedBlockValue{}.AttributeTypes(ctx),
 									ComputedOptionalRequired: convert.NewComputedOptionalRequired(specschema.Optional),
 								},
 							},
-							CustomType:    convert.NewCustomTypeNestedObject(nil, "nested_single_nested"),
+							CustomType:    convert.NewCustomTypeNestedObject(nil, "nested_single_nested", nil),
 							PlanModifiers: convert.NewPlanModifiers(convert.PlanModifierTypeObject, specschema.CustomPlanModifiers{}),
 							Validators:    convert.NewValidators(convert.ValidatorTypeObject, specschema.CustomValidators{}),
 						},
@@ -1711,6 +1753,7 @@ AttrTypes: SetNestedBlockValue{}.AttributeTypes(ctx),
 					convert.NewPlanModifiers(convert.PlanModifierTypeObject, specschema.CustomPlanModifiers{}),
 					convert.NewValidators(convert.ValidatorTypeObject, specschema.CustomValidators{}),
 					"set_nested_block",
+					nil,
 				),
 				PlanModifiers: convert.NewPlanModifiers(convert.PlanModifierTypeSet, specschema.CustomPlanModifiers{}),
 				Validators:    convert.NewValidators(convert.ValidatorTypeSet, specschema.CustomValidators{}),
@@ -1752,6 +1795,7 @@ AttrTypes: SetNestedBlockValue{}.AttributeTypes(ctx),
 					convert.NewPlanModifiers(convert.PlanModifierTypeObject, specschema.CustomPlanModifiers{}),
 					convert.NewValidators(convert.ValidatorTypeObject, specschema.CustomValidators{}),
 					"set_nested_block",
+					nil,
 				),
 			},
 			expected: `"set_nested_block": schema.SetNestedBlock{
@@ -1776,6 +1820,7 @@ CustomType: my_custom_type,
 					convert.NewPlanModifiers(convert.PlanModifierTypeObject, specschema.CustomPlanModifiers{}),
 					convert.NewValidators(convert.ValidatorTypeObject, specschema.CustomValidators{}),
 					"set_nested_block",
+					nil,
 				),
 			},
 			expected: `"set_nested_block": schema.SetNestedBlock{
@@ -1801,6 +1846,7 @@ MarkdownDescription: "description",
 					convert.NewPlanModifiers(convert.PlanModifierTypeObject, specschema.CustomPlanModifiers{}),
 					convert.NewValidators(convert.ValidatorTypeObject, specschema.CustomValidators{}),
 					"set_nested_block",
+					nil,
 				),
 			},
 			expected: `"set_nested_block": schema.SetNestedBlock{
@@ -1824,6 +1870,7 @@ DeprecationMessage: "deprecated",
 					convert.NewPlanModifiers(convert.PlanModifierTypeObject, specschema.CustomPlanModifiers{}),
 					convert.NewValidators(convert.ValidatorTypeObject, specschema.CustomValidators{}),
 					"set_nested_block",
+					nil,
 				),
 				Validators: convert.NewValidators(convert.ValidatorTypeSet, specschema.CustomValidators{
 					&specschema.CustomValidator{
@@ -1860,6 +1907,7 @@ my_other_validator.Validate(),
 					convert.NewPlanModifiers(convert.PlanModifierTypeObject, specschema.CustomPlanModifiers{}),
 					convert.NewValidators(convert.ValidatorTypeObject, specschema.CustomValidators{}),
 					"set_nested_block",
+					nil,
 				),
 			},
 			expected: `"set_nested_block": schema.SetNestedBlock{
@@ -1885,6 +1933,7 @@ CustomType: my_custom_type,
 						},
 					}),
 					"set_nested_block",
+					nil,
 				),
 			},
 			expected: `"set_nested_block": schema.SetNestedBlock{
@@ -1911,6 +1960,7 @@ my_other_validator.Validate(),
 					convert.NewPlanModifiers(convert.PlanModifierTypeObject, specschema.CustomPlanModifiers{}),
 					convert.NewValidators(convert.ValidatorTypeObject, specschema.CustomValidators{}),
 					"set_nested_block",
+					nil,
 				),
 				PlanModifiers: convert.NewPlanModifiers(
 					convert.PlanModifierTypeSet,
@@ -55,12 +55,12 @@ func TestGeneratorSingleNestedAttribute_New(t *testing.T) {
 				Attributes: generatorschema.GeneratorAttributes{
 					"bool_attribute": GeneratorBoolAttribute{
 						ComputedOptionalRequired: convert.NewComputedOptionalRequired(specschema.Optional),
-						CustomType:               convert.NewCustomTypePrimitive(nil, nil, "bool_attribute"),
+						CustomType:               convert.NewCustomTypePrimitive(nil, nil, "bool_attribute", nil),
 						PlanModifiers:            convert.NewPlanModifiers(convert.PlanModifierTypeBool, specschema.CustomPlanModifiers{}),
 						Validators:               convert.NewValidators(convert.ValidatorTypeBool, specschema.CustomValidators{}),
 					},
 				},
-				CustomType:    convert.NewCustomTypeNestedObject(nil, "name"),
+				CustomType:    convert.NewCustomTypeNestedObject(nil, "name", nil),
 				PlanModifiers: convert.NewPlanModifiers(convert.PlanModifierTypeObject, specschema.CustomPlanModifiers{}),
 				Validators:    convert.NewValidators(convert.ValidatorTypeObject, specschema.CustomValidators{}),
 			},
@@ -89,6 +89,7 @@ func TestGeneratorSingleNestedAttribute_New(t *testing.T) {
 							convert.CustomCollectionTypeList,
 							"types.BoolType",
 							"list_attribute",
+							nil,
 						),
 						ElementType: specschema.ElementType{
 							Bool: &specschema.BoolType{},
@@ -100,7 +101,7 @@ func TestGeneratorSingleNestedAttribute_New(t *testing.T) {
 						Validators:    convert.NewValidators(convert.ValidatorTypeList, specschema.CustomValidators{}),
 					},
 				},
-				CustomType:    convert.NewCustomTypeNestedObject(nil, "name"),
+				CustomType:    convert.NewCustomTypeNestedObject(nil, "name", nil),
 				PlanModifiers: convert.NewPlanModifiers(convert.PlanModifierTypeObject, specschema.CustomPlanModifiers{}),
 				Validators:    convert.NewValidators(convert.ValidatorTypeObject, specschema.CustomValidators{}),
 			},
@@ -134,7 +135,7 @@ func TestGeneratorSingleNestedAttribute_New(t *testing.T) {
 							Attributes: generatorschema.GeneratorAttributes{
 								"nested_bool": GeneratorBoolAttribute{
 									ComputedOptionalRequired: convert.NewComputedOptionalRequired(specschema.Computed),
-									CustomType:               convert.NewCustomTypePrimitive(nil, nil, "nested_bool"),
+									CustomType:               convert.NewCustomTypePrimitive(nil, nil, "nested_bool", nil),
 									PlanModifiers:            convert.NewPlanModifiers(convert.PlanModifierTypeBool, nil),
 									Validators:               convert.NewValidators(convert.ValidatorTypeBool, nil),
 								},
@@ -144,7 +145,7 @@ func TestGeneratorSingleNestedAttribute_New(t *testing.T) {
 							generatorschema.GeneratorAttributes{
 								"nested_bool": GeneratorBoolAttribute{
 									ComputedOptionalRequired: convert.NewComputedOptionalRequired(specschema.Computed),
-									CustomType:               convert.NewCustomTypePrimitive(nil, nil, "nested_bool"),
+									CustomType:               convert.NewCustomTypePrimitive(nil, nil, "nested_bool", nil),
 									PlanModifiers:            convert.NewPlanModifiers(convert.PlanModifierTypeBool, nil),
 									Validators:               convert.NewValidators(convert.ValidatorTypeBool, nil),
 								},
@@ -153,12 +154,13 @@ func TestGeneratorSingleNestedAttribute_New(t *testing.T) {
 							convert.NewPlanModifiers(convert.PlanModifierTypeObject, nil),
 							convert.NewValidators(convert.ValidatorTypeObject, nil),
 							"nested_attribute",
+							nil,
 						),
 						PlanModifiers: convert.NewPlanModifiers(convert.PlanModifierTypeList, nil),
 						Validators:    convert.NewValidators(convert.ValidatorTypeList, nil),
 					},
 				},
-				CustomType:    convert.NewCustomTypeNestedObject(nil, "name"),
+				CustomType:    convert.NewCustomTypeNestedObject(nil, "name", nil),
 				PlanModifiers: convert.NewPlanModifiers(convert.PlanModifierTypeObject, specschema.CustomPlanModifiers{}),
 				Validators:    convert.NewValidators(convert.ValidatorTypeObject, specschema.CustomValidators{}),
 			},
@@ -196,12 +198,12 @@ func TestGeneratorSingleNestedAttribute_New(t *testing.T) {
 							},
 						}),
 						ComputedOptionalRequired: convert.NewComputedOptionalRequired(specschema.Optional),
-						CustomType:               convert.NewCustomTypeObject(nil, nil, "object_attribute"),
+						CustomType:               convert.NewCustomTypeObject(nil, nil, "object_attribute", nil),
 						PlanModifiers:            convert.NewPlanModifiers(convert.PlanModifierTypeObject, specschema.CustomPlanModifiers{}),
 						Validators:               convert.NewValidators(convert.ValidatorTypeObject, specschema.CustomValidators{}),
 					},
 				},
-				CustomType:    convert.NewCustomTypeNestedObject(nil, "name"),
+				CustomType:    convert.NewCustomTypeNestedObject(nil, "name", nil),
 				PlanModifiers: convert.NewPlanModifiers(convert.PlanModifierTypeObject, specschema.CustomPlanModifiers{}),
 				Validators:    convert.NewValidators(convert.ValidatorTypeObject, specschema.CustomValidators{}),
 			},
@@ -231,18 +233,18 @@ func TestGeneratorSingleNestedAttribute_New(t *testing.T) {
 						Attributes: generatorschema.GeneratorAttributes{
 							"nested_bool": GeneratorBoolAttribute{
 								ComputedOptionalRequired: convert.NewComputedOptionalRequired(specschema.Computed),
-								CustomType:               convert.NewCustomTypePrimitive(nil, nil, "nested_bool"),
+								CustomType:               convert.NewCustomTypePrimitive(nil, nil, "nested_bool", nil),
 								PlanModifiers:            convert.NewPlanModifiers(convert.PlanModifierTypeBool, nil),
 								Validators:               convert.NewValidators(convert.ValidatorTypeBool, nil),
 							},
 						},
 						ComputedOptionalRequired: convert.NewComputedOptionalRequired(specschema.Optional),
-						CustomType:               convert.NewCustomTypeNestedObject(nil, "nested_attribute"),
+						CustomType:               convert.NewCustomTypeNestedObject(nil, "nested_attribute", nil),
 						PlanModifiers:            convert.NewPlanModifiers(convert.PlanModifierTypeObject, specschema.CustomPlanModifiers{}),
 						Validators:               convert.NewValidators(convert.ValidatorTypeObject, specschema.CustomValidators{}),
 					},
 				},
-				CustomType:    convert.NewCustomTypeNestedObject(nil, "name"),
+				CustomType:    convert.NewCustomTypeNestedObject(nil, "name", nil),
 				PlanModifiers: convert.NewPlanModifiers(convert.PlanModifierTypeObject, specschema.CustomPlanModifiers{}),
 				Validators:    convert.NewValidators(convert.ValidatorTypeObject, specschema.CustomValidators{}),
 			},
@@ -253,7 +255,7 @@ func TestGeneratorSingleNestedAttribute_New(t *testing.T) {
 			},
 			expected: GeneratorSingleNestedAttribute{
 				ComputedOptionalRequired: convert.NewComputedOptionalRequired(specschema.Computed),
-				CustomType:               convert.NewCustomTypeNestedObject(nil, "name"),
+				CustomType:               convert.NewCustomTypeNestedObject(nil, "name", nil),
 				PlanModifiers:            convert.NewPlanModifiers(convert.PlanModifierTypeObject, specschema.CustomPlanModifiers{}),
 				Validators:               convert.NewValidators(convert.ValidatorTypeObject, specschema.CustomValidators{}),
 			},
@@ -264,7 +266,7 @@ func TestGeneratorSingleNestedAttribute_New(t *testing.T) {
 			},
 			expected: GeneratorSingleNestedAttribute{
 				ComputedOptionalRequired: convert.NewComputedOptionalRequired(specschema.ComputedOptional),
-				CustomType:               convert.NewCustomTypeNestedObject(nil, "name"),
+				CustomType:               convert.NewCustomTypeNestedObject(nil, "name", nil),
 				PlanModifiers:            convert.NewPlanModifiers(convert.PlanModifierTypeObject, specschema.CustomPlanModifiers{}),
 				Validators:               convert.NewValidators(convert.ValidatorTypeObject, specschema.CustomValidators{}),
 			},
@@ -275,7 +277,7 @@ func TestGeneratorSingleNestedAttribute_New(t *testing.T) {
 			},
 			expected: GeneratorSingleNestedAttribute{
 				ComputedOptionalRequired: convert.NewComputedOptionalRequired(specschema.Optional),
-				CustomType:               convert.NewCustomTypeNestedObject(nil, "name"),
+				CustomType:               convert.NewCustomTypeNestedObject(nil, "name", nil),
 				PlanModifiers:            convert.NewPlanModifiers(convert.PlanModifierTypeObject, specschema.CustomPlanModifiers{}),
 				Validators:               convert.NewValidators(convert.ValidatorTypeObject, specschema.CustomValidators{}),
 			},
@@ -286,7 +288,7 @@ func TestGeneratorSingleNestedAttribute_New(t *testing.T) {
 			},
 			expected: GeneratorSingleNestedAttribute{
 				ComputedOptionalRequired: convert.NewComputedOptionalRequired(specschema.Required),
-				CustomType:               convert.NewCustomTypeNestedObject(nil, "name"),
+				CustomType:               convert.NewCustomTypeNestedObject(nil, "name", nil),
 				PlanModifiers:            convert.NewPlanModifiers(convert.PlanModifierTypeObject, specschema.CustomPlanModifiers{}),
 				Validators:               convert.NewValidators(convert.ValidatorTypeObject, specschema.CustomValidators{}),
 			},
@@ -308,7 +310,7 @@ func TestGeneratorSingleNestedAttribute_New(t *testing.T) {
 					},
 					Type:      "my_type",
 					ValueType: "myvalue_type",
-				}, "name"),
+				}, "name", nil),
 				PlanModifiers: convert.NewPlanModifiers(convert.PlanModifierTypeObject, specschema.CustomPlanModifiers{}),
 				Validators:    convert.NewValidators(convert.ValidatorTypeObject, specschema.CustomValidators{}),
 			},
@@ -318,7 +320,7 @@ func TestGeneratorSingleNestedAttribute_New(t *testing.T) {
 				DeprecationMessage: pointer("deprecation message"),
 			},
 			expected: GeneratorSingleNestedAttribute{
-				CustomType:         convert.NewCustomTypeNestedObject(nil, "name"),
+				CustomType:         convert.NewCustomTypeNestedObject(nil, "name", nil),
 				DeprecationMessage: convert.NewDeprecationMessage(pointer("deprecation message")),
 				PlanModifiers:      convert.NewPlanModifiers(convert.PlanModifierTypeObject, specschema.CustomPlanModifiers{}),
 				Validators:         convert.NewValidators(convert.ValidatorTypeObject, specschema.CustomValidators{}),
@@ -329,7 +331,7 @@ func TestGeneratorSingleNestedAttribute_New(t *testing.T) {
 				Description: pointer("description"),
 			},
 			expected: GeneratorSingleNestedAttribute{
-				CustomType:    convert.NewCustomTypeNestedObject(nil, "name"),
+				CustomType:    convert.NewCustomTypeNestedObject(nil, "name", nil),
 				Description:   convert.NewDescription(pointer("description")),
 				PlanModifiers: convert.NewPlanModifiers(convert.PlanModifierTypeObject, specschema.CustomPlanModifiers{}),
 				Validators:    convert.NewValidators(convert.ValidatorTypeObject, specschema.CustomValidators{}),
@@ -340,7 +342,7 @@ func TestGeneratorSingleNestedAttribute_New(t *testing.T) {
 				Sensitive: pointer(true),
 			},
 			expected: GeneratorSingleNestedAttribute{
-				CustomType:    convert.NewCustomTypeNestedObject(nil, "name"),
+				CustomType:    convert.NewCustomTypeNestedObject(nil, "name", nil),
 				PlanModifiers: convert.NewPlanModifiers(convert.PlanModifierTypeObject, specschema.CustomPlanModifiers{}),
 				Sensitive:     convert.NewSensitive(pointer(true)),
 				Validators:    convert.NewValidators(convert.ValidatorTypeObject, specschema.CustomValidators{}),
@@ -362,7 +364,7 @@ func TestGeneratorSingleNestedAttribute_New(t *testing.T) {
 				},
 			},
 			expected: GeneratorSingleNestedAttribute{
-				CustomType:    convert.NewCustomTypeNestedObject(nil, "name"),
+				CustomType:    convert.NewCustomTypeNestedObject(nil, "name", nil),
 				PlanModifiers: convert.NewPlanModifiers(convert.PlanModifierTypeObject, specschema.CustomPlanModifiers{}),
 				Validators: convert.NewValidators(convert.ValidatorTypeObject, specschema.CustomValidators{
 					&specschema.CustomValidator{
@@ -392,7 +394,7 @@ func TestGeneratorSingleNestedAttribute_New(t *testing.T) {
 				},
 			},
 			expected: GeneratorSingleNestedAttribute{
-				CustomType: convert.NewCustomTypeNestedObject(nil, "name"),
+				CustomType: convert.NewCustomTypeNestedObject(nil, "name", nil),
 				PlanModifiers: convert.NewPlanModifiers(convert.PlanModifierTypeObject, specschema.CustomPlanModifiers{
 					&specschema.CustomPlanModifier{
 						Imports: []code.Import{
@@ -420,7 +422,7 @@ func TestGeneratorSingleNestedAttribute_New(t *testing.T) {
 				},
 			},
 			expected: GeneratorSingleNestedAttribute{
-				CustomType: convert.NewCustomTypeNestedObject(nil, "name"),
+				CustomType: convert.NewCustomTypeNestedObject(nil, "name", nil),
 				Default: convert.NewDefaultCustom(&specschema.CustomDefault{
 					Imports: []code.Import{
 						{
@@ -440,7 +442,7 @@ func TestGeneratorSingleNestedAttribute_New(t *testing.T) {
 		t.Run(name, func(t *testing.T) {
 			t.Parallel()
 
-			got, err := NewGeneratorSingleNestedAttribute("name", testCase.input)
+			got, err := NewGeneratorSingleNestedAttribute("name", testCase.input, nil)
 
 			if diff := cmp.Diff(err, testCase.expectedError, equateErrorMessage); diff != "" {
 				t.Errorf("unexpected error: %s", diff)
@@ -475,6 +477,7 @@ func TestGeneratorSingleNestedAttribute_Imports(t *testing.T) {
 				CustomType: convert.NewCustomTypeNestedObject(
 					&specschema.CustomType{},
 					"",
+					nil,
 				),
 			},
 			expected: []code.Import{
@@ -492,6 +495,7 @@ func TestGeneratorSingleNestedAttribute_Imports(t *testing.T) {
 						},
 					},
 					"",
+					nil,
 				),
 			},
 			expected: []code.Import{
@@ -509,6 +513,7 @@ func TestGeneratorSingleNestedAttribute_Imports(t *testing.T) {
 						},
 					},
 					"",
+					nil,
 				),
 			},
 			expected: []code.Import{
@@ -553,6 +558,7 @@ func TestGeneratorSingleNestedAttribute_Imports(t *testing.T) {
 							convert.CustomCollectionTypeList,
 							"",
 							"",
+							nil,
 						),
 					},
 				},
@@ -583,6 +589,7 @@ func TestGeneratorSingleNestedAttribute_Imports(t *testing.T) {
 							convert.CustomCollectionTypeList,
 							"",
 							"",
+							nil,
 						),
 						ElementTypeCollection: convert.NewElementType(specschema.ElementType{
 							Bool: &specschema.BoolType{
@@ -645,6 +652,7 @@ func TestGeneratorSingleNestedAttribute_Imports(t *testing.T) {
 							},
 							nil,
 							"",
+							nil,
 						),
 					},
 				},
@@ -685,6 +693,7 @@ func TestGeneratorSingleNestedAttribute_Imports(t *testing.T) {
 							},
 							nil,
 							"",
+							nil,
 						),
 					},
 				},
@@ -873,7 +882,7 @@ func TestGeneratorSingleNestedAttribute_Schema(t *testing.T) {
 						ComputedOptionalRequired: convert.NewComputedOptionalRequired(specschema.Optional),
 					},
 				},
-				CustomType: convert.NewCustomTypeNestedObject(nil, "single_nested_attribute"),
+				CustomType: convert.NewCustomTypeNestedObject(nil, "single_nested_attribute", nil),
 			},
 			expected: `"single_nested_attribute": schema.SingleNestedAttribute{
 Attributes: map[string]schema.Attribute{
@@ -899,7 +908,7 @@ AttrTypes: SingleNestedAttributeValue{}.AttributeTypes(ctx),
 						}),
 					},
 				},
-				CustomType: convert.NewCustomTypeNestedObject(nil, "single_nested_attribute"),
+				CustomType: convert.NewCustomTypeNestedObject(nil, "single_nested_attribute", nil),
 			},
 			expected: `"single_nested_attribute": schema.SingleNestedAttribute{
 Attributes: map[string]schema.Attribute{
@@ -929,10 +938,10 @@ AttrTypes: SingleNestedAttributeValue{}.AttributeTypes(ctx),
 							nil,
 							convert.NewPlanModifiers(convert.PlanModifierTypeObject, nil),
 							convert.NewValidators(convert.ValidatorTypeObject, nil),
-							"nested_list_nested"),
+							"nested_list_nested", nil),
 					},
 				},
-				CustomType: convert.NewCustomTypeNestedObject(nil, "single_nested_attribute"),
+				CustomType: convert.NewCustomTypeNestedObject(nil, "single_nested_attribute", nil),
 			},
 			expected: `"single_nested_attribute": schema.SingleNestedAttribute{
 Attributes: map[string]schema.Attribute{
@@ -972,7 +981,7 @@ AttrTypes: SingleNestedAttributeValue{}.AttributeTypes(ctx),
 						ComputedOptionalRequired: convert.NewComputedOptionalRequired(specschema.Optional),
 					},
 				},
-				CustomType: convert.NewCustomTypeNestedObject(nil, "single_nested_attribute"),
+				CustomType: convert.NewCustomTypeNestedObject(nil, "single_nested_attribute", nil),
 			},
 			expected: `"single_nested_attribute": schema.SingleNestedAttribute{
 Attributes: map[string]schema.Attribute{
@@ -1000,10 +1009,10 @@ AttrTypes: SingleNestedAttributeValue{}.AttributeTypes(ctx),
 								ComputedOptionalRequired: convert.NewComputedOptionalRequired(specschema.Optional),
 							},
 						},
-						CustomType: convert.NewCustomTypeNestedObject(nil, "nested_single_nested"),
+						CustomType: convert.NewCustomTypeNestedObject(nil, "nested_single_nested", nil),
 					},
 				},
-				CustomType: convert.NewCustomTypeNestedObject(nil, "single_nested_attribute"),
+				CustomType: convert.NewCustomTypeNestedObject(nil, "single_nested_attribute", nil),
 			},
 			expected: `"single_nested_attribute": schema.SingleNestedAttribute{
 Attributes: map[string]schema.Attribute{
@@ -1032,7 +1041,7 @@ AttrTypes: SingleNestedAttributeValue{}.AttributeTypes(ctx),
 			input: GeneratorSingleNestedAttribute{
 				CustomType: convert.NewCustomTypeNestedObject(&specschema.CustomType{
 					Type: "my_custom_type",
-				}, "single_nested_attribute"),
+				}, "single_nested_attribute", nil),
 			},
 			expected: `"single_nested_attribute": schema.SingleNestedAttribute{
 Attributes: map[string]schema.Attribute{
@@ -1044,7 +1053,7 @@ CustomType: my_custom_type,
 		"required": {
 			input: GeneratorSingleNestedAttribute{
 				ComputedOptionalRequired: convert.NewComputedOptionalRequired(specschema.Required),
-				CustomType:               convert.NewCustomTypeNestedObject(nil, "single_nested_attribute"),
+				CustomType:               convert.NewCustomTypeNestedObject(nil, "single_nested_attribute", nil),
 			},
 			expected: `"single_nested_attribute": schema.SingleNestedAttribute{
 Attributes: map[string]schema.Attribute{
@@ -1061,7 +1070,7 @@ Required: true,
 		"optional": {
 			input: GeneratorSingleNestedAttribute{
 				ComputedOptionalRequired: convert.NewComputedOptionalRequired(specschema.Optional),
-				CustomType:               convert.NewCustomTypeNestedObject(nil, "single_nested_attribute"),
+				CustomType:               convert.NewCustomTypeNestedObject(nil, "single_nested_attribute", nil),
 			},
 			expected: `"single_nested_attribute": schema.SingleNestedAttribute{
 Attributes: map[string]schema.Attribute{
@@ -1078,7 +1087,7 @@ Optional: true,
 		"computed": {
 			input: GeneratorSingleNestedAttribute{
 				ComputedOptionalRequired: convert.NewComputedOptionalRequired(specschema.Computed),
-				CustomType:               convert.NewCustomTypeNestedObject(nil, "single_nested_attribute"),
+				CustomType:               convert.NewCustomTypeNestedObject(nil, "single_nested_attribute", nil),
 			},
 			expected: `"single_nested_attribute": schema.SingleNestedAttribute{
 Attributes: map[string]schema.Attribute{
@@ -1094,7 +1103,7 @@ Computed: true,
 
 		"sensitive": {
 			input: GeneratorSingleNestedAttribute{
-				CustomType: convert.NewCustomTypeNestedObject(nil, "single_nested_attribute"),
+				CustomType: convert.NewCustomTypeNestedObject(nil, "single_nested_attribute", nil),
 				Sensitive:  convert.NewSensitive(pointer(true)),
 			},
 			expected: `"single_nested_attribute": schema.SingleNestedAttribute{
@@ -1111,7 +1120,7 @@ Sensitive: true,
 
 		"description": {
 			input: GeneratorSingleNestedAttribute{
-				CustomType:  convert.NewCustomTypeNestedObject(nil, "single_nested_attribute"),
+				CustomType:  convert.NewCustomTypeNestedObject(nil, "single_nested_attribute", nil),
 				Description: convert.NewDescription(pointer("description")),
 			},
 			expected: `"single_nested_attribute": schema.SingleNestedAttribute{
@@ -1129,7 +1138,7 @@ MarkdownDescription: "description",
 
 		"deprecation-message": {
 			input: GeneratorSingleNestedAttribute{
-				CustomType:         convert.NewCustomTypeNestedObject(nil, "single_nested_attribute"),
+				CustomType:         convert.NewCustomTypeNestedObject(nil, "single_nested_attribute", nil),
 				DeprecationMessage: convert.NewDeprecationMessage(pointer("deprecated")),
 			},
 			expected: `"single_nested_attribute": schema.SingleNestedAttribute{
@@ -1146,7 +1155,7 @@ DeprecationMessage: "deprecated",
 
 		"validators": {
 			input: GeneratorSingleNestedAttribute{
-				CustomType: convert.NewCustomTypeNestedObject(nil, "single_nested_attribute"),
+				CustomType: convert.NewCustomTypeNestedObject(nil, "single_nested_attribute", nil),
 				Validators: convert.NewValidators(convert.ValidatorTypeObject, specschema.CustomValidators{
 					&specschema.CustomValidator{
 						SchemaDefinition: "my_validator.Validate()",
@@ -1173,7 +1182,7 @@ my_other_validator.Validate(),
 
 		"plan-modifiers": {
 			input: GeneratorSingleNestedAttribute{
-				CustomType: convert.NewCustomTypeNestedObject(nil, "single_nested_attribute"),
+				CustomType: convert.NewCustomTypeNestedObject(nil, "single_nested_attribute", nil),
 				PlanModifiers: convert.NewPlanModifiers(convert.PlanModifierTypeObject, specschema.CustomPlanModifiers{
 					&specschema.CustomPlanModifier{
 						SchemaDefinition: "my_plan_modifier.Modify()",
@@ -1200,7 +1209,7 @@ my_other_plan_modifier.Modify(),
 
 		"default-custom": {
 			input: GeneratorSingleNestedAttribute{
-				CustomType: convert.NewCustomTypeNestedObject(nil, "single_nested_attribute"),
+				CustomType: convert.NewCustomTypeNestedObject(nil, "single_nested_attribute", nil),
 				Default: convert.NewDefaultCustom(&specschema.CustomDefault{
 					SchemaDefinition: "my_object_default.Default()",
 				}),
@@ -1258,6 +1267,7 @@ func TestGeneratorSingleNestedAttribute_ModelField(t *testing.T) {
 						ValueType: "my_custom_value_type",
 					},
 					"single_nested_attribute",
+					nil,
 				),
 			},
 			expected: model.Field{
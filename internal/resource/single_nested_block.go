@@ -26,20 +26,22 @@ type GeneratorSingleNestedBlock struct {
 	PlanModifiers            convert.PlanModifiers
 	Sensitive                convert.Sensitive
 	Validators               convert.Validators
+
+	options *schema.Options
 }
 
-func NewGeneratorSingleNestedBlock(name string, b *resource.SingleNestedBlock) (GeneratorSingleNestedBlock, error) {
+func NewGeneratorSingleNestedBlock(name string, b *resource.SingleNestedBlock, opts *schema.Options) (GeneratorSingleNestedBlock, error) {
 	if b == nil {
 		return GeneratorSingleNestedBlock{}, fmt.Errorf("*resource.SingleNestedBlock is nil")
 	}
 
-	attributes, err := NewAttributes(b.Attributes)
+	attributes, err := NewAttributes(b.Attributes, name, opts)
 
 	if err != nil {
 		return GeneratorSingleNestedBlock{}, err
 	}
 
-	blocks, err := NewBlocks(b.Blocks)
+	blocks, err := NewBlocks(b.Blocks, name, opts)
 
 	if err != nil {
 		return GeneratorSingleNestedBlock{}, err
@@ -47,7 +49,7 @@ func NewGeneratorSingleNestedBlock(name string, b *resource.SingleNestedBlock) (
 
 	c := convert.NewComputedOptionalRequired(b.ComputedOptionalRequired)
 
-	ct := convert.NewCustomTypeNestedObject(b.CustomType, name)
+	ct := convert.NewCustomTypeNestedObject(b.CustomType, name, opts)
 
 	d := convert.NewDescription(b.Description)
 
@@ -60,7 +62,7 @@ func NewGeneratorSingleNestedBlock(name string, b *resource.SingleNestedBlock) (
 	v := convert.NewValidators(convert.ValidatorTypeObject, b.Validators.CustomValidators())
 
 	return GeneratorSingleNestedBlock{
-		AssociatedExternalType:   schema.NewAssocExtType(b.AssociatedExternalType),
+		AssociatedExternalType:   schema.NewAssocExtType(b.AssociatedExternalType, opts),
 		Attributes:               attributes,
 		Blocks:                   blocks,
 		ComputedOptionalRequired: c,
@@ -70,6 +72,7 @@ func NewGeneratorSingleNestedBlock(name string, b *resource.SingleNestedBlock) (
 		PlanModifiers:            pm,
 		Sensitive:                s,
 		Validators:               v,
+		options:                  opts,
 	}, nil
 }
 
@@ -183,9 +186,9 @@ func (g GeneratorSingleNestedBlock) Schema(name schema.FrameworkIdentifier) (str
 
 func (g GeneratorSingleNestedBlock) ModelField(name schema.FrameworkIdentifier) (model.Field, error) {
 	f := model.Field{
-		Name:      name.ToPascalCase(),
+		Name:      g.options.ToPascalCase(name),
 		TfsdkName: name.ToString(),
-		ValueType: name.ToPascalCase() + "Value",
+		ValueType: g.options.TypeReference(string(name)) + "Value",
 	}
 
 	customValueType := g.CustomType.ValueType()
@@ -208,7 +211,7 @@ func (g GeneratorSingleNestedBlock) GetBlocks() schema.GeneratorBlocks {
 func (g GeneratorSingleNestedBlock) CustomTypeAndValue(name string) ([]byte, error) {
 	var buf bytes.Buffer
 
-	attributeAttrValues, err := g.Attributes.AttrValues()
+	attributeAttrValues, err := g.Attributes.AttrValues(name, g.options)
 
 	if err != nil {
 		return nil, err
@@ -230,7 +233,7 @@ func (g GeneratorSingleNestedBlock) CustomTypeAndValue(name string) ([]byte, err
 		attributesBlocksAttrValues[k] = v
 	}
 
-	objectType := schema.NewCustomNestedObjectType(name, attributesBlocksAttrValues)
+	objectType := schema.NewCustomNestedObjectType(name, attributesBlocksAttrValues, g.options)
 
 	b, err := objectType.Render()
 
@@ -262,13 +265,13 @@ func (g GeneratorSingleNestedBlock) CustomTypeAndValue(name string) ([]byte, err
 		attributesBlocksTypes[k] = v
 	}
 
-	attributeAttrTypes, err := g.Attributes.AttrTypes()
+	attributeAttrTypes, err := g.Attributes.AttrTypes(name, g.options)
 
 	if err != nil {
 		return nil, err
 	}
 
-	blockAttrTypes, err := g.Blocks.AttrTypes()
+	blockAttrTypes, err := g.Blocks.AttrTypes(name, g.options)
 
 	if err != nil {
 		return nil, err
@@ -291,7 +294,7 @@ func (g GeneratorSingleNestedBlock) CustomTypeAndValue(name string) ([]byte, err
 		return nil, err
 	}
 
-	objectValue := schema.NewCustomNestedObjectValue(name, attributesBlocksTypes, attributesBlocksAttrTypes, attributesBlocksAttrValues, attributeCollectionTypes)
+	objectValue := schema.NewCustomNestedObjectValue(name, attributesBlocksTypes, attributesBlocksAttrTypes, attributesBlocksAttrValues, attributeCollectionTypes, g.options)
 
 	b, err = objectValue.Render()
 
@@ -309,7 +312,7 @@ func (g GeneratorSingleNestedBlock) CustomTypeAndValue(name string) ([]byte, err
 	// CustomTypeAndValue interface (i.e, nested attributes).
 	for _, k := range attributeKeys {
 		if c, ok := g.Attributes[k].(schema.CustomTypeAndValue); ok {
-			b, err := c.CustomTypeAndValue(k)
+			b, err := g.options.NestedCustomTypeAndValue(c, g.options.NestedTypeName(name, k))
 
 			if err != nil {
 				return nil, err
@@ -321,7 +324,7 @@ func (g GeneratorSingleNestedBlock) CustomTypeAndValue(name string) ([]byte, err
 
 	for _, k := range blockKeys {
 		if c, ok := g.Blocks[k].(schema.CustomTypeAndValue); ok {
-			b, err := c.CustomTypeAndValue(k)
+			b, err := g.options.NestedCustomTypeAndValue(c, g.options.NestedTypeName(name, k))
 
 			if err != nil {
 				return nil, err
@@ -334,6 +337,10 @@ func (g GeneratorSingleNestedBlock) CustomTypeAndValue(name string) ([]byte, err
 	return buf.Bytes(), nil
 }
 
+func (g GeneratorSingleNestedBlock) GetAssociatedExternalType() *schema.AssocExtType {
+	return g.AssociatedExternalType
+}
+
 func (g GeneratorSingleNestedBlock) ToFromFunctions(name string) ([]byte, error) {
 	if g.AssociatedExternalType == nil {
 		return nil, nil
@@ -349,7 +356,7 @@ func (g GeneratorSingleNestedBlock) ToFromFunctions(name string) ([]byte, error)
 
 	fromFuncs, _ := g.Attributes.FromFuncs()
 
-	toFrom := schema.NewToFromNestedObject(name, g.AssociatedExternalType, toFuncs, fromFuncs)
+	toFrom := schema.NewToFromNestedObject(name, g.AssociatedExternalType, toFuncs, fromFuncs, g.options)
 
 	b, err := toFrom.Render()
 
@@ -365,7 +372,7 @@ func (g GeneratorSingleNestedBlock) ToFromFunctions(name string) ([]byte, error)
 	// ToFrom interface.
 	for _, k := range attributeKeys {
 		if c, ok := g.Attributes[k].(schema.ToFrom); ok {
-			b, err := c.ToFromFunctions(k)
+			b, err := g.options.NestedToFromFunctions(c, g.options.NestedTypeName(name, k))
 
 			if err != nil {
 				return nil, err
@@ -385,3 +392,27 @@ func (g GeneratorSingleNestedBlock) To() (schema.ToFromConversion, error) {
 func (g GeneratorSingleNestedBlock) From() (schema.ToFromConversion, error) {
 	return schema.ToFromConversion{}, schema.NewUnimplementedError(errors.New("single nested type is not yet implemented"))
 }
+
+// ModelToFrom returns the conversion used for the block when it is a field of the
+// top-level data model, in which case the field is a custom value type which
+// implements conversion to and from the associated external type.
+func (g GeneratorSingleNestedBlock) ModelToFrom() (schema.ToFromConversion, error) {
+	if g.AssociatedExternalType == nil {
+		return schema.ToFromConversion{}, schema.NewUnimplementedError(errors.New("single nested type without an associated external type is not yet implemented"))
+	}
+
+	return schema.ToFromConversion{
+		AssocExtType: g.AssociatedExternalType,
+	}, nil
+}
+
+func (g GeneratorSingleNestedBlock) Properties() schema.FieldProperties {
+	return schema.FieldProperties{
+		Computed:           g.ComputedOptionalRequired.IsComputed(),
+		Optional:           g.ComputedOptionalRequired.IsOptional(),
+		Required:           g.ComputedOptionalRequired.IsRequired(),
+		Sensitive:          g.Sensitive.IsSensitive(),
+		Description:        g.Description.Description(),
+		DeprecationMessage: g.DeprecationMessage.DeprecationMessage(),
+	}
+}
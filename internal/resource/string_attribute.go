@@ -24,16 +24,18 @@ type GeneratorStringAttribute struct {
 	PlanModifiers            convert.PlanModifiers
 	Sensitive                convert.Sensitive
 	Validators               convert.Validators
+
+	options *generatorschema.Options
 }
 
-func NewGeneratorStringAttribute(name string, a *resource.StringAttribute) (GeneratorStringAttribute, error) {
+func NewGeneratorStringAttribute(name string, a *resource.StringAttribute, opts *generatorschema.Options) (GeneratorStringAttribute, error) {
 	if a == nil {
 		return GeneratorStringAttribute{}, fmt.Errorf("*resource.StringAttribute is nil")
 	}
 
 	c := convert.NewComputedOptionalRequired(a.ComputedOptionalRequired)
 
-	ctp := convert.NewCustomTypePrimitive(a.CustomType, a.AssociatedExternalType, name)
+	ctp := convert.NewCustomTypePrimitive(a.CustomType, a.AssociatedExternalType, name, opts)
 
 	ds := convert.NewDefaultString(a.Default)
 
@@ -48,7 +50,7 @@ func NewGeneratorStringAttribute(name string, a *resource.StringAttribute) (Gene
 	v := convert.NewValidators(convert.ValidatorTypeString, a.Validators.CustomValidators())
 
 	return GeneratorStringAttribute{
-		AssociatedExternalType:   generatorschema.NewAssocExtType(a.AssociatedExternalType),
+		AssociatedExternalType:   generatorschema.NewAssocExtType(a.AssociatedExternalType, opts),
 		ComputedOptionalRequired: c,
 		CustomType:               ctp,
 		Default:                  ds,
@@ -57,6 +59,7 @@ func NewGeneratorStringAttribute(name string, a *resource.StringAttribute) (Gene
 		PlanModifiers:            pm,
 		Sensitive:                s,
 		Validators:               v,
+		options:                  opts,
 	}, nil
 }
 
@@ -145,7 +148,7 @@ func (g GeneratorStringAttribute) Schema(name generatorschema.FrameworkIdentifie
 
 func (g GeneratorStringAttribute) ModelField(name generatorschema.FrameworkIdentifier) (model.Field, error) {
 	field := model.Field{
-		Name:      name.ToPascalCase(),
+		Name:      g.options.ToPascalCase(name),
 		TfsdkName: name.ToString(),
 		ValueType: model.StringValueType,
 	}
@@ -166,7 +169,7 @@ func (g GeneratorStringAttribute) CustomTypeAndValue(name string) ([]byte, error
 
 	var buf bytes.Buffer
 
-	stringType := generatorschema.NewCustomStringType(name)
+	stringType := generatorschema.NewCustomStringType(name, g.options)
 
 	b, err := stringType.Render()
 
@@ -176,7 +179,7 @@ func (g GeneratorStringAttribute) CustomTypeAndValue(name string) ([]byte, error
 
 	buf.Write(b)
 
-	stringValue := generatorschema.NewCustomStringValue(name)
+	stringValue := generatorschema.NewCustomStringValue(name, g.options)
 
 	b, err = stringValue.Render()
 
@@ -189,12 +192,16 @@ func (g GeneratorStringAttribute) CustomTypeAndValue(name string) ([]byte, error
 	return buf.Bytes(), nil
 }
 
+func (g GeneratorStringAttribute) GetAssociatedExternalType() *generatorschema.AssocExtType {
+	return g.AssociatedExternalType
+}
+
 func (g GeneratorStringAttribute) ToFromFunctions(name string) ([]byte, error) {
 	if g.AssociatedExternalType == nil {
 		return nil, nil
 	}
 
-	toFrom := generatorschema.NewToFromString(name, g.AssociatedExternalType)
+	toFrom := generatorschema.NewToFromString(name, g.AssociatedExternalType, g.options)
 
 	b, err := toFrom.Render()
 
@@ -208,7 +215,7 @@ func (g GeneratorStringAttribute) ToFromFunctions(name string) ([]byte, error) {
 // AttrType returns a string representation of a basetypes.StringTypable type.
 func (g GeneratorStringAttribute) AttrType(name generatorschema.FrameworkIdentifier) (string, error) {
 	if g.AssociatedExternalType != nil {
-		return fmt.Sprintf("%sType{}", name.ToPascalCase()), nil
+		return fmt.Sprintf("%sType{}", g.options.ToPascalCase(name)), nil
 	}
 
 	return "basetypes.StringType{}", nil
@@ -217,7 +224,7 @@ func (g GeneratorStringAttribute) AttrType(name generatorschema.FrameworkIdentif
 // AttrValue returns a string representation of a basetypes.StringValuable type.
 func (g GeneratorStringAttribute) AttrValue(name generatorschema.FrameworkIdentifier) string {
 	if g.AssociatedExternalType != nil {
-		return fmt.Sprintf("%sValue", name.ToPascalCase())
+		return fmt.Sprintf("%sValue", g.options.ToPascalCase(name))
 	}
 
 	return "basetypes.StringValue"
@@ -246,3 +253,15 @@ func (g GeneratorStringAttribute) From() (generatorschema.ToFromConversion, erro
 		Default: "StringPointerValue",
 	}, nil
 }
+
+func (g GeneratorStringAttribute) Properties() generatorschema.FieldProperties {
+	return generatorschema.FieldProperties{
+		Computed:           g.ComputedOptionalRequired.IsComputed(),
+		Optional:           g.ComputedOptionalRequired.IsOptional(),
+		Required:           g.ComputedOptionalRequired.IsRequired(),
+		Sensitive:          g.Sensitive.IsSensitive(),
+		Description:        g.Description.Description(),
+		DeprecationMessage: g.DeprecationMessage.DeprecationMessage(),
+		StaticDefault:      g.Default.Static(),
+	}
+}
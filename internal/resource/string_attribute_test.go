@@ -34,7 +34,7 @@ func TestGeneratorStringAttribute_New(t *testing.T) {
 			},
 			expected: GeneratorStringAttribute{
 				ComputedOptionalRequired: convert.NewComputedOptionalRequired(specschema.Computed),
-				CustomType:               convert.NewCustomTypePrimitive(nil, nil, "name"),
+				CustomType:               convert.NewCustomTypePrimitive(nil, nil, "name", nil),
 				PlanModifiers:            convert.NewPlanModifiers(convert.PlanModifierTypeString, specschema.CustomPlanModifiers{}),
 				Validators:               convert.NewValidators(convert.ValidatorTypeString, specschema.CustomValidators{}),
 			},
@@ -45,7 +45,7 @@ func TestGeneratorStringAttribute_New(t *testing.T) {
 			},
 			expected: GeneratorStringAttribute{
 				ComputedOptionalRequired: convert.NewComputedOptionalRequired(specschema.ComputedOptional),
-				CustomType:               convert.NewCustomTypePrimitive(nil, nil, "name"),
+				CustomType:               convert.NewCustomTypePrimitive(nil, nil, "name", nil),
 				PlanModifiers:            convert.NewPlanModifiers(convert.PlanModifierTypeString, specschema.CustomPlanModifiers{}),
 				Validators:               convert.NewValidators(convert.ValidatorTypeString, specschema.CustomValidators{}),
 			},
@@ -56,7 +56,7 @@ func TestGeneratorStringAttribute_New(t *testing.T) {
 			},
 			expected: GeneratorStringAttribute{
 				ComputedOptionalRequired: convert.NewComputedOptionalRequired(specschema.Optional),
-				CustomType:               convert.NewCustomTypePrimitive(nil, nil, "name"),
+				CustomType:               convert.NewCustomTypePrimitive(nil, nil, "name", nil),
 				PlanModifiers:            convert.NewPlanModifiers(convert.PlanModifierTypeString, specschema.CustomPlanModifiers{}),
 				Validators:               convert.NewValidators(convert.ValidatorTypeString, specschema.CustomValidators{}),
 			},
@@ -67,7 +67,7 @@ func TestGeneratorStringAttribute_New(t *testing.T) {
 			},
 			expected: GeneratorStringAttribute{
 				ComputedOptionalRequired: convert.NewComputedOptionalRequired(specschema.Required),
-				CustomType:               convert.NewCustomTypePrimitive(nil, nil, "name"),
+				CustomType:               convert.NewCustomTypePrimitive(nil, nil, "name", nil),
 				PlanModifiers:            convert.NewPlanModifiers(convert.PlanModifierTypeString, specschema.CustomPlanModifiers{}),
 				Validators:               convert.NewValidators(convert.ValidatorTypeString, specschema.CustomValidators{}),
 			},
@@ -89,7 +89,7 @@ func TestGeneratorStringAttribute_New(t *testing.T) {
 					},
 					Type:      "my_type",
 					ValueType: "myvalue_type",
-				}, nil, "name"),
+				}, nil, "name", nil),
 				PlanModifiers: convert.NewPlanModifiers(convert.PlanModifierTypeString, nil),
 				Validators:    convert.NewValidators(convert.ValidatorTypeString, nil),
 			},
@@ -99,7 +99,7 @@ func TestGeneratorStringAttribute_New(t *testing.T) {
 				DeprecationMessage: pointer("deprecation message"),
 			},
 			expected: GeneratorStringAttribute{
-				CustomType:         convert.NewCustomTypePrimitive(nil, nil, "name"),
+				CustomType:         convert.NewCustomTypePrimitive(nil, nil, "name", nil),
 				DeprecationMessage: convert.NewDeprecationMessage(pointer("deprecation message")),
 				PlanModifiers:      convert.NewPlanModifiers(convert.PlanModifierTypeString, specschema.CustomPlanModifiers{}),
 				Validators:         convert.NewValidators(convert.ValidatorTypeString, specschema.CustomValidators{}),
@@ -110,7 +110,7 @@ func TestGeneratorStringAttribute_New(t *testing.T) {
 				Description: pointer("description"),
 			},
 			expected: GeneratorStringAttribute{
-				CustomType:    convert.NewCustomTypePrimitive(nil, nil, "name"),
+				CustomType:    convert.NewCustomTypePrimitive(nil, nil, "name", nil),
 				Description:   convert.NewDescription(pointer("description")),
 				PlanModifiers: convert.NewPlanModifiers(convert.PlanModifierTypeString, specschema.CustomPlanModifiers{}),
 				Validators:    convert.NewValidators(convert.ValidatorTypeString, specschema.CustomValidators{}),
@@ -121,7 +121,7 @@ func TestGeneratorStringAttribute_New(t *testing.T) {
 				Sensitive: pointer(true),
 			},
 			expected: GeneratorStringAttribute{
-				CustomType:    convert.NewCustomTypePrimitive(nil, nil, "name"),
+				CustomType:    convert.NewCustomTypePrimitive(nil, nil, "name", nil),
 				Sensitive:     convert.NewSensitive(pointer(true)),
 				PlanModifiers: convert.NewPlanModifiers(convert.PlanModifierTypeString, specschema.CustomPlanModifiers{}),
 				Validators:    convert.NewValidators(convert.ValidatorTypeString, specschema.CustomValidators{}),
@@ -143,7 +143,7 @@ func TestGeneratorStringAttribute_New(t *testing.T) {
 				},
 			},
 			expected: GeneratorStringAttribute{
-				CustomType:    convert.NewCustomTypePrimitive(nil, nil, "name"),
+				CustomType:    convert.NewCustomTypePrimitive(nil, nil, "name", nil),
 				PlanModifiers: convert.NewPlanModifiers(convert.PlanModifierTypeString, nil),
 				Validators: convert.NewValidators(convert.ValidatorTypeString, specschema.CustomValidators{
 					&specschema.CustomValidator{
@@ -173,7 +173,7 @@ func TestGeneratorStringAttribute_New(t *testing.T) {
 				},
 			},
 			expected: GeneratorStringAttribute{
-				CustomType: convert.NewCustomTypePrimitive(nil, nil, "name"),
+				CustomType: convert.NewCustomTypePrimitive(nil, nil, "name", nil),
 				PlanModifiers: convert.NewPlanModifiers(convert.PlanModifierTypeString, specschema.CustomPlanModifiers{
 					&specschema.CustomPlanModifier{
 						Imports: []code.Import{
@@ -202,7 +202,7 @@ func TestGeneratorStringAttribute_New(t *testing.T) {
 				},
 			},
 			expected: GeneratorStringAttribute{
-				CustomType: convert.NewCustomTypePrimitive(nil, nil, "name"),
+				CustomType: convert.NewCustomTypePrimitive(nil, nil, "name", nil),
 				Default: convert.NewDefaultString(&specschema.StringDefault{
 					Custom: &specschema.CustomDefault{
 						Imports: []code.Import{
@@ -225,7 +225,7 @@ func TestGeneratorStringAttribute_New(t *testing.T) {
 		t.Run(name, func(t *testing.T) {
 			t.Parallel()
 
-			got, err := NewGeneratorStringAttribute("name", testCase.input)
+			got, err := NewGeneratorStringAttribute("name", testCase.input, nil)
 
 			if diff := cmp.Diff(err, testCase.expectedError, equateErrorMessage); diff != "" {
 				t.Errorf("unexpected error: %s", diff)
@@ -254,7 +254,7 @@ func TestGeneratorStringAttribute_Imports(t *testing.T) {
 		},
 		"custom-type-without-import": {
 			input: GeneratorStringAttribute{
-				CustomType: convert.NewCustomTypePrimitive(&specschema.CustomType{}, nil, ""),
+				CustomType: convert.NewCustomTypePrimitive(&specschema.CustomType{}, nil, "", nil),
 			},
 			expected: []code.Import{},
 		},
@@ -268,6 +268,7 @@ func TestGeneratorStringAttribute_Imports(t *testing.T) {
 					},
 					nil,
 					"",
+					nil,
 				),
 			},
 			expected: []code.Import{},
@@ -282,6 +283,7 @@ func TestGeneratorStringAttribute_Imports(t *testing.T) {
 					},
 					nil,
 					"",
+					nil,
 				),
 			},
 			expected: []code.Import{
@@ -604,6 +606,7 @@ func TestGeneratorStringAttribute_Imports(t *testing.T) {
 					},
 					nil,
 					"",
+					nil,
 				),
 			},
 			expected: []code.Import{
@@ -662,6 +665,7 @@ func TestGeneratorStringAttribute_Schema(t *testing.T) {
 					},
 					nil,
 					"string_attribute",
+					nil,
 				),
 			},
 			expected: `"string_attribute": schema.StringAttribute{
@@ -677,6 +681,7 @@ CustomType: my_custom_type,
 						Type: "*api.ExtString",
 					},
 					"string_attribute",
+					nil,
 				),
 			},
 			expected: `"string_attribute": schema.StringAttribute{
@@ -694,6 +699,7 @@ CustomType: StringAttributeType{},
 						Type: "*api.ExtString",
 					},
 					"string_attribute",
+					nil,
 				),
 			},
 			expected: `"string_attribute": schema.StringAttribute{
@@ -861,6 +867,7 @@ func TestGeneratorStringAttribute_ModelField(t *testing.T) {
 					},
 					nil,
 					"",
+					nil,
 				),
 			},
 			expected: model.Field{
@@ -877,6 +884,7 @@ func TestGeneratorStringAttribute_ModelField(t *testing.T) {
 						Type: "*api.StringAttribute",
 					},
 					"string_attribute",
+					nil,
 				),
 			},
 			expected: model.Field{
@@ -895,6 +903,7 @@ func TestGeneratorStringAttribute_ModelField(t *testing.T) {
 						Type: "*api.StringAttribute",
 					},
 					"",
+					nil,
 				),
 			},
 			expected: model.Field{
@@ -922,3 +931,77 @@ func TestGeneratorStringAttribute_ModelField(t *testing.T) {
 		})
 	}
 }
+
+func TestGeneratorStringAttribute_Properties(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		input    GeneratorStringAttribute
+		expected generatorschema.FieldProperties
+	}{
+		"default": {},
+		"computed-optional": {
+			input: GeneratorStringAttribute{
+				ComputedOptionalRequired: convert.NewComputedOptionalRequired(specschema.ComputedOptional),
+			},
+			expected: generatorschema.FieldProperties{
+				Computed: true,
+				Optional: true,
+			},
+		},
+		"required-sensitive-described": {
+			input: GeneratorStringAttribute{
+				ComputedOptionalRequired: convert.NewComputedOptionalRequired(specschema.Required),
+				DeprecationMessage:       convert.NewDeprecationMessage(pointer("Use other instead.")),
+				Description:              convert.NewDescription(pointer("The name.")),
+				Sensitive:                convert.NewSensitive(pointer(true)),
+			},
+			expected: generatorschema.FieldProperties{
+				Required:           true,
+				Sensitive:          true,
+				Description:        "The name.",
+				DeprecationMessage: "Use other instead.",
+			},
+		},
+		"static-default": {
+			input: GeneratorStringAttribute{
+				ComputedOptionalRequired: convert.NewComputedOptionalRequired(specschema.ComputedOptional),
+				Default: convert.NewDefaultString(&specschema.StringDefault{
+					Static: pointer(`say "hello"`),
+				}),
+			},
+			expected: generatorschema.FieldProperties{
+				Computed:      true,
+				Optional:      true,
+				StaticDefault: `"say \"hello\""`,
+			},
+		},
+		"custom-default": {
+			input: GeneratorStringAttribute{
+				ComputedOptionalRequired: convert.NewComputedOptionalRequired(specschema.ComputedOptional),
+				Default: convert.NewDefaultString(&specschema.StringDefault{
+					Custom: &specschema.CustomDefault{
+						SchemaDefinition: "my_default.Default()",
+					},
+				}),
+			},
+			expected: generatorschema.FieldProperties{
+				Computed: true,
+				Optional: true,
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.input.Properties()
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
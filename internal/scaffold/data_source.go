@@ -3,38 +3,17 @@
 
 package scaffold
 
-import (
-	"bytes"
-	"text/template"
-
-	"github.com/hashicorp/terraform-plugin-codegen-framework/internal/schema"
-)
-
 // DataSourceBytes will create scaffolding Go code bytes for a Terraform Plugin Framework data source
-func DataSourceBytes(dataSourceIdentifier schema.FrameworkIdentifier, packageName string) ([]byte, error) {
-	t, err := template.New("data_source_scaffold").Parse(dataSourceScaffoldGoTemplate)
-	if err != nil {
-		return nil, err
-	}
-
-	var buf bytes.Buffer
-
-	templateData := struct {
-		PackageName string
-		NameSnake   string
-		NameCamel   string
-		NamePascal  string
-	}{
-		PackageName: packageName,
-		NameSnake:   string(dataSourceIdentifier),
-		NameCamel:   dataSourceIdentifier.ToCamelCase(),
-		NamePascal:  dataSourceIdentifier.ToPascalCase(),
-	}
-
-	err = t.Execute(&buf, templateData)
-	if err != nil {
-		return nil, err
+// using the template text, or the embedded template if empty. The embedded template uses the
+// schema function and data model of the generated package, if set in the data.
+func DataSourceBytes(data TemplateData, text string) ([]byte, error) {
+	switch {
+	case text != "":
+	case data.GeneratedPackageName != "":
+		text = dataSourceSpecScaffoldGoTemplate
+	default:
+		text = dataSourceScaffoldGoTemplate
 	}
 
-	return buf.Bytes(), nil
+	return execute("data_source_scaffold", text, data)
 }
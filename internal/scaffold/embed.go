@@ -15,3 +15,36 @@ var dataSourceScaffoldGoTemplate string
 
 //go:embed templates/provider_scaffold.gotmpl
 var providerScaffoldGoTemplate string
+
+//go:embed templates/resource_spec_scaffold.gotmpl
+var resourceSpecScaffoldGoTemplate string
+
+//go:embed templates/data_source_spec_scaffold.gotmpl
+var dataSourceSpecScaffoldGoTemplate string
+
+//go:embed templates/provider_spec_scaffold.gotmpl
+var providerSpecScaffoldGoTemplate string
+
+//go:embed templates/project/go.mod.gotmpl
+var projectGoModTemplate string
+
+//go:embed templates/project/main.go.gotmpl
+var projectMainGoTemplate string
+
+//go:embed templates/project/GNUmakefile.gotmpl
+var projectMakefileTemplate string
+
+//go:embed templates/project/tools/go.mod.gotmpl
+var projectToolsGoModTemplate string
+
+//go:embed templates/project/tools/tools.go.gotmpl
+var projectToolsGoTemplate string
+
+//go:embed templates/project/tfplugingen/spec.json.gotmpl
+var projectSpecTemplate string
+
+//go:embed templates/ephemeral_resource_scaffold.gotmpl
+var ephemeralResourceScaffoldGoTemplate string
+
+//go:embed templates/function_scaffold.gotmpl
+var functionScaffoldGoTemplate string
@@ -0,0 +1,14 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package scaffold
+
+// EphemeralResourceBytes will create scaffolding Go code bytes for a Terraform Plugin Framework
+// ephemeral resource using the template text, or the embedded template if empty.
+func EphemeralResourceBytes(data TemplateData, text string) ([]byte, error) {
+	if text == "" {
+		text = ephemeralResourceScaffoldGoTemplate
+	}
+
+	return execute("ephemeral_resource_scaffold", text, data)
+}
@@ -0,0 +1,123 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package scaffold
+
+import (
+	"fmt"
+	"go/token"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-codegen-framework/internal/schema"
+)
+
+// FunctionType is a type of function parameter or return value.
+type FunctionType struct {
+	// Kind prefixes the framework parameter and return types (e.g., String
+	// for function.StringParameter and function.StringReturn).
+	Kind string
+
+	// GoType is the Go type that arguments and the result are read into and
+	// set from (e.g., string).
+	GoType string
+
+	// Import is the import path of the package declaring GoType, if any.
+	Import string
+}
+
+// typesImport is the import path of the framework types package, which is
+// grouped with the framework function package in scaffolded code.
+const typesImport = "github.com/hashicorp/terraform-plugin-framework/types"
+
+// functionTypes are the function types which can be used for parameters and
+// return values, keyed on name.
+var functionTypes = map[string]FunctionType{
+	"bool":    {Kind: "Bool", GoType: "bool"},
+	"dynamic": {Kind: "Dynamic", GoType: "types.Dynamic", Import: typesImport},
+	"float32": {Kind: "Float32", GoType: "float32"},
+	"float64": {Kind: "Float64", GoType: "float64"},
+	"int32":   {Kind: "Int32", GoType: "int32"},
+	"int64":   {Kind: "Int64", GoType: "int64"},
+	"number":  {Kind: "Number", GoType: "*big.Float", Import: "math/big"},
+	"string":  {Kind: "String", GoType: "string"},
+}
+
+// reservedParameterNames are used by the scaffolded Run method.
+var reservedParameterNames = []string{"ctx", "f", "req", "resp", "result"}
+
+// FunctionParameter is a parameter of a scaffolded function.
+type FunctionParameter struct {
+	// Name is the name of the parameter in snake case, and NameCamel is the
+	// name of the variable the argument is read into.
+	Name      string
+	NameCamel string
+
+	// Type is the type of the parameter.
+	Type FunctionType
+}
+
+// ParseFunctionType returns the function type with the name (e.g., string).
+func ParseFunctionType(name string) (FunctionType, error) {
+	t, ok := functionTypes[name]
+	if !ok {
+		return FunctionType{}, fmt.Errorf("unsupported function type %q, expected one of: %s", name, strings.Join(FunctionTypeNames(), ", "))
+	}
+
+	return t, nil
+}
+
+// FunctionTypeNames returns the sorted names of the supported function types.
+func FunctionTypeNames() []string {
+	names := make([]string, 0, len(functionTypes))
+
+	for name := range functionTypes {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+
+	return names
+}
+
+// ParseFunctionParameter returns the function parameter described by a name
+// and type separated by a colon (e.g., input:string).
+func ParseFunctionParameter(s string) (FunctionParameter, error) {
+	name, typeName, ok := strings.Cut(s, ":")
+	if !ok {
+		return FunctionParameter{}, fmt.Errorf("parameter %q must be in the format name:type", s)
+	}
+
+	identifier := schema.FrameworkIdentifier(name)
+	if !identifier.Valid() {
+		return FunctionParameter{}, fmt.Errorf("'%s' is not a valid function parameter identifier", name)
+	}
+
+	nameCamel := identifier.ToCamelCase()
+
+	if token.IsKeyword(nameCamel) || slices.Contains(reservedParameterNames, nameCamel) {
+		return FunctionParameter{}, fmt.Errorf("function parameter name %q is reserved", name)
+	}
+
+	t, err := ParseFunctionType(typeName)
+	if err != nil {
+		return FunctionParameter{}, err
+	}
+
+	return FunctionParameter{
+		Name:      name,
+		NameCamel: nameCamel,
+		Type:      t,
+	}, nil
+}
+
+// FunctionBytes will create scaffolding Go code bytes for a Terraform Plugin Framework function
+// using the template text, or the embedded template if empty.
+func FunctionBytes(data TemplateData, text string) ([]byte, error) {
+	if text == "" {
+		text = functionScaffoldGoTemplate
+	}
+
+	return execute("function_scaffold", text, data)
+}
@@ -0,0 +1,106 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package scaffold
+
+import (
+	"errors"
+	"fmt"
+	"go/parser"
+	"go/token"
+	"os"
+	"path"
+	"path/filepath"
+
+	"github.com/hashicorp/terraform-plugin-codegen-framework/internal/gomod"
+)
+
+// GeneratedPackage is the Go package containing the code generated for a data
+// source, resource or provider by the generate commands.
+type GeneratedPackage struct {
+	// Dir is the absolute path of the package directory.
+	Dir string
+
+	// ImportPath is the Go import path of the package.
+	ImportPath string
+
+	// Name is the name declared by the package clause.
+	Name string
+}
+
+// Alias returns the alias required when importing the package, which is empty
+// if the package name matches the last element of the import path.
+func (p GeneratedPackage) Alias() string {
+	if p.Name == path.Base(p.ImportPath) {
+		return ""
+	}
+
+	return p.Name
+}
+
+// FindResourcePackage returns the package containing the code generated for the
+// named resource by generate resources or generate all, with generatedDir as the
+// --output directory. When generated with --package, the code is found in
+// generatedDir itself (e.g., example_resource_gen.go), and otherwise in a
+// package for each resource (e.g., resource_example/example_resource_gen.go).
+// The latter is assumed if no generated code is found, so code can be
+// scaffolded before it is generated.
+//
+// The import path is derived from the path of the package directory relative to
+// the go.mod file in generatedDir or its closest parent directory.
+func FindResourcePackage(generatedDir, name string) (GeneratedPackage, error) {
+	return findGeneratedPackage(generatedDir, "resource", "resource", name)
+}
+
+func findGeneratedPackage(generatedDir, dirPrefix, fileSuffix, name string) (GeneratedPackage, error) {
+	dir, err := filepath.Abs(generatedDir)
+	if err != nil {
+		return GeneratedPackage{}, err
+	}
+
+	filename := fmt.Sprintf("%s_%s_gen.go", name, fileSuffix)
+
+	pkg := GeneratedPackage{
+		Dir:  filepath.Join(dir, fmt.Sprintf("%s_%s", dirPrefix, name)),
+		Name: fmt.Sprintf("%s_%s", dirPrefix, name),
+	}
+
+	if _, err := os.Stat(filepath.Join(dir, filename)); err == nil {
+		pkg.Dir = dir
+
+		pkg.Name, err = packageName(filepath.Join(dir, filename))
+		if err != nil {
+			return GeneratedPackage{}, err
+		}
+	}
+
+	pkg.ImportPath, err = gomod.ImportPath(pkg.Dir)
+	if err != nil {
+		return GeneratedPackage{}, err
+	}
+
+	return pkg, nil
+}
+
+// packageName returns the name declared by the package clause of a Go file.
+func packageName(filename string) (string, error) {
+	f, err := parser.ParseFile(token.NewFileSet(), filename, nil, parser.PackageClauseOnly)
+	if err != nil {
+		return "", err
+	}
+
+	return f.Name.Name, nil
+}
+
+// ModulePath returns the path of the Go module containing dir, read from the
+// go.mod file in dir or its closest parent directory. An empty path is returned
+// if there is no go.mod file.
+func ModulePath(dir string) (string, error) {
+	modPath, err := gomod.ModulePath(dir)
+
+	if errors.Is(err, gomod.ErrNotFound) {
+		return "", nil
+	}
+
+	return modPath, err
+}
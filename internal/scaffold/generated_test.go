@@ -0,0 +1,126 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package scaffold_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/hashicorp/terraform-plugin-codegen-framework/internal/scaffold"
+)
+
+func TestModulePath(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		goMod         string
+		expected      string
+		expectedError bool
+	}{
+		"module": {
+			goMod:    "module github.com/example/terraform-provider-example\n\ngo 1.22\n",
+			expected: "github.com/example/terraform-provider-example",
+		},
+		"quoted_module_with_comment": {
+			goMod:    "// Provider module.\nmodule \"github.com/example/terraform-provider-example\" // comment\n",
+			expected: "github.com/example/terraform-provider-example",
+		},
+		"no_module_directive": {
+			goMod:         "go 1.22\n",
+			expectedError: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			dir := t.TempDir()
+
+			err := os.WriteFile(filepath.Join(dir, "go.mod"), []byte(testCase.goMod), 0666)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			got, err := scaffold.ModulePath(filepath.Join(dir, "internal", "provider"))
+
+			if testCase.expectedError {
+				if err == nil {
+					t.Fatal("expected error")
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestFindResourcePackage(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+
+	err := os.WriteFile(filepath.Join(dir, "go.mod"), []byte("module example.com/provider\n"), 0666)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	err = os.MkdirAll(filepath.Join(dir, "internal", "specified"), 0777)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	err = os.WriteFile(filepath.Join(dir, "internal", "specified", "example_resource_gen.go"), []byte("package generated\n"), 0666)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	testCases := map[string]struct {
+		generatedDir string
+		expected     scaffold.GeneratedPackage
+	}{
+		"default_layout": {
+			generatedDir: filepath.Join(dir, "internal", "generated"),
+			expected: scaffold.GeneratedPackage{
+				Dir:        filepath.Join(dir, "internal", "generated", "resource_example"),
+				ImportPath: "example.com/provider/internal/generated/resource_example",
+				Name:       "resource_example",
+			},
+		},
+		"package_layout": {
+			generatedDir: filepath.Join(dir, "internal", "specified"),
+			expected: scaffold.GeneratedPackage{
+				Dir:        filepath.Join(dir, "internal", "specified"),
+				ImportPath: "example.com/provider/internal/specified",
+				Name:       "generated",
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := scaffold.FindResourcePackage(testCase.generatedDir, "example")
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
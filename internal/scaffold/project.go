@@ -0,0 +1,213 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package scaffold
+
+import (
+	"context"
+	"fmt"
+	"go/format"
+	"path"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-codegen-spec/spec"
+
+	"github.com/hashicorp/terraform-plugin-codegen-framework/internal/config"
+	"github.com/hashicorp/terraform-plugin-codegen-framework/internal/examples"
+	"github.com/hashicorp/terraform-plugin-codegen-framework/internal/schema"
+	"github.com/hashicorp/terraform-plugin-codegen-framework/pkg/generator"
+)
+
+const (
+	// projectGoVersion and projectFrameworkVersion are the versions of Go and
+	// terraform-plugin-framework required by a scaffolded project.
+	projectGoVersion        = "1.25.0"
+	projectFrameworkVersion = "v1.19.0"
+
+	// projectProviderDir is the directory of the provider package, and the
+	// output directory of the generated code, in a scaffolded project.
+	projectProviderDir = "internal/provider"
+
+	// projectStarter is the name of the starter resource and data source in
+	// a scaffolded project.
+	projectStarter = "example"
+)
+
+// projectData is the data available to the templates of a scaffolded project.
+type projectData struct {
+	Name             string
+	ModulePath       string
+	Address          string
+	GoVersion        string
+	FrameworkVersion string
+}
+
+// projectTemplates are the templates of the files in a scaffolded project other
+// than the generated and scaffolded provider code, keyed on path.
+var projectTemplates = map[string]string{
+	".tfplugingen/spec.json": projectSpecTemplate,
+	"GNUmakefile":            projectMakefileTemplate,
+	"go.mod":                 projectGoModTemplate,
+	"main.go":                projectMainGoTemplate,
+	"tools/go.mod":           projectToolsGoModTemplate,
+	"tools/tools.go":         projectToolsGoTemplate,
+}
+
+// Project returns the files of a new provider project with the Go module path,
+// keyed on slash-separated path relative to the project directory. The project
+// contains a specification skeleton (.tfplugingen/spec.json) declaring the
+// provider schema and a starter resource and data source, the code generated
+// from it, and scaffolded provider, resource and data source code which uses
+// the generated schema functions and data models. Example configurations, the
+// main package, go.mod, GNUmakefile and a tools module, which regenerates code
+// and documentation from the specification with go generate, are also
+// included.
+//
+// The provider is served at the registry address, or if empty, an address with
+// the namespace taken from the module path (e.g., registry.terraform.io/ourco/ourco
+// for github.com/ourco/terraform-provider-ourco).
+func Project(ctx context.Context, name schema.FrameworkIdentifier, modulePath, address string) (map[string][]byte, error) {
+	if address == "" {
+		address = defaultAddress(string(name), modulePath)
+	}
+
+	data := projectData{
+		Name:             string(name),
+		ModulePath:       modulePath,
+		Address:          address,
+		GoVersion:        projectGoVersion,
+		FrameworkVersion: projectFrameworkVersion,
+	}
+
+	files := make(map[string][]byte)
+
+	for filePath, text := range projectTemplates {
+		b, err := execute(filePath, text, data)
+		if err != nil {
+			return nil, fmt.Errorf("error creating %s: %w", filePath, err)
+		}
+
+		if path.Ext(filePath) == ".go" {
+			b, err = format.Source(b)
+			if err != nil {
+				return nil, fmt.Errorf("error formatting %s: %w", filePath, err)
+			}
+		}
+
+		files[filePath] = b
+	}
+
+	s, err := spec.Parse(ctx, files[".tfplugingen/spec.json"])
+	if err != nil {
+		return nil, fmt.Errorf("error parsing specification: %w", err)
+	}
+
+	generated, err := generator.Generate(ctx, s, generator.Options{})
+	if err != nil {
+		return nil, err
+	}
+
+	for filePath, b := range generated {
+		files[path.Join(projectProviderDir, filePath)] = b
+	}
+
+	exampleFiles, err := examples.Generate(s, config.Config{})
+	if err != nil {
+		return nil, err
+	}
+
+	for filePath, b := range exampleFiles {
+		files[path.Join("examples", filePath)] = b
+	}
+
+	err = projectCode(files, name, modulePath)
+	if err != nil {
+		return nil, err
+	}
+
+	return files, nil
+}
+
+// projectCode adds the scaffolded provider, resource and data source code, which
+// uses the code generated into packages for each in the provider directory.
+func projectCode(files map[string][]byte, name schema.FrameworkIdentifier, modulePath string) error {
+	starter := schema.FrameworkIdentifier(projectStarter)
+
+	generated := func(dirPrefix string, identifier schema.FrameworkIdentifier) GeneratedPackage {
+		pkgName := fmt.Sprintf("%s_%s", dirPrefix, identifier)
+
+		return GeneratedPackage{
+			ImportPath: path.Join(modulePath, projectProviderDir, pkgName),
+			Name:       pkgName,
+		}
+	}
+
+	providerData := NewTemplateData(name, path.Base(projectProviderDir), nil)
+	providerData.ProviderName = string(name)
+	providerData.ModulePath = modulePath
+	providerData.Resources = []string{starter.ToPascalCase()}
+	providerData.DataSources = []string{starter.ToPascalCase()}
+	providerData.SetGenerated(generated("provider", name))
+
+	resourceData := NewTemplateData(starter, path.Base(projectProviderDir), nil)
+	resourceData.ProviderName = string(name)
+	resourceData.ModulePath = modulePath
+	resourceData.SetGenerated(generated("resource", starter))
+
+	dataSourceData := NewTemplateData(starter, path.Base(projectProviderDir), nil)
+	dataSourceData.ProviderName = string(name)
+	dataSourceData.ModulePath = modulePath
+	dataSourceData.SetGenerated(generated("datasource", starter))
+
+	code := []struct {
+		description string
+		filename    string
+		bytes       func() ([]byte, error)
+	}{
+		{
+			description: "provider",
+			filename:    "provider.go",
+			bytes:       func() ([]byte, error) { return ProviderBytes(providerData, "") },
+		},
+		{
+			description: "resource",
+			filename:    projectStarter + "_resource.go",
+			bytes:       func() ([]byte, error) { return ResourceBytes(resourceData, "") },
+		},
+		{
+			description: "data source",
+			filename:    projectStarter + "_data_source.go",
+			bytes:       func() ([]byte, error) { return DataSourceBytes(dataSourceData, "") },
+		},
+	}
+
+	for _, c := range code {
+		b, err := c.bytes()
+		if err != nil {
+			return fmt.Errorf("error creating scaffolding %s Go code: %w", c.description, err)
+		}
+
+		b, err = format.Source(b)
+		if err != nil {
+			return fmt.Errorf("error formatting scaffolding %s Go code: %w", c.description, err)
+		}
+
+		files[path.Join(projectProviderDir, c.filename)] = b
+	}
+
+	return nil
+}
+
+// defaultAddress returns the registry address of the provider, with the second
+// element of the module path as the namespace (e.g., ourco in
+// github.com/ourco/terraform-provider-ourco), or the provider name if the
+// module path has fewer elements.
+func defaultAddress(name, modulePath string) string {
+	namespace := name
+
+	if elements := strings.Split(modulePath, "/"); len(elements) > 2 {
+		namespace = elements[1]
+	}
+
+	return fmt.Sprintf("registry.terraform.io/%s/%s", namespace, name)
+}
@@ -0,0 +1,51 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package scaffold_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-codegen-framework/internal/scaffold"
+)
+
+func TestProject_Address(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		modulePath string
+		address    string
+		expected   string
+	}{
+		"module_path_namespace": {
+			modulePath: "github.com/ourco/terraform-provider-ourco",
+			expected:   `Address: "registry.terraform.io/ourco/ourco"`,
+		},
+		"module_path_without_namespace": {
+			modulePath: "terraform-provider-ourco",
+			expected:   `Address: "registry.terraform.io/ourco/ourco"`,
+		},
+		"address": {
+			modulePath: "github.com/ourco/terraform-provider-ourco",
+			address:    "registry.terraform.io/example/ourco",
+			expected:   `Address: "registry.terraform.io/example/ourco"`,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			files, err := scaffold.Project(context.Background(), "ourco", testCase.modulePath, testCase.address)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if !strings.Contains(string(files["main.go"]), testCase.expected) {
+				t.Errorf("expected main.go to contain %s, got:\n%s", testCase.expected, files["main.go"])
+			}
+		})
+	}
+}
@@ -3,36 +3,17 @@
 
 package scaffold
 
-import (
-	"bytes"
-	"text/template"
-
-	"github.com/hashicorp/terraform-plugin-codegen-framework/internal/schema"
-)
-
 // ProviderBytes will create scaffolding Go code bytes for a Terraform Plugin Framework provider
-func ProviderBytes(providerIdentifier schema.FrameworkIdentifier, packageName string) ([]byte, error) {
-	t, err := template.New("provider_scaffold").Parse(providerScaffoldGoTemplate)
-	if err != nil {
-		return nil, err
-	}
-
-	var buf bytes.Buffer
-
-	templateData := struct {
-		PackageName string
-		NameSnake   string
-		NameCamel   string
-	}{
-		PackageName: packageName,
-		NameSnake:   string(providerIdentifier),
-		NameCamel:   providerIdentifier.ToCamelCase(),
-	}
-
-	err = t.Execute(&buf, templateData)
-	if err != nil {
-		return nil, err
+// using the template text, or the embedded template if empty. The embedded template uses the
+// schema function and data model of the generated package, if set in the data.
+func ProviderBytes(data TemplateData, text string) ([]byte, error) {
+	switch {
+	case text != "":
+	case data.GeneratedPackageName != "":
+		text = providerSpecScaffoldGoTemplate
+	default:
+		text = providerScaffoldGoTemplate
 	}
 
-	return buf.Bytes(), nil
+	return execute("provider_scaffold", text, data)
 }
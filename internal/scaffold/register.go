@@ -0,0 +1,200 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package scaffold
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// RegisterResource adds the resource constructor (e.g., NewExampleResource) to
+// the slice returned by the Resources method of the provider declared in the Go
+// package in dir, which must also declare the constructor. It returns the path
+// of the file declaring the Resources method, and whether the file was changed,
+// which is false if the constructor is already registered.
+func RegisterResource(dir, constructor string) (string, bool, error) {
+	return register(dir, "Resources", constructor)
+}
+
+// RegisterDataSource adds the data source constructor (e.g.,
+// NewExampleDataSource) to the slice returned by the DataSources method of the
+// provider declared in the Go package in dir, in the same way as
+// RegisterResource.
+func RegisterDataSource(dir, constructor string) (string, bool, error) {
+	return register(dir, "DataSources", constructor)
+}
+
+// RegisterEphemeralResource adds the ephemeral resource constructor (e.g.,
+// NewExampleEphemeralResource) to the slice returned by the EphemeralResources
+// method of the provider declared in the Go package in dir, in the same way as
+// RegisterResource.
+func RegisterEphemeralResource(dir, constructor string) (string, bool, error) {
+	return register(dir, "EphemeralResources", constructor)
+}
+
+// RegisterFunction adds the function constructor (e.g., NewExampleFunction) to
+// the slice returned by the Functions method of the provider declared in the Go
+// package in dir, in the same way as RegisterResource.
+func RegisterFunction(dir, constructor string) (string, bool, error) {
+	return register(dir, "Functions", constructor)
+}
+
+// register adds the constructor to the slice literal returned by the method. The
+// file is edited in place, rather than printed from the modified syntax tree, so
+// that comments and layout are preserved.
+func register(dir, method, constructor string) (string, bool, error) {
+	filenames, err := filepath.Glob(filepath.Join(dir, "*.go"))
+	if err != nil {
+		return "", false, err
+	}
+
+	fset := token.NewFileSet()
+
+	var (
+		constructorFound bool
+		methodFile       string
+		methodSrc        []byte
+		lit              *ast.CompositeLit
+	)
+
+	for _, filename := range filenames {
+		if strings.HasSuffix(filename, "_test.go") {
+			continue
+		}
+
+		src, err := os.ReadFile(filename)
+		if err != nil {
+			return "", false, err
+		}
+
+		f, err := parser.ParseFile(fset, filename, src, parser.ParseComments)
+		if err != nil {
+			return "", false, err
+		}
+
+		for _, decl := range f.Decls {
+			funcDecl, ok := decl.(*ast.FuncDecl)
+			if !ok || funcDecl.Body == nil {
+				continue
+			}
+
+			if funcDecl.Recv == nil {
+				constructorFound = constructorFound || funcDecl.Name.Name == constructor
+				continue
+			}
+
+			if funcDecl.Name.Name != method {
+				continue
+			}
+
+			if methodFile != "" {
+				return "", false, fmt.Errorf("method %s is declared in both %s and %s", method, methodFile, filename)
+			}
+
+			methodFile = filename
+			methodSrc = src
+
+			lit, err = returnedSliceLiteral(funcDecl)
+			if err != nil {
+				return "", false, fmt.Errorf("%s in %s: %w", method, filename, err)
+			}
+		}
+	}
+
+	if methodFile == "" {
+		return "", false, fmt.Errorf("method %s not found in %s", method, dir)
+	}
+
+	if !constructorFound {
+		return "", false, fmt.Errorf("function %s not found in %s", constructor, dir)
+	}
+
+	for _, elt := range lit.Elts {
+		if ident, ok := elt.(*ast.Ident); ok && ident.Name == constructor {
+			return methodFile, false, nil
+		}
+	}
+
+	file := fset.File(lit.Pos())
+
+	var edited []byte
+
+	switch {
+	case len(lit.Elts) == 0:
+		offset := file.Offset(lit.Rbrace)
+		edited = splice(methodSrc, offset, "\n"+constructor+",\n")
+	case bytes.Contains(methodSrc[file.Offset(lit.Elts[len(lit.Elts)-1].End()):file.Offset(lit.Rbrace)], []byte(",")):
+		offset := file.Offset(lit.Rbrace)
+		edited = splice(methodSrc, offset, constructor+",\n")
+	default:
+		offset := file.Offset(lit.Elts[len(lit.Elts)-1].End())
+		edited = splice(methodSrc, offset, ", "+constructor)
+	}
+
+	formatted, err := format.Source(edited)
+	if err != nil {
+		return "", false, fmt.Errorf("error formatting %s: %w", methodFile, err)
+	}
+
+	info, err := os.Stat(methodFile)
+	if err != nil {
+		return "", false, err
+	}
+
+	err = os.WriteFile(methodFile, formatted, info.Mode().Perm())
+	if err != nil {
+		return "", false, err
+	}
+
+	return methodFile, true, nil
+}
+
+// returnedSliceLiteral returns the slice literal returned by the method, which
+// must contain a single return statement.
+func returnedSliceLiteral(funcDecl *ast.FuncDecl) (*ast.CompositeLit, error) {
+	var returns []*ast.ReturnStmt
+
+	ast.Inspect(funcDecl.Body, func(n ast.Node) bool {
+		switch n := n.(type) {
+		case *ast.FuncLit:
+			return false
+		case *ast.ReturnStmt:
+			returns = append(returns, n)
+		}
+
+		return true
+	})
+
+	if len(returns) != 1 || len(returns[0].Results) != 1 {
+		return nil, errors.New("expected a single return statement")
+	}
+
+	lit, ok := returns[0].Results[0].(*ast.CompositeLit)
+	if !ok {
+		return nil, errors.New("expected a slice literal to be returned")
+	}
+
+	if _, ok := lit.Type.(*ast.ArrayType); !ok {
+		return nil, errors.New("expected a slice literal to be returned")
+	}
+
+	return lit, nil
+}
+
+// splice returns src with text inserted at the offset.
+func splice(src []byte, offset int, text string) []byte {
+	edited := make([]byte, 0, len(src)+len(text))
+	edited = append(edited, src[:offset]...)
+	edited = append(edited, text...)
+
+	return append(edited, src[offset:]...)
+}
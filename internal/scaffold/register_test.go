@@ -0,0 +1,186 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package scaffold_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/hashicorp/terraform-plugin-codegen-framework/internal/scaffold"
+)
+
+const registerConstructors = `package provider
+
+func NewExistingResource() resource.Resource { return nil }
+
+func NewExampleResource() resource.Resource { return nil }
+`
+
+func TestRegisterResource(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		provider        string
+		constructors    string
+		expected        string
+		expectedChanged bool
+		expectedError   bool
+	}{
+		"empty": {
+			provider: `package provider
+
+func (p *exampleProvider) Resources(ctx context.Context) []func() resource.Resource {
+	return []func() resource.Resource{}
+}
+`,
+			constructors: registerConstructors,
+			expected: `package provider
+
+func (p *exampleProvider) Resources(ctx context.Context) []func() resource.Resource {
+	return []func() resource.Resource{
+		NewExampleResource,
+	}
+}
+`,
+			expectedChanged: true,
+		},
+		"multi-line": {
+			provider: `package provider
+
+func (p *exampleProvider) Resources(ctx context.Context) []func() resource.Resource {
+	return []func() resource.Resource{
+		// Existing resource.
+		NewExistingResource,
+	}
+}
+`,
+			constructors: registerConstructors,
+			expected: `package provider
+
+func (p *exampleProvider) Resources(ctx context.Context) []func() resource.Resource {
+	return []func() resource.Resource{
+		// Existing resource.
+		NewExistingResource,
+		NewExampleResource,
+	}
+}
+`,
+			expectedChanged: true,
+		},
+		"single-line": {
+			provider: `package provider
+
+func (p *exampleProvider) Resources(ctx context.Context) []func() resource.Resource {
+	return []func() resource.Resource{NewExistingResource}
+}
+`,
+			constructors: registerConstructors,
+			expected: `package provider
+
+func (p *exampleProvider) Resources(ctx context.Context) []func() resource.Resource {
+	return []func() resource.Resource{NewExistingResource, NewExampleResource}
+}
+`,
+			expectedChanged: true,
+		},
+		"registered": {
+			provider: `package provider
+
+func (p *exampleProvider) Resources(ctx context.Context) []func() resource.Resource {
+	return []func() resource.Resource{NewExampleResource}
+}
+`,
+			constructors: registerConstructors,
+			expected: `package provider
+
+func (p *exampleProvider) Resources(ctx context.Context) []func() resource.Resource {
+	return []func() resource.Resource{NewExampleResource}
+}
+`,
+		},
+		"method-not-found": {
+			provider: `package provider
+
+func (p *exampleProvider) DataSources(ctx context.Context) []func() datasource.DataSource {
+	return []func() datasource.DataSource{}
+}
+`,
+			constructors:  registerConstructors,
+			expectedError: true,
+		},
+		"constructor-not-found": {
+			provider: `package provider
+
+func (p *exampleProvider) Resources(ctx context.Context) []func() resource.Resource {
+	return []func() resource.Resource{}
+}
+`,
+			constructors:  "package provider\n",
+			expectedError: true,
+		},
+		"not-slice-literal": {
+			provider: `package provider
+
+func (p *exampleProvider) Resources(ctx context.Context) []func() resource.Resource {
+	return resources
+}
+`,
+			constructors:  registerConstructors,
+			expectedError: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			dir := t.TempDir()
+			providerFile := filepath.Join(dir, "provider.go")
+
+			err := os.WriteFile(providerFile, []byte(testCase.provider), 0666)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			err = os.WriteFile(filepath.Join(dir, "resources.go"), []byte(testCase.constructors), 0666)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			gotFile, gotChanged, err := scaffold.RegisterResource(dir, "NewExampleResource")
+
+			if testCase.expectedError {
+				if err == nil {
+					t.Fatal("expected error")
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if diff := cmp.Diff(gotFile, providerFile); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(gotChanged, testCase.expectedChanged); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+
+			got, err := os.ReadFile(providerFile)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if diff := cmp.Diff(string(got), testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
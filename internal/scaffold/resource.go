@@ -3,38 +3,17 @@
 
 package scaffold
 
-import (
-	"bytes"
-	"text/template"
-
-	"github.com/hashicorp/terraform-plugin-codegen-framework/internal/schema"
-)
-
 // ResourceBytes will create scaffolding Go code bytes for a Terraform Plugin Framework resource
-func ResourceBytes(resourceIdentifier schema.FrameworkIdentifier, packageName string) ([]byte, error) {
-	t, err := template.New("resource_scaffold").Parse(resourceScaffoldGoTemplate)
-	if err != nil {
-		return nil, err
-	}
-
-	var buf bytes.Buffer
-
-	templateData := struct {
-		PackageName string
-		NameSnake   string
-		NameCamel   string
-		NamePascal  string
-	}{
-		PackageName: packageName,
-		NameSnake:   string(resourceIdentifier),
-		NameCamel:   resourceIdentifier.ToCamelCase(),
-		NamePascal:  resourceIdentifier.ToPascalCase(),
-	}
-
-	err = t.Execute(&buf, templateData)
-	if err != nil {
-		return nil, err
+// using the template text, or the embedded template if empty. The embedded template uses the
+// schema function and data model of the generated package, if set in the data.
+func ResourceBytes(data TemplateData, text string) ([]byte, error) {
+	switch {
+	case text != "":
+	case data.GeneratedPackageName != "":
+		text = resourceSpecScaffoldGoTemplate
+	default:
+		text = resourceScaffoldGoTemplate
 	}
 
-	return buf.Bytes(), nil
+	return execute("resource_scaffold", text, data)
 }
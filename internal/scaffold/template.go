@@ -0,0 +1,145 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package scaffold
+
+import (
+	"bytes"
+	"slices"
+	"sort"
+	"text/template"
+
+	"github.com/Masterminds/sprig/v3"
+
+	"github.com/hashicorp/terraform-plugin-codegen-framework/internal/schema"
+)
+
+// TemplateData is the data available to scaffold templates, including custom
+// templates supplied with the --template flag.
+type TemplateData struct {
+	// PackageName is the name of the Go package of the scaffolded code.
+	PackageName string
+
+	// NameSnake, NameCamel and NamePascal are the name of the data source,
+	// provider or resource in snake, camel and pascal case (e.g., my_thing,
+	// myThing and MyThing).
+	NameSnake  string
+	NameCamel  string
+	NamePascal string
+
+	// ProviderName is the name of the provider, which prefixes the type
+	// names of data sources and resources. It is empty if unknown.
+	ProviderName string
+
+	// ModulePath is the path of the Go module containing the scaffolded code,
+	// read from the closest go.mod file. It is empty if there is none.
+	ModulePath string
+
+	// GeneratedPackageName is the name of the Go package containing the code
+	// generated from the specification, if any. It is empty unless the
+	// scaffolded code uses the generated code.
+	GeneratedPackageName string
+
+	// GeneratedImportPath is the import path of the generated package. It is
+	// empty if the scaffolded code is in the generated package.
+	GeneratedImportPath string
+
+	// GeneratedImportAlias is the alias required to import the generated
+	// package, if its name differs from the last element of its import path.
+	GeneratedImportAlias string
+
+	// GeneratedQualifier prefixes references to identifiers in the generated
+	// package (e.g., resource_example.), and is empty if the scaffolded code
+	// is in the generated package.
+	GeneratedQualifier string
+
+	// Resources and DataSources are the names, in pascal case, of the
+	// resources and data sources registered by a scaffolded provider (e.g.,
+	// MyThing for NewMyThingResource).
+	Resources   []string
+	DataSources []string
+
+	// Parameters and Return are the parameters and return type of a
+	// scaffolded function.
+	Parameters []FunctionParameter
+	Return     FunctionType
+}
+
+// NewTemplateData returns the template data for scaffolding code with the
+// identifier into the package. The names are cased with the options, which
+// must match the options used to generate any code the scaffolded code uses
+// (e.g., ExampleIDModel rather than ExampleIdModel when initialisms are
+// enabled). Nil options use the default casing.
+func NewTemplateData(identifier schema.FrameworkIdentifier, packageName string, opts *schema.Options) TemplateData {
+	return TemplateData{
+		PackageName: packageName,
+		NameSnake:   string(identifier),
+		NameCamel:   opts.ToCamelCase(identifier),
+		NamePascal:  opts.ToPascalCase(identifier),
+	}
+}
+
+// SetGenerated sets the generated package used by the scaffolded code. An empty
+// import path indicates the scaffolded code is in the generated package.
+func (d *TemplateData) SetGenerated(p GeneratedPackage) {
+	d.GeneratedPackageName = p.Name
+	d.GeneratedImportPath = p.ImportPath
+	d.GeneratedImportAlias = ""
+	d.GeneratedQualifier = ""
+
+	if p.ImportPath != "" {
+		d.GeneratedImportAlias = p.Alias()
+		d.GeneratedQualifier = p.Name + "."
+	}
+}
+
+// FunctionImports returns the sorted import paths, other than the framework
+// types package, required by the Go types of the function parameters and
+// return type.
+func (d TemplateData) FunctionImports() []string {
+	var imports []string
+
+	for _, t := range d.functionTypes() {
+		if t.Import != "" && t.Import != typesImport && !slices.Contains(imports, t.Import) {
+			imports = append(imports, t.Import)
+		}
+	}
+
+	sort.Strings(imports)
+
+	return imports
+}
+
+// FunctionUsesTypes returns whether the framework types package is required by
+// the Go types of the function parameters and return type.
+func (d TemplateData) FunctionUsesTypes() bool {
+	return slices.ContainsFunc(d.functionTypes(), func(t FunctionType) bool { return t.Import == typesImport })
+}
+
+func (d TemplateData) functionTypes() []FunctionType {
+	types := []FunctionType{d.Return}
+
+	for _, p := range d.Parameters {
+		types = append(types, p.Type)
+	}
+
+	return types
+}
+
+// execute renders the template text with the data. Templates can use the
+// text/template functions provided by sprig (e.g., snakecase and upper).
+func execute(name, text string, data any) ([]byte, error) {
+	t, err := template.New(name).Funcs(sprig.TxtFuncMap()).Parse(text)
+	if err != nil {
+		return nil, err
+	}
+
+	var buf bytes.Buffer
+
+	err = t.Execute(&buf, data)
+	if err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
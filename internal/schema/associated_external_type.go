@@ -5,8 +5,6 @@ package schema
 
 import (
 	"strings"
-	"unicode"
-	"unicode/utf8"
 
 	"github.com/hashicorp/terraform-plugin-codegen-spec/code"
 	"github.com/hashicorp/terraform-plugin-codegen-spec/schema"
@@ -14,15 +12,18 @@ import (
 
 type AssocExtType struct {
 	*schema.AssociatedExternalType
+
+	options *Options
 }
 
-func NewAssocExtType(assocExtType *schema.AssociatedExternalType) *AssocExtType {
+func NewAssocExtType(assocExtType *schema.AssociatedExternalType, opts *Options) *AssocExtType {
 	if assocExtType == nil {
 		return nil
 	}
 
 	return &AssocExtType{
 		AssociatedExternalType: assocExtType,
+		options:                opts,
 	}
 }
 
@@ -84,32 +85,12 @@ func (a *AssocExtType) ToPascalCase() string {
 	var ucName string
 
 	for _, v := range inputSplit {
-		if len(v) < 1 {
-			continue
-		}
-
-		firstChar := v[0:1]
-		ucFirstChar := strings.ToUpper(firstChar)
-
-		if len(v) < 2 {
-			ucName += ucFirstChar
-			continue
-		}
-
-		ucName += ucFirstChar + v[1:]
+		ucName += a.options.casing().PascalCaseWord(v)
 	}
 
 	return ucName
 }
 
 func (a *AssocExtType) ToCamelCase() string {
-	pascal := a.ToPascalCase()
-
-	// Grab first rune and lower case it
-	firstLetter, size := utf8.DecodeRuneInString(pascal)
-	if firstLetter == utf8.RuneError && size <= 1 {
-		return pascal
-	}
-
-	return string(unicode.ToLower(firstLetter)) + pascal[size:]
+	return a.options.casing().LowerFirst(a.ToPascalCase())
 }
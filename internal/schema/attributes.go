@@ -54,14 +54,16 @@ func (g GeneratorAttributes) AttributeTypes() (map[string]string, error) {
 }
 
 // AttrTypes returns a mapping of attribute names to string representations of the
-// underlying attr.Type.
-func (g GeneratorAttributes) AttrTypes() (map[string]string, error) {
+// underlying attr.Type. The parent is the name of the custom type and value types
+// generated for the nested attribute or block containing the attributes, which are
+// named using the generator options.
+func (g GeneratorAttributes) AttrTypes(parent string, opts *Options) (map[string]string, error) {
 	attributeKeys := g.SortedKeys()
 
 	attrTypes := make(map[string]string, len(g))
 
 	for _, k := range attributeKeys {
-		name := FrameworkIdentifier(k)
+		name := FrameworkIdentifier(opts.NestedTypeName(parent, k))
 
 		if a, ok := g[k].(AttrType); ok {
 			attrType, err := a.AttrType(name)
@@ -77,13 +79,13 @@ func (g GeneratorAttributes) AttrTypes() (map[string]string, error) {
 
 		switch g[k].GeneratorSchemaType() {
 		case GeneratorListNestedAttribute:
-			attrTypes[k] = fmt.Sprintf("basetypes.ListType{\nElemType: %sValue{}.Type(ctx),\n}", name.ToPascalCase())
+			attrTypes[k] = fmt.Sprintf("basetypes.ListType{\nElemType: %sValue{}.Type(ctx),\n}", opts.TypeReference(string(name)))
 		case GeneratorMapNestedAttribute:
-			attrTypes[k] = fmt.Sprintf("basetypes.MapType{\nElemType: %sValue{}.Type(ctx),\n}", name.ToPascalCase())
+			attrTypes[k] = fmt.Sprintf("basetypes.MapType{\nElemType: %sValue{}.Type(ctx),\n}", opts.TypeReference(string(name)))
 		case GeneratorSetNestedAttribute:
-			attrTypes[k] = fmt.Sprintf("basetypes.SetType{\nElemType: %sValue{}.Type(ctx),\n}", name.ToPascalCase())
+			attrTypes[k] = fmt.Sprintf("basetypes.SetType{\nElemType: %sValue{}.Type(ctx),\n}", opts.TypeReference(string(name)))
 		case GeneratorSingleNestedAttribute:
-			attrTypes[k] = fmt.Sprintf("basetypes.ObjectType{\nAttrTypes: %sValue{}.AttributeTypes(ctx),\n}", name.ToPascalCase())
+			attrTypes[k] = fmt.Sprintf("basetypes.ObjectType{\nAttrTypes: %sValue{}.AttributeTypes(ctx),\n}", opts.TypeReference(string(name)))
 		}
 	}
 
@@ -91,15 +93,17 @@ func (g GeneratorAttributes) AttrTypes() (map[string]string, error) {
 }
 
 // AttrValues returns a mapping of attribute names to string representations of the
-// underlying attr.Value.
-func (g GeneratorAttributes) AttrValues() (map[string]string, error) {
+// underlying attr.Value. The parent is the name of the custom type and value types
+// generated for the nested attribute or block containing the attributes, which are
+// named using the generator options.
+func (g GeneratorAttributes) AttrValues(parent string, opts *Options) (map[string]string, error) {
 	attributeKeys := g.SortedKeys()
 
 	attrValues := make(map[string]string, len(g))
 
 	for _, k := range attributeKeys {
 		if a, ok := g[k].(AttrValue); ok {
-			attrValues[k] = a.AttrValue(FrameworkIdentifier(k))
+			attrValues[k] = a.AttrValue(FrameworkIdentifier(opts.NestedTypeName(parent, k)))
 			continue
 		}
 
@@ -33,22 +33,24 @@ func (g GeneratorBlocks) BlockTypes() (map[string]string, error) {
 }
 
 // AttrTypes returns a mapping of block names to string representations of the
-// underlying attr.Type.
-func (g GeneratorBlocks) AttrTypes() (map[string]string, error) {
+// underlying attr.Type. The parent is the name of the custom type and value types
+// generated for the nested block containing the blocks, which are named using the
+// generator options.
+func (g GeneratorBlocks) AttrTypes(parent string, opts *Options) (map[string]string, error) {
 	blockKeys := g.SortedKeys()
 
 	attrTypes := make(map[string]string, len(g))
 
 	for _, k := range blockKeys {
-		name := FrameworkIdentifier(k)
+		name := FrameworkIdentifier(opts.NestedTypeName(parent, k))
 
 		switch g[k].GeneratorSchemaType() {
 		case GeneratorListNestedBlock:
-			attrTypes[k] = fmt.Sprintf("basetypes.ListType{\nElemType: %sValue{}.Type(ctx),\n}", name.ToPascalCase())
+			attrTypes[k] = fmt.Sprintf("basetypes.ListType{\nElemType: %sValue{}.Type(ctx),\n}", opts.TypeReference(string(name)))
 		case GeneratorSetNestedBlock:
-			attrTypes[k] = fmt.Sprintf("basetypes.SetType{\nElemType: %sValue{}.Type(ctx),\n}", name.ToPascalCase())
+			attrTypes[k] = fmt.Sprintf("basetypes.SetType{\nElemType: %sValue{}.Type(ctx),\n}", opts.TypeReference(string(name)))
 		case GeneratorSingleNestedBlock:
-			attrTypes[k] = fmt.Sprintf("basetypes.ObjectType{\nAttrTypes: %sValue{}.AttributeTypes(ctx),\n}", name.ToPascalCase())
+			attrTypes[k] = fmt.Sprintf("basetypes.ObjectType{\nAttrTypes: %sValue{}.AttributeTypes(ctx),\n}", opts.TypeReference(string(name)))
 		}
 	}
 
@@ -0,0 +1,160 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package schema
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+	"text/template"
+
+	"github.com/hashicorp/terraform-plugin-codegen-spec/code"
+
+	"github.com/hashicorp/terraform-plugin-codegen-framework/internal/format"
+)
+
+// CRUD is supplied by the generator configuration to generate the Create, Read,
+// Update and Delete methods of a resource with an associated external type,
+// which call a generated client interface.
+type CRUD struct {
+	// Client is the name of the client interface. Defaults to the pascal case
+	// name of the resource with a Client suffix (e.g., ExampleClient).
+	Client string
+
+	// Input is the type passed to the client methods. Defaults to the
+	// associated external type of the resource.
+	Input *AssocExtType
+
+	// Output is the type returned by the Create, Read and Update client
+	// methods. Defaults to the associated external type of the resource.
+	Output *AssocExtType
+}
+
+// crudData holds the data used to render the CRUD template.
+type crudData struct {
+	// Name is the pascal case name of the resource.
+	Name string
+
+	PackageName string
+	Imports     string
+
+	// Client is the name of the client interface.
+	Client string
+
+	// Input and Output are the types passed to and returned by the client.
+	Input  *AssocExtType
+	Output *AssocExtType
+
+	// Conversions holds the code converting the data model to Input, and from
+	// Output, where these differ from the associated external type.
+	Conversions string
+}
+
+// CRUDMethods returns the code generated for the Create, Read, Update and Delete
+// methods of the named resource, together with the client interface they call,
+// as a Go file in the package. The conversions of the data model to the input
+// type and from the output type are included where these differ from the
+// associated external type. Nil is returned if CRUD is not set. An error is
+// returned if there is no associated external type, or if the data model cannot
+// be converted to and from it.
+func (g GeneratorSchema) CRUDMethods(name, packageName string) ([]byte, error) {
+	if g.CRUD == nil {
+		return nil, nil
+	}
+
+	if g.AssociatedExternalType == nil {
+		return nil, fmt.Errorf("resource %q: CRUD methods require an associated external type", name)
+	}
+
+	toFuncs, fromFuncs, err := g.ModelToFromFuncs()
+	if err != nil {
+		return nil, fmt.Errorf("resource %q: CRUD methods require conversion of the data model to and from %s: %w", name, g.AssociatedExternalType.Type(), err)
+	}
+
+	data := crudData{
+		Name:        g.Options.ToPascalCase(FrameworkIdentifier(name)),
+		PackageName: packageName,
+		Client:      g.CRUD.Client,
+		Input:       g.AssociatedExternalType,
+		Output:      g.AssociatedExternalType,
+	}
+
+	if data.Client == "" {
+		data.Client = data.Name + "Client"
+	}
+
+	imports := NewImports()
+
+	for _, path := range []string{ContextImport, FmtImport, AttrImport, BaseTypesImport, DiagImport, ResourceImport, TfsdkImport, TfTypesImport, TypesImport} {
+		imports.Add(code.Import{
+			Path: path,
+		})
+	}
+
+	if g.AssociatedExternalType.HasImport() {
+		imports.Add(*g.AssociatedExternalType.Import)
+	}
+
+	var conversions bytes.Buffer
+
+	if g.CRUD.Input != nil && g.CRUD.Input.Type() != g.AssociatedExternalType.Type() {
+		data.Input = g.CRUD.Input
+
+		b, err := NewToFromModel(name, data.Input, toFuncs, nil, g.Options).renderTo()
+		if err != nil {
+			return nil, err
+		}
+
+		conversions.WriteString("\n")
+		conversions.Write(b)
+	}
+
+	if g.CRUD.Output != nil && g.CRUD.Output.Type() != g.AssociatedExternalType.Type() {
+		data.Output = g.CRUD.Output
+
+		b, err := NewToFromModel(name, data.Output, nil, fromFuncs, g.Options).renderFrom()
+		if err != nil {
+			return nil, err
+		}
+
+		conversions.WriteString("\n")
+		conversions.Write(b)
+	}
+
+	for _, t := range []*AssocExtType{data.Input, data.Output} {
+		if t.HasImport() {
+			imports.Add(*t.Import)
+		}
+	}
+
+	data.Conversions = conversions.String()
+
+	var sb strings.Builder
+
+	for _, i := range imports.All() {
+		var alias string
+
+		if i.Alias != nil {
+			alias = *i.Alias + " "
+		}
+
+		sb.WriteString(fmt.Sprintf("%s%q\n", alias, i.Path))
+	}
+
+	data.Imports = sb.String()
+
+	t, err := template.New("crud").Parse(g.Options.template("crud"))
+	if err != nil {
+		return nil, err
+	}
+
+	var buf bytes.Buffer
+
+	err = t.Execute(&buf, data)
+	if err != nil {
+		return nil, err
+	}
+
+	return format.RemoveUnusedImports(buf.Bytes())
+}
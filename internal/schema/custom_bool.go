@@ -11,22 +11,24 @@ import (
 type CustomBoolType struct {
 	Name      FrameworkIdentifier
 	templates map[string]string
+	options   *Options
 }
 
-func NewCustomBoolType(name string) CustomBoolType {
+func NewCustomBoolType(name string, opts *Options) CustomBoolType {
 	t := map[string]string{
-		"equal":              BoolTypeEqualTemplate,
-		"string":             BoolTypeStringTemplate,
-		"type":               BoolTypeTypeTemplate,
-		"typable":            BoolTypeTypableTemplate,
-		"valueFromBool":      BoolTypeValueFromBoolTemplate,
-		"valueFromTerraform": BoolTypeValueFromTerraformTemplate,
-		"valueType":          BoolTypeValueTypeTemplate,
+		"equal":              opts.template("bool_type_equal"),
+		"string":             opts.template("bool_type_string"),
+		"type":               opts.template("bool_type_type"),
+		"typable":            opts.template("bool_type_typable"),
+		"valueFromBool":      opts.template("bool_type_value_from_bool"),
+		"valueFromTerraform": opts.template("bool_type_value_from_terraform"),
+		"valueType":          opts.template("bool_type_value_type"),
 	}
 
 	return CustomBoolType{
 		Name:      FrameworkIdentifier(name),
 		templates: t,
+		options:   opts,
 	}
 }
 
@@ -70,7 +72,7 @@ func (c CustomBoolType) renderEqual() ([]byte, error) {
 	err = t.Execute(&buf, struct {
 		Name string
 	}{
-		Name: c.Name.ToPascalCase(),
+		Name: c.options.ToPascalCase(c.Name),
 	})
 
 	if err != nil {
@@ -92,7 +94,7 @@ func (c CustomBoolType) renderString() ([]byte, error) {
 	err = t.Execute(&buf, struct {
 		Name string
 	}{
-		Name: c.Name.ToPascalCase(),
+		Name: c.options.ToPascalCase(c.Name),
 	})
 
 	if err != nil {
@@ -114,7 +116,7 @@ func (c CustomBoolType) renderType() ([]byte, error) {
 	err = t.Execute(&buf, struct {
 		Name string
 	}{
-		Name: c.Name.ToPascalCase(),
+		Name: c.options.ToPascalCase(c.Name),
 	})
 
 	if err != nil {
@@ -136,7 +138,7 @@ func (c CustomBoolType) renderTypable() ([]byte, error) {
 	err = t.Execute(&buf, struct {
 		Name string
 	}{
-		Name: c.Name.ToPascalCase(),
+		Name: c.options.ToPascalCase(c.Name),
 	})
 
 	if err != nil {
@@ -158,7 +160,7 @@ func (c CustomBoolType) renderValueFromBool() ([]byte, error) {
 	err = t.Execute(&buf, struct {
 		Name string
 	}{
-		Name: c.Name.ToPascalCase(),
+		Name: c.options.ToPascalCase(c.Name),
 	})
 
 	if err != nil {
@@ -180,7 +182,7 @@ func (c CustomBoolType) renderValueFromTerraform() ([]byte, error) {
 	err = t.Execute(&buf, struct {
 		Name string
 	}{
-		Name: c.Name.ToPascalCase(),
+		Name: c.options.ToPascalCase(c.Name),
 	})
 
 	if err != nil {
@@ -202,7 +204,7 @@ func (c CustomBoolType) renderValueType() ([]byte, error) {
 	err = t.Execute(&buf, struct {
 		Name string
 	}{
-		Name: c.Name.ToPascalCase(),
+		Name: c.options.ToPascalCase(c.Name),
 	})
 
 	if err != nil {
@@ -215,19 +217,21 @@ func (c CustomBoolType) renderValueType() ([]byte, error) {
 type CustomBoolValue struct {
 	Name      FrameworkIdentifier
 	templates map[string]string
+	options   *Options
 }
 
-func NewCustomBoolValue(name string) CustomBoolValue {
+func NewCustomBoolValue(name string, opts *Options) CustomBoolValue {
 	t := map[string]string{
-		"equal":    BoolValueEqualTemplate,
-		"type":     BoolValueTypeTemplate,
-		"valuable": BoolValueValuableTemplate,
-		"value":    BoolValueValueTemplate,
+		"equal":    opts.template("bool_value_equal"),
+		"type":     opts.template("bool_value_type"),
+		"valuable": opts.template("bool_value_valuable"),
+		"value":    opts.template("bool_value_value"),
 	}
 
 	return CustomBoolValue{
 		Name:      FrameworkIdentifier(name),
 		templates: t,
+		options:   opts,
 	}
 }
 
@@ -268,7 +272,7 @@ func (c CustomBoolValue) renderEqual() ([]byte, error) {
 	err = t.Execute(&buf, struct {
 		Name string
 	}{
-		Name: c.Name.ToPascalCase(),
+		Name: c.options.ToPascalCase(c.Name),
 	})
 
 	if err != nil {
@@ -290,7 +294,7 @@ func (c CustomBoolValue) renderType() ([]byte, error) {
 	err = t.Execute(&buf, struct {
 		Name string
 	}{
-		Name: c.Name.ToPascalCase(),
+		Name: c.options.ToPascalCase(c.Name),
 	})
 
 	if err != nil {
@@ -312,7 +316,7 @@ func (c CustomBoolValue) renderValuable() ([]byte, error) {
 	err = t.Execute(&buf, struct {
 		Name string
 	}{
-		Name: c.Name.ToPascalCase(),
+		Name: c.options.ToPascalCase(c.Name),
 	})
 
 	if err != nil {
@@ -334,7 +338,7 @@ func (c CustomBoolValue) renderValue() ([]byte, error) {
 	err = t.Execute(&buf, struct {
 		Name string
 	}{
-		Name: c.Name.ToPascalCase(),
+		Name: c.options.ToPascalCase(c.Name),
 	})
 
 	if err != nil {
@@ -11,22 +11,24 @@ import (
 type CustomFloat64Type struct {
 	Name      FrameworkIdentifier
 	templates map[string]string
+	options   *Options
 }
 
-func NewCustomFloat64Type(name string) CustomFloat64Type {
+func NewCustomFloat64Type(name string, opts *Options) CustomFloat64Type {
 	t := map[string]string{
-		"equal":              Float64TypeEqualTemplate,
-		"string":             Float64TypeStringTemplate,
-		"type":               Float64TypeTypeTemplate,
-		"typable":            Float64TypeTypableTemplate,
-		"valueFromFloat64":   Float64TypeValueFromFloat64Template,
-		"valueFromTerraform": Float64TypeValueFromTerraformTemplate,
-		"valueType":          Float64TypeValueTypeTemplate,
+		"equal":              opts.template("float64_type_equal"),
+		"string":             opts.template("float64_type_string"),
+		"type":               opts.template("float64_type_type"),
+		"typable":            opts.template("float64_type_typable"),
+		"valueFromFloat64":   opts.template("float64_type_value_from_float64"),
+		"valueFromTerraform": opts.template("float64_type_value_from_terraform"),
+		"valueType":          opts.template("float64_type_value_type"),
 	}
 
 	return CustomFloat64Type{
 		Name:      FrameworkIdentifier(name),
 		templates: t,
+		options:   opts,
 	}
 }
 
@@ -70,7 +72,7 @@ func (c CustomFloat64Type) renderEqual() ([]byte, error) {
 	err = t.Execute(&buf, struct {
 		Name string
 	}{
-		Name: c.Name.ToPascalCase(),
+		Name: c.options.ToPascalCase(c.Name),
 	})
 
 	if err != nil {
@@ -92,7 +94,7 @@ func (c CustomFloat64Type) renderString() ([]byte, error) {
 	err = t.Execute(&buf, struct {
 		Name string
 	}{
-		Name: c.Name.ToPascalCase(),
+		Name: c.options.ToPascalCase(c.Name),
 	})
 
 	if err != nil {
@@ -114,7 +116,7 @@ func (c CustomFloat64Type) renderType() ([]byte, error) {
 	err = t.Execute(&buf, struct {
 		Name string
 	}{
-		Name: c.Name.ToPascalCase(),
+		Name: c.options.ToPascalCase(c.Name),
 	})
 
 	if err != nil {
@@ -136,7 +138,7 @@ func (c CustomFloat64Type) renderTypable() ([]byte, error) {
 	err = t.Execute(&buf, struct {
 		Name string
 	}{
-		Name: c.Name.ToPascalCase(),
+		Name: c.options.ToPascalCase(c.Name),
 	})
 
 	if err != nil {
@@ -158,7 +160,7 @@ func (c CustomFloat64Type) renderValueFromFloat64() ([]byte, error) {
 	err = t.Execute(&buf, struct {
 		Name string
 	}{
-		Name: c.Name.ToPascalCase(),
+		Name: c.options.ToPascalCase(c.Name),
 	})
 
 	if err != nil {
@@ -180,7 +182,7 @@ func (c CustomFloat64Type) renderValueFromTerraform() ([]byte, error) {
 	err = t.Execute(&buf, struct {
 		Name string
 	}{
-		Name: c.Name.ToPascalCase(),
+		Name: c.options.ToPascalCase(c.Name),
 	})
 
 	if err != nil {
@@ -202,7 +204,7 @@ func (c CustomFloat64Type) renderValueType() ([]byte, error) {
 	err = t.Execute(&buf, struct {
 		Name string
 	}{
-		Name: c.Name.ToPascalCase(),
+		Name: c.options.ToPascalCase(c.Name),
 	})
 
 	if err != nil {
@@ -215,19 +217,21 @@ func (c CustomFloat64Type) renderValueType() ([]byte, error) {
 type CustomFloat64Value struct {
 	Name      FrameworkIdentifier
 	templates map[string]string
+	options   *Options
 }
 
-func NewCustomFloat64Value(name string) CustomFloat64Value {
+func NewCustomFloat64Value(name string, opts *Options) CustomFloat64Value {
 	t := map[string]string{
-		"equal":    Float64ValueEqualTemplate,
-		"type":     Float64ValueTypeTemplate,
-		"valuable": Float64ValueValuableTemplate,
-		"value":    Float64ValueValueTemplate,
+		"equal":    opts.template("float64_value_equal"),
+		"type":     opts.template("float64_value_type"),
+		"valuable": opts.template("float64_value_valuable"),
+		"value":    opts.template("float64_value_value"),
 	}
 
 	return CustomFloat64Value{
 		Name:      FrameworkIdentifier(name),
 		templates: t,
+		options:   opts,
 	}
 }
 
@@ -268,7 +272,7 @@ func (c CustomFloat64Value) renderEqual() ([]byte, error) {
 	err = t.Execute(&buf, struct {
 		Name string
 	}{
-		Name: c.Name.ToPascalCase(),
+		Name: c.options.ToPascalCase(c.Name),
 	})
 
 	if err != nil {
@@ -290,7 +294,7 @@ func (c CustomFloat64Value) renderType() ([]byte, error) {
 	err = t.Execute(&buf, struct {
 		Name string
 	}{
-		Name: c.Name.ToPascalCase(),
+		Name: c.options.ToPascalCase(c.Name),
 	})
 
 	if err != nil {
@@ -312,7 +316,7 @@ func (c CustomFloat64Value) renderValuable() ([]byte, error) {
 	err = t.Execute(&buf, struct {
 		Name string
 	}{
-		Name: c.Name.ToPascalCase(),
+		Name: c.options.ToPascalCase(c.Name),
 	})
 
 	if err != nil {
@@ -334,7 +338,7 @@ func (c CustomFloat64Value) renderValue() ([]byte, error) {
 	err = t.Execute(&buf, struct {
 		Name string
 	}{
-		Name: c.Name.ToPascalCase(),
+		Name: c.options.ToPascalCase(c.Name),
 	})
 
 	if err != nil {
@@ -11,22 +11,24 @@ import (
 type CustomInt64Type struct {
 	Name      FrameworkIdentifier
 	templates map[string]string
+	options   *Options
 }
 
-func NewCustomInt64Type(name string) CustomInt64Type {
+func NewCustomInt64Type(name string, opts *Options) CustomInt64Type {
 	t := map[string]string{
-		"equal":              Int64TypeEqualTemplate,
-		"string":             Int64TypeStringTemplate,
-		"type":               Int64TypeTypeTemplate,
-		"typable":            Int64TypeTypableTemplate,
-		"valueFromInt64":     Int64TypeValueFromInt64Template,
-		"valueFromTerraform": Int64TypeValueFromTerraformTemplate,
-		"valueType":          Int64TypeValueTypeTemplate,
+		"equal":              opts.template("int64_type_equal"),
+		"string":             opts.template("int64_type_string"),
+		"type":               opts.template("int64_type_type"),
+		"typable":            opts.template("int64_type_typable"),
+		"valueFromInt64":     opts.template("int64_type_value_from_int64"),
+		"valueFromTerraform": opts.template("int64_type_value_from_terraform"),
+		"valueType":          opts.template("int64_type_value_type"),
 	}
 
 	return CustomInt64Type{
 		Name:      FrameworkIdentifier(name),
 		templates: t,
+		options:   opts,
 	}
 }
 
@@ -70,7 +72,7 @@ func (c CustomInt64Type) renderEqual() ([]byte, error) {
 	err = t.Execute(&buf, struct {
 		Name string
 	}{
-		Name: c.Name.ToPascalCase(),
+		Name: c.options.ToPascalCase(c.Name),
 	})
 
 	if err != nil {
@@ -92,7 +94,7 @@ func (c CustomInt64Type) renderString() ([]byte, error) {
 	err = t.Execute(&buf, struct {
 		Name string
 	}{
-		Name: c.Name.ToPascalCase(),
+		Name: c.options.ToPascalCase(c.Name),
 	})
 
 	if err != nil {
@@ -114,7 +116,7 @@ func (c CustomInt64Type) renderType() ([]byte, error) {
 	err = t.Execute(&buf, struct {
 		Name string
 	}{
-		Name: c.Name.ToPascalCase(),
+		Name: c.options.ToPascalCase(c.Name),
 	})
 
 	if err != nil {
@@ -136,7 +138,7 @@ func (c CustomInt64Type) renderTypable() ([]byte, error) {
 	err = t.Execute(&buf, struct {
 		Name string
 	}{
-		Name: c.Name.ToPascalCase(),
+		Name: c.options.ToPascalCase(c.Name),
 	})
 
 	if err != nil {
@@ -158,7 +160,7 @@ func (c CustomInt64Type) renderValueFromInt64() ([]byte, error) {
 	err = t.Execute(&buf, struct {
 		Name string
 	}{
-		Name: c.Name.ToPascalCase(),
+		Name: c.options.ToPascalCase(c.Name),
 	})
 
 	if err != nil {
@@ -180,7 +182,7 @@ func (c CustomInt64Type) renderValueFromTerraform() ([]byte, error) {
 	err = t.Execute(&buf, struct {
 		Name string
 	}{
-		Name: c.Name.ToPascalCase(),
+		Name: c.options.ToPascalCase(c.Name),
 	})
 
 	if err != nil {
@@ -202,7 +204,7 @@ func (c CustomInt64Type) renderValueType() ([]byte, error) {
 	err = t.Execute(&buf, struct {
 		Name string
 	}{
-		Name: c.Name.ToPascalCase(),
+		Name: c.options.ToPascalCase(c.Name),
 	})
 
 	if err != nil {
@@ -215,19 +217,21 @@ func (c CustomInt64Type) renderValueType() ([]byte, error) {
 type CustomInt64Value struct {
 	Name      FrameworkIdentifier
 	templates map[string]string
+	options   *Options
 }
 
-func NewCustomInt64Value(name string) CustomInt64Value {
+func NewCustomInt64Value(name string, opts *Options) CustomInt64Value {
 	t := map[string]string{
-		"equal":    Int64ValueEqualTemplate,
-		"type":     Int64ValueTypeTemplate,
-		"valuable": Int64ValueValuableTemplate,
-		"value":    Int64ValueValueTemplate,
+		"equal":    opts.template("int64_value_equal"),
+		"type":     opts.template("int64_value_type"),
+		"valuable": opts.template("int64_value_valuable"),
+		"value":    opts.template("int64_value_value"),
 	}
 
 	return CustomInt64Value{
 		Name:      FrameworkIdentifier(name),
 		templates: t,
+		options:   opts,
 	}
 }
 
@@ -268,7 +272,7 @@ func (c CustomInt64Value) renderEqual() ([]byte, error) {
 	err = t.Execute(&buf, struct {
 		Name string
 	}{
-		Name: c.Name.ToPascalCase(),
+		Name: c.options.ToPascalCase(c.Name),
 	})
 
 	if err != nil {
@@ -290,7 +294,7 @@ func (c CustomInt64Value) renderType() ([]byte, error) {
 	err = t.Execute(&buf, struct {
 		Name string
 	}{
-		Name: c.Name.ToPascalCase(),
+		Name: c.options.ToPascalCase(c.Name),
 	})
 
 	if err != nil {
@@ -312,7 +316,7 @@ func (c CustomInt64Value) renderValuable() ([]byte, error) {
 	err = t.Execute(&buf, struct {
 		Name string
 	}{
-		Name: c.Name.ToPascalCase(),
+		Name: c.options.ToPascalCase(c.Name),
 	})
 
 	if err != nil {
@@ -334,7 +338,7 @@ func (c CustomInt64Value) renderValue() ([]byte, error) {
 	err = t.Execute(&buf, struct {
 		Name string
 	}{
-		Name: c.Name.ToPascalCase(),
+		Name: c.options.ToPascalCase(c.Name),
 	})
 
 	if err != nil {
@@ -36,7 +36,7 @@ return t.Int64Type.Equal(other.Int64Type)
 		t.Run(name, func(t *testing.T) {
 			t.Parallel()
 
-			customInt64Type := NewCustomInt64Type(testCase.name)
+			customInt64Type := NewCustomInt64Type(testCase.name, nil)
 
 			got, err := customInt64Type.renderEqual()
 
@@ -73,7 +73,7 @@ return "ExampleType"
 		t.Run(name, func(t *testing.T) {
 			t.Parallel()
 
-			customInt64Type := NewCustomInt64Type(testCase.name)
+			customInt64Type := NewCustomInt64Type(testCase.name, nil)
 
 			got, err := customInt64Type.renderString()
 
@@ -107,7 +107,7 @@ func TestCustomInt64Type_renderTypable(t *testing.T) {
 		t.Run(name, func(t *testing.T) {
 			t.Parallel()
 
-			customInt64Type := NewCustomInt64Type(testCase.name)
+			customInt64Type := NewCustomInt64Type(testCase.name, nil)
 
 			got, err := customInt64Type.renderTypable()
 
@@ -143,7 +143,7 @@ basetypes.Int64Type
 		t.Run(name, func(t *testing.T) {
 			t.Parallel()
 
-			customInt64Type := NewCustomInt64Type(testCase.name)
+			customInt64Type := NewCustomInt64Type(testCase.name, nil)
 
 			got, err := customInt64Type.renderType()
 
@@ -186,7 +186,7 @@ Int64Value: in,
 		t.Run(name, func(t *testing.T) {
 			t.Parallel()
 
-			customInt64Type := NewCustomInt64Type(testCase.name)
+			customInt64Type := NewCustomInt64Type(testCase.name, nil)
 
 			got, err := customInt64Type.renderValueFromInt64()
 
@@ -241,7 +241,7 @@ return boolValuable, nil
 		t.Run(name, func(t *testing.T) {
 			t.Parallel()
 
-			customInt64Type := NewCustomInt64Type(testCase.name)
+			customInt64Type := NewCustomInt64Type(testCase.name, nil)
 
 			got, err := customInt64Type.renderValueFromTerraform()
 
@@ -278,7 +278,7 @@ return ExampleValue{}
 		t.Run(name, func(t *testing.T) {
 			t.Parallel()
 
-			customInt64Type := NewCustomInt64Type(testCase.name)
+			customInt64Type := NewCustomInt64Type(testCase.name, nil)
 
 			got, err := customInt64Type.renderValueType()
 
@@ -325,7 +325,7 @@ return v.Int64Value.Equal(other.Int64Value)
 		t.Run(name, func(t *testing.T) {
 			t.Parallel()
 
-			customInt64Value := NewCustomInt64Value(testCase.name)
+			customInt64Value := NewCustomInt64Value(testCase.name, nil)
 
 			got, err := customInt64Value.renderEqual()
 
@@ -363,7 +363,7 @@ return ExampleType{
 		t.Run(name, func(t *testing.T) {
 			t.Parallel()
 
-			customInt64Value := NewCustomInt64Value(testCase.name)
+			customInt64Value := NewCustomInt64Value(testCase.name, nil)
 
 			got, err := customInt64Value.renderType()
 
@@ -397,7 +397,7 @@ func TestCustomInt64Value_renderValuable(t *testing.T) {
 		t.Run(name, func(t *testing.T) {
 			t.Parallel()
 
-			customInt64Value := NewCustomInt64Value(testCase.name)
+			customInt64Value := NewCustomInt64Value(testCase.name, nil)
 
 			got, err := customInt64Value.renderValuable()
 
@@ -433,7 +433,7 @@ basetypes.Int64Value
 		t.Run(name, func(t *testing.T) {
 			t.Parallel()
 
-			customInt64Value := NewCustomInt64Value(testCase.name)
+			customInt64Value := NewCustomInt64Value(testCase.name, nil)
 
 			got, err := customInt64Value.renderValue()
 
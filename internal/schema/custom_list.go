@@ -11,22 +11,24 @@ import (
 type CustomListType struct {
 	Name      FrameworkIdentifier
 	templates map[string]string
+	options   *Options
 }
 
-func NewCustomListType(name string) CustomListType {
+func NewCustomListType(name string, opts *Options) CustomListType {
 	t := map[string]string{
-		"equal":              ListTypeEqualTemplate,
-		"string":             ListTypeStringTemplate,
-		"type":               ListTypeTypeTemplate,
-		"typable":            ListTypeTypableTemplate,
-		"valueFromList":      ListTypeValueFromListTemplate,
-		"valueFromTerraform": ListTypeValueFromTerraformTemplate,
-		"valueType":          ListTypeValueTypeTemplate,
+		"equal":              opts.template("list_type_equal"),
+		"string":             opts.template("list_type_string"),
+		"type":               opts.template("list_type_type"),
+		"typable":            opts.template("list_type_typable"),
+		"valueFromList":      opts.template("list_type_value_from_list"),
+		"valueFromTerraform": opts.template("list_type_value_from_terraform"),
+		"valueType":          opts.template("list_type_value_type"),
 	}
 
 	return CustomListType{
 		Name:      FrameworkIdentifier(name),
 		templates: t,
+		options:   opts,
 	}
 }
 
@@ -70,7 +72,7 @@ func (c CustomListType) renderEqual() ([]byte, error) {
 	err = t.Execute(&buf, struct {
 		Name string
 	}{
-		Name: c.Name.ToPascalCase(),
+		Name: c.options.ToPascalCase(c.Name),
 	})
 
 	if err != nil {
@@ -92,7 +94,7 @@ func (c CustomListType) renderString() ([]byte, error) {
 	err = t.Execute(&buf, struct {
 		Name string
 	}{
-		Name: c.Name.ToPascalCase(),
+		Name: c.options.ToPascalCase(c.Name),
 	})
 
 	if err != nil {
@@ -114,7 +116,7 @@ func (c CustomListType) renderType() ([]byte, error) {
 	err = t.Execute(&buf, struct {
 		Name string
 	}{
-		Name: c.Name.ToPascalCase(),
+		Name: c.options.ToPascalCase(c.Name),
 	})
 
 	if err != nil {
@@ -136,7 +138,7 @@ func (c CustomListType) renderTypable() ([]byte, error) {
 	err = t.Execute(&buf, struct {
 		Name string
 	}{
-		Name: c.Name.ToPascalCase(),
+		Name: c.options.ToPascalCase(c.Name),
 	})
 
 	if err != nil {
@@ -158,7 +160,7 @@ func (c CustomListType) renderValueFromList() ([]byte, error) {
 	err = t.Execute(&buf, struct {
 		Name string
 	}{
-		Name: c.Name.ToPascalCase(),
+		Name: c.options.ToPascalCase(c.Name),
 	})
 
 	if err != nil {
@@ -180,7 +182,7 @@ func (c CustomListType) renderValueFromTerraform() ([]byte, error) {
 	err = t.Execute(&buf, struct {
 		Name string
 	}{
-		Name: c.Name.ToPascalCase(),
+		Name: c.options.ToPascalCase(c.Name),
 	})
 
 	if err != nil {
@@ -202,7 +204,7 @@ func (c CustomListType) renderValueType() ([]byte, error) {
 	err = t.Execute(&buf, struct {
 		Name string
 	}{
-		Name: c.Name.ToPascalCase(),
+		Name: c.options.ToPascalCase(c.Name),
 	})
 
 	if err != nil {
@@ -216,20 +218,22 @@ type CustomListValue struct {
 	Name        FrameworkIdentifier
 	ElementType string
 	templates   map[string]string
+	options     *Options
 }
 
-func NewCustomListValue(name, elemType string) CustomListValue {
+func NewCustomListValue(name, elemType string, opts *Options) CustomListValue {
 	t := map[string]string{
-		"equal":    ListValueEqualTemplate,
-		"type":     ListValueTypeTemplate,
-		"valuable": ListValueValuableTemplate,
-		"value":    ListValueValueTemplate,
+		"equal":    opts.template("list_value_equal"),
+		"type":     opts.template("list_value_type"),
+		"valuable": opts.template("list_value_valuable"),
+		"value":    opts.template("list_value_value"),
 	}
 
 	return CustomListValue{
 		Name:        FrameworkIdentifier(name),
 		ElementType: elemType,
 		templates:   t,
+		options:     opts,
 	}
 }
 
@@ -270,7 +274,7 @@ func (c CustomListValue) renderEqual() ([]byte, error) {
 	err = t.Execute(&buf, struct {
 		Name string
 	}{
-		Name: c.Name.ToPascalCase(),
+		Name: c.options.ToPascalCase(c.Name),
 	})
 
 	if err != nil {
@@ -293,7 +297,7 @@ func (c CustomListValue) renderType() ([]byte, error) {
 		Name        string
 		ElementType string
 	}{
-		Name:        c.Name.ToPascalCase(),
+		Name:        c.options.ToPascalCase(c.Name),
 		ElementType: c.ElementType,
 	})
 
@@ -316,7 +320,7 @@ func (c CustomListValue) renderValuable() ([]byte, error) {
 	err = t.Execute(&buf, struct {
 		Name string
 	}{
-		Name: c.Name.ToPascalCase(),
+		Name: c.options.ToPascalCase(c.Name),
 	})
 
 	if err != nil {
@@ -338,7 +342,7 @@ func (c CustomListValue) renderValue() ([]byte, error) {
 	err = t.Execute(&buf, struct {
 		Name string
 	}{
-		Name: c.Name.ToPascalCase(),
+		Name: c.options.ToPascalCase(c.Name),
 	})
 
 	if err != nil {
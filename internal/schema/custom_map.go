@@ -11,22 +11,24 @@ import (
 type CustomMapType struct {
 	Name      FrameworkIdentifier
 	templates map[string]string
+	options   *Options
 }
 
-func NewCustomMapType(name string) CustomMapType {
+func NewCustomMapType(name string, opts *Options) CustomMapType {
 	t := map[string]string{
-		"equal":              MapTypeEqualTemplate,
-		"string":             MapTypeStringTemplate,
-		"type":               MapTypeTypeTemplate,
-		"typable":            MapTypeTypableTemplate,
-		"valueFromMap":       MapTypeValueFromMapTemplate,
-		"valueFromTerraform": MapTypeValueFromTerraformTemplate,
-		"valueType":          MapTypeValueTypeTemplate,
+		"equal":              opts.template("map_type_equal"),
+		"string":             opts.template("map_type_string"),
+		"type":               opts.template("map_type_type"),
+		"typable":            opts.template("map_type_typable"),
+		"valueFromMap":       opts.template("map_type_value_from_map"),
+		"valueFromTerraform": opts.template("map_type_value_from_terraform"),
+		"valueType":          opts.template("map_type_value_type"),
 	}
 
 	return CustomMapType{
 		Name:      FrameworkIdentifier(name),
 		templates: t,
+		options:   opts,
 	}
 }
 
@@ -70,7 +72,7 @@ func (c CustomMapType) renderEqual() ([]byte, error) {
 	err = t.Execute(&buf, struct {
 		Name string
 	}{
-		Name: c.Name.ToPascalCase(),
+		Name: c.options.ToPascalCase(c.Name),
 	})
 
 	if err != nil {
@@ -92,7 +94,7 @@ func (c CustomMapType) renderString() ([]byte, error) {
 	err = t.Execute(&buf, struct {
 		Name string
 	}{
-		Name: c.Name.ToPascalCase(),
+		Name: c.options.ToPascalCase(c.Name),
 	})
 
 	if err != nil {
@@ -114,7 +116,7 @@ func (c CustomMapType) renderType() ([]byte, error) {
 	err = t.Execute(&buf, struct {
 		Name string
 	}{
-		Name: c.Name.ToPascalCase(),
+		Name: c.options.ToPascalCase(c.Name),
 	})
 
 	if err != nil {
@@ -136,7 +138,7 @@ func (c CustomMapType) renderTypable() ([]byte, error) {
 	err = t.Execute(&buf, struct {
 		Name string
 	}{
-		Name: c.Name.ToPascalCase(),
+		Name: c.options.ToPascalCase(c.Name),
 	})
 
 	if err != nil {
@@ -158,7 +160,7 @@ func (c CustomMapType) renderValueFromMap() ([]byte, error) {
 	err = t.Execute(&buf, struct {
 		Name string
 	}{
-		Name: c.Name.ToPascalCase(),
+		Name: c.options.ToPascalCase(c.Name),
 	})
 
 	if err != nil {
@@ -180,7 +182,7 @@ func (c CustomMapType) renderValueFromTerraform() ([]byte, error) {
 	err = t.Execute(&buf, struct {
 		Name string
 	}{
-		Name: c.Name.ToPascalCase(),
+		Name: c.options.ToPascalCase(c.Name),
 	})
 
 	if err != nil {
@@ -202,7 +204,7 @@ func (c CustomMapType) renderValueType() ([]byte, error) {
 	err = t.Execute(&buf, struct {
 		Name string
 	}{
-		Name: c.Name.ToPascalCase(),
+		Name: c.options.ToPascalCase(c.Name),
 	})
 
 	if err != nil {
@@ -216,20 +218,22 @@ type CustomMapValue struct {
 	Name        FrameworkIdentifier
 	ElementType string
 	templates   map[string]string
+	options     *Options
 }
 
-func NewCustomMapValue(name, elemType string) CustomMapValue {
+func NewCustomMapValue(name, elemType string, opts *Options) CustomMapValue {
 	t := map[string]string{
-		"equal":    MapValueEqualTemplate,
-		"type":     MapValueTypeTemplate,
-		"valuable": MapValueValuableTemplate,
-		"value":    MapValueValueTemplate,
+		"equal":    opts.template("map_value_equal"),
+		"type":     opts.template("map_value_type"),
+		"valuable": opts.template("map_value_valuable"),
+		"value":    opts.template("map_value_value"),
 	}
 
 	return CustomMapValue{
 		Name:        FrameworkIdentifier(name),
 		ElementType: elemType,
 		templates:   t,
+		options:     opts,
 	}
 }
 
@@ -270,7 +274,7 @@ func (c CustomMapValue) renderEqual() ([]byte, error) {
 	err = t.Execute(&buf, struct {
 		Name string
 	}{
-		Name: c.Name.ToPascalCase(),
+		Name: c.options.ToPascalCase(c.Name),
 	})
 
 	if err != nil {
@@ -293,7 +297,7 @@ func (c CustomMapValue) renderType() ([]byte, error) {
 		Name        string
 		ElementType string
 	}{
-		Name:        c.Name.ToPascalCase(),
+		Name:        c.options.ToPascalCase(c.Name),
 		ElementType: c.ElementType,
 	})
 
@@ -316,7 +320,7 @@ func (c CustomMapValue) renderValuable() ([]byte, error) {
 	err = t.Execute(&buf, struct {
 		Name string
 	}{
-		Name: c.Name.ToPascalCase(),
+		Name: c.options.ToPascalCase(c.Name),
 	})
 
 	if err != nil {
@@ -338,7 +342,7 @@ func (c CustomMapValue) renderValue() ([]byte, error) {
 	err = t.Execute(&buf, struct {
 		Name string
 	}{
-		Name: c.Name.ToPascalCase(),
+		Name: c.options.ToPascalCase(c.Name),
 	})
 
 	if err != nil {
@@ -12,21 +12,22 @@ type CustomNestedObjectType struct {
 	Name       FrameworkIdentifier
 	AttrValues map[FrameworkIdentifier]string
 	templates  map[string]string
+	options    *Options
 }
 
-func NewCustomNestedObjectType(name string, attrValues map[string]string) CustomNestedObjectType {
+func NewCustomNestedObjectType(name string, attrValues map[string]string, opts *Options) CustomNestedObjectType {
 	t := map[string]string{
-		"equal":              NestedObjectTypeEqualTemplate,
-		"string":             NestedObjectTypeStringTemplate,
-		"typable":            NestedObjectTypeTypableTemplate,
-		"type":               NestedObjectTypeTypeTemplate,
-		"value":              NestedObjectTypeValueTemplate,
-		"valueFromObject":    NestedObjectTypeValueFromObjectTemplate,
-		"valueFromTerraform": NestedObjectTypeValueFromTerraformTemplate,
-		"valueMust":          NestedObjectTypeValueMustTemplate,
-		"valueNull":          NestedObjectTypeValueNullTemplate,
-		"valueType":          NestedObjectTypeValueTypeTemplate,
-		"valueUnknown":       NestedObjectTypeValueUnknownTemplate,
+		"equal":              opts.template("nested_object_type_equal"),
+		"string":             opts.template("nested_object_type_string"),
+		"typable":            opts.template("nested_object_type_typable"),
+		"type":               opts.template("nested_object_type_type"),
+		"value":              opts.template("nested_object_type_value"),
+		"valueFromObject":    opts.template("nested_object_type_value_from_object"),
+		"valueFromTerraform": opts.template("nested_object_type_value_from_terraform"),
+		"valueMust":          opts.template("nested_object_type_value_must"),
+		"valueNull":          opts.template("nested_object_type_value_null"),
+		"valueType":          opts.template("nested_object_type_value_type"),
+		"valueUnknown":       opts.template("nested_object_type_value_unknown"),
 	}
 
 	a := make(map[FrameworkIdentifier]string, len(attrValues))
@@ -39,6 +40,7 @@ func NewCustomNestedObjectType(name string, attrValues map[string]string) Custom
 		Name:       FrameworkIdentifier(name),
 		AttrValues: a,
 		templates:  t,
+		options:    opts,
 	}
 }
 
@@ -86,7 +88,7 @@ func (c CustomNestedObjectType) renderEqual() ([]byte, error) {
 	err = t.Execute(&buf, struct {
 		Name string
 	}{
-		Name: c.Name.ToPascalCase(),
+		Name: c.options.ToPascalCase(c.Name),
 	})
 
 	if err != nil {
@@ -108,7 +110,7 @@ func (c CustomNestedObjectType) renderString() ([]byte, error) {
 	err = t.Execute(&buf, struct {
 		Name string
 	}{
-		Name: c.Name.ToPascalCase(),
+		Name: c.options.ToPascalCase(c.Name),
 	})
 
 	if err != nil {
@@ -130,7 +132,7 @@ func (c CustomNestedObjectType) renderTypable() ([]byte, error) {
 	err = t.Execute(&buf, struct {
 		Name string
 	}{
-		Name: c.Name.ToPascalCase(),
+		Name: c.options.ToPascalCase(c.Name),
 	})
 
 	if err != nil {
@@ -152,7 +154,7 @@ func (c CustomNestedObjectType) renderType() ([]byte, error) {
 	err = t.Execute(&buf, struct {
 		Name string
 	}{
-		Name: c.Name.ToPascalCase(),
+		Name: c.options.ToPascalCase(c.Name),
 	})
 
 	if err != nil {
@@ -173,10 +175,10 @@ func (c CustomNestedObjectType) renderValue() ([]byte, error) {
 
 	err = t.Execute(&buf, struct {
 		Name       string
-		AttrValues map[FrameworkIdentifier]string
+		AttrValues map[Identifier]string
 	}{
-		Name:       c.Name.ToPascalCase(),
-		AttrValues: c.AttrValues,
+		Name:       c.options.ToPascalCase(c.Name),
+		AttrValues: identifiers(c.options, c.AttrValues),
 	})
 
 	if err != nil {
@@ -197,10 +199,10 @@ func (c CustomNestedObjectType) renderValueFromObject() ([]byte, error) {
 
 	err = t.Execute(&buf, struct {
 		Name       string
-		AttrValues map[FrameworkIdentifier]string
+		AttrValues map[Identifier]string
 	}{
-		Name:       c.Name.ToPascalCase(),
-		AttrValues: c.AttrValues,
+		Name:       c.options.ToPascalCase(c.Name),
+		AttrValues: identifiers(c.options, c.AttrValues),
 	})
 
 	if err != nil {
@@ -222,7 +224,7 @@ func (c CustomNestedObjectType) renderValueFromTerraform() ([]byte, error) {
 	err = t.Execute(&buf, struct {
 		Name string
 	}{
-		Name: c.Name.ToPascalCase(),
+		Name: c.options.ToPascalCase(c.Name),
 	})
 
 	if err != nil {
@@ -244,7 +246,7 @@ func (c CustomNestedObjectType) renderValueMust() ([]byte, error) {
 	err = t.Execute(&buf, struct {
 		Name string
 	}{
-		Name: c.Name.ToPascalCase(),
+		Name: c.options.ToPascalCase(c.Name),
 	})
 
 	if err != nil {
@@ -266,7 +268,7 @@ func (c CustomNestedObjectType) renderValueNull() ([]byte, error) {
 	err = t.Execute(&buf, struct {
 		Name string
 	}{
-		Name: c.Name.ToPascalCase(),
+		Name: c.options.ToPascalCase(c.Name),
 	})
 
 	if err != nil {
@@ -288,7 +290,7 @@ func (c CustomNestedObjectType) renderValueType() ([]byte, error) {
 	err = t.Execute(&buf, struct {
 		Name string
 	}{
-		Name: c.Name.ToPascalCase(),
+		Name: c.options.ToPascalCase(c.Name),
 	})
 
 	if err != nil {
@@ -310,7 +312,7 @@ func (c CustomNestedObjectType) renderValueUnknown() ([]byte, error) {
 	err = t.Execute(&buf, struct {
 		Name string
 	}{
-		Name: c.Name.ToPascalCase(),
+		Name: c.options.ToPascalCase(c.Name),
 	})
 
 	if err != nil {
@@ -327,20 +329,21 @@ type CustomNestedObjectValue struct {
 	AttrValues      map[FrameworkIdentifier]string
 	CollectionTypes map[FrameworkIdentifier]map[string]string
 	templates       map[string]string
+	options         *Options
 }
 
-func NewCustomNestedObjectValue(name string, attributeTypes, attrTypes, attrValues map[string]string, collectionTypes map[string]map[string]string) CustomNestedObjectValue {
+func NewCustomNestedObjectValue(name string, attributeTypes, attrTypes, attrValues map[string]string, collectionTypes map[string]map[string]string, opts *Options) CustomNestedObjectValue {
 	t := map[string]string{
-		"attributeTypes":   NestedObjectValueAttributeTypesTemplate,
-		"equal":            NestedObjectValueEqualTemplate,
-		"isNull":           NestedObjectValueIsNullTemplate,
-		"isUnknown":        NestedObjectValueIsUnknownTemplate,
-		"string":           NestedObjectValueStringTemplate,
-		"toObjectValue":    NestedObjectValueToObjectValueTemplate,
-		"toTerraformValue": NestedObjectValueToTerraformValueTemplate,
-		"type":             NestedObjectValueTypeTemplate,
-		"valuable":         NestedObjectValueValuableTemplate,
-		"value":            NestedObjectValueValueTemplate,
+		"attributeTypes":   opts.template("nested_object_value_attribute_types"),
+		"equal":            opts.template("nested_object_value_equal"),
+		"isNull":           opts.template("nested_object_value_is_null"),
+		"isUnknown":        opts.template("nested_object_value_is_unknown"),
+		"string":           opts.template("nested_object_value_string"),
+		"toObjectValue":    opts.template("nested_object_value_to_object_value"),
+		"toTerraformValue": opts.template("nested_object_value_to_terraform_value"),
+		"type":             opts.template("nested_object_value_type"),
+		"valuable":         opts.template("nested_object_value_valuable"),
+		"value":            opts.template("nested_object_value_value"),
 	}
 
 	attribTypes := make(map[FrameworkIdentifier]string, len(attributeTypes))
@@ -374,6 +377,7 @@ func NewCustomNestedObjectValue(name string, attributeTypes, attrTypes, attrValu
 		AttrValues:      attrVals,
 		CollectionTypes: collectionTyps,
 		templates:       t,
+		options:         opts,
 	}
 }
 
@@ -419,10 +423,10 @@ func (c CustomNestedObjectValue) renderAttributeTypes() ([]byte, error) {
 
 	err = t.Execute(&buf, struct {
 		Name      string
-		AttrTypes map[FrameworkIdentifier]string
+		AttrTypes map[Identifier]string
 	}{
-		Name:      c.Name.ToPascalCase(),
-		AttrTypes: c.AttrTypes,
+		Name:      c.options.ToPascalCase(c.Name),
+		AttrTypes: identifiers(c.options, c.AttrTypes),
 	})
 
 	if err != nil {
@@ -443,10 +447,10 @@ func (c CustomNestedObjectValue) renderEqual() ([]byte, error) {
 
 	err = t.Execute(&buf, struct {
 		Name       string
-		AttrValues map[FrameworkIdentifier]string
+		AttrValues map[Identifier]string
 	}{
-		Name:       c.Name.ToPascalCase(),
-		AttrValues: c.AttrValues,
+		Name:       c.options.ToPascalCase(c.Name),
+		AttrValues: identifiers(c.options, c.AttrValues),
 	})
 
 	if err != nil {
@@ -468,7 +472,7 @@ func (c CustomNestedObjectValue) renderIsNull() ([]byte, error) {
 	err = t.Execute(&buf, struct {
 		Name string
 	}{
-		Name: c.Name.ToPascalCase(),
+		Name: c.options.ToPascalCase(c.Name),
 	})
 
 	if err != nil {
@@ -490,7 +494,7 @@ func (c CustomNestedObjectValue) renderIsUnknown() ([]byte, error) {
 	err = t.Execute(&buf, struct {
 		Name string
 	}{
-		Name: c.Name.ToPascalCase(),
+		Name: c.options.ToPascalCase(c.Name),
 	})
 
 	if err != nil {
@@ -512,7 +516,7 @@ func (c CustomNestedObjectValue) renderString() ([]byte, error) {
 	err = t.Execute(&buf, struct {
 		Name string
 	}{
-		Name: c.Name.ToPascalCase(),
+		Name: c.options.ToPascalCase(c.Name),
 	})
 
 	if err != nil {
@@ -533,14 +537,16 @@ func (c CustomNestedObjectValue) renderToObjectValue() ([]byte, error) {
 
 	err = t.Execute(&buf, struct {
 		Name            string
-		AttributeTypes  map[FrameworkIdentifier]string
-		AttrTypes       map[FrameworkIdentifier]string
-		CollectionTypes map[FrameworkIdentifier]map[string]string
+		AttributeTypes  map[Identifier]string
+		AttrTypes       map[Identifier]string
+		CollectionTypes map[Identifier]map[string]string
+		TypeNames       map[Identifier]string
 	}{
-		Name:            c.Name.ToPascalCase(),
-		AttributeTypes:  c.AttributeTypes,
-		AttrTypes:       c.AttrTypes,
-		CollectionTypes: c.CollectionTypes,
+		Name:            c.options.ToPascalCase(c.Name),
+		AttributeTypes:  identifiers(c.options, c.AttributeTypes),
+		AttrTypes:       identifiers(c.options, c.AttrTypes),
+		CollectionTypes: identifiers(c.options, c.CollectionTypes),
+		TypeNames:       nestedTypeNames(c.options, c.Name, c.AttributeTypes),
 	})
 
 	if err != nil {
@@ -561,10 +567,10 @@ func (c CustomNestedObjectValue) renderToTerraformValue() ([]byte, error) {
 
 	err = t.Execute(&buf, struct {
 		Name      string
-		AttrTypes map[FrameworkIdentifier]string
+		AttrTypes map[Identifier]string
 	}{
-		Name:      c.Name.ToPascalCase(),
-		AttrTypes: c.AttrTypes,
+		Name:      c.options.ToPascalCase(c.Name),
+		AttrTypes: identifiers(c.options, c.AttrTypes),
 	})
 
 	if err != nil {
@@ -586,7 +592,7 @@ func (c CustomNestedObjectValue) renderType() ([]byte, error) {
 	err = t.Execute(&buf, struct {
 		Name string
 	}{
-		Name: c.Name.ToPascalCase(),
+		Name: c.options.ToPascalCase(c.Name),
 	})
 
 	if err != nil {
@@ -608,7 +614,7 @@ func (c CustomNestedObjectValue) renderValuable() ([]byte, error) {
 	err = t.Execute(&buf, struct {
 		Name string
 	}{
-		Name: c.Name.ToPascalCase(),
+		Name: c.options.ToPascalCase(c.Name),
 	})
 
 	if err != nil {
@@ -629,10 +635,10 @@ func (c CustomNestedObjectValue) renderValue() ([]byte, error) {
 
 	err = t.Execute(&buf, struct {
 		Name       string
-		AttrValues map[FrameworkIdentifier]string
+		AttrValues map[Identifier]string
 	}{
-		Name:       c.Name.ToPascalCase(),
-		AttrValues: c.AttrValues,
+		Name:       c.options.ToPascalCase(c.Name),
+		AttrValues: identifiers(c.options, c.AttrValues),
 	})
 
 	if err != nil {
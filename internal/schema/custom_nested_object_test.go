@@ -36,7 +36,7 @@ return t.ObjectType.Equal(other.ObjectType)
 		t.Run(name, func(t *testing.T) {
 			t.Parallel()
 
-			customObjectType := NewCustomNestedObjectType(testCase.name, nil)
+			customObjectType := NewCustomNestedObjectType(testCase.name, nil, nil)
 
 			got, err := customObjectType.renderEqual()
 
@@ -73,7 +73,7 @@ return "ExampleType"
 		t.Run(name, func(t *testing.T) {
 			t.Parallel()
 
-			customObjectType := NewCustomNestedObjectType(testCase.name, nil)
+			customObjectType := NewCustomNestedObjectType(testCase.name, nil, nil)
 
 			got, err := customObjectType.renderString()
 
@@ -107,7 +107,7 @@ func TestCustomNestedObjectType_renderTypable(t *testing.T) {
 		t.Run(name, func(t *testing.T) {
 			t.Parallel()
 
-			customObjectType := NewCustomNestedObjectType(testCase.name, nil)
+			customObjectType := NewCustomNestedObjectType(testCase.name, nil, nil)
 
 			got, err := customObjectType.renderTypable()
 
@@ -143,7 +143,7 @@ basetypes.ObjectType
 		t.Run(name, func(t *testing.T) {
 			t.Parallel()
 
-			customObjectType := NewCustomNestedObjectType(testCase.name, nil)
+			customObjectType := NewCustomNestedObjectType(testCase.name, nil, nil)
 
 			got, err := customObjectType.renderType()
 
@@ -348,7 +348,7 @@ state: attr.ValueStateKnown,
 		t.Run(name, func(t *testing.T) {
 			t.Parallel()
 
-			customObjectType := NewCustomNestedObjectType(testCase.name, testCase.attrValues)
+			customObjectType := NewCustomNestedObjectType(testCase.name, testCase.attrValues, nil)
 
 			got, err := customObjectType.renderValue()
 
@@ -478,7 +478,7 @@ state: attr.ValueStateKnown,
 		t.Run(name, func(t *testing.T) {
 			t.Parallel()
 
-			customObjectType := NewCustomNestedObjectType(testCase.name, testCase.attrValues)
+			customObjectType := NewCustomNestedObjectType(testCase.name, testCase.attrValues, nil)
 
 			got, err := customObjectType.renderValueFromObject()
 
@@ -551,7 +551,7 @@ return NewExampleValueMust(ExampleValue{}.AttributeTypes(ctx), attributes), nil
 		t.Run(name, func(t *testing.T) {
 			t.Parallel()
 
-			customObjectType := NewCustomNestedObjectType(testCase.name, nil)
+			customObjectType := NewCustomNestedObjectType(testCase.name, nil, nil)
 
 			got, err := customObjectType.renderValueFromTerraform()
 
@@ -605,7 +605,7 @@ return object
 		t.Run(name, func(t *testing.T) {
 			t.Parallel()
 
-			customObjectType := NewCustomNestedObjectType(testCase.name, nil)
+			customObjectType := NewCustomNestedObjectType(testCase.name, nil, nil)
 
 			got, err := customObjectType.renderValueMust()
 
@@ -644,7 +644,7 @@ state: attr.ValueStateNull,
 		t.Run(name, func(t *testing.T) {
 			t.Parallel()
 
-			customObjectType := NewCustomNestedObjectType(testCase.name, nil)
+			customObjectType := NewCustomNestedObjectType(testCase.name, nil, nil)
 
 			got, err := customObjectType.renderValueNull()
 
@@ -681,7 +681,7 @@ return ExampleValue{}
 		t.Run(name, func(t *testing.T) {
 			t.Parallel()
 
-			customObjectType := NewCustomNestedObjectType(testCase.name, nil)
+			customObjectType := NewCustomNestedObjectType(testCase.name, nil, nil)
 
 			got, err := customObjectType.renderValueType()
 
@@ -720,7 +720,7 @@ state: attr.ValueStateUnknown,
 		t.Run(name, func(t *testing.T) {
 			t.Parallel()
 
-			customObjectType := NewCustomNestedObjectType(testCase.name, nil)
+			customObjectType := NewCustomNestedObjectType(testCase.name, nil, nil)
 
 			got, err := customObjectType.renderValueUnknown()
 
@@ -763,7 +763,7 @@ return map[string]attr.Type{
 		t.Run(name, func(t *testing.T) {
 			t.Parallel()
 
-			customObjectValue := NewCustomNestedObjectValue(testCase.name, nil, testCase.attrTypes, nil, nil)
+			customObjectValue := NewCustomNestedObjectValue(testCase.name, nil, testCase.attrTypes, nil, nil, nil)
 
 			got, err := customObjectValue.renderAttributeTypes()
 
@@ -854,7 +854,7 @@ return true
 		t.Run(name, func(t *testing.T) {
 			t.Parallel()
 
-			customObjectValue := NewCustomNestedObjectValue(testCase.name, nil, nil, testCase.attrValues, nil)
+			customObjectValue := NewCustomNestedObjectValue(testCase.name, nil, nil, testCase.attrValues, nil, nil)
 
 			got, err := customObjectValue.renderEqual()
 
@@ -891,7 +891,7 @@ return v.state == attr.ValueStateNull
 		t.Run(name, func(t *testing.T) {
 			t.Parallel()
 
-			customObjectValue := NewCustomNestedObjectValue(testCase.name, nil, nil, nil, nil)
+			customObjectValue := NewCustomNestedObjectValue(testCase.name, nil, nil, nil, nil, nil)
 
 			got, err := customObjectValue.renderIsNull()
 
@@ -928,7 +928,7 @@ return v.state == attr.ValueStateUnknown
 		t.Run(name, func(t *testing.T) {
 			t.Parallel()
 
-			customObjectValue := NewCustomNestedObjectValue(testCase.name, nil, nil, nil, nil)
+			customObjectValue := NewCustomNestedObjectValue(testCase.name, nil, nil, nil, nil, nil)
 
 			got, err := customObjectValue.renderIsUnknown()
 
@@ -965,7 +965,7 @@ return "ExampleValue"
 		t.Run(name, func(t *testing.T) {
 			t.Parallel()
 
-			customObjectValue := NewCustomNestedObjectValue(testCase.name, nil, nil, nil, nil)
+			customObjectValue := NewCustomNestedObjectValue(testCase.name, nil, nil, nil, nil, nil)
 
 			got, err := customObjectValue.renderString()
 
@@ -1654,7 +1654,7 @@ return objVal, diags
 		t.Run(name, func(t *testing.T) {
 			t.Parallel()
 
-			customObjectValue := NewCustomNestedObjectValue(testCase.name, testCase.attributeTypes, testCase.attrTypes, nil, testCase.collectionTypes)
+			customObjectValue := NewCustomNestedObjectValue(testCase.name, testCase.attributeTypes, testCase.attrTypes, nil, testCase.collectionTypes, nil)
 
 			got, err := customObjectValue.renderToObjectValue()
 
@@ -1804,7 +1804,7 @@ panic(fmt.Sprintf("unhandled Object state in ToTerraformValue: %s", v.state))
 		t.Run(name, func(t *testing.T) {
 			t.Parallel()
 
-			customObjectValue := NewCustomNestedObjectValue(testCase.name, nil, testCase.attrTypes, nil, nil)
+			customObjectValue := NewCustomNestedObjectValue(testCase.name, nil, testCase.attrTypes, nil, nil, nil)
 
 			got, err := customObjectValue.renderToTerraformValue()
 
@@ -1845,7 +1845,7 @@ AttrTypes: v.AttributeTypes(ctx),
 		t.Run(name, func(t *testing.T) {
 			t.Parallel()
 
-			customObjectValue := NewCustomNestedObjectValue(testCase.name, nil, nil, nil, nil)
+			customObjectValue := NewCustomNestedObjectValue(testCase.name, nil, nil, nil, nil, nil)
 
 			got, err := customObjectValue.renderType()
 
@@ -1879,7 +1879,7 @@ func TestCustomNestedObjectValue_renderValuable(t *testing.T) {
 		t.Run(name, func(t *testing.T) {
 			t.Parallel()
 
-			customObjectValue := NewCustomNestedObjectValue(testCase.name, nil, nil, nil, nil)
+			customObjectValue := NewCustomNestedObjectValue(testCase.name, nil, nil, nil, nil, nil)
 
 			got, err := customObjectValue.renderValuable()
 
@@ -1930,7 +1930,7 @@ state attr.ValueState
 		t.Run(name, func(t *testing.T) {
 			t.Parallel()
 
-			customObjectValue := NewCustomNestedObjectValue(testCase.name, nil, nil, testCase.attrValues, nil)
+			customObjectValue := NewCustomNestedObjectValue(testCase.name, nil, nil, testCase.attrValues, nil, nil)
 
 			got, err := customObjectValue.renderValue()
 
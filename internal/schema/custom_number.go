@@ -11,22 +11,24 @@ import (
 type CustomNumberType struct {
 	Name      FrameworkIdentifier
 	templates map[string]string
+	options   *Options
 }
 
-func NewCustomNumberType(name string) CustomNumberType {
+func NewCustomNumberType(name string, opts *Options) CustomNumberType {
 	t := map[string]string{
-		"equal":              NumberTypeEqualTemplate,
-		"string":             NumberTypeStringTemplate,
-		"type":               NumberTypeTypeTemplate,
-		"typable":            NumberTypeTypableTemplate,
-		"valueFromNumber":    NumberTypeValueFromNumberTemplate,
-		"valueFromTerraform": NumberTypeValueFromTerraformTemplate,
-		"valueType":          NumberTypeValueTypeTemplate,
+		"equal":              opts.template("number_type_equal"),
+		"string":             opts.template("number_type_string"),
+		"type":               opts.template("number_type_type"),
+		"typable":            opts.template("number_type_typable"),
+		"valueFromNumber":    opts.template("number_type_value_from_number"),
+		"valueFromTerraform": opts.template("number_type_value_from_terraform"),
+		"valueType":          opts.template("number_type_value_type"),
 	}
 
 	return CustomNumberType{
 		Name:      FrameworkIdentifier(name),
 		templates: t,
+		options:   opts,
 	}
 }
 
@@ -70,7 +72,7 @@ func (c CustomNumberType) renderEqual() ([]byte, error) {
 	err = t.Execute(&buf, struct {
 		Name string
 	}{
-		Name: c.Name.ToPascalCase(),
+		Name: c.options.ToPascalCase(c.Name),
 	})
 
 	if err != nil {
@@ -92,7 +94,7 @@ func (c CustomNumberType) renderString() ([]byte, error) {
 	err = t.Execute(&buf, struct {
 		Name string
 	}{
-		Name: c.Name.ToPascalCase(),
+		Name: c.options.ToPascalCase(c.Name),
 	})
 
 	if err != nil {
@@ -114,7 +116,7 @@ func (c CustomNumberType) renderType() ([]byte, error) {
 	err = t.Execute(&buf, struct {
 		Name string
 	}{
-		Name: c.Name.ToPascalCase(),
+		Name: c.options.ToPascalCase(c.Name),
 	})
 
 	if err != nil {
@@ -136,7 +138,7 @@ func (c CustomNumberType) renderTypable() ([]byte, error) {
 	err = t.Execute(&buf, struct {
 		Name string
 	}{
-		Name: c.Name.ToPascalCase(),
+		Name: c.options.ToPascalCase(c.Name),
 	})
 
 	if err != nil {
@@ -158,7 +160,7 @@ func (c CustomNumberType) renderValueFromNumber() ([]byte, error) {
 	err = t.Execute(&buf, struct {
 		Name string
 	}{
-		Name: c.Name.ToPascalCase(),
+		Name: c.options.ToPascalCase(c.Name),
 	})
 
 	if err != nil {
@@ -180,7 +182,7 @@ func (c CustomNumberType) renderValueFromTerraform() ([]byte, error) {
 	err = t.Execute(&buf, struct {
 		Name string
 	}{
-		Name: c.Name.ToPascalCase(),
+		Name: c.options.ToPascalCase(c.Name),
 	})
 
 	if err != nil {
@@ -202,7 +204,7 @@ func (c CustomNumberType) renderValueType() ([]byte, error) {
 	err = t.Execute(&buf, struct {
 		Name string
 	}{
-		Name: c.Name.ToPascalCase(),
+		Name: c.options.ToPascalCase(c.Name),
 	})
 
 	if err != nil {
@@ -215,19 +217,21 @@ func (c CustomNumberType) renderValueType() ([]byte, error) {
 type CustomNumberValue struct {
 	Name      FrameworkIdentifier
 	templates map[string]string
+	options   *Options
 }
 
-func NewCustomNumberValue(name string) CustomNumberValue {
+func NewCustomNumberValue(name string, opts *Options) CustomNumberValue {
 	t := map[string]string{
-		"equal":    NumberValueEqualTemplate,
-		"type":     NumberValueTypeTemplate,
-		"valuable": NumberValueValuableTemplate,
-		"value":    NumberValueValueTemplate,
+		"equal":    opts.template("number_value_equal"),
+		"type":     opts.template("number_value_type"),
+		"valuable": opts.template("number_value_valuable"),
+		"value":    opts.template("number_value_value"),
 	}
 
 	return CustomNumberValue{
 		Name:      FrameworkIdentifier(name),
 		templates: t,
+		options:   opts,
 	}
 }
 
@@ -268,7 +272,7 @@ func (c CustomNumberValue) renderEqual() ([]byte, error) {
 	err = t.Execute(&buf, struct {
 		Name string
 	}{
-		Name: c.Name.ToPascalCase(),
+		Name: c.options.ToPascalCase(c.Name),
 	})
 
 	if err != nil {
@@ -290,7 +294,7 @@ func (c CustomNumberValue) renderType() ([]byte, error) {
 	err = t.Execute(&buf, struct {
 		Name string
 	}{
-		Name: c.Name.ToPascalCase(),
+		Name: c.options.ToPascalCase(c.Name),
 	})
 
 	if err != nil {
@@ -312,7 +316,7 @@ func (c CustomNumberValue) renderValuable() ([]byte, error) {
 	err = t.Execute(&buf, struct {
 		Name string
 	}{
-		Name: c.Name.ToPascalCase(),
+		Name: c.options.ToPascalCase(c.Name),
 	})
 
 	if err != nil {
@@ -334,7 +338,7 @@ func (c CustomNumberValue) renderValue() ([]byte, error) {
 	err = t.Execute(&buf, struct {
 		Name string
 	}{
-		Name: c.Name.ToPascalCase(),
+		Name: c.options.ToPascalCase(c.Name),
 	})
 
 	if err != nil {
@@ -11,22 +11,24 @@ import (
 type CustomObjectType struct {
 	Name      FrameworkIdentifier
 	templates map[string]string
+	options   *Options
 }
 
-func NewCustomObjectType(name string) CustomObjectType {
+func NewCustomObjectType(name string, opts *Options) CustomObjectType {
 	t := map[string]string{
-		"equal":              ObjectTypeEqualTemplate,
-		"string":             ObjectTypeStringTemplate,
-		"type":               ObjectTypeTypeTemplate,
-		"typable":            ObjectTypeTypableTemplate,
-		"valueFromObject":    ObjectTypeValueFromObjectTemplate,
-		"valueFromTerraform": ObjectTypeValueFromTerraformTemplate,
-		"valueType":          ObjectTypeValueTypeTemplate,
+		"equal":              opts.template("object_type_equal"),
+		"string":             opts.template("object_type_string"),
+		"type":               opts.template("object_type_type"),
+		"typable":            opts.template("object_type_typable"),
+		"valueFromObject":    opts.template("object_type_value_from_object"),
+		"valueFromTerraform": opts.template("object_type_value_from_terraform"),
+		"valueType":          opts.template("object_type_value_type"),
 	}
 
 	return CustomObjectType{
 		Name:      FrameworkIdentifier(name),
 		templates: t,
+		options:   opts,
 	}
 }
 
@@ -70,7 +72,7 @@ func (c CustomObjectType) renderEqual() ([]byte, error) {
 	err = t.Execute(&buf, struct {
 		Name string
 	}{
-		Name: c.Name.ToPascalCase(),
+		Name: c.options.ToPascalCase(c.Name),
 	})
 
 	if err != nil {
@@ -92,7 +94,7 @@ func (c CustomObjectType) renderString() ([]byte, error) {
 	err = t.Execute(&buf, struct {
 		Name string
 	}{
-		Name: c.Name.ToPascalCase(),
+		Name: c.options.ToPascalCase(c.Name),
 	})
 
 	if err != nil {
@@ -114,7 +116,7 @@ func (c CustomObjectType) renderType() ([]byte, error) {
 	err = t.Execute(&buf, struct {
 		Name string
 	}{
-		Name: c.Name.ToPascalCase(),
+		Name: c.options.ToPascalCase(c.Name),
 	})
 
 	if err != nil {
@@ -136,7 +138,7 @@ func (c CustomObjectType) renderTypable() ([]byte, error) {
 	err = t.Execute(&buf, struct {
 		Name string
 	}{
-		Name: c.Name.ToPascalCase(),
+		Name: c.options.ToPascalCase(c.Name),
 	})
 
 	if err != nil {
@@ -158,7 +160,7 @@ func (c CustomObjectType) renderValueFromObject() ([]byte, error) {
 	err = t.Execute(&buf, struct {
 		Name string
 	}{
-		Name: c.Name.ToPascalCase(),
+		Name: c.options.ToPascalCase(c.Name),
 	})
 
 	if err != nil {
@@ -180,7 +182,7 @@ func (c CustomObjectType) renderValueFromTerraform() ([]byte, error) {
 	err = t.Execute(&buf, struct {
 		Name string
 	}{
-		Name: c.Name.ToPascalCase(),
+		Name: c.options.ToPascalCase(c.Name),
 	})
 
 	if err != nil {
@@ -202,7 +204,7 @@ func (c CustomObjectType) renderValueType() ([]byte, error) {
 	err = t.Execute(&buf, struct {
 		Name string
 	}{
-		Name: c.Name.ToPascalCase(),
+		Name: c.options.ToPascalCase(c.Name),
 	})
 
 	if err != nil {
@@ -216,21 +218,23 @@ type CustomObjectValue struct {
 	Name      FrameworkIdentifier
 	AttrTypes string
 	templates map[string]string
+	options   *Options
 }
 
-func NewCustomObjectValue(name, attrTypes string) CustomObjectValue {
+func NewCustomObjectValue(name, attrTypes string, opts *Options) CustomObjectValue {
 	t := map[string]string{
-		"attributeTypes": ObjectValueAttributeTypesTemplate,
-		"equal":          ObjectValueEqualTemplate,
-		"type":           ObjectValueTypeTemplate,
-		"valuable":       ObjectValueValuableTemplate,
-		"value":          ObjectValueValueTemplate,
+		"attributeTypes": opts.template("object_value_attribute_types"),
+		"equal":          opts.template("object_value_equal"),
+		"type":           opts.template("object_value_type"),
+		"valuable":       opts.template("object_value_valuable"),
+		"value":          opts.template("object_value_value"),
 	}
 
 	return CustomObjectValue{
 		Name:      FrameworkIdentifier(name),
 		AttrTypes: attrTypes,
 		templates: t,
+		options:   opts,
 	}
 }
 
@@ -273,7 +277,7 @@ func (c CustomObjectValue) renderAttributeTypes() ([]byte, error) {
 		Name      string
 		AttrTypes string
 	}{
-		Name:      c.Name.ToPascalCase(),
+		Name:      c.options.ToPascalCase(c.Name),
 		AttrTypes: c.AttrTypes,
 	})
 
@@ -296,7 +300,7 @@ func (c CustomObjectValue) renderEqual() ([]byte, error) {
 	err = t.Execute(&buf, struct {
 		Name string
 	}{
-		Name: c.Name.ToPascalCase(),
+		Name: c.options.ToPascalCase(c.Name),
 	})
 
 	if err != nil {
@@ -319,7 +323,7 @@ func (c CustomObjectValue) renderType() ([]byte, error) {
 		Name        string
 		ElementType string
 	}{
-		Name:        c.Name.ToPascalCase(),
+		Name:        c.options.ToPascalCase(c.Name),
 		ElementType: c.AttrTypes,
 	})
 
@@ -342,7 +346,7 @@ func (c CustomObjectValue) renderValuable() ([]byte, error) {
 	err = t.Execute(&buf, struct {
 		Name string
 	}{
-		Name: c.Name.ToPascalCase(),
+		Name: c.options.ToPascalCase(c.Name),
 	})
 
 	if err != nil {
@@ -364,7 +368,7 @@ func (c CustomObjectValue) renderValue() ([]byte, error) {
 	err = t.Execute(&buf, struct {
 		Name string
 	}{
-		Name: c.Name.ToPascalCase(),
+		Name: c.options.ToPascalCase(c.Name),
 	})
 
 	if err != nil {
@@ -36,7 +36,7 @@ return t.ObjectType.Equal(other.ObjectType)
 		t.Run(name, func(t *testing.T) {
 			t.Parallel()
 
-			customObjectType := NewCustomObjectType(testCase.name)
+			customObjectType := NewCustomObjectType(testCase.name, nil)
 
 			got, err := customObjectType.renderEqual()
 
@@ -73,7 +73,7 @@ return "ExampleType"
 		t.Run(name, func(t *testing.T) {
 			t.Parallel()
 
-			customObjectType := NewCustomObjectType(testCase.name)
+			customObjectType := NewCustomObjectType(testCase.name, nil)
 
 			got, err := customObjectType.renderString()
 
@@ -107,7 +107,7 @@ func TestCustomObjectType_renderTypable(t *testing.T) {
 		t.Run(name, func(t *testing.T) {
 			t.Parallel()
 
-			customObjectType := NewCustomObjectType(testCase.name)
+			customObjectType := NewCustomObjectType(testCase.name, nil)
 
 			got, err := customObjectType.renderTypable()
 
@@ -143,7 +143,7 @@ basetypes.ObjectType
 		t.Run(name, func(t *testing.T) {
 			t.Parallel()
 
-			customObjectType := NewCustomObjectType(testCase.name)
+			customObjectType := NewCustomObjectType(testCase.name, nil)
 
 			got, err := customObjectType.renderType()
 
@@ -186,7 +186,7 @@ ObjectValue: in,
 		t.Run(name, func(t *testing.T) {
 			t.Parallel()
 
-			customObjectType := NewCustomObjectType(testCase.name)
+			customObjectType := NewCustomObjectType(testCase.name, nil)
 
 			got, err := customObjectType.renderValueFromObject()
 
@@ -241,7 +241,7 @@ return objectValuable, nil
 		t.Run(name, func(t *testing.T) {
 			t.Parallel()
 
-			customObjectType := NewCustomObjectType(testCase.name)
+			customObjectType := NewCustomObjectType(testCase.name, nil)
 
 			got, err := customObjectType.renderValueFromTerraform()
 
@@ -278,7 +278,7 @@ return ExampleValue{}
 		t.Run(name, func(t *testing.T) {
 			t.Parallel()
 
-			customObjectType := NewCustomObjectType(testCase.name)
+			customObjectType := NewCustomObjectType(testCase.name, nil)
 
 			got, err := customObjectType.renderValueType()
 
@@ -323,7 +323,7 @@ return v.ObjectValue.Equal(other.ObjectValue)
 		t.Run(name, func(t *testing.T) {
 			t.Parallel()
 
-			customObjectValue := NewCustomObjectValue(testCase.name, testCase.elementType)
+			customObjectValue := NewCustomObjectValue(testCase.name, testCase.elementType, nil)
 
 			got, err := customObjectValue.renderEqual()
 
@@ -366,7 +366,7 @@ AttrTypes: v.AttributeTypes(ctx),
 		t.Run(name, func(t *testing.T) {
 			t.Parallel()
 
-			customObjectValue := NewCustomObjectValue(testCase.name, testCase.elementType)
+			customObjectValue := NewCustomObjectValue(testCase.name, testCase.elementType, nil)
 
 			got, err := customObjectValue.renderType()
 
@@ -402,7 +402,7 @@ func TestCustomObjectValue_renderValuable(t *testing.T) {
 		t.Run(name, func(t *testing.T) {
 			t.Parallel()
 
-			customObjectValue := NewCustomObjectValue(testCase.name, testCase.elementType)
+			customObjectValue := NewCustomObjectValue(testCase.name, testCase.elementType, nil)
 
 			got, err := customObjectValue.renderValuable()
 
@@ -440,7 +440,7 @@ basetypes.ObjectValue
 		t.Run(name, func(t *testing.T) {
 			t.Parallel()
 
-			customObjectValue := NewCustomObjectValue(testCase.name, testCase.elementType)
+			customObjectValue := NewCustomObjectValue(testCase.name, testCase.elementType, nil)
 
 			got, err := customObjectValue.renderValue()
 
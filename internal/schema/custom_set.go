@@ -11,22 +11,24 @@ import (
 type CustomSetType struct {
 	Name      FrameworkIdentifier
 	templates map[string]string
+	options   *Options
 }
 
-func NewCustomSetType(name string) CustomSetType {
+func NewCustomSetType(name string, opts *Options) CustomSetType {
 	t := map[string]string{
-		"equal":              SetTypeEqualTemplate,
-		"string":             SetTypeStringTemplate,
-		"type":               SetTypeTypeTemplate,
-		"typable":            SetTypeTypableTemplate,
-		"valueFromSet":       SetTypeValueFromSetTemplate,
-		"valueFromTerraform": SetTypeValueFromTerraformTemplate,
-		"valueType":          SetTypeValueTypeTemplate,
+		"equal":              opts.template("set_type_equal"),
+		"string":             opts.template("set_type_string"),
+		"type":               opts.template("set_type_type"),
+		"typable":            opts.template("set_type_typable"),
+		"valueFromSet":       opts.template("set_type_value_from_set"),
+		"valueFromTerraform": opts.template("set_type_value_from_terraform"),
+		"valueType":          opts.template("set_type_value_type"),
 	}
 
 	return CustomSetType{
 		Name:      FrameworkIdentifier(name),
 		templates: t,
+		options:   opts,
 	}
 }
 
@@ -70,7 +72,7 @@ func (c CustomSetType) renderEqual() ([]byte, error) {
 	err = t.Execute(&buf, struct {
 		Name string
 	}{
-		Name: c.Name.ToPascalCase(),
+		Name: c.options.ToPascalCase(c.Name),
 	})
 
 	if err != nil {
@@ -92,7 +94,7 @@ func (c CustomSetType) renderString() ([]byte, error) {
 	err = t.Execute(&buf, struct {
 		Name string
 	}{
-		Name: c.Name.ToPascalCase(),
+		Name: c.options.ToPascalCase(c.Name),
 	})
 
 	if err != nil {
@@ -114,7 +116,7 @@ func (c CustomSetType) renderType() ([]byte, error) {
 	err = t.Execute(&buf, struct {
 		Name string
 	}{
-		Name: c.Name.ToPascalCase(),
+		Name: c.options.ToPascalCase(c.Name),
 	})
 
 	if err != nil {
@@ -136,7 +138,7 @@ func (c CustomSetType) renderTypable() ([]byte, error) {
 	err = t.Execute(&buf, struct {
 		Name string
 	}{
-		Name: c.Name.ToPascalCase(),
+		Name: c.options.ToPascalCase(c.Name),
 	})
 
 	if err != nil {
@@ -158,7 +160,7 @@ func (c CustomSetType) renderValueFromSet() ([]byte, error) {
 	err = t.Execute(&buf, struct {
 		Name string
 	}{
-		Name: c.Name.ToPascalCase(),
+		Name: c.options.ToPascalCase(c.Name),
 	})
 
 	if err != nil {
@@ -180,7 +182,7 @@ func (c CustomSetType) renderValueFromTerraform() ([]byte, error) {
 	err = t.Execute(&buf, struct {
 		Name string
 	}{
-		Name: c.Name.ToPascalCase(),
+		Name: c.options.ToPascalCase(c.Name),
 	})
 
 	if err != nil {
@@ -202,7 +204,7 @@ func (c CustomSetType) renderValueType() ([]byte, error) {
 	err = t.Execute(&buf, struct {
 		Name string
 	}{
-		Name: c.Name.ToPascalCase(),
+		Name: c.options.ToPascalCase(c.Name),
 	})
 
 	if err != nil {
@@ -216,20 +218,22 @@ type CustomSetValue struct {
 	Name        FrameworkIdentifier
 	ElementType string
 	templates   map[string]string
+	options     *Options
 }
 
-func NewCustomSetValue(name, elemType string) CustomSetValue {
+func NewCustomSetValue(name, elemType string, opts *Options) CustomSetValue {
 	t := map[string]string{
-		"equal":    SetValueEqualTemplate,
-		"type":     SetValueTypeTemplate,
-		"valuable": SetValueValuableTemplate,
-		"value":    SetValueValueTemplate,
+		"equal":    opts.template("set_value_equal"),
+		"type":     opts.template("set_value_type"),
+		"valuable": opts.template("set_value_valuable"),
+		"value":    opts.template("set_value_value"),
 	}
 
 	return CustomSetValue{
 		Name:        FrameworkIdentifier(name),
 		ElementType: elemType,
 		templates:   t,
+		options:     opts,
 	}
 }
 
@@ -270,7 +274,7 @@ func (c CustomSetValue) renderEqual() ([]byte, error) {
 	err = t.Execute(&buf, struct {
 		Name string
 	}{
-		Name: c.Name.ToPascalCase(),
+		Name: c.options.ToPascalCase(c.Name),
 	})
 
 	if err != nil {
@@ -293,7 +297,7 @@ func (c CustomSetValue) renderType() ([]byte, error) {
 		Name        string
 		ElementType string
 	}{
-		Name:        c.Name.ToPascalCase(),
+		Name:        c.options.ToPascalCase(c.Name),
 		ElementType: c.ElementType,
 	})
 
@@ -316,7 +320,7 @@ func (c CustomSetValue) renderValuable() ([]byte, error) {
 	err = t.Execute(&buf, struct {
 		Name string
 	}{
-		Name: c.Name.ToPascalCase(),
+		Name: c.options.ToPascalCase(c.Name),
 	})
 
 	if err != nil {
@@ -338,7 +342,7 @@ func (c CustomSetValue) renderValue() ([]byte, error) {
 	err = t.Execute(&buf, struct {
 		Name string
 	}{
-		Name: c.Name.ToPascalCase(),
+		Name: c.options.ToPascalCase(c.Name),
 	})
 
 	if err != nil {
@@ -11,22 +11,24 @@ import (
 type CustomStringType struct {
 	Name      FrameworkIdentifier
 	templates map[string]string
+	options   *Options
 }
 
-func NewCustomStringType(name string) CustomStringType {
+func NewCustomStringType(name string, opts *Options) CustomStringType {
 	t := map[string]string{
-		"equal":              StringTypeEqualTemplate,
-		"string":             StringTypeStringTemplate,
-		"type":               StringTypeTypeTemplate,
-		"typable":            StringTypeTypableTemplate,
-		"valueFromString":    StringTypeValueFromStringTemplate,
-		"valueFromTerraform": StringTypeValueFromTerraformTemplate,
-		"valueType":          StringTypeValueTypeTemplate,
+		"equal":              opts.template("string_type_equal"),
+		"string":             opts.template("string_type_string"),
+		"type":               opts.template("string_type_type"),
+		"typable":            opts.template("string_type_typable"),
+		"valueFromString":    opts.template("string_type_value_from_string"),
+		"valueFromTerraform": opts.template("string_type_value_from_terraform"),
+		"valueType":          opts.template("string_type_value_type"),
 	}
 
 	return CustomStringType{
 		Name:      FrameworkIdentifier(name),
 		templates: t,
+		options:   opts,
 	}
 }
 
@@ -70,7 +72,7 @@ func (c CustomStringType) renderEqual() ([]byte, error) {
 	err = t.Execute(&buf, struct {
 		Name string
 	}{
-		Name: c.Name.ToPascalCase(),
+		Name: c.options.ToPascalCase(c.Name),
 	})
 
 	if err != nil {
@@ -92,7 +94,7 @@ func (c CustomStringType) renderString() ([]byte, error) {
 	err = t.Execute(&buf, struct {
 		Name string
 	}{
-		Name: c.Name.ToPascalCase(),
+		Name: c.options.ToPascalCase(c.Name),
 	})
 
 	if err != nil {
@@ -114,7 +116,7 @@ func (c CustomStringType) renderType() ([]byte, error) {
 	err = t.Execute(&buf, struct {
 		Name string
 	}{
-		Name: c.Name.ToPascalCase(),
+		Name: c.options.ToPascalCase(c.Name),
 	})
 
 	if err != nil {
@@ -136,7 +138,7 @@ func (c CustomStringType) renderTypable() ([]byte, error) {
 	err = t.Execute(&buf, struct {
 		Name string
 	}{
-		Name: c.Name.ToPascalCase(),
+		Name: c.options.ToPascalCase(c.Name),
 	})
 
 	if err != nil {
@@ -158,7 +160,7 @@ func (c CustomStringType) renderValueFromString() ([]byte, error) {
 	err = t.Execute(&buf, struct {
 		Name string
 	}{
-		Name: c.Name.ToPascalCase(),
+		Name: c.options.ToPascalCase(c.Name),
 	})
 
 	if err != nil {
@@ -180,7 +182,7 @@ func (c CustomStringType) renderValueFromTerraform() ([]byte, error) {
 	err = t.Execute(&buf, struct {
 		Name string
 	}{
-		Name: c.Name.ToPascalCase(),
+		Name: c.options.ToPascalCase(c.Name),
 	})
 
 	if err != nil {
@@ -202,7 +204,7 @@ func (c CustomStringType) renderValueType() ([]byte, error) {
 	err = t.Execute(&buf, struct {
 		Name string
 	}{
-		Name: c.Name.ToPascalCase(),
+		Name: c.options.ToPascalCase(c.Name),
 	})
 
 	if err != nil {
@@ -215,19 +217,21 @@ func (c CustomStringType) renderValueType() ([]byte, error) {
 type CustomStringValue struct {
 	Name      FrameworkIdentifier
 	templates map[string]string
+	options   *Options
 }
 
-func NewCustomStringValue(name string) CustomStringValue {
+func NewCustomStringValue(name string, opts *Options) CustomStringValue {
 	t := map[string]string{
-		"equal":    StringValueEqualTemplate,
-		"type":     StringValueTypeTemplate,
-		"valuable": StringValueValuableTemplate,
-		"value":    StringValueValueTemplate,
+		"equal":    opts.template("string_value_equal"),
+		"type":     opts.template("string_value_type"),
+		"valuable": opts.template("string_value_valuable"),
+		"value":    opts.template("string_value_value"),
 	}
 
 	return CustomStringValue{
 		Name:      FrameworkIdentifier(name),
 		templates: t,
+		options:   opts,
 	}
 }
 
@@ -268,7 +272,7 @@ func (c CustomStringValue) renderEqual() ([]byte, error) {
 	err = t.Execute(&buf, struct {
 		Name string
 	}{
-		Name: c.Name.ToPascalCase(),
+		Name: c.options.ToPascalCase(c.Name),
 	})
 
 	if err != nil {
@@ -290,7 +294,7 @@ func (c CustomStringValue) renderType() ([]byte, error) {
 	err = t.Execute(&buf, struct {
 		Name string
 	}{
-		Name: c.Name.ToPascalCase(),
+		Name: c.options.ToPascalCase(c.Name),
 	})
 
 	if err != nil {
@@ -312,7 +316,7 @@ func (c CustomStringValue) renderValuable() ([]byte, error) {
 	err = t.Execute(&buf, struct {
 		Name string
 	}{
-		Name: c.Name.ToPascalCase(),
+		Name: c.options.ToPascalCase(c.Name),
 	})
 
 	if err != nil {
@@ -334,7 +338,7 @@ func (c CustomStringValue) renderValue() ([]byte, error) {
 	err = t.Execute(&buf, struct {
 		Name string
 	}{
-		Name: c.Name.ToPascalCase(),
+		Name: c.options.ToPascalCase(c.Name),
 	})
 
 	if err != nil {
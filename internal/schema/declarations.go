@@ -0,0 +1,290 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package schema
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// Declaration is a package-level Go type declared by generated code, along
+// with the path of the data source, provider, resource, attribute or block
+// which it is generated for (e.g., resource.example.network.config).
+type Declaration struct {
+	Name string
+	Path string
+}
+
+// Declarations returns the package-level Go types declared by the code
+// generated for the schema, which are the data model and the custom type and
+// value types for attributes and blocks. The path is prefixed to the path of
+// each declaration. Shared types are declared in the shared package, so are
+// omitted.
+func (g GeneratorSchema) Declarations(name, path string, shared SharedTypes) ([]Declaration, error) {
+	path = path + "." + name
+
+	declarations := []Declaration{
+		{
+			Name: g.Options.ToPascalCase(FrameworkIdentifier(name)) + "Model",
+			Path: path,
+		},
+	}
+
+	d, err := attributesDeclarations(g.Attributes, "", path, shared, g.Options)
+
+	if err != nil {
+		return nil, err
+	}
+
+	declarations = append(declarations, d...)
+
+	d, err = blocksDeclarations(g.Blocks, "", path, shared, g.Options)
+
+	if err != nil {
+		return nil, err
+	}
+
+	declarations = append(declarations, d...)
+
+	return declarations, nil
+}
+
+// Declarations returns the package-level Go types declared by the code
+// generated for each of the schemas, keyed by schema name.
+func (g GeneratorSchemas) Declarations(path string, shared SharedTypes) (map[string][]Declaration, error) {
+	declarations := make(map[string][]Declaration, len(g.schemas))
+
+	for k, s := range g.schemas {
+		d, err := s.Declarations(k, path, shared)
+
+		if err != nil {
+			return nil, err
+		}
+
+		declarations[k] = d
+	}
+
+	return declarations, nil
+}
+
+// CheckCollisions returns an error if the code generated for the schemas would
+// declare the same Go type more than once within a package. If packageName is
+// empty, the code for each schema is generated into a separate package. Shared
+// types are declared in the same package as the schemas if packageName is not
+// empty, otherwise in a separate shared package.
+func (g GeneratorSchemas) CheckCollisions(path, packageName string, shared SharedTypes) error {
+	declarations, err := g.Declarations(path, shared)
+
+	if err != nil {
+		return err
+	}
+
+	if packageName != "" {
+		all := shared.Declarations()
+
+		for _, k := range sortedKeys(declarations) {
+			all = append(all, declarations[k]...)
+		}
+
+		return CheckDeclarations(all)
+	}
+
+	var errs []error
+
+	for _, k := range sortedKeys(declarations) {
+		errs = append(errs, CheckDeclarations(declarations[k]))
+	}
+
+	return errors.Join(errs...)
+}
+
+// CollisionError is returned by CheckDeclarations for each pair of paths for
+// which the same Go types would be declared.
+type CollisionError struct {
+	// Names are the Go types declared for both paths, sorted by name.
+	Names []string
+
+	// Paths are the paths of the attributes or blocks, sorted by path.
+	Paths [2]string
+}
+
+func (e *CollisionError) Error() string {
+	return fmt.Sprintf("%s generated for both %s and %s", strings.Join(e.Names, ", "), e.Paths[0], e.Paths[1])
+}
+
+// CheckDeclarations returns a *CollisionError for each pair of paths for which
+// the same Go type would be declared, joined with errors.Join.
+func CheckDeclarations(declarations []Declaration) error {
+	paths := make(map[string]string, len(declarations))
+	collisions := make(map[[2]string][]string)
+
+	for _, d := range declarations {
+		p, ok := paths[d.Name]
+
+		if !ok {
+			paths[d.Name] = d.Path
+			continue
+		}
+
+		pair := [2]string{p, d.Path}
+
+		if d.Path < p {
+			pair = [2]string{d.Path, p}
+		}
+
+		collisions[pair] = append(collisions[pair], d.Name)
+	}
+
+	pairs := make([][2]string, 0, len(collisions))
+
+	for k := range collisions {
+		pairs = append(pairs, k)
+	}
+
+	sort.Slice(pairs, func(i, j int) bool {
+		if pairs[i][0] != pairs[j][0] {
+			return pairs[i][0] < pairs[j][0]
+		}
+
+		return pairs[i][1] < pairs[j][1]
+	})
+
+	var errs []error
+
+	for _, pair := range pairs {
+		names := collisions[pair]
+
+		sort.Strings(names)
+
+		errs = append(errs, &CollisionError{
+			Names: names,
+			Paths: pair,
+		})
+	}
+
+	return errors.Join(errs...)
+}
+
+func attributesDeclarations(attributes GeneratorAttributes, parent, path string, shared SharedTypes, opts *Options) ([]Declaration, error) {
+	var declarations []Declaration
+
+	for _, k := range attributes.SortedKeys() {
+		if attributes[k] == nil {
+			continue
+		}
+
+		d, err := customTypeDeclarations(attributes[k], opts.NestedTypeName(parent, k), path+"."+k, shared, opts)
+
+		if err != nil {
+			return nil, err
+		}
+
+		declarations = append(declarations, d...)
+	}
+
+	return declarations, nil
+}
+
+func blocksDeclarations(blocks GeneratorBlocks, parent, path string, shared SharedTypes, opts *Options) ([]Declaration, error) {
+	var declarations []Declaration
+
+	for _, k := range blocks.SortedKeys() {
+		if blocks[k] == nil {
+			continue
+		}
+
+		d, err := customTypeDeclarations(blocks[k], opts.NestedTypeName(parent, k), path+"."+k, shared, opts)
+
+		if err != nil {
+			return nil, err
+		}
+
+		declarations = append(declarations, d...)
+	}
+
+	return declarations, nil
+}
+
+// customTypeDeclarations returns the custom type and value types declared for
+// an attribute or block, including those declared for any nested attributes
+// and blocks. Nested attributes and blocks always declare custom type and value
+// types, whereas other attributes only declare custom type and value types if
+// they have an associated external type. Shared types, and any types nested
+// within them, are omitted.
+func customTypeDeclarations(v any, typeName, path string, shared SharedTypes, opts *Options) ([]Declaration, error) {
+	c, ok := v.(CustomTypeAndValue)
+
+	if !ok {
+		return nil, nil
+	}
+
+	a, nested := v.(Attributes)
+
+	if _, ok := shared.types[typeName]; ok && nested {
+		return nil, nil
+	}
+
+	if !nested {
+		b, err := c.CustomTypeAndValue(typeName)
+
+		if err != nil {
+			return nil, err
+		}
+
+		if len(b) == 0 {
+			return nil, nil
+		}
+	}
+
+	name := opts.ToPascalCase(FrameworkIdentifier(typeName))
+
+	declarations := []Declaration{
+		{
+			Name: name + "Type",
+			Path: path,
+		},
+		{
+			Name: name + "Value",
+			Path: path,
+		},
+	}
+
+	if !nested {
+		return declarations, nil
+	}
+
+	d, err := attributesDeclarations(a.GetAttributes(), typeName, path, shared, opts)
+
+	if err != nil {
+		return nil, err
+	}
+
+	declarations = append(declarations, d...)
+
+	if b, ok := v.(Blocks); ok {
+		d, err := blocksDeclarations(b.GetBlocks(), typeName, path, shared, opts)
+
+		if err != nil {
+			return nil, err
+		}
+
+		declarations = append(declarations, d...)
+	}
+
+	return declarations, nil
+}
+
+func sortedKeys[T any](m map[string]T) []string {
+	keys := make([]string, 0, len(m))
+
+	for k := range m {
+		keys = append(keys, k)
+	}
+
+	sort.Strings(keys)
+
+	return keys
+}
@@ -0,0 +1,60 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package schema
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestCheckDeclarations(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		input    []Declaration
+		expected string
+	}{
+		"none": {},
+		"no-collisions": {
+			input: []Declaration{
+				{Name: "ExampleModel", Path: "resource.example"},
+				{Name: "NetworkConfigType", Path: "resource.example.network.config"},
+				{Name: "NetworkConfigValue", Path: "resource.example.network.config"},
+				{Name: "StorageConfigType", Path: "resource.example.storage.config"},
+				{Name: "StorageConfigValue", Path: "resource.example.storage.config"},
+			},
+		},
+		"collisions": {
+			input: []Declaration{
+				{Name: "ExampleModel", Path: "resource.example"},
+				{Name: "ConfigType", Path: "resource.example.storage.config"},
+				{Name: "ConfigValue", Path: "resource.example.storage.config"},
+				{Name: "ConfigType", Path: "resource.example.network.config"},
+				{Name: "ConfigValue", Path: "resource.example.network.config"},
+				{Name: "ExampleModel", Path: "data_source.example"},
+			},
+			expected: "ExampleModel generated for both data_source.example and resource.example\n" +
+				"ConfigType, ConfigValue generated for both resource.example.network.config and resource.example.storage.config",
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			err := CheckDeclarations(testCase.input)
+
+			var got string
+
+			if err != nil {
+				got = err.Error()
+			}
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
@@ -277,6 +277,17 @@ var NumberValueValueTemplate string
 //go:embed templates/number_value_valuable.gotmpl
 var NumberValueValuableTemplate string
 
+// Model From/To
+
+//go:embed templates/model_from.gotmpl
+var ModelFromTemplate string
+
+//go:embed templates/model_to.gotmpl
+var ModelToTemplate string
+
+//go:embed templates/model_to_from_test.gotmpl
+var ModelToFromTestTemplate string
+
 // NestedObject From/To
 
 //go:embed templates/nested_object_from.gotmpl
@@ -355,6 +366,9 @@ var NestedObjectValueValueTemplate string
 //go:embed templates/schema.gotmpl
 var SchemaGoTemplate string
 
+//go:embed templates/shared_types.gotmpl
+var SharedTypesGoTemplate string
+
 // Object From/To
 
 //go:embed templates/object_from.gotmpl
@@ -492,3 +506,14 @@ var StringValueValueTemplate string
 
 //go:embed templates/string_value_valuable.gotmpl
 var StringValueValuableTemplate string
+
+// To/From Tests
+
+//go:embed templates/to_from_test.gotmpl
+var ToFromTestTemplate string
+
+//go:embed templates/crud.gotmpl
+var CRUDTemplate string
+
+//go:embed templates/state_upgrade.gotmpl
+var StateUpgradeTemplate string
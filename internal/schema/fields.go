@@ -0,0 +1,90 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package schema
+
+import (
+	specschema "github.com/hashicorp/terraform-plugin-codegen-spec/schema"
+)
+
+// Field is an attribute or block, described by the properties of its Terraform
+// schema, from which documentation, example configurations and acceptance tests
+// are rendered.
+type Field struct {
+	Name  string
+	Type  Type
+	Block bool
+
+	FieldProperties
+
+	// Attributes and Blocks are the attributes and blocks of a nested
+	// attribute or block.
+	Attributes GeneratorAttributes
+	Blocks     GeneratorBlocks
+
+	// ElementType is the element type of a list, map or set attribute.
+	ElementType *specschema.ElementType
+
+	// AttributeTypes are the attribute types of an object attribute.
+	AttributeTypes specschema.ObjectAttributeTypes
+}
+
+// Fields returns the attributes, sorted by name, followed by the blocks, sorted
+// by name.
+func Fields(attributes GeneratorAttributes, blocks GeneratorBlocks) []Field {
+	fields := make([]Field, 0, len(attributes)+len(blocks))
+
+	for _, k := range attributes.SortedKeys() {
+		fields = append(fields, newField(k, attributes[k], false))
+	}
+
+	for _, k := range blocks.SortedKeys() {
+		fields = append(fields, newField(k, blocks[k], true))
+	}
+
+	return fields
+}
+
+func newField(name string, g interface{ GeneratorSchemaType() Type }, block bool) Field {
+	f := Field{
+		Name:  name,
+		Type:  g.GeneratorSchemaType(),
+		Block: block,
+	}
+
+	if p, ok := g.(Properties); ok {
+		f.FieldProperties = p.Properties()
+	}
+
+	if a, ok := g.(Attributes); ok {
+		f.Attributes = a.GetAttributes()
+	}
+
+	if b, ok := g.(Blocks); ok {
+		f.Blocks = b.GetBlocks()
+	}
+
+	if e, ok := g.(Elements); ok {
+		elementType := e.ElemType()
+		f.ElementType = &elementType
+	}
+
+	if a, ok := g.(Attrs); ok {
+		f.AttributeTypes = a.AttrTypes()
+	}
+
+	return f
+}
+
+// IsNested returns true for nested attributes and blocks.
+func (f Field) IsNested() bool {
+	switch f.Type {
+	case GeneratorListNestedAttribute, GeneratorListNestedBlock,
+		GeneratorMapNestedAttribute,
+		GeneratorSetNestedAttribute, GeneratorSetNestedBlock,
+		GeneratorSingleNestedAttribute, GeneratorSingleNestedBlock:
+		return true
+	}
+
+	return false
+}
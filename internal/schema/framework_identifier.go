@@ -5,9 +5,8 @@ package schema
 
 import (
 	"regexp"
-	"strings"
-	"unicode"
-	"unicode/utf8"
+
+	"github.com/hashicorp/terraform-plugin-codegen-framework/internal/format"
 )
 
 // FrameworkIdentifier is a string that implements helpful methods for validating and converting identifier names that are valid in Terraform Plugin Framework
@@ -19,9 +18,6 @@ type FrameworkIdentifier string
 // [Terraform Plugin Framework identifiers]: https://github.com/hashicorp/terraform-plugin-framework/blob/e036d9fbab4b72f8ec671a9d3e94649040e3eeb5/internal/fwschema/attribute_name_validation.go#L61
 var frameworkIdentifierRegex = regexp.MustCompile("^[a-z_][a-z0-9_]*$")
 
-// snakeLetters will match to the first letter and an underscore followed by a letter
-var snakeLetters = regexp.MustCompile("(^[a-z])|_[a-z0-9]")
-
 // Valid will return whether the identifier string is a valid identifier in Terraform Plugin Framework
 func (identifier FrameworkIdentifier) Valid() bool {
 	return frameworkIdentifierRegex.MatchString(string(identifier))
@@ -30,16 +26,15 @@ func (identifier FrameworkIdentifier) Valid() bool {
 // ToCamelCase will return a camel case formatted string of the identifier.
 // Example:
 //   - example_resource_thing -> exampleResourceThing
+//
+// Generated code uses the casing of the generator options, refer to
+// Options.ToCamelCase.
 func (identifier FrameworkIdentifier) ToCamelCase() string {
-	pascal := identifier.ToPascalCase()
-
-	// Grab first rune and lower case it
-	firstLetter, size := utf8.DecodeRuneInString(pascal)
-	if firstLetter == utf8.RuneError && size <= 1 {
-		return pascal
-	}
+	return identifier.toCamelCase(format.Casing{})
+}
 
-	return string(unicode.ToLower(firstLetter)) + pascal[size:]
+func (identifier FrameworkIdentifier) toCamelCase(casing format.Casing) string {
+	return casing.CamelCase(string(identifier))
 }
 
 // ToPrefixCamelCase will return a camel case formatted string of the identifier,
@@ -49,26 +44,51 @@ func (identifier FrameworkIdentifier) ToCamelCase() string {
 //   - equal(something) -> somethingEqual
 //   - type(something) -> somethingType
 func (identifier FrameworkIdentifier) ToPrefixCamelCase(prefix string) string {
-	pascalCase := identifier.ToPascalCase()
+	return identifier.toPrefixCamelCase(format.Casing{}, prefix)
+}
+
+func (identifier FrameworkIdentifier) toPrefixCamelCase(casing format.Casing, prefix string) string {
+	if identifier.isMethodName(casing) {
+		return casing.LowerFirst(prefix + identifier.toPascalCase(casing))
+	}
+
+	return identifier.toCamelCase(casing)
+}
 
-	methodNames := identifier.methodNames()
+// IsMethodName returns whether the pascal case of the identifier is the name of
+// a method generated for custom value types, which ToPrefixCamelCase and
+// ToPrefixPascalCase prefix.
+// Example:
+//   - type -> true
+//   - thing -> false
+func (identifier FrameworkIdentifier) IsMethodName() bool {
+	return identifier.isMethodName(format.Casing{})
+}
 
-	for _, v := range methodNames {
+func (identifier FrameworkIdentifier) isMethodName(casing format.Casing) bool {
+	pascalCase := identifier.toPascalCase(casing)
+
+	for _, v := range identifier.methodNames() {
 		if pascalCase == v {
-			return FrameworkIdentifier(prefix + pascalCase).ToCamelCase()
+			return true
 		}
 	}
 
-	return FrameworkIdentifier(pascalCase).ToCamelCase()
+	return false
 }
 
 // ToPascalCase will return a pascal case formatted string of the identifier.
 // Example:
 //   - example_resource_thing -> ExampleResourceThing
+//
+// Generated code uses the casing of the generator options, refer to
+// Options.ToPascalCase.
 func (identifier FrameworkIdentifier) ToPascalCase() string {
-	return snakeLetters.ReplaceAllStringFunc(string(identifier), func(s string) string {
-		return strings.ToUpper(strings.Replace(s, "_", "", -1))
-	})
+	return identifier.toPascalCase(format.Casing{})
+}
+
+func (identifier FrameworkIdentifier) toPascalCase(casing format.Casing) string {
+	return casing.PascalCase(string(identifier))
 }
 
 // ToPrefixPascalCase will return a pascal case formatted string of the identifier,
@@ -78,14 +98,14 @@ func (identifier FrameworkIdentifier) ToPascalCase() string {
 //   - equal(something) -> SomethingEqual
 //   - type(something) -> SomethingType
 func (identifier FrameworkIdentifier) ToPrefixPascalCase(prefix string) string {
-	pascalCase := identifier.ToPascalCase()
+	return identifier.toPrefixPascalCase(format.Casing{}, prefix)
+}
 
-	methodNames := identifier.methodNames()
+func (identifier FrameworkIdentifier) toPrefixPascalCase(casing format.Casing, prefix string) string {
+	pascalCase := identifier.toPascalCase(casing)
 
-	for _, v := range methodNames {
-		if pascalCase == v {
-			return FrameworkIdentifier(prefix).ToPascalCase() + pascalCase
-		}
+	if identifier.isMethodName(casing) {
+		return casing.PascalCase(prefix) + pascalCase
 	}
 
 	return pascalCase
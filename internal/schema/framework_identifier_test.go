@@ -180,6 +180,43 @@ func TestFrameworkIdentifier_ToPrefixCamelCase(t *testing.T) {
 	}
 }
 
+func TestFrameworkIdentifier_IsMethodName(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		identifier schema.FrameworkIdentifier
+		want       bool
+	}{
+		"method name": {
+			identifier: "type",
+			want:       true,
+		},
+		"method name - multiple words": {
+			identifier: "attribute_types",
+			want:       true,
+		},
+		"not method name": {
+			identifier: "thing",
+			want:       false,
+		},
+		"not method name - prefix": {
+			identifier: "type_name",
+			want:       false,
+		},
+	}
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.identifier.IsMethodName()
+			if got != testCase.want {
+				t.Fatalf("expected IsMethodName() to return %t, got %t", testCase.want, got)
+			}
+		})
+	}
+}
+
 func TestFrameworkIdentifier_ToPascalCase(t *testing.T) {
 	t.Parallel()
 
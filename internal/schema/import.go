@@ -8,17 +8,20 @@ import (
 )
 
 const (
-	AttrImport         = "github.com/hashicorp/terraform-plugin-framework/attr"
-	BaseTypesImport    = "github.com/hashicorp/terraform-plugin-framework/types/basetypes"
-	ContextImport      = "context"
-	DiagImport         = "github.com/hashicorp/terraform-plugin-framework/diag"
-	FmtImport          = "fmt"
-	MathBigImport      = "math/big"
-	PlanModifierImport = "github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
-	StringsImport      = "strings"
-	TfTypesImport      = "github.com/hashicorp/terraform-plugin-go/tftypes"
-	TypesImport        = "github.com/hashicorp/terraform-plugin-framework/types"
-	ValidatorImport    = "github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	AttrImport           = "github.com/hashicorp/terraform-plugin-framework/attr"
+	BaseTypesImport      = "github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	ContextImport        = "context"
+	DiagImport           = "github.com/hashicorp/terraform-plugin-framework/diag"
+	FmtImport            = "fmt"
+	MathBigImport        = "math/big"
+	PlanModifierImport   = "github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	ResourceImport       = "github.com/hashicorp/terraform-plugin-framework/resource"
+	ResourceSchemaImport = "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	StringsImport        = "strings"
+	TfsdkImport          = "github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	TfTypesImport        = "github.com/hashicorp/terraform-plugin-go/tftypes"
+	TypesImport          = "github.com/hashicorp/terraform-plugin-framework/types"
+	ValidatorImport      = "github.com/hashicorp/terraform-plugin-framework/schema/validator"
 )
 
 type Imports struct {
@@ -0,0 +1,254 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package schema
+
+import (
+	"fmt"
+	"text/template"
+
+	"github.com/hashicorp/terraform-plugin-codegen-spec/code"
+
+	"github.com/hashicorp/terraform-plugin-codegen-framework/internal/format"
+)
+
+// Options holds the settings used when generating code from the schemas, which are
+// supplied when converting the specification, and are held by each attribute and
+// block. A nil *Options uses the default settings.
+type Options struct {
+	// Casing converts snake case names to the Go identifiers used in generated
+	// code.
+	Casing format.Casing
+
+	// HierarchicalTypeNames indicates whether the custom type and value types
+	// generated for nested attributes and blocks are prefixed with the names of
+	// all parent attributes and blocks (e.g., NetworkConfigType,
+	// NetworkConfigValue), rather than named after the nested attribute or
+	// block alone (e.g., ConfigType, ConfigValue).
+	HierarchicalTypeNames bool
+
+	// FrameworkTypes indicates whether nested attributes and blocks use
+	// framework object types in place of generated custom type and value
+	// types. It is set when converting prior versions of resource schemas,
+	// which are only used to read prior state.
+	FrameworkTypes bool
+
+	// Templates overrides the templates used to generate code, keyed on
+	// template name. Refer to TemplateNames and ValidateTemplates.
+	Templates map[string]string
+
+	// sharedTypes holds the snake case names of the custom type and value
+	// types which are declared in a shared package, sharedTypesQualifier is
+	// the name which the shared package is referred to by, and
+	// sharedTypesImportPath is the path it is imported from. An empty
+	// qualifier indicates that the shared types are declared in the same
+	// package as the generated code.
+	sharedTypes           map[string]struct{}
+	sharedTypesQualifier  string
+	sharedTypesImportPath string
+}
+
+// ValidateTemplates returns an error if any name in the template overrides is
+// unknown, or any template cannot be parsed.
+func ValidateTemplates(overrides map[string]string) error {
+	for _, k := range sortedKeys(overrides) {
+		if _, ok := templates[k]; !ok {
+			return fmt.Errorf("unknown template %q", k)
+		}
+
+		if _, err := template.New(k).Parse(overrides[k]); err != nil {
+			return fmt.Errorf("template %q: %w", k, err)
+		}
+	}
+
+	return nil
+}
+
+// SetSharedTypes sets the shared custom type and value types which are referred to,
+// rather than declared, by the code generated for each schema. The qualifier is the
+// name of the shared package, which is imported from importPath, or empty if the
+// shared types are declared in the same package as the generated code. An empty
+// SharedTypes disables sharing.
+func (o *Options) SetSharedTypes(s SharedTypes, qualifier, importPath string) {
+	o.sharedTypes = make(map[string]struct{}, len(s.types))
+
+	for name := range s.types {
+		o.sharedTypes[name] = struct{}{}
+	}
+
+	o.sharedTypesQualifier = qualifier
+	o.sharedTypesImportPath = importPath
+}
+
+// casing returns the casing used for generated Go identifiers.
+func (o *Options) casing() format.Casing {
+	if o == nil {
+		return format.Casing{}
+	}
+
+	return o.Casing
+}
+
+// template returns the named template, which is either the embedded template or
+// its override.
+func (o *Options) template(name string) string {
+	if o != nil {
+		if t, ok := o.Templates[name]; ok {
+			return t
+		}
+	}
+
+	return *templates[name]
+}
+
+// ToPascalCase returns a pascal case formatted string of the identifier.
+func (o *Options) ToPascalCase(identifier FrameworkIdentifier) string {
+	return identifier.toPascalCase(o.casing())
+}
+
+// ToCamelCase returns a camel case formatted string of the identifier.
+func (o *Options) ToCamelCase(identifier FrameworkIdentifier) string {
+	return identifier.toCamelCase(o.casing())
+}
+
+// ToPrefixPascalCase returns a pascal case formatted string of the identifier,
+// prefixed with the pascal case of the supplied name if the identifier is a
+// generated custom value method name.
+func (o *Options) ToPrefixPascalCase(identifier FrameworkIdentifier, prefix string) string {
+	return identifier.toPrefixPascalCase(o.casing(), prefix)
+}
+
+// IsMethodName returns whether the pascal case of the identifier is the name of
+// a method generated for custom value types.
+func (o *Options) IsMethodName(identifier FrameworkIdentifier) bool {
+	return identifier.isMethodName(o.casing())
+}
+
+// NestedTypeName returns the snake case name used for the custom type and value types
+// generated for the named attribute or block, which is nested within an attribute or
+// block for which custom type and value types named parent are generated. An empty
+// parent indicates a top-level attribute or block.
+func (o *Options) NestedTypeName(parent, name string) string {
+	if parent == "" || o == nil || !o.HierarchicalTypeNames {
+		return name
+	}
+
+	return parent + "_" + name
+}
+
+// IsSharedType returns whether the custom type and value types with the supplied
+// snake case name are declared in the shared package.
+func (o *Options) IsSharedType(name string) bool {
+	if o == nil {
+		return false
+	}
+
+	_, ok := o.sharedTypes[name]
+
+	return ok
+}
+
+// SharedTypesImport returns the import of the shared package, if the shared types
+// are declared in a separate package.
+func (o *Options) SharedTypesImport() *code.Import {
+	if o == nil || len(o.sharedTypes) == 0 || o.sharedTypesImportPath == "" {
+		return nil
+	}
+
+	return &code.Import{
+		Path: o.sharedTypesImportPath,
+	}
+}
+
+// TypeReference returns the pascal case name used to refer to the custom type and
+// value types with the supplied snake case name, qualified with the name of the
+// shared package if the types are shared.
+func (o *Options) TypeReference(name string) string {
+	typeName := o.ToPascalCase(FrameworkIdentifier(name))
+
+	if !o.IsSharedType(name) || o.sharedTypesQualifier == "" {
+		return typeName
+	}
+
+	return o.sharedTypesQualifier + "." + typeName
+}
+
+// NestedCustomTypeAndValue generates the custom type and value types for a nested
+// attribute or block, unless the types are declared in the shared package.
+func (o *Options) NestedCustomTypeAndValue(c CustomTypeAndValue, name string) ([]byte, error) {
+	if o.IsSharedType(name) {
+		return nil, nil
+	}
+
+	return c.CustomTypeAndValue(name)
+}
+
+// NestedToFromFunctions generates the to/from functions for a nested attribute or
+// block, unless they are declared in the shared package.
+func (o *Options) NestedToFromFunctions(t ToFrom, name string) ([]byte, error) {
+	if o.IsSharedType(name) {
+		return nil, nil
+	}
+
+	return t.ToFromFunctions(name)
+}
+
+// identifier returns the identifier for use in template data.
+func (o *Options) identifier(identifier FrameworkIdentifier) Identifier {
+	return Identifier{
+		FrameworkIdentifier: identifier,
+		options:             o,
+	}
+}
+
+// Identifier is a FrameworkIdentifier which is converted to Go identifiers using the
+// casing of the generator options. It is used as the key of maps in the data passed
+// to templates, so that templates can call the methods of FrameworkIdentifier.
+type Identifier struct {
+	FrameworkIdentifier
+
+	options *Options
+}
+
+// identifiers returns a copy of the map keyed on Identifier rather than
+// FrameworkIdentifier, for use in template data.
+func identifiers[T any](o *Options, m map[FrameworkIdentifier]T) map[Identifier]T {
+	if m == nil {
+		return nil
+	}
+
+	ids := make(map[Identifier]T, len(m))
+
+	for k, v := range m {
+		ids[o.identifier(k)] = v
+	}
+
+	return ids
+}
+
+// String returns the identifier without any formatting.
+func (identifier Identifier) String() string {
+	return string(identifier.FrameworkIdentifier)
+}
+
+// ToCamelCase returns a camel case formatted string of the identifier.
+func (identifier Identifier) ToCamelCase() string {
+	return identifier.FrameworkIdentifier.toCamelCase(identifier.options.casing())
+}
+
+// ToPrefixCamelCase returns a camel case formatted string of the identifier, prefixed
+// as described by FrameworkIdentifier.ToPrefixCamelCase.
+func (identifier Identifier) ToPrefixCamelCase(prefix string) string {
+	return identifier.FrameworkIdentifier.toPrefixCamelCase(identifier.options.casing(), prefix)
+}
+
+// ToPascalCase returns a pascal case formatted string of the identifier.
+func (identifier Identifier) ToPascalCase() string {
+	return identifier.FrameworkIdentifier.toPascalCase(identifier.options.casing())
+}
+
+// ToPrefixPascalCase returns a pascal case formatted string of the identifier,
+// prefixed as described by FrameworkIdentifier.ToPrefixPascalCase.
+func (identifier Identifier) ToPrefixPascalCase(prefix string) string {
+	return identifier.FrameworkIdentifier.toPrefixPascalCase(identifier.options.casing(), prefix)
+}
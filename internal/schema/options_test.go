@@ -0,0 +1,168 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package schema
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/hashicorp/terraform-plugin-codegen-framework/internal/format"
+)
+
+func TestOptions_NestedTypeName(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		options  *Options
+		parent   string
+		name     string
+		expected string
+	}{
+		"nil": {
+			parent:   "network",
+			name:     "config",
+			expected: "config",
+		},
+		"attribute": {
+			options:  &Options{},
+			parent:   "network",
+			name:     "config",
+			expected: "config",
+		},
+		"hierarchical": {
+			options: &Options{
+				HierarchicalTypeNames: true,
+			},
+			parent:   "network",
+			name:     "config",
+			expected: "network_config",
+		},
+		"hierarchical-top-level": {
+			options: &Options{
+				HierarchicalTypeNames: true,
+			},
+			name:     "network",
+			expected: "network",
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.options.NestedTypeName(testCase.parent, testCase.name)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestOptions_TypeReference(t *testing.T) {
+	t.Parallel()
+
+	shared := SharedTypes{
+		types: map[string]sharedType{
+			"api_config": {},
+		},
+	}
+
+	testCases := map[string]struct {
+		options   *Options
+		qualifier string
+		name      string
+		expected  string
+	}{
+		"nil": {
+			name:     "api_config",
+			expected: "ApiConfig",
+		},
+		"initialisms": {
+			options: &Options{
+				Casing: format.NewCasing(nil),
+			},
+			name:     "api_config",
+			expected: "APIConfig",
+		},
+		"shared-same-package": {
+			options:  &Options{},
+			name:     "api_config",
+			expected: "ApiConfig",
+		},
+		"shared-qualified": {
+			options:   &Options{},
+			qualifier: "shared_resource",
+			name:      "api_config",
+			expected:  "shared_resource.ApiConfig",
+		},
+		"not-shared-qualified": {
+			options:   &Options{},
+			qualifier: "shared_resource",
+			name:      "network",
+			expected:  "Network",
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if testCase.options != nil {
+				testCase.options.SetSharedTypes(shared, testCase.qualifier, "")
+			}
+
+			got := testCase.options.TypeReference(testCase.name)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestValidateTemplates(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		overrides     map[string]string
+		expectedError string
+	}{
+		"nil": {},
+		"valid": {
+			overrides: map[string]string{
+				"bool_to": "// {{.Name}}",
+			},
+		},
+		"unknown": {
+			overrides: map[string]string{
+				"unknown": "",
+			},
+			expectedError: `unknown template "unknown"`,
+		},
+		"invalid": {
+			overrides: map[string]string{
+				"bool_to": "{{.Name",
+			},
+			expectedError: `template "bool_to": template: bool_to:1: unclosed action`,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var got string
+
+			if err := ValidateTemplates(testCase.overrides); err != nil {
+				got = err.Error()
+			}
+
+			if diff := cmp.Diff(got, testCase.expectedError); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
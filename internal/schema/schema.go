@@ -25,6 +25,26 @@ type GeneratorSchema struct {
 	Description         *string
 	MarkdownDescription *string
 	DeprecationMessage  *string
+
+	// AssociatedExternalType is optionally supplied by the generator
+	// configuration, and is used to generate conversion functions between
+	// the top-level data model and an external type.
+	AssociatedExternalType *AssocExtType
+
+	// CRUD is optionally supplied by the generator configuration for
+	// resources, and is used to generate Create, Read, Update and Delete
+	// methods which convert the data model to and from the associated
+	// external type.
+	CRUD *CRUD
+
+	// StateUpgrade is optionally supplied by the generator configuration
+	// for resources, and is used to set the schema version, and to generate
+	// state upgraders from prior versions of the schema.
+	StateUpgrade *StateUpgrade
+
+	// Options holds the settings used when generating code for the schema,
+	// which are supplied when converting the specification.
+	Options *Options
 }
 
 func (g GeneratorSchema) Imports() (string, error) {
@@ -108,6 +128,50 @@ func (g GeneratorSchema) Imports() (string, error) {
 		imports.Add(v.Imports().All()...)
 	}
 
+	// The shared package is imported whenever shared types are declared in a
+	// separate package, and the import is removed from generated code which
+	// does not refer to any shared types.
+	if i := g.Options.SharedTypesImport(); i != nil {
+		imports.Add(*i)
+	}
+
+	if g.AssociatedExternalType != nil {
+		toFuncs, fromFuncs, err := g.ModelToFromFuncs()
+
+		// Model conversion functions are not generated if any attribute or
+		// block conversion is unimplemented, so the imports are omitted.
+		if err == nil {
+			if g.AssociatedExternalType.HasImport() {
+				imports.Add(*g.AssociatedExternalType.Import)
+			}
+
+			imports.Add([]code.Import{
+				{
+					Path: DiagImport,
+				},
+				{
+					Path: TypesImport,
+				},
+			}...)
+
+			for k := range toFuncs {
+				if toFuncs[k].ObjectType != nil || fromFuncs[k].ObjectType != nil {
+					imports.Add([]code.Import{
+						{
+							Path: FmtImport,
+						},
+						{
+							Path: AttrImport,
+						},
+						{
+							Path: BaseTypesImport,
+						},
+					}...)
+				}
+			}
+		}
+	}
+
 	var sb strings.Builder
 
 	for _, i := range imports.All() {
@@ -167,8 +231,9 @@ func (g GeneratorSchema) Schema(name, packageName, generatorType string) ([]byte
 		Imports             string
 		MarkdownDescription string
 		DeprecationMessage  string
+		Version             int64
 	}{
-		Name:                FrameworkIdentifier(name).ToPascalCase(),
+		Name:                g.Options.ToPascalCase(FrameworkIdentifier(name)),
 		PackageName:         packageName,
 		GeneratorType:       generatorType,
 		Attributes:          attributes,
@@ -179,7 +244,11 @@ func (g GeneratorSchema) Schema(name, packageName, generatorType string) ([]byte
 		DeprecationMessage:  deprecationMessage,
 	}
 
-	t, err := template.New("schema").Parse(SchemaGoTemplate)
+	if g.StateUpgrade != nil {
+		templateData.Version = g.StateUpgrade.Version
+	}
+
+	t, err := template.New("schema").Parse(g.Options.template("schema"))
 
 	if err != nil {
 		return nil, err
@@ -233,7 +302,7 @@ func (g GeneratorSchema) Models(name string) ([]model.Model, error) {
 	}
 
 	m := model.Model{
-		Name:   FrameworkIdentifier(name).ToPascalCase(),
+		Name:   g.Options.ToPascalCase(FrameworkIdentifier(name)),
 		Fields: modelFields,
 	}
 
@@ -255,7 +324,7 @@ func (g GeneratorSchema) CustomTypeValueBytes() ([]byte, error) {
 		}
 
 		if c, ok := g.Attributes[k].(CustomTypeAndValue); ok {
-			b, err := c.CustomTypeAndValue(k)
+			b, err := g.Options.NestedCustomTypeAndValue(c, k)
 
 			if err != nil {
 				return nil, err
@@ -273,7 +342,7 @@ func (g GeneratorSchema) CustomTypeValueBytes() ([]byte, error) {
 		}
 
 		if c, ok := g.Blocks[k].(CustomTypeAndValue); ok {
-			b, err := c.CustomTypeAndValue(k)
+			b, err := g.Options.NestedCustomTypeAndValue(c, k)
 
 			if err != nil {
 				return nil, err
@@ -304,7 +373,7 @@ func (g GeneratorSchema) ToFromFunctions(ctx context.Context, logger *slog.Logge
 		}
 
 		if t, ok := g.Attributes[k].(ToFrom); ok {
-			b, err := t.ToFromFunctions(k)
+			b, err := g.Options.NestedToFromFunctions(t, k)
 
 			var unimplErr *UnimplementedError
 
@@ -330,7 +399,7 @@ func (g GeneratorSchema) ToFromFunctions(ctx context.Context, logger *slog.Logge
 		}
 
 		if t, ok := g.Blocks[k].(ToFrom); ok {
-			b, err := t.ToFromFunctions(k)
+			b, err := g.Options.NestedToFromFunctions(t, k)
 
 			var unimplErr *UnimplementedError
 
@@ -347,6 +416,119 @@ func (g GeneratorSchema) ToFromFunctions(ctx context.Context, logger *slog.Logge
 	return buf.Bytes(), nil
 }
 
+// ModelToFromFuncs returns mappings of attribute and block names to the
+// conversions used when converting between the top-level data model and
+// an associated external type. An UnimplementedError is returned if any
+// attribute or block does not support conversion.
+func (g GeneratorSchema) ModelToFromFuncs() (map[string]ToFromConversion, map[string]ToFromConversion, error) {
+	toFuncs := make(map[string]ToFromConversion, len(g.Attributes)+len(g.Blocks))
+	fromFuncs := make(map[string]ToFromConversion, len(g.Attributes)+len(g.Blocks))
+
+	for _, k := range g.Attributes.SortedKeys() {
+		if g.Attributes[k] == nil {
+			continue
+		}
+
+		toFunc, fromFunc, err := modelToFromFuncs(g.Attributes[k])
+
+		var unimplErr *UnimplementedError
+
+		if errors.As(err, &unimplErr) {
+			return nil, nil, unimplErr.NestedUnimplementedError(k)
+		} else if err != nil {
+			return nil, nil, err
+		}
+
+		toFuncs[k] = toFunc
+		fromFuncs[k] = fromFunc
+	}
+
+	for _, k := range g.Blocks.SortedKeys() {
+		if g.Blocks[k] == nil {
+			continue
+		}
+
+		toFunc, fromFunc, err := modelToFromFuncs(g.Blocks[k])
+
+		var unimplErr *UnimplementedError
+
+		if errors.As(err, &unimplErr) {
+			return nil, nil, unimplErr.NestedUnimplementedError(k)
+		} else if err != nil {
+			return nil, nil, err
+		}
+
+		toFuncs[k] = toFunc
+		fromFuncs[k] = fromFunc
+	}
+
+	return toFuncs, fromFuncs, nil
+}
+
+// modelToFromFuncs returns the conversions for an attribute or block which is
+// a field of the top-level data model. ModelToFrom is used in preference to
+// To and From, as the fields of the data model can differ from the fields of
+// nested objects for the same attribute or block.
+func modelToFromFuncs(v any) (ToFromConversion, ToFromConversion, error) {
+	if m, ok := v.(ModelToFrom); ok {
+		c, err := m.ModelToFrom()
+
+		return c, c, err
+	}
+
+	to, ok := v.(To)
+
+	if !ok {
+		return ToFromConversion{}, ToFromConversion{}, NewUnimplementedError(errors.New("conversion is not yet implemented"))
+	}
+
+	toFunc, err := to.To()
+
+	if err != nil {
+		return ToFromConversion{}, ToFromConversion{}, err
+	}
+
+	from, ok := v.(From)
+
+	if !ok {
+		return ToFromConversion{}, ToFromConversion{}, NewUnimplementedError(errors.New("conversion is not yet implemented"))
+	}
+
+	fromFunc, err := from.From()
+
+	if err != nil {
+		return ToFromConversion{}, ToFromConversion{}, err
+	}
+
+	return toFunc, fromFunc, nil
+}
+
+// ModelToFromFunctions generates code for converting the top-level data model
+// to an associated external type, and from an associated external type to the
+// top-level data model. No code is generated if there is no associated external
+// type, or if the conversion of any attribute or block is unimplemented.
+func (g GeneratorSchema) ModelToFromFunctions(ctx context.Context, name string, logger *slog.Logger) ([]byte, error) {
+	if g.AssociatedExternalType == nil {
+		return nil, nil
+	}
+
+	toFuncs, fromFuncs, err := g.ModelToFromFuncs()
+
+	var unimplErr *UnimplementedError
+
+	if errors.As(err, &unimplErr) {
+		logger.Error("error generating model to/from methods", "path", fmt.Sprintf("%s.%s", logging.GetPathFromContext(ctx), unimplErr.Path()), "err", err)
+
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	toFrom := NewToFromModel(name, g.AssociatedExternalType, toFuncs, fromFuncs, g.Options)
+
+	return toFrom.Render()
+}
+
 func ElementTypeString(elementType specschema.ElementType) (string, error) {
 	switch {
 	case elementType.Bool != nil:
@@ -511,16 +693,19 @@ func ObjectFieldFrom(o specschema.ObjectAttributeType) (ObjectField, error) {
 	switch {
 	case o.Bool != nil:
 		return ObjectField{
+			GoType:   "*bool",
 			Type:     "types.BoolType",
 			FromFunc: "BoolPointerValue",
 		}, nil
 	case o.Float64 != nil:
 		return ObjectField{
+			GoType:   "*float64",
 			Type:     "types.Float64Type",
 			FromFunc: "Float64PointerValue",
 		}, nil
 	case o.Int64 != nil:
 		return ObjectField{
+			GoType:   "*int64",
 			Type:     "types.Int64Type",
 			FromFunc: "Int64PointerValue",
 		}, nil
@@ -530,6 +715,7 @@ func ObjectFieldFrom(o specschema.ObjectAttributeType) (ObjectField, error) {
 		return ObjectField{}, NewUnimplementedError(errors.New("map attribute type is not yet implemented"))
 	case o.Number != nil:
 		return ObjectField{
+			GoType:   "*big.Float",
 			Type:     "types.NumberType",
 			FromFunc: "NumberValue",
 		}, nil
@@ -539,6 +725,7 @@ func ObjectFieldFrom(o specschema.ObjectAttributeType) (ObjectField, error) {
 		return ObjectField{}, NewUnimplementedError(errors.New("set attribute type is not yet implemented"))
 	case o.String != nil:
 		return ObjectField{
+			GoType:   "*string",
 			Type:     "types.StringType",
 			FromFunc: "StringPointerValue",
 		}, nil
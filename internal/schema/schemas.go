@@ -13,14 +13,18 @@ import (
 	"github.com/hashicorp/terraform-plugin-codegen-framework/internal/logging"
 )
 
-// TODO: Field(s) could be added to handle end-user supplying their own templates to allow overriding.
 type GeneratorSchemas struct {
 	schemas map[string]GeneratorSchema
+
+	// options are the generator options which the schemas were converted
+	// with.
+	options *Options
 }
 
-func NewGeneratorSchemas(schemas map[string]GeneratorSchema) GeneratorSchemas {
+func NewGeneratorSchemas(schemas map[string]GeneratorSchema, opts *Options) GeneratorSchemas {
 	return GeneratorSchemas{
 		schemas: schemas,
+		options: opts,
 	}
 }
 
@@ -53,11 +57,13 @@ func (g GeneratorSchemas) Models() (map[string][]byte, error) {
 		var buf bytes.Buffer
 
 		generatorSchema := GeneratorSchema{
-			Attributes:          schema.Attributes,
-			Blocks:              schema.Blocks,
-			Description:         schema.Description,
-			MarkdownDescription: schema.MarkdownDescription,
-			DeprecationMessage:  schema.DeprecationMessage,
+			Attributes:             schema.Attributes,
+			Blocks:                 schema.Blocks,
+			Description:            schema.Description,
+			MarkdownDescription:    schema.MarkdownDescription,
+			DeprecationMessage:     schema.DeprecationMessage,
+			AssociatedExternalType: schema.AssociatedExternalType,
+			Options:                schema.Options,
 		}
 
 		models, err := generatorSchema.Models(name)
@@ -96,13 +102,96 @@ func (g GeneratorSchemas) ToFromFunctions(ctx context.Context, logger *slog.Logg
 	for name, s := range g.schemas {
 		ctxWithPath := logging.SetPathInContext(ctx, name)
 
-		b, err := s.ToFromFunctions(ctxWithPath, logger)
+		var buf bytes.Buffer
+
+		b, err := s.ModelToFromFunctions(ctxWithPath, name, logger)
+		if err != nil {
+			return nil, err
+		}
+
+		buf.Write(b)
+
+		b, err = s.ToFromFunctions(ctxWithPath, logger)
 		if err != nil {
 			return nil, err
 		}
 
-		modelsExpandFlattenBytes[name] = b
+		buf.Write(b)
+
+		modelsExpandFlattenBytes[name] = buf.Bytes()
 	}
 
 	return modelsExpandFlattenBytes, nil
 }
+
+// ToFromTests returns the unit tests generated for the to/from functions of each
+// schema which has any, keyed on schema name.
+func (g GeneratorSchemas) ToFromTests(packageName, generatorType string) (map[string][]byte, error) {
+	toFromTests := make(map[string][]byte, len(g.schemas))
+
+	for name, s := range g.schemas {
+		pkgName := packageName
+		if pkgName == "" {
+			pkgName = fmt.Sprintf("%s_%s", strings.ToLower(generatorType), name)
+		}
+
+		b, err := s.ToFromTests(name, pkgName)
+		if err != nil {
+			return nil, err
+		}
+
+		if len(b) > 0 {
+			toFromTests[name] = b
+		}
+	}
+
+	return toFromTests, nil
+}
+
+// CRUDMethods returns the CRUD methods generated for each schema which has CRUD
+// set, keyed on schema name.
+func (g GeneratorSchemas) CRUDMethods(packageName, generatorType string) (map[string][]byte, error) {
+	crudMethods := make(map[string][]byte)
+
+	for name, s := range g.schemas {
+		pkgName := packageName
+		if pkgName == "" {
+			pkgName = fmt.Sprintf("%s_%s", strings.ToLower(generatorType), name)
+		}
+
+		b, err := s.CRUDMethods(name, pkgName)
+		if err != nil {
+			return nil, err
+		}
+
+		if len(b) > 0 {
+			crudMethods[name] = b
+		}
+	}
+
+	return crudMethods, nil
+}
+
+// StateUpgraders returns the state upgraders generated for each schema which has
+// prior versions, keyed on schema name.
+func (g GeneratorSchemas) StateUpgraders(packageName, generatorType string) (map[string][]byte, error) {
+	stateUpgraders := make(map[string][]byte)
+
+	for name, s := range g.schemas {
+		pkgName := packageName
+		if pkgName == "" {
+			pkgName = fmt.Sprintf("%s_%s", strings.ToLower(generatorType), name)
+		}
+
+		b, err := s.StateUpgraders(name, pkgName)
+		if err != nil {
+			return nil, err
+		}
+
+		if len(b) > 0 {
+			stateUpgraders[name] = b
+		}
+	}
+
+	return stateUpgraders, nil
+}
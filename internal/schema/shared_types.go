@@ -0,0 +1,400 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package schema
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"sort"
+	"strings"
+	"text/template"
+
+	"github.com/hashicorp/terraform-plugin-codegen-spec/code"
+
+	"github.com/hashicorp/terraform-plugin-codegen-framework/internal/logging"
+)
+
+// SharedTypes holds the nested attributes and blocks for which the same custom type
+// and value types would be generated for more than one data source, provider or
+// resource, keyed on the snake case name of the types. The types are declared once,
+// in a shared package, rather than once for each schema.
+type SharedTypes struct {
+	types map[string]sharedType
+
+	// options are the generator options which the schemas were converted
+	// with.
+	options *Options
+}
+
+// sharedType is a nested attribute or block for which custom type and value types
+// are declared in the shared package, along with the paths of every attribute or
+// block for which the types would otherwise be declared.
+type sharedType struct {
+	node  any
+	paths []string
+}
+
+// sharedTypeCandidate records an occurrence of a nested attribute or block while
+// the schemas are walked to find shared types.
+type sharedTypeCandidate struct {
+	node any
+	path string
+
+	// children holds the type names of nested attributes and blocks within the
+	// nested attribute or block.
+	children []string
+
+	// customTypes indicates that the attribute is not nested, or that the
+	// nested attribute or block contains other attributes with custom type and
+	// value types, such as attributes with an associated external type, which
+	// are not shared.
+	customTypes bool
+}
+
+// SharedTypes returns the custom type and value types which would be generated for
+// structurally equal nested attributes and blocks in more than one place within the
+// schemas. A nested attribute or block is only shared if every attribute or block
+// generating types of the same name is equal, and all nested attributes and blocks
+// within it are also shared. The path is prefixed to the path of each attribute or
+// block.
+func (g GeneratorSchemas) SharedTypes(path string) (SharedTypes, error) {
+	candidates := make(map[string][]sharedTypeCandidate)
+
+	for _, k := range sortedKeys(g.schemas) {
+		s := g.schemas[k]
+		p := path + "." + k
+
+		for _, ak := range s.Attributes.SortedKeys() {
+			if s.Attributes[ak] == nil {
+				continue
+			}
+
+			if _, err := sharedTypeCandidates(candidates, s.Attributes[ak], ak, p+"."+ak, g.options); err != nil {
+				return SharedTypes{}, err
+			}
+		}
+
+		for _, bk := range s.Blocks.SortedKeys() {
+			if s.Blocks[bk] == nil {
+				continue
+			}
+
+			if _, err := sharedTypeCandidates(candidates, s.Blocks[bk], bk, p+"."+bk, g.options); err != nil {
+				return SharedTypes{}, err
+			}
+		}
+	}
+
+	shared := make(map[string]struct{})
+
+	for name, c := range candidates {
+		if len(c) < 2 {
+			continue
+		}
+
+		equal := true
+
+		for _, v := range c {
+			if v.customTypes || !equalNodes(c[0].node, v.node) {
+				equal = false
+				break
+			}
+		}
+
+		if equal {
+			shared[name] = struct{}{}
+		}
+	}
+
+	// Nested attributes and blocks are only shared if all of the nested
+	// attributes and blocks within them are also shared, as the shared package
+	// cannot refer to types declared in the package of a schema.
+	for removed := true; removed; {
+		removed = false
+
+		for name := range shared {
+			for _, child := range candidates[name][0].children {
+				if _, ok := shared[child]; !ok {
+					delete(shared, name)
+					removed = true
+					break
+				}
+			}
+		}
+	}
+
+	types := make(map[string]sharedType, len(shared))
+
+	for name := range shared {
+		t := sharedType{
+			node: candidates[name][0].node,
+		}
+
+		for _, c := range candidates[name] {
+			t.paths = append(t.paths, c.path)
+		}
+
+		types[name] = t
+	}
+
+	return SharedTypes{
+		types:   types,
+		options: g.options,
+	}, nil
+}
+
+// sharedTypeCandidates records the nested attribute or block, and any nested
+// attributes and blocks within it, in candidates. The returned bool indicates
+// whether the attribute or block generates custom type and value types.
+func sharedTypeCandidates(candidates map[string][]sharedTypeCandidate, v any, typeName, path string, opts *Options) (bool, error) {
+	c, ok := v.(CustomTypeAndValue)
+
+	if !ok {
+		return false, nil
+	}
+
+	a, nested := v.(Attributes)
+
+	if !nested {
+		b, err := c.CustomTypeAndValue(typeName)
+
+		if err != nil {
+			return false, err
+		}
+
+		if len(b) == 0 {
+			return false, nil
+		}
+
+		// Other attributes generating types of the same name prevent the
+		// types from being shared.
+		candidates[typeName] = append(candidates[typeName], sharedTypeCandidate{
+			node:        v,
+			path:        path,
+			customTypes: true,
+		})
+
+		return true, nil
+	}
+
+	candidate := sharedTypeCandidate{
+		node: v,
+		path: path,
+	}
+
+	attributes := a.GetAttributes()
+
+	for _, k := range attributes.SortedKeys() {
+		if attributes[k] == nil {
+			continue
+		}
+
+		childTypeName := opts.NestedTypeName(typeName, k)
+
+		customType, err := sharedTypeCandidates(candidates, attributes[k], childTypeName, path+"."+k, opts)
+
+		if err != nil {
+			return false, err
+		}
+
+		if _, ok := attributes[k].(Attributes); ok {
+			candidate.children = append(candidate.children, childTypeName)
+		} else if customType {
+			candidate.customTypes = true
+		}
+	}
+
+	if b, ok := v.(Blocks); ok {
+		blocks := b.GetBlocks()
+
+		for _, k := range blocks.SortedKeys() {
+			if blocks[k] == nil {
+				continue
+			}
+
+			childTypeName := opts.NestedTypeName(typeName, k)
+
+			if _, err := sharedTypeCandidates(candidates, blocks[k], childTypeName, path+"."+k, opts); err != nil {
+				return false, err
+			}
+
+			candidate.children = append(candidate.children, childTypeName)
+		}
+	}
+
+	candidates[typeName] = append(candidates[typeName], candidate)
+
+	return true, nil
+}
+
+// equalNodes returns whether two attributes, or two blocks, are equal.
+func equalNodes(a, b any) bool {
+	switch a := a.(type) {
+	case GeneratorAttribute:
+		b, ok := b.(GeneratorAttribute)
+
+		return ok && a.Equal(b)
+	case GeneratorBlock:
+		b, ok := b.(GeneratorBlock)
+
+		return ok && a.Equal(b)
+	}
+
+	return false
+}
+
+// Names returns the sorted snake case names of the shared custom type and value
+// types.
+func (s SharedTypes) Names() []string {
+	return sortedKeys(s.types)
+}
+
+// Declarations returns the package-level Go types declared in the shared package,
+// using the first path of the attributes or blocks for which each is generated.
+func (s SharedTypes) Declarations() []Declaration {
+	var declarations []Declaration
+
+	for _, name := range s.Names() {
+		paths := s.types[name].paths
+
+		sort.Strings(paths)
+
+		typeName := s.options.ToPascalCase(FrameworkIdentifier(name))
+
+		declarations = append(declarations, Declaration{
+			Name: typeName + "Type",
+			Path: paths[0],
+		}, Declaration{
+			Name: typeName + "Value",
+			Path: paths[0],
+		})
+	}
+
+	return declarations
+}
+
+// Imports returns the imports required by the code generated for the shared custom
+// type and value types. Imports which are not required by every generated package
+// are included, so unused imports must be removed from the generated code.
+func (s SharedTypes) Imports() string {
+	imports := NewImports()
+
+	imports.Add([]code.Import{
+		{
+			Path: ContextImport,
+		},
+		{
+			Path: FmtImport,
+		},
+		{
+			Path: StringsImport,
+		},
+		{
+			Path: DiagImport,
+		},
+		{
+			Path: AttrImport,
+		},
+		{
+			Path: TfTypesImport,
+		},
+		{
+			Path: TypesImport,
+		},
+		{
+			Path: BaseTypesImport,
+		},
+	}...)
+
+	for _, name := range s.Names() {
+		switch v := s.types[name].node.(type) {
+		case GeneratorAttribute:
+			imports.Append(v.Imports())
+		case GeneratorBlock:
+			imports.Append(v.Imports())
+		}
+	}
+
+	var sb strings.Builder
+
+	for _, i := range imports.All() {
+		var alias string
+
+		if i.Alias != nil {
+			alias = *i.Alias + " "
+		}
+
+		sb.WriteString(fmt.Sprintf("%s%q\n", alias, i.Path))
+	}
+
+	return sb.String()
+}
+
+// Bytes generates the code for the shared package, which contains the custom type
+// and value types, and any to/from functions, for each shared type.
+func (s SharedTypes) Bytes(ctx context.Context, packageName string, logger *slog.Logger) ([]byte, error) {
+	// shared types refer to each other without a qualifier
+	if s.options != nil {
+		qualifier := s.options.sharedTypesQualifier
+		s.options.sharedTypesQualifier = ""
+		defer func() { s.options.sharedTypesQualifier = qualifier }()
+	}
+
+	var buf bytes.Buffer
+
+	t, err := template.New("shared_types").Parse(s.options.template("shared_types"))
+
+	if err != nil {
+		return nil, err
+	}
+
+	err = t.Execute(&buf, struct {
+		PackageName string
+		Imports     string
+	}{
+		PackageName: packageName,
+		Imports:     s.Imports(),
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	var pieces [][]byte
+
+	for _, name := range s.Names() {
+		if c, ok := s.types[name].node.(CustomTypeAndValue); ok {
+			b, err := c.CustomTypeAndValue(name)
+
+			if err != nil {
+				return nil, err
+			}
+
+			pieces = append(pieces, b)
+		}
+	}
+
+	for _, name := range s.Names() {
+		if t, ok := s.types[name].node.(ToFrom); ok {
+			b, err := t.ToFromFunctions(name)
+
+			var unimplErr *UnimplementedError
+
+			if errors.As(err, &unimplErr) {
+				logger.Error("error generating to/from methods", "path", fmt.Sprintf("%s.%s.%s", logging.GetPathFromContext(ctx), name, unimplErr.Path()), "err", err)
+			} else if err != nil {
+				return nil, err
+			}
+
+			pieces = append(pieces, b)
+		}
+	}
+
+	buf.Write(bytes.Join(pieces, []byte("\n")))
+
+	return buf.Bytes(), nil
+}
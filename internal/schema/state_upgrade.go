@@ -0,0 +1,198 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package schema
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+	"text/template"
+
+	"github.com/hashicorp/terraform-plugin-codegen-spec/code"
+
+	"github.com/hashicorp/terraform-plugin-codegen-framework/internal/format"
+	"github.com/hashicorp/terraform-plugin-codegen-framework/internal/model"
+)
+
+// StateUpgrade is supplied by the generator configuration to set the version of
+// a resource schema, and to generate state upgraders from prior versions.
+type StateUpgrade struct {
+	// Version is the current version of the resource schema.
+	Version int64
+
+	// PriorVersions are the prior versions of the resource schema, in
+	// ascending order.
+	PriorVersions []PriorVersion
+}
+
+// PriorVersion is a prior version of a resource schema, read from a
+// specification snapshot.
+type PriorVersion struct {
+	Version int64
+
+	// Schema is the prior resource schema, which is converted with options
+	// setting FrameworkTypes, so that it is declared without custom types.
+	Schema GeneratorSchema
+}
+
+// stateUpgradeData holds the data used to render the state upgrade template.
+type stateUpgradeData struct {
+	// Name is the pascal case name of the resource.
+	Name string
+
+	PackageName string
+	Imports     string
+
+	// Version is the current version of the resource schema.
+	Version int64
+
+	PriorVersions []priorVersionData
+}
+
+// priorVersionData holds the schema and data model of a prior version, rendered
+// as Go code.
+type priorVersionData struct {
+	Version    int64
+	Attributes string
+	Blocks     string
+	Fields     []string
+}
+
+// priorValueTypes are the framework value types used in the data models of prior
+// schemas, in place of custom value types.
+var priorValueTypes = map[Type]string{
+	GeneratorBoolAttribute:         model.BoolValueType,
+	GeneratorFloat64Attribute:      model.Float64ValueType,
+	GeneratorInt64Attribute:        model.Int64ValueType,
+	GeneratorListAttribute:         model.ListValueType,
+	GeneratorListNestedAttribute:   model.ListValueType,
+	GeneratorListNestedBlock:       model.ListValueType,
+	GeneratorMapAttribute:          model.MapValueType,
+	GeneratorMapNestedAttribute:    model.MapValueType,
+	GeneratorNumberAttribute:       model.NumberValueType,
+	GeneratorObjectAttribute:       model.ObjectValueType,
+	GeneratorSetAttribute:          model.SetValueType,
+	GeneratorSetNestedAttribute:    model.SetValueType,
+	GeneratorSetNestedBlock:        model.SetValueType,
+	GeneratorSingleNestedAttribute: model.ObjectValueType,
+	GeneratorSingleNestedBlock:     model.ObjectValueType,
+	GeneratorStringAttribute:       model.StringValueType,
+}
+
+// StateUpgraders returns the code generated for upgrading state from the prior
+// versions of the named resource schema as a Go file in the package, comprising
+// the schema and data model of each prior version, an interface converting each
+// prior data model to the current data model, and a function returning the
+// state upgraders. Nil is returned if there are no prior versions.
+//
+// Prior schemas are rendered in the same way as the current schema, but without
+// custom types, and prior data models use framework types, such as types.List
+// and types.Object, in place of custom value types.
+func (g GeneratorSchema) StateUpgraders(name, packageName string) ([]byte, error) {
+	if g.StateUpgrade == nil || len(g.StateUpgrade.PriorVersions) == 0 {
+		return nil, nil
+	}
+
+	data := stateUpgradeData{
+		Name:        g.Options.ToPascalCase(FrameworkIdentifier(name)),
+		PackageName: packageName,
+		Version:     g.StateUpgrade.Version,
+	}
+
+	imports := NewImports()
+
+	for _, path := range []string{ContextImport, AttrImport, DiagImport, ResourceImport, ResourceSchemaImport, TypesImport} {
+		imports.Add(code.Import{
+			Path: path,
+		})
+	}
+
+	for _, p := range g.StateUpgrade.PriorVersions {
+		if p.Version >= g.StateUpgrade.Version {
+			return nil, fmt.Errorf("resource %q: prior schema version %d must be less than version %d", name, p.Version, g.StateUpgrade.Version)
+		}
+
+		attributes, err := p.Schema.Attributes.Schema()
+		if err != nil {
+			return nil, fmt.Errorf("resource %q schema version %d: %w", name, p.Version, err)
+		}
+
+		blocks, err := p.Schema.Blocks.Schema()
+		if err != nil {
+			return nil, fmt.Errorf("resource %q schema version %d: %w", name, p.Version, err)
+		}
+
+		imports.Append(p.Schema.Attributes.Imports())
+		imports.Append(p.Schema.Blocks.Imports())
+
+		data.PriorVersions = append(data.PriorVersions, priorVersionData{
+			Version:    p.Version,
+			Attributes: attributes,
+			Blocks:     blocks,
+			Fields:     p.Schema.priorFields(g.Options),
+		})
+	}
+
+	var sb strings.Builder
+
+	for _, i := range imports.All() {
+		var alias string
+
+		if i.Alias != nil {
+			alias = *i.Alias + " "
+		}
+
+		sb.WriteString(fmt.Sprintf("%s%q\n", alias, i.Path))
+	}
+
+	data.Imports = sb.String()
+
+	t, err := template.New("state_upgrade").Parse(g.Options.template("state_upgrade"))
+	if err != nil {
+		return nil, err
+	}
+
+	var buf bytes.Buffer
+
+	err = t.Execute(&buf, data)
+	if err != nil {
+		return nil, err
+	}
+
+	return format.RemoveUnusedImports(buf.Bytes())
+}
+
+// priorFields returns the fields of the data model of a prior schema. Field names
+// are cased with the options used for the current schema.
+func (g GeneratorSchema) priorFields(opts *Options) []string {
+	var fields []string
+
+	for _, k := range g.Attributes.SortedKeys() {
+		if g.Attributes[k] == nil {
+			continue
+		}
+
+		fields = append(fields, priorField(k, g.Attributes[k].GeneratorSchemaType(), opts))
+	}
+
+	for _, k := range g.Blocks.SortedKeys() {
+		if g.Blocks[k] == nil {
+			continue
+		}
+
+		fields = append(fields, priorField(k, g.Blocks[k].GeneratorSchemaType(), opts))
+	}
+
+	return fields
+}
+
+// priorField returns the field of the data model of a prior schema for the named
+// attribute or block.
+func priorField(name string, t Type, opts *Options) string {
+	return model.Field{
+		Name:      opts.ToPascalCase(FrameworkIdentifier(name)),
+		TfsdkName: name,
+		ValueType: priorValueTypes[t],
+	}.String()
+}
@@ -0,0 +1,164 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package schema
+
+// templates maps the names of the embedded templates, which are their file names
+// without the .gotmpl extension, to the variables holding them.
+var templates = map[string]*string{
+	"bool_from":                               &BoolFromTemplate,
+	"bool_to":                                 &BoolToTemplate,
+	"bool_type_equal":                         &BoolTypeEqualTemplate,
+	"bool_type_string":                        &BoolTypeStringTemplate,
+	"bool_type_typable":                       &BoolTypeTypableTemplate,
+	"bool_type_type":                          &BoolTypeTypeTemplate,
+	"bool_type_value_from_bool":               &BoolTypeValueFromBoolTemplate,
+	"bool_type_value_from_terraform":          &BoolTypeValueFromTerraformTemplate,
+	"bool_type_value_type":                    &BoolTypeValueTypeTemplate,
+	"bool_value_equal":                        &BoolValueEqualTemplate,
+	"bool_value_type":                         &BoolValueTypeTemplate,
+	"bool_value_valuable":                     &BoolValueValuableTemplate,
+	"bool_value_value":                        &BoolValueValueTemplate,
+	"crud":                                    &CRUDTemplate,
+	"float64_from":                            &Float64FromTemplate,
+	"float64_to":                              &Float64ToTemplate,
+	"float64_type_equal":                      &Float64TypeEqualTemplate,
+	"float64_type_string":                     &Float64TypeStringTemplate,
+	"float64_type_typable":                    &Float64TypeTypableTemplate,
+	"float64_type_type":                       &Float64TypeTypeTemplate,
+	"float64_type_value_from_float64":         &Float64TypeValueFromFloat64Template,
+	"float64_type_value_from_terraform":       &Float64TypeValueFromTerraformTemplate,
+	"float64_type_value_type":                 &Float64TypeValueTypeTemplate,
+	"float64_value_equal":                     &Float64ValueEqualTemplate,
+	"float64_value_type":                      &Float64ValueTypeTemplate,
+	"float64_value_valuable":                  &Float64ValueValuableTemplate,
+	"float64_value_value":                     &Float64ValueValueTemplate,
+	"int64_from":                              &Int64FromTemplate,
+	"int64_to":                                &Int64ToTemplate,
+	"int64_type_equal":                        &Int64TypeEqualTemplate,
+	"int64_type_string":                       &Int64TypeStringTemplate,
+	"int64_type_typable":                      &Int64TypeTypableTemplate,
+	"int64_type_type":                         &Int64TypeTypeTemplate,
+	"int64_type_value_from_int64":             &Int64TypeValueFromInt64Template,
+	"int64_type_value_from_terraform":         &Int64TypeValueFromTerraformTemplate,
+	"int64_type_value_type":                   &Int64TypeValueTypeTemplate,
+	"int64_value_equal":                       &Int64ValueEqualTemplate,
+	"int64_value_type":                        &Int64ValueTypeTemplate,
+	"int64_value_valuable":                    &Int64ValueValuableTemplate,
+	"int64_value_value":                       &Int64ValueValueTemplate,
+	"list_from":                               &ListFromTemplate,
+	"list_to":                                 &ListToTemplate,
+	"list_type_equal":                         &ListTypeEqualTemplate,
+	"list_type_string":                        &ListTypeStringTemplate,
+	"list_type_typable":                       &ListTypeTypableTemplate,
+	"list_type_type":                          &ListTypeTypeTemplate,
+	"list_type_value_from_list":               &ListTypeValueFromListTemplate,
+	"list_type_value_from_terraform":          &ListTypeValueFromTerraformTemplate,
+	"list_type_value_type":                    &ListTypeValueTypeTemplate,
+	"list_value_equal":                        &ListValueEqualTemplate,
+	"list_value_type":                         &ListValueTypeTemplate,
+	"list_value_valuable":                     &ListValueValuableTemplate,
+	"list_value_value":                        &ListValueValueTemplate,
+	"map_from":                                &MapFromTemplate,
+	"map_to":                                  &MapToTemplate,
+	"map_type_equal":                          &MapTypeEqualTemplate,
+	"map_type_string":                         &MapTypeStringTemplate,
+	"map_type_typable":                        &MapTypeTypableTemplate,
+	"map_type_type":                           &MapTypeTypeTemplate,
+	"map_type_value_from_map":                 &MapTypeValueFromMapTemplate,
+	"map_type_value_from_terraform":           &MapTypeValueFromTerraformTemplate,
+	"map_type_value_type":                     &MapTypeValueTypeTemplate,
+	"map_value_equal":                         &MapValueEqualTemplate,
+	"map_value_type":                          &MapValueTypeTemplate,
+	"map_value_valuable":                      &MapValueValuableTemplate,
+	"map_value_value":                         &MapValueValueTemplate,
+	"model_from":                              &ModelFromTemplate,
+	"model_to":                                &ModelToTemplate,
+	"model_to_from_test":                      &ModelToFromTestTemplate,
+	"nested_object_from":                      &NestedObjectFromTemplate,
+	"nested_object_to":                        &NestedObjectToTemplate,
+	"nested_object_type_equal":                &NestedObjectTypeEqualTemplate,
+	"nested_object_type_string":               &NestedObjectTypeStringTemplate,
+	"nested_object_type_typable":              &NestedObjectTypeTypableTemplate,
+	"nested_object_type_type":                 &NestedObjectTypeTypeTemplate,
+	"nested_object_type_value":                &NestedObjectTypeValueTemplate,
+	"nested_object_type_value_from_object":    &NestedObjectTypeValueFromObjectTemplate,
+	"nested_object_type_value_from_terraform": &NestedObjectTypeValueFromTerraformTemplate,
+	"nested_object_type_value_must":           &NestedObjectTypeValueMustTemplate,
+	"nested_object_type_value_null":           &NestedObjectTypeValueNullTemplate,
+	"nested_object_type_value_type":           &NestedObjectTypeValueTypeTemplate,
+	"nested_object_type_value_unknown":        &NestedObjectTypeValueUnknownTemplate,
+	"nested_object_value_attribute_types":     &NestedObjectValueAttributeTypesTemplate,
+	"nested_object_value_equal":               &NestedObjectValueEqualTemplate,
+	"nested_object_value_is_null":             &NestedObjectValueIsNullTemplate,
+	"nested_object_value_is_unknown":          &NestedObjectValueIsUnknownTemplate,
+	"nested_object_value_string":              &NestedObjectValueStringTemplate,
+	"nested_object_value_to_object_value":     &NestedObjectValueToObjectValueTemplate,
+	"nested_object_value_to_terraform_value":  &NestedObjectValueToTerraformValueTemplate,
+	"nested_object_value_type":                &NestedObjectValueTypeTemplate,
+	"nested_object_value_valuable":            &NestedObjectValueValuableTemplate,
+	"nested_object_value_value":               &NestedObjectValueValueTemplate,
+	"number_from":                             &NumberFromTemplate,
+	"number_to":                               &NumberToTemplate,
+	"number_type_equal":                       &NumberTypeEqualTemplate,
+	"number_type_string":                      &NumberTypeStringTemplate,
+	"number_type_typable":                     &NumberTypeTypableTemplate,
+	"number_type_type":                        &NumberTypeTypeTemplate,
+	"number_type_value_from_number":           &NumberTypeValueFromNumberTemplate,
+	"number_type_value_from_terraform":        &NumberTypeValueFromTerraformTemplate,
+	"number_type_value_type":                  &NumberTypeValueTypeTemplate,
+	"number_value_equal":                      &NumberValueEqualTemplate,
+	"number_value_type":                       &NumberValueTypeTemplate,
+	"number_value_valuable":                   &NumberValueValuableTemplate,
+	"number_value_value":                      &NumberValueValueTemplate,
+	"object_from":                             &ObjectFromTemplate,
+	"object_to":                               &ObjectToTemplate,
+	"object_type_equal":                       &ObjectTypeEqualTemplate,
+	"object_type_string":                      &ObjectTypeStringTemplate,
+	"object_type_typable":                     &ObjectTypeTypableTemplate,
+	"object_type_type":                        &ObjectTypeTypeTemplate,
+	"object_type_value_from_object":           &ObjectTypeValueFromObjectTemplate,
+	"object_type_value_from_terraform":        &ObjectTypeValueFromTerraformTemplate,
+	"object_type_value_type":                  &ObjectTypeValueTypeTemplate,
+	"object_value_attribute_types":            &ObjectValueAttributeTypesTemplate,
+	"object_value_equal":                      &ObjectValueEqualTemplate,
+	"object_value_type":                       &ObjectValueTypeTemplate,
+	"object_value_valuable":                   &ObjectValueValuableTemplate,
+	"object_value_value":                      &ObjectValueValueTemplate,
+	"schema":                                  &SchemaGoTemplate,
+	"set_from":                                &SetFromTemplate,
+	"set_to":                                  &SetToTemplate,
+	"set_type_equal":                          &SetTypeEqualTemplate,
+	"set_type_string":                         &SetTypeStringTemplate,
+	"set_type_typable":                        &SetTypeTypableTemplate,
+	"set_type_type":                           &SetTypeTypeTemplate,
+	"set_type_value_from_set":                 &SetTypeValueFromSetTemplate,
+	"set_type_value_from_terraform":           &SetTypeValueFromTerraformTemplate,
+	"set_type_value_type":                     &SetTypeValueTypeTemplate,
+	"set_value_equal":                         &SetValueEqualTemplate,
+	"set_value_type":                          &SetValueTypeTemplate,
+	"set_value_valuable":                      &SetValueValuableTemplate,
+	"set_value_value":                         &SetValueValueTemplate,
+	"shared_types":                            &SharedTypesGoTemplate,
+	"state_upgrade":                           &StateUpgradeTemplate,
+	"string_from":                             &StringFromTemplate,
+	"string_to":                               &StringToTemplate,
+	"string_type_equal":                       &StringTypeEqualTemplate,
+	"string_type_string":                      &StringTypeStringTemplate,
+	"string_type_typable":                     &StringTypeTypableTemplate,
+	"string_type_type":                        &StringTypeTypeTemplate,
+	"string_type_value_from_string":           &StringTypeValueFromStringTemplate,
+	"string_type_value_from_terraform":        &StringTypeValueFromTerraformTemplate,
+	"string_type_value_type":                  &StringTypeValueTypeTemplate,
+	"string_value_equal":                      &StringValueEqualTemplate,
+	"string_value_type":                       &StringValueTypeTemplate,
+	"string_value_valuable":                   &StringValueValuableTemplate,
+	"string_value_value":                      &StringValueValueTemplate,
+	"to_from_test":                            &ToFromTestTemplate,
+}
+
+// TemplateNames returns the sorted names of the templates used to generate code,
+// which can be overridden with Options.Templates.
+func TemplateNames() []string {
+	return sortedKeys(templates)
+}
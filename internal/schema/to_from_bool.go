@@ -12,18 +12,20 @@ type ToFromBool struct {
 	Name         FrameworkIdentifier
 	AssocExtType *AssocExtType
 	templates    map[string]string
+	options      *Options
 }
 
-func NewToFromBool(name string, assocExtType *AssocExtType) ToFromBool {
+func NewToFromBool(name string, assocExtType *AssocExtType, opts *Options) ToFromBool {
 	t := map[string]string{
-		"from": BoolFromTemplate,
-		"to":   BoolToTemplate,
+		"from": opts.template("bool_from"),
+		"to":   opts.template("bool_to"),
 	}
 
 	return ToFromBool{
 		Name:         FrameworkIdentifier(name),
 		AssocExtType: assocExtType,
 		templates:    t,
+		options:      opts,
 	}
 }
 
@@ -63,7 +65,7 @@ func (o ToFromBool) renderTo() ([]byte, error) {
 		Name         string
 		AssocExtType *AssocExtType
 	}{
-		Name:         o.Name.ToPascalCase(),
+		Name:         o.options.ToPascalCase(o.Name),
 		AssocExtType: o.AssocExtType,
 	})
 
@@ -87,7 +89,7 @@ func (o ToFromBool) renderFrom() ([]byte, error) {
 		Name         string
 		AssocExtType *AssocExtType
 	}{
-		Name:         o.Name.ToPascalCase(),
+		Name:         o.options.ToPascalCase(o.Name),
 		AssocExtType: o.AssocExtType,
 	})
 
@@ -23,7 +23,7 @@ func TestToFromBool_renderFrom(t *testing.T) {
 		"default": {
 			name: "Example",
 			assocExtType: &AssocExtType{
-				&schema.AssociatedExternalType{
+				AssociatedExternalType: &schema.AssociatedExternalType{
 					Import: &code.Import{
 						Path: "example.com/apisdk",
 					},
@@ -53,7 +53,7 @@ types.BoolPointerValue(*apiObject),
 		t.Run(name, func(t *testing.T) {
 			t.Parallel()
 
-			toFromBool := NewToFromBool(testCase.name, testCase.assocExtType)
+			toFromBool := NewToFromBool(testCase.name, testCase.assocExtType, nil)
 
 			got, err := toFromBool.renderFrom()
 
@@ -80,7 +80,7 @@ func TestToFromBool_renderTo(t *testing.T) {
 		"default": {
 			name: "Example",
 			assocExtType: &AssocExtType{
-				&schema.AssociatedExternalType{
+				AssociatedExternalType: &schema.AssociatedExternalType{
 					Import: &code.Import{
 						Path: "example.com/apisdk",
 					},
@@ -115,7 +115,7 @@ return &a, diags
 		t.Run(name, func(t *testing.T) {
 			t.Parallel()
 
-			toFromBool := NewToFromBool(testCase.name, testCase.assocExtType)
+			toFromBool := NewToFromBool(testCase.name, testCase.assocExtType, nil)
 
 			got, err := toFromBool.renderTo()
 
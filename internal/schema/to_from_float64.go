@@ -12,18 +12,20 @@ type ToFromFloat64 struct {
 	Name         FrameworkIdentifier
 	AssocExtType *AssocExtType
 	templates    map[string]string
+	options      *Options
 }
 
-func NewToFromFloat64(name string, assocExtType *AssocExtType) ToFromFloat64 {
+func NewToFromFloat64(name string, assocExtType *AssocExtType, opts *Options) ToFromFloat64 {
 	t := map[string]string{
-		"from": Float64FromTemplate,
-		"to":   Float64ToTemplate,
+		"from": opts.template("float64_from"),
+		"to":   opts.template("float64_to"),
 	}
 
 	return ToFromFloat64{
 		Name:         FrameworkIdentifier(name),
 		AssocExtType: assocExtType,
 		templates:    t,
+		options:      opts,
 	}
 }
 
@@ -63,7 +65,7 @@ func (o ToFromFloat64) renderTo() ([]byte, error) {
 		Name         string
 		AssocExtType *AssocExtType
 	}{
-		Name:         o.Name.ToPascalCase(),
+		Name:         o.options.ToPascalCase(o.Name),
 		AssocExtType: o.AssocExtType,
 	})
 
@@ -87,7 +89,7 @@ func (o ToFromFloat64) renderFrom() ([]byte, error) {
 		Name         string
 		AssocExtType *AssocExtType
 	}{
-		Name:         o.Name.ToPascalCase(),
+		Name:         o.options.ToPascalCase(o.Name),
 		AssocExtType: o.AssocExtType,
 	})
 
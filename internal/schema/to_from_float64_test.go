@@ -23,7 +23,7 @@ func TestToFromFloat64_renderFrom(t *testing.T) {
 		"default": {
 			name: "Example",
 			assocExtType: &AssocExtType{
-				&schema.AssociatedExternalType{
+				AssociatedExternalType: &schema.AssociatedExternalType{
 					Import: &code.Import{
 						Path: "example.com/apisdk",
 					},
@@ -53,7 +53,7 @@ types.Float64PointerValue(*apiObject),
 		t.Run(name, func(t *testing.T) {
 			t.Parallel()
 
-			toFromFloat64 := NewToFromFloat64(testCase.name, testCase.assocExtType)
+			toFromFloat64 := NewToFromFloat64(testCase.name, testCase.assocExtType, nil)
 
 			got, err := toFromFloat64.renderFrom()
 
@@ -80,7 +80,7 @@ func TestToFromFloat64_renderTo(t *testing.T) {
 		"default": {
 			name: "Example",
 			assocExtType: &AssocExtType{
-				&schema.AssociatedExternalType{
+				AssociatedExternalType: &schema.AssociatedExternalType{
 					Import: &code.Import{
 						Path: "example.com/apisdk",
 					},
@@ -115,7 +115,7 @@ return &a, diags
 		t.Run(name, func(t *testing.T) {
 			t.Parallel()
 
-			toFromFloat64 := NewToFromFloat64(testCase.name, testCase.assocExtType)
+			toFromFloat64 := NewToFromFloat64(testCase.name, testCase.assocExtType, nil)
 
 			got, err := toFromFloat64.renderTo()
 
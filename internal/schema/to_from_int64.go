@@ -12,18 +12,20 @@ type ToFromInt64 struct {
 	Name         FrameworkIdentifier
 	AssocExtType *AssocExtType
 	templates    map[string]string
+	options      *Options
 }
 
-func NewToFromInt64(name string, assocExtType *AssocExtType) ToFromInt64 {
+func NewToFromInt64(name string, assocExtType *AssocExtType, opts *Options) ToFromInt64 {
 	t := map[string]string{
-		"from": Int64FromTemplate,
-		"to":   Int64ToTemplate,
+		"from": opts.template("int64_from"),
+		"to":   opts.template("int64_to"),
 	}
 
 	return ToFromInt64{
 		Name:         FrameworkIdentifier(name),
 		AssocExtType: assocExtType,
 		templates:    t,
+		options:      opts,
 	}
 }
 
@@ -63,7 +65,7 @@ func (o ToFromInt64) renderTo() ([]byte, error) {
 		Name         string
 		AssocExtType *AssocExtType
 	}{
-		Name:         o.Name.ToPascalCase(),
+		Name:         o.options.ToPascalCase(o.Name),
 		AssocExtType: o.AssocExtType,
 	})
 
@@ -87,7 +89,7 @@ func (o ToFromInt64) renderFrom() ([]byte, error) {
 		Name         string
 		AssocExtType *AssocExtType
 	}{
-		Name:         o.Name.ToPascalCase(),
+		Name:         o.options.ToPascalCase(o.Name),
 		AssocExtType: o.AssocExtType,
 	})
 
@@ -23,7 +23,7 @@ func TestToFromInt64_renderFrom(t *testing.T) {
 		"default": {
 			name: "Example",
 			assocExtType: &AssocExtType{
-				&schema.AssociatedExternalType{
+				AssociatedExternalType: &schema.AssociatedExternalType{
 					Import: &code.Import{
 						Path: "example.com/apisdk",
 					},
@@ -53,7 +53,7 @@ types.Int64PointerValue(*apiObject),
 		t.Run(name, func(t *testing.T) {
 			t.Parallel()
 
-			toFromInt64 := NewToFromInt64(testCase.name, testCase.assocExtType)
+			toFromInt64 := NewToFromInt64(testCase.name, testCase.assocExtType, nil)
 
 			got, err := toFromInt64.renderFrom()
 
@@ -80,7 +80,7 @@ func TestToFromInt64_renderTo(t *testing.T) {
 		"default": {
 			name: "Example",
 			assocExtType: &AssocExtType{
-				&schema.AssociatedExternalType{
+				AssociatedExternalType: &schema.AssociatedExternalType{
 					Import: &code.Import{
 						Path: "example.com/apisdk",
 					},
@@ -115,7 +115,7 @@ return &a, diags
 		t.Run(name, func(t *testing.T) {
 			t.Parallel()
 
-			toFromInt64 := NewToFromInt64(testCase.name, testCase.assocExtType)
+			toFromInt64 := NewToFromInt64(testCase.name, testCase.assocExtType, nil)
 
 			got, err := toFromInt64.renderTo()
 
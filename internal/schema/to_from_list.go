@@ -15,12 +15,13 @@ type ToFromList struct {
 	ElementTypeValue string
 	ElementFrom      string
 	templates        map[string]string
+	options          *Options
 }
 
-func NewToFromList(name string, assocExtType *AssocExtType, elemTypeType, elemTypeValue, elemFrom string) ToFromList {
+func NewToFromList(name string, assocExtType *AssocExtType, elemTypeType, elemTypeValue, elemFrom string, opts *Options) ToFromList {
 	t := map[string]string{
-		"from": ListFromTemplate,
-		"to":   ListToTemplate,
+		"from": opts.template("list_from"),
+		"to":   opts.template("list_to"),
 	}
 
 	return ToFromList{
@@ -30,6 +31,7 @@ func NewToFromList(name string, assocExtType *AssocExtType, elemTypeType, elemTy
 		ElementTypeValue: elemTypeValue,
 		ElementFrom:      elemFrom,
 		templates:        t,
+		options:          opts,
 	}
 }
 
@@ -69,7 +71,7 @@ func (o ToFromList) renderTo() ([]byte, error) {
 		Name         string
 		AssocExtType *AssocExtType
 	}{
-		Name:         o.Name.ToPascalCase(),
+		Name:         o.options.ToPascalCase(o.Name),
 		AssocExtType: o.AssocExtType,
 	})
 
@@ -96,7 +98,7 @@ func (o ToFromList) renderFrom() ([]byte, error) {
 		ElementTypeValue string
 		ElementFrom      string
 	}{
-		Name:             o.Name.ToPascalCase(),
+		Name:             o.options.ToPascalCase(o.Name),
 		AssocExtType:     o.AssocExtType,
 		ElementTypeType:  o.ElementTypeType,
 		ElementTypeValue: o.ElementTypeValue,
@@ -15,12 +15,13 @@ type ToFromMap struct {
 	ElementTypeValue string
 	ElementFrom      string
 	templates        map[string]string
+	options          *Options
 }
 
-func NewToFromMap(name string, assocExtType *AssocExtType, elemTypeType, elemTypeValue, elemFrom string) ToFromMap {
+func NewToFromMap(name string, assocExtType *AssocExtType, elemTypeType, elemTypeValue, elemFrom string, opts *Options) ToFromMap {
 	t := map[string]string{
-		"from": MapFromTemplate,
-		"to":   MapToTemplate,
+		"from": opts.template("map_from"),
+		"to":   opts.template("map_to"),
 	}
 
 	return ToFromMap{
@@ -30,6 +31,7 @@ func NewToFromMap(name string, assocExtType *AssocExtType, elemTypeType, elemTyp
 		ElementTypeValue: elemTypeValue,
 		ElementFrom:      elemFrom,
 		templates:        t,
+		options:          opts,
 	}
 }
 
@@ -69,7 +71,7 @@ func (o ToFromMap) renderTo() ([]byte, error) {
 		Name         string
 		AssocExtType *AssocExtType
 	}{
-		Name:         o.Name.ToPascalCase(),
+		Name:         o.options.ToPascalCase(o.Name),
 		AssocExtType: o.AssocExtType,
 	})
 
@@ -96,7 +98,7 @@ func (o ToFromMap) renderFrom() ([]byte, error) {
 		ElementTypeValue string
 		ElementFrom      string
 	}{
-		Name:             o.Name.ToPascalCase(),
+		Name:             o.options.ToPascalCase(o.Name),
 		AssocExtType:     o.AssocExtType,
 		ElementTypeType:  o.ElementTypeType,
 		ElementTypeValue: o.ElementTypeValue,
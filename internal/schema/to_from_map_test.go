@@ -26,7 +26,7 @@ func TestToFromMap_renderFrom(t *testing.T) {
 		"default": {
 			name: "Example",
 			assocExtType: &AssocExtType{
-				&schema.AssociatedExternalType{
+				AssociatedExternalType: &schema.AssociatedExternalType{
 					Import: &code.Import{
 						Path: "example.com/apisdk",
 					},
@@ -75,7 +75,7 @@ l,
 		t.Run(name, func(t *testing.T) {
 			t.Parallel()
 
-			toFromMap := NewToFromMap(testCase.name, testCase.assocExtType, testCase.elemTypeType, testCase.elemTypeValue, testCase.elemFrom)
+			toFromMap := NewToFromMap(testCase.name, testCase.assocExtType, testCase.elemTypeType, testCase.elemTypeValue, testCase.elemFrom, nil)
 
 			got, err := toFromMap.renderFrom()
 
@@ -105,7 +105,7 @@ func TestToFromMap_renderTo(t *testing.T) {
 		"default": {
 			name: "Example",
 			assocExtType: &AssocExtType{
-				&schema.AssociatedExternalType{
+				AssociatedExternalType: &schema.AssociatedExternalType{
 					Import: &code.Import{
 						Path: "example.com/apisdk",
 					},
@@ -151,7 +151,7 @@ return &apisdkType, diags
 		t.Run(name, func(t *testing.T) {
 			t.Parallel()
 
-			toFromMap := NewToFromMap(testCase.name, testCase.assocExtType, testCase.elemTypeType, testCase.elemTypeValue, testCase.elemFrom)
+			toFromMap := NewToFromMap(testCase.name, testCase.assocExtType, testCase.elemTypeType, testCase.elemTypeValue, testCase.elemFrom, nil)
 
 			got, err := toFromMap.renderTo()
 
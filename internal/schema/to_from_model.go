@@ -0,0 +1,126 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package schema
+
+import (
+	"bytes"
+	"text/template"
+)
+
+// ToFromModel generates code for converting between a top-level data model
+// (e.g., ExampleModel) and its associated external type, composed from the
+// conversion functions of the attributes and blocks within the model.
+type ToFromModel struct {
+	Name         FrameworkIdentifier
+	AssocExtType *AssocExtType
+	ToFuncs      map[FrameworkIdentifier]ToFromConversion
+	FromFuncs    map[FrameworkIdentifier]ToFromConversion
+	templates    map[string]string
+	options      *Options
+}
+
+func NewToFromModel(name string, assocExtType *AssocExtType, toFuncs, fromFuncs map[string]ToFromConversion, opts *Options) ToFromModel {
+	t := map[string]string{
+		"from": opts.template("model_from"),
+		"to":   opts.template("model_to"),
+	}
+
+	tf := make(map[FrameworkIdentifier]ToFromConversion, len(toFuncs))
+
+	for k, v := range toFuncs {
+		tf[FrameworkIdentifier(k)] = v
+	}
+
+	ff := make(map[FrameworkIdentifier]ToFromConversion, len(fromFuncs))
+
+	for k, v := range fromFuncs {
+		ff[FrameworkIdentifier(k)] = v
+	}
+
+	return ToFromModel{
+		Name:         FrameworkIdentifier(name),
+		AssocExtType: assocExtType,
+		FromFuncs:    ff,
+		ToFuncs:      tf,
+		templates:    t,
+		options:      opts,
+	}
+}
+
+func (o ToFromModel) Render() ([]byte, error) {
+	var buf bytes.Buffer
+
+	renderFuncs := []func() ([]byte, error){
+		o.renderTo,
+		o.renderFrom,
+	}
+
+	for _, f := range renderFuncs {
+		b, err := f()
+
+		if err != nil {
+			return nil, err
+		}
+
+		buf.Write([]byte("\n"))
+
+		buf.Write(b)
+	}
+
+	return buf.Bytes(), nil
+}
+
+func (o ToFromModel) renderTo() ([]byte, error) {
+	var buf bytes.Buffer
+
+	t, err := template.New("").Parse(o.templates["to"])
+
+	if err != nil {
+		return nil, err
+	}
+
+	err = t.Execute(&buf, struct {
+		Name         string
+		AssocExtType *AssocExtType
+		ToFuncs      map[Identifier]toFromConversionData
+	}{
+		Name:         o.options.ToPascalCase(o.Name),
+		AssocExtType: o.AssocExtType,
+		ToFuncs:      toFromConversionsData(o.options, o.ToFuncs),
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+func (o ToFromModel) renderFrom() ([]byte, error) {
+	var buf bytes.Buffer
+
+	t, err := template.New("").Parse(o.templates["from"])
+
+	if err != nil {
+		return nil, err
+	}
+
+	err = t.Execute(&buf, struct {
+		Name         string
+		AssocExtType *AssocExtType
+		FromFuncs    map[Identifier]toFromConversionData
+		TypeNames    map[Identifier]string
+	}{
+		Name:         o.options.ToPascalCase(o.Name),
+		AssocExtType: o.AssocExtType,
+		FromFuncs:    toFromConversionsData(o.options, o.FromFuncs),
+		TypeNames:    nestedTypeNames(o.options, "", o.FromFuncs),
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
@@ -0,0 +1,379 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package schema
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-codegen-spec/schema"
+)
+
+func TestToFromModel_renderFrom(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		name          string
+		assocExtType  *AssocExtType
+		fromFuncs     map[string]ToFromConversion
+		expected      []byte
+		expectedError error
+	}{
+		"default": {
+			name: "Example",
+			assocExtType: &AssocExtType{
+				AssociatedExternalType: &schema.AssociatedExternalType{
+					Type: "*apisdk.Example",
+				},
+			},
+			fromFuncs: map[string]ToFromConversion{
+				"bool_attribute": {
+					Default: "BoolPointerValue",
+				},
+			},
+			expected: []byte(`func ExampleModelFromApisdkExample(ctx context.Context, apiObject *apisdk.Example) (ExampleModel, diag.Diagnostics) {
+var diags diag.Diagnostics
+
+if apiObject == nil {
+diags.Append(diag.NewErrorDiagnostic(
+"ExampleModel From ApisdkExample Is Nil",
+` + "`" + `"*apisdk.Example" is nil.` + "`" + `,
+))
+
+return ExampleModel{}, diags
+}
+
+return ExampleModel{
+BoolAttribute: types.BoolPointerValue(apiObject.BoolAttribute),
+}, diags
+}
+`),
+		},
+		"assoc-ext-type": {
+			name: "Example",
+			assocExtType: &AssocExtType{
+				AssociatedExternalType: &schema.AssociatedExternalType{
+					Type: "*apisdk.Example",
+				},
+			},
+			fromFuncs: map[string]ToFromConversion{
+				"nested_attribute": {
+					AssocExtType: &AssocExtType{
+						AssociatedExternalType: &schema.AssociatedExternalType{
+							Type: "*apisdk.Nested",
+						},
+					},
+				},
+			},
+			expected: []byte(`func ExampleModelFromApisdkExample(ctx context.Context, apiObject *apisdk.Example) (ExampleModel, diag.Diagnostics) {
+var diags diag.Diagnostics
+
+if apiObject == nil {
+diags.Append(diag.NewErrorDiagnostic(
+"ExampleModel From ApisdkExample Is Nil",
+` + "`" + `"*apisdk.Example" is nil.` + "`" + `,
+))
+
+return ExampleModel{}, diags
+}
+
+nestedAttributeVal, d := NestedAttributeValue{}.FromApisdkNested(ctx, apiObject.NestedAttribute)
+
+diags.Append(d...)
+
+if diags.HasError() {
+return ExampleModel{}, diags
+}
+
+return ExampleModel{
+NestedAttribute: nestedAttributeVal,
+}, diags
+}
+`),
+		},
+		"collection-type": {
+			name: "Example",
+			assocExtType: &AssocExtType{
+				AssociatedExternalType: &schema.AssociatedExternalType{
+					Type: "*apisdk.Example",
+				},
+			},
+			fromFuncs: map[string]ToFromConversion{
+				"list_attribute": {
+					CollectionType: CollectionFields{
+						ElementType:   "types.BoolType",
+						TypeValueFrom: "types.ListValueFrom",
+					},
+				},
+			},
+			expected: []byte(`func ExampleModelFromApisdkExample(ctx context.Context, apiObject *apisdk.Example) (ExampleModel, diag.Diagnostics) {
+var diags diag.Diagnostics
+
+if apiObject == nil {
+diags.Append(diag.NewErrorDiagnostic(
+"ExampleModel From ApisdkExample Is Nil",
+` + "`" + `"*apisdk.Example" is nil.` + "`" + `,
+))
+
+return ExampleModel{}, diags
+}
+
+listAttributeVal, d := types.ListValueFrom(ctx, types.BoolType, apiObject.ListAttribute)
+
+diags.Append(d...)
+
+if diags.HasError() {
+return ExampleModel{}, diags
+}
+
+return ExampleModel{
+ListAttribute: listAttributeVal,
+}, diags
+}
+`),
+		},
+		"object-type": {
+			name: "Example",
+			assocExtType: &AssocExtType{
+				AssociatedExternalType: &schema.AssociatedExternalType{
+					Type: "*apisdk.Example",
+				},
+			},
+			fromFuncs: map[string]ToFromConversion{
+				"object_attribute": {
+					ObjectType: map[FrameworkIdentifier]ObjectField{
+						"string_attribute": {
+							GoType:   "*string",
+							Type:     "types.StringType",
+							FromFunc: "StringPointerValue",
+						},
+					},
+				},
+			},
+			expected: []byte(`func ExampleModelFromApisdkExample(ctx context.Context, apiObject *apisdk.Example) (ExampleModel, diag.Diagnostics) {
+var diags diag.Diagnostics
+
+if apiObject == nil {
+diags.Append(diag.NewErrorDiagnostic(
+"ExampleModel From ApisdkExample Is Nil",
+` + "`" + `"*apisdk.Example" is nil.` + "`" + `,
+))
+
+return ExampleModel{}, diags
+}
+
+objectAttributeAttributeTypes := map[string]attr.Type{
+"string_attribute": types.StringType,
+}
+
+objectAttributeVal := types.ObjectNull(objectAttributeAttributeTypes)
+
+if apiObject.ObjectAttribute != (struct {
+StringAttribute *string
+}{}) {
+o, d := basetypes.NewObjectValue(objectAttributeAttributeTypes, map[string]attr.Value{
+"string_attribute": types.StringPointerValue(apiObject.ObjectAttribute.StringAttribute),
+})
+
+diags.Append(d...)
+
+if diags.HasError() {
+return ExampleModel{}, diags
+}
+
+objectAttributeVal = o
+}
+
+return ExampleModel{
+ObjectAttribute: objectAttributeVal,
+}, diags
+}
+`),
+		},
+	}
+
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			toFromModel := NewToFromModel(testCase.name, testCase.assocExtType, nil, testCase.fromFuncs, nil)
+
+			got, err := toFromModel.renderFrom()
+
+			if diff := cmp.Diff(err, testCase.expectedError, equateErrorMessage); diff != "" {
+				t.Errorf("unexpected error: %s", diff)
+			}
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestToFromModel_renderTo(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		name          string
+		assocExtType  *AssocExtType
+		toFuncs       map[string]ToFromConversion
+		expected      []byte
+		expectedError error
+	}{
+		"default": {
+			name: "Example",
+			assocExtType: &AssocExtType{
+				AssociatedExternalType: &schema.AssociatedExternalType{
+					Type: "*apisdk.Example",
+				},
+			},
+			toFuncs: map[string]ToFromConversion{
+				"bool_attribute": {
+					Default: "ValueBoolPointer",
+				},
+			},
+			expected: []byte(`func (m ExampleModel) ToApisdkExample(ctx context.Context) (*apisdk.Example, diag.Diagnostics) {
+var diags diag.Diagnostics
+
+return &apisdk.Example{
+BoolAttribute: m.BoolAttribute.ValueBoolPointer(),
+}, diags
+}
+`),
+		},
+		"assoc-ext-type": {
+			name: "Example",
+			assocExtType: &AssocExtType{
+				AssociatedExternalType: &schema.AssociatedExternalType{
+					Type: "*apisdk.Example",
+				},
+			},
+			toFuncs: map[string]ToFromConversion{
+				"nested_attribute": {
+					AssocExtType: &AssocExtType{
+						AssociatedExternalType: &schema.AssociatedExternalType{
+							Type: "*apisdk.Nested",
+						},
+					},
+				},
+			},
+			expected: []byte(`func (m ExampleModel) ToApisdkExample(ctx context.Context) (*apisdk.Example, diag.Diagnostics) {
+var diags diag.Diagnostics
+
+nestedAttributeField, d := m.NestedAttribute.ToApisdkNested(ctx)
+
+diags.Append(d...)
+
+if diags.HasError() {
+return nil, diags
+}
+
+return &apisdk.Example{
+NestedAttribute: nestedAttributeField,
+}, diags
+}
+`),
+		},
+		"collection-type": {
+			name: "Example",
+			assocExtType: &AssocExtType{
+				AssociatedExternalType: &schema.AssociatedExternalType{
+					Type: "*apisdk.Example",
+				},
+			},
+			toFuncs: map[string]ToFromConversion{
+				"list_attribute": {
+					CollectionType: CollectionFields{
+						GoType: "[]*bool",
+					},
+				},
+			},
+			expected: []byte(`func (m ExampleModel) ToApisdkExample(ctx context.Context) (*apisdk.Example, diag.Diagnostics) {
+var diags diag.Diagnostics
+
+var listAttributeField []*bool
+
+diags.Append(m.ListAttribute.ElementsAs(ctx, &listAttributeField, false)...)
+
+if diags.HasError() {
+return nil, diags
+}
+
+return &apisdk.Example{
+ListAttribute: listAttributeField,
+}, diags
+}
+`),
+		},
+		"object-type": {
+			name: "Example",
+			assocExtType: &AssocExtType{
+				AssociatedExternalType: &schema.AssociatedExternalType{
+					Type: "*apisdk.Example",
+				},
+			},
+			toFuncs: map[string]ToFromConversion{
+				"object_attribute": {
+					ObjectType: map[FrameworkIdentifier]ObjectField{
+						"string_attribute": {
+							GoType: "*string",
+							Type:   "types.String",
+							ToFunc: "ValueStringPointer",
+						},
+					},
+				},
+			},
+			expected: []byte(`func (m ExampleModel) ToApisdkExample(ctx context.Context) (*apisdk.Example, diag.Diagnostics) {
+var diags diag.Diagnostics
+
+var objectAttributeField struct {
+StringAttribute *string
+}
+
+if !m.ObjectAttribute.IsNull() && !m.ObjectAttribute.IsUnknown() {
+objectAttributeAttributes := m.ObjectAttribute.Attributes()
+
+objectAttributeFieldStringAttribute, ok := objectAttributeAttributes["string_attribute"].(types.String)
+
+if !ok {
+diags.Append(diag.NewErrorDiagnostic(
+"ObjectAttribute Field string_attribute Is Wrong Type",
+fmt.Sprintf(` + "`" + `ObjectAttribute field string_attribute expected to be types.String, was: %T` + "`" + `, objectAttributeAttributes["string_attribute"]),
+))
+
+return nil, diags
+}
+
+objectAttributeField.StringAttribute = objectAttributeFieldStringAttribute.ValueStringPointer()
+}
+
+return &apisdk.Example{
+ObjectAttribute: objectAttributeField,
+}, diags
+}
+`),
+		},
+	}
+
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			toFromModel := NewToFromModel(testCase.name, testCase.assocExtType, testCase.toFuncs, nil, nil)
+
+			got, err := toFromModel.renderTo()
+
+			if diff := cmp.Diff(err, testCase.expectedError, equateErrorMessage); diff != "" {
+				t.Errorf("unexpected error: %s", diff)
+			}
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
@@ -14,12 +14,13 @@ type ToFromNestedObject struct {
 	ToFuncs      map[FrameworkIdentifier]ToFromConversion
 	FromFuncs    map[FrameworkIdentifier]ToFromConversion
 	templates    map[string]string
+	options      *Options
 }
 
-func NewToFromNestedObject(name string, assocExtType *AssocExtType, toFuncs, fromFuncs map[string]ToFromConversion) ToFromNestedObject {
+func NewToFromNestedObject(name string, assocExtType *AssocExtType, toFuncs, fromFuncs map[string]ToFromConversion, opts *Options) ToFromNestedObject {
 	t := map[string]string{
-		"from": NestedObjectFromTemplate,
-		"to":   NestedObjectToTemplate,
+		"from": opts.template("nested_object_from"),
+		"to":   opts.template("nested_object_to"),
 	}
 
 	tf := make(map[FrameworkIdentifier]ToFromConversion, len(toFuncs))
@@ -40,6 +41,7 @@ func NewToFromNestedObject(name string, assocExtType *AssocExtType, toFuncs, fro
 		FromFuncs:    ff,
 		ToFuncs:      tf,
 		templates:    t,
+		options:      opts,
 	}
 }
 
@@ -78,11 +80,11 @@ func (o ToFromNestedObject) renderTo() ([]byte, error) {
 	err = t.Execute(&buf, struct {
 		Name         string
 		AssocExtType *AssocExtType
-		ToFuncs      map[FrameworkIdentifier]ToFromConversion
+		ToFuncs      map[Identifier]toFromConversionData
 	}{
-		Name:         o.Name.ToPascalCase(),
+		Name:         o.options.ToPascalCase(o.Name),
 		AssocExtType: o.AssocExtType,
-		ToFuncs:      o.ToFuncs,
+		ToFuncs:      toFromConversionsData(o.options, o.ToFuncs),
 	})
 
 	if err != nil {
@@ -104,11 +106,13 @@ func (o ToFromNestedObject) renderFrom() ([]byte, error) {
 	err = t.Execute(&buf, struct {
 		Name         string
 		AssocExtType *AssocExtType
-		FromFuncs    map[FrameworkIdentifier]ToFromConversion
+		FromFuncs    map[Identifier]toFromConversionData
+		TypeNames    map[Identifier]string
 	}{
-		Name:         o.Name.ToPascalCase(),
+		Name:         o.options.ToPascalCase(o.Name),
 		AssocExtType: o.AssocExtType,
-		FromFuncs:    o.FromFuncs,
+		FromFuncs:    toFromConversionsData(o.options, o.FromFuncs),
+		TypeNames:    nestedTypeNames(o.options, o.Name, o.FromFuncs),
 	})
 
 	if err != nil {
@@ -12,18 +12,20 @@ type ToFromNumber struct {
 	Name         FrameworkIdentifier
 	AssocExtType *AssocExtType
 	templates    map[string]string
+	options      *Options
 }
 
-func NewToFromNumber(name string, assocExtType *AssocExtType) ToFromNumber {
+func NewToFromNumber(name string, assocExtType *AssocExtType, opts *Options) ToFromNumber {
 	t := map[string]string{
-		"from": NumberFromTemplate,
-		"to":   NumberToTemplate,
+		"from": opts.template("number_from"),
+		"to":   opts.template("number_to"),
 	}
 
 	return ToFromNumber{
 		Name:         FrameworkIdentifier(name),
 		AssocExtType: assocExtType,
 		templates:    t,
+		options:      opts,
 	}
 }
 
@@ -63,7 +65,7 @@ func (o ToFromNumber) renderTo() ([]byte, error) {
 		Name         string
 		AssocExtType *AssocExtType
 	}{
-		Name:         o.Name.ToPascalCase(),
+		Name:         o.options.ToPascalCase(o.Name),
 		AssocExtType: o.AssocExtType,
 	})
 
@@ -87,7 +89,7 @@ func (o ToFromNumber) renderFrom() ([]byte, error) {
 		Name         string
 		AssocExtType *AssocExtType
 	}{
-		Name:         o.Name.ToPascalCase(),
+		Name:         o.options.ToPascalCase(o.Name),
 		AssocExtType: o.AssocExtType,
 	})
 
@@ -14,12 +14,13 @@ type ToFromObject struct {
 	AttrTypesToFuncs   map[FrameworkIdentifier]AttrTypesToFuncs
 	AttrTypesFromFuncs map[FrameworkIdentifier]string
 	templates          map[string]string
+	options            *Options
 }
 
-func NewToFromObject(name string, assocExtType *AssocExtType, attrTypesToFuncs map[string]AttrTypesToFuncs, attrTypesFromFuncs map[string]string) ToFromObject {
+func NewToFromObject(name string, assocExtType *AssocExtType, attrTypesToFuncs map[string]AttrTypesToFuncs, attrTypesFromFuncs map[string]string, opts *Options) ToFromObject {
 	t := map[string]string{
-		"from": ObjectFromTemplate,
-		"to":   ObjectToTemplate,
+		"from": opts.template("object_from"),
+		"to":   opts.template("object_to"),
 	}
 
 	attf := make(map[FrameworkIdentifier]AttrTypesToFuncs, len(attrTypesToFuncs))
@@ -40,6 +41,7 @@ func NewToFromObject(name string, assocExtType *AssocExtType, attrTypesToFuncs m
 		AttrTypesToFuncs:   attf,
 		AttrTypesFromFuncs: atff,
 		templates:          t,
+		options:            opts,
 	}
 }
 
@@ -78,11 +80,11 @@ func (o ToFromObject) renderTo() ([]byte, error) {
 	err = t.Execute(&buf, struct {
 		Name             string
 		AssocExtType     *AssocExtType
-		AttrTypesToFuncs map[FrameworkIdentifier]AttrTypesToFuncs
+		AttrTypesToFuncs map[Identifier]AttrTypesToFuncs
 	}{
-		Name:             o.Name.ToPascalCase(),
+		Name:             o.options.ToPascalCase(o.Name),
 		AssocExtType:     o.AssocExtType,
-		AttrTypesToFuncs: o.AttrTypesToFuncs,
+		AttrTypesToFuncs: identifiers(o.options, o.AttrTypesToFuncs),
 	})
 
 	if err != nil {
@@ -104,11 +106,11 @@ func (o ToFromObject) renderFrom() ([]byte, error) {
 	err = t.Execute(&buf, struct {
 		Name               string
 		AssocExtType       *AssocExtType
-		AttrTypesFromFuncs map[FrameworkIdentifier]string
+		AttrTypesFromFuncs map[Identifier]string
 	}{
-		Name:               o.Name.ToPascalCase(),
+		Name:               o.options.ToPascalCase(o.Name),
 		AssocExtType:       o.AssocExtType,
-		AttrTypesFromFuncs: o.AttrTypesFromFuncs,
+		AttrTypesFromFuncs: identifiers(o.options, o.AttrTypesFromFuncs),
 	})
 
 	if err != nil {
@@ -15,12 +15,13 @@ type ToFromSet struct {
 	ElementTypeValue string
 	ElementFrom      string
 	templates        map[string]string
+	options          *Options
 }
 
-func NewToFromSet(name string, assocExtType *AssocExtType, elemTypeType, elemTypeValue, elemFrom string) ToFromSet {
+func NewToFromSet(name string, assocExtType *AssocExtType, elemTypeType, elemTypeValue, elemFrom string, opts *Options) ToFromSet {
 	t := map[string]string{
-		"from": SetFromTemplate,
-		"to":   SetToTemplate,
+		"from": opts.template("set_from"),
+		"to":   opts.template("set_to"),
 	}
 
 	return ToFromSet{
@@ -30,6 +31,7 @@ func NewToFromSet(name string, assocExtType *AssocExtType, elemTypeType, elemTyp
 		ElementTypeValue: elemTypeValue,
 		ElementFrom:      elemFrom,
 		templates:        t,
+		options:          opts,
 	}
 }
 
@@ -69,7 +71,7 @@ func (o ToFromSet) renderTo() ([]byte, error) {
 		Name         string
 		AssocExtType *AssocExtType
 	}{
-		Name:         o.Name.ToPascalCase(),
+		Name:         o.options.ToPascalCase(o.Name),
 		AssocExtType: o.AssocExtType,
 	})
 
@@ -96,7 +98,7 @@ func (o ToFromSet) renderFrom() ([]byte, error) {
 		ElementTypeValue string
 		ElementFrom      string
 	}{
-		Name:             o.Name.ToPascalCase(),
+		Name:             o.options.ToPascalCase(o.Name),
 		AssocExtType:     o.AssocExtType,
 		ElementTypeType:  o.ElementTypeType,
 		ElementTypeValue: o.ElementTypeValue,
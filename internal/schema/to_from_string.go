@@ -12,18 +12,20 @@ type ToFromString struct {
 	Name         FrameworkIdentifier
 	AssocExtType *AssocExtType
 	templates    map[string]string
+	options      *Options
 }
 
-func NewToFromString(name string, assocExtType *AssocExtType) ToFromString {
+func NewToFromString(name string, assocExtType *AssocExtType, opts *Options) ToFromString {
 	t := map[string]string{
-		"from": StringFromTemplate,
-		"to":   StringToTemplate,
+		"from": opts.template("string_from"),
+		"to":   opts.template("string_to"),
 	}
 
 	return ToFromString{
 		Name:         FrameworkIdentifier(name),
 		AssocExtType: assocExtType,
 		templates:    t,
+		options:      opts,
 	}
 }
 
@@ -63,7 +65,7 @@ func (o ToFromString) renderTo() ([]byte, error) {
 		Name         string
 		AssocExtType *AssocExtType
 	}{
-		Name:         o.Name.ToPascalCase(),
+		Name:         o.options.ToPascalCase(o.Name),
 		AssocExtType: o.AssocExtType,
 	})
 
@@ -87,7 +89,7 @@ func (o ToFromString) renderFrom() ([]byte, error) {
 		Name         string
 		AssocExtType *AssocExtType
 	}{
-		Name:         o.Name.ToPascalCase(),
+		Name:         o.options.ToPascalCase(o.Name),
 		AssocExtType: o.AssocExtType,
 	})
 
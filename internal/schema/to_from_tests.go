@@ -0,0 +1,534 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package schema
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"text/template"
+
+	"github.com/hashicorp/terraform-plugin-codegen-spec/code"
+
+	"github.com/hashicorp/terraform-plugin-codegen-framework/internal/format"
+)
+
+// testLocal is a variable declared by a generated test, which is referenced by
+// pointer when building an associated external type.
+type testLocal struct {
+	name        string
+	declaration string
+}
+
+// testLocals maps the Go types of the fields of associated external types to the
+// variables which are referenced to populate them.
+var testLocals = map[string]testLocal{
+	"*bool":    {name: "boolValue", declaration: "boolValue := true"},
+	"*float64": {name: "float64Value", declaration: "float64Value := 1.5"},
+	"*int64":   {name: "int64Value", declaration: "int64Value := int64(1)"},
+	"*string":  {name: "stringValue", declaration: `stringValue := "example"`},
+}
+
+// defaultGoTypes maps the methods used to convert framework values in the
+// Default field of ToFromConversion, and the ToFunc field of AttrTypesToFuncs,
+// to the Go types they return.
+var defaultGoTypes = map[string]string{
+	"ValueBigFloat":       "*big.Float",
+	"ValueBoolPointer":    "*bool",
+	"ValueFloat64Pointer": "*float64",
+	"ValueInt64Pointer":   "*int64",
+	"ValueStringPointer":  "*string",
+}
+
+// toFromTest holds the data used to render a test of the to/from functions of a
+// value type or data model.
+type toFromTest struct {
+	// Name is the pascal case name of the data model.
+	Name string
+
+	// ValueType is the name of the value type.
+	ValueType string
+
+	AssocExtType *AssocExtType
+
+	// Locals are the declarations of the variables referenced by External.
+	Locals []string
+
+	// External is the Go expression of the associated external type, without
+	// the pointer.
+	External string
+
+	// Unknown is the Go expression of an unknown value of the value type.
+	Unknown string
+
+	// NullObjects are the pascal case names of the object attributes of the
+	// data model, which are left unset in NullObjectsExternal.
+	NullObjects []string
+
+	// NullObjectsLocals are the declarations of the variables referenced by
+	// NullObjectsExternal.
+	NullObjectsLocals []string
+
+	// NullObjectsExternal is the Go expression of the associated external type
+	// with the object attributes of the data model unset, without the pointer.
+	NullObjectsExternal string
+}
+
+// testValues builds Go expressions for populating associated external types,
+// recording the variables which must be declared.
+type testValues struct {
+	locals  map[string]string
+	options *Options
+}
+
+func newTestValues(opts *Options) *testValues {
+	return &testValues{
+		locals:  make(map[string]string),
+		options: opts,
+	}
+}
+
+// value returns an expression of the Go type (e.g., *string), which is a pointer
+// to a primitive type.
+func (v *testValues) value(goType string) (string, error) {
+	if goType == "*big.Float" {
+		return "big.NewFloat(1.5)", nil
+	}
+
+	l, ok := testLocals[goType]
+
+	if !ok {
+		return "", NewUnimplementedError(fmt.Errorf("%s test value is not yet implemented", goType))
+	}
+
+	v.locals[l.name] = l.declaration
+
+	return "&" + l.name, nil
+}
+
+// collection returns a composite literal of the slice or map Go type (e.g.,
+// []*string), containing a single element.
+func (v *testValues) collection(goType string) (string, error) {
+	if elemType, ok := strings.CutPrefix(goType, "[]"); ok {
+		elem, err := v.value(elemType)
+
+		if err != nil {
+			return "", err
+		}
+
+		return fmt.Sprintf("%s{%s}", goType, elem), nil
+	}
+
+	if elemType, ok := strings.CutPrefix(goType, "map[string]"); ok {
+		elem, err := v.value(elemType)
+
+		if err != nil {
+			return "", err
+		}
+
+		return fmt.Sprintf("%s{%q: %s}", goType, "key", elem), nil
+	}
+
+	return "", NewUnimplementedError(fmt.Errorf("%s test value is not yet implemented", goType))
+}
+
+// fields returns a composite literal of the type, with a field for each
+// conversion. Fields with an associated external type are left nil, as they
+// are covered by the tests of their own value types.
+func (v *testValues) fields(typeReference string, conversions map[string]ToFromConversion) (string, error) {
+	keys := make([]string, 0, len(conversions))
+
+	for k := range conversions {
+		keys = append(keys, k)
+	}
+
+	sort.Strings(keys)
+
+	var b strings.Builder
+
+	b.WriteString(typeReference + "{\n")
+
+	for _, k := range keys {
+		c := conversions[k]
+
+		var value string
+
+		var err error
+
+		switch {
+		case c.AssocExtType != nil:
+			continue
+		case c.Default != "":
+			value, err = v.value(defaultGoTypes[c.Default])
+		case c.CollectionType.GoType != "":
+			value, err = v.collection(c.CollectionType.GoType)
+		case c.ObjectType != nil:
+			value, err = v.anonymousStruct(c.ObjectType)
+		default:
+			continue
+		}
+
+		if err != nil {
+			return "", err
+		}
+
+		fmt.Fprintf(&b, "%s: %s,\n", v.options.ToPascalCase(FrameworkIdentifier(k)), value)
+	}
+
+	b.WriteString("}")
+
+	return b.String(), nil
+}
+
+// anonymousStruct returns a composite literal of the anonymous struct used for
+// object fields of associated external types.
+func (v *testValues) anonymousStruct(objectType map[FrameworkIdentifier]ObjectField) (string, error) {
+	keys := make([]string, 0, len(objectType))
+
+	for k := range objectType {
+		keys = append(keys, string(k))
+	}
+
+	sort.Strings(keys)
+
+	var fields, values strings.Builder
+
+	for _, k := range keys {
+		f := objectType[FrameworkIdentifier(k)]
+
+		value, err := v.value(f.GoType)
+
+		if err != nil {
+			return "", err
+		}
+
+		fmt.Fprintf(&fields, "%s %s\n", v.options.ToPascalCase(FrameworkIdentifier(k)), f.GoType)
+		fmt.Fprintf(&values, "%s: %s,\n", v.options.ToPascalCase(FrameworkIdentifier(k)), value)
+	}
+
+	return fmt.Sprintf("struct {\n%s}{\n%s}", fields.String(), values.String()), nil
+}
+
+// declarations returns the declarations of the variables referenced by the
+// expressions built so far, in order of variable name.
+func (v *testValues) declarations() []string {
+	names := make([]string, 0, len(v.locals))
+
+	for name := range v.locals {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+
+	declarations := make([]string, 0, len(names))
+
+	for _, name := range names {
+		declarations = append(declarations, v.locals[name])
+	}
+
+	return declarations
+}
+
+// ToFromTests generates unit tests for the code generated by ToFromFunctions and
+// ModelToFromFunctions. Each test converts a representative value of the
+// associated external type to the value type or data model and back, checking
+// that the result is equal, and checks the handling of nil, null and unknown
+// values. No code is returned if there are no tests, such as when there are no
+// associated external types, or the conversion code is not generated.
+func (g GeneratorSchema) ToFromTests(name, packageName string) ([]byte, error) {
+	var tests []toFromTest
+
+	if g.AssociatedExternalType != nil {
+		toFuncs, _, err := g.ModelToFromFuncs()
+
+		if err == nil {
+			values := newTestValues(g.Options)
+
+			external, err := values.fields(g.AssociatedExternalType.TypeReference(), toFuncs)
+
+			if err == nil {
+				test := toFromTest{
+					Name:         g.Options.ToPascalCase(FrameworkIdentifier(name)),
+					AssocExtType: g.AssociatedExternalType,
+					Locals:       values.declarations(),
+					External:     external,
+				}
+
+				withoutObjects := make(map[string]ToFromConversion, len(toFuncs))
+
+				for k, c := range toFuncs {
+					if c.ObjectType != nil {
+						test.NullObjects = append(test.NullObjects, g.Options.ToPascalCase(FrameworkIdentifier(k)))
+
+						continue
+					}
+
+					withoutObjects[k] = c
+				}
+
+				sort.Strings(test.NullObjects)
+
+				if len(test.NullObjects) > 0 {
+					nullObjectsValues := newTestValues(g.Options)
+
+					test.NullObjectsExternal, err = nullObjectsValues.fields(g.AssociatedExternalType.TypeReference(), withoutObjects)
+					test.NullObjectsLocals = nullObjectsValues.declarations()
+				}
+
+				if err == nil {
+					tests = append(tests, test)
+				}
+			}
+		}
+	}
+
+	for _, k := range g.Attributes.SortedKeys() {
+		if g.Attributes[k] == nil {
+			continue
+		}
+
+		tests = append(tests, valueToFromTests(g.Attributes[k], k, g.Options)...)
+	}
+
+	for _, k := range g.Blocks.SortedKeys() {
+		if g.Blocks[k] == nil {
+			continue
+		}
+
+		tests = append(tests, valueToFromTests(g.Blocks[k], k, g.Options)...)
+	}
+
+	if len(tests) == 0 {
+		return nil, nil
+	}
+
+	imports := NewImports()
+
+	for _, path := range []string{ContextImport, MathBigImport, "reflect", "testing", TypesImport} {
+		imports.Add(code.Import{
+			Path: path,
+		})
+	}
+
+	var buf bytes.Buffer
+
+	fmt.Fprintf(&buf, "// Code generated by terraform-plugin-framework-generator DO NOT EDIT.\n\npackage %s\n", packageName)
+
+	var body bytes.Buffer
+
+	for _, test := range tests {
+		imports.Append(test.AssocExtType.Imports())
+
+		tmpl := g.Options.template("to_from_test")
+
+		if test.Name != "" {
+			tmpl = g.Options.template("model_to_from_test")
+		}
+
+		t, err := template.New("").Parse(tmpl)
+
+		if err != nil {
+			return nil, err
+		}
+
+		body.WriteString("\n")
+
+		err = t.Execute(&body, test)
+
+		if err != nil {
+			return nil, err
+		}
+
+		body.WriteString("\n")
+	}
+
+	buf.WriteString("\nimport (\n")
+
+	for _, i := range imports.All() {
+		if i.Alias != nil {
+			fmt.Fprintf(&buf, "%s %q\n", *i.Alias, i.Path)
+
+			continue
+		}
+
+		fmt.Fprintf(&buf, "%q\n", i.Path)
+	}
+
+	buf.WriteString(")\n")
+
+	buf.Write(body.Bytes())
+
+	return format.RemoveUnusedImports(buf.Bytes())
+}
+
+// valueToFromTests returns the tests of the to/from functions generated for an
+// attribute or block, and for the attributes nested within it. Attributes and
+// blocks for which no to/from functions are generated, or whose test values are
+// not yet implemented, are skipped.
+func valueToFromTests(v any, name string, opts *Options) []toFromTest {
+	t, ok := v.(ToFrom)
+
+	if !ok {
+		return nil
+	}
+
+	b, err := opts.NestedToFromFunctions(t, name)
+
+	if err != nil || len(b) == 0 {
+		return nil
+	}
+
+	var tests []toFromTest
+
+	test, err := valueToFromTest(v, name, opts)
+
+	if err == nil {
+		tests = append(tests, test)
+	}
+
+	if a, ok := v.(Attributes); ok {
+		attributes := a.GetAttributes()
+
+		for _, k := range attributes.SortedKeys() {
+			tests = append(tests, valueToFromTests(attributes[k], opts.NestedTypeName(name, k), opts)...)
+		}
+	}
+
+	return tests
+}
+
+// valueToFromTest returns the test of the to/from functions generated for an
+// attribute or block.
+func valueToFromTest(v any, name string, opts *Options) (toFromTest, error) {
+	getter, ok := v.(AssocExtTypeGetter)
+
+	if !ok || getter.GetAssociatedExternalType() == nil {
+		return toFromTest{}, errors.New("no associated external type")
+	}
+
+	a, ok := v.(interface{ GeneratorSchemaType() Type })
+
+	if !ok {
+		return toFromTest{}, errors.New("no generator schema type")
+	}
+
+	assocExtType := getter.GetAssociatedExternalType()
+	valueType := opts.ToPascalCase(FrameworkIdentifier(name)) + "Value"
+	values := newTestValues(opts)
+
+	var external, unknown string
+
+	var err error
+
+	switch a.GeneratorSchemaType() {
+	case GeneratorBoolAttribute, GeneratorFloat64Attribute, GeneratorInt64Attribute, GeneratorNumberAttribute, GeneratorStringAttribute:
+		primitive := map[Type]string{
+			GeneratorBoolAttribute:    "Bool",
+			GeneratorFloat64Attribute: "Float64",
+			GeneratorInt64Attribute:   "Int64",
+			GeneratorNumberAttribute:  "Number",
+			GeneratorStringAttribute:  "String",
+		}[a.GeneratorSchemaType()]
+
+		goType := map[string]string{
+			"Bool":    "*bool",
+			"Float64": "*float64",
+			"Int64":   "*int64",
+			"Number":  "*big.Float",
+			"String":  "*string",
+		}[primitive]
+
+		var value string
+
+		value, err = values.value(goType)
+		external = fmt.Sprintf("%s(%s)", assocExtType.TypeReference(), value)
+		unknown = fmt.Sprintf("%s{types.%sUnknown()}", valueType, primitive)
+	case GeneratorListAttribute, GeneratorMapAttribute, GeneratorSetAttribute:
+		e, ok := v.(Elements)
+
+		if !ok {
+			return toFromTest{}, errors.New("no element type")
+		}
+
+		var elemGoType, elem string
+
+		elemGoType, err = ElementTypeGoType(e.ElemType())
+
+		if err != nil {
+			return toFromTest{}, err
+		}
+
+		elem, err = values.value(elemGoType)
+
+		collection := map[Type]string{
+			GeneratorListAttribute: "List",
+			GeneratorMapAttribute:  "Map",
+			GeneratorSetAttribute:  "Set",
+		}[a.GeneratorSchemaType()]
+
+		if collection == "Map" {
+			external = fmt.Sprintf("%s{%q: %s}", assocExtType.TypeReference(), "key", elem)
+		} else {
+			external = fmt.Sprintf("%s{%s}", assocExtType.TypeReference(), elem)
+		}
+
+		unknown = fmt.Sprintf("%s{types.%sUnknown(%s)}", valueType, collection, GetElementType(e.ElemType()))
+	case GeneratorObjectAttribute:
+		o, ok := v.(Attrs)
+
+		if !ok {
+			return toFromTest{}, errors.New("no attribute types")
+		}
+
+		var attrTypesToFuncs map[string]AttrTypesToFuncs
+
+		attrTypesToFuncs, err = GetAttrTypesToFuncs(o.AttrTypes())
+
+		if err != nil {
+			return toFromTest{}, err
+		}
+
+		conversions := make(map[string]ToFromConversion, len(attrTypesToFuncs))
+
+		for k, f := range attrTypesToFuncs {
+			conversions[k] = ToFromConversion{
+				Default: f.ToFunc,
+			}
+		}
+
+		external, err = values.fields(assocExtType.TypeReference(), conversions)
+		unknown = fmt.Sprintf("%s{types.ObjectUnknown(%s{}.AttributeTypes(ctx))}", valueType, valueType)
+	default:
+		n, ok := v.(Attributes)
+
+		if !ok {
+			return toFromTest{}, errors.New("no nested attributes")
+		}
+
+		var toFuncs map[string]ToFromConversion
+
+		toFuncs, err = n.GetAttributes().ToFuncs()
+
+		if err != nil {
+			return toFromTest{}, err
+		}
+
+		external, err = values.fields(assocExtType.TypeReference(), toFuncs)
+		unknown = fmt.Sprintf("New%sUnknown()", valueType)
+	}
+
+	if err != nil {
+		return toFromTest{}, err
+	}
+
+	return toFromTest{
+		ValueType:    valueType,
+		AssocExtType: assocExtType,
+		Locals:       values.declarations(),
+		External:     external,
+		Unknown:      unknown,
+	}, nil
+}
@@ -0,0 +1,137 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package schema
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-codegen-spec/schema"
+)
+
+func TestTestValues_fields(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		conversions          map[string]ToFromConversion
+		expected             string
+		expectedDeclarations []string
+		expectedError        bool
+	}{
+		"default": {
+			conversions: map[string]ToFromConversion{
+				"string_attribute": {
+					Default: "ValueStringPointer",
+				},
+				"number_attribute": {
+					Default: "ValueBigFloat",
+				},
+			},
+			expected: `apisdk.Example{
+NumberAttribute: big.NewFloat(1.5),
+StringAttribute: &stringValue,
+}`,
+			expectedDeclarations: []string{
+				`stringValue := "example"`,
+			},
+		},
+		"assoc-ext-type": {
+			conversions: map[string]ToFromConversion{
+				"nested_attribute": {
+					AssocExtType: &AssocExtType{
+						AssociatedExternalType: &schema.AssociatedExternalType{
+							Type: "*apisdk.Nested",
+						},
+					},
+				},
+			},
+			expected: `apisdk.Example{
+}`,
+			expectedDeclarations: []string{},
+		},
+		"collection-type": {
+			conversions: map[string]ToFromConversion{
+				"list_attribute": {
+					CollectionType: CollectionFields{
+						GoType: "[]*bool",
+					},
+				},
+				"map_attribute": {
+					CollectionType: CollectionFields{
+						GoType: "map[string]*int64",
+					},
+				},
+			},
+			expected: `apisdk.Example{
+ListAttribute: []*bool{&boolValue},
+MapAttribute: map[string]*int64{"key": &int64Value},
+}`,
+			expectedDeclarations: []string{
+				"boolValue := true",
+				"int64Value := int64(1)",
+			},
+		},
+		"object-type": {
+			conversions: map[string]ToFromConversion{
+				"object_attribute": {
+					ObjectType: map[FrameworkIdentifier]ObjectField{
+						"float64_attribute": {
+							GoType: "*float64",
+						},
+					},
+				},
+			},
+			expected: `apisdk.Example{
+ObjectAttribute: struct {
+Float64Attribute *float64
+}{
+Float64Attribute: &float64Value,
+},
+}`,
+			expectedDeclarations: []string{
+				"float64Value := 1.5",
+			},
+		},
+		"unimplemented": {
+			conversions: map[string]ToFromConversion{
+				"list_attribute": {
+					CollectionType: CollectionFields{
+						GoType: "[][]*string",
+					},
+				},
+			},
+			expectedError: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			values := newTestValues(nil)
+
+			got, err := values.fields("apisdk.Example", testCase.conversions)
+
+			if testCase.expectedError {
+				if err == nil {
+					t.Fatal("expected error")
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(values.declarations(), testCase.expectedDeclarations); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
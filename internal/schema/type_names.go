@@ -0,0 +1,17 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package schema
+
+// nestedTypeNames returns a mapping of the supplied attribute and block names to the
+// pascal case names used to refer to their custom type and value types, for use in
+// template data.
+func nestedTypeNames[T any](o *Options, parent FrameworkIdentifier, m map[FrameworkIdentifier]T) map[Identifier]string {
+	typeNames := make(map[Identifier]string, len(m))
+
+	for k := range m {
+		typeNames[o.identifier(k)] = o.TypeReference(o.NestedTypeName(string(parent), string(k)))
+	}
+
+	return typeNames
+}
@@ -30,6 +30,30 @@ type Elements interface {
 	ElemType() specschema.ElementType
 }
 
+// Properties is implemented by attributes and blocks, and returns the properties
+// of the Terraform schema, rather than of the generated Go code, from which
+// documentation, example configurations and acceptance tests are rendered.
+type Properties interface {
+	Properties() FieldProperties
+}
+
+// FieldProperties holds the Terraform schema properties of an attribute or
+// block. The nested attributes and blocks, element type and attribute types are
+// available from the Attributes, Blocks, Elements and Attrs interfaces.
+type FieldProperties struct {
+	Computed           bool
+	Optional           bool
+	Required           bool
+	Sensitive          bool
+	Description        string
+	DeprecationMessage string
+
+	// StaticDefault is the static default value of a resource attribute, as a
+	// JSON literal which is also a valid Terraform expression (e.g.,
+	// "example"). It is empty if there is no static default.
+	StaticDefault string
+}
+
 type GeneratorAttribute interface {
 	Equal(GeneratorAttribute) bool
 	GeneratorSchemaType() Type
@@ -62,6 +86,12 @@ type ToFrom interface {
 	ToFromFunctions(name string) ([]byte, error)
 }
 
+// AssocExtTypeGetter is implemented by attributes and blocks which can have an
+// associated external type, which ToFromFunctions converts to and from.
+type AssocExtTypeGetter interface {
+	GetAssociatedExternalType() *AssocExtType
+}
+
 type ToFromConversion struct {
 	Default        string
 	AssocExtType   *AssocExtType
@@ -69,6 +99,31 @@ type ToFromConversion struct {
 	ObjectType     map[FrameworkIdentifier]ObjectField
 }
 
+// toFromConversionData is a ToFromConversion for use in template data, in which the
+// attribute types of an object are keyed on Identifier.
+type toFromConversionData struct {
+	Default        string
+	AssocExtType   *AssocExtType
+	CollectionType CollectionFields
+	ObjectType     map[Identifier]ObjectField
+}
+
+// toFromConversionsData returns a copy of the conversions for use in template data.
+func toFromConversionsData(o *Options, m map[FrameworkIdentifier]ToFromConversion) map[Identifier]toFromConversionData {
+	data := make(map[Identifier]toFromConversionData, len(m))
+
+	for k, v := range m {
+		data[o.identifier(k)] = toFromConversionData{
+			Default:        v.Default,
+			AssocExtType:   v.AssocExtType,
+			CollectionType: v.CollectionType,
+			ObjectType:     identifiers(o, v.ObjectType),
+		}
+	}
+
+	return data
+}
+
 type CollectionFields struct {
 	ElementType   string
 	GoType        string
@@ -90,6 +145,13 @@ type From interface {
 	From() (ToFromConversion, error)
 }
 
+// ModelToFrom is implemented by attributes and blocks which are converted
+// differently when they are a field of the top-level data model, rather than
+// a field of a nested object.
+type ModelToFrom interface {
+	ModelToFrom() (ToFromConversion, error)
+}
+
 type Type int64
 
 const (
@@ -0,0 +1,329 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+// Package specfmt formats specification documents canonically, so that
+// documents edited by hand have the same layout regardless of who edited them.
+//
+// The document is processed as JSON, rather than with the types of the
+// codegen-spec module, so that every property of the specification is kept,
+// and numbers and strings are written as they were read.
+package specfmt
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+)
+
+// Options changes how documents are formatted.
+type Options struct {
+	// KeepOrder keeps resources, data sources, attributes, blocks and object
+	// attribute types in their original order, rather than sorting them by
+	// name.
+	KeepOrder bool
+}
+
+// sortedArrays are the keys of arrays whose elements are objects identified by
+// a name, which are sorted by name.
+var sortedArrays = map[string]bool{
+	"attribute_types": true,
+	"attributes":      true,
+	"blocks":          true,
+	"datasources":     true,
+	"resources":       true,
+}
+
+// emptyStrings are the keys of optional strings which are dropped when empty,
+// as an empty description or deprecation message is the same as none.
+var emptyStrings = map[string]bool{
+	"deprecation_message":  true,
+	"description":          true,
+	"markdown_description": true,
+}
+
+// Format returns the canonical formatting of the JSON document:
+//
+//   - Object keys are sorted, other than name, which is first.
+//   - Resources, data sources, attributes, blocks and object attribute types
+//     are sorted by name, unless Options.KeepOrder is set.
+//   - Null values, empty arrays, and empty descriptions and deprecation
+//     messages are dropped, unless the object would otherwise be empty.
+//   - Values are indented with two spaces, and the document ends with a
+//     newline.
+func Format(document []byte, opts Options) ([]byte, error) {
+	dec := json.NewDecoder(bytes.NewReader(document))
+	dec.UseNumber()
+
+	v, err := decode(dec)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := dec.Token(); !errors.Is(err, io.EOF) {
+		return nil, errors.New("invalid JSON: unexpected content after document")
+	}
+
+	f := formatter{
+		opts: opts,
+	}
+
+	v = f.value("", v)
+
+	var b bytes.Buffer
+
+	err = encode(&b, v, "")
+	if err != nil {
+		return nil, err
+	}
+
+	b.WriteByte('\n')
+
+	return b.Bytes(), nil
+}
+
+// member is a key and value of an object.
+type member struct {
+	key   string
+	value any
+}
+
+// object is a JSON object, with its members in order.
+type object []member
+
+// decode reads the next value from the decoder, which is an object, []any,
+// json.Number, string, bool or nil.
+func decode(dec *json.Decoder) (any, error) {
+	tok, err := dec.Token()
+	if err != nil {
+		return nil, err
+	}
+
+	switch tok {
+	case json.Delim('{'):
+		o := object{}
+
+		for dec.More() {
+			key, err := dec.Token()
+			if err != nil {
+				return nil, err
+			}
+
+			v, err := decode(dec)
+			if err != nil {
+				return nil, err
+			}
+
+			o = append(o, member{key: key.(string), value: v})
+		}
+
+		_, err = dec.Token()
+
+		return o, err
+	case json.Delim('['):
+		a := []any{}
+
+		for dec.More() {
+			v, err := decode(dec)
+			if err != nil {
+				return nil, err
+			}
+
+			a = append(a, v)
+		}
+
+		_, err = dec.Token()
+
+		return a, err
+	}
+
+	return tok, nil
+}
+
+// formatter sorts and drops values according to the options.
+type formatter struct {
+	opts Options
+}
+
+// value returns the formatted value, which is the value of the key in its
+// parent object, if any.
+func (f formatter) value(key string, v any) any {
+	switch v := v.(type) {
+	case object:
+		return f.object(v)
+	case []any:
+		a := make([]any, 0, len(v))
+
+		for _, e := range v {
+			a = append(a, f.value("", e))
+		}
+
+		if sortedArrays[key] && !f.opts.KeepOrder {
+			sort.SliceStable(a, func(i, j int) bool {
+				return name(a[i]) < name(a[j])
+			})
+		}
+
+		return a
+	}
+
+	return v
+}
+
+// object returns the object with its keys sorted, and empty values dropped.
+func (f formatter) object(o object) object {
+	result := make(object, 0, len(o))
+
+	for _, m := range o {
+		result = append(result, member{key: m.key, value: f.value(m.key, m.value)})
+	}
+
+	sort.SliceStable(result, func(i, j int) bool {
+		if result[i].key == "name" || result[j].key == "name" {
+			return result[i].key == "name" && result[j].key != "name"
+		}
+
+		return result[i].key < result[j].key
+	})
+
+	nonEmpty := make(object, 0, len(result))
+
+	for _, m := range result {
+		if !empty(m) {
+			nonEmpty = append(nonEmpty, m)
+		}
+	}
+
+	if len(nonEmpty) == 0 {
+		return result
+	}
+
+	return nonEmpty
+}
+
+// empty returns true if the member is null, an empty array, or an empty
+// description or deprecation message.
+func empty(m member) bool {
+	switch v := m.value.(type) {
+	case nil:
+		return true
+	case []any:
+		return len(v) == 0
+	case string:
+		return v == "" && emptyStrings[m.key]
+	}
+
+	return false
+}
+
+// name returns the name of an object in an array, or an empty string if the
+// value is not an object with a name.
+func name(v any) string {
+	o, ok := v.(object)
+	if !ok {
+		return ""
+	}
+
+	for _, m := range o {
+		if s, ok := m.value.(string); ok && m.key == "name" {
+			return s
+		}
+	}
+
+	return ""
+}
+
+// encode writes the value, indented by two spaces for each level of nesting.
+func encode(w *bytes.Buffer, v any, indent string) error {
+	switch v := v.(type) {
+	case object:
+		if len(v) == 0 {
+			w.WriteString("{}")
+
+			return nil
+		}
+
+		w.WriteString("{\n")
+
+		for i, m := range v {
+			w.WriteString(indent + "  ")
+
+			err := encodeString(w, m.key)
+			if err != nil {
+				return err
+			}
+
+			w.WriteString(": ")
+
+			err = encode(w, m.value, indent+"  ")
+			if err != nil {
+				return err
+			}
+
+			if i < len(v)-1 {
+				w.WriteByte(',')
+			}
+
+			w.WriteByte('\n')
+		}
+
+		w.WriteString(indent + "}")
+	case []any:
+		if len(v) == 0 {
+			w.WriteString("[]")
+
+			return nil
+		}
+
+		w.WriteString("[\n")
+
+		for i, e := range v {
+			w.WriteString(indent + "  ")
+
+			err := encode(w, e, indent+"  ")
+			if err != nil {
+				return err
+			}
+
+			if i < len(v)-1 {
+				w.WriteByte(',')
+			}
+
+			w.WriteByte('\n')
+		}
+
+		w.WriteString(indent + "]")
+	case string:
+		return encodeString(w, v)
+	case json.Number:
+		w.WriteString(v.String())
+	case bool:
+		fmt.Fprint(w, v)
+	case nil:
+		w.WriteString("null")
+	default:
+		return fmt.Errorf("unexpected JSON value %T", v)
+	}
+
+	return nil
+}
+
+// encodeString writes the string as JSON, without escaping HTML characters,
+// which are common in Go code within the specification.
+func encodeString(w *bytes.Buffer, s string) error {
+	var b bytes.Buffer
+
+	enc := json.NewEncoder(&b)
+	enc.SetEscapeHTML(false)
+
+	err := enc.Encode(s)
+	if err != nil {
+		return err
+	}
+
+	w.Write(bytes.TrimSuffix(b.Bytes(), []byte("\n")))
+
+	return nil
+}
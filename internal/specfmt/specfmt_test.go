@@ -0,0 +1,170 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package specfmt_test
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/hashicorp/terraform-plugin-codegen-framework/internal/specfmt"
+)
+
+func TestFormat(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		document      string
+		opts          specfmt.Options
+		expected      string
+		expectedError bool
+	}{
+		"keys": {
+			document: `{"version": "0.1", "provider": {"schema": {"description": "Example."}, "name": "example"}}`,
+			expected: `{
+  "provider": {
+    "name": "example",
+    "schema": {
+      "description": "Example."
+    }
+  },
+  "version": "0.1"
+}
+`,
+		},
+		"sorted": {
+			document: `{"resources": [{"name": "b", "schema": {"attributes": [{"name": "z", "object": {"attribute_types": [{"name": "y", "string": {}}, {"name": "x", "bool": {}}]}}, {"name": "a", "bool": {}}]}}, {"name": "a", "schema": {}}]}`,
+			expected: `{
+  "resources": [
+    {
+      "name": "a",
+      "schema": {}
+    },
+    {
+      "name": "b",
+      "schema": {
+        "attributes": [
+          {
+            "name": "a",
+            "bool": {}
+          },
+          {
+            "name": "z",
+            "object": {
+              "attribute_types": [
+                {
+                  "name": "x",
+                  "bool": {}
+                },
+                {
+                  "name": "y",
+                  "string": {}
+                }
+              ]
+            }
+          }
+        ]
+      }
+    }
+  ]
+}
+`,
+		},
+		"keep_order": {
+			document: `{"resources": [{"name": "b", "schema": {}}, {"name": "a", "schema": {}}]}`,
+			opts:     specfmt.Options{KeepOrder: true},
+			expected: `{
+  "resources": [
+    {
+      "name": "b",
+      "schema": {}
+    },
+    {
+      "name": "a",
+      "schema": {}
+    }
+  ]
+}
+`,
+		},
+		"empty": {
+			document: `{"provider": {"name": "example", "schema": {"attributes": []}}, "resources": [{"name": "a", "schema": {"blocks": [], "description": "", "deprecation_message": "", "markdown_description": null, "attributes": [{"name": "b", "string": {"description": "", "validators": []}}]}}]}`,
+			expected: `{
+  "provider": {
+    "name": "example",
+    "schema": {
+      "attributes": []
+    }
+  },
+  "resources": [
+    {
+      "name": "a",
+      "schema": {
+        "attributes": [
+          {
+            "name": "b",
+            "string": {
+              "description": "",
+              "validators": []
+            }
+          }
+        ]
+      }
+    }
+  ]
+}
+`,
+		},
+		"values": {
+			document: `{"a": ["<&>", "é\n", 1.50, 1e3, -0, true, false, null, {}, []]}`,
+			expected: `{
+  "a": [
+    "<&>",
+    "é\n",
+    1.50,
+    1e3,
+    -0,
+    true,
+    false,
+    null,
+    {},
+    []
+  ]
+}
+`,
+		},
+		"invalid": {
+			document:      `{"a": }`,
+			expectedError: true,
+		},
+		"trailing": {
+			document:      `{} {}`,
+			expectedError: true,
+		},
+	}
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := specfmt.Format([]byte(testCase.document), testCase.opts)
+
+			if testCase.expectedError {
+				if err == nil {
+					t.Fatal("expected error, got none")
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if diff := cmp.Diff(string(got), testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
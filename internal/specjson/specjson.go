@@ -0,0 +1,202 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+// Package specjson declares the structure of a Provider Code Specification JSON
+// document, for commands which create a specification from another source,
+// such as an existing provider schema.
+//
+// Unlike the types of the codegen-spec module, a single set of types is used
+// for the provider, resources and data sources, and fields which are empty are
+// omitted, so that documents only contain the fields which have been set.
+package specjson
+
+import (
+	"encoding/json"
+)
+
+// Version is the version of the specification documents created.
+const Version = "0.1"
+
+// Specification is a Provider Code Specification document.
+type Specification struct {
+	DataSources []Schema  `json:"datasources,omitempty"`
+	Provider    *Provider `json:"provider,omitempty"`
+	Resources   []Schema  `json:"resources,omitempty"`
+	Version     string    `json:"version"`
+}
+
+// Provider is the provider in a specification.
+type Provider struct {
+	Name   string      `json:"name"`
+	Schema *SchemaBody `json:"schema,omitempty"`
+}
+
+// Schema is a named resource or data source in a specification.
+type Schema struct {
+	Name   string     `json:"name"`
+	Schema SchemaBody `json:"schema"`
+}
+
+// SchemaBody is the schema of the provider, or of a resource or data source.
+type SchemaBody struct {
+	Attributes          []Attribute `json:"attributes,omitempty"`
+	Blocks              []Block     `json:"blocks,omitempty"`
+	Description         string      `json:"description,omitempty"`
+	MarkdownDescription string      `json:"markdown_description,omitempty"`
+	DeprecationMessage  string      `json:"deprecation_message,omitempty"`
+}
+
+// Attribute is a named attribute, of which exactly one type is set.
+type Attribute struct {
+	Name string `json:"name"`
+
+	Bool         *AttributeType `json:"bool,omitempty"`
+	Dynamic      *AttributeType `json:"dynamic,omitempty"`
+	Float64      *AttributeType `json:"float64,omitempty"`
+	Int64        *AttributeType `json:"int64,omitempty"`
+	List         *AttributeType `json:"list,omitempty"`
+	ListNested   *AttributeType `json:"list_nested,omitempty"`
+	Map          *AttributeType `json:"map,omitempty"`
+	MapNested    *AttributeType `json:"map_nested,omitempty"`
+	Number       *AttributeType `json:"number,omitempty"`
+	Object       *AttributeType `json:"object,omitempty"`
+	Set          *AttributeType `json:"set,omitempty"`
+	SetNested    *AttributeType `json:"set_nested,omitempty"`
+	SingleNested *AttributeType `json:"single_nested,omitempty"`
+	String       *AttributeType `json:"string,omitempty"`
+}
+
+// AttributeType holds the properties of an attribute. Provider attributes set
+// OptionalRequired, and resource and data source attributes set
+// ComputedOptionalRequired.
+type AttributeType struct {
+	AssociatedExternalType *AssociatedExternalType `json:"associated_external_type,omitempty"`
+
+	// Attributes are set for single nested attributes.
+	Attributes []Attribute `json:"attributes,omitempty"`
+
+	// AttributeTypes are set for object attributes.
+	AttributeTypes []Type `json:"attribute_types,omitempty"`
+
+	ComputedOptionalRequired string   `json:"computed_optional_required,omitempty"`
+	Default                  *Default `json:"default,omitempty"`
+	DeprecationMessage       string   `json:"deprecation_message,omitempty"`
+	Description              string   `json:"description,omitempty"`
+
+	// ElementType is set for list, map and set attributes.
+	ElementType *Type `json:"element_type,omitempty"`
+
+	// NestedObject is set for list, map and set nested attributes.
+	NestedObject *NestedObject `json:"nested_object,omitempty"`
+
+	OptionalRequired string   `json:"optional_required,omitempty"`
+	PlanModifiers    []Custom `json:"plan_modifiers,omitempty"`
+	Sensitive        *bool    `json:"sensitive,omitempty"`
+	Validators       []Custom `json:"validators,omitempty"`
+}
+
+// Block is a named block, of which exactly one type is set.
+type Block struct {
+	Name string `json:"name"`
+
+	ListNested   *BlockType `json:"list_nested,omitempty"`
+	SetNested    *BlockType `json:"set_nested,omitempty"`
+	SingleNested *BlockType `json:"single_nested,omitempty"`
+}
+
+// BlockType holds the properties of a block.
+type BlockType struct {
+	AssociatedExternalType *AssociatedExternalType `json:"associated_external_type,omitempty"`
+
+	// Attributes and Blocks are set for single nested blocks.
+	Attributes []Attribute `json:"attributes,omitempty"`
+	Blocks     []Block     `json:"blocks,omitempty"`
+
+	DeprecationMessage string `json:"deprecation_message,omitempty"`
+	Description        string `json:"description,omitempty"`
+
+	// NestedObject is set for list and set nested blocks.
+	NestedObject *NestedObject `json:"nested_object,omitempty"`
+
+	PlanModifiers []Custom `json:"plan_modifiers,omitempty"`
+	Validators    []Custom `json:"validators,omitempty"`
+}
+
+// NestedObject is the object of a nested attribute or block.
+type NestedObject struct {
+	AssociatedExternalType *AssociatedExternalType `json:"associated_external_type,omitempty"`
+	Attributes             []Attribute             `json:"attributes,omitempty"`
+	Blocks                 []Block                 `json:"blocks,omitempty"`
+}
+
+// AssociatedExternalType is a Go type which the generated code converts to and
+// from.
+type AssociatedExternalType struct {
+	Import *Import `json:"import,omitempty"`
+	Type   string  `json:"type"`
+}
+
+// Default is the default value of an attribute. Static is a bool, number or
+// string.
+type Default struct {
+	Static any `json:"static"`
+}
+
+// Custom is a plan modifier or validator, defined by Go code.
+type Custom struct {
+	Custom CustomDefinition `json:"custom"`
+}
+
+// CustomDefinition is Go code, and the imports it uses.
+type CustomDefinition struct {
+	Imports          []Import `json:"imports,omitempty"`
+	SchemaDefinition string   `json:"schema_definition"`
+}
+
+// Import is a Go import path, with an optional alias.
+type Import struct {
+	Alias string `json:"alias,omitempty"`
+	Path  string `json:"path"`
+}
+
+// Type is an element type, or, when Name is set, an object attribute type, of
+// which exactly one type is set.
+type Type struct {
+	Name string `json:"name,omitempty"`
+
+	Bool    *struct{}       `json:"bool,omitempty"`
+	Dynamic *struct{}       `json:"dynamic,omitempty"`
+	Float64 *struct{}       `json:"float64,omitempty"`
+	Int64   *struct{}       `json:"int64,omitempty"`
+	List    *CollectionType `json:"list,omitempty"`
+	Map     *CollectionType `json:"map,omitempty"`
+	Number  *struct{}       `json:"number,omitempty"`
+	Object  *ObjectType     `json:"object,omitempty"`
+	Set     *CollectionType `json:"set,omitempty"`
+	String  *struct{}       `json:"string,omitempty"`
+}
+
+// CollectionType is a list, map or set type.
+type CollectionType struct {
+	ElementType Type `json:"element_type"`
+}
+
+// ObjectType is an object type.
+type ObjectType struct {
+	AttributeTypes []Type `json:"attribute_types"`
+}
+
+// Marshal returns the indented JSON of the specification, with a trailing
+// newline.
+func (s Specification) Marshal() ([]byte, error) {
+	if s.Version == "" {
+		s.Version = Version
+	}
+
+	b, err := json.MarshalIndent(s, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+
+	return append(b, '\n'), nil
+}
@@ -0,0 +1,168 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+// Package specmodel provides a read-only representation of the data source,
+// provider and resource schemas in the specification document, for the commands
+// which check the document itself: diff, lint and validate.
+//
+// Documentation, examples and acceptance tests are rendered from the schema
+// package's GeneratorSchema, as converted for code generation, and Go code must
+// not be generated from this package. The commands which use it report problems
+// by JSON pointer into the document, including duplicate names and unsupported
+// types, which GeneratorSchema, keyed on attribute and block name, cannot
+// represent. Generator configuration, such as naming, shared types and
+// associated external types, only affects generated Go code, and is not
+// represented.
+package specmodel
+
+import (
+	"encoding/json"
+
+	specschema "github.com/hashicorp/terraform-plugin-codegen-spec/schema"
+)
+
+// Schema is a data source, provider or resource schema. The schemas share the
+// same JSON structure, other than provider attributes and blocks using
+// optional_required in place of computed_optional_required.
+type Schema struct {
+	Attributes          []Field `json:"attributes,omitempty"`
+	Blocks              []Field `json:"blocks,omitempty"`
+	DeprecationMessage  *string `json:"deprecation_message,omitempty"`
+	Description         *string `json:"description,omitempty"`
+	MarkdownDescription *string `json:"markdown_description,omitempty"`
+}
+
+// Field is an attribute or block, which is an object containing the name, and
+// a single property named after the type (e.g., string or list_nested).
+type Field struct {
+	Name string
+	Type string
+
+	Properties
+}
+
+// Properties holds the properties of an attribute or block which are not
+// specific to the kind of schema.
+type Properties struct {
+	AssociatedExternalType   *specschema.AssociatedExternalType `json:"associated_external_type,omitempty"`
+	Attributes               []Field                            `json:"attributes,omitempty"`
+	AttributeTypes           specschema.ObjectAttributeTypes    `json:"attribute_types,omitempty"`
+	Blocks                   []Field                            `json:"blocks,omitempty"`
+	ComputedOptionalRequired string                             `json:"computed_optional_required,omitempty"`
+	CustomType               *specschema.CustomType             `json:"custom_type,omitempty"`
+	Default                  *Default                           `json:"default,omitempty"`
+	DeprecationMessage       *string                            `json:"deprecation_message,omitempty"`
+	Description              *string                            `json:"description,omitempty"`
+	ElementType              *specschema.ElementType            `json:"element_type,omitempty"`
+	NestedObject             *NestedObject                      `json:"nested_object,omitempty"`
+	OptionalRequired         string                             `json:"optional_required,omitempty"`
+	PlanModifiers            []PlanModifier                     `json:"plan_modifiers,omitempty"`
+	Sensitive                *bool                              `json:"sensitive,omitempty"`
+}
+
+// NestedObject holds the attributes and blocks of list, map and set nested
+// attributes and blocks.
+type NestedObject struct {
+	AssociatedExternalType *specschema.AssociatedExternalType `json:"associated_external_type,omitempty"`
+	Attributes             []Field                            `json:"attributes,omitempty"`
+	Blocks                 []Field                            `json:"blocks,omitempty"`
+	CustomType             *specschema.CustomType             `json:"custom_type,omitempty"`
+}
+
+// PlanModifier is a plan modifier of a resource attribute or block.
+type PlanModifier struct {
+	Custom *specschema.CustomPlanModifier `json:"custom,omitempty"`
+}
+
+// Default is the default value of a resource attribute. Static holds the JSON
+// value of a static default, if any.
+type Default struct {
+	Static json.RawMessage `json:"static,omitempty"`
+}
+
+// New converts a data source, provider or resource schema from the
+// specification. A nil schema returns an empty Schema.
+func New(schema any) (Schema, error) {
+	var result Schema
+
+	b, err := json.Marshal(schema)
+	if err != nil {
+		return result, err
+	}
+
+	err = json.Unmarshal(b, &result)
+
+	return result, err
+}
+
+func (f *Field) UnmarshalJSON(b []byte) error {
+	var m map[string]json.RawMessage
+
+	err := json.Unmarshal(b, &m)
+	if err != nil {
+		return err
+	}
+
+	for k, v := range m {
+		if k == "name" {
+			err = json.Unmarshal(v, &f.Name)
+			if err != nil {
+				return err
+			}
+
+			continue
+		}
+
+		f.Type = k
+
+		err = json.Unmarshal(v, &f.Properties)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Nested returns the attributes and blocks of a nested attribute or block.
+func (f Field) Nested() ([]Field, []Field) {
+	if f.NestedObject != nil {
+		return f.NestedObject.Attributes, f.NestedObject.Blocks
+	}
+
+	return f.Attributes, f.Blocks
+}
+
+// IsNested returns true for nested attributes and blocks.
+func (f Field) IsNested() bool {
+	switch f.Type {
+	case "list_nested", "map_nested", "set_nested", "single_nested":
+		return true
+	}
+
+	return false
+}
+
+// IsRequired returns true if the attribute or block must be configured.
+func (f Field) IsRequired() bool {
+	return f.ComputedOptionalRequired == "required" || f.OptionalRequired == "required"
+}
+
+// IsOptional returns true if the attribute or block can be configured, but is
+// not required.
+func (f Field) IsOptional() bool {
+	switch {
+	case f.ComputedOptionalRequired == "optional", f.ComputedOptionalRequired == "computed_optional":
+		return true
+	case f.OptionalRequired == "optional":
+		return true
+	}
+
+	return false
+}
+
+// IsComputed returns true if the value of the attribute or block is set by the
+// provider.
+func (f Field) IsComputed() bool {
+	return f.ComputedOptionalRequired == "computed" || f.ComputedOptionalRequired == "computed_optional"
+}
@@ -0,0 +1,580 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package validate
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"go/token"
+	"regexp"
+	"slices"
+	"strings"
+
+	specschema "github.com/hashicorp/terraform-plugin-codegen-spec/schema"
+	"github.com/hashicorp/terraform-plugin-codegen-spec/spec"
+	"github.com/xeipuuv/gojsonschema"
+
+	"github.com/hashicorp/terraform-plugin-codegen-framework/internal/config"
+	"github.com/hashicorp/terraform-plugin-codegen-framework/internal/schema"
+	"github.com/hashicorp/terraform-plugin-codegen-framework/internal/specmodel"
+)
+
+// Error is a problem with a specification document, which is located by a JSON
+// pointer (RFC 6901), such as /resources/0/schema/attributes/1/name. The
+// pointer is empty for problems with the document as a whole.
+//
+// Line and Column are set by callers which validate the JSON converted from a
+// YAML document, to locate the problem in the YAML document.
+type Error struct {
+	Pointer string `json:"pointer"`
+	Line    int    `json:"line,omitempty"`
+	Column  int    `json:"column,omitempty"`
+	Message string `json:"message"`
+}
+
+// Error returns the message, prefixed with the pointer.
+func (e Error) Error() string {
+	if e.Pointer == "" {
+		return e.Message
+	}
+
+	return fmt.Sprintf("%s: %s", e.Pointer, e.Message)
+}
+
+// Specification returns the problems with a specification document. The
+// document is validated against the JSON schema of its version, then parsed,
+// and then checked for problems which the JSON schema does not prevent, but
+// which cause code generation to fail, or the generated code not to compile:
+//
+//   - Names which are not valid Terraform Plugin Framework identifiers.
+//   - Names of resources, data sources, attributes and blocks which are the
+//     same once converted to Go identifiers.
+//   - Attributes and blocks of nested objects named after the methods generated
+//     for the nested object value type.
+//   - Nested attributes and blocks of nested objects named after Go keywords.
+//   - Defaults on attributes which are not computed.
+//   - Custom types from another package without an import.
+//   - Associated external types without a package, or without an import.
+//
+// Go identifiers are determined with the naming settings of the generator
+// configuration. If there are no other problems, the schemas are converted with
+// the generator configuration, and checked for custom type and value types
+// declared more than once in the code generated for a schema.
+func Specification(ctx context.Context, document []byte, cfg config.Config) []Error {
+	err := JSON(document)
+	if err != nil {
+		return []Error{{Message: err.Error()}}
+	}
+
+	errs := jsonSchemaErrors(document)
+	if len(errs) > 0 {
+		return errs
+	}
+
+	s, err := spec.Parse(ctx, document)
+	if err != nil {
+		return parseErrors(document, err)
+	}
+
+	c := &checker{
+		opts:     cfg.Naming.Options(),
+		pointers: make(map[string]string),
+	}
+
+	if s.Provider != nil {
+		c.name("/provider", s.Provider.Name)
+
+		if s.Provider.Schema != nil {
+			c.schema("/provider/schema", "provider."+s.Provider.Name, s.Provider.Schema)
+		}
+	}
+
+	resourceNames := make(goNames, len(s.Resources))
+
+	for i, r := range s.Resources {
+		pointer := fmt.Sprintf("/resources/%d", i)
+
+		c.name(pointer, r.Name)
+		c.unique(resourceNames, pointer, r.Name, c.opts.ToPascalCase(schema.FrameworkIdentifier(r.Name)))
+		c.schema(pointer+"/schema", "resource."+r.Name, r.Schema)
+	}
+
+	dataSourceNames := make(goNames, len(s.DataSources))
+
+	for i, d := range s.DataSources {
+		pointer := fmt.Sprintf("/datasources/%d", i)
+
+		c.name(pointer, d.Name)
+		c.unique(dataSourceNames, pointer, d.Name, c.opts.ToPascalCase(schema.FrameworkIdentifier(d.Name)))
+		c.schema(pointer+"/schema", "data_source."+d.Name, d.Schema)
+	}
+
+	if len(c.errs) > 0 {
+		return c.errs
+	}
+
+	c.declarations(s, cfg)
+
+	return c.errs
+}
+
+// parseStepRegexp matches the first step of the path within an error from
+// spec.Parse, such as resource "one" in resource "one" attribute "two".
+var parseStepRegexp = regexp.MustCompile(`^(resource|data source|provider|attribute|block|object attribute type) "([^"]*)" ?`)
+
+// parseErrors returns the problems in an error from spec.Parse. Once a document
+// is valid against the JSON schema, these are duplicated names, such as
+// resource "one" attribute "two" is duplicated, which are located at the name
+// of the duplicate.
+func parseErrors(document []byte, err error) []Error {
+	var value any
+
+	// The document has already been validated as JSON.
+	_ = json.Unmarshal(document, &value)
+
+	var errs []Error
+
+	for _, message := range strings.Split(err.Error(), "\n") {
+		errs = append(errs, parseError(value, message))
+	}
+
+	return errs
+}
+
+// parseError returns the problem for a single error message from spec.Parse,
+// which is only located if it is a duplicated name.
+func parseError(document any, message string) Error {
+	path, ok := strings.CutSuffix(message, " is duplicated")
+	if !ok {
+		return Error{Message: message}
+	}
+
+	var steps [][]string
+
+	for path != "" {
+		m := parseStepRegexp.FindStringSubmatch(path)
+		if m == nil {
+			return Error{Message: message}
+		}
+
+		steps = append(steps, m[1:])
+		path = path[len(m[0]):]
+	}
+
+	pointer := ""
+	value := document
+
+	for i, step := range steps {
+		kind, name := step[0], step[1]
+		last := i == len(steps)-1
+
+		if kind == "provider" {
+			pointer += "/provider/schema"
+			value = member(member(value, "provider"), "schema")
+
+			continue
+		}
+
+		key := map[string]string{
+			"resource":              "resources",
+			"data source":           "datasources",
+			"attribute":             "attributes",
+			"block":                 "blocks",
+			"object attribute type": "attribute_types",
+		}[kind]
+
+		elements, _ := member(value, key).([]any)
+
+		index := nameIndex(elements, name, last)
+		if index < 0 {
+			return Error{Message: message}
+		}
+
+		pointer += fmt.Sprintf("/%s/%d", key, index)
+
+		if last {
+			return Error{
+				Pointer: pointer + "/name",
+				Message: fmt.Sprintf("%s %q is duplicated", kind, name),
+			}
+		}
+
+		if kind == "resource" || kind == "data source" {
+			pointer += "/schema"
+			value = member(elements[index], "schema")
+
+			continue
+		}
+
+		t := typeKey(elements[index])
+		pointer += "/" + t
+		value = member(elements[index], t)
+
+		if nestedObject := member(value, "nested_object"); nestedObject != nil {
+			pointer += "/nested_object"
+			value = nestedObject
+		}
+	}
+
+	return Error{Message: message}
+}
+
+// member returns the value of the named member of a JSON object, or nil.
+func member(value any, name string) any {
+	object, _ := value.(map[string]any)
+
+	return object[name]
+}
+
+// nameIndex returns the index of the element of a JSON array with the name,
+// which is the second such element if duplicate is true, or -1.
+func nameIndex(elements []any, name string, duplicate bool) int {
+	found := false
+
+	for i, e := range elements {
+		if member(e, "name") != name {
+			continue
+		}
+
+		if !duplicate || found {
+			return i
+		}
+
+		found = true
+	}
+
+	return -1
+}
+
+// typeKey returns the name of the member of an attribute, block or object
+// attribute type which defines its type (e.g., string or list_nested).
+func typeKey(value any) string {
+	object, _ := value.(map[string]any)
+
+	for k := range object {
+		if k != "name" {
+			return k
+		}
+	}
+
+	return ""
+}
+
+// jsonSchemaErrors returns the errors from validating the document against the
+// JSON schema of its version. Errors that a value does not match any of several
+// schemas are omitted when there are more specific errors within the value.
+func jsonSchemaErrors(document []byte) []Error {
+	var versioned struct {
+		Version string `json:"version"`
+	}
+
+	err := json.Unmarshal(document, &versioned)
+	if err != nil {
+		return []Error{{Message: err.Error()}}
+	}
+
+	if versioned.Version == "" {
+		return []Error{{Message: "version is required"}}
+	}
+
+	if versioned.Version != spec.Version0_1 {
+		return []Error{{Pointer: "/version", Message: fmt.Sprintf("version %q is unsupported", versioned.Version)}}
+	}
+
+	result, err := gojsonschema.Validate(gojsonschema.NewBytesLoader(spec.JSONSchemaVersion0_1), gojsonschema.NewBytesLoader(document))
+	if err != nil {
+		return []Error{{Message: err.Error()}}
+	}
+
+	var errs []Error
+
+	for _, e := range result.Errors() {
+		errs = append(errs, Error{
+			Pointer: strings.TrimPrefix(e.Context().String("/"), gojsonschema.STRING_CONTEXT_ROOT),
+			Message: strings.TrimPrefix(e.Description(), e.Field()+" "),
+		})
+	}
+
+	var specific []Error
+
+	for i, e := range result.Errors() {
+		switch e.Type() {
+		case "number_one_of", "number_any_of":
+			if hasDescendant(errs, i) {
+				continue
+			}
+		}
+
+		specific = append(specific, errs[i])
+	}
+
+	return specific
+}
+
+// hasDescendant returns whether another error is located at or within the
+// value of the error at index i.
+func hasDescendant(errs []Error, i int) bool {
+	for j, e := range errs {
+		if j != i && (e.Pointer == errs[i].Pointer || strings.HasPrefix(e.Pointer, errs[i].Pointer+"/")) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// checker collects the problems found in the schemas of a specification.
+type checker struct {
+	errs []Error
+
+	// opts are the options with which Go identifiers are determined.
+	opts *schema.Options
+
+	// pointers maps the path of each attribute and block used in generated
+	// code (e.g., resource.example.network.config) to its JSON pointer.
+	pointers map[string]string
+}
+
+func (c *checker) errorf(pointer, format string, a ...any) {
+	c.errs = append(c.errs, Error{
+		Pointer: pointer,
+		Message: fmt.Sprintf(format, a...),
+	})
+}
+
+// name checks the name of the provider, or of a resource, data source,
+// attribute or block, at the pointer to its object.
+func (c *checker) name(pointer, name string) {
+	if !schema.FrameworkIdentifier(name).Valid() {
+		c.errorf(pointer+"/name", "%q is not a valid identifier, which must contain only lowercase letters, numbers and underscores, and not start with a number", name)
+	}
+}
+
+// goNames maps the Go identifiers of resources, data sources, or the
+// attributes and blocks of an object, to their names.
+type goNames map[string]string
+
+// unique checks that the Go identifier of the named object at the pointer is
+// not the Go identifier of another object with a different name.
+func (c *checker) unique(seen goNames, pointer, name, goName string) {
+	if other, ok := seen[goName]; ok {
+		c.errorf(pointer+"/name", "%q has the same Go identifier, %s, as %q", name, goName, other)
+
+		return
+	}
+
+	seen[goName] = name
+}
+
+// schema checks the attributes and blocks of a provider, resource or data
+// source schema, at the path used in generated code (e.g., resource.example).
+func (c *checker) schema(pointer, path string, s any) {
+	m, err := specmodel.New(s)
+	if err != nil {
+		c.errorf(pointer, "%s", err)
+
+		return
+	}
+
+	c.fields(pointer, path, m.Attributes, m.Blocks, "", nil)
+}
+
+// fields checks the attributes and blocks of an object. The nested type name
+// and associated external type are those of the nested object containing the
+// attributes and blocks, if any.
+func (c *checker) fields(pointer, path string, attributes, blocks []specmodel.Field, nestedTypeName string, assocExtType *specschema.AssociatedExternalType) {
+	type field struct {
+		specmodel.Field
+		pointer string
+	}
+
+	// Attributes and blocks are both fields of the generated model or nested
+	// object value type, so names must be unique across both.
+	fields := make([]field, 0, len(attributes)+len(blocks))
+
+	for i, f := range attributes {
+		fields = append(fields, field{f, fmt.Sprintf("%s/attributes/%d", pointer, i)})
+	}
+
+	for i, f := range blocks {
+		fields = append(fields, field{f, fmt.Sprintf("%s/blocks/%d", pointer, i)})
+	}
+
+	var methodNames []string
+
+	if nestedTypeName != "" && assocExtType != nil {
+		a := schema.NewAssocExtType(assocExtType, c.opts)
+		methodNames = []string{"To" + a.ToPascalCase(), "From" + a.ToPascalCase()}
+	}
+
+	seen := make(goNames, len(fields))
+
+	for _, f := range fields {
+		identifier := schema.FrameworkIdentifier(f.Name)
+
+		goName := c.opts.ToPascalCase(identifier)
+		if nestedTypeName != "" {
+			goName = c.opts.ToPrefixPascalCase(identifier, nestedTypeName)
+		}
+
+		c.unique(seen, f.pointer, f.Name, goName)
+
+		if slices.Contains(methodNames, goName) {
+			c.errorf(f.pointer+"/name", "%q has the same Go identifier, %s, as a conversion method generated for the nested object value type", f.Name, goName)
+		}
+
+		c.pointers[path+"."+f.Name] = f.pointer
+
+		c.field(f.pointer, path+"."+f.Name, f.Field, nestedTypeName)
+	}
+}
+
+// field checks an attribute or block, at the pointer to its object, and its
+// nested attributes and blocks. The nested type name is that of the nested
+// object containing the attribute or block, if any.
+func (c *checker) field(pointer, path string, f specmodel.Field, nestedTypeName string) {
+	c.name(pointer, f.Name)
+
+	identifier := schema.FrameworkIdentifier(f.Name)
+	typePointer := pointer + "/" + f.Type
+
+	if f.IsNested() && nestedTypeName != "" {
+		if f.Type == "single_nested" && c.opts.IsMethodName(identifier) {
+			c.errorf(pointer+"/name", "%q is the name of a method generated for the nested object value type, %s, which is not supported for single nested attributes and blocks", f.Name, c.opts.ToPascalCase(identifier))
+		}
+
+		if token.IsKeyword(c.opts.ToCamelCase(identifier)) {
+			c.errorf(pointer+"/name", "%q is a Go keyword, which is not supported for nested attributes and blocks within nested objects", f.Name)
+		}
+	}
+
+	if f.Default != nil && !f.IsComputed() {
+		c.errorf(typePointer+"/default", "default requires computed_optional_required to be computed or computed_optional, not %s", f.ComputedOptionalRequired)
+	}
+
+	c.customType(typePointer+"/custom_type", f.CustomType)
+	c.associatedExternalType(typePointer+"/associated_external_type", f.AssociatedExternalType)
+
+	if !f.IsNested() {
+		return
+	}
+
+	typeName := c.opts.NestedTypeName(nestedTypeName, f.Name)
+
+	if f.NestedObject != nil {
+		objectPointer := typePointer + "/nested_object"
+
+		c.customType(objectPointer+"/custom_type", f.NestedObject.CustomType)
+		c.associatedExternalType(objectPointer+"/associated_external_type", f.NestedObject.AssociatedExternalType)
+		c.fields(objectPointer, path, f.NestedObject.Attributes, f.NestedObject.Blocks, typeName, f.NestedObject.AssociatedExternalType)
+
+		return
+	}
+
+	c.fields(typePointer, path, f.Attributes, f.Blocks, typeName, f.AssociatedExternalType)
+}
+
+// declarations converts the schemas with the generator configuration, and
+// checks the custom type and value types declared by the code generated for
+// each schema, as when generating code into a package for each schema.
+func (c *checker) declarations(s spec.Specification, cfg config.Config) {
+	kinds := []struct {
+		path        string
+		schemas     func(config.Config, spec.Specification, *schema.Options) (map[string]schema.GeneratorSchema, error)
+		sharedTypes bool
+	}{
+		{"data_source", config.Config.DataSourceSchemas, true},
+		{"provider", config.Config.ProviderSchemas, false},
+		{"resource", config.Config.ResourceSchemas, true},
+	}
+
+	for _, k := range kinds {
+		schemas, err := k.schemas(cfg, s, c.opts)
+		if err != nil {
+			c.errorf("", "%s", err)
+
+			continue
+		}
+
+		g := schema.NewGeneratorSchemas(schemas, c.opts)
+
+		var shared schema.SharedTypes
+
+		if k.sharedTypes && cfg.SharedTypes != nil {
+			shared, err = g.SharedTypes(k.path)
+			if err != nil {
+				c.errorf("", "%s", err)
+
+				continue
+			}
+		}
+
+		c.collisions(g.CheckCollisions(k.path, "", shared))
+	}
+}
+
+// collisions reports each collision within an error from CheckCollisions at the
+// name of the attribute or block with the second path.
+func (c *checker) collisions(err error) {
+	if joined, ok := err.(interface{ Unwrap() []error }); ok {
+		for _, e := range joined.Unwrap() {
+			c.collisions(e)
+		}
+
+		return
+	}
+
+	var collision *schema.CollisionError
+
+	if !errors.As(err, &collision) {
+		if err != nil {
+			c.errorf("", "%s", err)
+		}
+
+		return
+	}
+
+	pointer, ok := c.pointers[collision.Paths[1]]
+	if ok {
+		pointer += "/name"
+	}
+
+	c.errorf(pointer, "%s", collision)
+}
+
+// customType checks that a custom type declared in another package has an
+// import.
+func (c *checker) customType(pointer string, customType *specschema.CustomType) {
+	if customType == nil || customType.HasImport() {
+		return
+	}
+
+	for _, t := range []string{customType.Type, customType.ValueType} {
+		if strings.Contains(t, ".") {
+			c.errorf(pointer+"/import", "custom type %s is declared in another package, which must be imported", t)
+
+			return
+		}
+	}
+}
+
+// associatedExternalType checks that an associated external type is qualified
+// with a package, which is imported.
+func (c *checker) associatedExternalType(pointer string, assocExtType *specschema.AssociatedExternalType) {
+	if assocExtType == nil {
+		return
+	}
+
+	if !strings.Contains(assocExtType.Type, ".") {
+		c.errorf(pointer+"/type", "associated external type %s must be qualified with a package (e.g., *apisdk.Thing)", assocExtType.Type)
+
+		return
+	}
+
+	if assocExtType.Import == nil || assocExtType.Import.Path == "" {
+		c.errorf(pointer+"/import", "associated external type %s is declared in another package, which must be imported", assocExtType.Type)
+	}
+}
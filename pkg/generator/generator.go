@@ -0,0 +1,210 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+// Package generator converts a Provider Code Specification into Go code for use
+// in a Terraform Plugin Framework provider. It is the library equivalent of the
+// tfplugingen-framework generate commands, and generates the same files in
+// memory.
+package generator
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"log/slog"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-codegen-spec/spec"
+
+	"github.com/hashicorp/terraform-plugin-codegen-framework/internal/config"
+	"github.com/hashicorp/terraform-plugin-codegen-framework/internal/schema"
+	"github.com/hashicorp/terraform-plugin-codegen-framework/pkg/plugin"
+)
+
+// Options defines settings for generating code.
+type Options struct {
+	// PackageName is the name of the Go package for all generated code. If
+	// empty, a package and directory is generated for each data source and
+	// resource, and for the provider, as with the --package flag.
+	PackageName string
+
+	// Config is a JSON generator configuration, in the same format as the
+	// file supplied to the --config flag. Optional.
+	Config []byte
+
+	// ConfigDir is the directory which relative paths in Config, such as the
+	// specifications of prior resource schema versions, are resolved
+	// against. If empty, the current directory is used.
+	ConfigDir string
+
+	// Logger receives errors for code which cannot be generated, such as
+	// to/from functions for unsupported associated external types. If nil,
+	// these errors are discarded.
+	Logger *slog.Logger
+
+	// Templates overrides the templates used to generate code, keyed on
+	// template name. Template names are returned by TemplateNames.
+	Templates map[string]string
+
+	// ToFromTests indicates whether unit tests are generated for the
+	// functions converting to and from associated external types, alongside
+	// the code for each data source, resource and the provider (e.g.,
+	// example_resource_gen_test.go), as with the --to-from-tests flag.
+	ToFromTests bool
+
+	// Plugins are the external generator plugins which are sent a
+	// description of the generated code, and return additional files to
+	// generate. Each is either a path to the plugin executable, or the name
+	// of an executable on the PATH. Refer to the plugin package for the
+	// protocol.
+	Plugins []string
+
+	// WriteFile, if set, is called for each generated file once all code has
+	// been generated, in order of path. The path is slash-separated, and
+	// relative to the output directory.
+	WriteFile func(path string, content []byte) error
+}
+
+// Generate returns the code generated for the data sources, resources and
+// provider in the specification, keyed on slash-separated file path relative to
+// the output directory (e.g., resource_example/example_resource_gen.go).
+func Generate(ctx context.Context, s spec.Specification, opts Options) (map[string][]byte, error) {
+	return generate(ctx, s, opts, dataSources, resources, providers)
+}
+
+// GenerateDataSources returns the code generated for the data sources in the
+// specification, keyed on slash-separated file path relative to the output
+// directory.
+func GenerateDataSources(ctx context.Context, s spec.Specification, opts Options) (map[string][]byte, error) {
+	return generate(ctx, s, opts, dataSources)
+}
+
+// GenerateResources returns the code generated for the resources in the
+// specification, keyed on slash-separated file path relative to the output
+// directory.
+func GenerateResources(ctx context.Context, s spec.Specification, opts Options) (map[string][]byte, error) {
+	return generate(ctx, s, opts, resources)
+}
+
+// GenerateProvider returns the code generated for the provider in the
+// specification, keyed on slash-separated file path relative to the output
+// directory.
+func GenerateProvider(ctx context.Context, s spec.Specification, opts Options) (map[string][]byte, error) {
+	return generate(ctx, s, opts, providers)
+}
+
+// TemplateNames returns the sorted names of the templates which can be
+// overridden with Options.Templates.
+func TemplateNames() []string {
+	return schema.TemplateNames()
+}
+
+func generate(ctx context.Context, s spec.Specification, opts Options, kinds ...kind) (map[string][]byte, error) {
+	err := s.Validate(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("error validating specification: %w", err)
+	}
+
+	cfg, err := config.Parse(opts.Config)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing generator configuration: %w", err)
+	}
+
+	err = cfg.ReadPriorVersions(ctx, opts.ConfigDir)
+	if err != nil {
+		return nil, fmt.Errorf("error reading prior resource schema versions: %w", err)
+	}
+
+	err = schema.ValidateTemplates(opts.Templates)
+	if err != nil {
+		return nil, fmt.Errorf("error overriding templates: %w", err)
+	}
+
+	logger := opts.Logger
+
+	if logger == nil {
+		logger = slog.New(slog.NewTextHandler(io.Discard, nil))
+	}
+
+	// check for Go types declared more than once when all generated code is
+	// placed into the same package
+	if opts.PackageName != "" && len(kinds) > 1 {
+		err = checkPackageCollisions(s, cfg, kinds)
+		if err != nil {
+			return nil, fmt.Errorf("error checking generated Go type names: %w", err)
+		}
+	}
+
+	files := make(map[string][]byte)
+
+	req := plugin.Request{
+		ProtocolVersion: plugin.ProtocolVersion,
+		PackageName:     opts.PackageName,
+	}
+
+	for _, k := range kinds {
+		f, pluginSchemas, err := k.generate(ctx, s, cfg, schemaOptions(cfg, opts.Templates), opts.PackageName, opts.ToFromTests, logger)
+		if err != nil {
+			return nil, fmt.Errorf("error generating %s code: %w", k.description, err)
+		}
+
+		for path, content := range f {
+			files[path] = content
+		}
+
+		switch k.generatorType {
+		case dataSources.generatorType:
+			req.DataSources = pluginSchemas
+		case providers.generatorType:
+			req.Provider = pluginSchemas
+		case resources.generatorType:
+			req.Resources = pluginSchemas
+		}
+	}
+
+	for _, name := range opts.Plugins {
+		f, err := runPlugin(ctx, name, req)
+		if err != nil {
+			return nil, fmt.Errorf("error running plugin %s: %w", name, err)
+		}
+
+		for path, content := range f {
+			if _, ok := files[path]; ok {
+				return nil, fmt.Errorf("error running plugin %s: file %q has already been generated", name, path)
+			}
+
+			files[path] = content
+		}
+	}
+
+	if opts.WriteFile == nil {
+		return files, nil
+	}
+
+	paths := make([]string, 0, len(files))
+
+	for path := range files {
+		paths = append(paths, path)
+	}
+
+	sort.Strings(paths)
+
+	for _, path := range paths {
+		err = opts.WriteFile(path, files[path])
+		if err != nil {
+			return nil, fmt.Errorf("error writing %s: %w", path, err)
+		}
+	}
+
+	return files, nil
+}
+
+// schemaOptions returns the options used when generating code from the schemas,
+// which apply the naming configuration and template overrides.
+func schemaOptions(cfg config.Config, templates map[string]string) *schema.Options {
+	opts := cfg.Naming.Options()
+
+	opts.Templates = templates
+
+	return opts
+}
@@ -0,0 +1,268 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package generator_test
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-codegen-spec/spec"
+
+	"github.com/hashicorp/terraform-plugin-codegen-framework/pkg/generator"
+)
+
+func TestGenerate(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		generate      func(context.Context, spec.Specification, generator.Options) (map[string][]byte, error)
+		irInputPath   string
+		configPath    string
+		packageName   string
+		toFromTests   bool
+		goldenFileDir string
+	}{
+		"all": {
+			generate:      generator.Generate,
+			irInputPath:   "../../internal/cmd/testdata/custom_and_external/ir.json",
+			goldenFileDir: "../../internal/cmd/testdata/custom_and_external/all_output/default_pkg_name",
+		},
+		"data_sources": {
+			generate:      generator.GenerateDataSources,
+			irInputPath:   "../../internal/cmd/testdata/custom_and_external/ir.json",
+			packageName:   "generated",
+			goldenFileDir: "../../internal/cmd/testdata/custom_and_external/data_sources_output",
+		},
+		"provider": {
+			generate:      generator.GenerateProvider,
+			irInputPath:   "../../internal/cmd/testdata/custom_and_external/ir.json",
+			packageName:   "generated",
+			goldenFileDir: "../../internal/cmd/testdata/custom_and_external/provider_output",
+		},
+		"resources": {
+			generate:      generator.GenerateResources,
+			irInputPath:   "../../internal/cmd/testdata/custom_and_external/ir.json",
+			packageName:   "generated",
+			goldenFileDir: "../../internal/cmd/testdata/custom_and_external/resources_output",
+		},
+		"resources_config": {
+			generate:      generator.GenerateResources,
+			irInputPath:   "../../internal/cmd/testdata/model_assoc_ext_type/ir.json",
+			configPath:    "../../internal/cmd/testdata/model_assoc_ext_type/config.json",
+			packageName:   "generated",
+			goldenFileDir: "../../internal/cmd/testdata/model_assoc_ext_type/resources_output",
+		},
+		"resources_to_from_tests": {
+			generate:      generator.GenerateResources,
+			irInputPath:   "../../internal/cmd/testdata/model_assoc_ext_type/ir.json",
+			configPath:    "../../internal/cmd/testdata/model_assoc_ext_type/config.json",
+			packageName:   "generated",
+			toFromTests:   true,
+			goldenFileDir: "../../internal/cmd/testdata/model_assoc_ext_type/resources_to_from_tests_output",
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			opts := generator.Options{
+				PackageName: testCase.packageName,
+				ToFromTests: testCase.toFromTests,
+			}
+
+			if testCase.configPath != "" {
+				opts.Config = readFile(t, testCase.configPath)
+			}
+
+			got, err := testCase.generate(context.Background(), readSpec(t, testCase.irInputPath), opts)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if diff := cmp.Diff(readDirectory(t, testCase.goldenFileDir), got); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestGenerate_Templates(t *testing.T) {
+	t.Parallel()
+
+	s := readSpec(t, "../../internal/cmd/testdata/custom_and_external/ir.json")
+
+	got, err := generator.GenerateResources(context.Background(), s, generator.Options{
+		PackageName: "generated",
+		Templates: map[string]string{
+			"nested_object_type_string": "\nfunc (t {{.Name}}Type) String() string {\nreturn \"overridden {{.Name}}Type\"\n}",
+		},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if !bytes.Contains(got["example_resource_gen.go"], []byte(`return "overridden `)) {
+		t.Errorf("expected overridden template in generated code")
+	}
+
+	// templates are restored once generation is complete
+	got, err = generator.GenerateResources(context.Background(), s, generator.Options{
+		PackageName: "generated",
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if bytes.Contains(got["example_resource_gen.go"], []byte(`return "overridden `)) {
+		t.Errorf("unexpected overridden template in generated code")
+	}
+}
+
+func TestGenerate_TemplatesError(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		templates     map[string]string
+		expectedError string
+	}{
+		"unknown": {
+			templates: map[string]string{
+				"unknown": "",
+			},
+			expectedError: `error overriding templates: unknown template "unknown"`,
+		},
+		"invalid": {
+			templates: map[string]string{
+				"nested_object_type_string": "{{.Name",
+			},
+			expectedError: `error overriding templates: template "nested_object_type_string"`,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			s := readSpec(t, "../../internal/cmd/testdata/custom_and_external/ir.json")
+
+			_, err := generator.GenerateResources(context.Background(), s, generator.Options{
+				Templates: testCase.templates,
+			})
+			if err == nil {
+				t.Fatal("expected error")
+			}
+
+			if !strings.HasPrefix(err.Error(), testCase.expectedError) {
+				t.Errorf("expected error %q, got %q", testCase.expectedError, err)
+			}
+		})
+	}
+}
+
+func TestGenerate_WriteFile(t *testing.T) {
+	t.Parallel()
+
+	s := readSpec(t, "../../internal/cmd/testdata/custom_and_external/ir.json")
+
+	var paths []string
+
+	got, err := generator.Generate(context.Background(), s, generator.Options{
+		WriteFile: func(path string, content []byte) error {
+			paths = append(paths, path)
+
+			return nil
+		},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	expected := make([]string, 0, len(got))
+
+	for k := range got {
+		expected = append(expected, k)
+	}
+
+	sort.Strings(expected)
+
+	if diff := cmp.Diff(expected, paths); diff != "" {
+		t.Errorf("unexpected difference: %s", diff)
+	}
+}
+
+func TestTemplateNames(t *testing.T) {
+	t.Parallel()
+
+	names := generator.TemplateNames()
+
+	if !sort.StringsAreSorted(names) {
+		t.Errorf("expected sorted template names")
+	}
+
+	for _, name := range []string{"schema", "model_from", "model_to", "nested_object_type_string"} {
+		i := sort.SearchStrings(names, name)
+
+		if i == len(names) || names[i] != name {
+			t.Errorf("expected template name %q", name)
+		}
+	}
+}
+
+func readFile(t *testing.T, name string) []byte {
+	t.Helper()
+
+	b, err := os.ReadFile(name)
+	if err != nil {
+		t.Fatalf("unexpected error reading %s: %s", name, err)
+	}
+
+	return b
+}
+
+func readSpec(t *testing.T, name string) spec.Specification {
+	t.Helper()
+
+	s, err := spec.Parse(context.Background(), readFile(t, name))
+	if err != nil {
+		t.Fatalf("unexpected error parsing %s: %s", name, err)
+	}
+
+	return s
+}
+
+// readDirectory returns the contents of the files within dir, keyed on
+// slash-separated path relative to dir.
+func readDirectory(t *testing.T, dir string) map[string][]byte {
+	t.Helper()
+
+	files := make(map[string][]byte)
+
+	err := filepath.WalkDir(dir, func(p string, d os.DirEntry, err error) error {
+		if err != nil || d.IsDir() {
+			return err
+		}
+
+		rel, err := filepath.Rel(dir, p)
+		if err != nil {
+			return err
+		}
+
+		files[path.Clean(filepath.ToSlash(rel))] = readFile(t, p)
+
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("unexpected error reading %s: %s", dir, err)
+	}
+
+	return files
+}
@@ -0,0 +1,332 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package generator
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"path"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-codegen-spec/spec"
+
+	"github.com/hashicorp/terraform-plugin-codegen-framework/internal/config"
+	"github.com/hashicorp/terraform-plugin-codegen-framework/internal/format"
+	"github.com/hashicorp/terraform-plugin-codegen-framework/internal/logging"
+	"github.com/hashicorp/terraform-plugin-codegen-framework/internal/schema"
+	"github.com/hashicorp/terraform-plugin-codegen-framework/pkg/plugin"
+)
+
+// kind defines how code is generated for data sources, resources or the
+// provider.
+type kind struct {
+	// description is used in error messages (e.g., data source).
+	description string
+
+	// path prefixes schema paths in logging and error messages (e.g.,
+	// data_source).
+	path string
+
+	// generatorType is used in generated function names (e.g., DataSource).
+	generatorType string
+
+	// dirPrefix and fileSuffix are used to name the directory and file
+	// generated for each schema (e.g., datasource_example and
+	// example_data_source_gen.go).
+	dirPrefix  string
+	fileSuffix string
+
+	// sharedTypes indicates whether custom type and value types can be
+	// declared in a shared package.
+	sharedTypes bool
+
+	// schemas converts the specification to framework schemas, applying the
+	// generator configuration and options.
+	schemas func(config.Config, spec.Specification, *schema.Options) (map[string]schema.GeneratorSchema, error)
+}
+
+var dataSources = kind{
+	description:   "data source",
+	path:          "data_source",
+	generatorType: "DataSource",
+	dirPrefix:     "datasource",
+	fileSuffix:    "data_source",
+	sharedTypes:   true,
+	schemas:       config.Config.DataSourceSchemas,
+}
+
+var resources = kind{
+	description:   "resource",
+	path:          "resource",
+	generatorType: "Resource",
+	dirPrefix:     "resource",
+	fileSuffix:    "resource",
+	sharedTypes:   true,
+	schemas:       config.Config.ResourceSchemas,
+}
+
+var providers = kind{
+	description:   "provider",
+	path:          "provider",
+	generatorType: "Provider",
+	dirPrefix:     "provider",
+	fileSuffix:    "provider",
+	schemas:       config.Config.ProviderSchemas,
+}
+
+// generate returns the code generated for each schema, and for any shared custom
+// type and value types, keyed on file path, together with the description of the
+// code generated for each schema which is sent to plugins. If toFromTests is
+// true, unit tests of the to/from functions are also generated for each schema.
+func (k kind) generate(ctx context.Context, s spec.Specification, cfg config.Config, opts *schema.Options, packageName string, toFromTests bool, logger *slog.Logger) (map[string][]byte, []plugin.Schema, error) {
+	ctx = logging.SetPathInContext(ctx, k.path)
+
+	// convert IR to framework schema
+	schemas, err := k.schemas(cfg, s, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// find custom type and value types shared by schemas
+	g := schema.NewGeneratorSchemas(schemas, opts)
+	shared, err := k.findSharedTypes(g, cfg, opts, packageName)
+	if err != nil {
+		return nil, nil, fmt.Errorf("error finding shared custom type and value types: %w", err)
+	}
+
+	// check for Go types declared more than once in a package
+	err = g.CheckCollisions(k.path, packageName, shared)
+	if err != nil {
+		return nil, nil, fmt.Errorf("error checking generated Go type names: %w", err)
+	}
+
+	// convert framework schema to []byte
+	schemaBytes, err := g.Schemas(packageName, k.generatorType)
+	if err != nil {
+		return nil, nil, fmt.Errorf("error converting Plugin Framework schema to Go code: %w", err)
+	}
+
+	// generate model code
+	models, err := g.Models()
+	if err != nil {
+		return nil, nil, fmt.Errorf("error generating model code: %w", err)
+	}
+
+	// generate custom type and value types code
+	customTypeValue, err := g.CustomTypeValue()
+	if err != nil {
+		return nil, nil, fmt.Errorf("error generating custom type and value types code: %w", err)
+	}
+
+	// generate "expand" and "flatten" code
+	toFromFunctions, err := g.ToFromFunctions(ctx, logger)
+	if err != nil {
+		return nil, nil, fmt.Errorf("error generating to/from code: %w", err)
+	}
+
+	// generate unit tests of "expand" and "flatten" code
+	tests := make(map[string][]byte)
+
+	if toFromTests {
+		tests, err = g.ToFromTests(packageName, k.generatorType)
+		if err != nil {
+			return nil, nil, fmt.Errorf("error generating to/from tests: %w", err)
+		}
+	}
+
+	// generate CRUD methods calling a client interface
+	crudMethods, err := g.CRUDMethods(packageName, k.generatorType)
+	if err != nil {
+		return nil, nil, fmt.Errorf("error generating CRUD methods: %w", err)
+	}
+
+	// generate state upgraders from prior schema versions
+	stateUpgraders, err := g.StateUpgraders(packageName, k.generatorType)
+	if err != nil {
+		return nil, nil, fmt.Errorf("error generating state upgraders: %w", err)
+	}
+
+	// format code
+	var formatted [4]map[string][]byte
+
+	for i, v := range []map[string][]byte{schemaBytes, models, customTypeValue, toFromFunctions} {
+		formatted[i], err = format.Format(v)
+		if err != nil {
+			return nil, nil, fmt.Errorf("error formatting Go code: %w", err)
+		}
+	}
+
+	files := make(map[string][]byte, len(schemaBytes)+1)
+
+	pluginSchemas := make([]plugin.Schema, 0, len(schemaBytes))
+
+	for name := range schemaBytes {
+		var buf bytes.Buffer
+
+		for _, v := range formatted {
+			buf.Write(v[name])
+		}
+
+		b := buf.Bytes()
+
+		// remove imports which are unused when types are shared
+		if len(shared.Names()) > 0 {
+			b, err = format.RemoveUnusedImports(b)
+			if err != nil {
+				return nil, nil, fmt.Errorf("error formatting Go code: %w", err)
+			}
+		}
+
+		filename := fmt.Sprintf("%s_%s_gen.go", name, k.fileSuffix)
+
+		if packageName == "" {
+			filename = path.Join(fmt.Sprintf("%s_%s", k.dirPrefix, name), filename)
+		}
+
+		files[filename] = b
+
+		if test, ok := tests[name]; ok {
+			files[strings.TrimSuffix(filename, ".go")+"_test.go"] = test
+		}
+
+		if crud, ok := crudMethods[name]; ok {
+			files[strings.TrimSuffix(filename, "_gen.go")+"_crud_gen.go"] = crud
+		}
+
+		if stateUpgraders, ok := stateUpgraders[name]; ok {
+			files[strings.TrimSuffix(filename, "_gen.go")+"_state_upgrade_gen.go"] = stateUpgraders
+		}
+
+		p, err := k.pluginSchema(name, schemas[name], filename, packageName)
+		if err != nil {
+			return nil, nil, fmt.Errorf("error generating plugin request: %w", err)
+		}
+
+		pluginSchemas = append(pluginSchemas, p)
+	}
+
+	sort.Slice(pluginSchemas, func(i, j int) bool {
+		return pluginSchemas[i].Name < pluginSchemas[j].Name
+	})
+
+	if len(shared.Names()) == 0 {
+		return files, pluginSchemas, nil
+	}
+
+	// generate shared custom type and value types code
+	sharedPackageName := k.sharedTypesPackageName()
+
+	pkgName := packageName
+
+	if pkgName == "" {
+		pkgName = sharedPackageName
+	}
+
+	b, err := shared.Bytes(ctx, pkgName, logger)
+	if err != nil {
+		return nil, nil, fmt.Errorf("error generating shared custom type and value types code: %w", err)
+	}
+
+	b, err = format.RemoveUnusedImports(b)
+	if err != nil {
+		return nil, nil, fmt.Errorf("error formatting Go code: %w", err)
+	}
+
+	filename := sharedPackageName + "_gen.go"
+
+	if packageName == "" {
+		filename = path.Join(sharedPackageName, filename)
+	}
+
+	files[filename] = b
+
+	return files, pluginSchemas, nil
+}
+
+// sharedTypesPackageName returns the name of the package which shared custom type
+// and value types are generated into when no package name is set (e.g.,
+// shared_resource).
+func (k kind) sharedTypesPackageName() string {
+	return "shared_" + strings.ToLower(k.generatorType)
+}
+
+// findSharedTypes returns the custom type and value types which are shared by the
+// schemas, if enabled in the generator configuration, and sets them on the options
+// for use when generating code for each schema.
+func (k kind) findSharedTypes(g schema.GeneratorSchemas, cfg config.Config, opts *schema.Options, packageName string) (schema.SharedTypes, error) {
+	if !k.sharedTypes || cfg.SharedTypes == nil {
+		return schema.SharedTypes{}, nil
+	}
+
+	if packageName == "" && cfg.SharedTypes.ImportPath == "" {
+		return schema.SharedTypes{}, errors.New("shared_types import_path is required when no package name is set")
+	}
+
+	shared, err := g.SharedTypes(k.path)
+	if err != nil {
+		return schema.SharedTypes{}, err
+	}
+
+	if packageName != "" {
+		opts.SetSharedTypes(shared, "", "")
+
+		return shared, nil
+	}
+
+	sharedPackageName := k.sharedTypesPackageName()
+
+	opts.SetSharedTypes(shared, sharedPackageName, strings.TrimSuffix(cfg.SharedTypes.ImportPath, "/")+"/"+sharedPackageName)
+
+	return shared, nil
+}
+
+// checkPackageCollisions returns an error if the code generated for the kinds
+// would declare the same Go type more than once when placed into the same
+// package.
+func checkPackageCollisions(s spec.Specification, cfg config.Config, kinds []kind) error {
+	var declarations []schema.Declaration
+
+	for _, k := range kinds {
+		opts := cfg.Naming.Options()
+
+		schemas, err := k.schemas(cfg, s, opts)
+		if err != nil {
+			return err
+		}
+
+		var shared schema.SharedTypes
+
+		if k.sharedTypes && cfg.SharedTypes != nil {
+			shared, err = schema.NewGeneratorSchemas(schemas, opts).SharedTypes(k.path)
+			if err != nil {
+				return err
+			}
+
+			declarations = append(declarations, shared.Declarations()...)
+		}
+
+		names := make([]string, 0, len(schemas))
+
+		for name := range schemas {
+			names = append(names, name)
+		}
+
+		sort.Strings(names)
+
+		for _, name := range names {
+			d, err := schemas[name].Declarations(name, k.path, shared)
+			if err != nil {
+				return err
+			}
+
+			declarations = append(declarations, d...)
+		}
+	}
+
+	return schema.CheckDeclarations(declarations)
+}
@@ -0,0 +1,243 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package generator
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os/exec"
+	"path"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-codegen-framework/internal/schema"
+	"github.com/hashicorp/terraform-plugin-codegen-framework/pkg/plugin"
+)
+
+// pluginTypes maps attribute and block types to the names used in plugin
+// requests.
+var pluginTypes = map[schema.Type]string{
+	schema.GeneratorBoolAttribute:         "bool",
+	schema.GeneratorFloat64Attribute:      "float64",
+	schema.GeneratorInt64Attribute:        "int64",
+	schema.GeneratorListAttribute:         "list",
+	schema.GeneratorListNestedAttribute:   "list_nested",
+	schema.GeneratorListNestedBlock:       "list_nested",
+	schema.GeneratorMapAttribute:          "map",
+	schema.GeneratorMapNestedAttribute:    "map_nested",
+	schema.GeneratorNumberAttribute:       "number",
+	schema.GeneratorObjectAttribute:       "object",
+	schema.GeneratorSetAttribute:          "set",
+	schema.GeneratorSetNestedAttribute:    "set_nested",
+	schema.GeneratorSetNestedBlock:        "set_nested",
+	schema.GeneratorSingleNestedAttribute: "single_nested",
+	schema.GeneratorSingleNestedBlock:     "single_nested",
+	schema.GeneratorStringAttribute:       "string",
+}
+
+// pluginSchema returns the description of the code generated for the named
+// schema which is sent to plugins. Shared types must be set on the schema
+// options, as custom type names are qualified with the shared package name.
+func (k kind) pluginSchema(name string, s schema.GeneratorSchema, file, packageName string) (plugin.Schema, error) {
+	if packageName == "" {
+		packageName = fmt.Sprintf("%s_%s", k.dirPrefix, name)
+	}
+
+	pascalName := s.Options.ToPascalCase(schema.FrameworkIdentifier(name))
+
+	attributes, err := pluginAttributes(s.Attributes, "", s.Options)
+	if err != nil {
+		return plugin.Schema{}, err
+	}
+
+	blocks, err := pluginBlocks(s.Blocks, "", s.Options)
+	if err != nil {
+		return plugin.Schema{}, err
+	}
+
+	return plugin.Schema{
+		Name:           name,
+		File:           file,
+		PackageName:    packageName,
+		SchemaFunction: pascalName + k.generatorType + "Schema",
+		Model:          pascalName + "Model",
+		Attributes:     attributes,
+		Blocks:         blocks,
+	}, nil
+}
+
+func pluginAttributes(attributes schema.GeneratorAttributes, parent string, opts *schema.Options) ([]plugin.Attribute, error) {
+	var result []plugin.Attribute
+
+	for _, k := range attributes.SortedKeys() {
+		a := attributes[k]
+
+		if a == nil {
+			continue
+		}
+
+		f, err := a.ModelField(schema.FrameworkIdentifier(k))
+		if err != nil {
+			return nil, err
+		}
+
+		typeName := opts.NestedTypeName(parent, k)
+
+		customType, err := pluginCustomType(a, typeName, opts)
+		if err != nil {
+			return nil, err
+		}
+
+		attribute := plugin.Attribute{
+			Name:       k,
+			Type:       pluginTypes[a.GeneratorSchemaType()],
+			ModelField: f.Name,
+			ValueType:  f.ValueType,
+			CustomType: customType,
+		}
+
+		if n, ok := a.(schema.Attributes); ok {
+			attribute.Attributes, err = pluginAttributes(n.GetAttributes(), typeName, opts)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		result = append(result, attribute)
+	}
+
+	return result, nil
+}
+
+func pluginBlocks(blocks schema.GeneratorBlocks, parent string, opts *schema.Options) ([]plugin.Block, error) {
+	var result []plugin.Block
+
+	for _, k := range blocks.SortedKeys() {
+		b := blocks[k]
+
+		if b == nil {
+			continue
+		}
+
+		f, err := b.ModelField(schema.FrameworkIdentifier(k))
+		if err != nil {
+			return nil, err
+		}
+
+		typeName := opts.NestedTypeName(parent, k)
+
+		customType, err := pluginCustomType(b, typeName, opts)
+		if err != nil {
+			return nil, err
+		}
+
+		block := plugin.Block{
+			Name:       k,
+			Type:       pluginTypes[b.GeneratorSchemaType()],
+			ModelField: f.Name,
+			ValueType:  f.ValueType,
+			CustomType: customType,
+		}
+
+		if n, ok := b.(schema.Blocks); ok {
+			block.Attributes, err = pluginAttributes(n.GetAttributes(), typeName, opts)
+			if err != nil {
+				return nil, err
+			}
+
+			block.Blocks, err = pluginBlocks(n.GetBlocks(), typeName, opts)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		result = append(result, block)
+	}
+
+	return result, nil
+}
+
+// pluginCustomType returns the names of the custom type and value types
+// generated for an attribute or block. Nested attributes and blocks always
+// generate custom type and value types, whereas other attributes only
+// generate them if they have an associated external type.
+func pluginCustomType(v any, typeName string, opts *schema.Options) (*plugin.CustomType, error) {
+	c, ok := v.(schema.CustomTypeAndValue)
+
+	if !ok {
+		return nil, nil
+	}
+
+	if _, nested := v.(schema.Attributes); !nested {
+		b, err := c.CustomTypeAndValue(typeName)
+		if err != nil {
+			return nil, err
+		}
+
+		if len(b) == 0 {
+			return nil, nil
+		}
+	}
+
+	name := opts.TypeReference(typeName)
+
+	return &plugin.CustomType{
+		Type:  name + "Type",
+		Value: name + "Value",
+	}, nil
+}
+
+// runPlugin sends the request to the named plugin, and returns the files it
+// generates keyed on path. The name is either a path to the plugin executable,
+// or the name of an executable on the PATH.
+func runPlugin(ctx context.Context, name string, req plugin.Request) (map[string][]byte, error) {
+	in, err := json.Marshal(req)
+	if err != nil {
+		return nil, fmt.Errorf("error encoding request: %w", err)
+	}
+
+	var stdout, stderr bytes.Buffer
+
+	c := exec.CommandContext(ctx, name)
+	c.Stdin = bytes.NewReader(in)
+	c.Stdout = &stdout
+	c.Stderr = &stderr
+
+	runErr := c.Run()
+
+	var resp plugin.Response
+
+	decodeErr := json.Unmarshal(stdout.Bytes(), &resp)
+
+	switch {
+	case decodeErr == nil && resp.Error != "":
+		return nil, errors.New(resp.Error)
+	case runErr != nil:
+		if s := strings.TrimSpace(stderr.String()); s != "" {
+			return nil, fmt.Errorf("%w: %s", runErr, s)
+		}
+
+		return nil, runErr
+	case decodeErr != nil:
+		return nil, fmt.Errorf("error decoding response: %w", decodeErr)
+	}
+
+	files := make(map[string][]byte, len(resp.Files))
+
+	for _, f := range resp.Files {
+		if f.Path == "" || path.IsAbs(f.Path) || path.Clean(f.Path) != f.Path || strings.HasPrefix(f.Path, "../") || f.Path == ".." || strings.Contains(f.Path, "\\") {
+			return nil, fmt.Errorf("invalid file path %q: must be a clean, relative, slash-separated path within the output directory", f.Path)
+		}
+
+		if _, ok := files[f.Path]; ok {
+			return nil, fmt.Errorf("file %q returned more than once", f.Path)
+		}
+
+		files[f.Path] = []byte(f.Content)
+	}
+
+	return files, nil
+}
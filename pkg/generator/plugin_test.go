@@ -0,0 +1,169 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package generator_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/hashicorp/terraform-plugin-codegen-framework/pkg/generator"
+	"github.com/hashicorp/terraform-plugin-codegen-framework/pkg/plugin"
+)
+
+// testPluginEnv is set to run the test binary as a generator plugin, which
+// behaves according to the value.
+const testPluginEnv = "TFPLUGINGEN_TEST_PLUGIN"
+
+func TestMain(m *testing.M) {
+	switch os.Getenv(testPluginEnv) {
+	case "":
+		os.Exit(m.Run())
+	case "request":
+		plugin.Main(func(req plugin.Request) (plugin.Response, error) {
+			b, err := json.MarshalIndent(req, "", "  ")
+			if err != nil {
+				return plugin.Response{}, err
+			}
+
+			return plugin.Response{
+				Files: []plugin.File{
+					{
+						Path:    "plugin/request.json",
+						Content: string(b) + "\n",
+					},
+				},
+			}, nil
+		})
+	case "error":
+		plugin.Main(func(req plugin.Request) (plugin.Response, error) {
+			return plugin.Response{}, errors.New("example error")
+		})
+	case "exit":
+		fmt.Fprintln(os.Stderr, "example failure")
+		os.Exit(2)
+	case "collision":
+		plugin.Main(func(req plugin.Request) (plugin.Response, error) {
+			return plugin.Response{
+				Files: []plugin.File{
+					{
+						Path: req.Resources[0].File,
+					},
+				},
+			}, nil
+		})
+	case "invalid_path":
+		plugin.Main(func(req plugin.Request) (plugin.Response, error) {
+			return plugin.Response{
+				Files: []plugin.File{
+					{
+						Path: "../example.go",
+					},
+				},
+			}, nil
+		})
+	}
+
+	os.Exit(0)
+}
+
+// The plugin environment variable applies to the whole process, so plugin
+// tests cannot run in parallel.
+func TestGenerate_Plugins(t *testing.T) {
+	executable, err := os.Executable()
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	s := readSpec(t, "../../internal/cmd/testdata/shared_types/ir.json")
+	cfg := readFile(t, "../../internal/cmd/testdata/shared_types/config.json")
+
+	t.Setenv(testPluginEnv, "request")
+
+	var written []string
+
+	got, err := generator.Generate(context.Background(), s, generator.Options{
+		Config:  cfg,
+		Plugins: []string{executable},
+		WriteFile: func(path string, content []byte) error {
+			written = append(written, path)
+
+			return nil
+		},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if diff := cmp.Diff(string(readFile(t, "testdata/plugin/request.json")), string(got["plugin/request.json"])); diff != "" {
+		t.Errorf("unexpected difference: %s", diff)
+	}
+
+	if diff := cmp.Diff(len(got), len(written)); diff != "" {
+		t.Errorf("unexpected difference: %s", diff)
+	}
+}
+
+func TestGenerate_PluginsError(t *testing.T) {
+	executable, err := os.Executable()
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	testCases := map[string]struct {
+		plugin        string
+		env           string
+		expectedError string
+	}{
+		"error": {
+			plugin:        executable,
+			env:           "error",
+			expectedError: fmt.Sprintf("error running plugin %s: example error", executable),
+		},
+		"exit": {
+			plugin:        executable,
+			env:           "exit",
+			expectedError: fmt.Sprintf("error running plugin %s: exit status 2: example failure", executable),
+		},
+		"collision": {
+			plugin:        executable,
+			env:           "collision",
+			expectedError: fmt.Sprintf("error running plugin %s: file \"resource_instance/instance_resource_gen.go\" has already been generated", executable),
+		},
+		"invalid_path": {
+			plugin:        executable,
+			env:           "invalid_path",
+			expectedError: fmt.Sprintf("error running plugin %s: invalid file path \"../example.go\"", executable),
+		},
+		"not_found": {
+			plugin:        "tfplugingen-does-not-exist",
+			expectedError: "error running plugin tfplugingen-does-not-exist: exec: \"tfplugingen-does-not-exist\": executable file not found in $PATH",
+		},
+	}
+
+	s := readSpec(t, "../../internal/cmd/testdata/shared_types/ir.json")
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Setenv(testPluginEnv, testCase.env)
+
+			_, err := generator.GenerateResources(context.Background(), s, generator.Options{
+				Plugins: []string{testCase.plugin},
+			})
+			if err == nil {
+				t.Fatal("expected error")
+			}
+
+			if !strings.HasPrefix(err.Error(), testCase.expectedError) {
+				t.Errorf("expected error %q, got %q", testCase.expectedError, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,188 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+// Package plugin defines the protocol used by tfplugingen-framework to run
+// external generator plugins, which generate additional files from the
+// resolved generator model.
+//
+// A plugin is an executable which reads a JSON encoded Request from stdin,
+// and writes a JSON encoded Response to stdout. Plugins are supplied to the
+// generate commands with the --plugin flag, which accepts either a path to
+// the plugin executable or the name of an executable on the PATH (e.g.,
+// --plugin=tfplugingen-example). Anything a plugin writes to stderr is
+// included in the error returned when the plugin fails.
+package plugin
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+)
+
+// ProtocolVersion is the version of the protocol described by Request and
+// Response. It is incremented for changes which are not backwards compatible.
+const ProtocolVersion = 1
+
+// Request is sent to the plugin on stdin.
+type Request struct {
+	// ProtocolVersion is the version of the protocol in use.
+	ProtocolVersion int `json:"protocol_version"`
+
+	// PackageName is the name of the Go package for all generated code, if
+	// set with the --package flag.
+	PackageName string `json:"package_name,omitempty"`
+
+	// DataSources, Provider and Resources hold the schemas for which code
+	// has been generated, sorted by name.
+	DataSources []Schema `json:"data_sources,omitempty"`
+	Provider    []Schema `json:"provider,omitempty"`
+	Resources   []Schema `json:"resources,omitempty"`
+}
+
+// Schema describes the code generated for a data source, resource or
+// provider schema.
+type Schema struct {
+	// Name is the name of the data source, resource or provider in the
+	// specification (e.g., example).
+	Name string `json:"name"`
+
+	// File is the slash-separated path of the generated file, relative to the
+	// output directory (e.g., resource_example/example_resource_gen.go).
+	File string `json:"file"`
+
+	// PackageName is the name of the Go package of the generated file (e.g.,
+	// resource_example).
+	PackageName string `json:"package_name"`
+
+	// SchemaFunction is the name of the generated function which returns the
+	// schema (e.g., ExampleResourceSchema).
+	SchemaFunction string `json:"schema_function"`
+
+	// Model is the name of the generated data model type (e.g., ExampleModel).
+	Model string `json:"model"`
+
+	// Attributes and Blocks are sorted by name.
+	Attributes []Attribute `json:"attributes,omitempty"`
+	Blocks     []Block     `json:"blocks,omitempty"`
+}
+
+// Attribute describes a schema attribute, and its field in the data model.
+type Attribute struct {
+	// Name is the name of the attribute in the schema (e.g., api_url).
+	Name string `json:"name"`
+
+	// Type is the type of the attribute in snake case (e.g., bool, list or
+	// single_nested).
+	Type string `json:"type"`
+
+	// ModelField is the name of the data model field (e.g., ApiUrl).
+	ModelField string `json:"model_field"`
+
+	// ValueType is the Go type of the data model field (e.g., types.String).
+	ValueType string `json:"value_type"`
+
+	// CustomType holds the names of the custom type and value types generated
+	// for the attribute, if any.
+	CustomType *CustomType `json:"custom_type,omitempty"`
+
+	// Attributes holds any nested attributes, sorted by name.
+	Attributes []Attribute `json:"attributes,omitempty"`
+}
+
+// Block describes a schema block, and its field in the data model.
+type Block struct {
+	// Name is the name of the block in the schema (e.g., network_interface).
+	Name string `json:"name"`
+
+	// Type is the type of the block in snake case (e.g., list_nested).
+	Type string `json:"type"`
+
+	// ModelField is the name of the data model field (e.g., NetworkInterface).
+	ModelField string `json:"model_field"`
+
+	// ValueType is the Go type of the data model field (e.g., types.List).
+	ValueType string `json:"value_type"`
+
+	// CustomType holds the names of the custom type and value types generated
+	// for the block.
+	CustomType *CustomType `json:"custom_type,omitempty"`
+
+	// Attributes and Blocks hold any nested attributes and blocks, sorted by
+	// name.
+	Attributes []Attribute `json:"attributes,omitempty"`
+	Blocks     []Block     `json:"blocks,omitempty"`
+}
+
+// CustomType holds the names of generated custom type and value types, which
+// are qualified with the package name when declared in a shared package (e.g.,
+// shared_resource.TagsType).
+type CustomType struct {
+	Type  string `json:"type"`
+	Value string `json:"value"`
+}
+
+// Response is written by the plugin to stdout.
+type Response struct {
+	// Files are written to the output directory alongside the generated code.
+	Files []File `json:"files,omitempty"`
+
+	// Error, if set, indicates that the plugin failed, and no files are
+	// written.
+	Error string `json:"error,omitempty"`
+}
+
+// File is a file generated by a plugin.
+type File struct {
+	// Path is the slash-separated path of the file, relative to the output
+	// directory. It must not refer to a file generated by
+	// tfplugingen-framework or by another plugin.
+	Path string `json:"path"`
+
+	// Content is the content of the file.
+	Content string `json:"content"`
+}
+
+// Main runs a plugin, calling f with the request read from stdin and writing
+// the response to stdout. An error returned by f is written to the response,
+// and the process exits with a non-zero status.
+func Main(f func(Request) (Response, error)) {
+	err := Serve(os.Stdin, os.Stdout, f)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
+
+// Serve decodes a request from r, calls f, and encodes the response to w. An
+// error returned by f is encoded as the response error, and returned.
+func Serve(r io.Reader, w io.Writer, f func(Request) (Response, error)) error {
+	var req Request
+
+	err := json.NewDecoder(r).Decode(&req)
+	if err != nil {
+		return fmt.Errorf("error decoding request: %w", err)
+	}
+
+	if req.ProtocolVersion != ProtocolVersion {
+		err = fmt.Errorf("unsupported protocol version %d, expected %d", req.ProtocolVersion, ProtocolVersion)
+
+		return encodeResponse(w, Response{Error: err.Error()}, err)
+	}
+
+	resp, err := f(req)
+	if err != nil {
+		return encodeResponse(w, Response{Error: err.Error()}, err)
+	}
+
+	return encodeResponse(w, resp, nil)
+}
+
+func encodeResponse(w io.Writer, resp Response, err error) error {
+	encodeErr := json.NewEncoder(w).Encode(resp)
+	if encodeErr != nil {
+		return fmt.Errorf("error encoding response: %w", encodeErr)
+	}
+
+	return err
+}
@@ -0,0 +1,92 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package plugin_test
+
+import (
+	"bytes"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/hashicorp/terraform-plugin-codegen-framework/pkg/plugin"
+)
+
+func TestServe(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		request          string
+		f                func(plugin.Request) (plugin.Response, error)
+		expectedResponse string
+		expectedError    string
+	}{
+		"files": {
+			request: `{"protocol_version": 1, "resources": [{"name": "example"}]}`,
+			f: func(req plugin.Request) (plugin.Response, error) {
+				return plugin.Response{
+					Files: []plugin.File{
+						{
+							Path:    req.Resources[0].Name + ".txt",
+							Content: "example",
+						},
+					},
+				}, nil
+			},
+			expectedResponse: `{"files":[{"path":"example.txt","content":"example"}]}` + "\n",
+		},
+		"error": {
+			request: `{"protocol_version": 1}`,
+			f: func(req plugin.Request) (plugin.Response, error) {
+				return plugin.Response{}, errors.New("example error")
+			},
+			expectedResponse: `{"error":"example error"}` + "\n",
+			expectedError:    "example error",
+		},
+		"unsupported_protocol_version": {
+			request: `{"protocol_version": 2}`,
+			f: func(req plugin.Request) (plugin.Response, error) {
+				return plugin.Response{}, nil
+			},
+			expectedResponse: `{"error":"unsupported protocol version 2, expected 1"}` + "\n",
+			expectedError:    "unsupported protocol version 2, expected 1",
+		},
+		"invalid_request": {
+			request: `{`,
+			f: func(req plugin.Request) (plugin.Response, error) {
+				return plugin.Response{}, nil
+			},
+			expectedError: "error decoding request: unexpected EOF",
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var w bytes.Buffer
+
+			err := plugin.Serve(strings.NewReader(testCase.request), &w, testCase.f)
+
+			if err != nil {
+				if testCase.expectedError == "" {
+					t.Fatalf("unexpected error: %s", err)
+				}
+
+				if diff := cmp.Diff(testCase.expectedError, err.Error()); diff != "" {
+					t.Errorf("unexpected error difference: %s", diff)
+				}
+			}
+
+			if err == nil && testCase.expectedError != "" {
+				t.Fatalf("expected error: %s", testCase.expectedError)
+			}
+
+			if diff := cmp.Diff(testCase.expectedResponse, w.String()); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}